@@ -483,12 +483,10 @@ func New(
 	app.transferModule = transfer.NewAppModule(app.TransferKeeper)
 
 	// create IBC module from bottom to top of stack
+	// the ibccrosschain middleware is added on top of the stack once its keeper is initialized
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
 
-	// Add transfer stack to IBC Router
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
-
 	// ZetaChain keepers
 
 	app.AuthorityKeeper = authoritykeeper.NewKeeper(
@@ -595,6 +593,7 @@ func New(
 		&app.FungibleKeeper,
 		app.AuthorityKeeper,
 		app.LightclientKeeper,
+		app.EmissionsKeeper,
 	)
 
 	// initialize ibccrosschain keeper and set it to the crosschain keeper
@@ -609,9 +608,13 @@ func New(
 		keys[ibccrosschaintypes.MemStoreKey],
 		&app.CrosschainKeeper,
 		app.TransferKeeper,
+		app.AuthorityKeeper,
 	)
 
-	ibcRouter.AddRoute(ibccrosschaintypes.ModuleName, ibccrosschain.NewIBCModule(app.IBCCrosschainKeeper))
+	// Add transfer stack to IBC Router
+	// the ibccrosschain middleware deposits into zEVM the transfers received from IBC chains and finalizes the outbounds
+	transferStack = ibccrosschain.NewIBCModule(transferStack, app.IBCCrosschainKeeper)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
 
 	app.CrosschainKeeper.SetIBCCrosschainKeeper(app.IBCCrosschainKeeper)
 
@@ -0,0 +1,131 @@
+// Command zetaclient-remote-signer is a reference remote signer holding the zetaclient hotkey
+//
+// It signs over mutual TLS the zetaclient transactions executing the messages zetaclient is granted through authz
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/zeta-chain/zetacore/app"
+	"github.com/zeta-chain/zetacore/cmd"
+	zetacoredconfig "github.com/zeta-chain/zetacore/cmd/zetacored/config"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/keys"
+	"github.com/zeta-chain/zetacore/zetaclient/remotesigner"
+)
+
+// defaultMaxFee is the default maximum fee paid by a transaction signed by the remote signer (1 ZETA)
+const defaultMaxFee = "1000000000000000000" + zetacoredconfig.BaseDenom
+
+var rootArgs = struct {
+	home           string
+	keyringBackend string
+	keyName        string
+	listen         string
+	certFile       string
+	keyFile        string
+	caFile         string
+	chainID        string
+	maxFee         string
+}{}
+
+var RootCmd = &cobra.Command{
+	Use:   "zetaclient-remote-signer",
+	Short: "Sign zetaclient transactions with the hotkey over mutual TLS",
+	RunE:  start,
+}
+
+func init() {
+	RootCmd.Flags().StringVar(&rootArgs.home, "home", app.DefaultNodeHome, "home directory of the keyring")
+	RootCmd.Flags().
+		StringVar(&rootArgs.keyringBackend, "keyring-backend", string(config.KeyringBackendTest), "keyring backend: test or file")
+	RootCmd.Flags().StringVar(&rootArgs.keyName, "key-name", "hotkey", "name of the hotkey in the keyring")
+	RootCmd.Flags().StringVar(&rootArgs.listen, "listen", "127.0.0.1:9999", "address to listen on")
+	RootCmd.Flags().StringVar(&rootArgs.certFile, "cert", "", "server certificate file")
+	RootCmd.Flags().StringVar(&rootArgs.keyFile, "key", "", "server key file")
+	RootCmd.Flags().StringVar(&rootArgs.caFile, "ca", "", "certificate authority of the zetaclient certificates")
+	RootCmd.Flags().StringVar(&rootArgs.chainID, "chain-id", "", "only sign the transactions of this chain id if set")
+	RootCmd.Flags().
+		StringVar(&rootArgs.maxFee, "max-fee", defaultMaxFee, "maximum fee paid by a signed transaction, empty to refuse any fee")
+}
+
+func main() {
+	if err := RootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func start(_ *cobra.Command, _ []string) error {
+	sdkConfig := sdk.GetConfig()
+	sdkConfig.SetBech32PrefixForAccount(cmd.Bech32PrefixAccAddr, cmd.Bech32PrefixAccPub)
+	logger := zerolog.New(zerolog.NewConsoleWriter()).With().Timestamp().Logger()
+
+	// read the hotkey password for the file keyring backend
+	password := ""
+	backend := config.KeyringBackend(rootArgs.keyringBackend)
+	if backend == config.KeyringBackendFile {
+		fmt.Print("HotKey Password: ")
+		input, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil {
+			return err
+		}
+		password = strings.TrimSpace(input)
+	}
+
+	maxFee, err := sdk.ParseCoinsNormalized(rootArgs.maxFee)
+	if err != nil {
+		return fmt.Errorf("invalid max fee %s: %w", rootArgs.maxFee, err)
+	}
+
+	// load the hotkey
+	cfg := config.Config{
+		ZetaCoreHome:   rootArgs.home,
+		KeyringBackend: backend,
+		AuthzHotkey:    rootArgs.keyName,
+	}
+	kb, _, err := keys.GetKeyringKeybase(cfg, password)
+	if err != nil {
+		return err
+	}
+	privKey, err := keys.NewKeysWithKeybase(kb, nil, rootArgs.keyName, password).GetPrivateKey(password)
+	if err != nil {
+		return err
+	}
+
+	// serve the remote signer over mutual TLS
+	tlsConfig, err := remotesigner.NewServerTLSConfig(rootArgs.certFile, rootArgs.keyFile, rootArgs.caFile)
+	if err != nil {
+		return err
+	}
+	listener, err := net.Listen("tcp", rootArgs.listen)
+	if err != nil {
+		return err
+	}
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)), remotesigner.ServerCodec())
+	remotesigner.RegisterRemoteSignerServer(server, remotesigner.NewServer(privKey, rootArgs.chainID, maxFee, logger))
+
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-ch
+		logger.Info().Msg("stopping remote signer")
+		server.GracefulStop()
+	}()
+
+	logger.Info().
+		Msgf("remote signer for hotkey %s listening on %s", sdk.AccAddress(privKey.PubKey().Address()), rootArgs.listen)
+	return server.Serve(listener)
+}
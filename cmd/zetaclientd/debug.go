@@ -6,7 +6,6 @@ import (
 	"io"
 	"strconv"
 	"strings"
-	"sync"
 
 	"github.com/btcsuite/btcd/rpcclient"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,8 +19,12 @@ import (
 	"github.com/zeta-chain/zetacore/pkg/coin"
 	"github.com/zeta-chain/zetacore/testutil/sample"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/base"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/bitcoin"
 	btcobserver "github.com/zeta-chain/zetacore/zetaclient/chains/bitcoin/observer"
 	evmobserver "github.com/zeta-chain/zetacore/zetaclient/chains/evm/observer"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	clientcommon "github.com/zeta-chain/zetacore/zetaclient/common"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 	clientcontext "github.com/zeta-chain/zetacore/zetaclient/context"
 	"github.com/zeta-chain/zetacore/zetaclient/keys"
@@ -78,21 +81,26 @@ func DebugCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			additionalChains, err := client.GetAdditionalChains()
+			if err != nil {
+				return err
+			}
 			tssEthAddress, err := client.GetEthTssAddress()
 			if err != nil {
 				return err
 			}
-			chain := chains.GetChainFromChainID(chainID)
+			chain := chains.GetChainFromChainID(chainID, additionalChains)
 			if chain == nil {
 				return fmt.Errorf("invalid chain id")
 			}
 
 			// get ballot identifier according to the chain type
-			if chains.IsEVMChain(chain.ChainId) {
-				evmObserver := evmobserver.Observer{
-					Mu: &sync.Mutex{},
+			if chain.IsEVMChain() {
+				baseObserver, err := newDebugObserver(*chain, coreContext, client)
+				if err != nil {
+					return err
 				}
-				evmObserver.WithZetacoreClient(client)
+				evmObserver := evmobserver.NewObserverFromBase(baseObserver)
 				evmObserver.WithLogger(chainLogger)
 				var ethRPC *ethrpc.EthRPC
 				var client *ethclient.Client
@@ -106,7 +114,7 @@ func DebugCmd() *cobra.Command {
 						}
 						evmObserver.WithEvmClient(client)
 						evmObserver.WithEvmJSONRPC(ethRPC)
-						evmObserver.WithChain(*chains.GetChainFromChainID(chainID))
+						evmObserver.WithChain(*chains.GetChainFromChainID(chainID, additionalChains))
 					}
 				}
 				hash := ethcommon.HexToHash(inboundHash)
@@ -167,20 +175,29 @@ func DebugCmd() *cobra.Command {
 					fmt.Println("CoinType not detected")
 				}
 				fmt.Println("CoinType : ", coinType)
-			} else if chains.IsBitcoinChain(chain.ChainId) {
-				btcObserver := btcobserver.Observer{
-					Mu: &sync.Mutex{},
+			} else if chain.IsBitcoinChain() {
+				baseObserver, err := newDebugObserver(*chain, coreContext, client)
+				if err != nil {
+					return err
 				}
-				btcObserver.WithZetacoreClient(client)
+				netParams, err := chains.BitcoinNetParamsFromChainID(chainID)
+				if err != nil {
+					return err
+				}
+				btcObserver := btcobserver.NewObserverFromBase(baseObserver, netParams)
 				btcObserver.WithLogger(chainLogger)
-				btcObserver.WithChain(*chains.GetChainFromChainID(chainID))
+				btcObserver.WithChain(*chains.GetChainFromChainID(chainID, additionalChains))
+				btcConfig, found := cfg.GetBTCConfig(chainID)
+				if !found {
+					return fmt.Errorf("bitcoin config not found for chain %d", chainID)
+				}
 				connCfg := &rpcclient.ConnConfig{
-					Host:         cfg.BitcoinConfig.RPCHost,
-					User:         cfg.BitcoinConfig.RPCUsername,
-					Pass:         cfg.BitcoinConfig.RPCPassword,
+					Host:         btcConfig.RPCHost,
+					User:         btcConfig.RPCUsername,
+					Pass:         btcConfig.RPCPassword,
 					HTTPPostMode: true,
 					DisableTLS:   true,
-					Params:       cfg.BitcoinConfig.RPCParams,
+					Params:       bitcoin.RPCClientParams(btcConfig.RPCParams),
 				}
 
 				btcClient, err := rpcclient.New(connCfg, nil)
@@ -213,3 +230,21 @@ func DebugCmd() *cobra.Command {
 
 	return cmd
 }
+
+// newDebugObserver creates a base observer of the chain without TSS, database and telemetry
+func newDebugObserver(
+	chain chains.Chain,
+	coreContext *clientcontext.ZetacoreContext,
+	client interfaces.ZetacoreClient,
+) (*base.Observer, error) {
+	return base.NewObserver(
+		chain,
+		observertypes.ChainParams{},
+		coreContext,
+		client,
+		nil,
+		base.DefaultBlockCacheSize,
+		nil,
+		clientcommon.ClientLogger{},
+	)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 	"github.com/zeta-chain/zetacore/zetaclient/testutils"
+	mc "github.com/zeta-chain/zetacore/zetaclient/tss"
 )
 
 var InitCmd = &cobra.Command{
@@ -28,10 +29,12 @@ type initArguments struct {
 	authzHotkey        string
 	level              int8
 	configUpdateTicker uint64
+	telemetryAddress   string
 
 	p2pDiagnostic       bool
 	p2pDiagnosticTicker uint64
 	TssPath             string
+	eddsaPort           int
 	TestTssKeysign      bool
 	KeyringBackend      string
 	HsmMode             bool
@@ -61,7 +64,11 @@ func init() {
 		Uint64Var(&initArgs.p2pDiagnosticTicker, "p2p-diagnostic-ticker", 30, "p2p diagnostic ticker (default: 0 means no ticker)")
 	InitCmd.Flags().
 		Uint64Var(&initArgs.configUpdateTicker, "config-update-ticker", 5, "config update ticker (default: 0 means no ticker)")
+	InitCmd.Flags().
+		StringVar(&initArgs.telemetryAddress, "telemetry-address", "", "listen address of the telemetry server (default: 127.0.0.1:8123)")
 	InitCmd.Flags().StringVar(&initArgs.TssPath, "tss-path", "~/.tss", "path to tss location")
+	InitCmd.Flags().
+		IntVar(&initArgs.eddsaPort, "eddsa-port", mc.DefaultEdDSAPort, "p2p port of the TSS EdDSA ceremonies")
 	InitCmd.Flags().
 		BoolVar(&initArgs.TestTssKeysign, "test-tss", false, "set to to true to run a check for TSS keysign on startup")
 	InitCmd.Flags().
@@ -101,8 +108,10 @@ func Initialize(_ *cobra.Command, _ []string) error {
 	configData.LogSampler = initArgs.logSampler
 	configData.P2PDiagnostic = initArgs.p2pDiagnostic
 	configData.TssPath = initArgs.TssPath
+	configData.EdDSAPort = initArgs.eddsaPort
 	configData.P2PDiagnosticTicker = initArgs.p2pDiagnosticTicker
 	configData.ConfigUpdateTicker = initArgs.configUpdateTicker
+	configData.TelemetryListenAddress = initArgs.telemetryAddress
 	configData.KeyringBackend = config.KeyringBackend(initArgs.KeyringBackend)
 	configData.HsmMode = initArgs.HsmMode
 	configData.HsmHotKey = initArgs.HsmHotKey
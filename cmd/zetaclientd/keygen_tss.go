@@ -22,6 +22,17 @@ import (
 	"github.com/zeta-chain/zetacore/zetaclient/zetacore"
 )
 
+const (
+	// eddsaKeygenInterval is the interval of zeta blocks between two tries of the EdDSA keygen
+	eddsaKeygenInterval = 100
+
+	// eddsaKeygenWindow is the number of zeta blocks after the keygen block a signer can join the EdDSA keygen
+	eddsaKeygenWindow = 5
+
+	// eddsaKeygenTicker is the interval at which the signers check if the EdDSA keygen is needed
+	eddsaKeygenTicker = 5 * time.Second
+)
+
 func GenerateTss(
 	appContext *context.AppContext,
 	logger zerolog.Logger,
@@ -38,9 +49,9 @@ func GenerateTss(
 	// TODO: remove this once we have a better way to determine the signature format
 	// https://github.com/zeta-chain/node/issues/1397
 	bitcoinChainID := chains.BitcoinRegtest.ChainId
-	btcChain, _, btcEnabled := appContext.GetBTCChainAndConfig()
-	if btcEnabled {
-		bitcoinChainID = btcChain.ChainId
+	// the bitcoin chain of lowest chain id is used if several are enabled, i.e. mainnet on mainnet nodes
+	if btcChains := appContext.GetEnabledBTCChains(); len(btcChains) > 0 {
+		bitcoinChainID = btcChains[0].ChainId
 	}
 
 	tss, err := mc.NewTSS(
@@ -159,7 +170,7 @@ func keygenTss(keyGen observertypes.Keygen, tss *mc.TSS, keygenLogger zerolog.Lo
 	keygenLogger.Info().Msgf("Keygen at blocknum %d , TSS signers %s ", keyGen.BlockNumber, keyGen.GranteePubkeys)
 	var req keygen.Request
 	req = keygen.NewRequest(keyGen.GranteePubkeys, keyGen.BlockNumber, "0.14.0")
-	res, err := tss.Server.Keygen(req)
+	res, err := tss.Keygen(req)
 	if res.Status != tsscommon.Success || res.PubKey == "" {
 		keygenLogger.Error().Msgf("keygen fail: reason %s blame nodes %s", res.Blame.FailReason, res.Blame.BlameNodes)
 		// Need to broadcast keygen blame result here
@@ -195,6 +206,53 @@ func keygenTss(keyGen observertypes.Keygen, tss *mc.TSS, keygenLogger zerolog.Lo
 	return nil
 }
 
+// GenerateEdDSAKey generates the EdDSA key of the current TSS signers (e.g. for Solana) if the local party has none
+// it runs apart from the ECDSA keygen, so the signers of an existing TSS get an EdDSA key as well:
+// the signers missing the key try the keygen together at the zeta heights multiple of eddsaKeygenInterval
+func GenerateEdDSAKey(client *zetacore.Client, tss *mc.TSS, logger zerolog.Logger) {
+	if tss.EdDSA == nil {
+		return
+	}
+	keygenLogger := logger.With().Str("module", "keygen_eddsa").Logger()
+
+	ticker := time.NewTicker(eddsaKeygenTicker)
+	defer ticker.Stop()
+	triedKeygenAtBlock := int64(0)
+	for range ticker.C {
+		currentTss, err := client.GetCurrentTss()
+		if err != nil {
+			keygenLogger.Error().Err(err).Msg("GetCurrentTss error")
+			continue
+		}
+		// the messages of the signers are received ahead of the keygen
+		tss.EdDSA.AllowParties(currentTss.TssParticipantList)
+		if tss.EdDSA.HasKeyOf(currentTss.TssParticipantList) {
+			continue
+		}
+
+		currentBlock, err := client.GetBlockHeight()
+		if err != nil {
+			keygenLogger.Error().Err(err).Msg("GetBlockHeight RPC error")
+			continue
+		}
+		// don't join a keygen started too long ago, the other signers gave up on it
+		keygenBlock := currentBlock - currentBlock%eddsaKeygenInterval
+		if keygenBlock == 0 || keygenBlock == triedKeygenAtBlock || currentBlock-keygenBlock > eddsaKeygenWindow {
+			continue
+		}
+		triedKeygenAtBlock = keygenBlock
+
+		keygenLogger.Info().
+			Msgf("EdDSA keygen at block %d, TSS signers %s", keygenBlock, currentTss.TssParticipantList)
+		pubKey, err := tss.EdDSA.Keygen(currentTss.TssParticipantList, keygenBlock)
+		if err != nil {
+			keygenLogger.Error().Err(err).Msgf("EdDSA keygen fail at block %d", keygenBlock)
+			continue
+		}
+		keygenLogger.Info().Msgf("EdDSA keygen success! pubkey %s", hex.EncodeToString(pubKey))
+	}
+}
+
 func SetTSSPubKey(tss *mc.TSS, logger zerolog.Logger) error {
 	err := tss.InsertPubKey(tss.CurrentPubkey)
 	if err != nil {
@@ -88,7 +88,7 @@ func RunDiagnostics(
 	}
 	startLogger.Info().Msgf("host created: ID %s", host.ID().String())
 	if len(peers) == 0 {
-		s = metrics.NewTelemetryServer()
+		s = metrics.NewTelemetryServer(cfg.TelemetryListenAddress)
 		s.SetP2PID(host.ID().String())
 		go func() {
 			startLogger.Info().Msg("Starting TSS HTTP Server...")
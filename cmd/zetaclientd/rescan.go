@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+	"github.com/zeta-chain/zetacore/zetaclient/rescan"
+)
+
+const (
+	// rescanTimeout is the timeout of the rescan job, a rescan of a large block range can take long
+	rescanTimeout = 30 * time.Minute
+
+	// rescanPollInterval is the interval between two polls of the rescan job status
+	rescanPollInterval = 2 * time.Second
+
+	// adminRequestTimeout is the timeout of a request to the admin endpoints
+	adminRequestTimeout = 10 * time.Second
+)
+
+var rescanArgs = rescanArguments{}
+
+type rescanArguments struct {
+	chainID   int64
+	fromBlock uint64
+	toBlock   uint64
+	dryRun    bool
+	adminURL  string
+	maxRange  uint64
+}
+
+var RescanCmd = &cobra.Command{
+	Use:   "rescan",
+	Short: "Re-observe the inbounds of a block range and vote on the inbounds without cctx",
+	Long: `Re-observe the inbounds of the block range [from, to] of a chain with the running zetaclient
+and post the votes of the inbounds that have no cctx yet. With --dry-run, the inbounds are only reported.
+The command starts a rescan job with the admin endpoint of the telemetry server authenticated with the
+AdminAPIToken of the config, and waits for the job to finish.`,
+	RunE: RescanInbound,
+}
+
+func init() {
+	RootCmd.AddCommand(RescanCmd)
+	RescanCmd.Flags().Int64Var(&rescanArgs.chainID, "chain", 0, "chain id of the chain to rescan")
+	RescanCmd.Flags().Uint64Var(&rescanArgs.fromBlock, "from", 0, "first block to rescan")
+	RescanCmd.Flags().Uint64Var(&rescanArgs.toBlock, "to", 0, "last block to rescan")
+	RescanCmd.Flags().BoolVar(&rescanArgs.dryRun, "dry-run", false, "report the inbounds without cctx without voting")
+	RescanCmd.Flags().
+		Uint64Var(&rescanArgs.maxRange, "max-range", metrics.MaxRescanRange, "maximum number of blocks to rescan")
+	RescanCmd.Flags().
+		StringVar(&rescanArgs.adminURL, "admin-url", "http://127.0.0.1:8123", "url of the zetaclient telemetry server")
+	for _, flag := range []string{"chain", "from", "to"} {
+		if err := RescanCmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func RescanInbound(_ *cobra.Command, _ []string) error {
+	if rescanArgs.fromBlock == 0 || rescanArgs.fromBlock > rescanArgs.toBlock {
+		return fmt.Errorf("invalid block range [%d, %d]", rescanArgs.fromBlock, rescanArgs.toBlock)
+	}
+	if rescanArgs.toBlock-rescanArgs.fromBlock >= rescanArgs.maxRange {
+		return fmt.Errorf("block range [%d, %d] exceeds %d blocks, split it into smaller ranges",
+			rescanArgs.fromBlock, rescanArgs.toBlock, rescanArgs.maxRange)
+	}
+	if err := setHomeDir(); err != nil {
+		return err
+	}
+	cfg, err := config.Load(rootArgs.zetaCoreHome)
+	if err != nil {
+		return err
+	}
+	if cfg.AdminAPIToken == "" {
+		return errors.New("AdminAPIToken is not set in the zetaclient config")
+	}
+
+	body, err := json.Marshal(metrics.RescanRequest{
+		ChainID:   rescanArgs.chainID,
+		FromBlock: rescanArgs.fromBlock,
+		ToBlock:   rescanArgs.toBlock,
+		DryRun:    rescanArgs.dryRun,
+	})
+	if err != nil {
+		return err
+	}
+	url := strings.TrimSuffix(rescanArgs.adminURL, "/") + "/admin/rescan"
+	var job metrics.RescanJob
+	if err := adminRequest(http.MethodPost, url, cfg.AdminAPIToken, body, &job); err != nil {
+		return err
+	}
+	fmt.Printf("rescan job %d started\n", job.ID)
+
+	// wait for the rescan job to finish
+	deadline := time.Now().Add(rescanTimeout)
+	for job.Status == metrics.RescanStatusRunning {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("rescan job %d still running after %s", job.ID, rescanTimeout)
+		}
+		time.Sleep(rescanPollInterval)
+		if err := adminRequest(http.MethodGet, fmt.Sprintf("%s/%d", url, job.ID), cfg.AdminAPIToken, nil, &job); err != nil {
+			return err
+		}
+	}
+	if job.Status == metrics.RescanStatusFailed {
+		return fmt.Errorf("rescan job %d failed: %s", job.ID, job.Error)
+	}
+	if job.Report == nil {
+		return fmt.Errorf("rescan job %d finished without report", job.ID)
+	}
+	printRescanReport(job.Report)
+	return nil
+}
+
+// adminRequest sends the request to the admin endpoint and decodes the JSON response into 'res'
+func adminRequest(method, url, token string, body []byte, res interface{}) error {
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := &http.Client{Timeout: adminRequestTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("admin request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return json.Unmarshal(respBody, res)
+}
+
+// printRescanReport prints the inbounds of the rescan report, one per line
+func printRescanReport(report *rescan.Report) {
+	fmt.Printf("chain %d blocks [%d, %d] dry-run %t\n", report.ChainID, report.FromBlock, report.ToBlock, report.DryRun)
+	for _, inbound := range report.Inbounds {
+		status := "missing"
+		switch {
+		case len(inbound.CctxIndexes) > 0:
+			status = "cctx " + strings.Join(inbound.CctxIndexes, ",")
+		case inbound.Voted:
+			status = "voted"
+		}
+		fmt.Printf("block %d inbound %s %s amount %s ballot %s: %s\n",
+			inbound.BlockHeight, inbound.InboundHash, inbound.CoinType, inbound.Amount, inbound.Ballot, status)
+	}
+	fmt.Printf("inbounds %d missing %d voted %d\n", len(report.Inbounds), report.Missing, report.Voted)
+}
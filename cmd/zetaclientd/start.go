@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/cometbft/cometbft/crypto/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/libp2p/go-libp2p/core"
 	maddr "github.com/multiformats/go-multiaddr"
@@ -24,8 +25,10 @@ import (
 	"github.com/zeta-chain/zetacore/pkg/authz"
 	"github.com/zeta-chain/zetacore/pkg/constant"
 	observerTypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/compliance"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 	"github.com/zeta-chain/zetacore/zetaclient/context"
+	"github.com/zeta-chain/zetacore/zetaclient/keys"
 	"github.com/zeta-chain/zetacore/zetaclient/metrics"
 	"github.com/zeta-chain/zetacore/zetaclient/orchestrator"
 )
@@ -67,6 +70,14 @@ func start(_ *cobra.Command, _ []string) error {
 		return err
 	}
 
+	// screen inbounds with the compliance providers, recording the decisions in the compliance log
+	screener, err := compliance.NewScreener(cfg.ComplianceConfig)
+	if err != nil {
+		log.Error().Err(err).Msg("NewScreener failed")
+		return err
+	}
+	compliance.SetScreener(screener, loggers.Compliance)
+
 	//Wait until zetacore has started
 	if len(cfg.Peer) != 0 {
 		err := validatePeer(cfg.Peer)
@@ -83,7 +94,7 @@ func start(_ *cobra.Command, _ []string) error {
 	waitForZetaCore(cfg, startLogger)
 	startLogger.Info().Msgf("Zetacore is ready, trying to connect to %s", cfg.Peer)
 
-	telemetryServer := metrics.NewTelemetryServer()
+	telemetryServer := metrics.NewTelemetryServer(cfg.TelemetryListenAddress)
 	go func() {
 		err := telemetryServer.Start()
 		if err != nil {
@@ -157,9 +168,16 @@ func start(_ *cobra.Command, _ []string) error {
 	// The hotkeyPk is private key for the Hotkey. The Hotkey is used to sign all inbound transactions
 	// Each node processes a portion of the key stored in ~/.tss by default . Custom location can be specified in config file during init.
 	// After generating the key , the address is set on the zetacore
-	hotkeyPk, err := zetacoreClient.GetKeys().GetPrivateKey(hotkeyPass)
+	// the hotkey is the p2p identity of the TSS, the TSS loads it from the keyring even if the transactions are
+	// signed by the remote signer: the hotkey of the remote signer has to be the same key
+	hotkeyPk, err := loadHotkey(cfg, hotkeyPass)
 	if err != nil {
-		startLogger.Error().Err(err).Msg("zetacore client GetPrivateKey error")
+		startLogger.Error().Err(err).Msg("loadHotkey error")
+		return err
+	}
+	if err := keys.ValidateTSSKey(hotkeyPk, zetacoreClient.GetKeys().GetRemoteSigner()); err != nil {
+		startLogger.Error().Err(err).Msg("remote signer error")
+		return err
 	}
 	startLogger.Debug().Msgf("hotkeyPk %s", hotkeyPk.String())
 	if len(hotkeyPk.Bytes()) != 32 {
@@ -244,11 +262,14 @@ func start(_ *cobra.Command, _ []string) error {
 
 	// Defensive check: Make sure the tss address is set to the current TSS address and not the newly generated one
 	tss.CurrentPubkey = currentTss.TssPubkey
-	if tss.EVMAddress() == (ethcommon.Address{}) || tss.BTCAddress() == "" {
+	if tss.EVMAddress() == (ethcommon.Address{}) || tss.BTCAddress(tss.BitcoinChainID) == "" {
 		startLogger.Error().Msg("TSS address is not set in zetacore")
 	}
 	startLogger.Info().
-		Msgf("Current TSS address \n ETH : %s \n BTC : %s \n PubKey : %s ", tss.EVMAddress(), tss.BTCAddress(), tss.CurrentPubkey)
+		Msgf("Current TSS address \n ETH : %s \n BTC : %s \n PubKey : %s ", tss.EVMAddress(), tss.BTCAddress(tss.BitcoinChainID), tss.CurrentPubkey)
+
+	// generate the EdDSA key of the current TSS signers if missing, the Solana signer waits for it
+	go GenerateEdDSAKey(zetacoreClient, tss, masterLogger)
 	if len(appContext.ZetacoreContext().GetEnabledChains()) == 0 {
 		startLogger.Error().Msgf("No chains enabled in updated config %s ", cfg.String())
 	}
@@ -298,13 +319,28 @@ func start(_ *cobra.Command, _ []string) error {
 	}
 
 	// Orchestrator wraps the zetacore client and adds the observers and signer maps to it . This is the high level object used for CCTX interactions
-	orchestrator := orchestrator.NewOrchestrator(zetacoreClient, signerMap, observerMap, masterLogger, telemetryServer)
+	// The chains enabled or reconfigured at runtime are started and stopped by the orchestrator without restart
+	orchestrator := orchestrator.NewOrchestrator(zetacoreClient, signerMap, observerMap, masterLogger, telemetryServer).
+		WithChainBuilder(chainBuilder{
+			zetacoreClient: zetacoreClient,
+			tss:            tss,
+			dbpath:         dbpath,
+			loggers:        loggers,
+			ts:             telemetryServer,
+		}, isNodeActive).
+		WithZetacoreEvents(zetacoreClient.DialEvents).
+		WithKeysignsTracker(tss.KeysignsTracker).
+		WithKeysignBudget(cfg.MaxConcurrentKeysigns, cfg.MaxConcurrentKeysignsPerChain)
 	err = orchestrator.MonitorCore(appContext)
 	if err != nil {
 		startLogger.Error().Err(err).Msg("Orchestrator failed to start")
 		return err
 	}
 
+	// report the status of the orchestrator and serve the admin endpoints, enabled by the admin api token
+	telemetryServer.SetStatusProvider(orchestrator)
+	telemetryServer.SetAdminProvider(orchestrator, cfg.AdminAPIToken)
+
 	// start zeta supply checker
 	// TODO: enable
 	// https://github.com/zeta-chain/node/issues/1354
@@ -326,14 +362,21 @@ func start(_ *cobra.Command, _ []string) error {
 	startLogger.Info().Msgf("stop signal received: %s", sig)
 
 	// stop chain observers
-	for _, observer := range observerMap {
-		observer.Stop()
-	}
+	orchestrator.StopChainObservers()
 	zetacoreClient.Stop()
 
 	return nil
 }
 
+// loadHotkey loads the private key of the hotkey from the keyring, the p2p identity of the TSS
+func loadHotkey(cfg config.Config, hotkeyPass string) (cryptotypes.PrivKey, error) {
+	kb, _, err := keys.GetKeyringKeybase(cfg, hotkeyPass)
+	if err != nil {
+		return nil, err
+	}
+	return keys.NewKeysWithKeybase(kb, nil, cfg.AuthzHotkey, hotkeyPass).GetPrivateKey(hotkeyPass)
+}
+
 func initPeers(peer string) (p2p.AddrList, error) {
 	var peers p2p.AddrList
 
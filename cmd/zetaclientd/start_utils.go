@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/rpcpool"
 )
 
 func waitForZetaCore(config config.Config, logger zerolog.Logger) {
@@ -58,17 +59,19 @@ func validatePeer(seedPeer string) error {
 func maskCfg(cfg config.Config) string {
 	maskedCfg := cfg
 
-	maskedCfg.BitcoinConfig = config.BTCConfig{
-		RPCUsername: cfg.BitcoinConfig.RPCUsername,
-		RPCPassword: cfg.BitcoinConfig.RPCPassword,
-		RPCHost:     cfg.BitcoinConfig.RPCHost,
-		RPCParams:   cfg.BitcoinConfig.RPCParams,
+	maskedCfg.BitcoinConfig = maskBTCConfig(cfg.BitcoinConfig)
+	maskedCfg.BTCChainConfigs = map[int64]config.BTCConfig{}
+	for key, val := range cfg.BTCChainConfigs {
+		maskedCfg.BTCChainConfigs[key] = maskBTCConfig(val)
 	}
 	maskedCfg.EVMChainConfigs = map[int64]config.EVMConfig{}
 	for key, val := range cfg.EVMChainConfigs {
 		maskedCfg.EVMChainConfigs[key] = config.EVMConfig{
-			Chain:    val.Chain,
-			Endpoint: val.Endpoint,
+			Chain:      val.Chain,
+			Endpoint:   val.Endpoint,
+			Endpoints:  maskEndpoints(val.Endpoints),
+			RPCQuorum:  val.RPCQuorum,
+			WSEndpoint: maskEndpoint(val.WSEndpoint),
 		}
 	}
 
@@ -84,8 +87,37 @@ func maskCfg(cfg config.Config) string {
 		chain.Endpoint = endpointURL.Hostname()
 	}
 
-	maskedCfg.BitcoinConfig.RPCUsername = ""
-	maskedCfg.BitcoinConfig.RPCPassword = ""
+	maskedCfg.ComplianceConfig.ScreeningURL = maskEndpoint(cfg.ComplianceConfig.ScreeningURL)
 
 	return maskedCfg.String()
 }
+
+// maskBTCConfig returns the bitcoin config without its credentials
+func maskBTCConfig(btcConfig config.BTCConfig) config.BTCConfig {
+	return config.BTCConfig{
+		RPCHost:   btcConfig.RPCHost,
+		RPCParams: btcConfig.RPCParams,
+		RPCHosts:  maskEndpoints(btcConfig.RPCHosts),
+		RPCQuorum: btcConfig.RPCQuorum,
+	}
+}
+
+// maskEndpoints returns the hosts of the endpoints, hiding their credentials and api keys
+func maskEndpoints(endpoints []string) []string {
+	if len(endpoints) == 0 {
+		return nil
+	}
+	masked := make([]string, len(endpoints))
+	for i, endpoint := range endpoints {
+		masked[i] = rpcpool.EndpointName(endpoint)
+	}
+	return masked
+}
+
+// maskEndpoint returns the host of the optional endpoint, hiding its credentials and api key
+func maskEndpoint(endpoint string) string {
+	if endpoint == "" {
+		return ""
+	}
+	return rpcpool.EndpointName(endpoint)
+}
@@ -1,20 +1,27 @@
 package main
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 
+	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/zetaclient/authz"
 	btcobserver "github.com/zeta-chain/zetacore/zetaclient/chains/bitcoin/observer"
 	btcsigner "github.com/zeta-chain/zetacore/zetaclient/chains/bitcoin/signer"
 	evmobserver "github.com/zeta-chain/zetacore/zetaclient/chains/evm/observer"
 	evmsigner "github.com/zeta-chain/zetacore/zetaclient/chains/evm/signer"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	solobserver "github.com/zeta-chain/zetacore/zetaclient/chains/solana/observer"
+	solsigner "github.com/zeta-chain/zetacore/zetaclient/chains/solana/signer"
 	clientcommon "github.com/zeta-chain/zetacore/zetaclient/common"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 	"github.com/zeta-chain/zetacore/zetaclient/context"
 	"github.com/zeta-chain/zetacore/zetaclient/keys"
 	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+	"github.com/zeta-chain/zetacore/zetaclient/orchestrator"
+	"github.com/zeta-chain/zetacore/zetaclient/remotesigner"
 	"github.com/zeta-chain/zetacore/zetaclient/zetacore"
 )
 
@@ -34,17 +41,26 @@ func CreateZetacoreClient(
 
 	chainIP := cfg.ZetaCoreURL
 
-	kb, _, err := keys.GetKeyringKeybase(cfg, hotkeyPassword)
-	if err != nil {
-		return nil, err
-	}
-
 	granterAddreess, err := sdk.AccAddressFromBech32(cfg.AuthzGranter)
 	if err != nil {
 		return nil, err
 	}
 
-	k := keys.NewKeysWithKeybase(kb, granterAddreess, cfg.AuthzHotkey, hotkeyPassword)
+	// sign the transactions with the hotkey held by the remote signer if enabled, without loading the keyring
+	var k *keys.Keys
+	if cfg.RemoteSigner.Endpoint != "" {
+		signer, err := remotesigner.Dial(cfg.RemoteSigner)
+		if err != nil {
+			return nil, err
+		}
+		k = keys.NewKeysWithRemoteSigner(signer, granterAddreess, cfg.AuthzHotkey)
+	} else {
+		kb, _, err := keys.GetKeyringKeybase(cfg, hotkeyPassword)
+		if err != nil {
+			return nil, err
+		}
+		k = keys.NewKeysWithKeybase(kb, granterAddreess, cfg.AuthzHotkey, hotkeyPassword)
+	}
 
 	client, err := zetacore.NewClient(k, chainIP, hotKey, cfg.ChainID, cfg.HsmMode, telemetry)
 	if err != nil {
@@ -60,7 +76,6 @@ func CreateSignerMap(
 	loggers clientcommon.ClientLogger,
 	ts *metrics.TelemetryServer,
 ) (map[int64]interfaces.ChainSigner, error) {
-	coreContext := appContext.ZetacoreContext()
 	signerMap := make(map[int64]interfaces.ChainSigner)
 
 	// EVM signers
@@ -68,16 +83,61 @@ func CreateSignerMap(
 		if evmConfig.Chain.IsZetaChain() {
 			continue
 		}
-		evmChainParams, found := coreContext.GetEVMChainParams(evmConfig.Chain.ChainId)
-		if !found {
-			loggers.Std.Error().Msgf("ChainParam not found for chain %s", evmConfig.Chain.String())
+		signer, err := CreateSigner(appContext, evmConfig.Chain, tss, loggers, ts)
+		if err != nil {
+			loggers.Std.Error().Err(err).Msgf("NewEVMSigner error for chain %s", evmConfig.Chain.String())
+			continue
+		}
+		signerMap[evmConfig.Chain.ChainId] = signer
+	}
+	// BTC signers
+	for _, btcChain := range appContext.GetEnabledBTCChains() {
+		signer, err := CreateSigner(appContext, btcChain, tss, loggers, ts)
+		if err != nil {
+			loggers.Std.Error().Err(err).Msgf("NewBTCSigner error for chain %s", btcChain.String())
 			continue
 		}
+		signerMap[btcChain.ChainId] = signer
+	}
+	// Solana signer
+	solChain, _, enabled := appContext.GetSolanaChainAndConfig()
+	if enabled {
+		signer, err := CreateSigner(appContext, solChain, tss, loggers, ts)
+		if err != nil {
+			loggers.Std.Error().Err(err).Msgf("NewSolanaSigner error for chain %s", solChain.String())
+		} else {
+			signerMap[solChain.ChainId] = signer
+		}
+	}
+
+	return signerMap, nil
+}
+
+// CreateSigner creates the signer of an external chain configured in the config
+func CreateSigner(
+	appContext *context.AppContext,
+	chain chains.Chain,
+	tss interfaces.TSSSigner,
+	loggers clientcommon.ClientLogger,
+	ts *metrics.TelemetryServer,
+) (interfaces.ChainSigner, error) {
+	coreContext := appContext.ZetacoreContext()
+
+	switch {
+	case chain.IsEVMChain():
+		evmConfig, found := appContext.Config().GetEVMConfig(chain.ChainId)
+		if !found {
+			return nil, fmt.Errorf("config not found for chain %s", chain.String())
+		}
+		evmChainParams, found := coreContext.GetEVMChainParams(chain.ChainId)
+		if !found {
+			return nil, fmt.Errorf("ChainParam not found for chain %s", chain.String())
+		}
 		mpiAddress := ethcommon.HexToAddress(evmChainParams.ConnectorContractAddress)
 		erc20CustodyAddress := ethcommon.HexToAddress(evmChainParams.Erc20CustodyContractAddress)
-		signer, err := evmsigner.NewSigner(
+		return evmsigner.NewSigner(
 			evmConfig.Chain,
-			evmConfig.Endpoint,
+			evmConfig.GetEndpoints(),
 			tss,
 			config.GetConnectorABI(),
 			config.GetERC20CustodyABI(),
@@ -86,24 +146,25 @@ func CreateSignerMap(
 			coreContext,
 			loggers,
 			ts)
-		if err != nil {
-			loggers.Std.Error().Err(err).Msgf("NewEVMSigner error for chain %s", evmConfig.Chain.String())
-			continue
+	case chain.IsBitcoinChain():
+		btcChain, btcConfig, enabled := appContext.GetBTCChainAndConfig(chain.ChainId)
+		if !enabled {
+			return nil, fmt.Errorf("bitcoin chain %s is not enabled", chain.String())
 		}
-		signerMap[evmConfig.Chain.ChainId] = signer
-	}
-	// BTC signer
-	btcChain, btcConfig, enabled := appContext.GetBTCChainAndConfig()
-	if enabled {
-		signer, err := btcsigner.NewSigner(btcConfig, tss, loggers, ts, coreContext)
-		if err != nil {
-			loggers.Std.Error().Err(err).Msgf("NewBTCSigner error for chain %s", btcChain.String())
-		} else {
-			signerMap[btcChain.ChainId] = signer
+		return btcsigner.NewSigner(btcChain, btcConfig, tss, loggers, ts, coreContext)
+	case chain.IsSolanaChain():
+		_, solConfig, enabled := appContext.GetSolanaChainAndConfig()
+		if !enabled {
+			return nil, fmt.Errorf("solana chain %s is not enabled", chain.String())
+		}
+		tssEdDSA, ok := tss.(interfaces.TSSSignerEdDSA)
+		if !ok {
+			return nil, fmt.Errorf("TSS doesn't support EdDSA")
 		}
+		return solsigner.NewSigner(solConfig, tssEdDSA, loggers, ts, coreContext), nil
+	default:
+		return nil, fmt.Errorf("unsupported chain %s", chain.String())
 	}
-
-	return signerMap, nil
 }
 
 // CreateChainObserverMap creates a map of ChainObservers for all chains in the config
@@ -121,29 +182,94 @@ func CreateChainObserverMap(
 		if evmConfig.Chain.IsZetaChain() {
 			continue
 		}
-		_, found := appContext.ZetacoreContext().GetEVMChainParams(evmConfig.Chain.ChainId)
-		if !found {
-			loggers.Std.Error().Msgf("ChainParam not found for chain %s", evmConfig.Chain.String())
-			continue
-		}
-		co, err := evmobserver.NewObserver(appContext, zetacoreClient, tss, dbpath, loggers, evmConfig, ts)
+		co, err := CreateChainObserver(appContext, evmConfig.Chain, zetacoreClient, tss, dbpath, loggers, ts)
 		if err != nil {
 			loggers.Std.Error().Err(err).Msgf("NewObserver error for evm chain %s", evmConfig.Chain.String())
 			continue
 		}
 		observerMap[evmConfig.Chain.ChainId] = co
 	}
-	// BTC observer
-	btcChain, btcConfig, enabled := appContext.GetBTCChainAndConfig()
-	if enabled {
-		co, err := btcobserver.NewObserver(appContext, btcChain, zetacoreClient, tss, dbpath, loggers, btcConfig, ts)
+	// BTC observers
+	for _, btcChain := range appContext.GetEnabledBTCChains() {
+		co, err := CreateChainObserver(appContext, btcChain, zetacoreClient, tss, dbpath, loggers, ts)
 		if err != nil {
 			loggers.Std.Error().Err(err).Msgf("NewObserver error for bitcoin chain %s", btcChain.String())
-
+			continue
+		}
+		observerMap[btcChain.ChainId] = co
+	}
+	// Solana observer
+	solChain, _, enabled := appContext.GetSolanaChainAndConfig()
+	if enabled {
+		co, err := CreateChainObserver(appContext, solChain, zetacoreClient, tss, dbpath, loggers, ts)
+		if err != nil {
+			loggers.Std.Error().Err(err).Msgf("NewObserver error for solana chain %s", solChain.String())
 		} else {
-			observerMap[btcChain.ChainId] = co
+			observerMap[solChain.ChainId] = co
 		}
 	}
 
 	return observerMap, nil
 }
+
+// CreateChainObserver creates the observer of an external chain configured in the config
+// the observer database is initialized and the last scanned block is loaded
+func CreateChainObserver(
+	appContext *context.AppContext,
+	chain chains.Chain,
+	zetacoreClient *zetacore.Client,
+	tss interfaces.TSSSigner,
+	dbpath string,
+	loggers clientcommon.ClientLogger,
+	ts *metrics.TelemetryServer,
+) (interfaces.ChainObserver, error) {
+	switch {
+	case chain.IsEVMChain():
+		evmConfig, found := appContext.Config().GetEVMConfig(chain.ChainId)
+		if !found {
+			return nil, fmt.Errorf("config not found for chain %s", chain.String())
+		}
+		if _, found := appContext.ZetacoreContext().GetEVMChainParams(chain.ChainId); !found {
+			return nil, fmt.Errorf("ChainParam not found for chain %s", chain.String())
+		}
+		return evmobserver.NewObserver(appContext, zetacoreClient, tss, dbpath, loggers, evmConfig, ts)
+	case chain.IsBitcoinChain():
+		btcChain, btcConfig, enabled := appContext.GetBTCChainAndConfig(chain.ChainId)
+		if !enabled {
+			return nil, fmt.Errorf("bitcoin chain %s is not enabled", chain.String())
+		}
+		return btcobserver.NewObserver(appContext, btcChain, zetacoreClient, tss, dbpath, loggers, btcConfig, ts)
+	case chain.IsSolanaChain():
+		solChain, solConfig, enabled := appContext.GetSolanaChainAndConfig()
+		if !enabled {
+			return nil, fmt.Errorf("solana chain %s is not enabled", chain.String())
+		}
+		return solobserver.NewObserver(appContext, solChain, zetacoreClient, dbpath, loggers, solConfig, ts)
+	default:
+		return nil, fmt.Errorf("unsupported chain %s", chain.String())
+	}
+}
+
+// chainBuilder builds the observers and signers of the chains enabled at runtime
+type chainBuilder struct {
+	zetacoreClient *zetacore.Client
+	tss            interfaces.TSSSigner
+	dbpath         string
+	loggers        clientcommon.ClientLogger
+	ts             *metrics.TelemetryServer
+}
+
+var _ orchestrator.ChainBuilder = chainBuilder{}
+
+// NewObserver creates the observer of the chain
+func (b chainBuilder) NewObserver(
+	appContext *context.AppContext,
+	chain chains.Chain,
+) (interfaces.ChainObserver, error) {
+	return CreateChainObserver(appContext, chain, b.zetacoreClient, b.tss, b.dbpath, b.loggers, b.ts)
+}
+
+// NewSigner creates the signer of the chain
+func (b chainBuilder) NewSigner(appContext *context.AppContext, chain chains.Chain) (interfaces.ChainSigner, error) {
+	return CreateSigner(appContext, chain, b.tss, b.loggers, b.ts)
+}
@@ -56,6 +56,7 @@ const (
 	 Test transfer of Bitcoin asset across chains
 	*/
 	TestBitcoinDepositName                = "bitcoin_deposit"
+	TestBitcoinDepositInscriptionName     = "bitcoin_deposit_inscription"
 	TestBitcoinWithdrawSegWitName         = "bitcoin_withdraw_segwit"
 	TestBitcoinWithdrawTaprootName        = "bitcoin_withdraw_taproot"
 	TestBitcoinWithdrawLegacyName         = "bitcoin_withdraw_legacy"
@@ -326,6 +327,15 @@ var AllE2ETests = []runner.E2ETest{
 		},
 		TestBitcoinDeposit,
 	),
+	runner.NewE2ETest(
+		TestBitcoinDepositInscriptionName,
+		"deposit Bitcoin into ZEVM with a large memo inscribed in a taproot witness",
+		[]runner.ArgDefinition{
+			{Description: "amount in btc", DefaultValue: "0.001"},
+			{Description: "payload size in bytes", DefaultValue: "1000"},
+		},
+		TestBitcoinDepositInscription,
+	),
 	runner.NewE2ETest(
 		TestBitcoinWithdrawSegWitName,
 		"withdraw BTC from ZEVM to a SegWit address",
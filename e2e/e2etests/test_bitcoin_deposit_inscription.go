@@ -0,0 +1,49 @@
+package e2etests
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/zeta-chain/zetacore/e2e/runner"
+	"github.com/zeta-chain/zetacore/e2e/utils"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	zetabitcoin "github.com/zeta-chain/zetacore/zetaclient/chains/bitcoin"
+)
+
+func TestBitcoinDepositInscription(r *runner.E2ERunner, args []string) {
+	if len(args) != 2 {
+		panic("TestBitcoinDepositInscription requires exactly two arguments for the amount and the payload size.")
+	}
+
+	depositAmount, err := strconv.ParseFloat(args[0], 64)
+	if err != nil {
+		panic("Invalid deposit amount specified for TestBitcoinDepositInscription.")
+	}
+	payloadSize, err := strconv.Atoi(args[1])
+	if err != nil {
+		panic("Invalid payload size specified for TestBitcoinDepositInscription.")
+	}
+
+	r.SetBtcAddress(r.Name, false)
+
+	// the payload is too large for an OP_RETURN output, the context app accepts any message
+	payload := bytes.Repeat([]byte{0x01}, payloadSize)
+	memo := zetabitcoin.EncodeInboundMemo(zetabitcoin.MemoOpDepositAndCall, r.ContextAppAddr, nil, payload)
+	txHash := r.DepositBTCWithInscription(depositAmount, memo)
+
+	// wait for the cctx to be mined
+	cctx := utils.WaitCctxMinedByInboundHash(r.Ctx, txHash.String(), r.CctxClient, r.Logger, r.CctxTimeout)
+	r.Logger.CCTX(*cctx, "deposit")
+	if cctx.CctxStatus.Status != crosschaintypes.CctxStatus_OutboundMined {
+		panic(fmt.Sprintf(
+			"expected mined status; got %s, message: %s",
+			cctx.CctxStatus.Status.String(),
+			cctx.CctxStatus.StatusMessage),
+		)
+	}
+	if cctx.RelayedMessage != hex.EncodeToString(append(r.ContextAppAddr.Bytes(), payload...)) {
+		panic(fmt.Sprintf("relayed message mismatch: %s", cctx.RelayedMessage))
+	}
+}
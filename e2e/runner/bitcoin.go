@@ -335,6 +335,7 @@ func (runner *E2ERunner) SendToTSSFromDeployerWithMemo(
 		log.Logger,
 		runner.BitcoinParams,
 		depositorFee,
+		0,
 	)
 	if err != nil {
 		panic(err)
@@ -0,0 +1,351 @@
+package runner
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/rs/zerolog/log"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	zetabitcoin "github.com/zeta-chain/zetacore/zetaclient/chains/bitcoin"
+	btcobserver "github.com/zeta-chain/zetacore/zetaclient/chains/bitcoin/observer"
+)
+
+// revealFeeSats is the fee paid by the reveal tx of an inscription deposit
+const revealFeeSats = btcutil.Amount(0.0001 * btcutil.SatoshiPerBitcoin)
+
+// inscription is the taproot commitment of a memo inscribed in a tapscript leaf
+type inscription struct {
+	// privKey is the deployer key signing the leaf script
+	privKey *btcec.PrivateKey
+
+	// leafScript is the tapscript envelope containing the memo
+	leafScript []byte
+
+	// leafHash is the BIP341 tapleaf hash of the leaf script
+	leafHash *chainhash.Hash
+
+	// controlBlock is the control block revealing the leaf script
+	controlBlock []byte
+
+	// pkScript is the P2TR script of the commit address
+	pkScript []byte
+
+	// address is the P2TR commit address
+	address *chains.AddressTaproot
+}
+
+// DepositBTCWithInscription deposits BTC to the TSS address with the memo inscribed in a taproot witness
+// the commit tx funds a taproot address committing to the memo, the reveal tx spends it to the TSS address
+func (runner *E2ERunner) DepositBTCWithInscription(amount float64, memo []byte) *chainhash.Hash {
+	runner.Logger.Print("⏳ depositing BTC into ZEVM with inscribed memo")
+
+	commitment, err := runner.newInscription(memo)
+	if err != nil {
+		panic(err)
+	}
+	runner.Logger.Info("inscription commit address: %s", commitment.address.EncodeAddress())
+
+	// commit tx
+	utxos, err := runner.ListDeployerUTXOs(1.0)
+	if err != nil {
+		panic(err)
+	}
+	amount = amount + zetabitcoin.DefaultDepositorFee
+	revealAmount := btcutil.Amount(amount*btcutil.SatoshiPerBitcoin) + revealFeeSats
+	commitHash, commitVout := runner.sendInscriptionCommit(commitment, revealAmount, utxos)
+	runner.Logger.Info("inscription commit txid: %s", commitHash.String())
+
+	// reveal tx
+	revealTx, err := runner.buildInscriptionReveal(commitment, commitHash, commitVout, revealAmount)
+	if err != nil {
+		panic(err)
+	}
+	txid, err := runner.BtcRPCClient.SendRawTransaction(revealTx, true)
+	if err != nil {
+		panic(err)
+	}
+	runner.Logger.Info("inscription reveal txid: %s", txid.String())
+	_, err = runner.BtcRPCClient.GenerateToAddress(6, runner.BTCDeployerAddress, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	rawtx, err := runner.BtcRPCClient.GetRawTransactionVerbose(txid)
+	if err != nil {
+		panic(err)
+	}
+	event, err := btcobserver.GetBtcEvent(
+		runner.BtcRPCClient,
+		*rawtx,
+		runner.BTCTSSAddress.EncodeAddress(),
+		0,
+		log.Logger,
+		runner.BitcoinParams,
+		zetabitcoin.DefaultDepositorFee,
+		0,
+	)
+	if err != nil {
+		panic(err)
+	}
+	if event == nil || !bytes.Equal(event.MemoBytes, memo) {
+		panic(fmt.Sprintf("inscribed memo not observed in reveal tx %s", txid.String()))
+	}
+	runner.Logger.Info("bitcoin inbound event:")
+	runner.Logger.Info("  From: %s", event.FromAddress)
+	runner.Logger.Info("  Amount: %f", event.Value)
+	runner.Logger.Info("  Memo length: %d", len(event.MemoBytes))
+
+	return txid
+}
+
+// newInscription creates the taproot commitment of the memo, the internal key is the deployer key
+func (runner *E2ERunner) newInscription(memo []byte) (*inscription, error) {
+	skBytes, err := hex.DecodeString(runner.DeployerPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	privKey, _ := btcec.PrivKeyFromBytes(skBytes)
+	_, internalPoint := evenKeyPair(privKey)
+	internalKey := internalPoint.X.Bytes()[:]
+
+	leafScript, err := zetabitcoin.EncodeInscriptionScript(internalKey, memo)
+	if err != nil {
+		return nil, err
+	}
+
+	// tapleaf hash = tagged_hash("TapLeaf", leaf version || compact size || script)
+	var leaf bytes.Buffer
+	leaf.WriteByte(zetabitcoin.BaseLeafVersion)
+	if err := wire.WriteVarBytes(&leaf, 0, leafScript); err != nil {
+		return nil, err
+	}
+	leafHash := chainhash.TaggedHash([]byte("TapLeaf"), leaf.Bytes())
+
+	// output key Q = P + tagged_hash("TapTweak", P || leaf hash) * G, with P the even-y internal key
+	tweakHash := chainhash.TaggedHash([]byte("TapTweak"), internalKey, leafHash[:])
+	var tweak btcec.ModNScalar
+	if overflow := tweak.SetByteSlice(tweakHash[:]); overflow {
+		return nil, fmt.Errorf("taproot tweak overflows the curve order")
+	}
+	var t, q btcec.JacobianPoint
+	btcec.ScalarBaseMultNonConst(&tweak, &t)
+	btcec.AddNonConst(&internalPoint, &t, &q)
+	q.ToAffine()
+	outputKey := q.X.Bytes()[:]
+
+	address, err := chains.NewAddressTaproot(outputKey, runner.BitcoinParams)
+	if err != nil {
+		return nil, err
+	}
+
+	// control block [ leaf version | output key parity, internal key ]
+	controlBlock := []byte{zetabitcoin.BaseLeafVersion}
+	if q.Y.IsOdd() {
+		controlBlock[0] |= 0x01
+	}
+	controlBlock = append(controlBlock, internalKey...)
+
+	return &inscription{
+		privKey:      privKey,
+		leafScript:   leafScript,
+		leafHash:     leafHash,
+		controlBlock: controlBlock,
+		pkScript:     append([]byte{txscript.OP_1, txscript.OP_DATA_32}, outputKey...),
+		address:      address,
+	}, nil
+}
+
+// sendInscriptionCommit funds the commit address from the deployer UTXOs
+// returns the hash of the commit tx and the index of the commit output
+func (runner *E2ERunner) sendInscriptionCommit(
+	commitment *inscription,
+	amount btcutil.Amount,
+	inputUTXOs []btcjson.ListUnspentResult,
+) (*chainhash.Hash, uint32) {
+	inputs := make([]btcjson.TransactionInput, len(inputUTXOs))
+	inputsForSign := make([]btcjson.RawTxWitnessInput, len(inputUTXOs))
+	inputSats := btcutil.Amount(0)
+	for i, utxo := range inputUTXOs {
+		inputs[i] = btcjson.TransactionInput{Txid: utxo.TxID, Vout: utxo.Vout}
+		utxoAmount := utxo.Amount
+		inputsForSign[i] = btcjson.RawTxWitnessInput{
+			Txid:         utxo.TxID,
+			Vout:         utxo.Vout,
+			Amount:       &utxoAmount,
+			ScriptPubKey: utxo.ScriptPubKey,
+		}
+		inputSats += btcutil.Amount(utxo.Amount * btcutil.SatoshiPerBitcoin)
+	}
+
+	feeSats := btcutil.Amount(0.0001 * btcutil.SatoshiPerBitcoin)
+	change := inputSats - feeSats - amount
+	if change < 0 {
+		panic(fmt.Errorf("not enough input amount in sats; wanted %d, got %d", amount+feeSats, inputSats))
+	}
+	amountMap := map[btcutil.Address]btcutil.Amount{
+		commitment.address:        amount,
+		runner.BTCDeployerAddress: change,
+	}
+
+	tx, err := runner.BtcRPCClient.CreateRawTransaction(inputs, amountMap, nil)
+	if err != nil {
+		panic(err)
+	}
+	stx, signed, err := runner.BtcRPCClient.SignRawTransactionWithWallet2(tx, inputsForSign)
+	if err != nil {
+		panic(err)
+	}
+	if !signed {
+		panic("btc transaction not signed")
+	}
+	txid, err := runner.BtcRPCClient.SendRawTransaction(stx, true)
+	if err != nil {
+		panic(err)
+	}
+
+	for i, txOut := range stx.TxOut {
+		if bytes.Equal(txOut.PkScript, commitment.pkScript) {
+			return txid, uint32(i)
+		}
+	}
+	panic(fmt.Sprintf("commit output not found in tx %s", txid.String()))
+}
+
+// buildInscriptionReveal builds the reveal tx spending the commit output to the TSS address
+// the leaf script is signed with SIGHASH_DEFAULT and revealed in the witness [ signature, script, control block ]
+func (runner *E2ERunner) buildInscriptionReveal(
+	commitment *inscription,
+	commitHash *chainhash.Hash,
+	commitVout uint32,
+	commitAmount btcutil.Amount,
+) (*wire.MsgTx, error) {
+	tssScript, err := zetabitcoin.PayToAddrScript(runner.BTCTSSAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(commitHash, commitVout), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(int64(commitAmount-revealFeeSats), tssScript))
+
+	sigHash, err := tapscriptSigHash(tx, commitment, int64(commitAmount))
+	if err != nil {
+		return nil, err
+	}
+	sig, err := signSchnorr(commitment.privKey, sigHash[:])
+	if err != nil {
+		return nil, err
+	}
+	tx.TxIn[0].Witness = wire.TxWitness{sig, commitment.leafScript, commitment.controlBlock}
+	return tx, nil
+}
+
+// tapscriptSigHash computes the BIP341 signature hash of the single input of the reveal tx
+// spending the leaf script of the commitment with SIGHASH_DEFAULT
+func tapscriptSigHash(tx *wire.MsgTx, commitment *inscription, prevAmount int64) (*chainhash.Hash, error) {
+	if len(tx.TxIn) != 1 {
+		return nil, fmt.Errorf("expected 1 input, got %d", len(tx.TxIn))
+	}
+	txIn := tx.TxIn[0]
+
+	// single SHA256 of the prevouts, amounts, scriptPubKeys, sequences and outputs
+	var prevouts, amounts, scriptPubKeys, sequences, outputs bytes.Buffer
+	prevouts.Write(txIn.PreviousOutPoint.Hash[:])
+	if err := binary.Write(&prevouts, binary.LittleEndian, txIn.PreviousOutPoint.Index); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(&amounts, binary.LittleEndian, prevAmount); err != nil {
+		return nil, err
+	}
+	if err := wire.WriteVarBytes(&scriptPubKeys, 0, commitment.pkScript); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(&sequences, binary.LittleEndian, txIn.Sequence); err != nil {
+		return nil, err
+	}
+	for _, txOut := range tx.TxOut {
+		if err := wire.WriteTxOut(&outputs, 0, 0, txOut); err != nil {
+			return nil, err
+		}
+	}
+
+	var msg bytes.Buffer
+	msg.WriteByte(0x00) // epoch
+	msg.WriteByte(0x00) // SIGHASH_DEFAULT
+	fields := []interface{}{tx.Version, tx.LockTime}
+	for _, field := range fields {
+		if err := binary.Write(&msg, binary.LittleEndian, field); err != nil {
+			return nil, err
+		}
+	}
+	for _, data := range []*bytes.Buffer{&prevouts, &amounts, &scriptPubKeys, &sequences, &outputs} {
+		hash := sha256.Sum256(data.Bytes())
+		msg.Write(hash[:])
+	}
+	msg.WriteByte(0x02)                       // spend type: script path, no annex
+	msg.Write([]byte{0x00, 0x00, 0x00, 0x00}) // input index
+	msg.Write(commitment.leafHash[:])
+	msg.WriteByte(0x00)                       // key version
+	msg.Write([]byte{0xff, 0xff, 0xff, 0xff}) // no OP_CODESEPARATOR executed
+
+	return chainhash.TaggedHash([]byte("TapSighash"), msg.Bytes()), nil
+}
+
+// evenKeyPair returns the secret scalar and the public point of the private key, the scalar is negated
+// if needed so that the public point has an even y as BIP340 x-only public keys
+func evenKeyPair(privKey *btcec.PrivateKey) (btcec.ModNScalar, btcec.JacobianPoint) {
+	d := privKey.Key
+	var p btcec.JacobianPoint
+	btcec.ScalarBaseMultNonConst(&d, &p)
+	p.ToAffine()
+	if p.Y.IsOdd() {
+		d.Negate()
+		p.Y.Negate(1).Normalize()
+	}
+	return d, p
+}
+
+// signSchnorr signs the 32-byte hash with the BIP340 schnorr signature scheme and no auxiliary randomness
+func signSchnorr(privKey *btcec.PrivateKey, hash []byte) ([]byte, error) {
+	d, p := evenKeyPair(privKey)
+	publicKey := p.X.Bytes()
+	secretKey := d.Bytes()
+
+	// nonce k = tagged_hash("BIP0340/nonce", d xor tagged_hash("BIP0340/aux", 0^32) || P || m)
+	aux := chainhash.TaggedHash([]byte("BIP0340/aux"), make([]byte, 32))
+	masked := make([]byte, 32)
+	for i := range masked {
+		masked[i] = secretKey[i] ^ aux[i]
+	}
+	nonceHash := chainhash.TaggedHash([]byte("BIP0340/nonce"), masked, publicKey[:], hash)
+	var k btcec.ModNScalar
+	k.SetByteSlice(nonceHash[:])
+	if k.IsZero() {
+		return nil, fmt.Errorf("invalid schnorr nonce")
+	}
+	var r btcec.JacobianPoint
+	btcec.ScalarBaseMultNonConst(&k, &r)
+	r.ToAffine()
+	if r.Y.IsOdd() {
+		k.Negate()
+	}
+	nonceKey := r.X.Bytes()
+
+	// s = k + tagged_hash("BIP0340/challenge", R || P || m) * d
+	challenge := chainhash.TaggedHash([]byte("BIP0340/challenge"), nonceKey[:], publicKey[:], hash)
+	var e btcec.ModNScalar
+	e.SetByteSlice(challenge[:])
+	s := e.Mul(&d).Add(&k).Bytes()
+
+	return append(nonceKey[:], s[:]...), nil
+}
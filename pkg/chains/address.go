@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
 	eth "github.com/ethereum/go-ethereum/common"
 )
 
@@ -19,6 +20,9 @@ var (
 
 const ETHAddressLen = 42
 
+// SolanaAddressLen is the length in bytes of a Solana address (an ed25519 public key)
+const SolanaAddressLen = 32
+
 // NewAddress create a new Address. Supports Ethereum, BSC, Polygon
 func NewAddress(address string) Address {
 	// Check is eth address
@@ -104,3 +108,12 @@ func IsBtcAddressSupported(addr btcutil.Address) bool {
 	}
 	return false
 }
+
+// DecodeSolanaAddress decodes a base58 encoded Solana address into its 32-byte public key
+func DecodeSolanaAddress(inputAddress string) ([]byte, error) {
+	pubKey := base58.Decode(inputAddress)
+	if len(pubKey) != SolanaAddressLen {
+		return nil, fmt.Errorf("invalid solana address %s", inputAddress)
+	}
+	return pubKey, nil
+}
@@ -340,6 +340,21 @@ func Test_IsBtcAddressSupported_P2PKH(t *testing.T) {
 	}
 }
 
+func TestDecodeSolanaAddress(t *testing.T) {
+	t.Run("should decode valid solana address", func(t *testing.T) {
+		pubKey, err := DecodeSolanaAddress("11111111111111111111111111111111")
+		require.NoError(t, err)
+		require.Equal(t, make([]byte, SolanaAddressLen), pubKey)
+	})
+	t.Run("should fail on invalid solana address", func(t *testing.T) {
+		_, err := DecodeSolanaAddress("0x2d4c3f0d8c9a1a1e9d5ab4f4a5e4f0a6d8e8b0e3")
+		require.Error(t, err)
+
+		_, err = DecodeSolanaAddress("3yMGLs3vFF1Pv1SU")
+		require.Error(t, err)
+	})
+}
+
 func TestConvertRecoverToError(t *testing.T) {
 	t.Run("recover with string", func(t *testing.T) {
 		err := ConvertRecoverToError("error occurred")
@@ -4,14 +4,40 @@ import (
 	"fmt"
 
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 )
 
 var (
-	BitcoinMainnetParams = &chaincfg.MainNetParams
-	BitcoinRegnetParams  = &chaincfg.RegressionNetParams
-	BitcoinTestnetParams = &chaincfg.TestNet3Params
+	BitcoinMainnetParams  = &chaincfg.MainNetParams
+	BitcoinRegnetParams   = &chaincfg.RegressionNetParams
+	BitcoinTestnetParams  = &chaincfg.TestNet3Params
+	BitcoinSignetParams   = &chaincfg.SigNetParams
+	BitcoinTestnet4Params = newBitcoinTestnet4Params()
 )
 
+// newBitcoinTestnet4Params returns the net params of bitcoin testnet4 (BIP94), not defined by btcd
+// testnet4 shares the address encoding of testnet3, only the network identity differs
+func newBitcoinTestnet4Params() *chaincfg.Params {
+	genesisHash, err := chainhash.NewHashFromStr("00000000da84f2bafbbc53dee25a72ae507ff4914b867c565be350b0da8bf043")
+	if err != nil {
+		panic(err)
+	}
+
+	params := chaincfg.TestNet3Params
+	params.Name = "testnet4"
+	params.Net = wire.BitcoinNet(0x283f161c)
+	params.DefaultPort = "48333"
+	params.DNSSeeds = []chaincfg.DNSSeed{
+		{Host: "seed.testnet4.bitcoin.sprovoost.nl", HasFiltering: true},
+		{Host: "seed.testnet4.wiz.biz", HasFiltering: true},
+	}
+	params.GenesisBlock = nil
+	params.GenesisHash = genesisHash
+	params.Checkpoints = nil
+	return &params
+}
+
 // BitcoinNetParamsFromChainID returns the bitcoin net params to be used from the chain id
 func BitcoinNetParamsFromChainID(chainID int64) (*chaincfg.Params, error) {
 	switch chainID {
@@ -21,6 +47,10 @@ func BitcoinNetParamsFromChainID(chainID int64) (*chaincfg.Params, error) {
 		return BitcoinMainnetParams, nil
 	case BitcoinTestnet.ChainId:
 		return BitcoinTestnetParams, nil
+	case BitcoinSignetTestnet.ChainId:
+		return BitcoinSignetParams, nil
+	case BitcoinTestnet4.ChainId:
+		return BitcoinTestnet4Params, nil
 	default:
 		return nil, fmt.Errorf("no Bitcoin net params for chain ID: %d", chainID)
 	}
@@ -35,6 +65,10 @@ func BitcoinChainIDFromNetworkName(name string) (int64, error) {
 		return BitcoinMainnet.ChainId, nil
 	case BitcoinTestnetParams.Name:
 		return BitcoinTestnet.ChainId, nil
+	case BitcoinSignetParams.Name:
+		return BitcoinSignetTestnet.ChainId, nil
+	case BitcoinTestnet4Params.Name:
+		return BitcoinTestnet4.ChainId, nil
 	default:
 		return 0, fmt.Errorf("invalid Bitcoin network name: %s", name)
 	}
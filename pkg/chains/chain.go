@@ -6,6 +6,7 @@ import (
 
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
@@ -41,6 +42,10 @@ func (chain Chain) Validate() error {
 		return fmt.Errorf("invalid consensus %d", int32(chain.Consensus))
 	}
 
+	if _, ok := CCTXGateway_name[int32(chain.CctxGateway)]; !ok {
+		return fmt.Errorf("invalid cctx gateway %d", int32(chain.CctxGateway))
+	}
+
 	return nil
 }
 
@@ -62,14 +67,22 @@ func (chain Chain) IsExternalChain() bool {
 // EncodeAddress bytes representations of address
 // on EVM chain, it is 20Bytes
 // on Bitcoin chain, it is P2WPKH address, []byte(bech32 encoded string)
+// on Solana chain, it is []byte(base58 encoded string)
+// on IBC chains, it is []byte(bech32 encoded string)
 func (chain Chain) EncodeAddress(b []byte) (string, error) {
-	if IsEVMChain(chain.ChainId) {
+	if chain.IsIBCChain() {
+		addrStr := string(b)
+		if _, _, err := bech32.DecodeAndConvert(addrStr); err != nil {
+			return "", fmt.Errorf("invalid bech32 address %s: %w", addrStr, err)
+		}
+		return addrStr, nil
+	} else if chain.IsEVMChain() {
 		addr := ethcommon.BytesToAddress(b)
 		if addr == (ethcommon.Address{}) {
 			return "", fmt.Errorf("invalid EVM address")
 		}
 		return addr.Hex(), nil
-	} else if IsBitcoinChain(chain.ChainId) {
+	} else if chain.IsBitcoinChain() {
 		addrStr := string(b)
 		chainParams, err := GetBTCChainParams(chain.ChainId)
 		if err != nil {
@@ -83,6 +96,12 @@ func (chain Chain) EncodeAddress(b []byte) (string, error) {
 			return "", fmt.Errorf("address is not for network %s", chainParams.Name)
 		}
 		return addrStr, nil
+	} else if chain.IsSolanaChain() {
+		addrStr := string(b)
+		if _, err := DecodeSolanaAddress(addrStr); err != nil {
+			return "", err
+		}
+		return addrStr, nil
 	}
 	return "", fmt.Errorf("chain (%d) not supported", chain.ChainId)
 }
@@ -101,47 +120,84 @@ func (chain Chain) BTCAddressFromWitnessProgram(witnessProgram []byte) (string,
 
 // DecodeAddress decode the address string to bytes
 func (chain Chain) DecodeAddress(addr string) ([]byte, error) {
-	return DecodeAddressFromChainID(chain.ChainId, addr)
+	if chain.IsIBCChain() {
+		return []byte(addr), nil
+	} else if chain.IsEVMChain() {
+		return ethcommon.HexToAddress(addr).Bytes(), nil
+	} else if chain.IsBitcoinChain() || chain.IsSolanaChain() {
+		return []byte(addr), nil
+	}
+	return nil, fmt.Errorf("chain (%d) not supported", chain.ChainId)
+}
+
+// IsEVMChain returns true if the chain uses the ethereum consensus mechanism for block finality
+func (chain Chain) IsEVMChain() bool {
+	return chain.Consensus == Consensus_ethereum
+}
+
+// IsBitcoinChain returns true if the chain uses the bitcoin consensus mechanism for block finality
+func (chain Chain) IsBitcoinChain() bool {
+	return chain.Consensus == Consensus_bitcoin
+}
+
+// IsSolanaChain returns true if the chain uses the solana consensus mechanism for block finality
+func (chain Chain) IsSolanaChain() bool {
+	return chain.Consensus == Consensus_solana_consensus
+}
+
+// IsIBCChain returns true if the chain is a Cosmos chain reached through IBC
+func (chain Chain) IsIBCChain() bool {
+	return chain.CctxGateway == CCTXGateway_ibc
 }
 
 // DecodeAddressFromChainID decode the address string to bytes
-func DecodeAddressFromChainID(chainID int64, addr string) ([]byte, error) {
-	if IsEVMChain(chainID) {
-		return ethcommon.HexToAddress(addr).Bytes(), nil
-	} else if IsBitcoinChain(chainID) {
-		return []byte(addr), nil
+// additionalChains is the list of chains added to the default chains, usually from the authority chain info
+func DecodeAddressFromChainID(chainID int64, addr string, additionalChains []Chain) ([]byte, error) {
+	chain := GetChainFromChainID(chainID, additionalChains)
+	if chain == nil {
+		return nil, fmt.Errorf("chain (%d) not supported", chainID)
 	}
-	return nil, fmt.Errorf("chain (%d) not supported", chainID)
+	return chain.DecodeAddress(addr)
 }
 
 // IsEVMChain returns true if the chain is an EVM chain or uses the ethereum consensus mechanism for block finality
-func IsEVMChain(chainID int64) bool {
-	return ChainIDInChainList(chainID, ChainListByConsensus(Consensus_ethereum))
+func IsEVMChain(chainID int64, additionalChains []Chain) bool {
+	return ChainIDInChainList(chainID, ChainListByConsensus(Consensus_ethereum, additionalChains))
 }
 
 // IsBitcoinChain returns true if the chain is a Bitcoin-based chain or uses the bitcoin consensus mechanism for block finality
-func IsBitcoinChain(chainID int64) bool {
-	return ChainIDInChainList(chainID, ChainListByConsensus(Consensus_bitcoin))
+func IsBitcoinChain(chainID int64, additionalChains []Chain) bool {
+	return ChainIDInChainList(chainID, ChainListByConsensus(Consensus_bitcoin, additionalChains))
+}
+
+// IsSolanaChain returns true if the chain is a Solana chain
+func IsSolanaChain(chainID int64, additionalChains []Chain) bool {
+	return ChainIDInChainList(chainID, ChainListByConsensus(Consensus_solana_consensus, additionalChains))
 }
 
 // IsEthereumChain returns true if the chain is an Ethereum chain
-func IsEthereumChain(chainID int64) bool {
-	return ChainIDInChainList(chainID, ChainListByNetwork(Network_eth))
+func IsEthereumChain(chainID int64, additionalChains []Chain) bool {
+	return ChainIDInChainList(chainID, ChainListByNetwork(Network_eth, additionalChains))
 }
 
 // IsZetaChain returns true if the chain is a Zeta chain
-func IsZetaChain(chainID int64) bool {
-	return ChainIDInChainList(chainID, ChainListByNetwork(Network_zeta))
+func IsZetaChain(chainID int64, additionalChains []Chain) bool {
+	return ChainIDInChainList(chainID, ChainListByNetwork(Network_zeta, additionalChains))
+}
+
+// IsIBCChain returns true if the chain is a Cosmos chain reached through IBC
+func IsIBCChain(chainID int64, additionalChains []Chain) bool {
+	return ChainIDInChainList(chainID, ChainListByGateway(CCTXGateway_ibc, additionalChains))
 }
 
 // IsHeaderSupportedChain returns true if the chain's consensus supports block header-based verification
-func IsHeaderSupportedChain(chainID int64) bool {
-	return ChainIDInChainList(chainID, ChainListForHeaderSupport())
+func IsHeaderSupportedChain(chainID int64, additionalChains []Chain) bool {
+	return ChainIDInChainList(chainID, ChainListForHeaderSupport(additionalChains))
 }
 
 // SupportMerkleProof returns true if the chain supports block header-based verification
 func (chain Chain) SupportMerkleProof() bool {
-	return IsEVMChain(chain.ChainId) || IsBitcoinChain(chain.ChainId)
+	return chain.IsEVMChain() || chain.IsBitcoinChain()
 }
 
 // IsEmpty is to determinate whether the chain is empty
@@ -178,8 +234,10 @@ func (chains Chains) Strings() []string {
 	return str
 }
 
-func GetChainFromChainID(chainID int64) *Chain {
-	chains := DefaultChainsList()
+// GetChainFromChainID returns the chain of the chain ID from the default chains and the additional chains
+// the additional chains take precedence over the default chains of the same chain ID
+func GetChainFromChainID(chainID int64, additionalChains []Chain) *Chain {
+	chains := CombineDefaultChainsList(additionalChains)
 	for _, chain := range chains {
 		if chainID == chain.ChainId {
 			return chain
@@ -194,6 +252,10 @@ func GetBTCChainParams(chainID int64) (*chaincfg.Params, error) {
 		return &chaincfg.RegressionNetParams, nil
 	case 18332:
 		return &chaincfg.TestNet3Params, nil
+	case 18333:
+		return &chaincfg.SigNetParams, nil
+	case 18334:
+		return BitcoinTestnet4Params, nil
 	case 8332:
 		return &chaincfg.MainNetParams, nil
 	default:
@@ -207,6 +269,10 @@ func GetBTCChainIDFromChainParams(params *chaincfg.Params) (int64, error) {
 		return 18444, nil
 	case chaincfg.TestNet3Params.Name:
 		return 18332, nil
+	case chaincfg.SigNetParams.Name:
+		return 18333, nil
+	case BitcoinTestnet4Params.Name:
+		return 18334, nil
 	case chaincfg.MainNetParams.Name:
 		return 8332, nil
 	default:
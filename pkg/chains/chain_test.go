@@ -11,6 +11,18 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// ibcChain is a Cosmos chain reached through IBC
+var ibcChain = Chain{
+	ChainId:     4200,
+	ChainName:   ChainName_empty,
+	Network:     Network_cosmos,
+	NetworkType: NetworkType_testnet,
+	Vm:          Vm_no_vm,
+	Consensus:   Consensus_tendermint,
+	IsExternal:  true,
+	CctxGateway: CCTXGateway_ibc,
+}
+
 func TestChain_Validate(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -59,7 +71,7 @@ func TestChain_Validate(t *testing.T) {
 			name: "should error if chain name invalid",
 			chain: Chain{
 				ChainId:     42,
-				ChainName:   ChainName_base_sepolia + 1,
+				ChainName:   ChainName_btc_testnet4 + 1,
 				Network:     Network_optimism,
 				NetworkType: NetworkType_testnet,
 				Vm:          Vm_evm,
@@ -73,7 +85,7 @@ func TestChain_Validate(t *testing.T) {
 			chain: Chain{
 				ChainId:     42,
 				ChainName:   ChainName_empty,
-				Network:     Network_base + 1,
+				Network:     Network_cosmos + 1,
 				NetworkType: NetworkType_testnet,
 				Vm:          Vm_evm,
 				Consensus:   Consensus_op_stack,
@@ -101,7 +113,7 @@ func TestChain_Validate(t *testing.T) {
 				ChainName:   ChainName_empty,
 				Network:     Network_base,
 				NetworkType: NetworkType_devnet,
-				Vm:          Vm_evm + 1,
+				Vm:          Vm_svm + 1,
 				Consensus:   Consensus_op_stack,
 				IsExternal:  true,
 			},
@@ -115,11 +127,25 @@ func TestChain_Validate(t *testing.T) {
 				Network:     Network_base,
 				NetworkType: NetworkType_devnet,
 				Vm:          Vm_evm,
-				Consensus:   Consensus_op_stack + 1,
+				Consensus:   Consensus_solana_consensus + 1,
 				IsExternal:  true,
 			},
 			errStr: "invalid consensus",
 		},
+		{
+			name: "should error if cctx gateway invalid",
+			chain: Chain{
+				ChainId:     42,
+				ChainName:   ChainName_empty,
+				Network:     Network_base,
+				NetworkType: NetworkType_devnet,
+				Vm:          Vm_evm,
+				Consensus:   Consensus_op_stack,
+				IsExternal:  true,
+				CctxGateway: CCTXGateway_ibc + 1,
+			},
+			errStr: "invalid cctx gateway",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -147,50 +173,67 @@ func TestChain_EncodeAddress(t *testing.T) {
 		wantErr bool
 	}{
 		{
-			name: "should error if b is not a valid address on the bitcoin network",
-			chain: Chain{
-				ChainName: ChainName_btc_testnet,
-				ChainId:   18332,
-			},
+			name:    "should error if b is not a valid address on the bitcoin network",
+			chain:   BitcoinTestnet,
 			b:       []byte("bc1qk0cc73p8m7hswn8y2q080xa4e5pxapnqgp7h9c"),
 			want:    "",
 			wantErr: true,
 		},
 		{
-			name: "should pass if b is a valid address on the network",
-			chain: Chain{
-				ChainName: ChainName_btc_mainnet,
-				ChainId:   8332,
-			},
+			name:    "should pass if b is a valid address on the network",
+			chain:   BitcoinMainnet,
 			b:       []byte("bc1qk0cc73p8m7hswn8y2q080xa4e5pxapnqgp7h9c"),
 			want:    "bc1qk0cc73p8m7hswn8y2q080xa4e5pxapnqgp7h9c",
 			wantErr: false,
 		},
 		{
-			name: "should error if b is not a valid address on the evm network",
-			chain: Chain{
-				ChainName: ChainName_goerli_testnet,
-				ChainId:   5,
-			},
+			name:    "should error if b is not a valid address on the evm network",
+			chain:   Goerli,
 			b:       ethcommon.Hex2Bytes("0x321"),
 			want:    "",
 			wantErr: true,
 		},
 		{
-			name: "should pass if b is a valid address on the evm network",
-			chain: Chain{
-				ChainName: ChainName_goerli_testnet,
-				ChainId:   5,
-			},
+			name:    "should pass if b is a valid address on the evm network",
+			chain:   Goerli,
 			b:       []byte("0x321"),
 			want:    "0x0000000000000000000000000000003078333231",
 			wantErr: false,
 		},
+		{
+			name:    "should pass if b is a valid address on the solana network",
+			chain:   SolanaDevnet,
+			b:       []byte("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM"),
+			want:    "9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM",
+			wantErr: false,
+		},
+		{
+			name:    "should error if b is not a valid address on the solana network",
+			chain:   SolanaDevnet,
+			b:       []byte("0x321"),
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "should pass if b is a valid bech32 address on an ibc chain",
+			chain:   ibcChain,
+			b:       []byte("cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"),
+			want:    "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+			wantErr: false,
+		},
+		{
+			name:    "should error if b is not a valid bech32 address on an ibc chain",
+			chain:   ibcChain,
+			b:       []byte("0x321"),
+			want:    "",
+			wantErr: true,
+		},
 		{
 			name: "should error if chain not supported",
 			chain: Chain{
 				ChainName: 999,
 				ChainId:   999,
+				Consensus: Consensus_tendermint,
 			},
 			b:       ethcommon.Hex2Bytes("0x321"),
 			want:    "",
@@ -220,30 +263,39 @@ func TestChain_DecodeAddress(t *testing.T) {
 		wantErr bool
 	}{
 		{
-			name: "should decode on btc chain",
-			chain: Chain{
-				ChainName: ChainName_btc_testnet,
-				ChainId:   18332,
-			},
+			name:    "should decode on btc chain",
+			chain:   BitcoinTestnet,
 			want:    []byte("bc1qk0cc73p8m7hswn8y2q080xa4e5pxapnqgp7h9c"),
 			b:       "bc1qk0cc73p8m7hswn8y2q080xa4e5pxapnqgp7h9c",
 			wantErr: false,
 		},
 		{
-			name: "should decode on evm chain",
-			chain: Chain{
-				ChainName: ChainName_goerli_testnet,
-				ChainId:   5,
-			},
+			name:    "should decode on evm chain",
+			chain:   Goerli,
 			want:    ethcommon.HexToAddress("0x321").Bytes(),
 			b:       "0x321",
 			wantErr: false,
 		},
+		{
+			name:    "should decode on solana chain",
+			chain:   SolanaMainnet,
+			want:    []byte("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM"),
+			b:       "9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM",
+			wantErr: false,
+		},
+		{
+			name:    "should decode on ibc chain",
+			chain:   ibcChain,
+			want:    []byte("cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"),
+			b:       "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+			wantErr: false,
+		},
 		{
 			name: "should error if chain not supported",
 			chain: Chain{
 				ChainName: 999,
 				ChainId:   999,
+				Consensus: Consensus_tendermint,
 			},
 			want:    ethcommon.Hex2Bytes("0x321"),
 			b:       "",
@@ -265,11 +317,11 @@ func TestChain_DecodeAddress(t *testing.T) {
 }
 
 func TestChain_InChainList(t *testing.T) {
-	require.True(t, ZetaChainMainnet.InChainList(ChainListByNetwork(Network_zeta)))
-	require.True(t, ZetaChainDevnet.InChainList(ChainListByNetwork(Network_zeta)))
-	require.True(t, ZetaChainPrivnet.InChainList(ChainListByNetwork(Network_zeta)))
-	require.True(t, ZetaChainTestnet.InChainList(ChainListByNetwork(Network_zeta)))
-	require.False(t, Ethereum.InChainList(ChainListByNetwork(Network_zeta)))
+	require.True(t, ZetaChainMainnet.InChainList(ChainListByNetwork(Network_zeta, []Chain{})))
+	require.True(t, ZetaChainDevnet.InChainList(ChainListByNetwork(Network_zeta, []Chain{})))
+	require.True(t, ZetaChainPrivnet.InChainList(ChainListByNetwork(Network_zeta, []Chain{})))
+	require.True(t, ZetaChainTestnet.InChainList(ChainListByNetwork(Network_zeta, []Chain{})))
+	require.False(t, Ethereum.InChainList(ChainListByNetwork(Network_zeta, []Chain{})))
 }
 
 func TestIsZetaChain(t *testing.T) {
@@ -287,7 +339,7 @@ func TestIsZetaChain(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			require.Equal(t, tt.want, IsZetaChain(tt.chainID))
+			require.Equal(t, tt.want, IsZetaChain(tt.chainID, []Chain{}))
 		})
 	}
 }
@@ -307,11 +359,19 @@ func TestIsEVMChain(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			require.Equal(t, tt.want, IsEVMChain(tt.chainID))
+			require.Equal(t, tt.want, IsEVMChain(tt.chainID, []Chain{}))
 		})
 	}
 }
 
+func TestIsIBCChain(t *testing.T) {
+	require.True(t, IsIBCChain(ibcChain.ChainId, []Chain{ibcChain}))
+	require.True(t, ibcChain.IsIBCChain())
+	require.False(t, IsIBCChain(ibcChain.ChainId, []Chain{}))
+	require.False(t, IsIBCChain(Ethereum.ChainId, []Chain{ibcChain}))
+	require.False(t, Ethereum.IsIBCChain())
+}
+
 func TestIsHeaderSupportedChain(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -330,7 +390,7 @@ func TestIsHeaderSupportedChain(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			require.Equal(t, tt.want, IsHeaderSupportedChain(tt.chainID))
+			require.Equal(t, tt.want, IsHeaderSupportedChain(tt.chainID, []Chain{}))
 		})
 	}
 }
@@ -346,6 +406,7 @@ func TestSupportMerkleProof(t *testing.T) {
 		{"BSC Mainnet", BscMainnet, true},
 		{"Non-EVM", BitcoinMainnet, true},
 		{"Zeta Mainnet", ZetaChainMainnet, false},
+		{"Solana Mainnet", SolanaMainnet, false},
 	}
 
 	for _, tt := range tests {
@@ -370,7 +431,27 @@ func TestIsBitcoinChain(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			require.Equal(t, tt.want, IsBitcoinChain(tt.chainID))
+			require.Equal(t, tt.want, IsBitcoinChain(tt.chainID, []Chain{}))
+		})
+	}
+}
+
+func TestIsSolanaChain(t *testing.T) {
+	tests := []struct {
+		name    string
+		chainID int64
+		want    bool
+	}{
+		{"Solana Mainnet", SolanaMainnet.ChainId, true},
+		{"Solana Devnet", SolanaDevnet.ChainId, true},
+		{"Solana Localnet", SolanaLocalnet.ChainId, true},
+		{"Non-Solana", Ethereum.ChainId, false},
+		{"Zeta Mainnet", ZetaChainMainnet.ChainId, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, IsSolanaChain(tt.chainID, []Chain{}))
 		})
 	}
 }
@@ -390,7 +471,7 @@ func TestIsEthereumChain(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			require.Equal(t, tt.want, IsEthereumChain(tt.chainID))
+			require.Equal(t, tt.want, IsEthereumChain(tt.chainID, []Chain{}))
 		})
 	}
 }
@@ -471,9 +552,19 @@ func TestChains_Strings(t *testing.T) {
 }
 
 func TestGetChainFromChainID(t *testing.T) {
-	chain := GetChainFromChainID(ZetaChainMainnet.ChainId)
+	chain := GetChainFromChainID(ZetaChainMainnet.ChainId, []Chain{})
 	require.Equal(t, ZetaChainMainnet, *chain)
-	require.Nil(t, GetChainFromChainID(9999))
+	require.Nil(t, GetChainFromChainID(9999, []Chain{}))
+
+	// additional chains are supported
+	newChain := Chain{ChainId: 9999, Network: Network_eth, Consensus: Consensus_ethereum, IsExternal: true}
+	chain = GetChainFromChainID(9999, []Chain{newChain})
+	require.Equal(t, newChain, *chain)
+	require.True(t, IsEVMChain(9999, []Chain{newChain}))
+	require.False(t, IsBitcoinChain(9999, []Chain{newChain}))
+	addr, err := DecodeAddressFromChainID(9999, "0x0000000000000000000000000000000000000001", []Chain{newChain})
+	require.NoError(t, err)
+	require.Len(t, addr, 20)
 }
 
 func TestGetBTCChainParams(t *testing.T) {
@@ -481,6 +572,16 @@ func TestGetBTCChainParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, &chaincfg.MainNetParams, params)
 
+	params, err = GetBTCChainParams(BitcoinSignetTestnet.ChainId)
+	require.NoError(t, err)
+	require.Equal(t, &chaincfg.SigNetParams, params)
+
+	params, err = GetBTCChainParams(BitcoinTestnet4.ChainId)
+	require.NoError(t, err)
+	require.Equal(t, "testnet4", params.Name)
+	require.Equal(t, chaincfg.TestNet3Params.Bech32HRPSegwit, params.Bech32HRPSegwit)
+	require.NotEqual(t, chaincfg.TestNet3Params.Net, params.Net)
+
 	_, err = GetBTCChainParams(9999)
 	require.Error(t, err)
 }
@@ -498,11 +599,19 @@ func TestGetBTCChainIDFromChainParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int64(18332), chainID)
 
+	chainID, err = GetBTCChainIDFromChainParams(&chaincfg.SigNetParams)
+	require.NoError(t, err)
+	require.Equal(t, int64(18333), chainID)
+
+	chainID, err = GetBTCChainIDFromChainParams(BitcoinTestnet4Params)
+	require.NoError(t, err)
+	require.Equal(t, int64(18334), chainID)
+
 	_, err = GetBTCChainIDFromChainParams(&chaincfg.Params{Name: "unknown"})
 	require.Error(t, err)
 }
 
 func TestChainIDInChainList(t *testing.T) {
-	require.True(t, ChainIDInChainList(ZetaChainMainnet.ChainId, ChainListByNetwork(Network_zeta)))
-	require.False(t, ChainIDInChainList(Ethereum.ChainId, ChainListByNetwork(Network_zeta)))
+	require.True(t, ChainIDInChainList(ZetaChainMainnet.ChainId, ChainListByNetwork(Network_zeta, []Chain{})))
+	require.False(t, ChainIDInChainList(Ethereum.ChainId, ChainListByNetwork(Network_zeta, []Chain{})))
 }
@@ -91,6 +91,18 @@ var (
 		CctxGateway: CCTXGateway_observers,
 	}
 
+	// SolanaMainnet is Solana mainnet-beta
+	SolanaMainnet = Chain{
+		ChainName:   ChainName_solana_mainnet,
+		ChainId:     900,
+		Network:     Network_solana,
+		NetworkType: NetworkType_mainnet,
+		Vm:          Vm_svm,
+		Consensus:   Consensus_solana_consensus,
+		IsExternal:  true,
+		CctxGateway: CCTXGateway_observers,
+	}
+
 	/**
 	* Testnet chains
 	 */
@@ -143,6 +155,30 @@ var (
 		CctxGateway: CCTXGateway_observers,
 	}
 
+	// BitcoinSignetTestnet is Bitcoin signet testnet
+	BitcoinSignetTestnet = Chain{
+		ChainName:   ChainName_btc_signet_testnet,
+		ChainId:     18333,
+		Network:     Network_btc,
+		NetworkType: NetworkType_testnet,
+		Vm:          Vm_no_vm,
+		Consensus:   Consensus_bitcoin,
+		IsExternal:  true,
+		CctxGateway: CCTXGateway_observers,
+	}
+
+	// BitcoinTestnet4 is Bitcoin testnet4
+	BitcoinTestnet4 = Chain{
+		ChainName:   ChainName_btc_testnet4,
+		ChainId:     18334,
+		Network:     Network_btc,
+		NetworkType: NetworkType_testnet,
+		Vm:          Vm_no_vm,
+		Consensus:   Consensus_bitcoin,
+		IsExternal:  true,
+		CctxGateway: CCTXGateway_observers,
+	}
+
 	// Amoy is Polygon amoy testnet
 	Amoy = Chain{
 		ChainName:   ChainName_amoy_testnet,
@@ -196,6 +232,18 @@ var (
 		CctxGateway: CCTXGateway_zevm,
 	}
 
+	// SolanaDevnet is Solana devnet
+	SolanaDevnet = Chain{
+		ChainName:   ChainName_solana_devnet,
+		ChainId:     901,
+		Network:     Network_solana,
+		NetworkType: NetworkType_devnet,
+		Vm:          Vm_svm,
+		Consensus:   Consensus_solana_consensus,
+		IsExternal:  true,
+		CctxGateway: CCTXGateway_observers,
+	}
+
 	/**
 	* Privnet chains
 	 */
@@ -236,6 +284,18 @@ var (
 		CctxGateway: CCTXGateway_observers,
 	}
 
+	// SolanaLocalnet is Solana local test validator (localnet)
+	SolanaLocalnet = Chain{
+		ChainName:   ChainName_solana_localnet,
+		ChainId:     902,
+		Network:     Network_solana,
+		NetworkType: NetworkType_privnet,
+		Vm:          Vm_svm,
+		Consensus:   Consensus_solana_consensus,
+		IsExternal:  true,
+		CctxGateway: CCTXGateway_observers,
+	}
+
 	/**
 	* Deprecated chains
 	 */
@@ -292,13 +352,39 @@ func DefaultChainsList() []*Chain {
 		OptimismSepolia,
 		BaseMainnet,
 		BaseSepolia,
+		SolanaMainnet,
+		SolanaDevnet,
+		SolanaLocalnet,
+		BitcoinSignetTestnet,
+		BitcoinTestnet4,
 	})
 }
 
+// CombineDefaultChainsList returns the default chains combined with the additional chains
+func CombineDefaultChainsList(additionalChains []Chain) []*Chain {
+	return CombineChainList(DefaultChainsList(), chainListPointers(additionalChains))
+}
+
+// CombineChainList returns the chains of the base list combined with the additional chains
+// a chain of the additional list replaces the chain of the base list having the same chain ID
+func CombineChainList(base []*Chain, additional []*Chain) []*Chain {
+	combined := make([]*Chain, 0, len(base)+len(additional))
+	indexes := make(map[int64]int, len(base)+len(additional))
+	for _, chain := range append(append([]*Chain{}, base...), additional...) {
+		if i, found := indexes[chain.ChainId]; found {
+			combined[i] = chain
+			continue
+		}
+		indexes[chain.ChainId] = len(combined)
+		combined = append(combined, chain)
+	}
+	return combined
+}
+
 // ChainListByNetworkType returns a list of chains by network type
-func ChainListByNetworkType(networkType NetworkType) []*Chain {
+func ChainListByNetworkType(networkType NetworkType, additionalChains []Chain) []*Chain {
 	var chainList []*Chain
-	for _, chain := range DefaultChainsList() {
+	for _, chain := range CombineDefaultChainsList(additionalChains) {
 		if chain.NetworkType == networkType {
 			chainList = append(chainList, chain)
 		}
@@ -307,9 +393,9 @@ func ChainListByNetworkType(networkType NetworkType) []*Chain {
 }
 
 // ChainListByNetwork returns a list of chains by network
-func ChainListByNetwork(network Network) []*Chain {
+func ChainListByNetwork(network Network, additionalChains []Chain) []*Chain {
 	var chainList []*Chain
-	for _, chain := range DefaultChainsList() {
+	for _, chain := range CombineDefaultChainsList(additionalChains) {
 		if chain.Network == network {
 			chainList = append(chainList, chain)
 		}
@@ -318,9 +404,9 @@ func ChainListByNetwork(network Network) []*Chain {
 }
 
 // ExternalChainList returns a list chains that are not Zeta
-func ExternalChainList() []*Chain {
+func ExternalChainList(additionalChains []Chain) []*Chain {
 	var chainList []*Chain
-	for _, chain := range DefaultChainsList() {
+	for _, chain := range CombineDefaultChainsList(additionalChains) {
 		if chain.IsExternal {
 			chainList = append(chainList, chain)
 		}
@@ -329,9 +415,9 @@ func ExternalChainList() []*Chain {
 }
 
 // ChainListByConsensus returns a list of chains by consensus
-func ChainListByConsensus(consensus Consensus) []*Chain {
+func ChainListByConsensus(consensus Consensus, additionalChains []Chain) []*Chain {
 	var chainList []*Chain
-	for _, chain := range DefaultChainsList() {
+	for _, chain := range CombineDefaultChainsList(additionalChains) {
 		if chain.Consensus == consensus {
 			chainList = append(chainList, chain)
 		}
@@ -339,10 +425,21 @@ func ChainListByConsensus(consensus Consensus) []*Chain {
 	return chainList
 }
 
+// ChainListByGateway returns a list of chains by the CCTX gateway they use
+func ChainListByGateway(gateway CCTXGateway, additionalChains []Chain) []*Chain {
+	var chainList []*Chain
+	for _, chain := range CombineDefaultChainsList(additionalChains) {
+		if chain.CctxGateway == gateway {
+			chainList = append(chainList, chain)
+		}
+	}
+	return chainList
+}
+
 // ChainListForHeaderSupport returns a list of chains that support headers
-func ChainListForHeaderSupport() []*Chain {
+func ChainListForHeaderSupport(additionalChains []Chain) []*Chain {
 	var chainList []*Chain
-	for _, chain := range DefaultChainsList() {
+	for _, chain := range CombineDefaultChainsList(additionalChains) {
 		if chain.Consensus == Consensus_ethereum || chain.Consensus == Consensus_bitcoin {
 			chainList = append(chainList, chain)
 		}
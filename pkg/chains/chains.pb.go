@@ -59,25 +59,30 @@ func (ReceiveStatus) EnumDescriptor() ([]byte, []int) {
 type ChainName int32
 
 const (
-	ChainName_empty            ChainName = 0
-	ChainName_eth_mainnet      ChainName = 1
-	ChainName_zeta_mainnet     ChainName = 2
-	ChainName_btc_mainnet      ChainName = 3
-	ChainName_polygon_mainnet  ChainName = 4
-	ChainName_bsc_mainnet      ChainName = 5
-	ChainName_goerli_testnet   ChainName = 6
-	ChainName_mumbai_testnet   ChainName = 7
-	ChainName_bsc_testnet      ChainName = 10
-	ChainName_zeta_testnet     ChainName = 11
-	ChainName_btc_testnet      ChainName = 12
-	ChainName_sepolia_testnet  ChainName = 13
-	ChainName_goerli_localnet  ChainName = 14
-	ChainName_btc_regtest      ChainName = 15
-	ChainName_amoy_testnet     ChainName = 16
-	ChainName_optimism_mainnet ChainName = 17
-	ChainName_optimism_sepolia ChainName = 18
-	ChainName_base_mainnet     ChainName = 19
-	ChainName_base_sepolia     ChainName = 20
+	ChainName_empty              ChainName = 0
+	ChainName_eth_mainnet        ChainName = 1
+	ChainName_zeta_mainnet       ChainName = 2
+	ChainName_btc_mainnet        ChainName = 3
+	ChainName_polygon_mainnet    ChainName = 4
+	ChainName_bsc_mainnet        ChainName = 5
+	ChainName_goerli_testnet     ChainName = 6
+	ChainName_mumbai_testnet     ChainName = 7
+	ChainName_bsc_testnet        ChainName = 10
+	ChainName_zeta_testnet       ChainName = 11
+	ChainName_btc_testnet        ChainName = 12
+	ChainName_sepolia_testnet    ChainName = 13
+	ChainName_goerli_localnet    ChainName = 14
+	ChainName_btc_regtest        ChainName = 15
+	ChainName_amoy_testnet       ChainName = 16
+	ChainName_optimism_mainnet   ChainName = 17
+	ChainName_optimism_sepolia   ChainName = 18
+	ChainName_base_mainnet       ChainName = 19
+	ChainName_base_sepolia       ChainName = 20
+	ChainName_solana_mainnet     ChainName = 21
+	ChainName_solana_devnet      ChainName = 22
+	ChainName_solana_localnet    ChainName = 23
+	ChainName_btc_signet_testnet ChainName = 24
+	ChainName_btc_testnet4       ChainName = 25
 )
 
 var ChainName_name = map[int32]string{
@@ -100,28 +105,38 @@ var ChainName_name = map[int32]string{
 	18: "optimism_sepolia",
 	19: "base_mainnet",
 	20: "base_sepolia",
+	21: "solana_mainnet",
+	22: "solana_devnet",
+	23: "solana_localnet",
+	24: "btc_signet_testnet",
+	25: "btc_testnet4",
 }
 
 var ChainName_value = map[string]int32{
-	"empty":            0,
-	"eth_mainnet":      1,
-	"zeta_mainnet":     2,
-	"btc_mainnet":      3,
-	"polygon_mainnet":  4,
-	"bsc_mainnet":      5,
-	"goerli_testnet":   6,
-	"mumbai_testnet":   7,
-	"bsc_testnet":      10,
-	"zeta_testnet":     11,
-	"btc_testnet":      12,
-	"sepolia_testnet":  13,
-	"goerli_localnet":  14,
-	"btc_regtest":      15,
-	"amoy_testnet":     16,
-	"optimism_mainnet": 17,
-	"optimism_sepolia": 18,
-	"base_mainnet":     19,
-	"base_sepolia":     20,
+	"empty":              0,
+	"eth_mainnet":        1,
+	"zeta_mainnet":       2,
+	"btc_mainnet":        3,
+	"polygon_mainnet":    4,
+	"bsc_mainnet":        5,
+	"goerli_testnet":     6,
+	"mumbai_testnet":     7,
+	"bsc_testnet":        10,
+	"zeta_testnet":       11,
+	"btc_testnet":        12,
+	"sepolia_testnet":    13,
+	"goerli_localnet":    14,
+	"btc_regtest":        15,
+	"amoy_testnet":       16,
+	"optimism_mainnet":   17,
+	"optimism_sepolia":   18,
+	"base_mainnet":       19,
+	"base_sepolia":       20,
+	"solana_mainnet":     21,
+	"solana_devnet":      22,
+	"solana_localnet":    23,
+	"btc_signet_testnet": 24,
+	"btc_testnet4":       25,
 }
 
 func (x ChainName) String() string {
@@ -145,6 +160,8 @@ const (
 	Network_bsc      Network = 4
 	Network_optimism Network = 5
 	Network_base     Network = 6
+	Network_solana   Network = 7
+	Network_cosmos   Network = 8
 )
 
 var Network_name = map[int32]string{
@@ -155,6 +172,8 @@ var Network_name = map[int32]string{
 	4: "bsc",
 	5: "optimism",
 	6: "base",
+	7: "solana",
+	8: "cosmos",
 }
 
 var Network_value = map[string]int32{
@@ -165,6 +184,8 @@ var Network_value = map[string]int32{
 	"bsc":      4,
 	"optimism": 5,
 	"base":     6,
+	"solana":   7,
+	"cosmos":   8,
 }
 
 func (x Network) String() string {
@@ -215,16 +236,19 @@ type Vm int32
 const (
 	Vm_no_vm Vm = 0
 	Vm_evm   Vm = 1
+	Vm_svm   Vm = 2
 )
 
 var Vm_name = map[int32]string{
 	0: "no_vm",
 	1: "evm",
+	2: "svm",
 }
 
 var Vm_value = map[string]int32{
 	"no_vm": 0,
 	"evm":   1,
+	"svm":   2,
 }
 
 func (x Vm) String() string {
@@ -241,10 +265,11 @@ func (Vm) EnumDescriptor() ([]byte, []int) {
 type Consensus int32
 
 const (
-	Consensus_ethereum   Consensus = 0
-	Consensus_tendermint Consensus = 1
-	Consensus_bitcoin    Consensus = 2
-	Consensus_op_stack   Consensus = 3
+	Consensus_ethereum         Consensus = 0
+	Consensus_tendermint       Consensus = 1
+	Consensus_bitcoin          Consensus = 2
+	Consensus_op_stack         Consensus = 3
+	Consensus_solana_consensus Consensus = 4
 )
 
 var Consensus_name = map[int32]string{
@@ -252,13 +277,15 @@ var Consensus_name = map[int32]string{
 	1: "tendermint",
 	2: "bitcoin",
 	3: "op_stack",
+	4: "solana_consensus",
 }
 
 var Consensus_value = map[string]int32{
-	"ethereum":   0,
-	"tendermint": 1,
-	"bitcoin":    2,
-	"op_stack":   3,
+	"ethereum":         0,
+	"tendermint":       1,
+	"bitcoin":          2,
+	"op_stack":         3,
+	"solana_consensus": 4,
 }
 
 func (x Consensus) String() string {
@@ -279,16 +306,21 @@ const (
 	// observers is the CCTX gateway for chains relying on the observer set to
 	// observe inbounds and TSS for outbounds
 	CCTXGateway_observers CCTXGateway = 1
+	// ibc is the CCTX gateway for Cosmos chains connected through IBC, the
+	// outbounds are ICS-20 transfers and the inbounds are received ICS-20 packets
+	CCTXGateway_ibc CCTXGateway = 2
 )
 
 var CCTXGateway_name = map[int32]string{
 	0: "zevm",
 	1: "observers",
+	2: "ibc",
 }
 
 var CCTXGateway_value = map[string]int32{
 	"zevm":      0,
 	"observers": 1,
+	"ibc":       2,
 }
 
 func (x CCTXGateway) String() string {
@@ -425,51 +457,56 @@ func init() {
 }
 
 var fileDescriptor_236b85e7bff6130d = []byte{
-	// 704 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x3d, 0x8f, 0x2b, 0x35,
-	0x14, 0xcd, 0x4c, 0xbe, 0xef, 0x64, 0xb3, 0xc6, 0x6f, 0x8b, 0xe1, 0x49, 0x0c, 0x0b, 0x05, 0x0a,
-	0x11, 0x24, 0x02, 0x4a, 0x1a, 0x44, 0xc4, 0x7b, 0x02, 0x89, 0x57, 0x0c, 0xab, 0x15, 0xd0, 0x8c,
-	0x3c, 0xce, 0x65, 0x62, 0x25, 0x1e, 0x8f, 0xc6, 0x4e, 0x76, 0xc3, 0xaf, 0xe0, 0x47, 0x50, 0xf0,
-	0x53, 0x28, 0xb7, 0xa4, 0x44, 0xbb, 0x05, 0x25, 0x7f, 0x01, 0xd9, 0xf3, 0x91, 0xa5, 0x80, 0xdd,
-	0x2a, 0xd7, 0x67, 0xce, 0x39, 0xf7, 0xd8, 0xbe, 0x31, 0xcc, 0x7f, 0x46, 0xc3, 0xf8, 0x86, 0x89,
-	0x7c, 0xe9, 0x2a, 0x55, 0xe2, 0xb2, 0xd8, 0x66, 0x4b, 0x07, 0xe9, 0xfa, 0x67, 0x51, 0x94, 0xca,
-	0x28, 0xfa, 0x4e, 0xcb, 0x5d, 0x34, 0xdc, 0x45, 0xb1, 0xcd, 0x16, 0x15, 0xe9, 0xe5, 0x45, 0xa6,
-	0x32, 0xe5, 0x98, 0x4b, 0x5b, 0x55, 0xa2, 0xf7, 0xff, 0xea, 0x42, 0x7f, 0x65, 0x09, 0xf4, 0x6d,
-	0x18, 0x39, 0x66, 0x22, 0xd6, 0xa1, 0x7f, 0xe9, 0xcd, 0xba, 0xf1, 0xd0, 0xad, 0xbf, 0x5e, 0xd3,
-	0xd7, 0x00, 0xd5, 0xa7, 0x9c, 0x49, 0x0c, 0xbd, 0x4b, 0x6f, 0x36, 0xfd, 0x74, 0xb6, 0xf8, 0xdf,
-	0x76, 0x0b, 0x67, 0xfa, 0x86, 0x49, 0x8c, 0xc7, 0xbc, 0x29, 0xe9, 0x17, 0x30, 0xcc, 0xd1, 0xdc,
-	0xa8, 0x72, 0x1b, 0x76, 0x9d, 0xcb, 0x07, 0x4f, 0xb8, 0xbc, 0xa9, 0xd8, 0x71, 0x23, 0xa3, 0xdf,
-	0xc2, 0xa4, 0x2e, 0x13, 0x73, 0x2c, 0x30, 0xec, 0x39, 0x9b, 0xf9, 0xf3, 0x6c, 0xae, 0x8e, 0x05,
-	0xc6, 0x41, 0x7e, 0x5a, 0xd0, 0x4f, 0xc0, 0x3f, 0xc8, 0xb0, 0xef, 0x4c, 0xde, 0x7b, 0xc2, 0xe4,
-	0x5a, 0xc6, 0xfe, 0x41, 0xd2, 0x57, 0x30, 0xe6, 0x2a, 0xd7, 0x98, 0xeb, 0xbd, 0x0e, 0x07, 0xcf,
-	0x3b, 0x8b, 0x86, 0x1f, 0x9f, 0xa4, 0xf4, 0x5d, 0x08, 0x84, 0x4e, 0xf0, 0xd6, 0x60, 0x99, 0xb3,
-	0x5d, 0x38, 0xbc, 0xf4, 0x66, 0xa3, 0x18, 0x84, 0xfe, 0xaa, 0x46, 0xec, 0x56, 0x39, 0x37, 0xb7,
-	0x49, 0xc6, 0x0c, 0xde, 0xb0, 0x63, 0x38, 0x7a, 0xd6, 0x56, 0x57, 0xab, 0xab, 0xef, 0x5f, 0x57,
-	0x8a, 0x38, 0xb0, 0xfa, 0x7a, 0x31, 0xff, 0x1c, 0xce, 0x62, 0xe4, 0x28, 0x0e, 0xf8, 0x9d, 0x61,
-	0x66, 0xaf, 0x69, 0x00, 0x43, 0x5e, 0x22, 0x33, 0xb8, 0x26, 0x1d, 0xbb, 0xd0, 0x7b, 0xce, 0x51,
-	0x6b, 0xe2, 0x51, 0x80, 0xc1, 0x4f, 0x4c, 0xec, 0x70, 0x4d, 0xfc, 0x97, 0xbd, 0xdf, 0x7e, 0x8d,
-	0xbc, 0xf9, 0xdf, 0x3e, 0x8c, 0xdb, 0x1b, 0xa5, 0x63, 0xe8, 0xa3, 0x2c, 0xcc, 0x91, 0x74, 0xe8,
-	0x39, 0x04, 0x68, 0x36, 0x89, 0x64, 0x22, 0xcf, 0xd1, 0x10, 0x8f, 0x12, 0x98, 0xd8, 0x58, 0x2d,
-	0xe2, 0x5b, 0x4a, 0x6a, 0x78, 0x0b, 0x74, 0xe9, 0x0b, 0x38, 0x2f, 0xd4, 0xee, 0x98, 0xa9, 0xbc,
-	0x05, 0x7b, 0x8e, 0xa5, 0x4f, 0xac, 0x3e, 0xa5, 0x30, 0xcd, 0x14, 0x96, 0x3b, 0x91, 0x18, 0xd4,
-	0xc6, 0x62, 0x03, 0x8b, 0xc9, 0xbd, 0x4c, 0xd9, 0x09, 0x1b, 0x36, 0xc2, 0x06, 0x80, 0x36, 0x41,
-	0x83, 0x04, 0x4d, 0x82, 0x06, 0x98, 0xd8, 0x04, 0x1a, 0x0b, 0xb5, 0x13, 0x27, 0xd6, 0x99, 0x05,
-	0xeb, 0x86, 0x3b, 0xc5, 0xd9, 0xce, 0x82, 0xd3, 0x46, 0x5a, 0x62, 0x66, 0x89, 0xe4, 0xdc, 0xba,
-	0x33, 0xa9, 0x8e, 0xad, 0x8e, 0xd0, 0x0b, 0x20, 0xaa, 0x30, 0x42, 0x0a, 0x2d, 0xdb, 0xf8, 0x6f,
-	0xfd, 0x0b, 0xad, 0x7b, 0x11, 0x6a, 0xd5, 0x29, 0xd3, 0xd8, 0xf2, 0x5e, 0xb4, 0x48, 0xc3, 0xb9,
-	0xa8, 0x4f, 0xfc, 0x07, 0x18, 0xd6, 0x53, 0x4b, 0x87, 0xd0, 0x45, 0xb3, 0x21, 0x1d, 0x3a, 0x82,
-	0x9e, 0xdd, 0x19, 0xf1, 0x2c, 0x94, 0x1a, 0x4e, 0x7c, 0x7b, 0x6f, 0xf5, 0x59, 0x92, 0xae, 0x43,
-	0x35, 0x27, 0x3d, 0x3a, 0x81, 0x51, 0xd3, 0x9c, 0xf4, 0xad, 0xcc, 0xb6, 0x20, 0x83, 0xda, 0xfa,
-	0x15, 0x04, 0x8f, 0xfe, 0x10, 0xd6, 0xa2, 0x89, 0xe3, 0xe6, 0xa0, 0xd9, 0x99, 0xe7, 0xcc, 0x4b,
-	0x71, 0xa8, 0xae, 0x11, 0x60, 0xb0, 0x46, 0x57, 0x77, 0x6b, 0x9f, 0x08, 0xfc, 0x6b, 0x69, 0x87,
-	0x21, 0x57, 0xc9, 0x41, 0x92, 0x8e, 0x0b, 0x7a, 0x90, 0xc4, 0xab, 0xbf, 0x7f, 0x03, 0xe3, 0x76,
-	0xf2, 0x6d, 0x24, 0x34, 0x1b, 0x2c, 0x71, 0x6f, 0x99, 0x53, 0x00, 0x83, 0xf9, 0x1a, 0x4b, 0x29,
-	0xf2, 0xba, 0x53, 0x2a, 0x0c, 0x57, 0x22, 0x27, 0x7e, 0x95, 0x3e, 0xd1, 0x86, 0xf1, 0x6d, 0xdb,
-	0xeb, 0x23, 0x08, 0x1e, 0x4d, 0x76, 0x75, 0x12, 0xae, 0xe7, 0x19, 0x8c, 0x55, 0xaa, 0xb1, 0x3c,
-	0x60, 0xa9, 0x9b, 0xce, 0x5f, 0xae, 0x7e, 0xbf, 0x8f, 0xbc, 0xbb, 0xfb, 0xc8, 0xfb, 0xf3, 0x3e,
-	0xf2, 0x7e, 0x79, 0x88, 0x3a, 0x77, 0x0f, 0x51, 0xe7, 0x8f, 0x87, 0xa8, 0xf3, 0xe3, 0x87, 0x99,
-	0x30, 0x9b, 0x7d, 0xba, 0xe0, 0x4a, 0xba, 0x17, 0xf5, 0xe3, 0xff, 0x7c, 0x5c, 0xd3, 0x81, 0x7b,
-	0x21, 0x3f, 0xfb, 0x27, 0x00, 0x00, 0xff, 0xff, 0x05, 0xa7, 0x7f, 0x40, 0x84, 0x05, 0x00, 0x00,
+	// 778 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x8e, 0xe4, 0x34,
+	0x10, 0xee, 0x74, 0xfa, 0xb7, 0x7a, 0x7e, 0xbc, 0xde, 0x61, 0xc9, 0xae, 0x44, 0x33, 0x70, 0x80,
+	0x61, 0x24, 0x7a, 0xc4, 0xdf, 0x89, 0x0b, 0x62, 0xc4, 0xae, 0x38, 0xb0, 0x87, 0xb0, 0x5a, 0x21,
+	0x2e, 0xc1, 0x71, 0x17, 0x69, 0x6b, 0x62, 0x3b, 0xc4, 0xee, 0xec, 0x36, 0x4f, 0xc1, 0x43, 0x70,
+	0xe0, 0x51, 0x38, 0xee, 0x05, 0x89, 0x23, 0x9a, 0x39, 0xf0, 0x1a, 0xc8, 0x8e, 0x93, 0x5e, 0x0e,
+	0x30, 0x73, 0x1a, 0xfb, 0x9b, 0xef, 0xab, 0xfa, 0xaa, 0x5c, 0x95, 0x86, 0xf3, 0x9f, 0xd1, 0x32,
+	0xbe, 0x61, 0x42, 0x5d, 0xf8, 0x93, 0xae, 0xf1, 0xa2, 0xba, 0x2a, 0x2e, 0x3c, 0x64, 0xc2, 0x9f,
+	0x55, 0x55, 0x6b, 0xab, 0xe9, 0x5b, 0x3d, 0x77, 0xd5, 0x71, 0x57, 0xd5, 0x55, 0xb1, 0x6a, 0x49,
+	0x8f, 0x4e, 0x0a, 0x5d, 0x68, 0xcf, 0xbc, 0x70, 0xa7, 0x56, 0xf4, 0xee, 0xdf, 0x31, 0x8c, 0x2f,
+	0x1d, 0x81, 0x3e, 0x84, 0x99, 0x67, 0x66, 0x62, 0x9d, 0x0c, 0x4f, 0xa3, 0xb3, 0x38, 0x9d, 0xfa,
+	0xfb, 0xd7, 0x6b, 0xfa, 0x04, 0xa0, 0xfd, 0x97, 0x62, 0x12, 0x93, 0xe8, 0x34, 0x3a, 0x3b, 0xfa,
+	0xf8, 0x6c, 0xf5, 0xbf, 0xe9, 0x56, 0x3e, 0xe8, 0x53, 0x26, 0x31, 0x9d, 0xf3, 0xee, 0x48, 0xbf,
+	0x80, 0xa9, 0x42, 0xfb, 0x42, 0xd7, 0x57, 0x49, 0xec, 0xa3, 0xbc, 0x77, 0x4b, 0x94, 0xa7, 0x2d,
+	0x3b, 0xed, 0x64, 0xf4, 0x1b, 0x38, 0x08, 0xc7, 0xcc, 0xee, 0x2a, 0x4c, 0x46, 0x3e, 0xcc, 0xf9,
+	0xdd, 0xc2, 0x3c, 0xdb, 0x55, 0x98, 0x2e, 0xd4, 0xfe, 0x42, 0x3f, 0x82, 0x61, 0x23, 0x93, 0xb1,
+	0x0f, 0xf2, 0xce, 0x2d, 0x41, 0x9e, 0xcb, 0x74, 0xd8, 0x48, 0xfa, 0x18, 0xe6, 0x5c, 0x2b, 0x83,
+	0xca, 0x6c, 0x4d, 0x32, 0xb9, 0x5b, 0x2f, 0x3a, 0x7e, 0xba, 0x97, 0xd2, 0xb7, 0x61, 0x21, 0x4c,
+	0x86, 0x2f, 0x2d, 0xd6, 0x8a, 0x95, 0xc9, 0xf4, 0x34, 0x3a, 0x9b, 0xa5, 0x20, 0xcc, 0x57, 0x01,
+	0x71, 0xa5, 0x72, 0x6e, 0x5f, 0x66, 0x05, 0xb3, 0xf8, 0x82, 0xed, 0x92, 0xd9, 0x9d, 0x4a, 0xbd,
+	0xbc, 0x7c, 0xf6, 0xdd, 0x93, 0x56, 0x91, 0x2e, 0x9c, 0x3e, 0x5c, 0xce, 0x3f, 0x87, 0xc3, 0x14,
+	0x39, 0x8a, 0x06, 0xbf, 0xb5, 0xcc, 0x6e, 0x0d, 0x5d, 0xc0, 0x94, 0xd7, 0xc8, 0x2c, 0xae, 0xc9,
+	0xc0, 0x5d, 0xcc, 0x96, 0x73, 0x34, 0x86, 0x44, 0x14, 0x60, 0xf2, 0x23, 0x13, 0x25, 0xae, 0xc9,
+	0xf0, 0xd1, 0xe8, 0xb7, 0x5f, 0x97, 0xd1, 0xf9, 0x1f, 0x31, 0xcc, 0xfb, 0x17, 0xa5, 0x73, 0x18,
+	0xa3, 0xac, 0xec, 0x8e, 0x0c, 0xe8, 0x31, 0x2c, 0xd0, 0x6e, 0x32, 0xc9, 0x84, 0x52, 0x68, 0x49,
+	0x44, 0x09, 0x1c, 0x38, 0x5b, 0x3d, 0x32, 0x74, 0x94, 0xdc, 0xf2, 0x1e, 0x88, 0xe9, 0x7d, 0x38,
+	0xae, 0x74, 0xb9, 0x2b, 0xb4, 0xea, 0xc1, 0x91, 0x67, 0x99, 0x3d, 0x6b, 0x4c, 0x29, 0x1c, 0x15,
+	0x1a, 0xeb, 0x52, 0x64, 0x16, 0x8d, 0x75, 0xd8, 0xc4, 0x61, 0x72, 0x2b, 0x73, 0xb6, 0xc7, 0xa6,
+	0x9d, 0xb0, 0x03, 0xa0, 0x77, 0xd0, 0x21, 0x8b, 0xce, 0x41, 0x07, 0x1c, 0x38, 0x07, 0x06, 0x2b,
+	0x5d, 0x8a, 0x3d, 0xeb, 0xd0, 0x81, 0x21, 0x61, 0xa9, 0x39, 0x2b, 0x1d, 0x78, 0xd4, 0x49, 0x6b,
+	0x2c, 0x1c, 0x91, 0x1c, 0xbb, 0xe8, 0x4c, 0xea, 0x5d, 0xaf, 0x23, 0xf4, 0x04, 0x88, 0xae, 0xac,
+	0x90, 0xc2, 0xc8, 0xde, 0xfe, 0xbd, 0x7f, 0xa1, 0x21, 0x17, 0xa1, 0x4e, 0x9d, 0x33, 0x83, 0x3d,
+	0xef, 0x7e, 0x8f, 0x74, 0x9c, 0x13, 0x57, 0xa4, 0xd1, 0x25, 0x53, 0xfb, 0x1e, 0xbe, 0x41, 0xef,
+	0xc1, 0x61, 0xc0, 0xd6, 0xd8, 0x38, 0xe8, 0x81, 0xaf, 0xa1, 0x85, 0x7a, 0xbb, 0x6f, 0xd2, 0x07,
+	0x40, 0x9d, 0x5d, 0x23, 0x0a, 0x85, 0xb6, 0xf7, 0x98, 0xf8, 0x2c, 0xfb, 0x0e, 0x7c, 0x4a, 0x1e,
+	0x86, 0x77, 0xfd, 0x09, 0xa6, 0x61, 0x37, 0xe8, 0x14, 0x62, 0xb4, 0x1b, 0x32, 0xa0, 0x33, 0x18,
+	0xb9, 0xfe, 0x91, 0xc8, 0x41, 0xb9, 0xe5, 0x64, 0xe8, 0xa6, 0x23, 0xbc, 0x18, 0x89, 0x3d, 0x6a,
+	0x38, 0x19, 0xd1, 0x03, 0x98, 0x75, 0x25, 0x92, 0xb1, 0x93, 0xb9, 0x42, 0xc8, 0xc4, 0x8d, 0x4f,
+	0xeb, 0x8c, 0x4c, 0xdd, 0x99, 0x6b, 0x23, 0xb5, 0x21, 0xb3, 0x90, 0xf2, 0x31, 0x2c, 0x5e, 0x5b,
+	0x47, 0x17, 0xba, 0x2b, 0xd3, 0x4f, 0x61, 0xe7, 0x39, 0xf2, 0x49, 0x6b, 0xd1, 0xb4, 0x43, 0x04,
+	0x30, 0x09, 0x95, 0xc7, 0x21, 0xce, 0xfb, 0x30, 0x7c, 0x2e, 0xdd, 0x28, 0x2a, 0x9d, 0x35, 0x92,
+	0x0c, 0x7c, 0x01, 0x8d, 0x6c, 0x6d, 0x9b, 0x46, 0xf6, 0xb3, 0xfb, 0x03, 0xcc, 0xfb, 0x05, 0x74,
+	0x9e, 0xd1, 0x6e, 0xb0, 0xc6, 0xad, 0x93, 0x1c, 0x01, 0x58, 0x54, 0x6b, 0xac, 0xa5, 0x50, 0x21,
+	0x65, 0x2e, 0x2c, 0xd7, 0x42, 0x91, 0x61, 0x5b, 0x5e, 0x66, 0x2c, 0xe3, 0x57, 0x24, 0x76, 0xef,
+	0x19, 0xda, 0xdd, 0xaf, 0x30, 0x19, 0x85, 0x0c, 0x9f, 0xc1, 0xe2, 0xb5, 0xb5, 0x6b, 0x1b, 0xe8,
+	0x2d, 0x1d, 0xc2, 0x5c, 0xe7, 0x06, 0xeb, 0x06, 0x6b, 0xd3, 0x1a, 0x13, 0x39, 0xef, 0x8c, 0x7d,
+	0x79, 0xf9, 0xfb, 0xf5, 0x32, 0x7a, 0x75, 0xbd, 0x8c, 0xfe, 0xba, 0x5e, 0x46, 0xbf, 0xdc, 0x2c,
+	0x07, 0xaf, 0x6e, 0x96, 0x83, 0x3f, 0x6f, 0x96, 0x83, 0xef, 0x3f, 0x28, 0x84, 0xdd, 0x6c, 0xf3,
+	0x15, 0xd7, 0xd2, 0x7f, 0xf7, 0x3f, 0xfc, 0xcf, 0x9f, 0x80, 0x7c, 0xe2, 0xbf, 0xe3, 0x9f, 0xfc,
+	0x33, 0x00, 0x29, 0xf7, 0x24, 0x78, 0x2a, 0x06, 0x00, 0x00,
 }
 
 func (m *Chain) Marshal() (dAtA []byte, err error) {
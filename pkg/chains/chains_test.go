@@ -23,6 +23,7 @@ func TestChainListByNetworkType(t *testing.T) {
 				&Polygon,
 				&OptimismMainnet,
 				&BaseMainnet,
+				&SolanaMainnet,
 			},
 		},
 		{
@@ -38,6 +39,8 @@ func TestChainListByNetworkType(t *testing.T) {
 				&Sepolia,
 				&OptimismSepolia,
 				&BaseSepolia,
+				&BitcoinSignetTestnet,
+				&BitcoinTestnet4,
 			},
 		},
 		{
@@ -47,13 +50,14 @@ func TestChainListByNetworkType(t *testing.T) {
 				&ZetaChainPrivnet,
 				&BitcoinRegtest,
 				&GoerliLocalnet,
+				&SolanaLocalnet,
 			},
 		},
 	}
 
 	for _, lt := range listTests {
 		t.Run(lt.name, func(t *testing.T) {
-			require.ElementsMatch(t, lt.expected, ChainListByNetworkType(lt.networkType))
+			require.ElementsMatch(t, lt.expected, ChainListByNetworkType(lt.networkType, []Chain{}))
 		})
 	}
 }
@@ -72,7 +76,7 @@ func TestChainListByNetwork(t *testing.T) {
 		{
 			"Btc",
 			Network_btc,
-			[]*Chain{&BitcoinMainnet, &BitcoinTestnet, &BitcoinRegtest},
+			[]*Chain{&BitcoinMainnet, &BitcoinTestnet, &BitcoinRegtest, &BitcoinSignetTestnet, &BitcoinTestnet4},
 		},
 		{
 			"Eth",
@@ -99,11 +103,16 @@ func TestChainListByNetwork(t *testing.T) {
 			Network_base,
 			[]*Chain{&BaseMainnet, &BaseSepolia},
 		},
+		{
+			"Solana",
+			Network_solana,
+			[]*Chain{&SolanaMainnet, &SolanaDevnet, &SolanaLocalnet},
+		},
 	}
 
 	for _, lt := range listTests {
 		t.Run(lt.name, func(t *testing.T) {
-			require.ElementsMatch(t, lt.expected, ChainListByNetwork(lt.network))
+			require.ElementsMatch(t, lt.expected, ChainListByNetwork(lt.network, []Chain{}))
 		})
 	}
 }
@@ -137,11 +146,16 @@ func TestChainListFunctions(t *testing.T) {
 				&OptimismSepolia,
 				&BaseMainnet,
 				&BaseSepolia,
+				&SolanaMainnet,
+				&SolanaDevnet,
+				&SolanaLocalnet,
+				&BitcoinSignetTestnet,
+				&BitcoinTestnet4,
 			},
 		},
 		{
 			"ExternalChainList",
-			ExternalChainList,
+			func() []*Chain { return ExternalChainList([]Chain{}) },
 			[]*Chain{
 				&BitcoinMainnet,
 				&BscMainnet,
@@ -159,6 +173,11 @@ func TestChainListFunctions(t *testing.T) {
 				&OptimismSepolia,
 				&BaseMainnet,
 				&BaseSepolia,
+				&SolanaMainnet,
+				&SolanaDevnet,
+				&SolanaLocalnet,
+				&BitcoinSignetTestnet,
+				&BitcoinTestnet4,
 			},
 		},
 	}
@@ -171,6 +190,31 @@ func TestChainListFunctions(t *testing.T) {
 	}
 }
 
+func TestCombineDefaultChainsList(t *testing.T) {
+	t.Run("should return default chains without additional chains", func(t *testing.T) {
+		require.ElementsMatch(t, DefaultChainsList(), CombineDefaultChainsList([]Chain{}))
+	})
+	t.Run("should add new chains and replace the default chains of the same chain ID", func(t *testing.T) {
+		newChain := Chain{
+			ChainId:     1234,
+			ChainName:   ChainName_empty,
+			Network:     Network_eth,
+			NetworkType: NetworkType_mainnet,
+			Vm:          Vm_evm,
+			Consensus:   Consensus_ethereum,
+			IsExternal:  true,
+		}
+		updatedEthereum := Ethereum
+		updatedEthereum.CctxGateway = CCTXGateway_zevm
+
+		combined := CombineDefaultChainsList([]Chain{newChain, updatedEthereum})
+		require.Len(t, combined, len(DefaultChainsList())+1)
+		require.Contains(t, combined, &newChain)
+		require.Contains(t, combined, &updatedEthereum)
+		require.NotContains(t, combined, &Ethereum)
+	})
+}
+
 func TestZetaChainFromChainID(t *testing.T) {
 	tests := []struct {
 		name     string
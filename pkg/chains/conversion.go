@@ -15,10 +15,11 @@ func NonceMarkAmount(nonce uint64) int64 {
 }
 
 // HashToString convert hash bytes to string
+// the block headers are only verified for the default chains
 func HashToString(chainID int64, blockHash []byte) (string, error) {
-	if IsEVMChain(chainID) {
+	if IsEVMChain(chainID, []Chain{}) {
 		return hex.EncodeToString(blockHash), nil
-	} else if IsBitcoinChain(chainID) {
+	} else if IsBitcoinChain(chainID, []Chain{}) {
 		hash, err := chainhash.NewHash(blockHash)
 		if err != nil {
 			return "", err
@@ -29,10 +30,11 @@ func HashToString(chainID int64, blockHash []byte) (string, error) {
 }
 
 // StringToHash convert string to hash bytes
+// the block headers are only verified for the default chains
 func StringToHash(chainID int64, hash string) ([]byte, error) {
-	if IsEVMChain(chainID) {
+	if IsEVMChain(chainID, []Chain{}) {
 		return ethcommon.HexToHash(hash).Bytes(), nil
-	} else if IsBitcoinChain(chainID) {
+	} else if IsBitcoinChain(chainID, []Chain{}) {
 		hash, err := chainhash.NewHashFromStr(hash)
 		if err != nil {
 			return nil, err
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/testutil/sample"
 	"github.com/zeta-chain/zetacore/x/authority/keeper"
 	"github.com/zeta-chain/zetacore/x/authority/types"
@@ -89,3 +90,13 @@ func SetAdminPolices(ctx sdk.Context, ak *keeper.Keeper) string {
 	}})
 	return admin
 }
+
+// MockGetChainListEmpty mocks the GetAdditionalChainList method of an authority keeper mock to return an empty list
+func MockGetChainListEmpty(m *mock.Mock) {
+	m.On("GetAdditionalChainList", mock.Anything).Return([]chains.Chain{})
+}
+
+// MockGetChainList mocks the GetAdditionalChainList method of an authority keeper mock to return the list
+func MockGetChainList(m *mock.Mock, additionalChains []chains.Chain) {
+	m.On("GetAdditionalChainList", mock.Anything).Return(additionalChains)
+}
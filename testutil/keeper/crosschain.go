@@ -32,6 +32,7 @@ type CrosschainMockOptions struct {
 	UseFungibleMock      bool
 	UseAuthorityMock     bool
 	UseLightclientMock   bool
+	UseEmissionsMock     bool
 	UseIBCCrosschainMock bool
 }
 
@@ -44,6 +45,7 @@ var (
 		UseFungibleMock:      true,
 		UseAuthorityMock:     true,
 		UseLightclientMock:   true,
+		UseEmissionsMock:     true,
 		UseIBCCrosschainMock: true,
 	}
 	CrosschainNoMocks = CrosschainMockOptions{}
@@ -60,6 +62,7 @@ func initCrosschainKeeper(
 	fungibleKeeper types.FungibleKeeper,
 	authorityKeeper types.AuthorityKeeper,
 	lightclientKeeper types.LightclientKeeper,
+	emissionsKeeper types.EmissionsKeeper,
 ) *keeper.Keeper {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
@@ -77,6 +80,7 @@ func initCrosschainKeeper(
 		fungibleKeeper,
 		authorityKeeper,
 		lightclientKeeper,
+		emissionsKeeper,
 	)
 }
 
@@ -119,12 +123,23 @@ func CrosschainKeeperWithMocks(
 		observerKeeperTmp,
 		authorityKeeperTmp,
 	)
+	emissionsKeeperTmp := initEmissionsKeeper(
+		cdc,
+		db,
+		stateStore,
+		sdkKeepers.BankKeeper,
+		sdkKeepers.StakingKeeper,
+		observerKeeperTmp,
+		sdkKeepers.AuthKeeper,
+	)
 	zetaKeepers := ZetaKeepers{
 		ObserverKeeper:  observerKeeperTmp,
 		FungibleKeeper:  fungibleKeeperTmp,
 		AuthorityKeeper: &authorityKeeperTmp,
+		EmissionsKeeper: emissionsKeeperTmp,
 	}
 	var lightclientKeeper types.LightclientKeeper = lightclientKeeperTmp
+	var emissionsKeeper types.EmissionsKeeper = emissionsKeeperTmp
 	var authorityKeeper types.AuthorityKeeper = authorityKeeperTmp
 	var observerKeeper types.ObserverKeeper = observerKeeperTmp
 	var fungibleKeeper types.FungibleKeeper = fungibleKeeperTmp
@@ -159,6 +174,9 @@ func CrosschainKeeperWithMocks(
 	if mockOptions.UseLightclientMock {
 		lightclientKeeper = crosschainmocks.NewCrosschainLightclientKeeper(t)
 	}
+	if mockOptions.UseEmissionsMock {
+		emissionsKeeper = crosschainmocks.NewCrosschainEmissionsKeeper(t)
+	}
 
 	// create crosschain keeper
 	k := keeper.NewKeeper(
@@ -172,6 +190,7 @@ func CrosschainKeeperWithMocks(
 		fungibleKeeper,
 		authorityKeeper,
 		lightclientKeeper,
+		emissionsKeeper,
 	)
 
 	// initialize ibccrosschain keeper and set it to the crosschain keeper
@@ -183,6 +202,7 @@ func CrosschainKeeperWithMocks(
 		stateStore,
 		k,
 		sdkKeepers.TransferKeeper,
+		authorityKeeper,
 		*sdkKeepers.CapabilityKeeper,
 	)
 	if mockOptions.UseIBCCrosschainMock {
@@ -225,6 +245,13 @@ func GetCrosschainLightclientMock(t testing.TB, keeper *keeper.Keeper) *crosscha
 	return lk
 }
 
+// GetCrosschainEmissionsMock returns a new crosschain emissions keeper mock
+func GetCrosschainEmissionsMock(t testing.TB, keeper *keeper.Keeper) *crosschainmocks.CrosschainEmissionsKeeper {
+	cek, ok := keeper.GetEmissionsKeeper().(*crosschainmocks.CrosschainEmissionsKeeper)
+	require.True(t, ok)
+	return cek
+}
+
 // GetCrosschainAuthorityMock returns a new crosschain authority keeper mock
 func GetCrosschainAuthorityMock(t testing.TB, keeper *keeper.Keeper) *crosschainmocks.CrosschainAuthorityKeeper {
 	cok, ok := keeper.GetAuthorityKeeper().(*crosschainmocks.CrosschainAuthorityKeeper)
@@ -262,6 +289,15 @@ func GetCrosschainFungibleMock(t testing.TB, keeper *keeper.Keeper) *crosschainm
 	return cfk
 }
 
+func GetCrosschainIBCCrosschainMock(
+	t testing.TB,
+	keeper *keeper.Keeper,
+) *crosschainmocks.CrosschainIBCCrosschainKeeper {
+	cik, ok := keeper.GetIBCCrosschainKeeper().(*crosschainmocks.CrosschainIBCCrosschainKeeper)
+	require.True(t, ok)
+	return cik
+}
+
 func MockGetSupportedChainFromChainID(m *crosschainmocks.CrosschainObserverKeeper, senderChain *chains.Chain) {
 	m.On("GetSupportedChainFromChainID", mock.Anything, senderChain.ChainId).
 		Return(senderChain).Once()
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	tmdb "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -24,6 +25,33 @@ type EmissionMockOptions struct {
 	SkipSettingParams bool
 }
 
+func initEmissionsKeeper(
+	cdc codec.Codec,
+	db *tmdb.MemDB,
+	ss store.CommitMultiStore,
+	bankKeeper types.BankKeeper,
+	stakingKeeper types.StakingKeeper,
+	observerKeeper types.ObserverKeeper,
+	authKeeper types.AccountKeeper,
+) *keeper.Keeper {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	memKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+	ss.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	ss.MountStoreWithDB(memKey, storetypes.StoreTypeMemory, db)
+
+	return keeper.NewKeeper(
+		cdc,
+		storeKey,
+		memKey,
+		authtypes.FeeCollectorName,
+		bankKeeper,
+		stakingKeeper,
+		observerKeeper,
+		authKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+}
+
 func EmissionsKeeper(t testing.TB) (*keeper.Keeper, sdk.Context, SDKKeepers, ZetaKeepers) {
 	return EmissionKeeperWithMockOptions(t, EmissionMockOptions{})
 }
@@ -19,12 +19,14 @@ import (
 type IBCCroscchainMockOptions struct {
 	UseCrosschainMock  bool
 	UseIBCTransferMock bool
+	UseAuthorityMock   bool
 }
 
 var (
 	IBCCrosschainMocksAll = IBCCroscchainMockOptions{
 		UseCrosschainMock:  true,
 		UseIBCTransferMock: true,
+		UseAuthorityMock:   true,
 	}
 	IBCCrosschainNoMocks = IBCCroscchainMockOptions{}
 )
@@ -35,6 +37,7 @@ func initIBCCrosschainKeeper(
 	ss store.CommitMultiStore,
 	crosschainKeeper types.CrosschainKeeper,
 	ibcTransferKeeper types.IBCTransferKeeper,
+	authorityKeeper types.AuthorityKeeper,
 	capabilityKeeper capabilitykeeper.Keeper,
 ) *keeper.Keeper {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
@@ -50,6 +53,7 @@ func initIBCCrosschainKeeper(
 		memKey,
 		crosschainKeeper,
 		ibcTransferKeeper,
+		authorityKeeper,
 	)
 }
 
@@ -90,6 +94,15 @@ func IBCCrosschainKeeperWithMocks(
 		observerKeeper,
 		authorityKeeper,
 	)
+	emissionsKeeper := initEmissionsKeeper(
+		cdc,
+		db,
+		stateStore,
+		sdkKeepers.BankKeeper,
+		sdkKeepers.StakingKeeper,
+		observerKeeper,
+		sdkKeepers.AuthKeeper,
+	)
 	crosschainKeeperTmp := initCrosschainKeeper(
 		cdc,
 		db,
@@ -101,6 +114,7 @@ func IBCCrosschainKeeperWithMocks(
 		fungibleKeeper,
 		authorityKeeper,
 		lightclientKeeper,
+		emissionsKeeper,
 	)
 
 	zetaKeepers := ZetaKeepers{
@@ -108,11 +122,13 @@ func IBCCrosschainKeeperWithMocks(
 		FungibleKeeper:    fungibleKeeper,
 		AuthorityKeeper:   &authorityKeeper,
 		LightclientKeeper: &lightclientKeeper,
+		EmissionsKeeper:   emissionsKeeper,
 		CrosschainKeeper:  crosschainKeeperTmp,
 	}
 
 	var crosschainKeeper types.CrosschainKeeper = crosschainKeeperTmp
 	var ibcTransferKeeper types.IBCTransferKeeper = sdkKeepers.TransferKeeper
+	var ibcAuthorityKeeper types.AuthorityKeeper = authorityKeeper
 
 	// Create the ibccrosschain keeper
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
@@ -135,6 +151,9 @@ func IBCCrosschainKeeperWithMocks(
 	if mockOptions.UseIBCTransferMock {
 		ibcTransferKeeper = ibccrosschainmocks.NewLightclientTransferKeeper(t)
 	}
+	if mockOptions.UseAuthorityMock {
+		ibcAuthorityKeeper = ibccrosschainmocks.NewIBCCrosschainAuthorityKeeper(t)
+	}
 
 	sdkKeepers.CapabilityKeeper.ScopeToModule(types.ModuleName)
 
@@ -144,6 +163,7 @@ func IBCCrosschainKeeperWithMocks(
 		memStoreKey,
 		crosschainKeeper,
 		ibcTransferKeeper,
+		ibcAuthorityKeeper,
 	)
 
 	// seal the IBC router
@@ -162,3 +182,24 @@ func IBCCrosschainKeeperAllMocks(t testing.TB) (*keeper.Keeper, sdk.Context) {
 func IBCCrosschainKeeper(t testing.TB) (*keeper.Keeper, sdk.Context, SDKKeepers, ZetaKeepers) {
 	return IBCCrosschainKeeperWithMocks(t, IBCCrosschainNoMocks)
 }
+
+// GetIBCCrosschainCrosschainMock returns a new ibccrosschain crosschain keeper mock
+func GetIBCCrosschainCrosschainMock(t testing.TB, keeper *keeper.Keeper) *ibccrosschainmocks.LightclientCrosschainKeeper {
+	cok, ok := keeper.GetCrosschainKeeper().(*ibccrosschainmocks.LightclientCrosschainKeeper)
+	require.True(t, ok)
+	return cok
+}
+
+// GetIBCCrosschainTransferMock returns a new ibccrosschain transfer keeper mock
+func GetIBCCrosschainTransferMock(t testing.TB, keeper *keeper.Keeper) *ibccrosschainmocks.LightclientTransferKeeper {
+	cok, ok := keeper.GetIBCTransferKeeper().(*ibccrosschainmocks.LightclientTransferKeeper)
+	require.True(t, ok)
+	return cok
+}
+
+// GetIBCCrosschainAuthorityMock returns a new ibccrosschain authority keeper mock
+func GetIBCCrosschainAuthorityMock(t testing.TB, keeper *keeper.Keeper) *ibccrosschainmocks.IBCCrosschainAuthorityKeeper {
+	cok, ok := keeper.GetAuthorityKeeper().(*ibccrosschainmocks.IBCCrosschainAuthorityKeeper)
+	require.True(t, ok)
+	return cok
+}
@@ -2,13 +2,36 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	mock "github.com/stretchr/testify/mock"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+)
 
 // CrosschainIBCCrosschainKeeper is an autogenerated mock type for the CrosschainIBCCrosschainKeeper type
 type CrosschainIBCCrosschainKeeper struct {
 	mock.Mock
 }
 
+// InitiateOutbound provides a mock function with given fields: ctx, cctx
+func (_m *CrosschainIBCCrosschainKeeper) InitiateOutbound(ctx types.Context, cctx *crosschaintypes.CrossChainTx) error {
+	ret := _m.Called(ctx, cctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InitiateOutbound")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *crosschaintypes.CrossChainTx) error); ok {
+		r0 = rf(ctx, cctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewCrosschainIBCCrosschainKeeper creates a new instance of CrosschainIBCCrosschainKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewCrosschainIBCCrosschainKeeper(t interface {
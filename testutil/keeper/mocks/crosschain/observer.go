@@ -109,6 +109,11 @@ func (_m *CrosschainObserverKeeper) CheckIfTssPubkeyHasBeenGenerated(ctx types.C
 	return r0, r1
 }
 
+// DeleteBallot provides a mock function with given fields: ctx, index
+func (_m *CrosschainObserverKeeper) DeleteBallot(ctx types.Context, index string) {
+	_m.Called(ctx, index)
+}
+
 // FindBallot provides a mock function with given fields: ctx, index, chain, observationType
 func (_m *CrosschainObserverKeeper) FindBallot(ctx types.Context, index string, chain *chains.Chain, observationType observertypes.ObservationType) (observertypes.Ballot, bool, error) {
 	ret := _m.Called(ctx, index, chain, observationType)
@@ -2,13 +2,92 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	mock "github.com/stretchr/testify/mock"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+)
 
 // LightclientCrosschainKeeper is an autogenerated mock type for the LightclientCrosschainKeeper type
 type LightclientCrosschainKeeper struct {
 	mock.Mock
 }
 
+// GetCrossChainTx provides a mock function with given fields: ctx, index
+func (_m *LightclientCrosschainKeeper) GetCrossChainTx(ctx types.Context, index string) (crosschaintypes.CrossChainTx, bool) {
+	ret := _m.Called(ctx, index)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCrossChainTx")
+	}
+
+	var r0 crosschaintypes.CrossChainTx
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(types.Context, string) (crosschaintypes.CrossChainTx, bool)); ok {
+		return rf(ctx, index)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, string) crosschaintypes.CrossChainTx); ok {
+		r0 = rf(ctx, index)
+	} else {
+		r0 = ret.Get(0).(crosschaintypes.CrossChainTx)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, string) bool); ok {
+		r1 = rf(ctx, index)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// ProcessIBCInbound provides a mock function with given fields: ctx, msg
+func (_m *LightclientCrosschainKeeper) ProcessIBCInbound(ctx types.Context, msg crosschaintypes.MsgVoteInbound) (crosschaintypes.CrossChainTx, error) {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessIBCInbound")
+	}
+
+	var r0 crosschaintypes.CrossChainTx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, crosschaintypes.MsgVoteInbound) (crosschaintypes.CrossChainTx, error)); ok {
+		return rf(ctx, msg)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, crosschaintypes.MsgVoteInbound) crosschaintypes.CrossChainTx); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Get(0).(crosschaintypes.CrossChainTx)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, crosschaintypes.MsgVoteInbound) error); ok {
+		r1 = rf(ctx, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProcessIBCOutbound provides a mock function with given fields: ctx, cctx, success, message
+func (_m *LightclientCrosschainKeeper) ProcessIBCOutbound(ctx types.Context, cctx *crosschaintypes.CrossChainTx, success bool, message string) error {
+	ret := _m.Called(ctx, cctx, success, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessIBCOutbound")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, *crosschaintypes.CrossChainTx, bool, string) error); ok {
+		r0 = rf(ctx, cctx, success, message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewLightclientCrosschainKeeper creates a new instance of LightclientCrosschainKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewLightclientCrosschainKeeper(t interface {
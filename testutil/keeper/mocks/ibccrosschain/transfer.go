@@ -2,13 +2,49 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
 
 // LightclientTransferKeeper is an autogenerated mock type for the LightclientTransferKeeper type
 type LightclientTransferKeeper struct {
 	mock.Mock
 }
 
+// Transfer provides a mock function with given fields: goCtx, msg
+func (_m *LightclientTransferKeeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
+	ret := _m.Called(goCtx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transfer")
+	}
+
+	var r0 *types.MsgTransferResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MsgTransfer) (*types.MsgTransferResponse, error)); ok {
+		return rf(goCtx, msg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MsgTransfer) *types.MsgTransferResponse); ok {
+		r0 = rf(goCtx, msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MsgTransferResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.MsgTransfer) error); ok {
+		r1 = rf(goCtx, msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewLightclientTransferKeeper creates a new instance of LightclientTransferKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewLightclientTransferKeeper(t interface {
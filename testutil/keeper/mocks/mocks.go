@@ -48,6 +48,11 @@ type CrosschainLightclientKeeper interface {
 	crosschaintypes.LightclientKeeper
 }
 
+//go:generate mockery --name CrosschainEmissionsKeeper --filename emissions.go --case underscore --output ./crosschain
+type CrosschainEmissionsKeeper interface {
+	crosschaintypes.EmissionsKeeper
+}
+
 //go:generate mockery --name CrosschainIBCCrosschainKeeper --filename ibccrosschain.go --case underscore --output ./crosschain
 type CrosschainIBCCrosschainKeeper interface {
 	crosschaintypes.IBCCrosschainKeeper
@@ -152,3 +157,8 @@ type LightclientCrosschainKeeper interface {
 type LightclientTransferKeeper interface {
 	ibccrosschaintypes.IBCTransferKeeper
 }
+
+//go:generate mockery --name IBCCrosschainAuthorityKeeper --filename authority.go --case underscore --output ./ibccrosschain
+type IBCCrosschainAuthorityKeeper interface {
+	ibccrosschaintypes.AuthorityKeeper
+}
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	chains "github.com/zeta-chain/zetacore/pkg/chains"
+
 	mock "github.com/stretchr/testify/mock"
 	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
 
@@ -14,6 +16,26 @@ type ObserverAuthorityKeeper struct {
 	mock.Mock
 }
 
+// GetAdditionalChainList provides a mock function with given fields: ctx
+func (_m *ObserverAuthorityKeeper) GetAdditionalChainList(ctx types.Context) []chains.Chain {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAdditionalChainList")
+	}
+
+	var r0 []chains.Chain
+	if rf, ok := ret.Get(0).(func(types.Context) []chains.Chain); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]chains.Chain)
+		}
+	}
+
+	return r0
+}
+
 // IsAuthorized provides a mock function with given fields: ctx, address, policyType
 func (_m *ObserverAuthorityKeeper) IsAuthorized(ctx types.Context, address string, policyType authoritytypes.PolicyType) bool {
 	ret := _m.Called(ctx, address, policyType)
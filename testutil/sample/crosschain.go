@@ -51,6 +51,17 @@ func RateLimiterFlags() types.RateLimiterFlags {
 	}
 }
 
+// CctxRetentionPolicy returns a sample enabled cctx retention policy
+func CctxRetentionPolicy() types.CctxRetentionPolicy {
+	r := Rand()
+
+	return types.CctxRetentionPolicy{
+		Enabled:           true,
+		RetentionBlocks:   types.MinCctxRetentionBlocks + uint64(r.Int63n(100000)),
+		MaxPrunedPerBlock: uint32(r.Int63n(types.MaxCctxPrunedPerBlock)) + 1,
+	}
+}
+
 // CustomRateLimiterFlags creates a custom rate limiter flags with the given parameters
 func CustomRateLimiterFlags(
 	enabled bool,
@@ -269,6 +280,15 @@ func InboundHashToCctx(t *testing.T, inboundHash string) types.InboundHashToCctx
 	}
 }
 
+func OutboundHashToCctx(t *testing.T, outboundHash string) types.OutboundHashToCctx {
+	r := newRandFromStringSeed(t, outboundHash)
+
+	return types.OutboundHashToCctx{
+		OutboundHash: outboundHash,
+		CctxIndex:    []string{StringRandom(r, 32), StringRandom(r, 32)},
+	}
+}
+
 func ZetaAccounting(t *testing.T, index string) types.ZetaAccounting {
 	r := newRandFromStringSeed(t, index)
 	return types.ZetaAccounting{
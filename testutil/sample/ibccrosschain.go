@@ -0,0 +1,38 @@
+package sample
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	ibccrosschaintypes "github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+// IBCChain returns a sample Cosmos chain reached through IBC
+func IBCChain(chainID int64) chains.Chain {
+	return chains.Chain{
+		ChainId:     chainID,
+		ChainName:   chains.ChainName_empty,
+		Network:     chains.Network_cosmos,
+		NetworkType: chains.NetworkType_testnet,
+		Vm:          chains.Vm_no_vm,
+		Consensus:   chains.Consensus_tendermint,
+		IsExternal:  true,
+		CctxGateway: chains.CCTXGateway_ibc,
+	}
+}
+
+func ChainChannel(chainID int64) ibccrosschaintypes.ChainChannel {
+	return ibccrosschaintypes.ChainChannel{
+		ChainId:   chainID,
+		ChannelId: fmt.Sprintf("channel-%d", chainID),
+	}
+}
+
+func OutboundPacket(t *testing.T, channelID string, sequence uint64) ibccrosschaintypes.OutboundPacket {
+	return ibccrosschaintypes.OutboundPacket{
+		ChannelId: channelID,
+		Sequence:  sequence,
+		CctxIndex: ZetaIndex(t),
+	}
+}
@@ -0,0 +1,50 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file zetachain/zetacore/crosschain/cctx_retention_policy.proto (package zetachain.zetacore.crosschain, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * CctxRetentionPolicy defines when the cctxs in a terminal state are pruned
+ * from the state, an archival event with the cctx is emitted when pruned
+ *
+ * @generated from message zetachain.zetacore.crosschain.CctxRetentionPolicy
+ */
+export declare class CctxRetentionPolicy extends Message<CctxRetentionPolicy> {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * number of blocks the cctxs are kept after the zeta height they were
+   * created at
+   *
+   * @generated from field: uint64 retention_blocks = 2;
+   */
+  retentionBlocks: bigint;
+
+  /**
+   * maximum number of cctxs pruned per block
+   *
+   * @generated from field: uint32 max_pruned_per_block = 3;
+   */
+  maxPrunedPerBlock: number;
+
+  constructor(data?: PartialMessage<CctxRetentionPolicy>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.CctxRetentionPolicy";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CctxRetentionPolicy;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CctxRetentionPolicy;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CctxRetentionPolicy;
+
+  static equals(a: CctxRetentionPolicy | PlainMessage<CctxRetentionPolicy> | undefined, b: CctxRetentionPolicy | PlainMessage<CctxRetentionPolicy> | undefined): boolean;
+}
+
@@ -147,6 +147,14 @@ export declare class InboundParams extends Message<InboundParams> {
    */
   txFinalizationStatus: TxFinalizationStatus;
 
+  /**
+   * address on the sender chain receiving the reverted funds, the sender if
+   * empty
+   *
+   * @generated from field: string revert_address = 12;
+   */
+  revertAddress: string;
+
   constructor(data?: PartialMessage<InboundParams>);
 
   static readonly runtime: typeof proto3;
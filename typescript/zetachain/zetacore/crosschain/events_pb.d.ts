@@ -5,6 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
+import type { CrossChainTx } from "./cross_chain_tx_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.crosschain.EventInboundFinalized
@@ -354,3 +355,35 @@ export declare class EventERC20Whitelist extends Message<EventERC20Whitelist> {
   static equals(a: EventERC20Whitelist | PlainMessage<EventERC20Whitelist> | undefined, b: EventERC20Whitelist | PlainMessage<EventERC20Whitelist> | undefined): boolean;
 }
 
+/**
+ * EventCCTXArchived is emitted with the full cctx when it is pruned from the
+ * state by the retention policy
+ *
+ * @generated from message zetachain.zetacore.crosschain.EventCCTXArchived
+ */
+export declare class EventCCTXArchived extends Message<EventCCTXArchived> {
+  /**
+   * @generated from field: string cctx_index = 1;
+   */
+  cctxIndex: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.CrossChainTx cctx = 2;
+   */
+  cctx?: CrossChainTx;
+
+  constructor(data?: PartialMessage<EventCCTXArchived>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.EventCCTXArchived";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventCCTXArchived;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventCCTXArchived;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventCCTXArchived;
+
+  static equals(a: EventCCTXArchived | PlainMessage<EventCCTXArchived> | undefined, b: EventCCTXArchived | PlainMessage<EventCCTXArchived> | undefined): boolean;
+}
+
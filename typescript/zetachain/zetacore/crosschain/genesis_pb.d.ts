@@ -12,6 +12,8 @@ import type { LastBlockHeight } from "./last_block_height_pb.js";
 import type { InboundHashToCctx } from "./inbound_hash_to_cctx_pb.js";
 import type { InboundTracker } from "./inbound_tracker_pb.js";
 import type { RateLimiterFlags } from "./rate_limiter_flags_pb.js";
+import type { OutboundHashToCctx } from "./outbound_hash_to_cctx_pb.js";
+import type { CctxRetentionPolicy } from "./cctx_retention_policy_pb.js";
 
 /**
  * GenesisState defines the metacore module's genesis state.
@@ -64,6 +66,16 @@ export declare class GenesisState extends Message<GenesisState> {
    */
   rateLimiterFlags?: RateLimiterFlags;
 
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.OutboundHashToCctx outbound_hash_to_cctx_list = 18;
+   */
+  outboundHashToCctxList: OutboundHashToCctx[];
+
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.CctxRetentionPolicy cctx_retention_policy = 19;
+   */
+  cctxRetentionPolicy?: CctxRetentionPolicy;
+
   constructor(data?: PartialMessage<GenesisState>);
 
   static readonly runtime: typeof proto3;
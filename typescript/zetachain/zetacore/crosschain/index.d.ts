@@ -1,3 +1,4 @@
+export * from "./cctx_retention_policy_pb";
 export * from "./cross_chain_tx_pb";
 export * from "./events_pb";
 export * from "./gas_price_pb";
@@ -5,6 +6,7 @@ export * from "./genesis_pb";
 export * from "./inbound_hash_to_cctx_pb";
 export * from "./inbound_tracker_pb";
 export * from "./last_block_height_pb";
+export * from "./outbound_hash_to_cctx_pb";
 export * from "./outbound_tracker_pb";
 export * from "./query_pb";
 export * from "./rate_limiter_flags_pb";
@@ -0,0 +1,40 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file zetachain/zetacore/crosschain/outbound_hash_to_cctx.proto (package zetachain.zetacore.crosschain, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * OutboundHashToCctx maps an observed outbound hash to the cctxs it processes
+ * a single outbound can process several cctxs (e.g. batched Bitcoin withdrawals)
+ *
+ * @generated from message zetachain.zetacore.crosschain.OutboundHashToCctx
+ */
+export declare class OutboundHashToCctx extends Message<OutboundHashToCctx> {
+  /**
+   * @generated from field: string inbound_hash = 1;
+   */
+  outboundHash: string;
+
+  /**
+   * @generated from field: repeated string cctx_index = 2;
+   */
+  cctxIndex: string[];
+
+  constructor(data?: PartialMessage<OutboundHashToCctx>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.OutboundHashToCctx";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OutboundHashToCctx;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OutboundHashToCctx;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OutboundHashToCctx;
+
+  static equals(a: OutboundHashToCctx | PlainMessage<OutboundHashToCctx> | undefined, b: OutboundHashToCctx | PlainMessage<OutboundHashToCctx> | undefined): boolean;
+}
+
@@ -9,10 +9,11 @@ import type { OutboundTracker } from "./outbound_tracker_pb.js";
 import type { PageRequest, PageResponse } from "../../../cosmos/base/query/v1beta1/pagination_pb.js";
 import type { InboundTracker } from "./inbound_tracker_pb.js";
 import type { InboundHashToCctx } from "./inbound_hash_to_cctx_pb.js";
-import type { CrossChainTx } from "./cross_chain_tx_pb.js";
+import type { CctxStatus, CrossChainTx } from "./cross_chain_tx_pb.js";
 import type { GasPrice } from "./gas_price_pb.js";
 import type { LastBlockHeight } from "./last_block_height_pb.js";
-import type { RateLimiterFlags } from "./rate_limiter_flags_pb.js";
+import type { RateLimitBucket, RateLimitBucketUsage, RateLimiterFlags } from "./rate_limiter_flags_pb.js";
+import type { CctxRetentionPolicy } from "./cctx_retention_policy_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryZetaAccountingRequest
@@ -413,6 +414,14 @@ export declare class QueryInboundHashToCctxDataResponse extends Message<QueryInb
    */
   CrossChainTxs: CrossChainTx[];
 
+  /**
+   * indexes of the cctxs pruned by the retention policy, the cctxs are
+   * available from their EventCCTXArchived event
+   *
+   * @generated from field: repeated string pruned_cctx_indexes = 2;
+   */
+  prunedCctxIndexes: string[];
+
   constructor(data?: PartialMessage<QueryInboundHashToCctxDataResponse>);
 
   static readonly runtime: typeof proto3;
@@ -481,6 +490,54 @@ export declare class QueryAllInboundHashToCctxResponse extends Message<QueryAllI
   static equals(a: QueryAllInboundHashToCctxResponse | PlainMessage<QueryAllInboundHashToCctxResponse> | undefined, b: QueryAllInboundHashToCctxResponse | PlainMessage<QueryAllInboundHashToCctxResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryOutboundHashToCctxDataRequest
+ */
+export declare class QueryOutboundHashToCctxDataRequest extends Message<QueryOutboundHashToCctxDataRequest> {
+  /**
+   * @generated from field: string outboundHash = 1;
+   */
+  outboundHash: string;
+
+  constructor(data?: PartialMessage<QueryOutboundHashToCctxDataRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryOutboundHashToCctxDataRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryOutboundHashToCctxDataRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryOutboundHashToCctxDataRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryOutboundHashToCctxDataRequest;
+
+  static equals(a: QueryOutboundHashToCctxDataRequest | PlainMessage<QueryOutboundHashToCctxDataRequest> | undefined, b: QueryOutboundHashToCctxDataRequest | PlainMessage<QueryOutboundHashToCctxDataRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryOutboundHashToCctxDataResponse
+ */
+export declare class QueryOutboundHashToCctxDataResponse extends Message<QueryOutboundHashToCctxDataResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.CrossChainTx CrossChainTxs = 1;
+   */
+  CrossChainTxs: CrossChainTx[];
+
+  constructor(data?: PartialMessage<QueryOutboundHashToCctxDataResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryOutboundHashToCctxDataResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryOutboundHashToCctxDataResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryOutboundHashToCctxDataResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryOutboundHashToCctxDataResponse;
+
+  static equals(a: QueryOutboundHashToCctxDataResponse | PlainMessage<QueryOutboundHashToCctxDataResponse> | undefined, b: QueryOutboundHashToCctxDataResponse | PlainMessage<QueryOutboundHashToCctxDataResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryGetGasPriceRequest
  */
@@ -813,6 +870,76 @@ export declare class QueryAllCctxResponse extends Message<QueryAllCctxResponse>
   static equals(a: QueryAllCctxResponse | PlainMessage<QueryAllCctxResponse> | undefined, b: QueryAllCctxResponse | PlainMessage<QueryAllCctxResponse> | undefined): boolean;
 }
 
+/**
+ * QueryAllCctxByFilterRequest combines the filters of the cctxs, a zero value
+ * matches all the cctxs
+ *
+ * @generated from message zetachain.zetacore.crosschain.QueryAllCctxByFilterRequest
+ */
+export declare class QueryAllCctxByFilterRequest extends Message<QueryAllCctxByFilterRequest> {
+  /**
+   * sender of the inbound
+   *
+   * @generated from field: string sender = 1;
+   */
+  sender: string;
+
+  /**
+   * receiver of the first outbound
+   *
+   * @generated from field: string receiver = 2;
+   */
+  receiver: string;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.CctxStatus statuses = 3;
+   */
+  statuses: CctxStatus[];
+
+  /**
+   * @generated from field: int64 sender_chain_id = 4;
+   */
+  senderChainId: bigint;
+
+  /**
+   * receiver chain of the first outbound
+   *
+   * @generated from field: int64 receiver_chain_id = 5;
+   */
+  receiverChainId: bigint;
+
+  /**
+   * range of the zeta heights the cctxs were created at
+   *
+   * @generated from field: uint64 min_height = 6;
+   */
+  minHeight: bigint;
+
+  /**
+   * @generated from field: uint64 max_height = 7;
+   */
+  maxHeight: bigint;
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 8;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryAllCctxByFilterRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryAllCctxByFilterRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllCctxByFilterRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllCctxByFilterRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllCctxByFilterRequest;
+
+  static equals(a: QueryAllCctxByFilterRequest | PlainMessage<QueryAllCctxByFilterRequest> | undefined, b: QueryAllCctxByFilterRequest | PlainMessage<QueryAllCctxByFilterRequest> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryListPendingCctxRequest
  */
@@ -939,6 +1066,11 @@ export declare class QueryRateLimiterInputResponse extends Message<QueryRateLimi
    */
   lowestPendingCctxHeight: bigint;
 
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.RateLimitBucketUsage bucket_usages = 8;
+   */
+  bucketUsages: RateLimitBucketUsage[];
+
   constructor(data?: PartialMessage<QueryRateLimiterInputResponse>);
 
   static readonly runtime: typeof proto3;
@@ -954,6 +1086,105 @@ export declare class QueryRateLimiterInputResponse extends Message<QueryRateLimi
   static equals(a: QueryRateLimiterInputResponse | PlainMessage<QueryRateLimiterInputResponse> | undefined, b: QueryRateLimiterInputResponse | PlainMessage<QueryRateLimiterInputResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryRateLimiterUsageRequest
+ */
+export declare class QueryRateLimiterUsageRequest extends Message<QueryRateLimiterUsageRequest> {
+  constructor(data?: PartialMessage<QueryRateLimiterUsageRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryRateLimiterUsageRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryRateLimiterUsageRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryRateLimiterUsageRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryRateLimiterUsageRequest;
+
+  static equals(a: QueryRateLimiterUsageRequest | PlainMessage<QueryRateLimiterUsageRequest> | undefined, b: QueryRateLimiterUsageRequest | PlainMessage<QueryRateLimiterUsageRequest> | undefined): boolean;
+}
+
+/**
+ * RateLimitBucketUtilization is the current utilization of a rate limit bucket
+ *
+ * @generated from message zetachain.zetacore.crosschain.RateLimitBucketUtilization
+ */
+export declare class RateLimitBucketUtilization extends Message<RateLimitBucketUtilization> {
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.RateLimitBucket bucket = 1;
+   */
+  bucket?: RateLimitBucket;
+
+  /**
+   * @generated from field: int64 current_withdraw_window = 2;
+   */
+  currentWithdrawWindow: bigint;
+
+  /**
+   * @generated from field: string current_withdraw_limit = 3;
+   */
+  currentWithdrawLimit: string;
+
+  /**
+   * @generated from field: string current_withdraw_value = 4;
+   */
+  currentWithdrawValue: string;
+
+  /**
+   * @generated from field: string current_withdraw_rate = 5;
+   */
+  currentWithdrawRate: string;
+
+  /**
+   * @generated from field: bool rate_limit_exceeded = 6;
+   */
+  rateLimitExceeded: boolean;
+
+  constructor(data?: PartialMessage<RateLimitBucketUtilization>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.RateLimitBucketUtilization";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RateLimitBucketUtilization;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RateLimitBucketUtilization;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RateLimitBucketUtilization;
+
+  static equals(a: RateLimitBucketUtilization | PlainMessage<RateLimitBucketUtilization> | undefined, b: RateLimitBucketUtilization | PlainMessage<RateLimitBucketUtilization> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryRateLimiterUsageResponse
+ */
+export declare class QueryRateLimiterUsageResponse extends Message<QueryRateLimiterUsageResponse> {
+  /**
+   * @generated from field: int64 height = 1;
+   */
+  height: bigint;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.RateLimitBucketUtilization buckets = 2;
+   */
+  buckets: RateLimitBucketUtilization[];
+
+  constructor(data?: PartialMessage<QueryRateLimiterUsageResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryRateLimiterUsageResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryRateLimiterUsageResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryRateLimiterUsageResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryRateLimiterUsageResponse;
+
+  static equals(a: QueryRateLimiterUsageResponse | PlainMessage<QueryRateLimiterUsageResponse> | undefined, b: QueryRateLimiterUsageResponse | PlainMessage<QueryRateLimiterUsageResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryListPendingCctxWithinRateLimitRequest
  */
@@ -1214,3 +1445,46 @@ export declare class QueryRateLimiterFlagsResponse extends Message<QueryRateLimi
   static equals(a: QueryRateLimiterFlagsResponse | PlainMessage<QueryRateLimiterFlagsResponse> | undefined, b: QueryRateLimiterFlagsResponse | PlainMessage<QueryRateLimiterFlagsResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryCctxRetentionPolicyRequest
+ */
+export declare class QueryCctxRetentionPolicyRequest extends Message<QueryCctxRetentionPolicyRequest> {
+  constructor(data?: PartialMessage<QueryCctxRetentionPolicyRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryCctxRetentionPolicyRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryCctxRetentionPolicyRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryCctxRetentionPolicyRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryCctxRetentionPolicyRequest;
+
+  static equals(a: QueryCctxRetentionPolicyRequest | PlainMessage<QueryCctxRetentionPolicyRequest> | undefined, b: QueryCctxRetentionPolicyRequest | PlainMessage<QueryCctxRetentionPolicyRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryCctxRetentionPolicyResponse
+ */
+export declare class QueryCctxRetentionPolicyResponse extends Message<QueryCctxRetentionPolicyResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.CctxRetentionPolicy cctx_retention_policy = 1;
+   */
+  cctxRetentionPolicy?: CctxRetentionPolicy;
+
+  constructor(data?: PartialMessage<QueryCctxRetentionPolicyResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryCctxRetentionPolicyResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryCctxRetentionPolicyResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryCctxRetentionPolicyResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryCctxRetentionPolicyResponse;
+
+  static equals(a: QueryCctxRetentionPolicyResponse | PlainMessage<QueryCctxRetentionPolicyResponse> | undefined, b: QueryCctxRetentionPolicyResponse | PlainMessage<QueryCctxRetentionPolicyResponse> | undefined): boolean;
+}
+
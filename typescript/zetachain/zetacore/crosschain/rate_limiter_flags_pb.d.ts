@@ -7,6 +7,42 @@ import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialM
 import { Message, proto3 } from "@bufbuild/protobuf";
 import type { CoinType } from "../pkg/coin/coin_pb.js";
 
+/**
+ * RateLimitBucketType is the type of the withdrawals a rate limit bucket
+ * applies to
+ *
+ * @generated from enum zetachain.zetacore.crosschain.RateLimitBucketType
+ */
+export declare enum RateLimitBucketType {
+  /**
+   * all withdrawals, valued in azeta
+   *
+   * @generated from enum value: Global = 0;
+   */
+  Global = 0,
+
+  /**
+   * withdrawals to a receiver chain, valued in azeta
+   *
+   * @generated from enum value: Chain = 1;
+   */
+  Chain = 1,
+
+  /**
+   * withdrawals of an asset, valued in azeta
+   *
+   * @generated from enum value: Asset = 2;
+   */
+  Asset = 2,
+
+  /**
+   * withdrawals of an asset, valued in native units
+   *
+   * @generated from enum value: AssetNative = 3;
+   */
+  AssetNative = 3,
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.RateLimiterFlags
  */
@@ -37,6 +73,20 @@ export declare class RateLimiterFlags extends Message<RateLimiterFlags> {
    */
   conversions: Conversion[];
 
+  /**
+   * rate limits of the withdrawals to a receiver chain
+   *
+   * @generated from field: repeated zetachain.zetacore.crosschain.ChainRateLimit chain_rate_limits = 5;
+   */
+  chainRateLimits: ChainRateLimit[];
+
+  /**
+   * rate limits of the withdrawals of a zrc20 asset
+   *
+   * @generated from field: repeated zetachain.zetacore.crosschain.AssetRateLimit asset_rate_limits = 6;
+   */
+  assetRateLimits: AssetRateLimit[];
+
   constructor(data?: PartialMessage<RateLimiterFlags>);
 
   static readonly runtime: typeof proto3;
@@ -52,6 +102,204 @@ export declare class RateLimiterFlags extends Message<RateLimiterFlags> {
   static equals(a: RateLimiterFlags | PlainMessage<RateLimiterFlags> | undefined, b: RateLimiterFlags | PlainMessage<RateLimiterFlags> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.ChainRateLimit
+ */
+export declare class ChainRateLimit extends Message<ChainRateLimit> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * window in blocks
+   *
+   * @generated from field: int64 window = 2;
+   */
+  window: bigint;
+
+  /**
+   * rate in azeta per block
+   *
+   * @generated from field: string rate = 3;
+   */
+  rate: string;
+
+  constructor(data?: PartialMessage<ChainRateLimit>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.ChainRateLimit";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChainRateLimit;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChainRateLimit;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChainRateLimit;
+
+  static equals(a: ChainRateLimit | PlainMessage<ChainRateLimit> | undefined, b: ChainRateLimit | PlainMessage<ChainRateLimit> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.AssetRateLimit
+ */
+export declare class AssetRateLimit extends Message<AssetRateLimit> {
+  /**
+   * @generated from field: string zrc20 = 1;
+   */
+  zrc20: string;
+
+  /**
+   * window in blocks
+   *
+   * @generated from field: int64 window = 2;
+   */
+  window: bigint;
+
+  /**
+   * rate in azeta per block, the asset is not limited in azeta if zero
+   *
+   * @generated from field: string rate = 3;
+   */
+  rate: string;
+
+  /**
+   * rate in native units of the asset per block, the asset is not limited in
+   * native units if zero
+   *
+   * @generated from field: string native_rate = 4;
+   */
+  nativeRate: string;
+
+  constructor(data?: PartialMessage<AssetRateLimit>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.AssetRateLimit";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AssetRateLimit;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AssetRateLimit;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AssetRateLimit;
+
+  static equals(a: AssetRateLimit | PlainMessage<AssetRateLimit> | undefined, b: AssetRateLimit | PlainMessage<AssetRateLimit> | undefined): boolean;
+}
+
+/**
+ * RateLimitBucket is a rate limit applied to a set of withdrawals
+ *
+ * @generated from message zetachain.zetacore.crosschain.RateLimitBucket
+ */
+export declare class RateLimitBucket extends Message<RateLimitBucket> {
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.RateLimitBucketType type = 1;
+   */
+  type: RateLimitBucketType;
+
+  /**
+   * receiver chain of the withdrawals, zero for the global bucket
+   *
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * zrc20 of the asset buckets
+   *
+   * @generated from field: string zrc20 = 3;
+   */
+  zrc20: string;
+
+  /**
+   * coin type and asset of the foreign coin of the asset buckets
+   *
+   * @generated from field: zetachain.zetacore.pkg.coin.CoinType coin_type = 4;
+   */
+  coinType: CoinType;
+
+  /**
+   * @generated from field: string asset = 5;
+   */
+  asset: string;
+
+  /**
+   * window in blocks
+   *
+   * @generated from field: int64 window = 6;
+   */
+  window: bigint;
+
+  /**
+   * rate per block in the unit of the bucket
+   *
+   * @generated from field: string rate = 7;
+   */
+  rate: string;
+
+  constructor(data?: PartialMessage<RateLimitBucket>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.RateLimitBucket";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RateLimitBucket;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RateLimitBucket;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RateLimitBucket;
+
+  static equals(a: RateLimitBucket | PlainMessage<RateLimitBucket> | undefined, b: RateLimitBucket | PlainMessage<RateLimitBucket> | undefined): boolean;
+}
+
+/**
+ * RateLimitBucketUsage is the value of the withdrawals of a bucket in the unit
+ * of the bucket
+ *
+ * @generated from message zetachain.zetacore.crosschain.RateLimitBucketUsage
+ */
+export declare class RateLimitBucketUsage extends Message<RateLimitBucketUsage> {
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.RateLimitBucket bucket = 1;
+   */
+  bucket?: RateLimitBucket;
+
+  /**
+   * total value of the past cctxs within the window of the bucket
+   *
+   * @generated from field: string past_cctxs_value = 2;
+   */
+  pastCctxsValue: string;
+
+  /**
+   * total value of the pending cctxs
+   *
+   * @generated from field: string pending_cctxs_value = 3;
+   */
+  pendingCctxsValue: string;
+
+  /**
+   * the lowest height of the pending cctxs of the bucket
+   *
+   * @generated from field: int64 lowest_pending_cctx_height = 4;
+   */
+  lowestPendingCctxHeight: bigint;
+
+  constructor(data?: PartialMessage<RateLimitBucketUsage>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.RateLimitBucketUsage";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RateLimitBucketUsage;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RateLimitBucketUsage;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RateLimitBucketUsage;
+
+  static equals(a: RateLimitBucketUsage | PlainMessage<RateLimitBucketUsage> | undefined, b: RateLimitBucketUsage | PlainMessage<RateLimitBucketUsage> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.Conversion
  */
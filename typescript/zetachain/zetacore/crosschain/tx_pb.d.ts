@@ -9,6 +9,7 @@ import type { CoinType } from "../pkg/coin/coin_pb.js";
 import type { Proof } from "../pkg/proofs/proofs_pb.js";
 import type { ReceiveStatus } from "../pkg/chains/chains_pb.js";
 import type { RateLimiterFlags } from "./rate_limiter_flags_pb.js";
+import type { CctxRetentionPolicy } from "./cctx_retention_policy_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgMigrateTssFunds
@@ -641,6 +642,14 @@ export declare class MsgVoteInbound extends Message<MsgVoteInbound> {
    */
   eventIndex: bigint;
 
+  /**
+   * address on the sender chain receiving the reverted funds, the sender if
+   * empty
+   *
+   * @generated from field: string revert_address = 16;
+   */
+  revertAddress: string;
+
   constructor(data?: PartialMessage<MsgVoteInbound>);
 
   static readonly runtime: typeof proto3;
@@ -826,3 +835,51 @@ export declare class MsgUpdateRateLimiterFlagsResponse extends Message<MsgUpdate
   static equals(a: MsgUpdateRateLimiterFlagsResponse | PlainMessage<MsgUpdateRateLimiterFlagsResponse> | undefined, b: MsgUpdateRateLimiterFlagsResponse | PlainMessage<MsgUpdateRateLimiterFlagsResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgUpdateCctxRetentionPolicy
+ */
+export declare class MsgUpdateCctxRetentionPolicy extends Message<MsgUpdateCctxRetentionPolicy> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.CctxRetentionPolicy cctx_retention_policy = 2;
+   */
+  cctxRetentionPolicy?: CctxRetentionPolicy;
+
+  constructor(data?: PartialMessage<MsgUpdateCctxRetentionPolicy>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgUpdateCctxRetentionPolicy";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateCctxRetentionPolicy;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateCctxRetentionPolicy;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateCctxRetentionPolicy;
+
+  static equals(a: MsgUpdateCctxRetentionPolicy | PlainMessage<MsgUpdateCctxRetentionPolicy> | undefined, b: MsgUpdateCctxRetentionPolicy | PlainMessage<MsgUpdateCctxRetentionPolicy> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgUpdateCctxRetentionPolicyResponse
+ */
+export declare class MsgUpdateCctxRetentionPolicyResponse extends Message<MsgUpdateCctxRetentionPolicyResponse> {
+  constructor(data?: PartialMessage<MsgUpdateCctxRetentionPolicyResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgUpdateCctxRetentionPolicyResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateCctxRetentionPolicyResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateCctxRetentionPolicyResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateCctxRetentionPolicyResponse;
+
+  static equals(a: MsgUpdateCctxRetentionPolicyResponse | PlainMessage<MsgUpdateCctxRetentionPolicyResponse> | undefined, b: MsgUpdateCctxRetentionPolicyResponse | PlainMessage<MsgUpdateCctxRetentionPolicyResponse> | undefined): boolean;
+}
+
@@ -0,0 +1,39 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file zetachain/zetacore/ibccrosschain/chain_channel.proto (package zetachain.zetacore.ibccrosschain, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * ChainChannel is the IBC transfer channel used to reach an IBC-connected chain
+ *
+ * @generated from message zetachain.zetacore.ibccrosschain.ChainChannel
+ */
+export declare class ChainChannel extends Message<ChainChannel> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string channel_id = 2;
+   */
+  channelId: string;
+
+  constructor(data?: PartialMessage<ChainChannel>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.ibccrosschain.ChainChannel";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChainChannel;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChainChannel;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChainChannel;
+
+  static equals(a: ChainChannel | PlainMessage<ChainChannel> | undefined, b: ChainChannel | PlainMessage<ChainChannel> | undefined): boolean;
+}
+
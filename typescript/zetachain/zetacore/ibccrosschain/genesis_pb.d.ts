@@ -5,6 +5,8 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
+import type { ChainChannel } from "./chain_channel_pb.js";
+import type { OutboundPacket } from "./outbound_packet_pb.js";
 
 /**
  * GenesisState defines the ibccrosschain module's genesis state.
@@ -12,6 +14,16 @@ import { Message, proto3 } from "@bufbuild/protobuf";
  * @generated from message zetachain.zetacore.ibccrosschain.GenesisState
  */
 export declare class GenesisState extends Message<GenesisState> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.ibccrosschain.ChainChannel chain_channels = 1;
+   */
+  chainChannels: ChainChannel[];
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.ibccrosschain.OutboundPacket outbound_packets = 2;
+   */
+  outboundPackets: OutboundPacket[];
+
   constructor(data?: PartialMessage<GenesisState>);
 
   static readonly runtime: typeof proto3;
@@ -1 +1,5 @@
+export * from "./chain_channel_pb";
 export * from "./genesis_pb";
+export * from "./outbound_packet_pb";
+export * from "./query_pb";
+export * from "./tx_pb";
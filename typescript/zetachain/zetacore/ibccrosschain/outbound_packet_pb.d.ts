@@ -0,0 +1,45 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file zetachain/zetacore/ibccrosschain/outbound_packet.proto (package zetachain.zetacore.ibccrosschain, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * OutboundPacket links the ICS-20 transfer packet sent for an outbound to its
+ * CCTX until the packet is acknowledged or timed out
+ *
+ * @generated from message zetachain.zetacore.ibccrosschain.OutboundPacket
+ */
+export declare class OutboundPacket extends Message<OutboundPacket> {
+  /**
+   * @generated from field: string channel_id = 1;
+   */
+  channelId: string;
+
+  /**
+   * @generated from field: uint64 sequence = 2;
+   */
+  sequence: bigint;
+
+  /**
+   * @generated from field: string cctx_index = 3;
+   */
+  cctxIndex: string;
+
+  constructor(data?: PartialMessage<OutboundPacket>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.ibccrosschain.OutboundPacket";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OutboundPacket;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OutboundPacket;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OutboundPacket;
+
+  static equals(a: OutboundPacket | PlainMessage<OutboundPacket> | undefined, b: OutboundPacket | PlainMessage<OutboundPacket> | undefined): boolean;
+}
+
@@ -0,0 +1,165 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file zetachain/zetacore/ibccrosschain/query.proto (package zetachain.zetacore.ibccrosschain, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+import type { PageRequest, PageResponse } from "../../../cosmos/base/query/v1beta1/pagination_pb.js";
+import type { ChainChannel } from "./chain_channel_pb.js";
+import type { OutboundPacket } from "./outbound_packet_pb.js";
+
+/**
+ * @generated from message zetachain.zetacore.ibccrosschain.QueryAllChainChannelRequest
+ */
+export declare class QueryAllChainChannelRequest extends Message<QueryAllChainChannelRequest> {
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 1;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryAllChainChannelRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.ibccrosschain.QueryAllChainChannelRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllChainChannelRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllChainChannelRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllChainChannelRequest;
+
+  static equals(a: QueryAllChainChannelRequest | PlainMessage<QueryAllChainChannelRequest> | undefined, b: QueryAllChainChannelRequest | PlainMessage<QueryAllChainChannelRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.ibccrosschain.QueryAllChainChannelResponse
+ */
+export declare class QueryAllChainChannelResponse extends Message<QueryAllChainChannelResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.ibccrosschain.ChainChannel chain_channels = 1;
+   */
+  chainChannels: ChainChannel[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryAllChainChannelResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.ibccrosschain.QueryAllChainChannelResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllChainChannelResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllChainChannelResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllChainChannelResponse;
+
+  static equals(a: QueryAllChainChannelResponse | PlainMessage<QueryAllChainChannelResponse> | undefined, b: QueryAllChainChannelResponse | PlainMessage<QueryAllChainChannelResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.ibccrosschain.QueryGetChainChannelRequest
+ */
+export declare class QueryGetChainChannelRequest extends Message<QueryGetChainChannelRequest> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  constructor(data?: PartialMessage<QueryGetChainChannelRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.ibccrosschain.QueryGetChainChannelRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetChainChannelRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetChainChannelRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetChainChannelRequest;
+
+  static equals(a: QueryGetChainChannelRequest | PlainMessage<QueryGetChainChannelRequest> | undefined, b: QueryGetChainChannelRequest | PlainMessage<QueryGetChainChannelRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.ibccrosschain.QueryGetChainChannelResponse
+ */
+export declare class QueryGetChainChannelResponse extends Message<QueryGetChainChannelResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.ibccrosschain.ChainChannel chain_channel = 1;
+   */
+  chainChannel?: ChainChannel;
+
+  constructor(data?: PartialMessage<QueryGetChainChannelResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.ibccrosschain.QueryGetChainChannelResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetChainChannelResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetChainChannelResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetChainChannelResponse;
+
+  static equals(a: QueryGetChainChannelResponse | PlainMessage<QueryGetChainChannelResponse> | undefined, b: QueryGetChainChannelResponse | PlainMessage<QueryGetChainChannelResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.ibccrosschain.QueryAllOutboundPacketRequest
+ */
+export declare class QueryAllOutboundPacketRequest extends Message<QueryAllOutboundPacketRequest> {
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 1;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryAllOutboundPacketRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.ibccrosschain.QueryAllOutboundPacketRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllOutboundPacketRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllOutboundPacketRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllOutboundPacketRequest;
+
+  static equals(a: QueryAllOutboundPacketRequest | PlainMessage<QueryAllOutboundPacketRequest> | undefined, b: QueryAllOutboundPacketRequest | PlainMessage<QueryAllOutboundPacketRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.ibccrosschain.QueryAllOutboundPacketResponse
+ */
+export declare class QueryAllOutboundPacketResponse extends Message<QueryAllOutboundPacketResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.ibccrosschain.OutboundPacket outbound_packets = 1;
+   */
+  outboundPackets: OutboundPacket[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryAllOutboundPacketResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.ibccrosschain.QueryAllOutboundPacketResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllOutboundPacketResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllOutboundPacketResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllOutboundPacketResponse;
+
+  static equals(a: QueryAllOutboundPacketResponse | PlainMessage<QueryAllOutboundPacketResponse> | undefined, b: QueryAllOutboundPacketResponse | PlainMessage<QueryAllOutboundPacketResponse> | undefined): boolean;
+}
+
@@ -0,0 +1,64 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file zetachain/zetacore/ibccrosschain/tx.proto (package zetachain.zetacore.ibccrosschain, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * MsgUpdateChainChannel sets the IBC transfer channel used to reach a chain
+ * using the IBC CCTX gateway
+ *
+ * @generated from message zetachain.zetacore.ibccrosschain.MsgUpdateChainChannel
+ */
+export declare class MsgUpdateChainChannel extends Message<MsgUpdateChainChannel> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string channel_id = 3;
+   */
+  channelId: string;
+
+  constructor(data?: PartialMessage<MsgUpdateChainChannel>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.ibccrosschain.MsgUpdateChainChannel";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateChainChannel;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateChainChannel;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateChainChannel;
+
+  static equals(a: MsgUpdateChainChannel | PlainMessage<MsgUpdateChainChannel> | undefined, b: MsgUpdateChainChannel | PlainMessage<MsgUpdateChainChannel> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.ibccrosschain.MsgUpdateChainChannelResponse
+ */
+export declare class MsgUpdateChainChannelResponse extends Message<MsgUpdateChainChannelResponse> {
+  constructor(data?: PartialMessage<MsgUpdateChainChannelResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.ibccrosschain.MsgUpdateChainChannelResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateChainChannelResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateChainChannelResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateChainChannelResponse;
+
+  static equals(a: MsgUpdateChainChannelResponse | PlainMessage<MsgUpdateChainChannelResponse> | undefined, b: MsgUpdateChainChannelResponse | PlainMessage<MsgUpdateChainChannelResponse> | undefined): boolean;
+}
+
@@ -104,6 +104,39 @@ export declare class ChainParams extends Message<ChainParams> {
    */
   isSupported: boolean;
 
+  /**
+   * is_eip1559_enabled selects EIP-1559 dynamic fee transactions for outbounds
+   * on EVM chains, legacy transactions are used otherwise
+   *
+   * @generated from field: bool is_eip1559_enabled = 17;
+   */
+  isEip1559Enabled: boolean;
+
+  /**
+   * outbound_batch_size is the maximum number of outbounds paid by a single
+   * transaction on Bitcoin chains, 0 or 1 means no batching
+   *
+   * @generated from field: uint64 outbound_batch_size = 18;
+   */
+  outboundBatchSize: bigint;
+
+  /**
+   * gateway_address is the address of the gateway program on Solana chains
+   *
+   * @generated from field: string gateway_address = 19;
+   */
+  gatewayAddress: string;
+
+  /**
+   * multi_output_deposit_height is the Bitcoin block height from which the
+   * deposits are detected regardless of the output order and credited with the
+   * value of all the outputs paying the TSS address, 0 keeps detecting only the
+   * deposits paid by the 1st output with the memo in the 2nd output
+   *
+   * @generated from field: uint64 multi_output_deposit_height = 20;
+   */
+  multiOutputDepositHeight: bigint;
+
   constructor(data?: PartialMessage<ChainParams>);
 
   static readonly runtime: typeof proto3;
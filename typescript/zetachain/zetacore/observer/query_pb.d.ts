@@ -27,6 +27,13 @@ export declare class QueryGetChainNoncesRequest extends Message<QueryGetChainNon
    */
   index: string;
 
+  /**
+   * chain_id is the id of the chain of the nonces, used if index is empty
+   *
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
   constructor(data?: PartialMessage<QueryGetChainNoncesRequest>);
 
   static readonly runtime: typeof proto3;
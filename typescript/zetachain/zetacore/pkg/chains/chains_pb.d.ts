@@ -132,6 +132,31 @@ export declare enum ChainName {
    * @generated from enum value: base_sepolia = 20;
    */
   base_sepolia = 20,
+
+  /**
+   * @generated from enum value: solana_mainnet = 21;
+   */
+  solana_mainnet = 21,
+
+  /**
+   * @generated from enum value: solana_devnet = 22;
+   */
+  solana_devnet = 22,
+
+  /**
+   * @generated from enum value: solana_localnet = 23;
+   */
+  solana_localnet = 23,
+
+  /**
+   * @generated from enum value: btc_signet_testnet = 24;
+   */
+  btc_signet_testnet = 24,
+
+  /**
+   * @generated from enum value: btc_testnet4 = 25;
+   */
+  btc_testnet4 = 25,
 }
 
 /**
@@ -176,6 +201,16 @@ export declare enum Network {
    * @generated from enum value: base = 6;
    */
   base = 6,
+
+  /**
+   * @generated from enum value: solana = 7;
+   */
+  solana = 7,
+
+  /**
+   * @generated from enum value: cosmos = 8;
+   */
+  cosmos = 8,
 }
 
 /**
@@ -222,6 +257,11 @@ export declare enum Vm {
    * @generated from enum value: evm = 1;
    */
   evm = 1,
+
+  /**
+   * @generated from enum value: svm = 2;
+   */
+  svm = 2,
 }
 
 /**
@@ -251,6 +291,11 @@ export declare enum Consensus {
    * @generated from enum value: op_stack = 3;
    */
   op_stack = 3,
+
+  /**
+   * @generated from enum value: solana_consensus = 4;
+   */
+  solana_consensus = 4,
 }
 
 /**
@@ -274,6 +319,14 @@ export declare enum CCTXGateway {
    * @generated from enum value: observers = 1;
    */
   observers = 1,
+
+  /**
+   * ibc is the CCTX gateway for Cosmos chains connected through IBC, the
+   * outbounds are ICS-20 transfers and the inbounds are received ICS-20 packets
+   *
+   * @generated from enum value: ibc = 2;
+   */
+  ibc = 2,
 }
 
 /**
@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/x/authority/types"
 )
 
@@ -14,7 +15,7 @@ func (k Keeper) SetChainInfo(ctx sdk.Context, chainInfo types.ChainInfo) {
 	store.Set([]byte{0}, b)
 }
 
-// GetChainInfo returns the policies from the store
+// GetChainInfo returns the chain info from the store
 func (k Keeper) GetChainInfo(ctx sdk.Context) (val types.ChainInfo, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainInfoKey))
 	b := store.Get([]byte{0})
@@ -24,3 +25,13 @@ func (k Keeper) GetChainInfo(ctx sdk.Context) (val types.ChainInfo, found bool)
 	k.cdc.MustUnmarshal(b, &val)
 	return val, true
 }
+
+// GetAdditionalChainList returns the list of chains supported in addition to the default chains
+// the list is empty if the chain info is not set
+func (k Keeper) GetAdditionalChainList(ctx sdk.Context) []chains.Chain {
+	chainInfo, found := k.GetChainInfo(ctx)
+	if !found {
+		return []chains.Chain{}
+	}
+	return chainInfo.Chains
+}
@@ -31,3 +31,14 @@ func TestKeeper_SetChainInfo(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, newChainInfo, got)
 }
+
+func TestKeeper_GetAdditionalChainList(t *testing.T) {
+	k, ctx := keepertest.AuthorityKeeper(t)
+
+	// empty list if chain info is not set
+	require.Empty(t, k.GetAdditionalChainList(ctx))
+
+	chainInfo := sample.ChainInfo(42)
+	k.SetChainInfo(ctx, chainInfo)
+	require.Equal(t, chainInfo.Chains, k.GetAdditionalChainList(ctx))
+}
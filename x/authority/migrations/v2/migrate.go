@@ -0,0 +1,44 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// authorityKeeper is an interface to prevent cyclic dependency
+type authorityKeeper interface {
+	GetChainInfo(ctx sdk.Context) (types.ChainInfo, bool)
+	SetChainInfo(ctx sdk.Context, chainInfo types.ChainInfo)
+}
+
+// MigrateStore migrates the x/authority module state from the consensus version 1 to 2
+// It merges the chains of the chain info reusing a chain ID into a single chain, the last one as it is the one
+// replacing the others when combining the chain lists, and checks the stored chain info is valid
+func MigrateStore(ctx sdk.Context, authorityKeeper authorityKeeper) error {
+	chainInfo, found := authorityKeeper.GetChainInfo(ctx)
+	if !found {
+		return nil
+	}
+
+	indexes := make(map[int64]int)
+	uniqueChains := make([]chains.Chain, 0, len(chainInfo.Chains))
+	for _, chain := range chainInfo.Chains {
+		if i, found := indexes[chain.ChainId]; found {
+			ctx.Logger().Info("merging chains with duplicated chain ID in chain info", "chain_id", chain.ChainId)
+			uniqueChains[i] = chain
+			continue
+		}
+		indexes[chain.ChainId] = len(uniqueChains)
+		uniqueChains = append(uniqueChains, chain)
+	}
+	chainInfo.Chains = uniqueChains
+
+	if err := chainInfo.Validate(); err != nil {
+		return err
+	}
+	authorityKeeper.SetChainInfo(ctx, chainInfo)
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	v2 "github.com/zeta-chain/zetacore/x/authority/migrations/v2"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	t.Run("do nothing if chain info is not set", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+
+		err := v2.MigrateStore(ctx, *k)
+		require.NoError(t, err)
+
+		_, found := k.GetChainInfo(ctx)
+		require.False(t, found)
+	})
+
+	t.Run("keep valid chain info", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		chainInfo := sample.ChainInfo(42)
+		k.SetChainInfo(ctx, chainInfo)
+
+		err := v2.MigrateStore(ctx, *k)
+		require.NoError(t, err)
+
+		migrated, found := k.GetChainInfo(ctx)
+		require.True(t, found)
+		require.Equal(t, chainInfo, migrated)
+	})
+
+	t.Run("merge chains with duplicated chain ID keeping the last one", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		first := *sample.Chain(42)
+		other := *sample.Chain(43)
+		last := *sample.Chain(42)
+		last.Network = chains.Network_polygon
+		k.SetChainInfo(ctx, types.ChainInfo{
+			Chains: []chains.Chain{first, other, last},
+		})
+
+		err := v2.MigrateStore(ctx, *k)
+		require.NoError(t, err)
+
+		migrated, found := k.GetChainInfo(ctx)
+		require.True(t, found)
+		require.Equal(t, []chains.Chain{last, other}, migrated.Chains)
+	})
+
+	t.Run("fail if chain info is invalid", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		chain := *sample.Chain(42)
+		chain.IsExternal = false
+		k.SetChainInfo(ctx, types.ChainInfo{
+			Chains: []chains.Chain{chain},
+		})
+
+		err := v2.MigrateStore(ctx, *k)
+		require.ErrorContains(t, err, "not external")
+	})
+}
@@ -14,9 +14,10 @@ func DefaultChainInfo() ChainInfo {
 }
 
 // Validate performs basic validation of chain info
-// It checks all chains are valid and they're all of external type
+// It checks all chains are valid, they're all of external type and their chain IDs are unique
 // The structure is used to store external chain information
 func (ci ChainInfo) Validate() error {
+	chainIDs := make(map[int64]bool)
 	for _, chain := range ci.Chains {
 		if err := chain.Validate(); err != nil {
 			return err
@@ -24,6 +25,10 @@ func (ci ChainInfo) Validate() error {
 		if !chain.IsExternal {
 			return fmt.Errorf("chain %d is not external", chain.ChainId)
 		}
+		if chainIDs[chain.ChainId] {
+			return fmt.Errorf("chain ID %d is already used", chain.ChainId)
+		}
+		chainIDs[chain.ChainId] = true
 	}
 
 	return nil
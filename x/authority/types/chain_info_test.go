@@ -65,6 +65,26 @@ func TestChainInfo_Validate(t *testing.T) {
 			},
 			errContains: "not external",
 		},
+		{
+			name: "invalid if chain ID is duplicated",
+			chainInfo: types.ChainInfo{
+				Chains: []chains.Chain{
+					*sample.Chain(42),
+					*sample.Chain(43),
+					*sample.Chain(42),
+				},
+			},
+			errContains: "chain ID 42 is already used",
+		},
+		{
+			name: "valid if chain ID is used by a default chain",
+			chainInfo: types.ChainInfo{
+				Chains: []chains.Chain{
+					*sample.Chain(42),
+					*sample.Chain(chains.Ethereum.ChainId),
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
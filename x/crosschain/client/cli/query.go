@@ -27,11 +27,13 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdShowGasPrice(),
 
 		CmdListSend(),
+		CmdListCctxByFilter(),
 		CmdShowSend(),
 		CmdLastZetaHeight(),
 		CmdInboundHashToCctxData(),
 		CmdListInboundHashToCctx(),
 		CmdShowInboundHashToCctx(),
+		CmdOutboundHashToCctxData(),
 
 		CmdPendingCctx(),
 		CmdListInboundTrackerByChain(),
@@ -40,6 +42,8 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdListPendingCCTXWithinRateLimit(),
 
 		CmdShowUpdateRateLimiterFlags(),
+		CmdShowRateLimiterUsage(),
+		CmdShowCctxRetentionPolicy(),
 	)
 
 	return cmd
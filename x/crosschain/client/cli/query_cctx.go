@@ -2,10 +2,14 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
 
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
@@ -98,6 +102,127 @@ func CmdShowSend() *cobra.Command {
 			}
 
 			res, err := queryClient.Cctx(context.Background(), params)
+			if err != nil {
+				// the cctx might have been pruned from the state, fallback to its archival event
+				cctx, archivedErr := queryArchivedCctx(clientCtx, args[0])
+				if archivedErr != nil {
+					return err
+				}
+				res = &types.QueryGetCctxResponse{CrossChainTx: cctx}
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// queryArchivedCctx returns the cctx pruned from the state from its archival event
+// the node must index the block events for the archival event to be found
+func queryArchivedCctx(clientCtx client.Context, index string) (*types.CrossChainTx, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	signClient, ok := node.(rpcclient.SignClient)
+	if !ok {
+		return nil, fmt.Errorf("node client doesn't support block search")
+	}
+
+	eventType := proto.MessageName(&types.EventCCTXArchived{})
+	query := fmt.Sprintf("%s.cctx_index='%q'", eventType, index)
+	page, perPage := 1, 1
+	blocks, err := signClient.BlockSearch(context.Background(), query, &page, &perPage, "desc")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, block := range blocks.Blocks {
+		height := block.Block.Height
+		results, err := signClient.BlockResults(context.Background(), &height)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range results.EndBlockEvents {
+			if event.Type != eventType {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(event)
+			if err != nil {
+				return nil, err
+			}
+			archived, ok := msg.(*types.EventCCTXArchived)
+			if ok && archived.CctxIndex == index && archived.Cctx != nil {
+				return archived.Cctx, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("archived cctx %s not found", index)
+}
+
+const (
+	flagSender          = "sender"
+	flagReceiver        = "receiver"
+	flagStatus          = "status"
+	flagSenderChainID   = "sender-chain-id"
+	flagReceiverChainID = "receiver-chain-id"
+	flagMinHeight       = "min-height"
+	flagMaxHeight       = "max-height"
+)
+
+func CmdListCctxByFilter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-cctx-by-filter",
+		Short: "list the CCTX matching all the filters",
+		Example: "zetacored query crosschain list-cctx-by-filter --status Aborted --status Reverted " +
+			"--receiver-chain-id 56 --min-height 1000",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllCctxByFilterRequest{
+				Pagination: pageReq,
+			}
+			if params.Sender, err = cmd.Flags().GetString(flagSender); err != nil {
+				return err
+			}
+			if params.Receiver, err = cmd.Flags().GetString(flagReceiver); err != nil {
+				return err
+			}
+			if params.SenderChainId, err = cmd.Flags().GetInt64(flagSenderChainID); err != nil {
+				return err
+			}
+			if params.ReceiverChainId, err = cmd.Flags().GetInt64(flagReceiverChainID); err != nil {
+				return err
+			}
+			if params.MinHeight, err = cmd.Flags().GetUint64(flagMinHeight); err != nil {
+				return err
+			}
+			if params.MaxHeight, err = cmd.Flags().GetUint64(flagMaxHeight); err != nil {
+				return err
+			}
+			statuses, err := cmd.Flags().GetStringSlice(flagStatus)
+			if err != nil {
+				return err
+			}
+			for _, s := range statuses {
+				cctxStatus, ok := types.CctxStatus_value[s]
+				if !ok {
+					return fmt.Errorf("invalid cctx status %s", s)
+				}
+				params.Statuses = append(params.Statuses, types.CctxStatus(cctxStatus))
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CctxAllByFilter(context.Background(), params)
 			if err != nil {
 				return err
 			}
@@ -106,6 +231,14 @@ func CmdShowSend() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagSender, "", "sender of the inbound")
+	cmd.Flags().String(flagReceiver, "", "receiver of the first outbound")
+	cmd.Flags().StringSlice(flagStatus, nil, "status of the CCTX, can be repeated to match any of the statuses")
+	cmd.Flags().Int64(flagSenderChainID, 0, "sender chain ID")
+	cmd.Flags().Int64(flagReceiverChainID, 0, "receiver chain ID of the first outbound")
+	cmd.Flags().Uint64(flagMinHeight, 0, "minimum zeta height the CCTX was created at")
+	cmd.Flags().Uint64(flagMaxHeight, 0, "maximum zeta height the CCTX was created at")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
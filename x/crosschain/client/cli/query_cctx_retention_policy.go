@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func CmdShowCctxRetentionPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-cctx-retention-policy",
+		Short: "shows the retention policy of the cctxs",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CctxRetentionPolicy(context.Background(), &types.QueryCctxRetentionPolicyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -94,6 +94,18 @@ func CmdInboundHashToCctxData() *cobra.Command {
 				return err
 			}
 
+			// the cctxs pruned from the state are retrieved from their archival event
+			prunedCctxIndexes := make([]string, 0)
+			for _, index := range res.PrunedCctxIndexes {
+				cctx, err := queryArchivedCctx(clientCtx, index)
+				if err != nil {
+					prunedCctxIndexes = append(prunedCctxIndexes, index)
+					continue
+				}
+				res.CrossChainTxs = append(res.CrossChainTxs, *cctx)
+			}
+			res.PrunedCctxIndexes = prunedCctxIndexes
+
 			return clientCtx.PrintProto(res)
 		},
 	}
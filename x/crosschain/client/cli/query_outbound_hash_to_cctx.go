@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func CmdOutboundHashToCctxData() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outbound-hash-to-cctx-data [outbound-hash]",
+		Short: "query the data of all cctxs processed by an outbound hash",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argOutboundHash := args[0]
+
+			params := &types.QueryOutboundHashToCctxDataRequest{
+				OutboundHash: argOutboundHash,
+			}
+
+			res, err := queryClient.OutboundHashToCctxData(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -32,3 +32,26 @@ func CmdShowUpdateRateLimiterFlags() *cobra.Command {
 
 	return cmd
 }
+
+func CmdShowRateLimiterUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-rate-limiter-usage",
+		Short: "shows the current utilization of the rate limit buckets",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimiterUsage(context.Background(), &types.QueryRateLimiterUsageRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
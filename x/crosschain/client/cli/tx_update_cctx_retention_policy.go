@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func CmdUpdateCctxRetentionPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-cctx-retention-policy [enabled] [retention-blocks] [max-pruned-per-block]",
+		Short: "Update the retention policy of the finalized cctxs pruned from the state",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+			retentionBlocks, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			maxPrunedPerBlock, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateCctxRetentionPolicy(clientCtx.GetFromAddress().String(), types.CctxRetentionPolicy{
+				Enabled:           enabled,
+				RetentionBlocks:   retentionBlocks,
+				MaxPrunedPerBlock: uint32(maxPrunedPerBlock),
+			})
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
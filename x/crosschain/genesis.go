@@ -26,6 +26,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetInboundHashToCctx(ctx, elem)
 	}
 
+	// Set all the outboundHashToCctx
+	for _, elem := range genState.OutboundHashToCctxList {
+		k.SetOutboundHashToCctx(ctx, elem)
+	}
+
 	// Set all the gasPrice
 	for _, elem := range genState.GasPriceList {
 		if elem != nil {
@@ -53,6 +58,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	}
 
 	k.SetRateLimiterFlags(ctx, genState.RateLimiterFlags)
+	k.SetCctxRetentionPolicy(ctx, genState.CctxRetentionPolicy)
 }
 
 // ExportGenesis returns the crosschain module's exported genesis.
@@ -62,6 +68,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.OutboundTrackerList = k.GetAllOutboundTracker(ctx)
 	genesis.InboundHashToCctxList = k.GetAllInboundHashToCctx(ctx)
 	genesis.InboundTrackerList = k.GetAllInboundTracker(ctx)
+	genesis.OutboundHashToCctxList = k.GetAllOutboundHashToCctx(ctx)
 
 	// Get all gas prices
 	gasPriceList := k.GetAllGasPrice(ctx)
@@ -95,5 +102,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.RateLimiterFlags = rateLimiterFlags
 	}
 
+	cctxRetentionPolicy, found := k.GetCctxRetentionPolicy(ctx)
+	if found {
+		genesis.CctxRetentionPolicy = cctxRetentionPolicy
+	}
+
 	return &genesis
 }
@@ -50,7 +50,13 @@ func TestGenesis(t *testing.T) {
 			sample.InboundHashToCctx(t, "0x1"),
 			sample.InboundHashToCctx(t, "0x2"),
 		},
-		RateLimiterFlags: sample.RateLimiterFlags(),
+		OutboundHashToCctxList: []types.OutboundHashToCctx{
+			sample.OutboundHashToCctx(t, "0x0"),
+			sample.OutboundHashToCctx(t, "0x1"),
+			sample.OutboundHashToCctx(t, "0x2"),
+		},
+		RateLimiterFlags:    sample.RateLimiterFlags(),
+		CctxRetentionPolicy: sample.CctxRetentionPolicy(),
 	}
 
 	// Init and export
@@ -49,8 +49,9 @@ func (k Keeper) IterateAndUpdateCctxGasPrice(
 
 IterateChains:
 	for _, chain := range chains {
-		// support only external evm chains
-		if zetachains.IsEVMChain(chain.ChainId) && !zetachains.IsZetaChain(chain.ChainId) {
+		// support only external evm chains and bitcoin chains
+		// bitcoin outbounds with increased gas price are replaced by fee (RBF) on zetaclient side
+		if isGasPriceIncreaseSupported(*chain) {
 			res, err := k.ListPendingCctx(sdk.UnwrapSDKContext(ctx), &types.QueryListPendingCctxRequest{
 				ChainId: chain.ChainId,
 				Limit:   gasPriceIncreaseFlags.MaxPendingCctxs,
@@ -97,6 +98,14 @@ IterateChains:
 	return cctxCount, gasPriceIncreaseFlags
 }
 
+// isGasPriceIncreaseSupported returns true if the gas price of pending cctxs can be increased for the chain
+func isGasPriceIncreaseSupported(chain zetachains.Chain) bool {
+	if chain.IsZetaChain() {
+		return false
+	}
+	return chain.IsEVMChain() || chain.IsBitcoinChain()
+}
+
 // CheckAndUpdateCctxGasPrice checks if the retry interval is reached and updates the gas price if so
 // The function returns the gas price increase and the additional fees paid from the gas stability pool
 func CheckAndUpdateCctxGasPrice(
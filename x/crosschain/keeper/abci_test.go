@@ -42,12 +42,8 @@ func TestKeeper_IterateAndUpdateCctxGasPrice(t *testing.T) {
 	}
 
 	// add some evm and non-evm chains
-	supportedChains := []*chains.Chain{
-		{ChainId: chains.Ethereum.ChainId},
-		{ChainId: chains.BitcoinMainnet.ChainId},
-		{ChainId: chains.BscMainnet.ChainId},
-		{ChainId: chains.ZetaChainMainnet.ChainId},
-	}
+	ethereum, bitcoin, bsc, zeta := chains.Ethereum, chains.BitcoinMainnet, chains.BscMainnet, chains.ZetaChainMainnet
+	supportedChains := []*chains.Chain{&ethereum, &bitcoin, &bsc, &zeta}
 
 	// set pending cctx
 	tss := sample.Tss()
@@ -87,15 +83,21 @@ func TestKeeper_IterateAndUpdateCctxGasPrice(t *testing.T) {
 	ctx = ctx.WithBlockHeight(observertypes.DefaultCrosschainFlags().GasPriceIncreaseFlags.EpochLength * 2)
 	cctxCount, flags = k.IterateAndUpdateCctxGasPrice(ctx, supportedChains, updateFunc)
 
-	// 2 eth + 5 bsc = 7
-	require.Equal(t, 7, cctxCount)
+	// 2 eth + 5 btc + 5 bsc = 12
+	require.Equal(t, 12, cctxCount)
 	require.Equal(t, customFlags, flags)
 
 	// check that the update function was called with the cctx index
-	require.Equal(t, 7, len(updateFuncMap))
+	require.Equal(t, 12, len(updateFuncMap))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("1-10"))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("1-11"))
 
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-20"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-21"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-22"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-23"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-24"))
+
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("56-30"))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("56-31"))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("56-32"))
@@ -145,6 +147,29 @@ func TestCheckAndUpdateCctxGasPrice(t *testing.T) {
 			expectedGasPriceIncrease:               math.NewUint(50),    // 100% medianGasPrice
 			expectedAdditionalFees:                 math.NewUint(50000), // gasLimit * increase
 		},
+		{
+			name: "can update fee rate of bitcoin outbound when retry interval is reached",
+			cctx: types.CrossChainTx{
+				Index: "a3",
+				CctxStatus: &types.Status{
+					LastUpdateTimestamp: sampleTimestamp.Unix(),
+				},
+				OutboundParams: []*types.OutboundParams{
+					{
+						ReceiverChainId: chains.BitcoinMainnet.ChainId,
+						GasLimit:        254,
+						GasPrice:        "20",
+					},
+				},
+			},
+			flags:                                  observertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:                         retryIntervalReached,
+			medianGasPrice:                         10,
+			withdrawFromGasStabilityPoolReturn:     nil,
+			expectWithdrawFromGasStabilityPoolCall: true,
+			expectedGasPriceIncrease:               math.NewUint(10),   // 100% medianGasPrice
+			expectedAdditionalFees:                 math.NewUint(2540), // tx size * increase
+		},
 		{
 			name: "can update gas price at max limit",
 			cctx: types.CrossChainTx{
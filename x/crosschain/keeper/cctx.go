@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/pkg/coin"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observerTypes "github.com/zeta-chain/zetacore/x/observer/types"
@@ -22,8 +23,13 @@ func (k Keeper) SetCctxAndNonceToCctxAndInboundHashToCctx(ctx sdk.Context, cctx
 		return
 	}
 	// set mapping nonce => cctxIndex
-	if cctx.CctxStatus.Status == types.CctxStatus_PendingOutbound ||
-		cctx.CctxStatus.Status == types.CctxStatus_PendingRevert {
+	// the outbounds to IBC chains are ICS-20 transfers and don't have a TSS nonce
+	if (cctx.CctxStatus.Status == types.CctxStatus_PendingOutbound ||
+		cctx.CctxStatus.Status == types.CctxStatus_PendingRevert) &&
+		!chains.IsIBCChain(
+			cctx.GetCurrentOutboundParam().ReceiverChainId,
+			k.GetAuthorityKeeper().GetAdditionalChainList(ctx),
+		) {
 		k.GetObserverKeeper().SetNonceToCctx(ctx, observerTypes.NonceToCctx{
 			ChainId: cctx.GetCurrentOutboundParam().ReceiverChainId,
 			// #nosec G701 always in range
@@ -55,11 +61,21 @@ func (k Keeper) SetCctxAndNonceToCctxAndInboundHashToCctx(ctx sdk.Context, cctx
 }
 
 // SetCrossChainTx set a specific send in the store from its index
+// the secondary indexes of the cctx are updated from its previous value
 func (k Keeper) SetCrossChainTx(ctx sdk.Context, cctx types.CrossChainTx) {
 	p := types.KeyPrefix(fmt.Sprintf("%s", types.CCTXKey))
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), p)
+
+	var prevIndexKeys [][]byte
+	if b := store.Get(types.KeyPrefix(cctx.Index)); b != nil {
+		var prev types.CrossChainTx
+		k.cdc.MustUnmarshal(b, &prev)
+		prevIndexKeys = types.CctxIndexKeys(prev)
+	}
+
 	b := k.cdc.MustMarshal(&cctx)
 	store.Set(types.KeyPrefix(cctx.Index), b)
+	k.updateCctxIndexes(ctx, cctx.Index, prevIndexKeys, types.CctxIndexKeys(cctx))
 }
 
 // GetCrossChainTx returns a send from its index
@@ -93,9 +109,46 @@ func (k Keeper) GetAllCrossChainTx(ctx sdk.Context) (list []types.CrossChainTx)
 	return list
 }
 
-// RemoveCrossChainTx removes a send from the store
+// RemoveCrossChainTx removes a send and its secondary indexes from the store
 func (k Keeper) RemoveCrossChainTx(ctx sdk.Context, index string) {
 	p := types.KeyPrefix(fmt.Sprintf("%s", types.CCTXKey))
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), p)
+
+	b := store.Get(types.KeyPrefix(index))
+	if b == nil {
+		return
+	}
+	var cctx types.CrossChainTx
+	k.cdc.MustUnmarshal(b, &cctx)
+
 	store.Delete(types.KeyPrefix(index))
+	k.updateCctxIndexes(ctx, index, types.CctxIndexKeys(cctx), nil)
+}
+
+// IndexCrossChainTx sets all the secondary index entries of the cctx
+func (k Keeper) IndexCrossChainTx(ctx sdk.Context, cctx types.CrossChainTx) {
+	k.updateCctxIndexes(ctx, cctx.Index, nil, types.CctxIndexKeys(cctx))
+}
+
+// updateCctxIndexes replaces the index entries of the cctx
+// only the entries that changed are written to the store
+func (k Keeper) updateCctxIndexes(ctx sdk.Context, index string, prevKeys, keys [][]byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxIndexKeyPrefix))
+
+	isPrev := make(map[string]bool, len(prevKeys))
+	for _, key := range prevKeys {
+		isPrev[string(key)] = true
+	}
+	for _, key := range keys {
+		if isPrev[string(key)] {
+			delete(isPrev, string(key))
+			continue
+		}
+		store.Set(key, []byte(index))
+	}
+	for _, key := range prevKeys {
+		if isPrev[string(key)] {
+			store.Delete(key)
+		}
+	}
 }
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// cctxIndexBackfillBatchSize is the number of existing cctxs indexed per block by the index backfill
+// the existing cctxs are not indexed in a single block not to stall the chain on the upgrade
+const cctxIndexBackfillBatchSize = 1000
+
+// StartCctxIndexBackfill starts indexing the existing cctxs from the first one, a batch per block
+func (k Keeper) StartCctxIndexBackfill(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CCTXKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	if iterator.Valid() {
+		k.setCctxIndexBackfillCursor(ctx, bytes.Clone(iterator.Key()))
+	}
+}
+
+// BackfillCctxIndexes indexes the next batch of existing cctxs if the index backfill is not completed
+// the cctxs set meanwhile are indexed by SetCrossChainTx, indexing them again is a no-op
+// it returns the number of indexed cctxs
+func (k Keeper) BackfillCctxIndexes(ctx sdk.Context) int {
+	cursor, found := k.GetCctxIndexBackfillCursor(ctx)
+	if !found {
+		return 0
+	}
+
+	cctxs, next := k.readCctxBatch(ctx, cursor, cctxIndexBackfillBatchSize)
+	for _, cctx := range cctxs {
+		k.IndexCrossChainTx(ctx, cctx)
+	}
+	k.setCctxIndexBackfillCursor(ctx, next)
+	return len(cctxs)
+}
+
+// GetCctxIndexBackfillCursor returns the store key of the next cctx to index
+// it returns false if there is no index backfill in progress
+func (k Keeper) GetCctxIndexBackfillCursor(ctx sdk.Context) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	cursor := store.Get(types.KeyPrefix(types.CctxIndexBackfillKey))
+	return cursor, cursor != nil
+}
+
+// setCctxIndexBackfillCursor sets the store key of the next cctx to index, the backfill is completed if nil
+func (k Keeper) setCctxIndexBackfillCursor(ctx sdk.Context, cursor []byte) {
+	store := ctx.KVStore(k.storeKey)
+	if cursor == nil {
+		store.Delete(types.KeyPrefix(types.CctxIndexBackfillKey))
+		return
+	}
+	store.Set(types.KeyPrefix(types.CctxIndexBackfillKey), cursor)
+}
+
+// readCctxBatch reads a batch of cctxs from the start store key
+// the store can't be modified while iterated and the whole cctx store must not be loaded in memory
+// it returns the store key of the next batch, nil if all the cctxs have been read
+func (k Keeper) readCctxBatch(ctx sdk.Context, start []byte, size int) ([]types.CrossChainTx, []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CCTXKey))
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+
+	cctxs := make([]types.CrossChainTx, 0, size)
+	for ; iterator.Valid(); iterator.Next() {
+		if len(cctxs) == size {
+			return cctxs, bytes.Clone(iterator.Key())
+		}
+		var cctx types.CrossChainTx
+		k.cdc.MustUnmarshal(iterator.Value(), &cctx)
+		cctxs = append(cctxs, cctx)
+	}
+	return cctxs, nil
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestKeeper_BackfillCctxIndexes(t *testing.T) {
+	t.Run("should do nothing without backfill in progress", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		setCctxForFilter(t, k, ctx, 1, sample.EthAddress().Hex(), 56, types.CctxStatus_OutboundMined)
+
+		require.Zero(t, k.BackfillCctxIndexes(ctx))
+	})
+
+	t.Run("should keep the indexes of the cctxs updated during the backfill", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		sender := sample.EthAddress().Hex()
+		cctx := setCctxForFilter(t, k, ctx, 1, sender, 56, types.CctxStatus_PendingOutbound)
+		k.StartCctxIndexBackfill(ctx)
+
+		// the cctx is updated before the backfill reaches it
+		cctx.CctxStatus.Status = types.CctxStatus_OutboundMined
+		k.SetCrossChainTx(ctx, cctx)
+		require.Equal(t, 1, k.BackfillCctxIndexes(ctx))
+
+		res, err := k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{
+			Statuses: []types.CctxStatus{types.CctxStatus_PendingOutbound},
+		})
+		require.NoError(t, err)
+		require.Empty(t, res.CrossChainTx)
+		res, err = k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{
+			Statuses: []types.CctxStatus{types.CctxStatus_OutboundMined},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.CrossChainTx, 1)
+	})
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	emissionstypes "github.com/zeta-chain/zetacore/x/emissions/types"
+)
+
+// cctxPruningScanFactor bounds the number of index entries of a status scanned per block relative to the max
+// pruned cctxs: the aborted cctxs that are not refunded are kept and must not result in an unbounded scan
+const cctxPruningScanFactor = 10
+
+// prunableCctxStatuses are the terminal statuses of the cctxs that can be pruned
+var prunableCctxStatuses = []types.CctxStatus{
+	types.CctxStatus_OutboundMined,
+	types.CctxStatus_Reverted,
+	types.CctxStatus_Aborted,
+}
+
+// PruneCctxs removes from the state the cctxs in a terminal state created before the retention period
+// a full archival event is emitted for each pruned cctx and its ballots are removed
+// the cctxs are only pruned once their ballots are matured and rewarded by the emissions module
+// the inbound hash to cctx and finalized inbound entries are kept for replay protection
+// it returns the number of pruned cctxs
+func (k Keeper) PruneCctxs(ctx sdk.Context) int {
+	policy, found := k.GetCctxRetentionPolicy(ctx)
+	if !found || !policy.Enabled || policy.MaxPrunedPerBlock == 0 {
+		return 0
+	}
+	height := ctx.BlockHeight()
+	// #nosec G701 block height always positive
+	if height <= 0 || uint64(height) <= policy.RetentionBlocks {
+		return 0
+	}
+	// #nosec G701 block height always positive
+	cutoff := uint64(height) - policy.RetentionBlocks
+
+	cctxs := k.getPrunableCctxs(ctx, cutoff, k.getBallotMaturityBlocks(ctx), int(policy.MaxPrunedPerBlock))
+	for _, cctx := range cctxs {
+		if err := EmitCCTXArchived(ctx, cctx); err != nil {
+			ctx.Logger().Error("error emitting CCTXArchived, cctx not pruned", "cctx", cctx.Index, "error", err)
+			continue
+		}
+		k.RemoveCrossChainTx(ctx, cctx.Index)
+		k.deleteCctxBallots(ctx, cctx)
+	}
+	return len(cctxs)
+}
+
+// getPrunableCctxs returns the prunable cctxs created before the cutoff height from the status indexes
+// the cctxs without creation height are never pruned, neither are the cctxs with ballots not yet matured
+// the cctxs are collected before being pruned since the store can't be modified while iterated
+//
+// the scan of a status resumes from its cursor, so the cctxs that can't be pruned yet don't stall the pruning:
+// they are skipped and checked again on the next pass over the index, started once the cutoff height is reached
+// the statuses are scanned first in turn since the max pruned cctxs can be reached by a single status
+func (k Keeper) getPrunableCctxs(
+	ctx sdk.Context,
+	cutoff uint64,
+	ballotMaturityBlocks int64,
+	limit int,
+) []types.CrossChainTx {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxIndexKeyPrefix))
+
+	cctxs := make([]types.CrossChainTx, 0)
+	// #nosec G701 block height always positive
+	first := int(ctx.BlockHeight() % int64(len(prunableCctxStatuses)))
+	for i := range prunableCctxStatuses {
+		cctxStatus := prunableCctxStatuses[(first+i)%len(prunableCctxStatuses)]
+		indexPrefix := types.CctxIndexPrefix(types.CctxIndexStatus, cctxStatus.String())
+		start := types.CctxIndexHeightKey(indexPrefix, 1)
+		end := types.CctxIndexHeightKey(indexPrefix, cutoff)
+		if cursor, found := k.getCctxPruningCursor(ctx, cctxStatus); found &&
+			bytes.Compare(cursor, start) > 0 && bytes.Compare(cursor, end) < 0 {
+			start = cursor
+		}
+
+		// the cursor is cleared if the scan reaches the cutoff height, the next pass starts from the first entry
+		var next []byte
+		scanned := 0
+		iterator := store.Iterator(start, end)
+		for ; iterator.Valid(); iterator.Next() {
+			if len(cctxs) >= limit || scanned >= limit*cctxPruningScanFactor {
+				next = bytes.Clone(iterator.Key())
+				break
+			}
+			scanned++
+
+			cctx, found := k.GetCrossChainTx(ctx, string(iterator.Value()))
+			if !found || !cctx.IsPrunable() || cctx.GetInboundParams().GetFinalizedZetaHeight() == 0 {
+				continue
+			}
+			if !k.areCctxBallotsMatured(ctx, cctx, ballotMaturityBlocks) {
+				continue
+			}
+			cctxs = append(cctxs, cctx)
+		}
+		iterator.Close()
+		k.setCctxPruningCursor(ctx, cctxStatus, next)
+	}
+	return cctxs
+}
+
+// getCctxPruningCursor returns the index key the pruning scan of the status resumes from
+func (k Keeper) getCctxPruningCursor(ctx sdk.Context, cctxStatus types.CctxStatus) ([]byte, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxPruningCursorKey))
+	cursor := store.Get([]byte(cctxStatus.String()))
+	return cursor, cursor != nil
+}
+
+// setCctxPruningCursor sets the index key the pruning scan of the status resumes from, the cursor is removed if nil
+func (k Keeper) setCctxPruningCursor(ctx sdk.Context, cctxStatus types.CctxStatus, cursor []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxPruningCursorKey))
+	if cursor == nil {
+		store.Delete([]byte(cctxStatus.String()))
+		return
+	}
+	store.Set([]byte(cctxStatus.String()), cursor)
+}
+
+// getBallotMaturityBlocks returns the number of blocks after which the ballots are rewarded by the emissions module
+func (k Keeper) getBallotMaturityBlocks(ctx sdk.Context) int64 {
+	params, found := k.GetEmissionsKeeper().GetParams(ctx)
+	if !found {
+		return int64(emissionstypes.BallotMaturityBlocks)
+	}
+	return params.BallotMaturityBlocks
+}
+
+// areCctxBallotsMatured returns true if all the ballots of the cctx are matured
+// the ballots are read by the emissions module to distribute the rewards and must not be deleted before
+func (k Keeper) areCctxBallotsMatured(ctx sdk.Context, cctx types.CrossChainTx, ballotMaturityBlocks int64) bool {
+	for _, ballotIndex := range cctxBallotIndexes(cctx) {
+		ballot, found := k.GetObserverKeeper().GetBallot(ctx, ballotIndex)
+		if found && ballot.BallotCreationHeight+ballotMaturityBlocks >= ctx.BlockHeight() {
+			return false
+		}
+	}
+	return true
+}
+
+// deleteCctxBallots removes the inbound and outbound ballots of the cctx, with their entries in the ballot lists
+func (k Keeper) deleteCctxBallots(ctx sdk.Context, cctx types.CrossChainTx) {
+	for _, ballotIndex := range cctxBallotIndexes(cctx) {
+		k.GetObserverKeeper().DeleteBallot(ctx, ballotIndex)
+	}
+}
+
+// cctxBallotIndexes returns the indexes of the inbound and outbound ballots of the cctx
+func cctxBallotIndexes(cctx types.CrossChainTx) []string {
+	indexes := make([]string, 0, len(cctx.OutboundParams)+1)
+	if cctx.InboundParams != nil && cctx.InboundParams.BallotIndex != "" {
+		indexes = append(indexes, cctx.InboundParams.BallotIndex)
+	}
+	for _, outbound := range cctx.OutboundParams {
+		if outbound != nil && outbound.BallotIndex != "" {
+			indexes = append(indexes, outbound.BallotIndex)
+		}
+	}
+	return indexes
+}
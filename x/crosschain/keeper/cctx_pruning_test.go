@@ -0,0 +1,217 @@
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	emissionstypes "github.com/zeta-chain/zetacore/x/emissions/types"
+)
+
+// archivedCctxIndexes returns the indexes of the cctxs in the archival events
+func archivedCctxIndexes(t *testing.T, ctx sdk.Context) []string {
+	var indexes []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != "zetachain.zetacore.crosschain.EventCCTXArchived" {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		archived, ok := msg.(*types.EventCCTXArchived)
+		require.True(t, ok)
+		require.NotNil(t, archived.Cctx)
+		require.Equal(t, archived.CctxIndex, archived.Cctx.Index)
+		indexes = append(indexes, archived.CctxIndex)
+	}
+	return indexes
+}
+
+func TestKeeper_PruneCctxs(t *testing.T) {
+	policy := types.CctxRetentionPolicy{
+		Enabled:           true,
+		RetentionBlocks:   types.MinCctxRetentionBlocks,
+		MaxPrunedPerBlock: 10,
+	}
+	height := int64(types.MinCctxRetentionBlocks + 100)
+
+	t.Run("should not prune if the policy is not set or disabled", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(height)
+		setCctxForFilter(t, k, ctx, 1, sample.EthAddress().Hex(), 56, types.CctxStatus_OutboundMined)
+
+		require.Equal(t, 0, k.PruneCctxs(ctx))
+
+		disabled := policy
+		disabled.Enabled = false
+		k.SetCctxRetentionPolicy(ctx, disabled)
+		require.Equal(t, 0, k.PruneCctxs(ctx))
+		require.Len(t, k.GetAllCrossChainTx(ctx), 1)
+	})
+
+	t.Run("should prune the finalized cctxs past the retention period", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(height)
+		k.SetCctxRetentionPolicy(ctx, policy)
+		sender := sample.EthAddress().Hex()
+
+		mined := setCctxForFilter(t, k, ctx, 10, sender, 56, types.CctxStatus_OutboundMined)
+		reverted := setCctxForFilter(t, k, ctx, 20, sender, 56, types.CctxStatus_Reverted)
+		refunded := setCctxForFilter(t, k, ctx, 30, sender, 56, types.CctxStatus_Aborted)
+		refunded.CctxStatus.IsAbortRefunded = true
+		k.SetCrossChainTx(ctx, refunded)
+		aborted := setCctxForFilter(t, k, ctx, 40, sender, 56, types.CctxStatus_Aborted)
+		pending := setCctxForFilter(t, k, ctx, 50, sender, 56, types.CctxStatus_PendingOutbound)
+		recent := setCctxForFilter(t, k, ctx, 100, sender, 56, types.CctxStatus_OutboundMined)
+		noHeight := setCctxForFilter(t, k, ctx, 0, sender, 56, types.CctxStatus_OutboundMined)
+
+		// set the ballots and the inbound hash of the pruned cctx
+		for _, index := range []string{mined.InboundParams.BallotIndex, mined.OutboundParams[0].BallotIndex} {
+			ballot := sample.Ballot(t, index)
+			ballot.BallotIdentifier = index
+			ballot.BallotCreationHeight = 10
+			zk.ObserverKeeper.SetBallot(ctx, ballot)
+		}
+		k.SetInboundHashToCctx(ctx, types.InboundHashToCctx{
+			InboundHash: mined.InboundParams.ObservedHash,
+			CctxIndex:   []string{mined.Index},
+		})
+
+		require.Equal(t, 3, k.PruneCctxs(ctx))
+		require.ElementsMatch(
+			t,
+			[]string{mined.Index, reverted.Index, refunded.Index},
+			archivedCctxIndexes(t, ctx),
+		)
+
+		for _, cctx := range []types.CrossChainTx{mined, reverted, refunded} {
+			_, found := k.GetCrossChainTx(ctx, cctx.Index)
+			require.False(t, found)
+		}
+		for _, cctx := range []types.CrossChainTx{aborted, pending, recent, noHeight} {
+			_, found := k.GetCrossChainTx(ctx, cctx.Index)
+			require.True(t, found)
+		}
+
+		// the ballots are removed
+		_, found := zk.ObserverKeeper.GetBallot(ctx, mined.InboundParams.BallotIndex)
+		require.False(t, found)
+		_, found = zk.ObserverKeeper.GetBallot(ctx, mined.OutboundParams[0].BallotIndex)
+		require.False(t, found)
+
+		// the inbound hash is kept for replay protection
+		inboundHashToCctx, found := k.GetInboundHashToCctx(ctx, mined.InboundParams.ObservedHash)
+		require.True(t, found)
+		require.Equal(t, []string{mined.Index}, inboundHashToCctx.CctxIndex)
+
+		// the indexes of the pruned cctxs are removed
+		res, err := k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{Sender: sender})
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			[]string{noHeight.Index, aborted.Index, pending.Index, recent.Index},
+			cctxIndexes(res.CrossChainTx),
+		)
+
+		// nothing left to prune
+		require.Equal(t, 0, k.PruneCctxs(ctx.WithEventManager(sdk.NewEventManager())))
+	})
+
+	t.Run("should not prune the cctxs with ballots not matured", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(height)
+		k.SetCctxRetentionPolicy(ctx, policy)
+		cctx := setCctxForFilter(t, k, ctx, 10, sample.EthAddress().Hex(), 56, types.CctxStatus_OutboundMined)
+
+		// the outbound ballot is created after the inbound and is not matured
+		params, found := zk.EmissionsKeeper.GetParams(ctx)
+		require.True(t, found)
+		ballot := sample.Ballot(t, cctx.OutboundParams[0].BallotIndex)
+		ballot.BallotIdentifier = cctx.OutboundParams[0].BallotIndex
+		ballot.BallotCreationHeight = height - params.BallotMaturityBlocks
+		zk.ObserverKeeper.SetBallot(ctx, ballot)
+
+		require.Equal(t, 0, k.PruneCctxs(ctx))
+		_, found = k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		_, found = zk.ObserverKeeper.GetBallot(ctx, ballot.BallotIdentifier)
+		require.True(t, found)
+
+		// the cctx is pruned once the ballot is matured
+		require.Equal(t, 1, k.PruneCctxs(ctx.WithBlockHeight(height+1)))
+		_, found = k.GetCrossChainTx(ctx, cctx.Index)
+		require.False(t, found)
+		_, found = zk.ObserverKeeper.GetBallot(ctx, ballot.BallotIdentifier)
+		require.False(t, found)
+	})
+
+	t.Run("should use the default ballot maturity if the emissions params are not set", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseEmissionsMock: true,
+		})
+		emissionsMock := keepertest.GetCrosschainEmissionsMock(t, k)
+		emissionsMock.On("GetParams", mock.Anything).Return(emissionstypes.Params{}, false)
+		ctx = ctx.WithBlockHeight(height)
+		k.SetCctxRetentionPolicy(ctx, policy)
+		cctx := setCctxForFilter(t, k, ctx, 10, sample.EthAddress().Hex(), 56, types.CctxStatus_OutboundMined)
+
+		ballot := sample.Ballot(t, cctx.InboundParams.BallotIndex)
+		ballot.BallotIdentifier = cctx.InboundParams.BallotIndex
+		ballot.BallotCreationHeight = height - int64(emissionstypes.BallotMaturityBlocks)
+		zk.ObserverKeeper.SetBallot(ctx, ballot)
+
+		require.Equal(t, 0, k.PruneCctxs(ctx))
+		require.Equal(t, 1, k.PruneCctxs(ctx.WithBlockHeight(height+1)))
+	})
+
+	t.Run("should prune at most the max pruned cctxs per block", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(height)
+		limited := policy
+		limited.MaxPrunedPerBlock = 2
+		k.SetCctxRetentionPolicy(ctx, limited)
+		for i := uint64(1); i <= 5; i++ {
+			setCctxForFilter(t, k, ctx, i, sample.EthAddress().Hex(), 56, types.CctxStatus_OutboundMined)
+		}
+
+		require.Equal(t, 2, k.PruneCctxs(ctx))
+		require.Len(t, k.GetAllCrossChainTx(ctx), 3)
+		require.Equal(t, 2, k.PruneCctxs(ctx))
+		require.Equal(t, 1, k.PruneCctxs(ctx))
+		require.Empty(t, k.GetAllCrossChainTx(ctx))
+	})
+
+	t.Run("should resume the scan after the cctxs that can't be pruned", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(height)
+		limited := policy
+		limited.MaxPrunedPerBlock = 1
+		k.SetCctxRetentionPolicy(ctx, limited)
+
+		// more aborted cctxs not refunded than scanned per block, ahead of a refunded one
+		aborted := make([]types.CrossChainTx, 0, 15)
+		for i := uint64(1); i <= 15; i++ {
+			cctx := setCctxForFilter(t, k, ctx, i, sample.EthAddress().Hex(), 56, types.CctxStatus_Aborted)
+			aborted = append(aborted, cctx)
+		}
+		refunded := setCctxForFilter(t, k, ctx, 20, sample.EthAddress().Hex(), 56, types.CctxStatus_Aborted)
+		refunded.CctxStatus.IsAbortRefunded = true
+		k.SetCrossChainTx(ctx, refunded)
+
+		require.Equal(t, 0, k.PruneCctxs(ctx))
+		require.Equal(t, 1, k.PruneCctxs(ctx))
+		_, found := k.GetCrossChainTx(ctx, refunded.Index)
+		require.False(t, found)
+
+		// the next pass starts again from the first cctx
+		aborted[0].CctxStatus.IsAbortRefunded = true
+		k.SetCrossChainTx(ctx, aborted[0])
+		require.Equal(t, 1, k.PruneCctxs(ctx))
+		require.Len(t, k.GetAllCrossChainTx(ctx), 14)
+	})
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// SetCctxRetentionPolicy set the cctx retention policy in the store
+func (k Keeper) SetCctxRetentionPolicy(ctx sdk.Context, policy types.CctxRetentionPolicy) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxRetentionPolicyKey))
+	b := k.cdc.MustMarshal(&policy)
+	store.Set([]byte{0}, b)
+}
+
+// GetCctxRetentionPolicy returns the cctx retention policy
+func (k Keeper) GetCctxRetentionPolicy(ctx sdk.Context) (val types.CctxRetentionPolicy, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxRetentionPolicyKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
@@ -9,19 +9,19 @@ import (
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 )
 
-func EmitEventInboundFinalized(ctx sdk.Context, cctx *types.CrossChainTx) {
+func EmitEventInboundFinalized(ctx sdk.Context, cctx *types.CrossChainTx, additionalChains []chains.Chain) {
 	currentOutParam := cctx.GetCurrentOutboundParam()
 	err := ctx.EventManager().EmitTypedEvents(&types.EventInboundFinalized{
 		MsgTypeUrl:         sdk.MsgTypeURL(&types.MsgVoteInbound{}),
 		CctxIndex:          cctx.Index,
 		Sender:             cctx.InboundParams.Sender,
-		SenderChain:        chains.GetChainFromChainID(cctx.InboundParams.SenderChainId).ChainName.String(),
+		SenderChain:        chains.GetChainFromChainID(cctx.InboundParams.SenderChainId, additionalChains).ChainName.String(),
 		TxOrgin:            cctx.InboundParams.TxOrigin,
 		Asset:              cctx.InboundParams.Asset,
 		InboundHash:        cctx.InboundParams.ObservedHash,
 		InboundBlockHeight: strconv.FormatUint(cctx.InboundParams.ObservedExternalHeight, 10),
 		Receiver:           currentOutParam.Receiver,
-		ReceiverChain:      chains.GetChainFromChainID(currentOutParam.ReceiverChainId).ChainName.String(),
+		ReceiverChain:      chains.GetChainFromChainID(currentOutParam.ReceiverChainId, additionalChains).ChainName.String(),
 		Amount:             cctx.InboundParams.Amount.String(),
 		RelayedMessage:     cctx.RelayedMessage,
 		NewStatus:          cctx.CctxStatus.Status.String(),
@@ -85,3 +85,12 @@ func EmitOutboundFailure(ctx sdk.Context, valueReceived string, oldStatus string
 		ctx.Logger().Error("Error emitting MsgVoteOutbound :", err)
 	}
 }
+
+// EmitCCTXArchived emits the archival event of the cctx pruned from the state
+// the event contains the full cctx so it can be retrieved from the event indexer
+func EmitCCTXArchived(ctx sdk.Context, cctx types.CrossChainTx) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventCCTXArchived{
+		CctxIndex: cctx.Index,
+		Cctx:      &cctx,
+	})
+}
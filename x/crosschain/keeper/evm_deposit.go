@@ -76,7 +76,11 @@ func (k Keeper) HandleEVMDeposit(ctx sdk.Context, cctx *types.CrossChainTx) (boo
 			to = parsedAddress
 		}
 
-		from, err := chains.DecodeAddressFromChainID(inboundSenderChainID, inboundSender)
+		from, err := chains.DecodeAddressFromChainID(
+			inboundSenderChainID,
+			inboundSender,
+			k.GetAuthorityKeeper().GetAdditionalChainList(ctx),
+		)
 		if err != nil {
 			return false, fmt.Errorf("HandleEVMDeposit: unable to decode address: %s", err.Error())
 		}
@@ -114,7 +114,11 @@ func (k Keeper) ProcessLogs(
 
 			// If Validation fails, we will not process the event and return and error. This condition means that the event was correct, and emitted from a registered ZRC20 contract
 			// But the information entered by the user is incorrect. In this case we can return an error and roll back the transaction
-			if err := ValidateZrc20WithdrawEvent(eventZrc20Withdrawal, coin.ForeignChainId); err != nil {
+			if err := ValidateZrc20WithdrawEvent(
+				eventZrc20Withdrawal,
+				coin.ForeignChainId,
+				k.GetAuthorityKeeper().GetAdditionalChainList(ctx),
+			); err != nil {
 				return err
 			}
 			// If the event is valid, we will process it and create a new CCTX
@@ -189,17 +193,20 @@ func (k Keeper) ProcessZRC20WithdrawalEvent(
 	)
 
 	// Create a new cctx with status as pending Inbound, this is created directly from the event without waiting for any observer votes
-	cctx, err := types.NewCCTX(ctx, *msg, tss.TssPubkey)
+	cctx, err := types.NewCCTX(ctx, *msg, tss.TssPubkey, k.GetAuthorityKeeper().GetAdditionalChainList(ctx))
 	if err != nil {
 		return fmt.Errorf("ProcessZRC20WithdrawalEvent: failed to initialize cctx: %s", err.Error())
 	}
 	cctx.SetPendingOutbound("ZRC20 withdrawal event setting to pending outbound directly")
 	// Get gas price and amount
-	gasprice, found := k.GetGasPrice(ctx, receiverChain.ChainId)
-	if !found {
-		return fmt.Errorf("gasprice not found for %s", receiverChain)
+	// the outbounds to IBC chains are ICS-20 transfers relayed by the IBC relayers, no gas price is required
+	if !receiverChain.IsIBCChain() {
+		gasprice, found := k.GetGasPrice(ctx, receiverChain.ChainId)
+		if !found {
+			return fmt.Errorf("gasprice not found for %s", receiverChain)
+		}
+		cctx.GetCurrentOutboundParam().GasPrice = fmt.Sprintf("%d", gasprice.Prices[gasprice.MedianIndex])
 	}
-	cctx.GetCurrentOutboundParam().GasPrice = fmt.Sprintf("%d", gasprice.Prices[gasprice.MedianIndex])
 	cctx.GetCurrentOutboundParam().Amount = cctx.InboundParams.Amount
 
 	EmitZRCWithdrawCreated(ctx, cctx)
@@ -269,7 +276,7 @@ func (k Keeper) ProcessZetaSentEvent(
 
 	// create a new cctx with status as pending Inbound,
 	// this is created directly from the event without waiting for any observer votes
-	cctx, err := types.NewCCTX(ctx, *msg, tss.TssPubkey)
+	cctx, err := types.NewCCTX(ctx, *msg, tss.TssPubkey, k.GetAuthorityKeeper().GetAdditionalChainList(ctx))
 	if err != nil {
 		return fmt.Errorf("ProcessZetaSentEvent: failed to initialize cctx: %s", err.Error())
 	}
@@ -295,10 +302,18 @@ func (k Keeper) ProcessCCTX(ctx sdk.Context, cctx types.CrossChainTx, receiverCh
 		cctx.InboundParams.ObservedHash = inCctxIndex
 	}
 
-	if err := k.UpdateNonce(ctx, receiverChain.ChainId, &cctx); err != nil {
+	if receiverChain.IsIBCChain() {
+		// the outbound is sent as an ICS-20 transfer, there is no TSS nonce
+		if err := k.ibcCrosschainKeeper.InitiateOutbound(ctx, &cctx); err != nil {
+			return fmt.Errorf("ProcessWithdrawalEvent: initiate IBC outbound failed: %s", err.Error())
+		}
+	} else if err := k.UpdateNonce(ctx, receiverChain.ChainId, &cctx); err != nil {
 		return fmt.Errorf("ProcessWithdrawalEvent: update nonce failed: %s", err.Error())
 	}
 
+	// the inbound of a cctx created from a zEVM event is finalized in the current block
+	// #nosec G701 always positive
+	cctx.InboundParams.FinalizedZetaHeight = uint64(ctx.BlockHeight())
 	k.SetCctxAndNonceToCctxAndInboundHashToCctx(ctx, cctx)
 	ctx.Logger().Debug("ProcessCCTX successful \n")
 	return nil
@@ -322,11 +337,11 @@ func ParseZRC20WithdrawalEvent(log ethtypes.Log) (*zrc20.ZRC20Withdrawal, error)
 }
 
 // ValidateZrc20WithdrawEvent checks if the ZRC20Withdrawal event is valid
-// It verifies event information for BTC chains and returns an error if the event is invalid
-func ValidateZrc20WithdrawEvent(event *zrc20.ZRC20Withdrawal, chainID int64) error {
+// It verifies event information for BTC and Solana chains and returns an error if the event is invalid
+func ValidateZrc20WithdrawEvent(event *zrc20.ZRC20Withdrawal, chainID int64, additionalChains []chains.Chain) error {
 	// The event was parsed; that means the user has deposited tokens to the contract.
 
-	if chains.IsBitcoinChain(chainID) {
+	if chains.IsBitcoinChain(chainID, additionalChains) {
 		if event.Value.Cmp(big.NewInt(0)) <= 0 {
 			return fmt.Errorf("ParseZRC20WithdrawalEvent: invalid amount %s", event.Value.String())
 		}
@@ -337,6 +352,13 @@ func ValidateZrc20WithdrawEvent(event *zrc20.ZRC20Withdrawal, chainID int64) err
 		if !chains.IsBtcAddressSupported(addr) {
 			return fmt.Errorf("ParseZRC20WithdrawalEvent: unsupported address %s", string(event.To))
 		}
+	} else if chains.IsSolanaChain(chainID, additionalChains) {
+		if event.Value.Cmp(big.NewInt(0)) <= 0 {
+			return fmt.Errorf("ParseZRC20WithdrawalEvent: invalid amount %s", event.Value.String())
+		}
+		if _, err := chains.DecodeSolanaAddress(string(event.To)); err != nil {
+			return fmt.Errorf("ParseZRC20WithdrawalEvent: invalid address %s: %s", event.To, err)
+		}
 	}
 	return nil
 }
@@ -160,7 +160,7 @@ func TestValidateZrc20WithdrawEvent(t *testing.T) {
 			*sample.GetValidZRC20WithdrawToBTC(t).Logs[3],
 		)
 		require.NoError(t, err)
-		err = crosschainkeeper.ValidateZrc20WithdrawEvent(btcMainNetWithdrawalEvent, chains.BitcoinMainnet.ChainId)
+		err = crosschainkeeper.ValidateZrc20WithdrawEvent(btcMainNetWithdrawalEvent, chains.BitcoinMainnet.ChainId, []chains.Chain{})
 		require.NoError(t, err)
 	})
 
@@ -170,7 +170,7 @@ func TestValidateZrc20WithdrawEvent(t *testing.T) {
 		)
 		require.NoError(t, err)
 		btcMainNetWithdrawalEvent.Value = big.NewInt(0)
-		err = crosschainkeeper.ValidateZrc20WithdrawEvent(btcMainNetWithdrawalEvent, chains.BitcoinMainnet.ChainId)
+		err = crosschainkeeper.ValidateZrc20WithdrawEvent(btcMainNetWithdrawalEvent, chains.BitcoinMainnet.ChainId, []chains.Chain{})
 		require.ErrorContains(t, err, "ParseZRC20WithdrawalEvent: invalid amount")
 	})
 
@@ -179,7 +179,7 @@ func TestValidateZrc20WithdrawEvent(t *testing.T) {
 			*sample.GetValidZRC20WithdrawToBTC(t).Logs[3],
 		)
 		require.NoError(t, err)
-		err = crosschainkeeper.ValidateZrc20WithdrawEvent(btcMainNetWithdrawalEvent, chains.BitcoinTestnet.ChainId)
+		err = crosschainkeeper.ValidateZrc20WithdrawEvent(btcMainNetWithdrawalEvent, chains.BitcoinTestnet.ChainId, []chains.Chain{})
 		require.ErrorContains(t, err, "invalid address")
 	})
 
@@ -190,9 +190,28 @@ func TestValidateZrc20WithdrawEvent(t *testing.T) {
 		require.NoError(t, err)
 		btcMainNetWithdrawalEvent.To = []byte("04b2891ba8cb491828db3ebc8a780d43b169e7b3974114e6e50f9bab6ec" +
 			"63c2f20f6d31b2025377d05c2a704d3bd799d0d56f3a8543d79a01ab6084a1cb204f260")
-		err = crosschainkeeper.ValidateZrc20WithdrawEvent(btcMainNetWithdrawalEvent, chains.BitcoinMainnet.ChainId)
+		err = crosschainkeeper.ValidateZrc20WithdrawEvent(btcMainNetWithdrawalEvent, chains.BitcoinMainnet.ChainId, []chains.Chain{})
 		require.ErrorContains(t, err, "unsupported address")
 	})
+
+	t.Run("successfully validate a valid event to solana chain", func(t *testing.T) {
+		withdrawalEvent, err := crosschainkeeper.ParseZRC20WithdrawalEvent(
+			*sample.GetValidZRC20WithdrawToBTC(t).Logs[3],
+		)
+		require.NoError(t, err)
+		withdrawalEvent.To = []byte("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM")
+		err = crosschainkeeper.ValidateZrc20WithdrawEvent(withdrawalEvent, chains.SolanaMainnet.ChainId, []chains.Chain{})
+		require.NoError(t, err)
+	})
+
+	t.Run("unable to validate an invalid solana address", func(t *testing.T) {
+		withdrawalEvent, err := crosschainkeeper.ParseZRC20WithdrawalEvent(
+			*sample.GetValidZRC20WithdrawToBTC(t).Logs[3],
+		)
+		require.NoError(t, err)
+		err = crosschainkeeper.ValidateZrc20WithdrawEvent(withdrawalEvent, chains.SolanaMainnet.ChainId, []chains.Chain{})
+		require.ErrorContains(t, err, "invalid address")
+	})
 }
 
 func TestKeeper_ProcessZRC20WithdrawalEvent(t *testing.T) {
@@ -859,3 +878,56 @@ func TestKeeper_ProcessLogs(t *testing.T) {
 		require.Len(t, cctxList, 0)
 	})
 }
+
+func TestKeeper_ProcessCCTX(t *testing.T) {
+	t.Run("should initiate the outbound to an IBC chain without nonce", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock:     true,
+			UseIBCCrosschainMock: true,
+		})
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		ibcChain := sample.IBCChain(4200)
+
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockGetChainList(&authorityMock.Mock, []chains.Chain{ibcChain})
+		ibcCrosschainMock := keepertest.GetCrosschainIBCCrosschainMock(t, k)
+		ibcCrosschainMock.On("InitiateOutbound", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*crosschaintypes.CrossChainTx).GetCurrentOutboundParam().Hash = "transfer/channel-0/1"
+			}).
+			Return(nil)
+
+		cctx := sample.CrossChainTx(t, "ibc")
+		cctx.CctxStatus.Status = crosschaintypes.CctxStatus_PendingOutbound
+		cctx.GetCurrentOutboundParam().ReceiverChainId = ibcChain.ChainId
+		cctx.GetCurrentOutboundParam().TssNonce = 0
+		cctx.InboundParams.FinalizedZetaHeight = 0
+
+		ctx = ctx.WithBlockHeight(42)
+		err := k.ProcessCCTX(ctx, *cctx, &ibcChain)
+		require.NoError(t, err)
+		got, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, "transfer/channel-0/1", got.GetCurrentOutboundParam().Hash)
+		require.EqualValues(t, 42, got.InboundParams.FinalizedZetaHeight)
+		_, found = zk.ObserverKeeper.GetNonceToCctx(ctx, tss.TssPubkey, ibcChain.ChainId, 0)
+		require.False(t, found)
+	})
+
+	t.Run("should fail if the outbound to an IBC chain can't be initiated", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseIBCCrosschainMock: true,
+		})
+		ibcChain := sample.IBCChain(4200)
+
+		ibcCrosschainMock := keepertest.GetCrosschainIBCCrosschainMock(t, k)
+		ibcCrosschainMock.On("InitiateOutbound", mock.Anything, mock.Anything).Return(fmt.Errorf("no channel"))
+
+		cctx := sample.CrossChainTx(t, "ibc")
+		cctx.GetCurrentOutboundParam().ReceiverChainId = ibcChain.ChainId
+
+		err := k.ProcessCCTX(ctx, *cctx, &ibcChain)
+		require.ErrorContains(t, err, "no channel")
+	})
+}
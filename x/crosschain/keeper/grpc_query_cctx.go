@@ -140,13 +140,13 @@ func (k Keeper) ListPendingCctx(
 		startNonce = 0
 	}
 	for i := startNonce; i < pendingNonces.NonceLow; i++ {
-		cctx, err := getCctxByChainIDAndNonce(k, ctx, tss.TssPubkey, req.ChainId, i)
+		cctx, err := getLookbackCctxByChainIDAndNonce(k, ctx, tss.TssPubkey, req.ChainId, i)
 		if err != nil {
 			return nil, err
 		}
 
 		// only take a `limit` number of pending cctxs as result but still count the total pending cctxs
-		if IsPending(cctx) {
+		if cctx != nil && IsPending(cctx) {
 			totalPending++
 			if !maxCCTXsReached() {
 				cctxs = append(cctxs, cctx)
@@ -194,3 +194,26 @@ func getCctxByChainIDAndNonce(
 	}
 	return &cctx, nil
 }
+
+// getLookbackCctxByChainIDAndNonce returns the cctx by chainID and nonce when looking back for missed pending cctxs
+// the cctx is nil if it was pruned by the retention policy, the pruned cctxs are not pending
+func getLookbackCctxByChainIDAndNonce(
+	k Keeper,
+	ctx sdk.Context,
+	tssPubkey string,
+	chainID int64,
+	nonce int64,
+) (*types.CrossChainTx, error) {
+	nonceToCctx, found := k.GetObserverKeeper().GetNonceToCctx(ctx, tssPubkey, chainID, nonce)
+	if !found {
+		return nil, status.Error(
+			codes.Internal,
+			fmt.Sprintf("nonceToCctx not found: chainid %d, nonce %d", chainID, nonce),
+		)
+	}
+	cctx, found := k.GetCrossChainTx(ctx, nonceToCctx.CctxIndex)
+	if !found {
+		return nil, nil
+	}
+	return &cctx, nil
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+const (
+	// MaxCctxsByFilter is the maximum number of cctxs that can be queried by filter in a page
+	MaxCctxsByFilter = 500
+
+	// MaxScannedCctxsByFilter is the maximum number of index entries scanned for a page of cctxs queried by filter
+	// the page is returned with the next key once reached, even if it is not full
+	MaxScannedCctxsByFilter = 10000
+)
+
+// CctxAllByFilter returns the cctxs matching all the filters of the request
+// the cctxs are iterated by the zeta height they were created at from the most selective index of the filters
+// the existing cctxs are indexed over several blocks after the upgrade to the consensus version 6, they are only
+// returned once indexed
+func (k Keeper) CctxAllByFilter(
+	c context.Context,
+	req *types.QueryAllCctxByFilterRequest,
+) (*types.QueryAllCctxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.MaxHeight != 0 && req.MinHeight > req.MaxHeight {
+		return nil, status.Error(codes.InvalidArgument, "min height is greater than max height")
+	}
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset != 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset pagination is not supported, use the next key")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	} else if limit > MaxCctxsByFilter {
+		limit = MaxCctxsByFilter
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// iterate the entries of the index within the height range
+	indexPrefix := cctxIndexPrefixForFilter(req)
+	start := types.CctxIndexHeightKey(indexPrefix, req.MinHeight)
+	end := storetypes.PrefixEndBytes(indexPrefix)
+	if req.MaxHeight != 0 && req.MaxHeight < ^uint64(0) {
+		end = types.CctxIndexHeightKey(indexPrefix, req.MaxHeight+1)
+	}
+	if len(pageReq.Key) > 0 {
+		if bytes.Compare(pageReq.Key, start) < 0 || bytes.Compare(pageReq.Key, end) >= 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid pagination key for the filters")
+		}
+		if pageReq.Reverse {
+			// the next key is included
+			end = append(slices.Clone(pageReq.Key), 0)
+		} else {
+			start = pageReq.Key
+		}
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxIndexKeyPrefix))
+	var iterator storetypes.Iterator
+	if pageReq.Reverse {
+		iterator = store.ReverseIterator(start, end)
+	} else {
+		iterator = store.Iterator(start, end)
+	}
+	defer iterator.Close()
+
+	cctxs := make([]*types.CrossChainTx, 0)
+	var nextKey []byte
+	for scanned := 0; iterator.Valid(); iterator.Next() {
+		// #nosec G701 len always positive
+		if uint64(len(cctxs)) >= limit || scanned >= MaxScannedCctxsByFilter {
+			nextKey = slices.Clone(iterator.Key())
+			break
+		}
+		scanned++
+
+		cctx, found := k.GetCrossChainTx(ctx, string(iterator.Value()))
+		if !found {
+			return nil, status.Errorf(codes.Internal, "cctx not found: index %s", iterator.Value())
+		}
+		if matchCctxFilter(cctx, req) {
+			cctxs = append(cctxs, &cctx)
+		}
+	}
+
+	return &types.QueryAllCctxResponse{
+		CrossChainTx: cctxs,
+		Pagination:   &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
+// cctxIndexPrefixForFilter returns the prefix of the most selective index of the filters
+func cctxIndexPrefixForFilter(req *types.QueryAllCctxByFilterRequest) []byte {
+	switch {
+	case req.Sender != "":
+		return types.CctxIndexPrefix(types.CctxIndexSender, types.CctxIndexAddress(req.Sender))
+	case req.Receiver != "":
+		return types.CctxIndexPrefix(types.CctxIndexReceiver, types.CctxIndexAddress(req.Receiver))
+	case len(req.Statuses) == 1:
+		return types.CctxIndexPrefix(types.CctxIndexStatus, req.Statuses[0].String())
+	case req.ReceiverChainId != 0:
+		return types.CctxIndexPrefix(types.CctxIndexReceiverChainID, strconv.FormatInt(req.ReceiverChainId, 10))
+	case req.SenderChainId != 0:
+		return types.CctxIndexPrefix(types.CctxIndexSenderChainID, strconv.FormatInt(req.SenderChainId, 10))
+	default:
+		return types.CctxIndexPrefix(types.CctxIndexHeight, "")
+	}
+}
+
+// matchCctxFilter returns true if the cctx matches all the filters
+// the height range is not checked since it's the range of the iterated index entries
+func matchCctxFilter(cctx types.CrossChainTx, req *types.QueryAllCctxByFilterRequest) bool {
+	inbound := cctx.InboundParams
+	if inbound == nil {
+		inbound = &types.InboundParams{}
+	}
+	outbound := &types.OutboundParams{}
+	if len(cctx.OutboundParams) > 0 && cctx.OutboundParams[0] != nil {
+		outbound = cctx.OutboundParams[0]
+	}
+	cctxStatus := &types.Status{}
+	if cctx.CctxStatus != nil {
+		cctxStatus = cctx.CctxStatus
+	}
+
+	switch {
+	case req.Sender != "" && types.CctxIndexAddress(req.Sender) != types.CctxIndexAddress(inbound.Sender):
+		return false
+	case req.Receiver != "" && types.CctxIndexAddress(req.Receiver) != types.CctxIndexAddress(outbound.Receiver):
+		return false
+	case len(req.Statuses) > 0 && !slices.Contains(req.Statuses, cctxStatus.Status):
+		return false
+	case req.SenderChainId != 0 && req.SenderChainId != inbound.SenderChainId:
+		return false
+	case req.ReceiverChainId != 0 && req.ReceiverChainId != outbound.ReceiverChainId:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// setCctxForFilter sets a cctx created at the height with the given fields
+func setCctxForFilter(
+	t *testing.T,
+	k *keeper.Keeper,
+	ctx sdk.Context,
+	height uint64,
+	sender string,
+	receiverChainID int64,
+	status types.CctxStatus,
+) types.CrossChainTx {
+	cctx := sample.CrossChainTx(t, fmt.Sprintf("%d-%s-%d", height, sender, receiverChainID))
+	cctx.InboundParams.Sender = sender
+	cctx.InboundParams.SenderChainId = 1
+	cctx.InboundParams.FinalizedZetaHeight = height
+	cctx.OutboundParams[0].ReceiverChainId = receiverChainID
+	cctx.CctxStatus.Status = status
+	k.SetCrossChainTx(ctx, *cctx)
+	return *cctx
+}
+
+// cctxIndexes returns the indexes of the cctxs
+func cctxIndexes(cctxs []*types.CrossChainTx) []string {
+	indexes := make([]string, 0, len(cctxs))
+	for _, cctx := range cctxs {
+		indexes = append(indexes, cctx.Index)
+	}
+	return indexes
+}
+
+func TestKeeper_CctxAllByFilter(t *testing.T) {
+	senderA := sample.EthAddress().Hex()
+	senderB := sample.EthAddress().Hex()
+
+	setup := func(t *testing.T) (*keeper.Keeper, sdk.Context, []types.CrossChainTx) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		cctxs := []types.CrossChainTx{
+			setCctxForFilter(t, k, ctx, 10, senderA, 56, types.CctxStatus_OutboundMined),
+			setCctxForFilter(t, k, ctx, 11, senderB, 56, types.CctxStatus_Aborted),
+			setCctxForFilter(t, k, ctx, 12, senderA, 137, types.CctxStatus_Aborted),
+			setCctxForFilter(t, k, ctx, 13, senderA, 56, types.CctxStatus_Aborted),
+			setCctxForFilter(t, k, ctx, 14, senderB, 56, types.CctxStatus_Reverted),
+			setCctxForFilter(t, k, ctx, 15, senderA, 56, types.CctxStatus_PendingOutbound),
+		}
+		return k, ctx, cctxs
+	}
+
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.CctxAllByFilter(ctx, nil)
+		require.ErrorContains(t, err, "invalid request")
+	})
+
+	t.Run("should error if min height is greater than max height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{MinHeight: 10, MaxHeight: 9})
+		require.ErrorContains(t, err, "min height is greater than max height")
+	})
+
+	t.Run("should error if offset pagination is used", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{
+			Pagination: &query.PageRequest{Offset: 1},
+		})
+		require.ErrorContains(t, err, "offset pagination is not supported")
+	})
+
+	t.Run("should return all cctxs by height without filter", func(t *testing.T) {
+		k, ctx, cctxs := setup(t)
+		res, err := k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.CrossChainTx, len(cctxs))
+		for i, cctx := range cctxs {
+			require.Equal(t, cctx, *res.CrossChainTx[i])
+		}
+		require.Nil(t, res.Pagination.NextKey)
+	})
+
+	t.Run("should return cctxs by sender regardless of the case", func(t *testing.T) {
+		k, ctx, cctxs := setup(t)
+		res, err := k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{
+			Sender: strings.ToLower(senderA),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			cctxs[0].Index,
+			cctxs[2].Index,
+			cctxs[3].Index,
+			cctxs[5].Index,
+		}, cctxIndexes(res.CrossChainTx))
+	})
+
+	t.Run("should return cctxs matching all filters", func(t *testing.T) {
+		k, ctx, cctxs := setup(t)
+		res, err := k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{
+			Statuses:        []types.CctxStatus{types.CctxStatus_Aborted},
+			SenderChainId:   1,
+			ReceiverChainId: 56,
+			MinHeight:       11,
+			MaxHeight:       13,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{cctxs[1].Index, cctxs[3].Index}, cctxIndexes(res.CrossChainTx))
+
+		res, err = k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{
+			Sender:   senderB,
+			Statuses: []types.CctxStatus{types.CctxStatus_Aborted, types.CctxStatus_Reverted},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{cctxs[1].Index, cctxs[4].Index}, cctxIndexes(res.CrossChainTx))
+
+		res, err = k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{
+			ReceiverChainId: 137,
+			SenderChainId:   2,
+		})
+		require.NoError(t, err)
+		require.Empty(t, res.CrossChainTx)
+	})
+
+	t.Run("should paginate cctxs with the next key", func(t *testing.T) {
+		k, ctx, cctxs := setup(t)
+		for _, reverse := range []bool{false, true} {
+			var indexes []string
+			var nextKey []byte
+			for i := 0; ; i++ {
+				res, err := k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{
+					ReceiverChainId: 56,
+					Pagination:      &query.PageRequest{Key: nextKey, Limit: 2, Reverse: reverse},
+				})
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(res.CrossChainTx), 2)
+				indexes = append(indexes, cctxIndexes(res.CrossChainTx)...)
+				nextKey = res.Pagination.NextKey
+				if nextKey == nil {
+					break
+				}
+				require.Less(t, i, 3)
+			}
+			expected := []string{cctxs[0].Index, cctxs[1].Index, cctxs[3].Index, cctxs[4].Index, cctxs[5].Index}
+			if reverse {
+				expected = []string{cctxs[5].Index, cctxs[4].Index, cctxs[3].Index, cctxs[1].Index, cctxs[0].Index}
+			}
+			require.Equal(t, expected, indexes)
+		}
+	})
+
+	t.Run("should error if the next key is outside of the filters", func(t *testing.T) {
+		k, ctx, _ := setup(t)
+		res, err := k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{
+			ReceiverChainId: 56,
+			Pagination:      &query.PageRequest{Limit: 1},
+		})
+		require.NoError(t, err)
+
+		_, err = k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{
+			ReceiverChainId: 137,
+			Pagination:      &query.PageRequest{Key: res.Pagination.NextKey},
+		})
+		require.ErrorContains(t, err, "invalid pagination key")
+	})
+
+	t.Run("should update the indexes when the cctx is updated or removed", func(t *testing.T) {
+		k, ctx, cctxs := setup(t)
+		pending := &types.QueryAllCctxByFilterRequest{
+			Statuses: []types.CctxStatus{types.CctxStatus_PendingOutbound},
+		}
+		res, err := k.CctxAllByFilter(ctx, pending)
+		require.NoError(t, err)
+		require.Equal(t, []string{cctxs[5].Index}, cctxIndexes(res.CrossChainTx))
+
+		cctxs[5].CctxStatus.Status = types.CctxStatus_OutboundMined
+		k.SetCrossChainTx(ctx, cctxs[5])
+		res, err = k.CctxAllByFilter(ctx, pending)
+		require.NoError(t, err)
+		require.Empty(t, res.CrossChainTx)
+
+		mined := &types.QueryAllCctxByFilterRequest{
+			Statuses: []types.CctxStatus{types.CctxStatus_OutboundMined},
+		}
+		res, err = k.CctxAllByFilter(ctx, mined)
+		require.NoError(t, err)
+		require.Equal(t, []string{cctxs[0].Index, cctxs[5].Index}, cctxIndexes(res.CrossChainTx))
+
+		k.RemoveCrossChainTx(ctx, cctxs[0].Index)
+		res, err = k.CctxAllByFilter(ctx, mined)
+		require.NoError(t, err)
+		require.Equal(t, []string{cctxs[5].Index}, cctxIndexes(res.CrossChainTx))
+
+		res, err = k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.CrossChainTx, len(cctxs)-1)
+	})
+}
@@ -63,8 +63,9 @@ func (k Keeper) RateLimiterInput(
 
 	// if a cctx is an outgoing cctx that orginates from ZetaChain
 	// reverted incoming cctx has an external `SenderChainId` and should not be counted
+	additionalChains := k.GetAuthorityKeeper().GetAdditionalChainList(ctx)
 	isCCTXOutgoing := func(cctx *types.CrossChainTx) bool {
-		return chains.IsZetaChain(cctx.InboundParams.SenderChainId)
+		return chains.IsZetaChain(cctx.InboundParams.SenderChainId, additionalChains)
 	}
 
 	// it is a past cctx if its nonce < `nonceLow`,
@@ -75,12 +76,27 @@ func (k Keeper) RateLimiterInput(
 
 	// get foreign chains and conversion rates of foreign coins
 	chains := k.zetaObserverKeeper.GetSupportedForeignChains(ctx)
-	_, assetRates, found := k.GetRateLimiterAssetRateList(ctx)
+	rateLimitFlags, assetRates, found := k.GetRateLimiterAssetRateList(ctx)
 	if !found {
 		return nil, status.Error(codes.Internal, "asset rates not found")
 	}
 	gasAssetRateMap, erc20AssetRateMap := types.BuildAssetRateMapFromList(assetRates)
 
+	// the usages of the rate limits per chain and per asset, each evaluated within its own window
+	bucketUsages := types.NewRateLimitBucketUsages(k.GetRateLimitBuckets(ctx, rateLimitFlags))
+
+	// the cctxs are looked back to the widest window of the rate limits
+	lookbackWindowBoundary := leftWindowBoundary
+	for _, usage := range bucketUsages {
+		if boundary := usage.Bucket.LeftWindowBoundary(height); boundary < lookbackWindowBoundary {
+			lookbackWindowBoundary = boundary
+		}
+	}
+	isCCTXInLookbackWindow := func(cctx *types.CrossChainTx) bool {
+		// #nosec G701 checked positive
+		return cctx.InboundParams.ObservedExternalHeight >= uint64(lookbackWindowBoundary)
+	}
+
 	// query pending nonces of each foreign chain and get the lowest height of the pending cctxs
 	lowestPendingCctxHeight := int64(0)
 	pendingNoncesMap := make(map[int64]observertypes.PendingNonces)
@@ -102,6 +118,9 @@ func (k Keeper) RateLimiterInput(
 			if lowestPendingCctxHeight == 0 || cctxHeight < lowestPendingCctxHeight {
 				lowestPendingCctxHeight = cctxHeight
 			}
+			for i := range bucketUsages {
+				bucketUsages[i].UpdateLowestPendingCctxHeight(chain.ChainId, cctxHeight)
+			}
 		}
 	}
 
@@ -126,17 +145,24 @@ func (k Keeper) RateLimiterInput(
 
 		// go all the way back to the left window boundary or `NonceLow - 1000`, depending on which on arrives first
 		for nonce := startNonce; nonce >= 0; nonce-- {
-			cctx, err := getCctxByChainIDAndNonce(k, ctx, tss.TssPubkey, chain.ChainId, nonce)
+			cctx, err := getLookbackCctxByChainIDAndNonce(k, ctx, tss.TssPubkey, chain.ChainId, nonce)
 			if err != nil {
 				return nil, err
 			}
+			// the cctxs pruned by the retention policy are not pending and out of the window
+			if cctx == nil {
+				if nonce < endNonce {
+					break
+				}
+				continue
+			}
 			inWindow := isCCTXInWindow(cctx)
 			isOutgoing := isCCTXOutgoing(cctx)
 			isPast := isPastCctx(cctx, pendingNonces.NonceLow)
 
 			// we should at least go backwards by 1000 nonces to pick up missed pending cctxs
 			// we might go even further back if the endNonce hasn't hit the left window boundary yet
-			if nonce < endNonce && !inWindow {
+			if nonce < endNonce && !isCCTXInLookbackWindow(cctx) {
 				break
 			}
 
@@ -146,6 +172,11 @@ func (k Keeper) RateLimiterInput(
 					types.ConvertCctxValueToAzeta(chain.ChainId, cctx, gasAssetRateMap, erc20AssetRateMap),
 				)
 			}
+			if isOutgoing && isPast {
+				for i := range bucketUsages {
+					bucketUsages[i].AddPastCctx(cctx, height, gasAssetRateMap, erc20AssetRateMap)
+				}
+			}
 
 			// add cctx to corresponding list
 			if IsPending(cctx) {
@@ -156,6 +187,9 @@ func (k Keeper) RateLimiterInput(
 					cctxsPending = append(cctxsPending, cctx)
 					// sum up non-past pending cctxs' value
 					pendingCctxsValue = pendingCctxsValue.Add(types.ConvertCctxValueToAzeta(chain.ChainId, cctx, gasAssetRateMap, erc20AssetRateMap))
+					for i := range bucketUsages {
+						bucketUsages[i].AddPendingCctx(cctx, gasAssetRateMap, erc20AssetRateMap)
+					}
 				}
 			}
 		}
@@ -180,6 +214,7 @@ func (k Keeper) RateLimiterInput(
 		PastCctxsValue:          pastCctxsValue.String(),
 		PendingCctxsValue:       pendingCctxsValue.String(),
 		LowestPendingCctxHeight: lowestPendingCctxHeight,
+		BucketUsages:            bucketUsages,
 	}, nil
 }
 
@@ -208,12 +243,14 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 	foreignChains := k.zetaObserverKeeper.GetSupportedForeignChains(ctx)
 
 	// check rate limit flags to decide if we should apply rate limit
+	// the global rate limit and the rate limits per chain and per asset are applied together
 	applyLimit := true
 	rateLimitFlags, assetRates, found := k.GetRateLimiterAssetRateList(ctx)
 	if !found || !rateLimitFlags.Enabled {
 		applyLimit = false
 	}
-	if rateLimitFlags.Rate.IsNil() || rateLimitFlags.Rate.IsZero() {
+	applyGlobalLimit := rateLimitFlags.HasGlobalRateLimit()
+	if !applyGlobalLimit && !rateLimitFlags.HasBucketRateLimits() {
 		applyLimit = false
 	}
 
@@ -253,10 +290,27 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 	}
 
 	// initiate block limit and window limit in azeta; build asset rate maps
-	blockLimitInAzeta := sdkmath.NewIntFromBigInt(rateLimitFlags.Rate.BigInt())
+	blockLimitInAzeta := sdkmath.ZeroInt()
+	if applyGlobalLimit {
+		blockLimitInAzeta = sdkmath.NewIntFromBigInt(rateLimitFlags.Rate.BigInt())
+	}
 	windowLimitInAzeta := blockLimitInAzeta.Mul(sdkmath.NewInt(rateLimitFlags.Window))
 	gasAssetRateMap, erc20AssetRateMap := types.BuildAssetRateMapFromList(assetRates)
 
+	// the usages of the rate limits per chain and per asset, each evaluated within its own window
+	bucketUsages := types.NewRateLimitBucketUsages(k.GetRateLimitBuckets(ctx, rateLimitFlags))
+
+	// the cctxs are looked back to the widest window of the rate limits
+	lookbackWindowBoundary := height
+	if applyGlobalLimit {
+		lookbackWindowBoundary = leftWindowBoundary
+	}
+	for _, usage := range bucketUsages {
+		if boundary := usage.Bucket.LeftWindowBoundary(height); boundary < lookbackWindowBoundary {
+			lookbackWindowBoundary = boundary
+		}
+	}
+
 	// the criteria to stop adding cctxs to the rpc response
 	maxCCTXsReached := func(cctxs []*types.CrossChainTx) bool {
 		// #nosec G701 len always positive
@@ -269,10 +323,17 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 		return cctx.InboundParams.ObservedExternalHeight >= uint64(leftWindowBoundary)
 	}
 
+	// if a cctx falls within the widest window of the rate limits
+	isCCTXInLookbackWindow := func(cctx *types.CrossChainTx) bool {
+		// #nosec G701 checked positive
+		return cctx.InboundParams.ObservedExternalHeight >= uint64(lookbackWindowBoundary)
+	}
+
 	// if a cctx is outgoing from ZetaChain
 	// reverted incoming cctx has an external `SenderChainId` and should not be counted
+	additionalChains := k.GetAuthorityKeeper().GetAdditionalChainList(ctx)
 	isCCTXOutgoing := func(cctx *types.CrossChainTx) bool {
-		return chains.IsZetaChain(cctx.InboundParams.SenderChainId)
+		return chains.IsZetaChain(cctx.InboundParams.SenderChainId, additionalChains)
 	}
 
 	// query pending nonces for each foreign chain and get the lowest height of the pending cctxs
@@ -296,6 +357,9 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 			if lowestPendingCctxHeight == 0 || cctxHeight < lowestPendingCctxHeight {
 				lowestPendingCctxHeight = cctxHeight
 			}
+			for i := range bucketUsages {
+				bucketUsages[i].UpdateLowestPendingCctxHeight(chain.ChainId, cctxHeight)
+			}
 		}
 	}
 
@@ -327,21 +391,33 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 
 		// query cctx by nonce backwards to the left boundary of the rate limit sliding window
 		for nonce := startNonce; nonce >= 0; nonce-- {
-			cctx, err := getCctxByChainIDAndNonce(k, ctx, tss.TssPubkey, chain.ChainId, nonce)
+			cctx, err := getLookbackCctxByChainIDAndNonce(k, ctx, tss.TssPubkey, chain.ChainId, nonce)
 			if err != nil {
 				return nil, err
 			}
+			// the cctxs pruned by the retention policy are not pending and out of the window
+			if cctx == nil {
+				if nonce < endNonce {
+					break
+				}
+				continue
+			}
 			inWindow := isCCTXInWindow(cctx)
 			isOutgoing := isCCTXOutgoing(cctx)
 
 			// we should at least go backwards by 1000 nonces to pick up missed pending cctxs
 			// we might go even further back if rate limiter is enabled and the endNonce hasn't hit the left window boundary yet
 			// stop at the left window boundary if the `endNonce` hasn't hit it yet
-			if nonce < endNonce && !inWindow {
+			if nonce < endNonce && !isCCTXInLookbackWindow(cctx) {
 				break
 			}
+			if isOutgoing {
+				for i := range bucketUsages {
+					bucketUsages[i].AddPastCctx(cctx, height, gasAssetRateMap, erc20AssetRateMap)
+				}
+			}
 			// sum up the cctxs' value if the cctx is outgoing and within the window
-			if inWindow && isOutgoing &&
+			if inWindow && isOutgoing && applyGlobalLimit &&
 				types.RateLimitExceeded(
 					chain.ChainId,
 					cctx,
@@ -381,9 +457,14 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 				return nil, err
 			}
 			isOutgoing := isCCTXOutgoing(cctx)
+			if isOutgoing {
+				for i := range bucketUsages {
+					bucketUsages[i].AddPendingCctx(cctx, gasAssetRateMap, erc20AssetRateMap)
+				}
+			}
 
 			// skip the cctx if rate limit is exceeded but still accumulate the total withdraw value
-			if isOutgoing && types.RateLimitExceeded(
+			if isOutgoing && applyGlobalLimit && types.RateLimitExceeded(
 				chain.ChainId,
 				cctx,
 				gasAssetRateMap,
@@ -403,8 +484,21 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 	}
 
 	// if the rate limit is exceeded, only return the missed pending cctxs
+	// otherwise hold the pending cctxs of a chain from the first cctx of an exceeded chain or asset rate limit
 	if limitExceeded {
 		cctxs = cctxs[:missedPending]
+	} else {
+		heldNonces := types.RateLimitHeldNonces(cctxs[missedPending:], bucketUsages, height)
+		if len(heldNonces) > 0 {
+			limitExceeded = true
+			scheduled := cctxs[:missedPending]
+			for _, cctx := range cctxs[missedPending:] {
+				if !types.IsRateLimitHeld(cctx, heldNonces) {
+					scheduled = append(scheduled, cctx)
+				}
+			}
+			cctxs = scheduled
+		}
 	}
 
 	// sort the cctxs by chain ID and nonce (lower nonce holds higher priority for scheduling)
@@ -415,11 +509,16 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 		return cctxs[i].GetCurrentOutboundParam().ReceiverChainId < cctxs[j].GetCurrentOutboundParam().ReceiverChainId
 	})
 
+	currentWithdrawRate := sdkmath.ZeroInt()
+	if withdrawWindow > 0 {
+		currentWithdrawRate = totalWithdrawInAzeta.Quo(sdk.NewInt(withdrawWindow))
+	}
+
 	return &types.QueryListPendingCctxWithinRateLimitResponse{
 		CrossChainTx:          cctxs,
 		TotalPending:          totalPending,
 		CurrentWithdrawWindow: withdrawWindow,
-		CurrentWithdrawRate:   totalWithdrawInAzeta.Quo(sdk.NewInt(withdrawWindow)).String(),
+		CurrentWithdrawRate:   currentWithdrawRate.String(),
 		RateLimitExceeded:     limitExceeded,
 	}, nil
 }
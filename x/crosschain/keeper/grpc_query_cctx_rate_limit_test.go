@@ -986,6 +986,142 @@ func TestKeeper_ListPendingCctxWithinRateLimit(t *testing.T) {
 			expectedWithdrawRate:   sdk.NewInt(3e18).String(), // 3 ZETA, (2.5 + 0.5) per block
 			rateLimitExceeded:      true,
 		},
+		{
+			name: "can retrieve all pending cctxs without exceeding the chain rate limits",
+			rateLimitFlags: func() *types.RateLimiterFlags {
+				flags := createTestRateLimiterFlags(
+					0,
+					math.NewUint(0),
+					zrc20ETH,
+					zrc20BTC,
+					zrc20USDT,
+					"2500",
+					"50000",
+					"0.8",
+				)
+				flags.ChainRateLimits = []types.ChainRateLimit{
+					{ChainId: ethChainID, Window: 500, Rate: math.NewUint(3 * 1e18)},
+					{ChainId: btcChainID, Window: 500, Rate: math.NewUint(5 * 1e17)},
+				}
+				return flags
+			}(),
+			ethMinedCctxs:   ethMinedCctxs,
+			ethPendingCctxs: ethPendingCctxs,
+			ethPendingNonces: observertypes.PendingNonces{
+				ChainId:   ethChainID,
+				NonceLow:  1099,
+				NonceHigh: 1199,
+				Tss:       tss.TssPubkey,
+			},
+			btcMinedCctxs:   btcMinedCctxs,
+			btcPendingCctxs: btcPendingCctxs,
+			btcPendingNonces: observertypes.PendingNonces{
+				ChainId:   btcChainID,
+				NonceLow:  1099,
+				NonceHigh: 1199,
+				Tss:       tss.TssPubkey,
+			},
+			currentHeight:          1199,
+			queryLimit:             keeper.MaxPendingCctxs,
+			expectedCctxs:          append(append([]*types.CrossChainTx{}, ethPendingCctxs...), btcPendingCctxs...),
+			expectedTotalPending:   400,
+			expectedWithdrawWindow: 100, // [lowest pending cctx height, current height] = [1100, 1199]
+			expectedWithdrawRate:   "0", // no global rate limit
+			rateLimitExceeded:      false,
+		},
+		{
+			name: "should hold the pending cctxs of the chain exceeding its chain rate limit only",
+			// the btc cctxs are worth 0.5 ZETA per block, exceeding the btc chain rate 0.4 ZETA/block
+			rateLimitFlags: func() *types.RateLimiterFlags {
+				flags := createTestRateLimiterFlags(
+					0,
+					math.NewUint(0),
+					zrc20ETH,
+					zrc20BTC,
+					zrc20USDT,
+					"2500",
+					"50000",
+					"0.8",
+				)
+				flags.ChainRateLimits = []types.ChainRateLimit{
+					{ChainId: ethChainID, Window: 500, Rate: math.NewUint(3 * 1e18)},
+					{ChainId: btcChainID, Window: 500, Rate: math.NewUint(4 * 1e17)},
+				}
+				return flags
+			}(),
+			ethMinedCctxs:   ethMinedCctxs,
+			ethPendingCctxs: ethPendingCctxs,
+			ethPendingNonces: observertypes.PendingNonces{
+				ChainId:   ethChainID,
+				NonceLow:  1099,
+				NonceHigh: 1199,
+				Tss:       tss.TssPubkey,
+			},
+			btcMinedCctxs:   btcMinedCctxs,
+			btcPendingCctxs: btcPendingCctxs,
+			btcPendingNonces: observertypes.PendingNonces{
+				ChainId:   btcChainID,
+				NonceLow:  1099,
+				NonceHigh: 1199,
+				Tss:       tss.TssPubkey,
+			},
+			currentHeight: 1199,
+			queryLimit:    keeper.MaxPendingCctxs,
+			// return all eth cctxs and the missed btc cctxs only
+			expectedCctxs: append(
+				append([]*types.CrossChainTx{}, ethPendingCctxs...),
+				btcPendingCctxs[0:100]...),
+			expectedTotalPending:   400,
+			expectedWithdrawWindow: 100, // [lowest pending cctx height, current height] = [1100, 1199]
+			expectedWithdrawRate:   "0", // no global rate limit
+			rateLimitExceeded:      true,
+		},
+		{
+			name: "should hold the pending cctxs of the chain exceeding an asset rate limit in native units",
+			// the eth cctxs are worth 0.001 ETH per block, exceeding the native rate 0.0009 ETH/block
+			rateLimitFlags: func() *types.RateLimiterFlags {
+				flags := createTestRateLimiterFlags(
+					500,
+					math.NewUint(10*1e18),
+					zrc20ETH,
+					zrc20BTC,
+					zrc20USDT,
+					"2500",
+					"50000",
+					"0.8",
+				)
+				flags.AssetRateLimits = []types.AssetRateLimit{
+					{Zrc20: zrc20ETH, Window: 500, Rate: math.ZeroUint(), NativeRate: math.NewUint(9e14)},
+				}
+				return flags
+			}(),
+			ethMinedCctxs:   ethMinedCctxs,
+			ethPendingCctxs: ethPendingCctxs,
+			ethPendingNonces: observertypes.PendingNonces{
+				ChainId:   ethChainID,
+				NonceLow:  1099,
+				NonceHigh: 1199,
+				Tss:       tss.TssPubkey,
+			},
+			btcMinedCctxs:   btcMinedCctxs,
+			btcPendingCctxs: btcPendingCctxs,
+			btcPendingNonces: observertypes.PendingNonces{
+				ChainId:   btcChainID,
+				NonceLow:  1099,
+				NonceHigh: 1199,
+				Tss:       tss.TssPubkey,
+			},
+			currentHeight: 1199,
+			queryLimit:    keeper.MaxPendingCctxs,
+			// return the missed eth cctxs and all btc cctxs only
+			expectedCctxs: append(
+				append([]*types.CrossChainTx{}, ethPendingCctxs[0:100]...),
+				btcPendingCctxs...),
+			expectedTotalPending:   400,
+			expectedWithdrawWindow: 500,                       // the sliding window
+			expectedWithdrawRate:   sdk.NewInt(3e18).String(), // 3 ZETA, (2.5 + 0.5) per block
+			rateLimitExceeded:      true,
+		},
 	}
 
 	for _, tt := range tests {
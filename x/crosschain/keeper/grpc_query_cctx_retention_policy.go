@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// CctxRetentionPolicy queries the cctx retention policy
+func (k Keeper) CctxRetentionPolicy(
+	c context.Context,
+	req *types.QueryCctxRetentionPolicyRequest,
+) (*types.QueryCctxRetentionPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	policy, found := k.GetCctxRetentionPolicy(ctx)
+	if !found {
+		return nil, status.Error(codes.Internal, "not found")
+	}
+
+	return &types.QueryCctxRetentionPolicyResponse{CctxRetentionPolicy: policy}, nil
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestKeeper_CctxRetentionPolicy(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.CctxRetentionPolicy(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if cctx retention policy not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.CctxRetentionPolicy(wctx, &types.QueryCctxRetentionPolicyRequest{})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return if cctx retention policy found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		policy := sample.CctxRetentionPolicy()
+		k.SetCctxRetentionPolicy(ctx, policy)
+
+		res, err := k.CctxRetentionPolicy(wctx, &types.QueryCctxRetentionPolicyRequest{})
+
+		require.NoError(t, err)
+		require.Equal(t, &types.QueryCctxRetentionPolicyResponse{
+			CctxRetentionPolicy: policy,
+		}, res)
+	})
+}
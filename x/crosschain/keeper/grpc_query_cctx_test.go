@@ -184,6 +184,23 @@ func TestKeeper_CctxListPending(t *testing.T) {
 		// pending nonce + 2
 		require.EqualValues(t, uint64(1002), res.TotalPending)
 	})
+
+	t.Run("can retrieve pending cctx with pruned cctx below nonce low", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		chainID := getValidEthChainID()
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		cctxs := createCctxWithNonceRange(t, ctx, *k, 1000, 2000, chainID, tss, zk)
+
+		// prune some cctxs below nonce low
+		k.RemoveCrossChainTx(ctx, sample.GetCctxIndexFromString("1337-940"))
+		k.RemoveCrossChainTx(ctx, sample.GetCctxIndexFromString("1337-955"))
+
+		res, err := k.ListPendingCctx(ctx, &types.QueryListPendingCctxRequest{ChainId: chainID, Limit: 100})
+		require.NoError(t, err)
+		require.EqualValues(t, cctxs[0:100], res.CrossChainTx)
+		require.EqualValues(t, uint64(1000), res.TotalPending)
+	})
 }
 
 func TestKeeper_ZetaAccounting(t *testing.T) {
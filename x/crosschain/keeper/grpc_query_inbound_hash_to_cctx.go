@@ -76,17 +76,23 @@ func (k Keeper) InboundHashToCctxData(
 		return nil, err
 	}
 
-	cctxs := make([]types.CrossChainTx, len(inTxHashToCctxRes.InboundHashToCctx.CctxIndex))
+	cctxs := make([]types.CrossChainTx, 0, len(inTxHashToCctxRes.InboundHashToCctx.CctxIndex))
+	prunedCctxIndexes := make([]string, 0)
 	ctx := sdk.UnwrapSDKContext(c)
-	for i, cctxIndex := range inTxHashToCctxRes.InboundHashToCctx.CctxIndex {
+	for _, cctxIndex := range inTxHashToCctxRes.InboundHashToCctx.CctxIndex {
 		cctx, found := k.GetCrossChainTx(ctx, cctxIndex)
 		if !found {
-			// This is an internal error because the cctx should always exist from the index
-			return nil, status.Errorf(codes.Internal, "cctx indexed %s doesn't exist", cctxIndex)
+			// the cctx was pruned by the retention policy, the index is kept for the replay protection
+			// the cctx can be retrieved from its archival event
+			prunedCctxIndexes = append(prunedCctxIndexes, cctxIndex)
+			continue
 		}
 
-		cctxs[i] = cctx
+		cctxs = append(cctxs, cctx)
 	}
 
-	return &types.QueryInboundHashToCctxDataResponse{CrossChainTxs: cctxs}, nil
+	return &types.QueryInboundHashToCctxDataResponse{
+		CrossChainTxs:     cctxs,
+		PrunedCctxIndexes: prunedCctxIndexes,
+	}, nil
 }
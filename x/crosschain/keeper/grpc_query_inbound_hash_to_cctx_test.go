@@ -171,16 +171,21 @@ func TestKeeper_InTxHashToCctxDataQuery(t *testing.T) {
 		_, err := keeper.InboundHashToCctxData(wctx, req)
 		require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
 	})
-	t.Run("cctx not indexed return internal error", func(t *testing.T) {
+	t.Run("pruned cctx is returned as pruned", func(t *testing.T) {
+		cctx := sample.CrossChainTx(t, "pruned")
+		keeper.SetCrossChainTx(ctx, *cctx)
 		keeper.SetInboundHashToCctx(ctx, types.InboundHashToCctx{
 			InboundHash: "nocctx",
-			CctxIndex:   []string{"notfound"},
+			CctxIndex:   []string{cctx.Index, "notfound"},
 		})
 
 		req := &types.QueryInboundHashToCctxDataRequest{
 			InboundHash: "nocctx",
 		}
-		_, err := keeper.InboundHashToCctxData(wctx, req)
-		require.ErrorIs(t, err, status.Error(codes.Internal, "cctx indexed notfound doesn't exist"))
+		res, err := keeper.InboundHashToCctxData(wctx, req)
+		require.NoError(t, err)
+		require.Len(t, res.CrossChainTxs, 1)
+		require.Equal(t, cctx.Index, res.CrossChainTxs[0].Index)
+		require.Equal(t, []string{"notfound"}, res.PrunedCctxIndexes)
 	})
 }
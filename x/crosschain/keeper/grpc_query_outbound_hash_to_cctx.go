@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// OutboundHashToCctxData queries the data of all cctxs processed by an outbound hash
+func (k Keeper) OutboundHashToCctxData(
+	c context.Context,
+	req *types.QueryOutboundHashToCctxDataRequest,
+) (*types.QueryOutboundHashToCctxDataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	outboundHashToCctx, found := k.GetOutboundHashToCctx(ctx, req.OutboundHash)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	cctxs := make([]types.CrossChainTx, 0, len(outboundHashToCctx.CctxIndex))
+	for _, cctxIndex := range outboundHashToCctx.CctxIndex {
+		cctx, found := k.GetCrossChainTx(ctx, cctxIndex)
+		if !found {
+			// the cctx was pruned by the retention policy
+			continue
+		}
+
+		cctxs = append(cctxs, cctx)
+	}
+
+	return &types.QueryOutboundHashToCctxDataResponse{CrossChainTxs: cctxs}, nil
+}
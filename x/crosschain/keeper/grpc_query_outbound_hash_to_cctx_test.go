@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/nullify"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestKeeper_OutboundHashToCctxData(t *testing.T) {
+	keeper, ctx, _, _ := keepertest.CrosschainKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	t.Run("can query all cctxs data with outbound hash", func(t *testing.T) {
+		cctxs := []types.CrossChainTx{*sample.CrossChainTx(t, "0"), *sample.CrossChainTx(t, "1")}
+		for _, cctx := range cctxs {
+			keeper.SetCrossChainTx(ctx, cctx)
+			keeper.AddOutboundHashToCctx(ctx, "batch", cctx.Index)
+		}
+
+		res, err := keeper.OutboundHashToCctxData(wctx, &types.QueryOutboundHashToCctxDataRequest{
+			OutboundHash: "batch",
+		})
+		require.NoError(t, err)
+		require.Equal(t, len(cctxs), len(res.CrossChainTxs))
+		for i := range cctxs {
+			require.Equal(t, nullify.Fill(cctxs[i]), nullify.Fill(res.CrossChainTxs[i]))
+		}
+	})
+	t.Run("invalid request", func(t *testing.T) {
+		_, err := keeper.OutboundHashToCctxData(wctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+	t.Run("outbound hash not found", func(t *testing.T) {
+		_, err := keeper.OutboundHashToCctxData(wctx, &types.QueryOutboundHashToCctxDataRequest{
+			OutboundHash: "notfound",
+		})
+		require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+	})
+	t.Run("pruned cctx is skipped", func(t *testing.T) {
+		keeper.SetOutboundHashToCctx(ctx, types.OutboundHashToCctx{
+			OutboundHash: "nocctx",
+			CctxIndex:    []string{"notfound"},
+		})
+
+		res, err := keeper.OutboundHashToCctxData(wctx, &types.QueryOutboundHashToCctxDataRequest{
+			OutboundHash: "nocctx",
+		})
+		require.NoError(t, err)
+		require.Empty(t, res.CrossChainTxs)
+	})
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// RateLimiterUsage queries the current utilization of the global, per chain and per asset rate limit buckets
+func (k Keeper) RateLimiterUsage(
+	c context.Context,
+	req *types.QueryRateLimiterUsageRequest,
+) (*types.QueryRateLimiterUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	height := ctx.BlockHeight()
+
+	rateLimitFlags, found := k.GetRateLimiterFlags(ctx)
+	if !found {
+		return nil, status.Error(codes.Internal, "not found")
+	}
+
+	// no bucket to report if no rate limit is set
+	window := rateLimitFlags.InputWindow()
+	if window <= 0 {
+		return &types.QueryRateLimiterUsageResponse{
+			Height:  height,
+			Buckets: []types.RateLimitBucketUtilization{},
+		}, nil
+	}
+
+	input, err := k.RateLimiterInput(c, &types.QueryRateLimiterInputRequest{Window: window})
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]types.RateLimitBucketUtilization, 0, len(input.BucketUsages)+1)
+	if rateLimitFlags.HasGlobalRateLimit() {
+		pastCctxsValue, ok := sdkmath.NewIntFromString(input.PastCctxsValue)
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "invalid past cctxs value: %s", input.PastCctxsValue)
+		}
+		pendingCctxsValue, ok := sdkmath.NewIntFromString(input.PendingCctxsValue)
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "invalid pending cctxs value: %s", input.PendingCctxsValue)
+		}
+		globalUsage := types.RateLimitBucketUsage{
+			Bucket:                  rateLimitFlags.GlobalRateLimitBucket(),
+			PastCctxsValue:          pastCctxsValue,
+			PendingCctxsValue:       pendingCctxsValue,
+			LowestPendingCctxHeight: input.LowestPendingCctxHeight,
+		}
+		buckets = append(buckets, globalUsage.Utilization(input.Height))
+	}
+	for _, usage := range input.BucketUsages {
+		buckets = append(buckets, usage.Utilization(input.Height))
+	}
+
+	return &types.QueryRateLimiterUsageResponse{
+		Height:  input.Height,
+		Buckets: buckets,
+	}, nil
+}
@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/pkg/coin"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestKeeper_RateLimiterUsage(t *testing.T) {
+	// create sample TSS
+	tss := sample.Tss()
+	zetaChainID := chains.ZetaChainMainnet.ChainId
+
+	// create sample zrc20 addresses for ETH, BTC, USDT
+	zrc20ETH := sample.EthAddress().Hex()
+	zrc20BTC := sample.EthAddress().Hex()
+	zrc20USDT := sample.EthAddress().Hex()
+
+	// create Eth and Btc chain 999 mined and 200 pending cctxs, worth 2.5 ZETA and 0.5 ZETA each
+	ethMinedCctxs := sample.CustomCctxsInBlockRange(
+		t,
+		1,
+		999,
+		zetaChainID,
+		ethChainID,
+		coin.CoinType_Gas,
+		"",
+		uint64(1e15),
+		types.CctxStatus_OutboundMined,
+	)
+	ethPendingCctxs := sample.CustomCctxsInBlockRange(
+		t,
+		1000,
+		1199,
+		zetaChainID,
+		ethChainID,
+		coin.CoinType_Gas,
+		"",
+		uint64(1e15),
+		types.CctxStatus_PendingOutbound,
+	)
+	btcMinedCctxs := sample.CustomCctxsInBlockRange(
+		t,
+		1,
+		999,
+		zetaChainID,
+		btcChainID,
+		coin.CoinType_Gas,
+		"",
+		1000,
+		types.CctxStatus_OutboundMined,
+	)
+	btcPendingCctxs := sample.CustomCctxsInBlockRange(
+		t,
+		1000,
+		1199,
+		zetaChainID,
+		btcChainID,
+		coin.CoinType_Gas,
+		"",
+		1000,
+		types.CctxStatus_PendingOutbound,
+	)
+
+	// setupKeeper sets up the cctxs, pending nonces and the given rate limiter flags in the keeper
+	setupKeeper := func(t *testing.T, flags *types.RateLimiterFlags) *types.QueryRateLimiterUsageResponse {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		setupForeignCoins(t, ctx, zk, zrc20ETH, zrc20BTC, zrc20USDT, sample.EthAddress().Hex())
+		if flags != nil {
+			k.SetRateLimiterFlags(ctx, *flags)
+		}
+
+		setCctxsInKeeper(ctx, *k, zk, tss, ethMinedCctxs)
+		setCctxsInKeeper(ctx, *k, zk, tss, ethPendingCctxs)
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			ChainId:   ethChainID,
+			NonceLow:  1099,
+			NonceHigh: 1199,
+			Tss:       tss.TssPubkey,
+		})
+		setCctxsInKeeper(ctx, *k, zk, tss, btcMinedCctxs)
+		setCctxsInKeeper(ctx, *k, zk, tss, btcPendingCctxs)
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			ChainId:   btcChainID,
+			NonceLow:  1099,
+			NonceHigh: 1199,
+			Tss:       tss.TssPubkey,
+		})
+		ctx = ctx.WithBlockHeight(1199)
+
+		res, err := k.RateLimiterUsage(ctx, &types.QueryRateLimiterUsageRequest{})
+		require.NoError(t, err)
+		return res
+	}
+
+	t.Run("should fail for empty req", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.RateLimiterUsage(ctx, nil)
+		require.ErrorContains(t, err, "invalid request")
+	})
+
+	t.Run("should fail if rate limiter flags not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.RateLimiterUsage(ctx, &types.QueryRateLimiterUsageRequest{})
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("should return no bucket if no rate limit is set", func(t *testing.T) {
+		res := setupKeeper(t, &types.RateLimiterFlags{Enabled: true})
+		require.Equal(t, int64(1199), res.Height)
+		require.Empty(t, res.Buckets)
+	})
+
+	t.Run("should return the utilization of the global and the chain rate limits", func(t *testing.T) {
+		flags := createTestRateLimiterFlags(
+			500,
+			math.NewUint(10*1e18),
+			zrc20ETH,
+			zrc20BTC,
+			zrc20USDT,
+			"2500",
+			"50000",
+			"0.8",
+		)
+		flags.ChainRateLimits = []types.ChainRateLimit{
+			{ChainId: btcChainID, Window: 500, Rate: math.NewUint(4 * 1e17)},
+		}
+		res := setupKeeper(t, flags)
+
+		require.Equal(t, int64(1199), res.Height)
+		require.Len(t, res.Buckets, 2)
+
+		// 3 ZETA per block within the window [700, 1199] across all chains
+		require.Equal(t, types.RateLimitBucketUtilization{
+			Bucket:                flags.GlobalRateLimitBucket(),
+			CurrentWithdrawWindow: 500,
+			CurrentWithdrawLimit:  sdk.NewInt(5000).Mul(sdk.NewInt(1e18)).String(),
+			CurrentWithdrawValue:  sdk.NewInt(1500).Mul(sdk.NewInt(1e18)).String(),
+			CurrentWithdrawRate:   sdk.NewInt(3e18).String(),
+			RateLimitExceeded:     false,
+		}, res.Buckets[0])
+
+		// 0.5 ZETA per block within the window [700, 1199] to the btc chain
+		require.Equal(t, types.RateLimitBucketUtilization{
+			Bucket: types.RateLimitBucket{
+				Type:    types.RateLimitBucketType_Chain,
+				ChainId: btcChainID,
+				Window:  500,
+				Rate:    math.NewUint(4 * 1e17),
+			},
+			CurrentWithdrawWindow: 500,
+			CurrentWithdrawLimit:  sdk.NewInt(200).Mul(sdk.NewInt(1e18)).String(),
+			CurrentWithdrawValue:  sdk.NewInt(250).Mul(sdk.NewInt(1e18)).String(),
+			CurrentWithdrawRate:   sdk.NewInt(5e17).String(),
+			RateLimitExceeded:     true,
+		}, res.Buckets[1])
+	})
+
+	t.Run("should return the utilization of the asset rate limits without global rate limit", func(t *testing.T) {
+		flags := createTestRateLimiterFlags(
+			0,
+			math.NewUint(0),
+			zrc20ETH,
+			zrc20BTC,
+			zrc20USDT,
+			"2500",
+			"50000",
+			"0.8",
+		)
+		flags.AssetRateLimits = []types.AssetRateLimit{
+			{Zrc20: zrc20ETH, Window: 100, Rate: math.NewUint(3 * 1e18), NativeRate: math.NewUint(1e15)},
+		}
+		res := setupKeeper(t, flags)
+
+		require.Equal(t, int64(1199), res.Height)
+		require.Len(t, res.Buckets, 2)
+
+		// 2.5 ZETA and 0.001 ETH per block within the window [1100, 1199] to the eth chain
+		require.Equal(t, types.RateLimitBucketType_Asset, res.Buckets[0].Bucket.Type)
+		require.Equal(t, int64(100), res.Buckets[0].CurrentWithdrawWindow)
+		require.Equal(t, sdk.NewInt(250).Mul(sdk.NewInt(1e18)).String(), res.Buckets[0].CurrentWithdrawValue)
+		require.Equal(t, sdk.NewInt(25e17).String(), res.Buckets[0].CurrentWithdrawRate)
+		require.False(t, res.Buckets[0].RateLimitExceeded)
+
+		require.Equal(t, types.RateLimitBucketType_AssetNative, res.Buckets[1].Bucket.Type)
+		require.Equal(t, int64(100), res.Buckets[1].CurrentWithdrawWindow)
+		require.Equal(t, sdk.NewInt(1e17).String(), res.Buckets[1].CurrentWithdrawValue)
+		require.Equal(t, sdk.NewInt(1e15).String(), res.Buckets[1].CurrentWithdrawRate)
+		require.False(t, res.Buckets[1].RateLimitExceeded)
+	})
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+// ProcessIBCInbound creates and processes the cctx of a deposit received from an IBC chain
+// unlike the other inbounds, the deposit is not voted by the observers, the ICS-20 packet is verified by the IBC light client
+// the returned cctx has already been saved, the caller must check its status
+func (k Keeper) ProcessIBCInbound(ctx sdk.Context, msg types.MsgVoteInbound) (types.CrossChainTx, error) {
+	foreignCoin, found := k.fungibleKeeper.GetForeignCoinFromAsset(ctx, msg.Asset, msg.SenderChainId)
+	if !found {
+		return types.CrossChainTx{}, cosmoserrors.Wrapf(
+			fungibletypes.ErrForeignCoinNotFound,
+			"asset %s on chain %d",
+			msg.Asset,
+			msg.SenderChainId,
+		)
+	}
+	msg.CoinType = foreignCoin.CoinType
+
+	if k.IsFinalizedInbound(ctx, msg.InboundHash, msg.SenderChainId, msg.EventIndex) {
+		return types.CrossChainTx{}, cosmoserrors.Wrapf(
+			types.ErrObservedTxAlreadyFinalized,
+			"inboundHash:%s, SenderChainID:%d, EventIndex:%d",
+			msg.InboundHash,
+			msg.SenderChainId,
+			msg.EventIndex,
+		)
+	}
+
+	tss, found := k.zetaObserverKeeper.GetTSS(ctx)
+	if !found {
+		return types.CrossChainTx{}, types.ErrCannotFindTSSKeys
+	}
+	additionalChains := k.GetAuthorityKeeper().GetAdditionalChainList(ctx)
+	cctx, err := types.NewCCTX(ctx, msg, tss.TssPubkey, additionalChains)
+	if err != nil {
+		return types.CrossChainTx{}, err
+	}
+
+	k.ProcessInbound(ctx, &cctx, additionalChains)
+	k.SaveInbound(ctx, &cctx, msg.EventIndex, additionalChains)
+	return cctx, nil
+}
+
+// ProcessIBCOutbound finalizes the outbound of a cctx to an IBC chain from the result of the ICS-20 transfer
+// if the transfer failed, the tokens have been refunded to the module account by the transfer module,
+// the ZRC20 are then deposited back to the origin of the withdrawal and the cctx is reverted
+// if the deposit fails, the cctx is aborted
+func (k Keeper) ProcessIBCOutbound(ctx sdk.Context, cctx *types.CrossChainTx, success bool, message string) error {
+	if cctx.CctxStatus.Status != types.CctxStatus_PendingOutbound {
+		return cosmoserrors.Wrapf(types.ErrIBCOutboundNotPending, "cctx %s is %s", cctx.Index, cctx.CctxStatus.Status)
+	}
+	// #nosec G701 always positive
+	cctx.GetCurrentOutboundParam().ObservedExternalHeight = uint64(ctx.BlockHeight())
+
+	if success {
+		k.ProcessSuccessfulOutbound(ctx, cctx, cctx.GetCurrentOutboundParam().Amount.String())
+	} else {
+		oldStatus := cctx.CctxStatus.Status
+		tmpCtx, commit := ctx.CacheContext()
+		if err := k.refundIBCOutbound(tmpCtx, cctx); err != nil {
+			cctx.SetAbort(fmt.Sprintf("IBC outbound failed: %s, refund failed: %s", message, err.Error()))
+		} else {
+			commit()
+			cctx.SetReverted(fmt.Sprintf("IBC outbound failed: %s, refunded on zEVM", message))
+		}
+		cctx.GetCurrentOutboundParam().TxFinalizationStatus = types.TxFinalizationStatus_Executed
+		EmitOutboundFailure(
+			ctx,
+			cctx.GetCurrentOutboundParam().Amount.String(),
+			oldStatus.String(),
+			cctx.CctxStatus.Status.String(),
+			cctx.Index,
+		)
+	}
+
+	k.SetCctxAndNonceToCctxAndInboundHashToCctx(ctx, *cctx)
+	return nil
+}
+
+// refundIBCOutbound deposits back the withdrawn ZRC20 to the origin of the withdrawal
+func (k Keeper) refundIBCOutbound(ctx sdk.Context, cctx *types.CrossChainTx) error {
+	receiverChainID := cctx.GetCurrentOutboundParam().ReceiverChainId
+	foreignCoin, found := k.fungibleKeeper.GetForeignCoinFromAsset(ctx, cctx.InboundParams.Asset, receiverChainID)
+	if !found {
+		return cosmoserrors.Wrapf(
+			fungibletypes.ErrForeignCoinNotFound,
+			"asset %s on chain %d",
+			cctx.InboundParams.Asset,
+			receiverChainID,
+		)
+	}
+	if !ethcommon.IsHexAddress(cctx.InboundParams.TxOrigin) {
+		return fmt.Errorf("invalid tx origin %s", cctx.InboundParams.TxOrigin)
+	}
+
+	_, err := k.fungibleKeeper.DepositZRC20(
+		ctx,
+		ethcommon.HexToAddress(foreignCoin.Zrc20ContractAddress),
+		ethcommon.HexToAddress(cctx.InboundParams.TxOrigin),
+		cctx.GetCurrentOutboundParam().Amount.BigInt(),
+	)
+	return err
+}
@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/pkg/coin"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+// setupIBCChain adds an IBC chain to the supported chains and deploys the ZRC20 of the IBC denom
+func setupIBCChain(t *testing.T, ctx sdk.Context, zk keepertest.ZetaKeepers, sdkk keepertest.SDKKeepers) chains.Chain {
+	ibcChain := sample.IBCChain(4200)
+	zk.AuthorityKeeper.SetChainInfo(ctx, authoritytypes.ChainInfo{Chains: []chains.Chain{ibcChain}})
+	deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+	_, err := zk.FungibleKeeper.DeployZRC20Contract(
+		ctx,
+		"atom",
+		"ATOM",
+		6,
+		ibcChain.ChainId,
+		coin.CoinType_ERC20,
+		ibcDenom,
+		big.NewInt(0),
+	)
+	require.NoError(t, err)
+	zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+	return ibcChain
+}
+
+// ibcDepositMsg returns the inbound of a deposit of the IBC denom to the receiver
+func ibcDepositMsg(t *testing.T, ctx sdk.Context, receiver string, amount uint64) types.MsgVoteInbound {
+	zetaChain, err := chains.ZetaChainFromChainID(ctx.ChainID())
+	require.NoError(t, err)
+	receiverBytes, err := hex.DecodeString(receiver[2:])
+	require.NoError(t, err)
+
+	return *types.NewMsgVoteInbound(
+		"",
+		"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+		4200,
+		"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+		receiver,
+		zetaChain.ChainId,
+		math.NewUint(amount),
+		hex.EncodeToString(receiverBytes),
+		"transfer/channel-0/1",
+		1,
+		0,
+		coin.CoinType_ERC20,
+		ibcDenom,
+		0,
+	)
+}
+
+func TestKeeper_ProcessIBCInbound(t *testing.T) {
+	t.Run("should deposit the tokens received from an IBC chain", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		ibcChain := setupIBCChain(t, ctx, zk, sdkk)
+		receiver := sample.EthAddress()
+
+		cctx, err := k.ProcessIBCInbound(ctx, ibcDepositMsg(t, ctx, receiver.Hex(), 1000))
+		require.NoError(t, err)
+		require.Equal(t, types.CctxStatus_OutboundMined, cctx.CctxStatus.Status)
+		_, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+
+		foreignCoin, found := zk.FungibleKeeper.GetForeignCoinFromAsset(ctx, ibcDenom, ibcChain.ChainId)
+		require.True(t, found)
+		balance, err := zk.FungibleKeeper.BalanceOfZRC4(
+			ctx,
+			ethcommon.HexToAddress(foreignCoin.Zrc20ContractAddress),
+			receiver,
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, 1000, balance.Uint64())
+	})
+
+	t.Run("should fail if the inbound is already finalized", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		setupIBCChain(t, ctx, zk, sdkk)
+		msg := ibcDepositMsg(t, ctx, sample.EthAddress().Hex(), 1000)
+
+		_, err := k.ProcessIBCInbound(ctx, msg)
+		require.NoError(t, err)
+		_, err = k.ProcessIBCInbound(ctx, msg)
+		require.ErrorIs(t, err, types.ErrObservedTxAlreadyFinalized)
+	})
+
+	t.Run("should fail if the denom has no ZRC20", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+
+		_, err := k.ProcessIBCInbound(ctx, ibcDepositMsg(t, ctx, sample.EthAddress().Hex(), 1000))
+		require.ErrorIs(t, err, fungibletypes.ErrForeignCoinNotFound)
+	})
+}
+
+func TestKeeper_ProcessIBCOutbound(t *testing.T) {
+	// ibcOutboundCctx returns a pending cctx withdrawing 'amount' of the IBC denom
+	ibcOutboundCctx := func(t *testing.T) *types.CrossChainTx {
+		cctx := sample.CrossChainTx(t, "ibc")
+		cctx.CctxStatus.Status = types.CctxStatus_PendingOutbound
+		cctx.InboundParams.Asset = ibcDenom
+		cctx.InboundParams.TxOrigin = sample.EthAddress().Hex()
+		cctx.OutboundParams = []*types.OutboundParams{
+			{
+				Receiver:        "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+				ReceiverChainId: 4200,
+				Amount:          math.NewUint(1000),
+			},
+		}
+		return cctx
+	}
+
+	t.Run("should set the cctx mined if the transfer succeeded", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+		cctx := ibcOutboundCctx(t)
+
+		err := k.ProcessIBCOutbound(ctx, cctx, true, "")
+		require.NoError(t, err)
+		got, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_OutboundMined, got.CctxStatus.Status)
+		require.Equal(t, types.TxFinalizationStatus_Executed, got.GetCurrentOutboundParam().TxFinalizationStatus)
+	})
+
+	t.Run("should refund the ZRC20 and revert the cctx if the transfer failed", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		setupIBCChain(t, ctx, zk, sdkk)
+		cctx := ibcOutboundCctx(t)
+
+		err := k.ProcessIBCOutbound(ctx, cctx, false, "packet timed out")
+		require.NoError(t, err)
+		got, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_Reverted, got.CctxStatus.Status)
+		require.Contains(t, got.CctxStatus.StatusMessage, "packet timed out")
+
+		foreignCoin, found := zk.FungibleKeeper.GetForeignCoinFromAsset(ctx, ibcDenom, 4200)
+		require.True(t, found)
+		balance, err := zk.FungibleKeeper.BalanceOfZRC4(
+			ctx,
+			ethcommon.HexToAddress(foreignCoin.Zrc20ContractAddress),
+			ethcommon.HexToAddress(cctx.InboundParams.TxOrigin),
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, 1000, balance.Uint64())
+	})
+
+	t.Run("should abort the cctx if the refund failed", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+		cctx := ibcOutboundCctx(t)
+
+		err := k.ProcessIBCOutbound(ctx, cctx, false, "packet timed out")
+		require.NoError(t, err)
+		got, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_Aborted, got.CctxStatus.Status)
+	})
+
+	t.Run("should fail if the outbound is not pending", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		cctx := ibcOutboundCctx(t)
+		cctx.CctxStatus.Status = types.CctxStatus_OutboundMined
+
+		err := k.ProcessIBCOutbound(ctx, cctx, true, "")
+		require.ErrorIs(t, err, types.ErrIBCOutboundNotPending)
+	})
+}
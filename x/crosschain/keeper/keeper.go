@@ -24,6 +24,7 @@ type (
 		fungibleKeeper      types.FungibleKeeper
 		authorityKeeper     types.AuthorityKeeper
 		lightclientKeeper   types.LightclientKeeper
+		emissionsKeeper     types.EmissionsKeeper
 		ibcCrosschainKeeper types.IBCCrosschainKeeper
 	}
 )
@@ -39,6 +40,7 @@ func NewKeeper(
 	fungibleKeeper types.FungibleKeeper,
 	authorityKeeper types.AuthorityKeeper,
 	lightclientKeeper types.LightclientKeeper,
+	emissionsKeeper types.EmissionsKeeper,
 ) *Keeper {
 	// ensure governance module account is set
 	// FIXME: enable this check! (disabled for now to avoid unit test panic)
@@ -57,6 +59,7 @@ func NewKeeper(
 		fungibleKeeper:     fungibleKeeper,
 		authorityKeeper:    authorityKeeper,
 		lightclientKeeper:  lightclientKeeper,
+		emissionsKeeper:    emissionsKeeper,
 	}
 }
 
@@ -92,6 +95,10 @@ func (k Keeper) GetLightclientKeeper() types.LightclientKeeper {
 	return k.lightclientKeeper
 }
 
+func (k Keeper) GetEmissionsKeeper() types.EmissionsKeeper {
+	return k.emissionsKeeper
+}
+
 func (k Keeper) GetIBCCrosschainKeeper() types.IBCCrosschainKeeper {
 	return k.ibcCrosschainKeeper
 }
@@ -7,6 +7,7 @@ import (
 	v3 "github.com/zeta-chain/zetacore/x/crosschain/migrations/v3"
 	v4 "github.com/zeta-chain/zetacore/x/crosschain/migrations/v4"
 	v5 "github.com/zeta-chain/zetacore/x/crosschain/migrations/v5"
+	v6 "github.com/zeta-chain/zetacore/x/crosschain/migrations/v6"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -45,3 +46,8 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 	return v5.MigrateStore(ctx, m.crossChainKeeper, m.crossChainKeeper.zetaObserverKeeper)
 }
+
+// Migrate5to6 migrates the store from consensus version 5 to 6
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.crossChainKeeper)
+}
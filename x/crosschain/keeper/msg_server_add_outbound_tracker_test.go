@@ -336,6 +336,7 @@ func TestMsgServer_AddToOutboundTracker(t *testing.T) {
 		txHash := ethTx.Hash().Hex()
 
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		observerMock := keepertest.GetCrosschainObserverMock(t, k)
 		lightclientMock := keepertest.GetCrosschainLightclientMock(t, k)
 
@@ -379,6 +380,7 @@ func TestMsgServer_AddToOutboundTracker(t *testing.T) {
 		txHash := ethTx.Hash().Hex()
 
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		observerMock := keepertest.GetCrosschainObserverMock(t, k)
 		lightclientMock := keepertest.GetCrosschainLightclientMock(t, k)
 
@@ -474,6 +476,7 @@ func TestMsgServer_AddToOutboundTracker(t *testing.T) {
 		txHash := ethTx.Hash().Hex()
 
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		observerMock := keepertest.GetCrosschainObserverMock(t, k)
 		lightclientMock := keepertest.GetCrosschainLightclientMock(t, k)
 
@@ -513,6 +516,7 @@ func TestMsgServer_AddToOutboundTracker(t *testing.T) {
 		txHash := ethTx.Hash().Hex()
 
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		observerMock := keepertest.GetCrosschainObserverMock(t, k)
 		lightclientMock := keepertest.GetCrosschainLightclientMock(t, k)
 
@@ -127,7 +127,8 @@ func (k Keeper) MigrateTSSFundsForChain(
 			ObservedHash:           tmbytes.HexBytes(tmtypes.Tx(ctx.TxBytes()).Hash()).String(),
 			ObservedExternalHeight: 0,
 			BallotIndex:            "",
-			FinalizedZetaHeight:    0,
+			// #nosec G701 always positive
+			FinalizedZetaHeight: uint64(ctx.BlockHeight()),
 		},
 		OutboundParams: []*types.OutboundParams{{
 			Receiver:               "",
@@ -145,8 +146,10 @@ func (k Keeper) MigrateTSSFundsForChain(
 			EffectiveGasLimit:      0,
 			TssPubkey:              currentTss.TssPubkey,
 		}}}
+	additionalChains := k.GetAuthorityKeeper().GetAdditionalChainList(ctx)
+
 	// Set the sender and receiver addresses for EVM chain
-	if chains.IsEVMChain(chainID) {
+	if chains.IsEVMChain(chainID, additionalChains) {
 		ethAddressOld, err := zetacrypto.GetTssAddrEVM(currentTss.TssPubkey)
 		if err != nil {
 			return err
@@ -180,7 +183,7 @@ func (k Keeper) MigrateTSSFundsForChain(
 		cctx.GetCurrentOutboundParam().Amount = amount.Sub(evmFee)
 	}
 	// Set the sender and receiver addresses for Bitcoin chain
-	if chains.IsBitcoinChain(chainID) {
+	if chains.IsBitcoinChain(chainID, additionalChains) {
 		bitcoinNetParams, err := chains.BitcoinNetParamsFromChainID(chainID)
 		if err != nil {
 			return err
@@ -232,7 +235,7 @@ func (k Keeper) MigrateTSSFundsForChain(
 		ChainId:            chainID,
 		MigrationCctxIndex: index,
 	})
-	EmitEventInboundFinalized(ctx, &cctx)
+	EmitEventInboundFinalized(ctx, &cctx, additionalChains)
 
 	return nil
 }
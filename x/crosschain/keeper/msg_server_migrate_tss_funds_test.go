@@ -93,6 +93,7 @@ func TestKeeper_MigrateTSSFundsForChain(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
@@ -111,6 +112,7 @@ func TestKeeper_MigrateTSSFundsForChain(t *testing.T) {
 		index := hash.Hex()
 		cctx, found := k.GetCrossChainTx(ctx, index)
 		require.True(t, found)
+		require.EqualValues(t, ctx.BlockHeight(), cctx.InboundParams.FinalizedZetaHeight)
 		multipliedValue, err := gas.MultiplyGasPrice(gp, crosschaintypes.TssMigrationGasMultiplierEVM)
 		require.NoError(t, err)
 		require.Equal(t, multipliedValue.String(), cctx.GetCurrentOutboundParam().GasPrice)
@@ -123,6 +125,7 @@ func TestKeeper_MigrateTSSFundsForChain(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
@@ -336,6 +339,7 @@ func TestMsgServer_MigrateTssFunds(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
@@ -364,6 +368,7 @@ func TestMsgServer_MigrateTssFunds(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
@@ -446,6 +451,7 @@ func TestMsgServer_MigrateTssFunds(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
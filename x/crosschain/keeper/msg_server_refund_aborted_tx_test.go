@@ -92,6 +92,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		admin := sample.AccAddress()
 		chainID := getValidEthChainID()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupOperational, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
@@ -134,6 +135,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		admin := sample.AccAddress()
 		chainID := getValidEthChainID()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupOperational, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
@@ -247,6 +249,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		admin := sample.AccAddress()
 		chainID := getValidEthChainID()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupOperational, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
@@ -287,6 +290,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		chainID := getValidEthChainID()
 		asset := sample.EthAddress().String()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupOperational, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
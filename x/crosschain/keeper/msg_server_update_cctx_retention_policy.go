@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// UpdateCctxRetentionPolicy updates the retention policy of the cctxs.
+// Authorized: admin policy group 2.
+func (k msgServer) UpdateCctxRetentionPolicy(
+	goCtx context.Context,
+	msg *types.MsgUpdateCctxRetentionPolicy,
+) (*types.MsgUpdateCctxRetentionPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Creator, authoritytypes.PolicyType_groupAdmin) {
+		return nil, errorsmod.Wrap(authoritytypes.ErrUnauthorized, fmt.Sprintf("Creator %s", msg.Creator))
+	}
+
+	k.SetCctxRetentionPolicy(ctx, msg.CctxRetentionPolicy)
+
+	return &types.MsgUpdateCctxRetentionPolicyResponse{}, nil
+}
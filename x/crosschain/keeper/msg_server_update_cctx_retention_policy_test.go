@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestMsgServer_UpdateCctxRetentionPolicy(t *testing.T) {
+	t.Run("can update cctx retention policy", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+
+		_, found := k.GetCctxRetentionPolicy(ctx)
+		require.False(t, found)
+
+		policy := sample.CctxRetentionPolicy()
+
+		_, err := msgServer.UpdateCctxRetentionPolicy(ctx, types.NewMsgUpdateCctxRetentionPolicy(
+			admin,
+			policy,
+		))
+		require.NoError(t, err)
+
+		storedPolicy, found := k.GetCctxRetentionPolicy(ctx)
+		require.True(t, found)
+		require.Equal(t, policy, storedPolicy)
+	})
+
+	t.Run("cannot update cctx retention policy if unauthorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, false)
+
+		_, err := msgServer.UpdateCctxRetentionPolicy(ctx, types.NewMsgUpdateCctxRetentionPolicy(
+			admin,
+			sample.CctxRetentionPolicy(),
+		))
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+}
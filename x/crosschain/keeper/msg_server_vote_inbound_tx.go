@@ -6,7 +6,9 @@ import (
 
 	cosmoserrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 )
 
@@ -59,6 +61,19 @@ func (k msgServer) VoteInbound(
 ) (*types.MsgVoteInboundResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	index := msg.Digest()
+	additionalChains := k.GetAuthorityKeeper().GetAdditionalChainList(ctx)
+
+	if msg.RevertAddress != "" {
+		err := types.ValidateRevertAddress(msg.SenderChainId, msg.RevertAddress, additionalChains)
+		if err != nil {
+			return nil, cosmoserrors.Wrapf(
+				sdkerrors.ErrInvalidAddress,
+				"invalid revert address (%s): %s",
+				msg.RevertAddress,
+				err,
+			)
+		}
+	}
 
 	// vote on inbound ballot
 	// use a temporary context to not commit any ballot state change in case of error
@@ -102,15 +117,15 @@ func (k msgServer) VoteInbound(
 		return nil, types.ErrCannotFindTSSKeys
 	}
 	// create a new CCTX from the inbound message.The status of the new CCTX is set to PendingInbound.
-	cctx, err := types.NewCCTX(ctx, *msg, tss.TssPubkey)
+	cctx, err := types.NewCCTX(ctx, *msg, tss.TssPubkey, additionalChains)
 	if err != nil {
 		return nil, err
 	}
 	// Process the inbound CCTX, the process function manages the state commit and cctx status change.
 	//	If the process fails, the changes to the evm state are rolled back.
-	k.ProcessInbound(ctx, &cctx)
+	k.ProcessInbound(ctx, &cctx, additionalChains)
 	// Save the inbound CCTX to the store. This is called irrespective of the status of the CCTX or the outcome of the process function.
-	k.SaveInbound(ctx, &cctx, msg.EventIndex)
+	k.SaveInbound(ctx, &cctx, msg.EventIndex, additionalChains)
 	return &types.MsgVoteInboundResponse{}, nil
 }
 
@@ -122,8 +137,13 @@ func (k msgServer) VoteInbound(
 	- Sets the CCTX and nonce to the CCTX and inbound transaction hash to CCTX store.
 */
 
-func (k Keeper) SaveInbound(ctx sdk.Context, cctx *types.CrossChainTx, eventIndex uint64) {
-	EmitEventInboundFinalized(ctx, cctx)
+func (k Keeper) SaveInbound(
+	ctx sdk.Context,
+	cctx *types.CrossChainTx,
+	eventIndex uint64,
+	additionalChains []chains.Chain,
+) {
+	EmitEventInboundFinalized(ctx, cctx, additionalChains)
 	k.AddFinalizedInbound(ctx,
 		cctx.GetInboundParams().ObservedHash,
 		cctx.GetInboundParams().SenderChainId,
@@ -53,10 +53,10 @@ func TestKeeper_VoteInbound(t *testing.T) {
 		to, from := int64(1337), int64(101)
 		supportedChains := zk.ObserverKeeper.GetSupportedChains(ctx)
 		for _, chain := range supportedChains {
-			if chains.IsEVMChain(chain.ChainId) {
+			if chain.IsEVMChain() {
 				from = chain.ChainId
 			}
-			if chains.IsZetaChain(chain.ChainId) {
+			if chain.IsZetaChain() {
 				to = chain.ChainId
 			}
 		}
@@ -201,10 +201,10 @@ func TestKeeper_VoteInbound(t *testing.T) {
 		to, from := int64(1337), int64(101)
 		supportedChains := zk.ObserverKeeper.GetSupportedChains(ctx)
 		for _, chain := range supportedChains {
-			if chains.IsEVMChain(chain.ChainId) {
+			if chain.IsEVMChain() {
 				from = chain.ChainId
 			}
-			if chains.IsZetaChain(chain.ChainId) {
+			if chain.IsZetaChain() {
 				to = chain.ChainId
 			}
 		}
@@ -309,7 +309,7 @@ func TestKeeper_SaveInbound(t *testing.T) {
 		senderChain := getValidEthChain()
 		cctx := GetERC20Cctx(t, receiver, *senderChain, "", amount)
 		eventIndex := sample.Uint64InRange(1, 100)
-		k.SaveInbound(ctx, cctx, eventIndex)
+		k.SaveInbound(ctx, cctx, eventIndex, []chains.Chain{})
 		require.Equal(t, types.TxFinalizationStatus_Executed, cctx.InboundParams.TxFinalizationStatus)
 		require.True(
 			t,
@@ -340,7 +340,7 @@ func TestKeeper_SaveInbound(t *testing.T) {
 		eventIndex := sample.Uint64InRange(1, 100)
 		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
 
-		k.SaveInbound(ctx, cctx, eventIndex)
+		k.SaveInbound(ctx, cctx, eventIndex, []chains.Chain{})
 		require.Equal(t, types.TxFinalizationStatus_Executed, cctx.InboundParams.TxFinalizationStatus)
 		require.True(
 			t,
@@ -95,6 +95,9 @@ func (k msgServer) VoteOutbound(
 	// Fund the gas stability pool with the remaining funds
 	k.FundStabilityPool(ctx, &cctx)
 
+	// index the cctx by the observed outbound hash, an outbound can process several cctxs
+	k.AddOutboundHashToCctx(ctx, msg.ObservedOutboundHash, cctx.Index)
+
 	err = k.ProcessOutbound(ctx, &cctx, ballot.BallotStatus, msg.ValueReceived.String())
 	if err != nil {
 		k.SaveFailedOutbound(ctx, &cctx, err.Error(), ballotIndex)
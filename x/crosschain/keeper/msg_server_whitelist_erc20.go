@@ -146,7 +146,8 @@ func (k msgServer) WhitelistERC20(
 			ObservedHash:           hash.String(), // all Upper case Cosmos TX HEX, with no 0x prefix
 			ObservedExternalHeight: 0,
 			BallotIndex:            "",
-			FinalizedZetaHeight:    0,
+			// #nosec G701 always positive
+			FinalizedZetaHeight: uint64(ctx.BlockHeight()),
 		},
 		OutboundParams: []*types.OutboundParams{
 			{
@@ -32,6 +32,7 @@ func TestKeeper_WhitelistERC20(t *testing.T) {
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
 		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupOperational, true)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 
 		deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
 		setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "foobar", "FOOBAR")
@@ -66,6 +67,7 @@ func TestKeeper_WhitelistERC20(t *testing.T) {
 		cctx, found := k.GetCrossChainTx(ctx, cctxIndex)
 		require.True(t, found)
 		require.EqualValues(t, fmt.Sprintf("%s:%s", constant.CmdWhitelistERC20, erc20Address), cctx.RelayedMessage)
+		require.EqualValues(t, ctx.BlockHeight(), cctx.InboundParams.FinalizedZetaHeight)
 
 		// check gas limit is set
 		gasLimit, err := zk.FungibleKeeper.QueryGasLimit(ctx, ethcommon.HexToAddress(zrc20))
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// SetOutboundHashToCctx set a specific outboundHashToCctx in the store from its index
+func (k Keeper) SetOutboundHashToCctx(ctx sdk.Context, outboundHashToCctx types.OutboundHashToCctx) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OutboundHashToCctxKeyPrefix))
+	b := k.cdc.MustMarshal(&outboundHashToCctx)
+	store.Set(types.OutboundHashToCctxKey(
+		outboundHashToCctx.OutboundHash,
+	), b)
+}
+
+// GetOutboundHashToCctx returns a outboundHashToCctx from its index
+func (k Keeper) GetOutboundHashToCctx(
+	ctx sdk.Context,
+	outboundHash string,
+) (val types.OutboundHashToCctx, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OutboundHashToCctxKeyPrefix))
+
+	b := store.Get(types.OutboundHashToCctxKey(
+		outboundHash,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllOutboundHashToCctx returns all outboundHashToCctx
+func (k Keeper) GetAllOutboundHashToCctx(ctx sdk.Context) (list []types.OutboundHashToCctx) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OutboundHashToCctxKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.OutboundHashToCctx
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AddOutboundHashToCctx indexes the cctx by the outbound hash that processed it
+// an outbound hash can process several cctxs (e.g. batched Bitcoin withdrawals)
+func (k Keeper) AddOutboundHashToCctx(ctx sdk.Context, outboundHash string, cctxIndex string) {
+	if outboundHash == "" {
+		return
+	}
+	out, found := k.GetOutboundHashToCctx(ctx, outboundHash)
+	if !found {
+		out.OutboundHash = outboundHash
+	}
+	for _, index := range out.CctxIndex {
+		if index == cctxIndex {
+			return
+		}
+	}
+	out.CctxIndex = append(out.CctxIndex, cctxIndex)
+	k.SetOutboundHashToCctx(ctx, out)
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/nullify"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func createNOutboundHashToCctx(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.OutboundHashToCctx {
+	items := make([]types.OutboundHashToCctx, n)
+	for i := range items {
+		items[i].OutboundHash = strconv.Itoa(i)
+
+		keeper.SetOutboundHashToCctx(ctx, items[i])
+	}
+	return items
+}
+
+func TestKeeper_GetOutboundHashToCctx(t *testing.T) {
+	keeper, ctx, _, _ := keepertest.CrosschainKeeper(t)
+	items := createNOutboundHashToCctx(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetOutboundHashToCctx(ctx,
+			item.OutboundHash,
+		)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestKeeper_GetAllOutboundHashToCctx(t *testing.T) {
+	keeper, ctx, _, _ := keepertest.CrosschainKeeper(t)
+	items := createNOutboundHashToCctx(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllOutboundHashToCctx(ctx)),
+	)
+}
+
+func TestKeeper_AddOutboundHashToCctx(t *testing.T) {
+	t.Run("should index several cctxs by same outbound hash", func(t *testing.T) {
+		keeper, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		keeper.AddOutboundHashToCctx(ctx, "hash", "cctx1")
+		keeper.AddOutboundHashToCctx(ctx, "hash", "cctx2")
+		keeper.AddOutboundHashToCctx(ctx, "hash", "cctx1")
+
+		rst, found := keeper.GetOutboundHashToCctx(ctx, "hash")
+		require.True(t, found)
+		require.Equal(t, []string{"cctx1", "cctx2"}, rst.CctxIndex)
+	})
+
+	t.Run("should not index empty outbound hash", func(t *testing.T) {
+		keeper, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		keeper.AddOutboundHashToCctx(ctx, "", "cctx1")
+
+		_, found := keeper.GetOutboundHashToCctx(ctx, "")
+		require.False(t, found)
+	})
+}
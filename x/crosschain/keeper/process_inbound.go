@@ -11,12 +11,13 @@ import (
 
 // ProcessInbound processes the inbound CCTX.
 // It does a conditional dispatch to ProcessZEVMDeposit or ProcessCrosschainMsgPassing based on the receiver chain.
+// The additional chains are read once by the caller from the authority chain info.
 // The internal functions handle the state changes and error handling.
-func (k Keeper) ProcessInbound(ctx sdk.Context, cctx *types.CrossChainTx) {
-	if chains.IsZetaChain(cctx.GetCurrentOutboundParam().ReceiverChainId) {
+func (k Keeper) ProcessInbound(ctx sdk.Context, cctx *types.CrossChainTx, additionalChains []chains.Chain) {
+	if chains.IsZetaChain(cctx.GetCurrentOutboundParam().ReceiverChainId, additionalChains) {
 		k.processZEVMDeposit(ctx, cctx)
 	} else {
-		k.processCrosschainMsgPassing(ctx, cctx)
+		k.processCrosschainMsgPassing(ctx, cctx, additionalChains)
 	}
 }
 
@@ -104,10 +105,17 @@ processCrosschainMsgPassing processes the CCTX for crosschain message passing. A
 
     Instead, we use a temporary context to make changes and then commit the context on for the happy path ,i.e cctx is set to PendingOutbound.
 */
-func (k Keeper) processCrosschainMsgPassing(ctx sdk.Context, cctx *types.CrossChainTx) {
+func (k Keeper) processCrosschainMsgPassing(
+	ctx sdk.Context,
+	cctx *types.CrossChainTx,
+	additionalChains []chains.Chain,
+) {
 	tmpCtx, commit := ctx.CacheContext()
 	outboundReceiverChainID := cctx.GetCurrentOutboundParam().ReceiverChainId
 	err := func() error {
+		if chains.IsIBCChain(outboundReceiverChainID, additionalChains) {
+			return types.ErrIBCOutboundFromZEVMOnly
+		}
 		err := k.PayGasAndUpdateCctx(
 			tmpCtx,
 			outboundReceiverChainID,
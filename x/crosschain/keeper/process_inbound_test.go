@@ -42,7 +42,7 @@ func TestKeeper_ProcessInboundZEVMDeposit(t *testing.T) {
 		cctx.GetInboundParams().Amount = sdkmath.NewUintFromBigInt(amount)
 		cctx.InboundParams.CoinType = coin.CoinType_Zeta
 		cctx.GetInboundParams().SenderChainId = 0
-		k.ProcessInbound(ctx, cctx)
+		k.ProcessInbound(ctx, cctx, []chains.Chain{})
 		require.Equal(t, types.CctxStatus_OutboundMined, cctx.CctxStatus.Status)
 	})
 
@@ -69,7 +69,7 @@ func TestKeeper_ProcessInboundZEVMDeposit(t *testing.T) {
 		cctx.GetInboundParams().Amount = sdkmath.NewUintFromBigInt(amount)
 		cctx.InboundParams.CoinType = coin.CoinType_Zeta
 		cctx.GetInboundParams().SenderChainId = 0
-		k.ProcessInbound(ctx, cctx)
+		k.ProcessInbound(ctx, cctx, []chains.Chain{})
 		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
 		require.Equal(t, "deposit error", cctx.CctxStatus.StatusMessage)
 	})
@@ -101,7 +101,7 @@ func TestKeeper_ProcessInboundZEVMDeposit(t *testing.T) {
 			// call ProcessInbound
 			cctx := GetERC20Cctx(t, receiver, *senderChain, "", amount)
 			cctx.GetCurrentOutboundParam().ReceiverChainId = chains.ZetaChainPrivnet.ChainId
-			k.ProcessInbound(ctx, cctx)
+			k.ProcessInbound(ctx, cctx, []chains.Chain{})
 			require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
 			require.Equal(
 				t,
@@ -139,7 +139,7 @@ func TestKeeper_ProcessInboundZEVMDeposit(t *testing.T) {
 		// call ProcessInbound
 		cctx := GetERC20Cctx(t, receiver, *senderChain, asset, amount)
 		cctx.GetCurrentOutboundParam().ReceiverChainId = chains.ZetaChainPrivnet.ChainId
-		k.ProcessInbound(ctx, cctx)
+		k.ProcessInbound(ctx, cctx, []chains.Chain{})
 		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
 		require.Equal(
 			t,
@@ -182,7 +182,7 @@ func TestKeeper_ProcessInboundZEVMDeposit(t *testing.T) {
 			// call ProcessInbound
 			cctx := GetERC20Cctx(t, receiver, *senderChain, asset, amount)
 			cctx.GetCurrentOutboundParam().ReceiverChainId = chains.ZetaChainPrivnet.ChainId
-			k.ProcessInbound(ctx, cctx)
+			k.ProcessInbound(ctx, cctx, []chains.Chain{})
 			require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
 			require.Equal(
 				t,
@@ -226,7 +226,7 @@ func TestKeeper_ProcessInboundZEVMDeposit(t *testing.T) {
 			// call ProcessInbound
 			cctx := GetERC20Cctx(t, receiver, *senderChain, asset, amount)
 			cctx.GetCurrentOutboundParam().ReceiverChainId = chains.ZetaChainPrivnet.ChainId
-			k.ProcessInbound(ctx, cctx)
+			k.ProcessInbound(ctx, cctx, []chains.Chain{})
 			require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
 			require.Equal(
 				t,
@@ -271,7 +271,7 @@ func TestKeeper_ProcessInboundZEVMDeposit(t *testing.T) {
 		// call ProcessInbound
 		cctx := GetERC20Cctx(t, receiver, *senderChain, asset, amount)
 		cctx.GetCurrentOutboundParam().ReceiverChainId = chains.ZetaChainPrivnet.ChainId
-		k.ProcessInbound(ctx, cctx)
+		k.ProcessInbound(ctx, cctx, []chains.Chain{})
 		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
 		require.Contains(t, cctx.CctxStatus.StatusMessage, "cannot find receiver chain nonce")
 	})
@@ -309,12 +309,45 @@ func TestKeeper_ProcessInboundZEVMDeposit(t *testing.T) {
 		// call ProcessInbound
 		cctx := GetERC20Cctx(t, receiver, *senderChain, asset, amount)
 		cctx.GetCurrentOutboundParam().ReceiverChainId = chains.ZetaChainPrivnet.ChainId
-		k.ProcessInbound(ctx, cctx)
+		k.ProcessInbound(ctx, cctx, []chains.Chain{})
 		require.Equal(t, types.CctxStatus_PendingRevert, cctx.CctxStatus.Status)
 		require.Equal(t, errDeposit.Error(), cctx.CctxStatus.StatusMessage)
 		require.Equal(t, updatedNonce, cctx.GetCurrentOutboundParam().TssNonce)
 	})
 
+	t.Run("unable to process zevm deposit HandleEVMDeposit revert to the revert address", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseFungibleMock: true,
+			UseObserverMock: true,
+		})
+
+		// Setup mock data
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		receiver := sample.EthAddress()
+		revertAddress := sample.EthAddress()
+		amount := big.NewInt(42)
+		senderChain := getValidEthChain()
+		asset := ""
+		errDeposit := fmt.Errorf("deposit failed")
+
+		// Setup expected calls
+		keepertest.MockRevertForHandleEVMDeposit(fungibleMock, receiver, amount, senderChain.ChainId, errDeposit)
+		keepertest.MockGetSupportedChainFromChainID(observerMock, senderChain)
+		keepertest.MockGetRevertGasLimitForERC20(fungibleMock, asset, *senderChain, 100)
+		keepertest.MockPayGasAndUpdateCCTX(fungibleMock, observerMock, ctx, *k, *senderChain, asset)
+		keepertest.MockUpdateNonce(observerMock, *senderChain)
+
+		// call ProcessInbound
+		cctx := GetERC20Cctx(t, receiver, *senderChain, asset, amount)
+		cctx.GetCurrentOutboundParam().ReceiverChainId = chains.ZetaChainPrivnet.ChainId
+		cctx.InboundParams.RevertAddress = revertAddress.String()
+		k.ProcessInbound(ctx, cctx, []chains.Chain{})
+		require.Equal(t, types.CctxStatus_PendingRevert, cctx.CctxStatus.Status)
+		require.Equal(t, revertAddress.String(), cctx.GetCurrentOutboundParam().Receiver)
+		require.Equal(t, senderChain.ChainId, cctx.GetCurrentOutboundParam().ReceiverChainId)
+	})
+
 	t.Run(
 		"unable to process zevm deposit HandleEVMDeposit revert fails as the cctx has already been reverted",
 		func(t *testing.T) {
@@ -346,7 +379,7 @@ func TestKeeper_ProcessInboundZEVMDeposit(t *testing.T) {
 			cctx := GetERC20Cctx(t, receiver, *senderChain, asset, amount)
 			cctx.GetCurrentOutboundParam().ReceiverChainId = chains.ZetaChainPrivnet.ChainId
 			cctx.OutboundParams = append(cctx.OutboundParams, cctx.GetCurrentOutboundParam())
-			k.ProcessInbound(ctx, cctx)
+			k.ProcessInbound(ctx, cctx, []chains.Chain{})
 			require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
 			require.Contains(
 				t,
@@ -379,7 +412,7 @@ func TestKeeper_ProcessInboundProcessCrosschainMsgPassing(t *testing.T) {
 
 		// call ProcessInbound
 		cctx := GetERC20Cctx(t, receiver, *receiverChain, "", amount)
-		k.ProcessInbound(ctx, cctx)
+		k.ProcessInbound(ctx, cctx, []chains.Chain{})
 		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
 		require.Equal(t, updatedNonce, cctx.GetCurrentOutboundParam().TssNonce)
 	})
@@ -402,7 +435,7 @@ func TestKeeper_ProcessInboundProcessCrosschainMsgPassing(t *testing.T) {
 
 		// call ProcessInbound
 		cctx := GetERC20Cctx(t, receiver, *receiverChain, "", amount)
-		k.ProcessInbound(ctx, cctx)
+		k.ProcessInbound(ctx, cctx, []chains.Chain{})
 		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
 		require.Equal(t, observertypes.ErrSupportedChains.Error(), cctx.CctxStatus.StatusMessage)
 	})
@@ -429,8 +462,21 @@ func TestKeeper_ProcessInboundProcessCrosschainMsgPassing(t *testing.T) {
 
 		// call ProcessInbound
 		cctx := GetERC20Cctx(t, receiver, *receiverChain, "", amount)
-		k.ProcessInbound(ctx, cctx)
+		k.ProcessInbound(ctx, cctx, []chains.Chain{})
 		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
 		require.Contains(t, cctx.CctxStatus.StatusMessage, "cannot find receiver chain nonce")
 	})
+
+	t.Run("unable to process crosschain msg passing to an IBC chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+
+		// Setup mock data
+		ibcChain := sample.IBCChain(4200)
+
+		// call ProcessInbound
+		cctx := GetERC20Cctx(t, sample.EthAddress(), ibcChain, "", big.NewInt(42))
+		k.ProcessInbound(ctx, cctx, []chains.Chain{ibcChain})
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+		require.Contains(t, cctx.CctxStatus.StatusMessage, types.ErrIBCOutboundFromZEVMOnly.Error())
+	})
 }
@@ -41,7 +41,7 @@ func (k Keeper) ProcessOutbound(
 	if err != nil {
 		return err
 	}
-	err = cctx.Validate()
+	err = cctx.Validate(k.GetAuthorityKeeper().GetAdditionalChainList(ctx))
 	if err != nil {
 		return err
 	}
@@ -103,7 +103,7 @@ func (k Keeper) ProcessFailedOutbound(ctx sdk.Context, cctx *types.CrossChainTx,
 		// if the cctx is of coin type cmd or the sender chain is zeta chain, then we do not revert, the cctx is aborted
 		cctx.GetCurrentOutboundParam().TxFinalizationStatus = types.TxFinalizationStatus_Executed
 		cctx.SetAbort("Outbound failed")
-	} else if chains.IsZetaChain(cctx.InboundParams.SenderChainId) {
+	} else if chains.IsZetaChain(cctx.InboundParams.SenderChainId, k.GetAuthorityKeeper().GetAdditionalChainList(ctx)) {
 		switch cctx.InboundParams.CoinType {
 		// Try revert if the coin-type is ZETA
 		case coin.CoinType_Zeta:
@@ -56,3 +56,44 @@ func (k Keeper) GetRateLimiterAssetRateList(
 	}
 	return flags, assetRates, true
 }
+
+// GetRateLimitBuckets returns the buckets of the rate limits per chain and per asset of the rate limiter flags
+// the asset rate limits of the zrc20s without foreign coin are ignored
+func (k Keeper) GetRateLimitBuckets(ctx sdk.Context, flags types.RateLimiterFlags) []types.RateLimitBucket {
+	buckets := make([]types.RateLimitBucket, 0)
+	for _, chainRateLimit := range flags.ChainRateLimits {
+		buckets = append(buckets, types.RateLimitBucket{
+			Type:    types.RateLimitBucketType_Chain,
+			ChainId: chainRateLimit.ChainId,
+			Window:  chainRateLimit.Window,
+			Rate:    chainRateLimit.Rate,
+		})
+	}
+
+	for _, assetRateLimit := range flags.AssetRateLimits {
+		fCoin, found := k.fungibleKeeper.GetForeignCoins(ctx, assetRateLimit.Zrc20)
+		if !found {
+			continue
+		}
+		bucket := types.RateLimitBucket{
+			ChainId:  fCoin.ForeignChainId,
+			Zrc20:    assetRateLimit.Zrc20,
+			CoinType: fCoin.CoinType,
+			Asset:    strings.ToLower(fCoin.Asset),
+			Window:   assetRateLimit.Window,
+		}
+
+		// an asset can be limited both in azeta and in native units
+		if !assetRateLimit.Rate.IsNil() && !assetRateLimit.Rate.IsZero() {
+			bucket.Type = types.RateLimitBucketType_Asset
+			bucket.Rate = assetRateLimit.Rate
+			buckets = append(buckets, bucket)
+		}
+		if !assetRateLimit.NativeRate.IsNil() && !assetRateLimit.NativeRate.IsZero() {
+			bucket.Type = types.RateLimitBucketType_AssetNative
+			bucket.Rate = assetRateLimit.NativeRate
+			buckets = append(buckets, bucket)
+		}
+	}
+	return buckets
+}
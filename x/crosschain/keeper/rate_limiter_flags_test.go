@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -135,3 +136,73 @@ func TestKeeper_GetRateLimiterAssetRateList(t *testing.T) {
 	require.Equal(t, testflags, flags)
 	require.EqualValues(t, []types.AssetRate{gasAssetRate, erc20AssetRate1, erc20AssetRate2}, assetRates)
 }
+
+func TestKeeper_GetRateLimitBuckets(t *testing.T) {
+	k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+
+	// create test foreign coins
+	chainID := chains.GoerliLocalnet.ChainId
+	zrc20GasAddr := sample.EthAddress().Hex()
+	zrc20ERC20Addr := sample.EthAddress().Hex()
+	erc20Asset := sample.EthAddress().Hex()
+	gasCoin, _ := createForeignCoinAndAssetRate(t, zrc20GasAddr, "", chainID, 18, coin.CoinType_Gas, sdk.NewDec(1))
+	erc20Coin, _ := createForeignCoinAndAssetRate(
+		t,
+		zrc20ERC20Addr,
+		erc20Asset,
+		chainID,
+		6,
+		coin.CoinType_ERC20,
+		sdk.NewDec(2),
+	)
+	zk.FungibleKeeper.SetForeignCoins(ctx, gasCoin)
+	zk.FungibleKeeper.SetForeignCoins(ctx, erc20Coin)
+
+	flags := types.RateLimiterFlags{
+		Enabled: true,
+		ChainRateLimits: []types.ChainRateLimit{
+			{ChainId: chainID, Window: 100, Rate: sdk.NewUint(1)},
+		},
+		AssetRateLimits: []types.AssetRateLimit{
+			{Zrc20: zrc20GasAddr, Window: 200, Rate: sdk.NewUint(2), NativeRate: sdk.NewUint(3)},
+			{Zrc20: zrc20ERC20Addr, Window: 300, Rate: sdk.NewUint(0), NativeRate: sdk.NewUint(4)},
+			// no foreign coin for this zrc20, should be ignored
+			{Zrc20: sample.EthAddress().Hex(), Window: 400, Rate: sdk.NewUint(5), NativeRate: sdk.NewUint(0)},
+		},
+	}
+
+	buckets := k.GetRateLimitBuckets(ctx, flags)
+	require.Equal(t, []types.RateLimitBucket{
+		{
+			Type:    types.RateLimitBucketType_Chain,
+			ChainId: chainID,
+			Window:  100,
+			Rate:    sdk.NewUint(1),
+		},
+		{
+			Type:     types.RateLimitBucketType_Asset,
+			ChainId:  chainID,
+			Zrc20:    zrc20GasAddr,
+			CoinType: coin.CoinType_Gas,
+			Window:   200,
+			Rate:     sdk.NewUint(2),
+		},
+		{
+			Type:     types.RateLimitBucketType_AssetNative,
+			ChainId:  chainID,
+			Zrc20:    zrc20GasAddr,
+			CoinType: coin.CoinType_Gas,
+			Window:   200,
+			Rate:     sdk.NewUint(3),
+		},
+		{
+			Type:     types.RateLimitBucketType_AssetNative,
+			ChainId:  chainID,
+			Zrc20:    zrc20ERC20Addr,
+			CoinType: coin.CoinType_ERC20,
+			Asset:    strings.ToLower(erc20Asset),
+			Window:   300,
+			Rate:     sdk.NewUint(4),
+		},
+	}, buckets)
+}
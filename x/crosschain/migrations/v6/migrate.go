@@ -0,0 +1,19 @@
+package v6
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// crosschainKeeper is an interface to prevent cyclic dependency
+type crosschainKeeper interface {
+	StartCctxIndexBackfill(ctx sdk.Context)
+}
+
+// MigrateStore migrates the x/crosschain module state from the consensus version 5 to 6
+// It starts the backfill of the secondary indexes of the existing cctxs, indexed by batches over the next blocks
+// The indexes are then maintained when setting a cctx
+// The existing cctxs are not modified: the ones created without finalized zeta height are indexed at height 0
+func MigrateStore(ctx sdk.Context, crosschainKeeper crosschainKeeper) error {
+	crosschainKeeper.StartCctxIndexBackfill(ctx)
+	return nil
+}
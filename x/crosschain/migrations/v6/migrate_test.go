@@ -0,0 +1,139 @@
+package v6_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	v6 "github.com/zeta-chain/zetacore/x/crosschain/migrations/v6"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	t.Run("should build the indexes of the cctxs", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		cctxs := make([]*types.CrossChainTx, 10)
+		for i := range cctxs {
+			cctxs[i] = sample.CrossChainTx(t, fmt.Sprintf("%d", i))
+			cctxs[i].InboundParams.FinalizedZetaHeight = uint64(i + 1)
+			k.SetCrossChainTx(ctx, *cctxs[i])
+		}
+
+		// remove the indexes to get the state before the migration
+		removeCctxIndexes(t, ctx, k)
+		res, err := k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{})
+		require.NoError(t, err)
+		require.Empty(t, res.CrossChainTx)
+
+		err = v6.MigrateStore(ctx, k)
+		require.NoError(t, err)
+		require.Equal(t, len(cctxs), k.BackfillCctxIndexes(ctx))
+
+		res, err = k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.CrossChainTx, len(cctxs))
+		for i, cctx := range cctxs {
+			require.Equal(t, *cctx, *res.CrossChainTx[i])
+		}
+
+		res, err = k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{
+			Sender: cctxs[3].InboundParams.Sender,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []*types.CrossChainTx{cctxs[3]}, res.CrossChainTx)
+	})
+
+	t.Run("should index the cctxs without finalized zeta height at height 0", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		finalized := sample.CrossChainTx(t, "finalized")
+		finalized.InboundParams.FinalizedZetaHeight = 42
+		k.SetCrossChainTx(ctx, *finalized)
+		created := sample.CrossChainTx(t, "created")
+		created.InboundParams.FinalizedZetaHeight = 0
+		k.SetCrossChainTx(ctx, *created)
+		removeCctxIndexes(t, ctx, k)
+
+		err := v6.MigrateStore(ctx, k)
+		require.NoError(t, err)
+		k.BackfillCctxIndexes(ctx)
+
+		// the cctxs are not modified
+		cctx, found := k.GetCrossChainTx(ctx, created.Index)
+		require.True(t, found)
+		require.Equal(t, *created, cctx)
+
+		res, err := k.CctxAllByFilter(ctx, &types.QueryAllCctxByFilterRequest{MaxHeight: 1})
+		require.NoError(t, err)
+		require.Len(t, res.CrossChainTx, 1)
+		require.Equal(t, created.Index, res.CrossChainTx[0].Index)
+	})
+
+	t.Run("should index the cctxs over several blocks", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		count := 2500
+		for i := 0; i < count; i++ {
+			cctx := sample.CrossChainTx(t, fmt.Sprintf("%d", i))
+			cctx.InboundParams.FinalizedZetaHeight = uint64(i + 1)
+			k.SetCrossChainTx(ctx, *cctx)
+		}
+		removeCctxIndexes(t, ctx, k)
+
+		err := v6.MigrateStore(ctx, k)
+		require.NoError(t, err)
+		require.Zero(t, countIndexedCctxs(t, ctx, k))
+
+		require.Equal(t, 1000, k.BackfillCctxIndexes(ctx))
+		require.Equal(t, 1000, countIndexedCctxs(t, ctx, k))
+		require.Equal(t, 1000, k.BackfillCctxIndexes(ctx))
+		require.Equal(t, 500, k.BackfillCctxIndexes(ctx))
+		require.Equal(t, count, countIndexedCctxs(t, ctx, k))
+
+		// the backfill is completed
+		_, found := k.GetCctxIndexBackfillCursor(ctx)
+		require.False(t, found)
+		require.Zero(t, k.BackfillCctxIndexes(ctx))
+	})
+
+	t.Run("should not start the backfill without cctx", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+
+		err := v6.MigrateStore(ctx, k)
+		require.NoError(t, err)
+		_, found := k.GetCctxIndexBackfillCursor(ctx)
+		require.False(t, found)
+	})
+}
+
+// countIndexedCctxs returns the number of cctxs in the height index
+func countIndexedCctxs(t *testing.T, ctx sdk.Context, k *keeper.Keeper) int {
+	indexStore := prefix.NewStore(ctx.KVStore(k.GetStoreKey()), types.KeyPrefix(types.CctxIndexKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(indexStore, types.CctxIndexPrefix(types.CctxIndexHeight, ""))
+	indexed := 0
+	for ; iterator.Valid(); iterator.Next() {
+		indexed++
+	}
+	require.NoError(t, iterator.Close())
+	return indexed
+}
+
+// removeCctxIndexes removes all the index entries of the cctxs
+func removeCctxIndexes(t *testing.T, ctx sdk.Context, k *keeper.Keeper) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.GetStoreKey()), types.KeyPrefix(types.CctxIndexKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(indexStore, []byte{})
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	require.NoError(t, iterator.Close())
+	require.NotEmpty(t, keys)
+	for _, key := range keys {
+		indexStore.Delete(key)
+	}
+}
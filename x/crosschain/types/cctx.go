@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/zeta-chain/zetacore/pkg/chains"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 )
 
@@ -38,7 +39,8 @@ func (m CrossChainTx) OriginalDestinationChainID() int64 {
 }
 
 // Validate checks if the CCTX is valid.
-func (m CrossChainTx) Validate() error {
+// additionalChains is the list of chains supported in addition to the default chains
+func (m CrossChainTx) Validate(additionalChains []chains.Chain) error {
 	if m.InboundParams == nil {
 		return fmt.Errorf("inbound tx params cannot be nil")
 	}
@@ -57,12 +59,12 @@ func (m CrossChainTx) Validate() error {
 			return err
 		}
 	}
-	err := m.InboundParams.Validate()
+	err := m.InboundParams.Validate(additionalChains)
 	if err != nil {
 		return err
 	}
 	for _, outboundParam := range m.OutboundParams {
-		err = outboundParam.Validate()
+		err = outboundParam.Validate(additionalChains)
 		if err != nil {
 			return err
 		}
@@ -82,8 +84,14 @@ func (m *CrossChainTx) AddRevertOutbound(gasLimit uint64) error {
 		return fmt.Errorf("cannot revert before trying to process an outbound tx")
 	}
 
+	// the funds are reverted to the revert address if specified by the sender
+	receiver := m.InboundParams.Sender
+	if m.InboundParams.RevertAddress != "" {
+		receiver = m.InboundParams.RevertAddress
+	}
+
 	revertTxParams := &OutboundParams{
-		Receiver:        m.InboundParams.Sender,
+		Receiver:        receiver,
 		ReceiverChainId: m.InboundParams.SenderChainId,
 		Amount:          m.GetCurrentOutboundParam().Amount,
 		GasLimit:        gasLimit,
@@ -169,8 +177,8 @@ func GetCctxIndexFromBytes(sendHash [32]byte) string {
 }
 
 // NewCCTX creates a new CCTX.From a MsgVoteInbound message and a TSS pubkey.
-// It also validates the created cctx
-func NewCCTX(ctx sdk.Context, msg MsgVoteInbound, tssPubkey string) (CrossChainTx, error) {
+// It also validates the created cctx against the default chains and the additional chains
+func NewCCTX(ctx sdk.Context, msg MsgVoteInbound, tssPubkey string, additionalChains []chains.Chain) (CrossChainTx, error) {
 	index := msg.Digest()
 
 	if msg.TxOrigin == "" {
@@ -187,6 +195,7 @@ func NewCCTX(ctx sdk.Context, msg MsgVoteInbound, tssPubkey string) (CrossChainT
 		FinalizedZetaHeight:    0,
 		BallotIndex:            index,
 		CoinType:               msg.CoinType,
+		RevertAddress:          msg.RevertAddress,
 	}
 
 	outBoundParams := &OutboundParams{
@@ -220,7 +229,7 @@ func NewCCTX(ctx sdk.Context, msg MsgVoteInbound, tssPubkey string) (CrossChainT
 
 	// TODO: remove this validate call
 	// https://github.com/zeta-chain/node/issues/2236
-	err := cctx.Validate()
+	err := cctx.Validate(additionalChains)
 	if err != nil {
 		return CrossChainTx{}, err
 	}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// CctxIndexField is a field of the cctxs with a secondary index
+type CctxIndexField string
+
+const (
+	CctxIndexHeight          CctxIndexField = "height"
+	CctxIndexSender          CctxIndexField = "sender"
+	CctxIndexReceiver        CctxIndexField = "receiver"
+	CctxIndexStatus          CctxIndexField = "status"
+	CctxIndexSenderChainID   CctxIndexField = "senderChain"
+	CctxIndexReceiverChainID CctxIndexField = "receiverChain"
+)
+
+// CctxIndexPrefix returns the prefix of the index entries of the cctxs with the given value of the field
+// the entries of a prefix are ordered by the zeta height the cctxs were created at
+func CctxIndexPrefix(field CctxIndexField, value string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", field, value))
+}
+
+// CctxIndexHeightKey returns the key of the index entries of the prefix starting at the given height
+func CctxIndexHeightKey(prefix []byte, height uint64) []byte {
+	key := make([]byte, len(prefix), len(prefix)+8)
+	copy(key, prefix)
+	return binary.BigEndian.AppendUint64(key, height)
+}
+
+// CctxIndexAddress normalizes the address of a cctx index, hex addresses are case-insensitive
+func CctxIndexAddress(address string) string {
+	if ethcommon.IsHexAddress(address) {
+		return strings.ToLower(address)
+	}
+	return address
+}
+
+// CctxIndexKeys returns the keys of the index entries of the cctx
+// the cctx is indexed at the zeta height its inbound is finalized at, set on all the cctx creation paths
+// the cctxs created without inbound vote before the height was set (consensus version 6) are indexed at height 0,
+// the unknown height
+func CctxIndexKeys(cctx CrossChainTx) [][]byte {
+	var height uint64
+	prefixes := [][]byte{CctxIndexPrefix(CctxIndexHeight, "")}
+	if cctx.InboundParams != nil {
+		height = cctx.InboundParams.FinalizedZetaHeight
+		prefixes = append(prefixes, CctxIndexPrefix(
+			CctxIndexSenderChainID,
+			strconv.FormatInt(cctx.InboundParams.SenderChainId, 10),
+		))
+		if cctx.InboundParams.Sender != "" {
+			prefixes = append(prefixes, CctxIndexPrefix(
+				CctxIndexSender,
+				CctxIndexAddress(cctx.InboundParams.Sender),
+			))
+		}
+	}
+	// the reverts append an outbound, the first outbound is the one to the receiver
+	if len(cctx.OutboundParams) > 0 && cctx.OutboundParams[0] != nil {
+		prefixes = append(prefixes, CctxIndexPrefix(
+			CctxIndexReceiverChainID,
+			strconv.FormatInt(cctx.OutboundParams[0].ReceiverChainId, 10),
+		))
+		if cctx.OutboundParams[0].Receiver != "" {
+			prefixes = append(prefixes, CctxIndexPrefix(
+				CctxIndexReceiver,
+				CctxIndexAddress(cctx.OutboundParams[0].Receiver),
+			))
+		}
+	}
+	if cctx.CctxStatus != nil {
+		prefixes = append(prefixes, CctxIndexPrefix(CctxIndexStatus, cctx.CctxStatus.Status.String()))
+	}
+
+	keys := make([][]byte, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		keys = append(keys, append(CctxIndexHeightKey(prefix, height), []byte(cctx.Index)...))
+	}
+	return keys
+}
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestCctxIndexAddress(t *testing.T) {
+	ethAddress := sample.EthAddress().Hex()
+	require.Equal(t, strings.ToLower(ethAddress), types.CctxIndexAddress(ethAddress))
+
+	// non-hex addresses can be case-sensitive
+	btcAddress := "bc1qysd4sp9q8my59ul9wsf5rvs9p387hf8vfwatzu"
+	require.Equal(t, btcAddress, types.CctxIndexAddress(btcAddress))
+	require.Equal(t, "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", types.CctxIndexAddress("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"))
+}
+
+func TestCctxIndexKeys(t *testing.T) {
+	t.Run("should return the keys of all the indexed fields", func(t *testing.T) {
+		cctx := sample.CrossChainTx(t, "sample")
+		cctx.InboundParams.FinalizedZetaHeight = 42
+		keys := types.CctxIndexKeys(*cctx)
+		require.Len(t, keys, 6)
+
+		statusKey := append(types.CctxIndexHeightKey(
+			types.CctxIndexPrefix(types.CctxIndexStatus, cctx.CctxStatus.Status.String()),
+			42,
+		), []byte(cctx.Index)...)
+		require.Contains(t, keys, statusKey)
+		senderKey := append(types.CctxIndexHeightKey(
+			types.CctxIndexPrefix(types.CctxIndexSender, types.CctxIndexAddress(cctx.InboundParams.Sender)),
+			42,
+		), []byte(cctx.Index)...)
+		require.Contains(t, keys, senderKey)
+	})
+
+	t.Run("should skip the fields not set", func(t *testing.T) {
+		cctx := types.CrossChainTx{Index: "index"}
+		require.Equal(t, [][]byte{
+			append(types.CctxIndexHeightKey(types.CctxIndexPrefix(types.CctxIndexHeight, ""), 0), []byte("index")...),
+		}, types.CctxIndexKeys(cctx))
+	})
+}
@@ -0,0 +1,46 @@
+package types
+
+import "fmt"
+
+const (
+	// MinCctxRetentionBlocks is the minimum number of blocks the cctxs are kept
+	// the ballots of the cctxs must be kept until they are matured for the observer rewards
+	MinCctxRetentionBlocks = 14400
+
+	// MaxCctxPrunedPerBlock is the maximum number of cctxs that can be pruned per block
+	MaxCctxPrunedPerBlock = 1000
+)
+
+// Validate checks the retention policy is valid, the disabled policy is always valid
+func (p CctxRetentionPolicy) Validate() error {
+	if !p.Enabled {
+		return nil
+	}
+	if p.RetentionBlocks < MinCctxRetentionBlocks {
+		return fmt.Errorf("retention blocks must be at least %d: %d", MinCctxRetentionBlocks, p.RetentionBlocks)
+	}
+	if p.MaxPrunedPerBlock == 0 || p.MaxPrunedPerBlock > MaxCctxPrunedPerBlock {
+		return fmt.Errorf(
+			"max pruned per block must be between 1 and %d: %d",
+			MaxCctxPrunedPerBlock,
+			p.MaxPrunedPerBlock,
+		)
+	}
+	return nil
+}
+
+// IsPrunable returns true if the cctx is in a terminal state and can be pruned from the state
+// the aborted cctxs can only be pruned once refunded
+func (m CrossChainTx) IsPrunable() bool {
+	if m.CctxStatus == nil {
+		return false
+	}
+	switch m.CctxStatus.Status {
+	case CctxStatus_OutboundMined, CctxStatus_Reverted:
+		return true
+	case CctxStatus_Aborted:
+		return m.CctxStatus.IsAbortRefunded
+	default:
+		return false
+	}
+}
@@ -0,0 +1,386 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zetachain/zetacore/crosschain/cctx_retention_policy.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CctxRetentionPolicy defines when the cctxs in a terminal state are pruned
+// from the state, an archival event with the cctx is emitted when pruned
+type CctxRetentionPolicy struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// number of blocks the cctxs are kept after the zeta height they were
+	// created at
+	RetentionBlocks uint64 `protobuf:"varint,2,opt,name=retention_blocks,json=retentionBlocks,proto3" json:"retention_blocks,omitempty"`
+	// maximum number of cctxs pruned per block
+	MaxPrunedPerBlock uint32 `protobuf:"varint,3,opt,name=max_pruned_per_block,json=maxPrunedPerBlock,proto3" json:"max_pruned_per_block,omitempty"`
+}
+
+func (m *CctxRetentionPolicy) Reset()         { *m = CctxRetentionPolicy{} }
+func (m *CctxRetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*CctxRetentionPolicy) ProtoMessage()    {}
+func (*CctxRetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b1fc714576e2031, []int{0}
+}
+func (m *CctxRetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CctxRetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CctxRetentionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CctxRetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CctxRetentionPolicy.Merge(m, src)
+}
+func (m *CctxRetentionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *CctxRetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CctxRetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CctxRetentionPolicy proto.InternalMessageInfo
+
+func (m *CctxRetentionPolicy) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *CctxRetentionPolicy) GetRetentionBlocks() uint64 {
+	if m != nil {
+		return m.RetentionBlocks
+	}
+	return 0
+}
+
+func (m *CctxRetentionPolicy) GetMaxPrunedPerBlock() uint32 {
+	if m != nil {
+		return m.MaxPrunedPerBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CctxRetentionPolicy)(nil), "zetachain.zetacore.crosschain.CctxRetentionPolicy")
+}
+
+func init() {
+	proto.RegisterFile("zetachain/zetacore/crosschain/cctx_retention_policy.proto", fileDescriptor_5b1fc714576e2031)
+}
+
+var fileDescriptor_5b1fc714576e2031 = []byte{
+	// 240 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0xac, 0x4a, 0x2d, 0x49,
+	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb3, 0xf2, 0x8b, 0x52, 0xf5, 0x93, 0x8b, 0xf2, 0x8b,
+	0x8b, 0x21, 0x62, 0xc9, 0xc9, 0x25, 0x15, 0xf1, 0x45, 0xa9, 0x25, 0xa9, 0x79, 0x25, 0x99, 0xf9,
+	0x79, 0xf1, 0x05, 0xf9, 0x39, 0x99, 0xc9, 0x95, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xb2,
+	0x70, 0xad, 0x7a, 0x30, 0xad, 0x7a, 0x08, 0xad, 0x4a, 0xdd, 0x8c, 0x5c, 0xc2, 0xce, 0xc9, 0x25,
+	0x15, 0x41, 0x30, 0xdd, 0x01, 0x60, 0xcd, 0x42, 0x12, 0x5c, 0xec, 0xa9, 0x79, 0x89, 0x49, 0x39,
+	0xa9, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x1c, 0x41, 0x30, 0xae, 0x90, 0x26, 0x97, 0x00, 0xc2,
+	0xaa, 0xa4, 0x9c, 0xfc, 0xe4, 0xec, 0x62, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x7e, 0xb8,
+	0xb8, 0x13, 0x58, 0x58, 0x48, 0x9f, 0x4b, 0x24, 0x37, 0xb1, 0x22, 0xbe, 0xa0, 0xa8, 0x34, 0x2f,
+	0x35, 0x25, 0xbe, 0x20, 0xb5, 0x08, 0xa2, 0x5e, 0x82, 0x59, 0x81, 0x51, 0x83, 0x37, 0x48, 0x30,
+	0x37, 0xb1, 0x22, 0x00, 0x2c, 0x15, 0x90, 0x5a, 0x04, 0xd6, 0xe1, 0xe4, 0x7d, 0xe2, 0x91, 0x1c,
+	0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1,
+	0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x86, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9,
+	0xf9, 0xb9, 0xe0, 0x20, 0xd0, 0x45, 0x0b, 0x8d, 0x0a, 0xe4, 0xf0, 0x28, 0xa9, 0x2c, 0x48, 0x2d,
+	0x4e, 0x62, 0x03, 0x07, 0x80, 0x31, 0x60, 0x00, 0x16, 0x9b, 0x28, 0x54, 0x3d, 0x01, 0x00, 0x00,
+}
+
+func (m *CctxRetentionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CctxRetentionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CctxRetentionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPrunedPerBlock != 0 {
+		i = encodeVarintCctxRetentionPolicy(dAtA, i, uint64(m.MaxPrunedPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RetentionBlocks != 0 {
+		i = encodeVarintCctxRetentionPolicy(dAtA, i, uint64(m.RetentionBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCctxRetentionPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCctxRetentionPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CctxRetentionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.RetentionBlocks != 0 {
+		n += 1 + sovCctxRetentionPolicy(uint64(m.RetentionBlocks))
+	}
+	if m.MaxPrunedPerBlock != 0 {
+		n += 1 + sovCctxRetentionPolicy(uint64(m.MaxPrunedPerBlock))
+	}
+	return n
+}
+
+func sovCctxRetentionPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCctxRetentionPolicy(x uint64) (n int) {
+	return sovCctxRetentionPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CctxRetentionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCctxRetentionPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CctxRetentionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CctxRetentionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCctxRetentionPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionBlocks", wireType)
+			}
+			m.RetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCctxRetentionPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedPerBlock", wireType)
+			}
+			m.MaxPrunedPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCctxRetentionPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCctxRetentionPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCctxRetentionPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCctxRetentionPolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCctxRetentionPolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCctxRetentionPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCctxRetentionPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCctxRetentionPolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCctxRetentionPolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCctxRetentionPolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCctxRetentionPolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCctxRetentionPolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCctxRetentionPolicy = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,118 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestCctxRetentionPolicy_Validate(t *testing.T) {
+	tt := []struct {
+		name   string
+		policy types.CctxRetentionPolicy
+		isErr  bool
+	}{
+		{
+			name:   "valid policy",
+			policy: sample.CctxRetentionPolicy(),
+		},
+		{
+			name:   "empty is valid",
+			policy: types.CctxRetentionPolicy{},
+		},
+		{
+			name: "disabled policy is valid",
+			policy: types.CctxRetentionPolicy{
+				Enabled:         false,
+				RetentionBlocks: 1,
+			},
+		},
+		{
+			name: "retention blocks too low",
+			policy: types.CctxRetentionPolicy{
+				Enabled:           true,
+				RetentionBlocks:   types.MinCctxRetentionBlocks - 1,
+				MaxPrunedPerBlock: 1,
+			},
+			isErr: true,
+		},
+		{
+			name: "max pruned per block is zero",
+			policy: types.CctxRetentionPolicy{
+				Enabled:           true,
+				RetentionBlocks:   types.MinCctxRetentionBlocks,
+				MaxPrunedPerBlock: 0,
+			},
+			isErr: true,
+		},
+		{
+			name: "max pruned per block too high",
+			policy: types.CctxRetentionPolicy{
+				Enabled:           true,
+				RetentionBlocks:   types.MinCctxRetentionBlocks,
+				MaxPrunedPerBlock: types.MaxCctxPrunedPerBlock + 1,
+			},
+			isErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.isErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCrossChainTx_IsPrunable(t *testing.T) {
+	tt := []struct {
+		name       string
+		status     *types.Status
+		isPrunable bool
+	}{
+		{
+			name:       "outbound mined",
+			status:     &types.Status{Status: types.CctxStatus_OutboundMined},
+			isPrunable: true,
+		},
+		{
+			name:       "reverted",
+			status:     &types.Status{Status: types.CctxStatus_Reverted},
+			isPrunable: true,
+		},
+		{
+			name:       "aborted and refunded",
+			status:     &types.Status{Status: types.CctxStatus_Aborted, IsAbortRefunded: true},
+			isPrunable: true,
+		},
+		{
+			name:   "aborted and not refunded",
+			status: &types.Status{Status: types.CctxStatus_Aborted},
+		},
+		{
+			name:   "pending outbound",
+			status: &types.Status{Status: types.CctxStatus_PendingOutbound},
+		},
+		{
+			name:   "pending revert",
+			status: &types.Status{Status: types.CctxStatus_PendingRevert},
+		},
+		{
+			name: "nil status",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			cctx := types.CrossChainTx{CctxStatus: tc.status}
+			require.Equal(t, tc.isPrunable, cctx.IsPrunable())
+		})
+	}
+}
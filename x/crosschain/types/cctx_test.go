@@ -39,6 +39,7 @@ func Test_InitializeCCTX(t *testing.T) {
 		asset := "test-asset"
 		eventIndex := uint64(1)
 		cointType := coin.CoinType_ERC20
+		revertAddress := sample.EthAddress().String()
 		tss := sample.Tss()
 		msg := types.MsgVoteInbound{
 			Creator:            creator,
@@ -55,8 +56,9 @@ func Test_InitializeCCTX(t *testing.T) {
 			TxOrigin:           sender.String(),
 			Asset:              asset,
 			EventIndex:         eventIndex,
+			RevertAddress:      revertAddress,
 		}
-		cctx, err := types.NewCCTX(ctx, msg, tss.TssPubkey)
+		cctx, err := types.NewCCTX(ctx, msg, tss.TssPubkey, []chains.Chain{})
 		require.NoError(t, err)
 		require.Equal(t, receiver.String(), cctx.GetCurrentOutboundParam().Receiver)
 		require.Equal(t, receiverChain.ChainId, cctx.GetCurrentOutboundParam().ReceiverChainId)
@@ -69,6 +71,7 @@ func Test_InitializeCCTX(t *testing.T) {
 		require.Equal(t, gasLimit, cctx.GetCurrentOutboundParam().GasLimit)
 		require.Equal(t, asset, cctx.GetInboundParams().Asset)
 		require.Equal(t, cointType, cctx.InboundParams.CoinType)
+		require.Equal(t, revertAddress, cctx.InboundParams.RevertAddress)
 		require.Equal(t, uint64(0), cctx.GetCurrentOutboundParam().TssNonce)
 		require.Equal(t, sdkmath.ZeroUint(), cctx.GetCurrentOutboundParam().Amount)
 		require.Equal(t, types.CctxStatus_PendingInbound, cctx.CctxStatus.Status)
@@ -106,7 +109,7 @@ func Test_InitializeCCTX(t *testing.T) {
 			Asset:              asset,
 			EventIndex:         eventIndex,
 		}
-		_, err := types.NewCCTX(ctx, msg, tss.TssPubkey)
+		_, err := types.NewCCTX(ctx, msg, tss.TssPubkey, []chains.Chain{})
 		require.ErrorContains(t, err, "sender cannot be empty")
 	})
 }
@@ -114,30 +117,30 @@ func Test_InitializeCCTX(t *testing.T) {
 func TestCrossChainTx_Validate(t *testing.T) {
 	cctx := sample.CrossChainTx(t, "foo")
 	cctx.InboundParams = nil
-	require.ErrorContains(t, cctx.Validate(), "inbound tx params cannot be nil")
+	require.ErrorContains(t, cctx.Validate([]chains.Chain{}), "inbound tx params cannot be nil")
 	cctx = sample.CrossChainTx(t, "foo")
 	cctx.OutboundParams = nil
-	require.ErrorContains(t, cctx.Validate(), "outbound tx params cannot be nil")
+	require.ErrorContains(t, cctx.Validate([]chains.Chain{}), "outbound tx params cannot be nil")
 	cctx = sample.CrossChainTx(t, "foo")
 	cctx.CctxStatus = nil
-	require.ErrorContains(t, cctx.Validate(), "cctx status cannot be nil")
+	require.ErrorContains(t, cctx.Validate([]chains.Chain{}), "cctx status cannot be nil")
 	cctx = sample.CrossChainTx(t, "foo")
 	cctx.OutboundParams = make([]*types.OutboundParams, 3)
-	require.ErrorContains(t, cctx.Validate(), "outbound tx params cannot be more than 2")
+	require.ErrorContains(t, cctx.Validate([]chains.Chain{}), "outbound tx params cannot be more than 2")
 	cctx = sample.CrossChainTx(t, "foo")
 	cctx.Index = "0"
-	require.ErrorContains(t, cctx.Validate(), "invalid index length 1")
+	require.ErrorContains(t, cctx.Validate([]chains.Chain{}), "invalid index length 1")
 	cctx = sample.CrossChainTx(t, "foo")
 	cctx.InboundParams = sample.InboundParamsValidChainID(rand.New(rand.NewSource(42)))
 	cctx.InboundParams.SenderChainId = 1000
-	require.ErrorContains(t, cctx.Validate(), "invalid sender chain id 1000")
+	require.ErrorContains(t, cctx.Validate([]chains.Chain{}), "invalid sender chain id 1000")
 	cctx = sample.CrossChainTx(t, "foo")
 	cctx.OutboundParams = []*types.OutboundParams{sample.OutboundParamsValidChainID(rand.New(rand.NewSource(42)))}
 	cctx.InboundParams = sample.InboundParamsValidChainID(rand.New(rand.NewSource(42)))
 	cctx.InboundParams.ObservedHash = sample.Hash().String()
 	cctx.InboundParams.BallotIndex = sample.ZetaIndex(t)
 	cctx.OutboundParams[0].ReceiverChainId = 1000
-	require.ErrorContains(t, cctx.Validate(), "invalid receiver chain id 1000")
+	require.ErrorContains(t, cctx.Validate([]chains.Chain{}), "invalid receiver chain id 1000")
 }
 
 func TestCrossChainTx_GetCurrentOutboundParam(t *testing.T) {
@@ -259,6 +262,17 @@ func Test_SetRevertOutboundValues(t *testing.T) {
 		require.Equal(t, types.TxFinalizationStatus_Executed, cctx.OutboundParams[0].TxFinalizationStatus)
 	})
 
+	t.Run("successfully set revert outbound values with revert address", func(t *testing.T) {
+		cctx := sample.CrossChainTx(t, "test")
+		cctx.OutboundParams = cctx.OutboundParams[:1]
+		cctx.InboundParams.RevertAddress = "bc1qysd4sp9q8my59ul9wsf5rvs9p387hf8vfwatzu"
+		err := cctx.AddRevertOutbound(100)
+		require.NoError(t, err)
+		require.Len(t, cctx.OutboundParams, 2)
+		require.Equal(t, "bc1qysd4sp9q8my59ul9wsf5rvs9p387hf8vfwatzu", cctx.GetCurrentOutboundParam().Receiver)
+		require.Equal(t, cctx.GetCurrentOutboundParam().ReceiverChainId, cctx.InboundParams.SenderChainId)
+	})
+
 	t.Run("failed to set revert outbound values if revert outbound already exists", func(t *testing.T) {
 		cctx := sample.CrossChainTx(t, "test")
 		err := cctx.AddRevertOutbound(100)
@@ -103,6 +103,9 @@ type InboundParams struct {
 	BallotIndex            string                                  `protobuf:"bytes,9,opt,name=ballot_index,json=ballotIndex,proto3" json:"ballot_index,omitempty"`
 	FinalizedZetaHeight    uint64                                  `protobuf:"varint,10,opt,name=finalized_zeta_height,json=finalizedZetaHeight,proto3" json:"finalized_zeta_height,omitempty"`
 	TxFinalizationStatus   TxFinalizationStatus                    `protobuf:"varint,11,opt,name=tx_finalization_status,json=txFinalizationStatus,proto3,enum=zetachain.zetacore.crosschain.TxFinalizationStatus" json:"tx_finalization_status,omitempty"`
+	// address on the sender chain receiving the reverted funds, the sender if
+	// empty
+	RevertAddress string `protobuf:"bytes,12,opt,name=revert_address,json=revertAddress,proto3" json:"revert_address,omitempty"`
 }
 
 func (m *InboundParams) Reset()         { *m = InboundParams{} }
@@ -208,6 +211,13 @@ func (m *InboundParams) GetTxFinalizationStatus() TxFinalizationStatus {
 	return TxFinalizationStatus_NotFinalized
 }
 
+func (m *InboundParams) GetRevertAddress() string {
+	if m != nil {
+		return m.RevertAddress
+	}
+	return ""
+}
+
 type ZetaAccounting struct {
 	// aborted_zeta_amount stores the total aborted amount for cctx of coin-type
 	// ZETA
@@ -559,74 +569,75 @@ func init() {
 }
 
 var fileDescriptor_d4c1966807fb5cb2 = []byte{
-	// 1072 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x16, 0x23, 0x59, 0x96, 0x46, 0xbf, 0x59, 0x2b, 0x06, 0xeb, 0x22, 0x8a, 0xab, 0x22, 0x89,
-	0x12, 0xd4, 0x14, 0xe2, 0x5c, 0x8a, 0xde, 0x6c, 0x23, 0x4e, 0x8c, 0x34, 0x89, 0xc1, 0xd8, 0x3d,
-	0xe4, 0x50, 0x76, 0x45, 0x8e, 0xa9, 0x85, 0x25, 0xae, 0xca, 0x5d, 0x19, 0x74, 0xd0, 0x87, 0xe8,
-	0x43, 0xf4, 0xd0, 0x63, 0x9f, 0xa0, 0xe7, 0xdc, 0x9a, 0x63, 0xd1, 0x83, 0xd1, 0xda, 0x6f, 0xd0,
-	0x27, 0x28, 0x76, 0x97, 0x94, 0x2c, 0xc3, 0xb0, 0xd3, 0xb4, 0x27, 0xcd, 0x7c, 0xb3, 0xfb, 0xcd,
-	0x68, 0xf6, 0x9b, 0x5d, 0xc2, 0xfa, 0x5b, 0x94, 0xd4, 0x1f, 0x50, 0x16, 0xf5, 0xb4, 0xc5, 0x63,
-	0xec, 0xf9, 0x31, 0x17, 0xc2, 0x60, 0xda, 0xf4, 0xb4, 0xed, 0xc9, 0xc4, 0x19, 0xc7, 0x5c, 0x72,
-	0x72, 0x7b, 0xba, 0xc7, 0xc9, 0xf6, 0x38, 0xb3, 0x3d, 0x2b, 0xad, 0x90, 0x87, 0x5c, 0xaf, 0xec,
-	0x29, 0xcb, 0x6c, 0x5a, 0xb9, 0x77, 0x49, 0xa2, 0xf1, 0x61, 0xd8, 0xf3, 0xb9, 0x4a, 0xc3, 0x59,
-	0x64, 0xd6, 0x75, 0x7e, 0x29, 0x40, 0x6d, 0x27, 0xea, 0xf3, 0x49, 0x14, 0xec, 0xd2, 0x98, 0x8e,
-	0x04, 0x59, 0x86, 0xa2, 0xc0, 0x28, 0xc0, 0xd8, 0xb6, 0x56, 0xad, 0x6e, 0xd9, 0x4d, 0x3d, 0x72,
-	0x0f, 0x1a, 0xc6, 0x4a, 0xeb, 0x63, 0x81, 0x7d, 0x63, 0xd5, 0xea, 0xe6, 0xdd, 0x9a, 0x81, 0xb7,
-	0x14, 0xba, 0x13, 0x90, 0x4f, 0xa1, 0x2c, 0x13, 0x8f, 0xc7, 0x2c, 0x64, 0x91, 0x9d, 0xd7, 0x14,
-	0x25, 0x99, 0xbc, 0xd2, 0x3e, 0xd9, 0x84, 0xb2, 0x4a, 0xee, 0xc9, 0xe3, 0x31, 0xda, 0x85, 0x55,
-	0xab, 0x5b, 0x5f, 0xbf, 0xeb, 0x5c, 0xf2, 0xff, 0xc6, 0x87, 0xa1, 0xa3, 0xab, 0xdc, 0xe2, 0x2c,
-	0xda, 0x3b, 0x1e, 0xa3, 0x5b, 0xf2, 0x53, 0x8b, 0xb4, 0x60, 0x81, 0x0a, 0x81, 0xd2, 0x5e, 0xd0,
-	0xe4, 0xc6, 0x21, 0x4f, 0xa1, 0x48, 0x47, 0x7c, 0x12, 0x49, 0xbb, 0xa8, 0xe0, 0xcd, 0xde, 0xbb,
-	0x93, 0x3b, 0xb9, 0x3f, 0x4e, 0xee, 0xdc, 0x0f, 0x99, 0x1c, 0x4c, 0xfa, 0x8e, 0xcf, 0x47, 0x3d,
-	0x9f, 0x8b, 0x11, 0x17, 0xe9, 0xcf, 0x9a, 0x08, 0x0e, 0x7b, 0xaa, 0x0e, 0xe1, 0xec, 0xb3, 0x48,
-	0xba, 0xe9, 0x76, 0xf2, 0x39, 0xd4, 0x78, 0x5f, 0x60, 0x7c, 0x84, 0x81, 0x37, 0xa0, 0x62, 0x60,
-	0x2f, 0xea, 0x34, 0xd5, 0x0c, 0x7c, 0x46, 0xc5, 0x80, 0x7c, 0x09, 0xf6, 0x74, 0x11, 0x26, 0x12,
-	0xe3, 0x88, 0x0e, 0xbd, 0x01, 0xb2, 0x70, 0x20, 0xed, 0xd2, 0xaa, 0xd5, 0x2d, 0xb8, 0xcb, 0x59,
-	0xfc, 0x49, 0x1a, 0x7e, 0xa6, 0xa3, 0xe4, 0x33, 0xa8, 0xf6, 0xe9, 0x70, 0xc8, 0xa5, 0xc7, 0xa2,
-	0x00, 0x13, 0xbb, 0xac, 0xd9, 0x2b, 0x06, 0xdb, 0x51, 0x10, 0x59, 0x87, 0x5b, 0x07, 0x2c, 0xa2,
-	0x43, 0xf6, 0x16, 0x03, 0x4f, 0xb5, 0x24, 0x63, 0x06, 0xcd, 0xbc, 0x34, 0x0d, 0xbe, 0x41, 0x49,
-	0x53, 0x5a, 0x06, 0xcb, 0x32, 0xf1, 0xd2, 0x08, 0x95, 0x8c, 0x47, 0x9e, 0x90, 0x54, 0x4e, 0x84,
-	0x5d, 0xd1, 0x5d, 0x7e, 0xec, 0x5c, 0xa9, 0x22, 0x67, 0x2f, 0xd9, 0x3e, 0xb7, 0xf7, 0xb5, 0xde,
-	0xea, 0xb6, 0xe4, 0x25, 0x68, 0xe7, 0x7b, 0xa8, 0xab, 0xc4, 0x1b, 0xbe, 0xaf, 0xfa, 0xc5, 0xa2,
-	0x90, 0x78, 0xb0, 0x44, 0xfb, 0x3c, 0x96, 0x59, 0xb9, 0xe9, 0x41, 0x58, 0x1f, 0x77, 0x10, 0x37,
-	0x53, 0x2e, 0x9d, 0x44, 0x33, 0x75, 0xfe, 0x5a, 0x80, 0xfa, 0xab, 0x89, 0x3c, 0x2f, 0xd3, 0x15,
-	0x28, 0xc5, 0xe8, 0x23, 0x3b, 0x9a, 0x0a, 0x75, 0xea, 0x93, 0x07, 0xd0, 0xcc, 0x6c, 0x23, 0xd6,
-	0x9d, 0x4c, 0xab, 0x8d, 0x0c, 0xcf, 0xd4, 0x3a, 0x27, 0xc8, 0xfc, 0xc7, 0x09, 0x72, 0x26, 0xbd,
-	0xc2, 0x7f, 0x93, 0x9e, 0x1a, 0x1d, 0x21, 0xbc, 0x88, 0x47, 0x3e, 0x6a, 0x75, 0x17, 0xdc, 0x92,
-	0x14, 0xe2, 0xa5, 0xf2, 0x55, 0x30, 0xa4, 0xc2, 0x1b, 0xb2, 0x11, 0x33, 0x1a, 0x2f, 0xb8, 0xa5,
-	0x90, 0x8a, 0xaf, 0x95, 0x9f, 0x05, 0xc7, 0x31, 0xf3, 0x31, 0x15, 0xac, 0x0a, 0xee, 0x2a, 0x9f,
-	0x10, 0x28, 0x68, 0x21, 0x97, 0x34, 0xae, 0xed, 0x0f, 0x91, 0xe1, 0x55, 0x1a, 0x87, 0x2b, 0x35,
-	0xfe, 0x09, 0xa8, 0xe4, 0xde, 0x44, 0x60, 0x60, 0xb7, 0xf4, 0xca, 0xc5, 0x90, 0x8a, 0x7d, 0x81,
-	0x01, 0xf9, 0x16, 0x96, 0xf0, 0xe0, 0x00, 0x7d, 0xc9, 0x8e, 0xd0, 0x9b, 0x95, 0x7c, 0x4b, 0x37,
-	0xce, 0x49, 0x1b, 0x77, 0xef, 0x03, 0x1a, 0xb7, 0xa3, 0x94, 0x32, 0xa5, 0x7a, 0x9a, 0xfd, 0x57,
-	0xe7, 0x22, 0xbf, 0xe9, 0xd7, 0xb2, 0xae, 0x62, 0x6e, 0xbd, 0x69, 0xdc, 0x6d, 0x00, 0xd5, 0xf2,
-	0xf1, 0xa4, 0x7f, 0x88, 0xc7, 0x7a, 0x56, 0xca, 0xae, 0x3a, 0x84, 0x5d, 0x0d, 0x5c, 0x31, 0x56,
-	0xd5, 0xff, 0x7b, 0xac, 0x7e, 0xb3, 0xa0, 0x68, 0x4c, 0xb2, 0x01, 0xc5, 0x34, 0x8b, 0xa5, 0xb3,
-	0x3c, 0xb8, 0x26, 0xcb, 0x96, 0x2f, 0x93, 0x94, 0x3b, 0xdd, 0x48, 0xee, 0x42, 0xdd, 0x58, 0xde,
-	0x08, 0x85, 0xa0, 0x21, 0xea, 0x01, 0x28, 0xbb, 0x35, 0x83, 0xbe, 0x30, 0x20, 0x79, 0x04, 0xad,
-	0x21, 0x15, 0x72, 0x7f, 0x1c, 0x50, 0x89, 0x9e, 0x64, 0x23, 0x14, 0x92, 0x8e, 0xc6, 0x7a, 0x12,
-	0xf2, 0xee, 0xd2, 0x2c, 0xb6, 0x97, 0x85, 0x48, 0x17, 0x1a, 0x4c, 0x6c, 0xa8, 0x11, 0x75, 0xf1,
-	0x60, 0x12, 0x05, 0x18, 0x68, 0xd9, 0x97, 0xdc, 0x8b, 0x70, 0xe7, 0xd7, 0x3c, 0x54, 0xb7, 0x54,
-	0x95, 0x7a, 0xd8, 0xf6, 0x12, 0x62, 0xc3, 0xa2, 0x1f, 0x23, 0x95, 0x3c, 0x1b, 0xd9, 0xcc, 0x55,
-	0x77, 0xba, 0xd1, 0xa1, 0xa9, 0xd2, 0x38, 0xe4, 0x3b, 0x28, 0xeb, 0xfb, 0xe4, 0x00, 0x51, 0x98,
-	0xdb, 0x7e, 0x73, 0xeb, 0x5f, 0xce, 0xd6, 0xdf, 0x27, 0x77, 0x9a, 0xc7, 0x74, 0x34, 0xfc, 0xaa,
-	0x33, 0x65, 0xea, 0xb8, 0x25, 0x65, 0x6f, 0x23, 0x0a, 0x72, 0x1f, 0x1a, 0x31, 0x0e, 0xe9, 0x31,
-	0x06, 0xd3, 0x3e, 0xe9, 0xe7, 0xc3, 0xad, 0xa7, 0x70, 0xd6, 0xa8, 0x6d, 0xa8, 0xf8, 0xbe, 0x4c,
-	0xb2, 0xd3, 0x57, 0xa3, 0x54, 0xb9, 0xfc, 0xa6, 0x38, 0x77, 0x2e, 0xe9, 0x99, 0x80, 0x3f, 0x3d,
-	0x1f, 0xf2, 0x1a, 0xea, 0xcc, 0x3c, 0xb7, 0xde, 0x58, 0x5f, 0x64, 0x7a, 0xf2, 0x2a, 0xeb, 0x5f,
-	0x5c, 0x43, 0x35, 0xf7, 0x46, 0xbb, 0x35, 0x36, 0xf7, 0x64, 0x7f, 0x03, 0x0d, 0x9e, 0xde, 0x8e,
-	0x19, 0x2b, 0xac, 0xe6, 0xbb, 0x95, 0xf5, 0xb5, 0x6b, 0x58, 0xe7, 0xef, 0x54, 0xb7, 0xce, 0xe7,
-	0xfc, 0x87, 0x3f, 0x00, 0xcc, 0xa4, 0x45, 0x08, 0xd4, 0x77, 0x31, 0x0a, 0x58, 0x14, 0xa6, 0xc5,
-	0x34, 0x73, 0x64, 0x09, 0x1a, 0x29, 0x96, 0x51, 0x35, 0x2d, 0x72, 0x13, 0x6a, 0x99, 0xf7, 0x82,
-	0x45, 0x18, 0x34, 0xf3, 0x0a, 0x4a, 0xd7, 0xb9, 0x78, 0x84, 0xb1, 0x6c, 0x16, 0x48, 0x15, 0x4a,
-	0xc6, 0xc6, 0xa0, 0xb9, 0x40, 0x2a, 0xb0, 0xb8, 0x61, 0xae, 0xfd, 0x66, 0x71, 0xa5, 0xf0, 0xf3,
-	0x4f, 0x6d, 0xeb, 0xe1, 0x73, 0x68, 0x5d, 0x36, 0x3e, 0xa4, 0x09, 0xd5, 0x97, 0x5c, 0x6e, 0x67,
-	0x8f, 0x60, 0x33, 0x47, 0x6a, 0x50, 0x9e, 0xb9, 0x96, 0x62, 0x7e, 0x92, 0xa0, 0x3f, 0x51, 0x64,
-	0x37, 0x0c, 0xd9, 0xe6, 0xf3, 0x77, 0xa7, 0x6d, 0xeb, 0xfd, 0x69, 0xdb, 0xfa, 0xf3, 0xb4, 0x6d,
-	0xfd, 0x78, 0xd6, 0xce, 0xbd, 0x3f, 0x6b, 0xe7, 0x7e, 0x3f, 0x6b, 0xe7, 0xde, 0x3c, 0x3a, 0xa7,
-	0x24, 0xd5, 0xa3, 0xb5, 0x0b, 0x5f, 0x4d, 0xc9, 0xf9, 0x0f, 0x34, 0x2d, 0xac, 0x7e, 0x51, 0x7f,
-	0x3b, 0x3d, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x77, 0x35, 0xca, 0x79, 0xce, 0x09, 0x00, 0x00,
+	// 1087 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0x1b, 0x37,
+	0x10, 0xd6, 0x46, 0xb2, 0x2c, 0x8d, 0x7e, 0x43, 0x2b, 0xc6, 0xd6, 0x45, 0x14, 0x57, 0x85, 0x1d,
+	0x25, 0xa8, 0x25, 0x44, 0xb9, 0x14, 0xbd, 0xd9, 0x46, 0x9c, 0x18, 0x69, 0x12, 0x63, 0x63, 0xf7,
+	0x90, 0x43, 0xb7, 0xd4, 0xee, 0x78, 0x45, 0x58, 0x5a, 0xaa, 0x4b, 0xca, 0x58, 0x07, 0x7d, 0x88,
+	0x3e, 0x44, 0x0f, 0x7d, 0x8a, 0x9e, 0x73, 0x6b, 0x6e, 0x2d, 0x7a, 0x30, 0x5a, 0xfb, 0x0d, 0xfa,
+	0x04, 0x05, 0xc9, 0x5d, 0xc9, 0x32, 0x0c, 0x3b, 0x4d, 0x7b, 0xd2, 0xcc, 0x37, 0xe4, 0x47, 0xee,
+	0xf0, 0xfb, 0x48, 0x41, 0xef, 0x2d, 0x4a, 0xea, 0x0d, 0x28, 0x0b, 0xbb, 0x3a, 0xe2, 0x11, 0x76,
+	0xbd, 0x88, 0x0b, 0x61, 0x30, 0x1d, 0xba, 0x3a, 0x76, 0x65, 0xdc, 0x19, 0x47, 0x5c, 0x72, 0x72,
+	0x77, 0x3a, 0xa7, 0x93, 0xce, 0xe9, 0xcc, 0xe6, 0xac, 0x34, 0x02, 0x1e, 0x70, 0x3d, 0xb2, 0xab,
+	0x22, 0x33, 0x69, 0x65, 0xfd, 0x8a, 0x85, 0xc6, 0x47, 0x41, 0xd7, 0xe3, 0x6a, 0x19, 0xce, 0x42,
+	0x33, 0xae, 0xf5, 0x5b, 0x0e, 0x2a, 0xbb, 0x61, 0x9f, 0x4f, 0x42, 0x7f, 0x8f, 0x46, 0x74, 0x24,
+	0xc8, 0x32, 0xe4, 0x05, 0x86, 0x3e, 0x46, 0xb6, 0xb5, 0x6a, 0xb5, 0x8b, 0x4e, 0x92, 0x91, 0x75,
+	0xa8, 0x99, 0x28, 0xd9, 0x1f, 0xf3, 0xed, 0x5b, 0xab, 0x56, 0x3b, 0xeb, 0x54, 0x0c, 0xbc, 0xad,
+	0xd0, 0x5d, 0x9f, 0x7c, 0x0a, 0x45, 0x19, 0xbb, 0x3c, 0x62, 0x01, 0x0b, 0xed, 0xac, 0xa6, 0x28,
+	0xc8, 0xf8, 0x95, 0xce, 0xc9, 0x16, 0x14, 0xd5, 0xe2, 0xae, 0x3c, 0x19, 0xa3, 0x9d, 0x5b, 0xb5,
+	0xda, 0xd5, 0xde, 0x5a, 0xe7, 0x8a, 0xef, 0x1b, 0x1f, 0x05, 0x1d, 0xbd, 0xcb, 0x6d, 0xce, 0xc2,
+	0xfd, 0x93, 0x31, 0x3a, 0x05, 0x2f, 0x89, 0x48, 0x03, 0x16, 0xa8, 0x10, 0x28, 0xed, 0x05, 0x4d,
+	0x6e, 0x12, 0xf2, 0x14, 0xf2, 0x74, 0xc4, 0x27, 0xa1, 0xb4, 0xf3, 0x0a, 0xde, 0xea, 0xbe, 0x3b,
+	0xbd, 0x97, 0xf9, 0xe3, 0xf4, 0xde, 0xfd, 0x80, 0xc9, 0xc1, 0xa4, 0xdf, 0xf1, 0xf8, 0xa8, 0xeb,
+	0x71, 0x31, 0xe2, 0x22, 0xf9, 0xd9, 0x10, 0xfe, 0x51, 0x57, 0xed, 0x43, 0x74, 0x0e, 0x58, 0x28,
+	0x9d, 0x64, 0x3a, 0xf9, 0x1c, 0x2a, 0xbc, 0x2f, 0x30, 0x3a, 0x46, 0xdf, 0x1d, 0x50, 0x31, 0xb0,
+	0x17, 0xf5, 0x32, 0xe5, 0x14, 0x7c, 0x46, 0xc5, 0x80, 0x7c, 0x09, 0xf6, 0x74, 0x10, 0xc6, 0x12,
+	0xa3, 0x90, 0x0e, 0xdd, 0x01, 0xb2, 0x60, 0x20, 0xed, 0xc2, 0xaa, 0xd5, 0xce, 0x39, 0xcb, 0x69,
+	0xfd, 0x49, 0x52, 0x7e, 0xa6, 0xab, 0xe4, 0x33, 0x28, 0xf7, 0xe9, 0x70, 0xc8, 0xa5, 0xcb, 0x42,
+	0x1f, 0x63, 0xbb, 0xa8, 0xd9, 0x4b, 0x06, 0xdb, 0x55, 0x10, 0xe9, 0xc1, 0x9d, 0x43, 0x16, 0xd2,
+	0x21, 0x7b, 0x8b, 0xbe, 0xab, 0x5a, 0x92, 0x32, 0x83, 0x66, 0x5e, 0x9a, 0x16, 0xdf, 0xa0, 0xa4,
+	0x09, 0x2d, 0x83, 0x65, 0x19, 0xbb, 0x49, 0x85, 0x4a, 0xc6, 0x43, 0x57, 0x48, 0x2a, 0x27, 0xc2,
+	0x2e, 0xe9, 0x2e, 0x3f, 0xee, 0x5c, 0xab, 0xa2, 0xce, 0x7e, 0xbc, 0x73, 0x61, 0xee, 0x6b, 0x3d,
+	0xd5, 0x69, 0xc8, 0x2b, 0x50, 0xb2, 0x06, 0xd5, 0x08, 0x8f, 0x31, 0x92, 0x2e, 0xf5, 0xfd, 0x08,
+	0x85, 0xb0, 0xcb, 0xfa, 0x1b, 0x2a, 0x06, 0xdd, 0x34, 0x60, 0xeb, 0x7b, 0xa8, 0xaa, 0xfd, 0x6d,
+	0x7a, 0x9e, 0x6a, 0x2b, 0x0b, 0x03, 0xe2, 0xc2, 0x12, 0xed, 0xf3, 0x48, 0xa6, 0x5f, 0x95, 0x9c,
+	0x97, 0xf5, 0x71, 0xe7, 0x75, 0x3b, 0xe1, 0xd2, 0x8b, 0x68, 0xa6, 0xd6, 0x5f, 0x0b, 0x50, 0x7d,
+	0x35, 0x91, 0x17, 0xd5, 0xbc, 0x02, 0x85, 0x08, 0x3d, 0x64, 0xc7, 0x53, 0x3d, 0x4f, 0x73, 0xf2,
+	0x00, 0xea, 0x69, 0x6c, 0x34, 0xbd, 0x9b, 0x4a, 0xba, 0x96, 0xe2, 0xa9, 0xa8, 0xe7, 0x74, 0x9b,
+	0xfd, 0x38, 0xdd, 0xce, 0x14, 0x9a, 0xfb, 0x6f, 0x0a, 0x55, 0x0e, 0x13, 0xc2, 0x0d, 0x79, 0xe8,
+	0xa1, 0x36, 0x41, 0xce, 0x29, 0x48, 0x21, 0x5e, 0xaa, 0x5c, 0x15, 0x03, 0x2a, 0xdc, 0x21, 0x1b,
+	0x31, 0x63, 0x85, 0x9c, 0x53, 0x08, 0xa8, 0xf8, 0x5a, 0xe5, 0x69, 0x71, 0x1c, 0x31, 0x0f, 0x13,
+	0x5d, 0xab, 0xe2, 0x9e, 0xca, 0x09, 0x81, 0x9c, 0xd6, 0x7b, 0x41, 0xe3, 0x3a, 0xfe, 0x10, 0xb5,
+	0x5e, 0x67, 0x05, 0xb8, 0xd6, 0x0a, 0x9f, 0x80, 0x5a, 0xdc, 0x9d, 0x08, 0xf4, 0xed, 0x86, 0x1e,
+	0xb9, 0x18, 0x50, 0x71, 0x20, 0xd0, 0x27, 0xdf, 0xc2, 0x12, 0x1e, 0x1e, 0xa2, 0x27, 0xd9, 0x31,
+	0xba, 0xb3, 0x2d, 0xdf, 0xd1, 0x8d, 0xeb, 0x24, 0x8d, 0x5b, 0xff, 0x80, 0xc6, 0xed, 0x2a, 0xa5,
+	0x4c, 0xa9, 0x9e, 0xa6, 0xdf, 0xda, 0xb9, 0xcc, 0x6f, 0xfa, 0xb5, 0xac, 0x77, 0x31, 0x37, 0xde,
+	0x34, 0xee, 0x2e, 0x80, 0x6a, 0xf9, 0x78, 0xd2, 0x3f, 0xc2, 0x13, 0x6d, 0xa9, 0xa2, 0xa3, 0x0e,
+	0x61, 0x4f, 0x03, 0xd7, 0xb8, 0xaf, 0xfc, 0x3f, 0xbb, 0xaf, 0xf5, 0xab, 0x05, 0x79, 0x13, 0x92,
+	0x4d, 0xc8, 0x27, 0xab, 0x58, 0x7a, 0x95, 0x07, 0x37, 0xac, 0xb2, 0xed, 0xc9, 0x38, 0xe1, 0xce,
+	0x8b, 0xa9, 0x97, 0x4d, 0xe4, 0x8e, 0x50, 0x08, 0x1a, 0xa0, 0x36, 0x40, 0xd1, 0xa9, 0x18, 0xf4,
+	0x85, 0x01, 0xc9, 0x23, 0x68, 0x0c, 0xa9, 0x90, 0x07, 0x63, 0x9f, 0x4a, 0x74, 0x25, 0x1b, 0xa1,
+	0x90, 0x74, 0x34, 0xd6, 0x4e, 0xc8, 0x3a, 0x4b, 0xb3, 0xda, 0x7e, 0x5a, 0x22, 0x6d, 0xa8, 0x31,
+	0xb1, 0xa9, 0x2c, 0xea, 0xe0, 0xe1, 0x24, 0xf4, 0xd1, 0xd7, 0xb2, 0x2f, 0x38, 0x97, 0xe1, 0xd6,
+	0x2f, 0x59, 0x28, 0x6f, 0xab, 0x5d, 0x6a, 0xb3, 0xed, 0xc7, 0xc4, 0x86, 0x45, 0x2f, 0x42, 0x2a,
+	0x79, 0x6a, 0xd9, 0x34, 0x55, 0x57, 0xbf, 0xd1, 0xa1, 0xd9, 0xa5, 0x49, 0xc8, 0x77, 0x50, 0xd4,
+	0xf7, 0xc9, 0x21, 0xa2, 0x30, 0x8f, 0xc2, 0xd6, 0xf6, 0xbf, 0xf4, 0xd6, 0xdf, 0xa7, 0xf7, 0xea,
+	0x27, 0x74, 0x34, 0xfc, 0xaa, 0x35, 0x65, 0x6a, 0x39, 0x05, 0x15, 0xef, 0x20, 0x0a, 0x72, 0x1f,
+	0x6a, 0x11, 0x0e, 0xe9, 0x09, 0xfa, 0xd3, 0x3e, 0xe9, 0x57, 0xc6, 0xa9, 0x26, 0x70, 0xda, 0xa8,
+	0x1d, 0x28, 0x79, 0x9e, 0x8c, 0xd3, 0xd3, 0x57, 0x56, 0x2a, 0xf5, 0xd6, 0x6e, 0x38, 0x97, 0xe4,
+	0x4c, 0xc0, 0x9b, 0x9e, 0x0f, 0x79, 0x0d, 0x55, 0x66, 0x5e, 0x65, 0x77, 0xac, 0x2f, 0x32, 0xed,
+	0xbc, 0x52, 0xef, 0x8b, 0x1b, 0xa8, 0xe6, 0x9e, 0x72, 0xa7, 0xc2, 0x2e, 0xa6, 0xe4, 0x1b, 0xa8,
+	0xf1, 0x89, 0x9c, 0x63, 0x85, 0xd5, 0x6c, 0xbb, 0xd4, 0xdb, 0xb8, 0x81, 0x75, 0xfe, 0x4e, 0x75,
+	0xaa, 0x7c, 0x2e, 0x7f, 0xf8, 0x03, 0xc0, 0x4c, 0x5a, 0x84, 0x40, 0x75, 0x0f, 0x43, 0x9f, 0x85,
+	0x41, 0xb2, 0x99, 0x7a, 0x86, 0x2c, 0x41, 0x2d, 0xc1, 0x52, 0xaa, 0xba, 0x45, 0x6e, 0x43, 0x25,
+	0xcd, 0x5e, 0xb0, 0x10, 0xfd, 0x7a, 0x56, 0x41, 0xc9, 0x38, 0x47, 0xbf, 0x25, 0xf5, 0x1c, 0x29,
+	0x43, 0xc1, 0xc4, 0xe8, 0xd7, 0x17, 0x48, 0x09, 0x16, 0x37, 0xcd, 0xb5, 0x5f, 0xcf, 0xaf, 0xe4,
+	0x7e, 0xfe, 0xa9, 0x69, 0x3d, 0x7c, 0x0e, 0x8d, 0xab, 0xec, 0x43, 0xea, 0x50, 0x7e, 0xc9, 0xe5,
+	0x4e, 0xfa, 0x56, 0xd6, 0x33, 0xa4, 0x02, 0xc5, 0x59, 0x6a, 0x29, 0xe6, 0x27, 0x31, 0x7a, 0x13,
+	0x45, 0x76, 0xcb, 0x90, 0x6d, 0x3d, 0x7f, 0x77, 0xd6, 0xb4, 0xde, 0x9f, 0x35, 0xad, 0x3f, 0xcf,
+	0x9a, 0xd6, 0x8f, 0xe7, 0xcd, 0xcc, 0xfb, 0xf3, 0x66, 0xe6, 0xf7, 0xf3, 0x66, 0xe6, 0xcd, 0xa3,
+	0x0b, 0x4a, 0x52, 0x3d, 0xda, 0xb8, 0xf4, 0xe7, 0x2a, 0xbe, 0xf8, 0x3f, 0x4e, 0x0b, 0xab, 0x9f,
+	0xd7, 0x7f, 0xb1, 0x1e, 0xff, 0x33, 0x00, 0x45, 0xbc, 0x78, 0x8f, 0xf5, 0x09, 0x00, 0x00,
 }
 
 func (m *InboundParams) Marshal() (dAtA []byte, err error) {
@@ -649,6 +660,13 @@ func (m *InboundParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RevertAddress) > 0 {
+		i -= len(m.RevertAddress)
+		copy(dAtA[i:], m.RevertAddress)
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(len(m.RevertAddress)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.TxFinalizationStatus != 0 {
 		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.TxFinalizationStatus))
 		i--
@@ -1075,6 +1093,10 @@ func (m *InboundParams) Size() (n int) {
 	if m.TxFinalizationStatus != 0 {
 		n += 1 + sovCrossChainTx(uint64(m.TxFinalizationStatus))
 	}
+	l = len(m.RevertAddress)
+	if l > 0 {
+		n += 1 + l + sovCrossChainTx(uint64(l))
+	}
 	return n
 }
 
@@ -1529,6 +1551,38 @@ func (m *InboundParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevertAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrossChainTx(dAtA[iNdEx:])
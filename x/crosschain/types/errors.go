@@ -48,4 +48,7 @@ var (
 	ErrUnableToDecodeMessageString   = errorsmod.Register(ModuleName, 1151, "unable to decode message string")
 	ErrInvalidRateLimiterFlags       = errorsmod.Register(ModuleName, 1152, "invalid rate limiter flags")
 	ErrMaxTxOutTrackerHashesReached  = errorsmod.Register(ModuleName, 1153, "max tx out tracker hashes reached")
+	ErrIBCOutboundFromZEVMOnly       = errorsmod.Register(ModuleName, 1154, "IBC outbounds must come from zEVM")
+	ErrIBCOutboundNotPending         = errorsmod.Register(ModuleName, 1155, "IBC outbound is not pending")
+	ErrInvalidCctxRetentionPolicy    = errorsmod.Register(ModuleName, 1156, "invalid cctx retention policy")
 )
@@ -619,6 +619,60 @@ func (m *EventERC20Whitelist) GetZrc20Address() string {
 	return ""
 }
 
+// EventCCTXArchived is emitted with the full cctx when it is pruned from the
+// state by the retention policy
+type EventCCTXArchived struct {
+	CctxIndex string        `protobuf:"bytes,1,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+	Cctx      *CrossChainTx `protobuf:"bytes,2,opt,name=cctx,proto3" json:"cctx,omitempty"`
+}
+
+func (m *EventCCTXArchived) Reset()         { *m = EventCCTXArchived{} }
+func (m *EventCCTXArchived) String() string { return proto.CompactTextString(m) }
+func (*EventCCTXArchived) ProtoMessage()    {}
+func (*EventCCTXArchived) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd08b628129fa2e1, []int{7}
+}
+func (m *EventCCTXArchived) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCCTXArchived) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCCTXArchived.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCCTXArchived) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCCTXArchived.Merge(m, src)
+}
+func (m *EventCCTXArchived) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCCTXArchived) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCCTXArchived.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCCTXArchived proto.InternalMessageInfo
+
+func (m *EventCCTXArchived) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return ""
+}
+
+func (m *EventCCTXArchived) GetCctx() *CrossChainTx {
+	if m != nil {
+		return m.Cctx
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventInboundFinalized)(nil), "zetachain.zetacore.crosschain.EventInboundFinalized")
 	proto.RegisterType((*EventZrcWithdrawCreated)(nil), "zetachain.zetacore.crosschain.EventZrcWithdrawCreated")
@@ -627,6 +681,7 @@ func init() {
 	proto.RegisterType((*EventOutboundSuccess)(nil), "zetachain.zetacore.crosschain.EventOutboundSuccess")
 	proto.RegisterType((*EventCCTXGasPriceIncreased)(nil), "zetachain.zetacore.crosschain.EventCCTXGasPriceIncreased")
 	proto.RegisterType((*EventERC20Whitelist)(nil), "zetachain.zetacore.crosschain.EventERC20Whitelist")
+	proto.RegisterType((*EventCCTXArchived)(nil), "zetachain.zetacore.crosschain.EventCCTXArchived")
 }
 
 func init() {
@@ -634,50 +689,53 @@ func init() {
 }
 
 var fileDescriptor_dd08b628129fa2e1 = []byte{
-	// 686 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0xd1, 0x4e, 0x13, 0x4f,
-	0x14, 0xc6, 0x59, 0x68, 0x4b, 0x3b, 0xb4, 0xfd, 0xff, 0x33, 0x56, 0x5d, 0x49, 0x68, 0xa0, 0xc6,
-	0x68, 0x8c, 0x16, 0xc4, 0x27, 0x90, 0x06, 0x84, 0x18, 0x83, 0x01, 0x0c, 0x86, 0x9b, 0xc9, 0x74,
-	0xe7, 0xb8, 0x3b, 0x71, 0xbb, 0xd3, 0xcc, 0xcc, 0xd2, 0xc2, 0x53, 0x18, 0x1f, 0xc5, 0xc4, 0x3b,
-	0x1f, 0xc0, 0x4b, 0x2e, 0xbd, 0x34, 0xf4, 0x45, 0xcc, 0xcc, 0xec, 0x16, 0xda, 0x1a, 0xbd, 0x30,
-	0x9a, 0x78, 0xb7, 0xe7, 0x3b, 0x67, 0xa6, 0xbf, 0xf9, 0xbe, 0xed, 0x0e, 0x7a, 0x78, 0x0e, 0x9a,
-	0x06, 0x11, 0xe5, 0xc9, 0xba, 0x7d, 0x12, 0x12, 0xd6, 0x03, 0x29, 0x94, 0x72, 0x1a, 0x9c, 0x42,
-	0xa2, 0x55, 0xbb, 0x2f, 0x85, 0x16, 0x78, 0x65, 0x3c, 0xdb, 0xce, 0x67, 0xdb, 0x57, 0xb3, 0xcb,
-	0x8d, 0x50, 0x84, 0xc2, 0x4e, 0xae, 0x9b, 0x27, 0xb7, 0xa8, 0x35, 0x5a, 0x40, 0x37, 0xb7, 0xcd,
-	0x2e, 0x7b, 0x49, 0x57, 0xa4, 0x09, 0xdb, 0xe1, 0x09, 0x8d, 0xf9, 0x39, 0x30, 0xbc, 0x8a, 0xaa,
-	0x3d, 0x15, 0x12, 0x7d, 0xd6, 0x07, 0x92, 0xca, 0xd8, 0xf7, 0x56, 0xbd, 0x07, 0x95, 0x03, 0xd4,
-	0x53, 0xe1, 0xd1, 0x59, 0x1f, 0x5e, 0xcb, 0x18, 0xaf, 0x20, 0x14, 0x04, 0x7a, 0x48, 0x78, 0xc2,
-	0x60, 0xe8, 0xcf, 0xdb, 0x7e, 0xc5, 0x28, 0x7b, 0x46, 0xc0, 0xb7, 0x50, 0x49, 0x41, 0xc2, 0x40,
-	0xfa, 0x0b, 0xb6, 0x95, 0x55, 0xf8, 0x0e, 0x2a, 0xeb, 0x21, 0x11, 0x32, 0xe4, 0x89, 0x5f, 0xb0,
-	0x9d, 0x45, 0x3d, 0xdc, 0x37, 0x25, 0x6e, 0xa0, 0x22, 0x55, 0x0a, 0xb4, 0x5f, 0xb4, 0xba, 0x2b,
-	0xf0, 0x1a, 0xaa, 0x72, 0x47, 0x47, 0x22, 0xaa, 0x22, 0xbf, 0x64, 0x9b, 0x4b, 0x99, 0xb6, 0x4b,
-	0x55, 0x84, 0x37, 0x50, 0x23, 0x1f, 0xe9, 0xc6, 0x22, 0x78, 0x47, 0x22, 0xe0, 0x61, 0xa4, 0xfd,
-	0x45, 0x3b, 0x8a, 0xb3, 0xde, 0x96, 0x69, 0xed, 0xda, 0x0e, 0x5e, 0x46, 0x65, 0x09, 0x01, 0xf0,
-	0x53, 0x90, 0x7e, 0xd9, 0x4e, 0x8d, 0x6b, 0x7c, 0x0f, 0xd5, 0xf3, 0x67, 0x62, 0xcd, 0xf3, 0x2b,
-	0x76, 0xa2, 0x96, 0xab, 0x1d, 0x23, 0x9a, 0x03, 0xd2, 0x9e, 0x48, 0x13, 0xed, 0x23, 0x77, 0x40,
-	0x57, 0xe1, 0xfb, 0xe8, 0x3f, 0x09, 0x31, 0x3d, 0x03, 0x46, 0x7a, 0xa0, 0x14, 0x0d, 0xc1, 0x5f,
-	0xb2, 0x03, 0xf5, 0x4c, 0x7e, 0xe9, 0x54, 0x63, 0x60, 0x02, 0x03, 0xa2, 0x34, 0xd5, 0xa9, 0xf2,
-	0xab, 0xce, 0xc0, 0x04, 0x06, 0x87, 0x56, 0x30, 0x18, 0xae, 0x35, 0xde, 0xa6, 0xe6, 0x30, 0x9c,
-	0x9a, 0xef, 0xb2, 0x86, 0xaa, 0xce, 0xd9, 0x8c, 0xb5, 0xee, 0xec, 0x71, 0x9a, 0x25, 0x6d, 0x7d,
-	0x9c, 0x47, 0xb7, 0x6d, 0xca, 0x27, 0x32, 0x38, 0xe6, 0x3a, 0x62, 0x92, 0x0e, 0x3a, 0x12, 0xa8,
-	0xfe, 0x93, 0x39, 0x4f, 0x73, 0x15, 0x66, 0xb8, 0x66, 0x92, 0x2d, 0xce, 0x26, 0x7b, 0x3d, 0xa7,
-	0xd2, 0x2f, 0x73, 0x5a, 0xfc, 0x79, 0x4e, 0xe5, 0x89, 0x9c, 0x26, 0xed, 0xaf, 0x4c, 0xd9, 0xdf,
-	0xfa, 0xe4, 0x21, 0xdf, 0x99, 0x06, 0x9a, 0xfe, 0x4d, 0xd7, 0x26, 0x2c, 0x29, 0xcc, 0x5a, 0x32,
-	0xc9, 0x5d, 0x9c, 0xe6, 0xfe, 0xec, 0xa1, 0x86, 0xe5, 0xde, 0x4f, 0xb5, 0xfb, 0x4f, 0x53, 0x1e,
-	0xa7, 0x12, 0x7e, 0x9f, 0x79, 0x05, 0x21, 0x11, 0xb3, 0xfc, 0x87, 0x1d, 0x77, 0x45, 0xc4, 0x2c,
-	0x7b, 0x5f, 0x27, 0xb9, 0x0a, 0x3f, 0x78, 0x9d, 0x4f, 0x69, 0x9c, 0x02, 0xc9, 0xd2, 0x61, 0x19,
-	0x7a, 0xcd, 0xaa, 0x07, 0x99, 0x38, 0x8b, 0x7f, 0x98, 0x06, 0x01, 0x28, 0xf5, 0x8f, 0xe0, 0x7f,
-	0xf0, 0xd0, 0xb2, 0xc5, 0xef, 0x74, 0x8e, 0xde, 0x3c, 0xa7, 0xea, 0x95, 0xe4, 0x01, 0xec, 0x25,
-	0x81, 0x04, 0xaa, 0x80, 0x4d, 0x21, 0x7a, 0xd3, 0x88, 0x8f, 0x10, 0x0e, 0xa9, 0x22, 0x7d, 0xb3,
-	0x88, 0xf0, 0x6c, 0x55, 0x76, 0x92, 0xff, 0xc3, 0xa9, 0xdd, 0xcc, 0x87, 0x86, 0x32, 0xc6, 0x35,
-	0x17, 0x09, 0x8d, 0xc9, 0x5b, 0x80, 0xfc, 0x54, 0xf5, 0x2b, 0x79, 0x07, 0x40, 0xb5, 0x62, 0x74,
-	0xc3, 0x32, 0x6d, 0x1f, 0x74, 0x36, 0x37, 0x8e, 0x23, 0xae, 0x21, 0xe6, 0x4a, 0x9b, 0xaf, 0xe6,
-	0x20, 0x2f, 0xc8, 0x0c, 0x16, 0x1e, 0xf7, 0x3a, 0x63, 0xbe, 0xbb, 0xa8, 0x76, 0x2e, 0x83, 0xcd,
-	0x0d, 0x42, 0x19, 0x93, 0xa0, 0x54, 0x86, 0x56, 0xb5, 0xe2, 0x33, 0xa7, 0x6d, 0xbd, 0xf8, 0x72,
-	0xd9, 0xf4, 0x2e, 0x2e, 0x9b, 0xde, 0xb7, 0xcb, 0xa6, 0xf7, 0x7e, 0xd4, 0x9c, 0xbb, 0x18, 0x35,
-	0xe7, 0xbe, 0x8e, 0x9a, 0x73, 0x27, 0x4f, 0x42, 0xae, 0xa3, 0xb4, 0xdb, 0x0e, 0x44, 0xcf, 0xde,
-	0x67, 0x8f, 0xa7, 0xae, 0xb6, 0xe1, 0xf5, 0xcb, 0xcd, 0x04, 0xad, 0xba, 0x25, 0x7b, 0x4f, 0x3d,
-	0xfd, 0x1e, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x44, 0xd3, 0x7e, 0x0a, 0x07, 0x00, 0x00,
+	// 735 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0xcf, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc6, 0xeb, 0x36, 0x49, 0x93, 0x69, 0x92, 0x7b, 0xaf, 0x6f, 0xee, 0xc5, 0x44, 0x6a, 0xd4,
+	0x06, 0x21, 0x10, 0x7f, 0xd2, 0x12, 0x1e, 0x00, 0xb5, 0x51, 0x4b, 0x2b, 0x84, 0x8a, 0xd2, 0xa2,
+	0xa2, 0x6e, 0x46, 0x13, 0xfb, 0x60, 0x8f, 0x70, 0x3c, 0xd1, 0xcc, 0x38, 0x49, 0xfb, 0x14, 0x88,
+	0x47, 0x41, 0x62, 0xc7, 0x03, 0xb0, 0xec, 0x92, 0x25, 0x6a, 0x5e, 0x04, 0xcd, 0x8c, 0x9d, 0x36,
+	0x0e, 0x6a, 0x17, 0x08, 0x24, 0x76, 0xe7, 0x7c, 0xe7, 0xcc, 0xf8, 0x37, 0xdf, 0xb1, 0x3d, 0xe8,
+	0xc1, 0x19, 0x48, 0xe2, 0x06, 0x84, 0x46, 0x1b, 0x3a, 0x62, 0x1c, 0x36, 0x5c, 0xce, 0x84, 0x30,
+	0x1a, 0x0c, 0x21, 0x92, 0xa2, 0x35, 0xe0, 0x4c, 0x32, 0x7b, 0x75, 0xda, 0xdb, 0x4a, 0x7b, 0x5b,
+	0x97, 0xbd, 0xf5, 0x9a, 0xcf, 0x7c, 0xa6, 0x3b, 0x37, 0x54, 0x64, 0x16, 0xd5, 0xdb, 0xd7, 0x3f,
+	0x40, 0x87, 0x58, 0xc7, 0x58, 0x8e, 0xcd, 0x9a, 0xe6, 0x64, 0x09, 0xfd, 0xb7, 0xa3, 0x9e, 0xbc,
+	0x1f, 0xf5, 0x58, 0x1c, 0x79, 0xbb, 0x34, 0x22, 0x21, 0x3d, 0x03, 0xcf, 0x5e, 0x43, 0xe5, 0xbe,
+	0xf0, 0xb1, 0x3c, 0x1d, 0x00, 0x8e, 0x79, 0xe8, 0x58, 0x6b, 0xd6, 0xfd, 0x52, 0x17, 0xf5, 0x85,
+	0x7f, 0x74, 0x3a, 0x80, 0xd7, 0x3c, 0xb4, 0x57, 0x11, 0x72, 0x5d, 0x39, 0xc6, 0x34, 0xf2, 0x60,
+	0xec, 0x2c, 0xea, 0x7a, 0x49, 0x29, 0xfb, 0x4a, 0xb0, 0xff, 0x47, 0x05, 0x01, 0x91, 0x07, 0xdc,
+	0x59, 0xd2, 0xa5, 0x24, 0xb3, 0x6f, 0xa3, 0xa2, 0x1c, 0x63, 0xc6, 0x7d, 0x1a, 0x39, 0x39, 0x5d,
+	0x59, 0x96, 0xe3, 0x03, 0x95, 0xda, 0x35, 0x94, 0x27, 0x42, 0x80, 0x74, 0xf2, 0x5a, 0x37, 0x89,
+	0xbd, 0x8e, 0xca, 0xd4, 0xd0, 0xe1, 0x80, 0x88, 0xc0, 0x29, 0xe8, 0xe2, 0x4a, 0xa2, 0xed, 0x11,
+	0x11, 0xd8, 0x9b, 0xa8, 0x96, 0xb6, 0xf4, 0x42, 0xe6, 0xbe, 0xc3, 0x01, 0x50, 0x3f, 0x90, 0xce,
+	0xb2, 0x6e, 0xb5, 0x93, 0xda, 0xb6, 0x2a, 0xed, 0xe9, 0x8a, 0x5d, 0x47, 0x45, 0x0e, 0x2e, 0xd0,
+	0x21, 0x70, 0xa7, 0xa8, 0xbb, 0xa6, 0xb9, 0x7d, 0x17, 0x55, 0xd3, 0xd8, 0xf8, 0xe5, 0x94, 0x74,
+	0x47, 0x25, 0x55, 0x3b, 0x4a, 0x54, 0x07, 0x24, 0x7d, 0x16, 0x47, 0xd2, 0x41, 0xe6, 0x80, 0x26,
+	0xb3, 0xef, 0xa1, 0xbf, 0x38, 0x84, 0xe4, 0x14, 0x3c, 0xdc, 0x07, 0x21, 0x88, 0x0f, 0xce, 0x8a,
+	0x6e, 0xa8, 0x26, 0xf2, 0x4b, 0xa3, 0x2a, 0x03, 0x23, 0x18, 0x61, 0x21, 0x89, 0x8c, 0x85, 0x53,
+	0x36, 0x06, 0x46, 0x30, 0x3a, 0xd4, 0x82, 0xc2, 0x30, 0xa5, 0xe9, 0x36, 0x15, 0x83, 0x61, 0xd4,
+	0x74, 0x97, 0x75, 0x54, 0x36, 0xce, 0x26, 0xac, 0x55, 0x63, 0x8f, 0xd1, 0x34, 0x69, 0xf3, 0xe3,
+	0x22, 0xba, 0xa5, 0xa7, 0x7c, 0xc2, 0xdd, 0x63, 0x2a, 0x03, 0x8f, 0x93, 0x51, 0x87, 0x03, 0x91,
+	0xbf, 0x72, 0xce, 0x59, 0xae, 0xdc, 0x1c, 0xd7, 0xdc, 0x64, 0xf3, 0xf3, 0x93, 0xbd, 0x3a, 0xa7,
+	0xc2, 0x8d, 0x73, 0x5a, 0xbe, 0x7e, 0x4e, 0xc5, 0x99, 0x39, 0xcd, 0xda, 0x5f, 0xca, 0xd8, 0xdf,
+	0xfc, 0x64, 0x21, 0xc7, 0x98, 0x06, 0x92, 0xfc, 0x4e, 0xd7, 0x66, 0x2c, 0xc9, 0xcd, 0x5b, 0x32,
+	0xcb, 0x9d, 0xcf, 0x72, 0x7f, 0xb6, 0x50, 0x4d, 0x73, 0x1f, 0xc4, 0xd2, 0x7c, 0xd3, 0x84, 0x86,
+	0x31, 0x87, 0x9f, 0x67, 0x5e, 0x45, 0x88, 0x85, 0x5e, 0xfa, 0x60, 0xc3, 0x5d, 0x62, 0xa1, 0x97,
+	0xbc, 0xaf, 0xb3, 0x5c, 0xb9, 0x1f, 0xbc, 0xce, 0x43, 0x12, 0xc6, 0x80, 0x93, 0xe9, 0x78, 0x09,
+	0x7a, 0x45, 0xab, 0xdd, 0x44, 0x9c, 0xc7, 0x3f, 0x8c, 0x5d, 0x17, 0x84, 0xf8, 0x43, 0xf0, 0x3f,
+	0x58, 0xa8, 0xae, 0xf1, 0x3b, 0x9d, 0xa3, 0x37, 0xcf, 0x89, 0x78, 0xc5, 0xa9, 0x0b, 0xfb, 0x91,
+	0xcb, 0x81, 0x08, 0xf0, 0x32, 0x88, 0x56, 0x16, 0xf1, 0x11, 0xb2, 0x7d, 0x22, 0xf0, 0x40, 0x2d,
+	0xc2, 0x34, 0x59, 0x95, 0x9c, 0xe4, 0x6f, 0x3f, 0xb3, 0x9b, 0xfa, 0xd1, 0x10, 0xcf, 0xa3, 0x92,
+	0xb2, 0x88, 0x84, 0xf8, 0x2d, 0x40, 0x7a, 0xaa, 0xea, 0xa5, 0xbc, 0x0b, 0x20, 0x9a, 0x21, 0xfa,
+	0x57, 0x33, 0xed, 0x74, 0x3b, 0xed, 0xcd, 0xe3, 0x80, 0x4a, 0x08, 0xa9, 0x90, 0xea, 0xaf, 0x39,
+	0x4a, 0x13, 0x3c, 0x87, 0x65, 0x4f, 0x6b, 0x9d, 0x29, 0xdf, 0x1d, 0x54, 0x39, 0xe3, 0x6e, 0x7b,
+	0x13, 0x13, 0xcf, 0xe3, 0x20, 0x44, 0x82, 0x56, 0xd6, 0xe2, 0x96, 0xd1, 0x9a, 0x02, 0xfd, 0x33,
+	0x75, 0x60, 0x8b, 0xbb, 0x01, 0x1d, 0xde, 0x7c, 0xf0, 0x67, 0x28, 0xa7, 0x12, 0xbd, 0xdf, 0x4a,
+	0xfb, 0x61, 0xeb, 0xda, 0xfb, 0xaf, 0xd5, 0x51, 0xa1, 0xfe, 0xb8, 0x8f, 0xc6, 0x5d, 0xbd, 0x70,
+	0xfb, 0xc5, 0x97, 0x8b, 0x86, 0x75, 0x7e, 0xd1, 0xb0, 0xbe, 0x5d, 0x34, 0xac, 0xf7, 0x93, 0xc6,
+	0xc2, 0xf9, 0xa4, 0xb1, 0xf0, 0x75, 0xd2, 0x58, 0x38, 0x79, 0xe2, 0x53, 0x19, 0xc4, 0xbd, 0x96,
+	0xcb, 0xfa, 0xfa, 0x5e, 0x7c, 0x9c, 0xb9, 0x22, 0xc7, 0x57, 0x2f, 0x49, 0xf5, 0x76, 0x89, 0x5e,
+	0x41, 0x5f, 0x8e, 0x4f, 0xbf, 0x0f, 0x00, 0x14, 0xdf, 0xfd, 0xed, 0xb3, 0x07, 0x00, 0x00,
 }
 
 func (m *EventInboundFinalized) Marshal() (dAtA []byte, err error) {
@@ -1142,6 +1200,48 @@ func (m *EventERC20Whitelist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCCTXArchived) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCCTXArchived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCCTXArchived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cctx != nil {
+		{
+			size, err := m.Cctx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1388,6 +1488,23 @@ func (m *EventERC20Whitelist) Size() (n int) {
 	return n
 }
 
+func (m *EventCCTXArchived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Cctx != nil {
+		l = m.Cctx.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3120,6 +3237,124 @@ func (m *EventERC20Whitelist) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCCTXArchived) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCCTXArchived: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCCTXArchived: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cctx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cctx == nil {
+				m.Cctx = &CrossChainTx{}
+			}
+			if err := m.Cctx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
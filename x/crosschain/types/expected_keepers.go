@@ -14,6 +14,7 @@ import (
 	"github.com/zeta-chain/zetacore/pkg/coin"
 	"github.com/zeta-chain/zetacore/pkg/proofs"
 	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
+	emissionstypes "github.com/zeta-chain/zetacore/x/emissions/types"
 	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 )
@@ -36,6 +37,7 @@ type BankKeeper interface {
 type ObserverKeeper interface {
 	GetObserverSet(ctx sdk.Context) (val observertypes.ObserverSet, found bool)
 	GetBallot(ctx sdk.Context, index string) (val observertypes.Ballot, found bool)
+	DeleteBallot(ctx sdk.Context, index string)
 	GetChainParamsByChainID(ctx sdk.Context, chainID int64) (params *observertypes.ChainParams, found bool)
 	GetNodeAccount(ctx sdk.Context, address string) (nodeAccount observertypes.NodeAccount, found bool)
 	GetAllNodeAccount(ctx sdk.Context) (nodeAccounts []observertypes.NodeAccount)
@@ -210,11 +212,19 @@ type FungibleKeeper interface {
 
 type AuthorityKeeper interface {
 	IsAuthorized(ctx sdk.Context, address string, policyType authoritytypes.PolicyType) bool
+
+	// GetAdditionalChainList returns the chains supported in addition to the default chains
+	GetAdditionalChainList(ctx sdk.Context) []chains.Chain
 }
 
 type LightclientKeeper interface {
 	VerifyProof(ctx sdk.Context, proof *proofs.Proof, chainID int64, blockHash string, txIndex int64) ([]byte, error)
 }
 
+type EmissionsKeeper interface {
+	GetParams(ctx sdk.Context) (params emissionstypes.Params, found bool)
+}
+
 type IBCCrosschainKeeper interface {
+	InitiateOutbound(ctx sdk.Context, cctx *CrossChainTx) error
 }
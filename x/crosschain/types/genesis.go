@@ -10,9 +10,10 @@ import (
 // DefaultGenesis returns the default crosschain genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		OutboundTrackerList:   []OutboundTracker{},
-		InboundHashToCctxList: []InboundHashToCctx{},
-		GasPriceList:          []*GasPrice{},
+		OutboundTrackerList:    []OutboundTracker{},
+		InboundHashToCctxList:  []InboundHashToCctx{},
+		GasPriceList:           []*GasPrice{},
+		OutboundHashToCctxList: []OutboundHashToCctx{},
 	}
 }
 
@@ -40,6 +41,17 @@ func (gs GenesisState) Validate() error {
 		inboundHashToCctxIndexMap[index] = struct{}{}
 	}
 
+	// Check for duplicated index in outboundHashToCctx
+	outboundHashToCctxIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.OutboundHashToCctxList {
+		index := string(OutboundHashToCctxKey(elem.OutboundHash))
+		if _, ok := outboundHashToCctxIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for outboundHashToCctx")
+		}
+		outboundHashToCctxIndexMap[index] = struct{}{}
+	}
+
 	// Check for duplicated index in gasPrice
 	gasPriceIndexMap := make(map[string]bool)
 
@@ -50,7 +62,11 @@ func (gs GenesisState) Validate() error {
 		gasPriceIndexMap[elem.Index] = true
 	}
 
-	return gs.RateLimiterFlags.Validate()
+	if err := gs.RateLimiterFlags.Validate(); err != nil {
+		return err
+	}
+
+	return gs.CctxRetentionPolicy.Validate()
 }
 
 func GetGenesisStateFromAppState(marshaler codec.JSONCodec, appState map[string]json.RawMessage) GenesisState {
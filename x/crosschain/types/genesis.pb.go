@@ -25,15 +25,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the metacore module's genesis state.
 type GenesisState struct {
-	OutboundTrackerList   []OutboundTracker   `protobuf:"bytes,2,rep,name=outboundTrackerList,proto3" json:"outboundTrackerList"`
-	GasPriceList          []*GasPrice         `protobuf:"bytes,5,rep,name=gasPriceList,proto3" json:"gasPriceList,omitempty"`
-	CrossChainTxs         []*CrossChainTx     `protobuf:"bytes,7,rep,name=CrossChainTxs,proto3" json:"CrossChainTxs,omitempty"`
-	LastBlockHeightList   []*LastBlockHeight  `protobuf:"bytes,8,rep,name=lastBlockHeightList,proto3" json:"lastBlockHeightList,omitempty"`
-	InboundHashToCctxList []InboundHashToCctx `protobuf:"bytes,9,rep,name=inboundHashToCctxList,proto3" json:"inboundHashToCctxList"`
-	InboundTrackerList    []InboundTracker    `protobuf:"bytes,11,rep,name=inbound_tracker_list,json=inboundTrackerList,proto3" json:"inbound_tracker_list"`
-	ZetaAccounting        ZetaAccounting      `protobuf:"bytes,12,opt,name=zeta_accounting,json=zetaAccounting,proto3" json:"zeta_accounting"`
-	FinalizedInbounds     []string            `protobuf:"bytes,16,rep,name=FinalizedInbounds,proto3" json:"FinalizedInbounds,omitempty"`
-	RateLimiterFlags      RateLimiterFlags    `protobuf:"bytes,17,opt,name=rate_limiter_flags,json=rateLimiterFlags,proto3" json:"rate_limiter_flags"`
+	OutboundTrackerList    []OutboundTracker    `protobuf:"bytes,2,rep,name=outboundTrackerList,proto3" json:"outboundTrackerList"`
+	GasPriceList           []*GasPrice          `protobuf:"bytes,5,rep,name=gasPriceList,proto3" json:"gasPriceList,omitempty"`
+	CrossChainTxs          []*CrossChainTx      `protobuf:"bytes,7,rep,name=CrossChainTxs,proto3" json:"CrossChainTxs,omitempty"`
+	LastBlockHeightList    []*LastBlockHeight   `protobuf:"bytes,8,rep,name=lastBlockHeightList,proto3" json:"lastBlockHeightList,omitempty"`
+	InboundHashToCctxList  []InboundHashToCctx  `protobuf:"bytes,9,rep,name=inboundHashToCctxList,proto3" json:"inboundHashToCctxList"`
+	InboundTrackerList     []InboundTracker     `protobuf:"bytes,11,rep,name=inbound_tracker_list,json=inboundTrackerList,proto3" json:"inbound_tracker_list"`
+	ZetaAccounting         ZetaAccounting       `protobuf:"bytes,12,opt,name=zeta_accounting,json=zetaAccounting,proto3" json:"zeta_accounting"`
+	FinalizedInbounds      []string             `protobuf:"bytes,16,rep,name=FinalizedInbounds,proto3" json:"FinalizedInbounds,omitempty"`
+	RateLimiterFlags       RateLimiterFlags     `protobuf:"bytes,17,opt,name=rate_limiter_flags,json=rateLimiterFlags,proto3" json:"rate_limiter_flags"`
+	OutboundHashToCctxList []OutboundHashToCctx `protobuf:"bytes,18,rep,name=outbound_hash_to_cctx_list,json=outboundHashToCctxList,proto3" json:"outbound_hash_to_cctx_list"`
+	CctxRetentionPolicy    CctxRetentionPolicy  `protobuf:"bytes,19,opt,name=cctx_retention_policy,json=cctxRetentionPolicy,proto3" json:"cctx_retention_policy"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,6 +134,20 @@ func (m *GenesisState) GetRateLimiterFlags() RateLimiterFlags {
 	return RateLimiterFlags{}
 }
 
+func (m *GenesisState) GetOutboundHashToCctxList() []OutboundHashToCctx {
+	if m != nil {
+		return m.OutboundHashToCctxList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCctxRetentionPolicy() CctxRetentionPolicy {
+	if m != nil {
+		return m.CctxRetentionPolicy
+	}
+	return CctxRetentionPolicy{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.crosschain.GenesisState")
 }
@@ -141,39 +157,44 @@ func init() {
 }
 
 var fileDescriptor_547615497292ea23 = []byte{
-	// 512 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x5b, 0x06, 0x83, 0x79, 0x05, 0x36, 0x6f, 0x48, 0x51, 0x25, 0x42, 0xc5, 0x85, 0x49,
-	0xa3, 0x29, 0x6c, 0x80, 0xb8, 0xb2, 0x4a, 0xdb, 0xd0, 0x2a, 0x01, 0xa1, 0xa7, 0x09, 0xc9, 0xb8,
-	0x9e, 0x97, 0x58, 0xcb, 0xe2, 0xca, 0x7e, 0x95, 0x4a, 0x3f, 0x05, 0x9f, 0x88, 0xf3, 0x8e, 0x3b,
-	0x72, 0x42, 0xa8, 0xfd, 0x22, 0xc8, 0x8e, 0x57, 0x96, 0xb6, 0x4a, 0x7a, 0x7b, 0x7a, 0x79, 0xff,
-	0xff, 0xef, 0x29, 0x7f, 0x3f, 0xb4, 0x3b, 0xe2, 0x40, 0x59, 0x4c, 0x45, 0xda, 0xb2, 0x95, 0x54,
-	0xbc, 0xc5, 0x94, 0xd4, 0x3a, 0xeb, 0x45, 0x3c, 0xe5, 0x5a, 0xe8, 0xa0, 0xaf, 0x24, 0x48, 0xfc,
-	0x74, 0x3a, 0x1c, 0xdc, 0x0c, 0x07, 0xff, 0x87, 0xeb, 0x7b, 0xc5, 0x5e, 0xb6, 0x24, 0xb6, 0x26,
-	0x30, 0xcc, 0x2c, 0xeb, 0xcd, 0x12, 0x3e, 0xd5, 0xa4, 0xaf, 0x04, 0xe3, 0x6e, 0xfc, 0x7d, 0xf1,
-	0xb8, 0x48, 0x7b, 0x72, 0x90, 0x9e, 0x91, 0x98, 0xea, 0x98, 0x80, 0x24, 0x8c, 0x4d, 0x41, 0xfb,
-	0xcb, 0x29, 0x41, 0x51, 0x76, 0xc1, 0x95, 0x13, 0xbd, 0x2d, 0x16, 0x25, 0x54, 0x03, 0xe9, 0x25,
-	0x92, 0x5d, 0x90, 0x98, 0x8b, 0x28, 0x06, 0x27, 0x7b, 0x53, 0x2c, 0x93, 0x03, 0x58, 0x04, 0x7b,
-	0x57, 0xac, 0x52, 0x14, 0x38, 0x49, 0xc4, 0xa5, 0x00, 0xae, 0xc8, 0x79, 0x42, 0x23, 0x97, 0x4a,
-	0x7d, 0x3b, 0x92, 0x91, 0xb4, 0x65, 0xcb, 0x54, 0x59, 0xf7, 0xf9, 0xaf, 0x55, 0x54, 0x3b, 0xca,
-	0xd2, 0xfb, 0x0a, 0x14, 0x38, 0x3e, 0x47, 0x5b, 0x37, 0xe0, 0x6e, 0xc6, 0xed, 0x08, 0x0d, 0xde,
-	0x9d, 0xc6, 0xca, 0xce, 0xfa, 0x5e, 0x10, 0x14, 0x46, 0x1b, 0x7c, 0xca, 0x2b, 0x0f, 0xee, 0x5e,
-	0xfd, 0x79, 0x56, 0x09, 0x17, 0x19, 0xe2, 0x13, 0x54, 0x8b, 0xa8, 0xfe, 0x6c, 0x42, 0xb3, 0x80,
-	0x7b, 0x16, 0xf0, 0xa2, 0x04, 0x70, 0xe4, 0x24, 0x61, 0x4e, 0x8c, 0xbf, 0xa0, 0x87, 0x6d, 0x33,
-	0xd4, 0x36, 0x43, 0xdd, 0xa1, 0xf6, 0xee, 0x5b, 0xb7, 0xdd, 0x12, 0xb7, 0xdb, 0x9a, 0x30, 0xef,
-	0x80, 0xbf, 0xa3, 0x2d, 0x93, 0xdb, 0x81, 0x89, 0xed, 0xd8, 0xa6, 0x66, 0xd7, 0x7c, 0xb0, 0xd4,
-	0x7f, 0xe8, 0xe4, 0x95, 0xe1, 0x22, 0x2b, 0x9c, 0xa0, 0x27, 0xee, 0x39, 0x1d, 0x53, 0x1d, 0x77,
-	0x65, 0x9b, 0xc1, 0xd0, 0x32, 0xd6, 0x2c, 0xe3, 0x55, 0x09, 0xe3, 0xe3, 0xac, 0xd6, 0xfd, 0xed,
-	0xc5, 0xa6, 0x98, 0xa3, 0xed, 0x99, 0xc7, 0x4b, 0x12, 0x03, 0x5b, 0xb7, 0xb0, 0xe6, 0x72, 0xb0,
-	0x7c, 0xae, 0x58, 0xa4, 0x73, 0xb1, 0x7e, 0x43, 0x8f, 0x8d, 0x9e, 0x50, 0xc6, 0xe4, 0x20, 0x05,
-	0x91, 0x46, 0x5e, 0xad, 0x51, 0x5d, 0x82, 0x70, 0xca, 0x81, 0x7e, 0x98, 0x8a, 0x1c, 0xe1, 0xd1,
-	0x28, 0xd7, 0xc5, 0x2f, 0xd1, 0xe6, 0xa1, 0x48, 0x69, 0x22, 0x46, 0xfc, 0xcc, 0xad, 0xa4, 0xbd,
-	0x8d, 0xc6, 0xca, 0xce, 0x5a, 0x38, 0xff, 0x01, 0x33, 0x84, 0xe7, 0xaf, 0xc1, 0xdb, 0xb4, 0xeb,
-	0xb4, 0x4a, 0xd6, 0x09, 0x29, 0xf0, 0x4e, 0xa6, 0x3b, 0x34, 0x32, 0xb7, 0xd0, 0x86, 0x9a, 0xed,
-	0x9f, 0x5c, 0x8d, 0xfd, 0xea, 0xf5, 0xd8, 0xaf, 0xfe, 0x1d, 0xfb, 0xd5, 0x9f, 0x13, 0xbf, 0x72,
-	0x3d, 0xf1, 0x2b, 0xbf, 0x27, 0x7e, 0xe5, 0xf4, 0x75, 0x24, 0x20, 0x1e, 0xf4, 0x02, 0x26, 0x2f,
-	0xed, 0xa5, 0x36, 0x67, 0x8e, 0x76, 0x78, 0xfb, 0x6c, 0xe1, 0x47, 0x9f, 0xeb, 0xde, 0xaa, 0x3d,
-	0xca, 0xfd, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x73, 0xa8, 0xd7, 0xb5, 0x6f, 0x05, 0x00, 0x00,
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0x5b, 0xc6, 0x9f, 0xcd, 0x1b, 0xb0, 0xb9, 0x03, 0x45, 0x95, 0x08, 0x15, 0x37, 0x4c,
+	0x1a, 0x4d, 0x59, 0x07, 0x08, 0x2e, 0x59, 0xa5, 0x6d, 0x68, 0x95, 0x18, 0xa1, 0x57, 0x13, 0x92,
+	0x71, 0x3d, 0x2f, 0xb1, 0x96, 0xc5, 0x55, 0x7c, 0x2a, 0x65, 0x7d, 0x0a, 0x5e, 0x81, 0xb7, 0xd9,
+	0xe5, 0x2e, 0xb9, 0x42, 0xa8, 0x7d, 0x11, 0x14, 0xc7, 0x1d, 0x4b, 0x1b, 0x9a, 0xdc, 0x59, 0xce,
+	0xf9, 0x9d, 0xef, 0xcb, 0x39, 0xc7, 0x07, 0x6d, 0x8f, 0x38, 0x50, 0xe6, 0x53, 0x11, 0xb6, 0xf4,
+	0x49, 0x46, 0xbc, 0xc5, 0x22, 0xa9, 0x54, 0x7a, 0xe7, 0xf1, 0x90, 0x2b, 0xa1, 0x9c, 0x41, 0x24,
+	0x41, 0xe2, 0x67, 0x37, 0xc1, 0xce, 0x34, 0xd8, 0xf9, 0x17, 0x5c, 0xff, 0xb0, 0x38, 0x17, 0x63,
+	0x10, 0x93, 0x88, 0x03, 0x0f, 0x41, 0xc8, 0x90, 0x0c, 0x64, 0x20, 0xd8, 0x65, 0x9a, 0xb9, 0xde,
+	0x2e, 0x40, 0x93, 0x23, 0xd1, 0x67, 0x02, 0xb1, 0x61, 0x9a, 0x05, 0xd6, 0xa9, 0x22, 0x83, 0x48,
+	0x30, 0x6e, 0xc2, 0xdf, 0x2f, 0x0e, 0x17, 0x61, 0x5f, 0x0e, 0xc3, 0x53, 0xe2, 0x53, 0xe5, 0x13,
+	0x90, 0x24, 0x71, 0x6b, 0xc8, 0xdd, 0x72, 0x24, 0x44, 0x94, 0x9d, 0xf3, 0xc8, 0x40, 0x6f, 0x17,
+	0x43, 0x01, 0x55, 0x40, 0xfa, 0x81, 0x64, 0xe7, 0xc4, 0xe7, 0xc2, 0xf3, 0xc1, 0x60, 0x05, 0x35,
+	0x94, 0x43, 0xf8, 0xaf, 0xcd, 0x37, 0x25, 0xd1, 0xac, 0xcf, 0x77, 0x8b, 0xa9, 0x88, 0x02, 0x27,
+	0x81, 0xb8, 0x10, 0xc0, 0x23, 0x72, 0x16, 0x50, 0xcf, 0xcc, 0x42, 0x7d, 0xd3, 0x93, 0x9e, 0xd4,
+	0xc7, 0x56, 0x72, 0x4a, 0x6f, 0x5f, 0xfc, 0x5c, 0x46, 0x6b, 0x07, 0xe9, 0xcc, 0x7c, 0x05, 0x0a,
+	0x1c, 0x9f, 0xa1, 0xda, 0x54, 0xb8, 0x97, 0xea, 0x76, 0x85, 0x02, 0xeb, 0x4e, 0x63, 0x69, 0x6b,
+	0xb5, 0xed, 0x38, 0x0b, 0x07, 0xca, 0xf9, 0x9c, 0x25, 0xf7, 0xee, 0x5e, 0xfd, 0x7e, 0x5e, 0x71,
+	0xf3, 0x12, 0xe2, 0x23, 0xb4, 0xe6, 0x51, 0x75, 0x9c, 0xf4, 0x5b, 0x0b, 0xdc, 0xd3, 0x02, 0x2f,
+	0x0b, 0x04, 0x0e, 0x0c, 0xe2, 0x66, 0x60, 0xfc, 0x05, 0x3d, 0xec, 0x24, 0x41, 0x9d, 0x24, 0xa8,
+	0x17, 0x2b, 0xeb, 0x81, 0xce, 0xb6, 0x5d, 0x90, 0xed, 0x36, 0xe3, 0x66, 0x33, 0xe0, 0xef, 0xa8,
+	0x96, 0xb4, 0x7c, 0x2f, 0xe9, 0xf8, 0xa1, 0x6e, 0xb8, 0xb6, 0xb9, 0x5c, 0xaa, 0x0e, 0xdd, 0x2c,
+	0xe9, 0xe6, 0xa5, 0xc2, 0x01, 0x7a, 0x62, 0x26, 0xf1, 0x90, 0x2a, 0xbf, 0x27, 0x3b, 0x0c, 0x62,
+	0xad, 0xb1, 0xa2, 0x35, 0x5e, 0x17, 0x68, 0x7c, 0x9a, 0x65, 0x4d, 0xb5, 0xf3, 0x93, 0x62, 0x8e,
+	0x36, 0x67, 0xe6, 0x9e, 0x04, 0x89, 0xd8, 0xaa, 0x16, 0x6b, 0x96, 0x13, 0xcb, 0xf6, 0x15, 0x8b,
+	0x70, 0xae, 0xad, 0xdf, 0xd0, 0xe3, 0x84, 0x27, 0x94, 0x31, 0x39, 0x0c, 0x41, 0x84, 0x9e, 0xb5,
+	0xd6, 0xa8, 0x96, 0x50, 0x38, 0xe1, 0x40, 0x3f, 0xde, 0x40, 0x46, 0xe1, 0xd1, 0x28, 0x73, 0x8b,
+	0x5f, 0xa1, 0x8d, 0x7d, 0x11, 0xd2, 0x40, 0x8c, 0xf8, 0xa9, 0xb1, 0xa4, 0xac, 0xf5, 0xc6, 0xd2,
+	0xd6, 0x8a, 0x3b, 0xff, 0x01, 0x33, 0x84, 0xe7, 0x5f, 0x83, 0xb5, 0xa1, 0xed, 0xb4, 0x0a, 0xec,
+	0xb8, 0x14, 0x78, 0x37, 0xe5, 0xf6, 0x13, 0xcc, 0x18, 0x5a, 0x8f, 0x66, 0xee, 0xb1, 0x42, 0xf5,
+	0xdc, 0x37, 0x9e, 0x56, 0x17, 0xeb, 0xea, 0xee, 0x94, 0x7c, 0x36, 0x73, 0xbd, 0x7c, 0x2a, 0xe7,
+	0xbe, 0x4c, 0x47, 0x27, 0x77, 0x39, 0x5b, 0x35, 0xfd, 0x73, 0xed, 0xa2, 0xb9, 0x67, 0x10, 0xbb,
+	0x53, 0xf4, 0x58, 0x93, 0xd3, 0xa7, 0xca, 0x72, 0x3e, 0x1d, 0x5d, 0x8d, 0xed, 0xea, 0xf5, 0xd8,
+	0xae, 0xfe, 0x19, 0xdb, 0xd5, 0x1f, 0x13, 0xbb, 0x72, 0x3d, 0xb1, 0x2b, 0xbf, 0x26, 0x76, 0xe5,
+	0x64, 0xc7, 0x13, 0xe0, 0x0f, 0xfb, 0x0e, 0x93, 0x17, 0x7a, 0x19, 0x35, 0x67, 0xf6, 0x52, 0x7c,
+	0x7b, 0x33, 0xc1, 0xe5, 0x80, 0xab, 0xfe, 0x7d, 0xbd, 0x77, 0x76, 0xff, 0x0e, 0x00, 0xb0, 0x78,
+	0xe8, 0x03, 0xc8, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -196,6 +217,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.CctxRetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if len(m.OutboundHashToCctxList) > 0 {
+		for iNdEx := len(m.OutboundHashToCctxList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutboundHashToCctxList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
 	{
 		size, err := m.RateLimiterFlags.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -379,6 +428,14 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.RateLimiterFlags.Size()
 	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.OutboundHashToCctxList) > 0 {
+		for _, e := range m.OutboundHashToCctxList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.CctxRetentionPolicy.Size()
+	n += 2 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -719,6 +776,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundHashToCctxList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundHashToCctxList = append(m.OutboundHashToCctxList, OutboundHashToCctx{})
+			if err := m.OutboundHashToCctxList[len(m.OutboundHashToCctxList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxRetentionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CctxRetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -44,7 +44,8 @@ func TestGenesisState_Validate(t *testing.T) {
 					sample.GasPrice(t, "1"),
 					sample.GasPrice(t, "2"),
 				},
-				RateLimiterFlags: sample.RateLimiterFlags(),
+				RateLimiterFlags:    sample.RateLimiterFlags(),
+				CctxRetentionPolicy: sample.CctxRetentionPolicy(),
 			},
 			valid: true,
 		},
@@ -77,6 +78,16 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "invalid cctx retention policy",
+			genState: &types.GenesisState{
+				CctxRetentionPolicy: types.CctxRetentionPolicy{
+					Enabled:         true,
+					RetentionBlocks: 1,
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "duplicated inboundHashToCctx",
 			genState: &types.GenesisState{
@@ -91,6 +102,20 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated outboundHashToCctx",
+			genState: &types.GenesisState{
+				OutboundHashToCctxList: []types.OutboundHashToCctx{
+					{
+						OutboundHash: "0",
+					},
+					{
+						OutboundHash: "0",
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "duplicated gasPriceList",
 			genState: &types.GenesisState{
@@ -6,12 +6,13 @@ import (
 	"github.com/zeta-chain/zetacore/pkg/chains"
 )
 
-func (m InboundParams) Validate() error {
+// Validate checks the inbound params, additionalChains is the list of chains supported in addition to the default chains
+func (m InboundParams) Validate(additionalChains []chains.Chain) error {
 	if m.Sender == "" {
 		return fmt.Errorf("sender cannot be empty")
 	}
 
-	if chains.GetChainFromChainID(m.SenderChainId) == nil {
+	if chains.GetChainFromChainID(m.SenderChainId, additionalChains) == nil {
 		return fmt.Errorf("invalid sender chain id %d", m.SenderChainId)
 	}
 
@@ -7,6 +7,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/testutil/sample"
 )
 
@@ -15,20 +16,25 @@ func TestInboundTxParams_Validate(t *testing.T) {
 
 	inboundParams := sample.InboundParamsValidChainID(r)
 	inboundParams.Sender = ""
-	require.ErrorContains(t, inboundParams.Validate(), "sender cannot be empty")
+	require.ErrorContains(t, inboundParams.Validate([]chains.Chain{}), "sender cannot be empty")
 
 	inboundParams = sample.InboundParamsValidChainID(r)
 	inboundParams.SenderChainId = 1000
-	require.ErrorContains(t, inboundParams.Validate(), "invalid sender chain id 1000")
+	require.ErrorContains(t, inboundParams.Validate([]chains.Chain{}), "invalid sender chain id 1000")
+
+	// the sender chain can be a chain added in the chain info
+	additionalChain := chains.Ethereum
+	additionalChain.ChainId = 1000
+	require.NoError(t, inboundParams.Validate([]chains.Chain{additionalChain}))
 
 	inboundParams = sample.InboundParamsValidChainID(r)
 	inboundParams.Amount = sdkmath.Uint{}
-	require.ErrorContains(t, inboundParams.Validate(), "amount cannot be nil")
+	require.ErrorContains(t, inboundParams.Validate([]chains.Chain{}), "amount cannot be nil")
 
 	inboundParams = sample.InboundParamsValidChainID(r)
 	inboundParams.ObservedHash = sample.Hash().String()
 	inboundParams.BallotIndex = sample.ZetaIndex(t)
-	require.NoError(t, inboundParams.Validate())
+	require.NoError(t, inboundParams.Validate([]chains.Chain{}))
 
 	// Disabled checks
 	// TODO: Improve the checks, move the validation call to a new place and reenable
@@ -37,14 +43,14 @@ func TestInboundTxParams_Validate(t *testing.T) {
 	//inboundParams = sample.InboundParamsValidChainID(r)
 	//inboundParams.SenderChainId = chains.GoerliChain.ChainId
 	//inboundParams.Sender = "0x123"
-	//require.ErrorContains(t, inboundParams.Validate(), "invalid address 0x123")
+	//require.ErrorContains(t, inboundParams.Validate([]chains.Chain{}), "invalid address 0x123")
 	//
 	//inboundParams = sample.InboundParamsValidChainID(r)
 	//inboundParams.ObservedHash = "12"
-	//require.ErrorContains(t, inboundParams.Validate(), "hash must be a valid ethereum hash 12")
+	//require.ErrorContains(t, inboundParams.Validate([]chains.Chain{}), "hash must be a valid ethereum hash 12")
 	//
 	//inboundParams = sample.InboundParamsValidChainID(r)
 	//inboundParams.ObservedHash = sample.Hash().String()
 	//inboundParams.BallotIndex = "12"
-	//require.ErrorContains(t, inboundParams.Validate(), "invalid index length 2")
+	//require.ErrorContains(t, inboundParams.Validate([]chains.Chain{}), "invalid index length 2")
 }
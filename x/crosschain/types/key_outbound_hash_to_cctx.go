@@ -0,0 +1,19 @@
+package types
+
+const (
+	// OutboundHashToCctxKeyPrefix is the prefix to retrieve all OutboundHashToCctx
+	OutboundHashToCctxKeyPrefix = "OutboundHashToCctx/value/"
+)
+
+// OutboundHashToCctxKey returns the store key to retrieve a OutboundHashToCctx from the index fields
+func OutboundHashToCctxKey(
+	outboundHash string,
+) []byte {
+	var key []byte
+
+	outboundHashBytes := []byte(outboundHash)
+	key = append(key, outboundHashBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -61,6 +61,17 @@ const (
 	ZetaAccountingKey = "ZetaAccounting-value-"
 
 	RateLimiterFlagsKey = "RateLimiterFlags-value-"
+
+	// CctxIndexKeyPrefix is the prefix of the secondary indexes of the cctxs
+	CctxIndexKeyPrefix = "CctxIndex-value-"
+
+	CctxRetentionPolicyKey = "CctxRetentionPolicy-value-"
+
+	// CctxIndexBackfillKey is the key of the store key of the next existing cctx indexed by the index backfill
+	CctxIndexBackfillKey = "CctxIndexBackfill-value-"
+
+	// CctxPruningCursorKey is the prefix of the index keys the pruning scans of the cctx statuses resume from
+	CctxPruningCursorKey = "CctxPruningCursor-value-"
 )
 
 // OutboundTrackerKey returns the store key to retrieve a OutboundTracker from the index fields
@@ -48,11 +48,12 @@ func (msg *MsgAddInboundTracker) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	chain := chains.GetChainFromChainID(msg.ChainId)
-	if chain == nil {
+	// the chain can be added by the authority chain info, its support is checked by the message server
+	if msg.ChainId <= 0 {
 		return errorsmod.Wrapf(ErrInvalidChainID, "chain id (%d)", msg.ChainId)
 	}
-	if msg.Proof != nil && !chain.SupportMerkleProof() {
+	// block header verification is only supported for the default chains
+	if msg.Proof != nil && !chains.IsHeaderSupportedChain(msg.ChainId, []chains.Chain{}) {
 		return errorsmod.Wrapf(
 			ErrProofVerificationFail,
 			"chain id %d does not support proof-based trackers",
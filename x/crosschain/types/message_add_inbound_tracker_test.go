@@ -35,11 +35,11 @@ func TestMsgAddInboundTracker_ValidateBasic(t *testing.T) {
 			name: "invalid chain id",
 			msg: types.NewMsgAddInboundTracker(
 				sample.AccAddress(),
-				42,
+				-1,
 				coin.CoinType_Gas,
 				"hash",
 			),
-			err: errorsmod.Wrapf(types.ErrInvalidChainID, "chain id (%d)", 42),
+			err: errorsmod.Wrapf(types.ErrInvalidChainID, "chain id (%d)", -1),
 		},
 		{
 			name: "invalid proof",
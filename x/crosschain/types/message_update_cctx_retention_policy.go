@@ -0,0 +1,49 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateCctxRetentionPolicy = "UpdateCctxRetentionPolicy"
+
+var _ sdk.Msg = &MsgUpdateCctxRetentionPolicy{}
+
+func NewMsgUpdateCctxRetentionPolicy(creator string, policy CctxRetentionPolicy) *MsgUpdateCctxRetentionPolicy {
+	return &MsgUpdateCctxRetentionPolicy{
+		Creator:             creator,
+		CctxRetentionPolicy: policy,
+	}
+}
+
+func (msg *MsgUpdateCctxRetentionPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateCctxRetentionPolicy) Type() string {
+	return TypeMsgUpdateCctxRetentionPolicy
+}
+
+func (msg *MsgUpdateCctxRetentionPolicy) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateCctxRetentionPolicy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateCctxRetentionPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.CctxRetentionPolicy.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCctxRetentionPolicy, err.Error())
+	}
+	return nil
+}
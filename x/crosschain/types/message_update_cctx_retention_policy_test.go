@@ -0,0 +1,99 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestMsgUpdateCctxRetentionPolicy_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateCctxRetentionPolicy
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg:  types.NewMsgUpdateCctxRetentionPolicy(sample.AccAddress(), sample.CctxRetentionPolicy()),
+		},
+		{
+			name: "invalid creator address",
+			msg:  types.NewMsgUpdateCctxRetentionPolicy("invalid", sample.CctxRetentionPolicy()),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid cctx retention policy",
+			msg: types.NewMsgUpdateCctxRetentionPolicy(sample.AccAddress(), types.CctxRetentionPolicy{
+				Enabled:         true,
+				RetentionBlocks: 1,
+			}),
+			err: types.ErrInvalidCctxRetentionPolicy,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateCctxRetentionPolicy_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    *types.MsgUpdateCctxRetentionPolicy
+		panics bool
+	}{
+		{
+			name:   "valid signer",
+			msg:    types.NewMsgUpdateCctxRetentionPolicy(signer, sample.CctxRetentionPolicy()),
+			panics: false,
+		},
+		{
+			name:   "invalid signer",
+			msg:    types.NewMsgUpdateCctxRetentionPolicy("invalid", sample.CctxRetentionPolicy()),
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgUpdateCctxRetentionPolicy_Type(t *testing.T) {
+	msg := types.NewMsgUpdateCctxRetentionPolicy(sample.AccAddress(), sample.CctxRetentionPolicy())
+	require.Equal(t, types.TypeMsgUpdateCctxRetentionPolicy, msg.Type())
+}
+
+func TestMsgUpdateCctxRetentionPolicy_Route(t *testing.T) {
+	msg := types.NewMsgUpdateCctxRetentionPolicy(sample.AccAddress(), sample.CctxRetentionPolicy())
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgUpdateCctxRetentionPolicy_GetSignBytes(t *testing.T) {
+	msg := types.NewMsgUpdateCctxRetentionPolicy(sample.AccAddress(), sample.CctxRetentionPolicy())
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
@@ -1,13 +1,17 @@
 package types
 
 import (
+	"fmt"
+
 	cosmoserrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/zeta-chain/zetacore/pkg/authz"
+	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/pkg/coin"
 )
 
@@ -92,9 +96,43 @@ func (msg *MsgVoteInbound) ValidateBasic() error {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "message is too long: %d", len(msg.Message))
 	}
 
+	// the revert address of a chain outside the default chains is validated by the keeper against the chain info
+	if msg.RevertAddress != "" && chains.GetChainFromChainID(msg.SenderChainId, []chains.Chain{}) != nil {
+		if err := ValidateRevertAddress(msg.SenderChainId, msg.RevertAddress, []chains.Chain{}); err != nil {
+			return cosmoserrors.Wrapf(
+				sdkerrors.ErrInvalidAddress,
+				"invalid revert address (%s): %s",
+				msg.RevertAddress,
+				err,
+			)
+		}
+	}
+
 	return nil
 }
 
+// ValidateRevertAddress checks the revert address is a supported address of the sender chain
+// additionalChains is the list of chains supported in addition to the default chains
+func ValidateRevertAddress(senderChainID int64, revertAddress string, additionalChains []chains.Chain) error {
+	if chains.IsBitcoinChain(senderChainID, additionalChains) {
+		addr, err := chains.DecodeBtcAddress(revertAddress, senderChainID)
+		if err != nil {
+			return err
+		}
+		if !chains.IsBtcAddressSupported(addr) {
+			return fmt.Errorf("unsupported address type")
+		}
+		return nil
+	}
+	if chains.IsEVMChain(senderChainID, additionalChains) {
+		if !ethcommon.IsHexAddress(revertAddress) {
+			return fmt.Errorf("not a hex address")
+		}
+		return nil
+	}
+	return fmt.Errorf("revert address not supported for chain %d", senderChainID)
+}
+
 func (msg *MsgVoteInbound) Digest() string {
 	m := *msg
 	m.Creator = ""
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/zeta-chain/zetacore/pkg/authz"
+	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/pkg/coin"
 	"github.com/zeta-chain/zetacore/testutil/sample"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
@@ -123,6 +124,57 @@ func TestMsgVoteInbound_ValidateBasic(t *testing.T) {
 			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
+
+	// messages with a revert address, the sender is on bitcoin or ethereum
+	withRevertAddress := func(senderChainID int64, revertAddress string) *types.MsgVoteInbound {
+		msg := types.NewMsgVoteInbound(
+			sample.AccAddress(),
+			sample.AccAddress(),
+			senderChainID,
+			sample.String(),
+			sample.String(),
+			42,
+			math.NewUint(42),
+			sample.String(),
+			sample.String(),
+			42,
+			42,
+			coin.CoinType_Gas,
+			sample.String(),
+			42,
+		)
+		msg.RevertAddress = revertAddress
+		return msg
+	}
+	tests = append(tests, []struct {
+		name string
+		msg  *types.MsgVoteInbound
+		err  error
+	}{
+		{
+			name: "valid bitcoin revert address",
+			msg:  withRevertAddress(chains.BitcoinMainnet.ChainId, "bc1qysd4sp9q8my59ul9wsf5rvs9p387hf8vfwatzu"),
+		},
+		{
+			name: "valid evm revert address",
+			msg:  withRevertAddress(chains.Ethereum.ChainId, sample.EthAddress().Hex()),
+		},
+		{
+			name: "bitcoin revert address for another network",
+			msg:  withRevertAddress(chains.BitcoinMainnet.ChainId, "tb1qy9pqmk2pd9sv63g27jt8r657wy0d9uee4x2dt2"),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid evm revert address",
+			msg:  withRevertAddress(chains.Ethereum.ChainId, "0x123"),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "revert address on chain outside the default chains is checked by the keeper",
+			msg:  withRevertAddress(42, sample.EthAddress().Hex()),
+		},
+	}...)
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			err := tt.msg.ValidateBasic()
@@ -0,0 +1,376 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zetachain/zetacore/crosschain/outbound_hash_to_cctx.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OutboundHashToCctx maps an observed outbound hash to the cctxs it processes
+// a single outbound can process several cctxs (e.g. batched Bitcoin withdrawals)
+type OutboundHashToCctx struct {
+	OutboundHash string   `protobuf:"bytes,1,opt,name=outbound_hash,json=outboundHash,proto3" json:"outbound_hash,omitempty"`
+	CctxIndex    []string `protobuf:"bytes,2,rep,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+}
+
+func (m *OutboundHashToCctx) Reset()         { *m = OutboundHashToCctx{} }
+func (m *OutboundHashToCctx) String() string { return proto.CompactTextString(m) }
+func (*OutboundHashToCctx) ProtoMessage()    {}
+func (*OutboundHashToCctx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82b9fd2f5bfc70bb, []int{0}
+}
+func (m *OutboundHashToCctx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutboundHashToCctx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutboundHashToCctx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutboundHashToCctx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutboundHashToCctx.Merge(m, src)
+}
+func (m *OutboundHashToCctx) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutboundHashToCctx) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutboundHashToCctx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutboundHashToCctx proto.InternalMessageInfo
+
+func (m *OutboundHashToCctx) GetOutboundHash() string {
+	if m != nil {
+		return m.OutboundHash
+	}
+	return ""
+}
+
+func (m *OutboundHashToCctx) GetCctxIndex() []string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*OutboundHashToCctx)(nil), "zetachain.zetacore.crosschain.OutboundHashToCctx")
+}
+
+func init() {
+	proto.RegisterFile("zetachain/zetacore/crosschain/outbound_hash_to_cctx.proto", fileDescriptor_82b9fd2f5bfc70bb)
+}
+
+var fileDescriptor_82b9fd2f5bfc70bb = []byte{
+	// 201 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xb2, 0xac, 0x4a, 0x2d, 0x49,
+	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb3, 0xf2, 0x8b, 0x52, 0xf5, 0x93, 0x8b, 0xf2, 0x8b,
+	0x8b, 0x21, 0x62, 0xf9, 0xa5, 0x25, 0x49, 0xf9, 0xa5, 0x79, 0x29, 0xf1, 0x19, 0x89, 0xc5, 0x19,
+	0xf1, 0x25, 0xf9, 0xf1, 0xc9, 0xc9, 0x25, 0x15, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xb2,
+	0x70, 0xad, 0x7a, 0x30, 0xad, 0x7a, 0x08, 0xad, 0x4a, 0x11, 0x5c, 0x42, 0xfe, 0x50, 0xdd, 0x1e,
+	0x89, 0xc5, 0x19, 0x21, 0xf9, 0xce, 0xc9, 0x25, 0x15, 0x42, 0xca, 0x5c, 0xbc, 0x28, 0x66, 0x4a,
+	0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0xf1, 0xe4, 0x23, 0x29, 0x15, 0x92, 0xe5, 0xe2, 0x02, 0xd9,
+	0x13, 0x9f, 0x99, 0x97, 0x92, 0x5a, 0x21, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x19, 0xc4, 0x09, 0x12,
+	0xf1, 0x04, 0x09, 0x38, 0x79, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
+	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94,
+	0x61, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0xd8, 0x3b, 0xba, 0x68, 0x3e,
+	0xab, 0x40, 0xf6, 0x5b, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x33, 0xc6, 0x80, 0x01,
+	0x00, 0x6e, 0xbf, 0x23, 0xe0, 0x09, 0x01, 0x00, 0x00,
+}
+
+func (m *OutboundHashToCctx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutboundHashToCctx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutboundHashToCctx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CctxIndex) > 0 {
+		for iNdEx := len(m.CctxIndex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CctxIndex[iNdEx])
+			copy(dAtA[i:], m.CctxIndex[iNdEx])
+			i = encodeVarintOutboundHashToCctx(dAtA, i, uint64(len(m.CctxIndex[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.OutboundHash) > 0 {
+		i -= len(m.OutboundHash)
+		copy(dAtA[i:], m.OutboundHash)
+		i = encodeVarintOutboundHashToCctx(dAtA, i, uint64(len(m.OutboundHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOutboundHashToCctx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOutboundHashToCctx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OutboundHashToCctx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OutboundHash)
+	if l > 0 {
+		n += 1 + l + sovOutboundHashToCctx(uint64(l))
+	}
+	if len(m.CctxIndex) > 0 {
+		for _, s := range m.CctxIndex {
+			l = len(s)
+			n += 1 + l + sovOutboundHashToCctx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovOutboundHashToCctx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOutboundHashToCctx(x uint64) (n int) {
+	return sovOutboundHashToCctx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OutboundHashToCctx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOutboundHashToCctx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutboundHashToCctx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutboundHashToCctx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutboundHashToCctx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutboundHashToCctx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutboundHashToCctx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOutboundHashToCctx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOutboundHashToCctx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOutboundHashToCctx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = append(m.CctxIndex, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOutboundHashToCctx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOutboundHashToCctx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOutboundHashToCctx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOutboundHashToCctx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOutboundHashToCctx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOutboundHashToCctx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOutboundHashToCctx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOutboundHashToCctx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOutboundHashToCctx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOutboundHashToCctx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOutboundHashToCctx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOutboundHashToCctx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -16,12 +16,13 @@ func (m OutboundParams) GetGasPriceUInt64() (uint64, error) {
 	return gasPrice, nil
 }
 
-func (m OutboundParams) Validate() error {
+// Validate checks the outbound params, additionalChains is the list of chains supported in addition to the default chains
+func (m OutboundParams) Validate(additionalChains []chains.Chain) error {
 	if m.Receiver == "" {
 		return fmt.Errorf("receiver cannot be empty")
 	}
 
-	if chains.GetChainFromChainID(m.ReceiverChainId) == nil {
+	if chains.GetChainFromChainID(m.ReceiverChainId, additionalChains) == nil {
 		return fmt.Errorf("invalid receiver chain id %d", m.ReceiverChainId)
 	}
 
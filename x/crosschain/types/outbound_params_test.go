@@ -7,6 +7,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/testutil/sample"
 )
 
@@ -14,20 +15,20 @@ func TestOutboundParams_Validate(t *testing.T) {
 	r := rand.New(rand.NewSource(42))
 	outTxParams := sample.OutboundParamsValidChainID(r)
 	outTxParams.Receiver = ""
-	require.ErrorContains(t, outTxParams.Validate(), "receiver cannot be empty")
+	require.ErrorContains(t, outTxParams.Validate([]chains.Chain{}), "receiver cannot be empty")
 
 	outTxParams = sample.OutboundParamsValidChainID(r)
 	outTxParams.ReceiverChainId = 1000
-	require.ErrorContains(t, outTxParams.Validate(), "invalid receiver chain id 1000")
+	require.ErrorContains(t, outTxParams.Validate([]chains.Chain{}), "invalid receiver chain id 1000")
 
 	outTxParams = sample.OutboundParamsValidChainID(r)
 	outTxParams.Amount = sdkmath.Uint{}
-	require.ErrorContains(t, outTxParams.Validate(), "amount cannot be nil")
+	require.ErrorContains(t, outTxParams.Validate([]chains.Chain{}), "amount cannot be nil")
 
 	outTxParams = sample.OutboundParamsValidChainID(r)
 	outTxParams.BallotIndex = sample.ZetaIndex(t)
 	outTxParams.Hash = sample.Hash().String()
-	require.NoError(t, outTxParams.Validate())
+	require.NoError(t, outTxParams.Validate([]chains.Chain{}))
 
 	// Disabled checks
 	// TODO: Improve the checks, move the validation call to a new place and reenable
@@ -35,10 +36,10 @@ func TestOutboundParams_Validate(t *testing.T) {
 	// https://github.com/zeta-chain/node/issues/2235
 	//outTxParams = sample.OutboundParamsValidChainID(r)
 	//outTxParams.Receiver = "0x123"
-	//require.ErrorContains(t, outTxParams.Validate(), "invalid address 0x123")
+	//require.ErrorContains(t, outTxParams.Validate([]chains.Chain{}), "invalid address 0x123")
 	//outTxParams = sample.OutboundParamsValidChainID(r)
 	//outTxParams.BallotIndex = "12"
-	//require.ErrorContains(t, outTxParams.Validate(), "invalid index length 2")
+	//require.ErrorContains(t, outTxParams.Validate([]chains.Chain{}), "invalid index length 2")
 }
 
 func TestOutboundTxParams_GetGasPrice(t *testing.T) {
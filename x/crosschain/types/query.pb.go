@@ -742,6 +742,9 @@ func (m *QueryInboundHashToCctxDataRequest) GetInboundHash() string {
 
 type QueryInboundHashToCctxDataResponse struct {
 	CrossChainTxs []CrossChainTx `protobuf:"bytes,1,rep,name=CrossChainTxs,proto3" json:"CrossChainTxs"`
+	// indexes of the cctxs pruned by the retention policy, the cctxs are
+	// available from their EventCCTXArchived event
+	PrunedCctxIndexes []string `protobuf:"bytes,2,rep,name=pruned_cctx_indexes,json=prunedCctxIndexes,proto3" json:"pruned_cctx_indexes,omitempty"`
 }
 
 func (m *QueryInboundHashToCctxDataResponse) Reset()         { *m = QueryInboundHashToCctxDataResponse{} }
@@ -784,6 +787,13 @@ func (m *QueryInboundHashToCctxDataResponse) GetCrossChainTxs() []CrossChainTx {
 	return nil
 }
 
+func (m *QueryInboundHashToCctxDataResponse) GetPrunedCctxIndexes() []string {
+	if m != nil {
+		return m.PrunedCctxIndexes
+	}
+	return nil
+}
+
 type QueryAllInboundHashToCctxRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -880,6 +890,94 @@ func (m *QueryAllInboundHashToCctxResponse) GetPagination() *query.PageResponse
 	return nil
 }
 
+type QueryOutboundHashToCctxDataRequest struct {
+	OutboundHash string `protobuf:"bytes,1,opt,name=outboundHash,proto3" json:"outboundHash,omitempty"`
+}
+
+func (m *QueryOutboundHashToCctxDataRequest) Reset()         { *m = QueryOutboundHashToCctxDataRequest{} }
+func (m *QueryOutboundHashToCctxDataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutboundHashToCctxDataRequest) ProtoMessage()    {}
+func (*QueryOutboundHashToCctxDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{18}
+}
+func (m *QueryOutboundHashToCctxDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutboundHashToCctxDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutboundHashToCctxDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOutboundHashToCctxDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutboundHashToCctxDataRequest.Merge(m, src)
+}
+func (m *QueryOutboundHashToCctxDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutboundHashToCctxDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutboundHashToCctxDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutboundHashToCctxDataRequest proto.InternalMessageInfo
+
+func (m *QueryOutboundHashToCctxDataRequest) GetOutboundHash() string {
+	if m != nil {
+		return m.OutboundHash
+	}
+	return ""
+}
+
+type QueryOutboundHashToCctxDataResponse struct {
+	CrossChainTxs []CrossChainTx `protobuf:"bytes,1,rep,name=CrossChainTxs,proto3" json:"CrossChainTxs"`
+}
+
+func (m *QueryOutboundHashToCctxDataResponse) Reset()         { *m = QueryOutboundHashToCctxDataResponse{} }
+func (m *QueryOutboundHashToCctxDataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutboundHashToCctxDataResponse) ProtoMessage()    {}
+func (*QueryOutboundHashToCctxDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{19}
+}
+func (m *QueryOutboundHashToCctxDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutboundHashToCctxDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutboundHashToCctxDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOutboundHashToCctxDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutboundHashToCctxDataResponse.Merge(m, src)
+}
+func (m *QueryOutboundHashToCctxDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutboundHashToCctxDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutboundHashToCctxDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutboundHashToCctxDataResponse proto.InternalMessageInfo
+
+func (m *QueryOutboundHashToCctxDataResponse) GetCrossChainTxs() []CrossChainTx {
+	if m != nil {
+		return m.CrossChainTxs
+	}
+	return nil
+}
+
 type QueryGetGasPriceRequest struct {
 	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 }
@@ -888,7 +986,7 @@ func (m *QueryGetGasPriceRequest) Reset()         { *m = QueryGetGasPriceRequest
 func (m *QueryGetGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetGasPriceRequest) ProtoMessage()    {}
 func (*QueryGetGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{18}
+	return fileDescriptor_d00cb546ea76908b, []int{20}
 }
 func (m *QueryGetGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -932,7 +1030,7 @@ func (m *QueryGetGasPriceResponse) Reset()         { *m = QueryGetGasPriceRespon
 func (m *QueryGetGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetGasPriceResponse) ProtoMessage()    {}
 func (*QueryGetGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{19}
+	return fileDescriptor_d00cb546ea76908b, []int{21}
 }
 func (m *QueryGetGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -976,7 +1074,7 @@ func (m *QueryAllGasPriceRequest) Reset()         { *m = QueryAllGasPriceRequest
 func (m *QueryAllGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllGasPriceRequest) ProtoMessage()    {}
 func (*QueryAllGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{20}
+	return fileDescriptor_d00cb546ea76908b, []int{22}
 }
 func (m *QueryAllGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1021,7 +1119,7 @@ func (m *QueryAllGasPriceResponse) Reset()         { *m = QueryAllGasPriceRespon
 func (m *QueryAllGasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllGasPriceResponse) ProtoMessage()    {}
 func (*QueryAllGasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{21}
+	return fileDescriptor_d00cb546ea76908b, []int{23}
 }
 func (m *QueryAllGasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1072,7 +1170,7 @@ func (m *QueryGetLastBlockHeightRequest) Reset()         { *m = QueryGetLastBloc
 func (m *QueryGetLastBlockHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetLastBlockHeightRequest) ProtoMessage()    {}
 func (*QueryGetLastBlockHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{22}
+	return fileDescriptor_d00cb546ea76908b, []int{24}
 }
 func (m *QueryGetLastBlockHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1116,7 +1214,7 @@ func (m *QueryGetLastBlockHeightResponse) Reset()         { *m = QueryGetLastBlo
 func (m *QueryGetLastBlockHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetLastBlockHeightResponse) ProtoMessage()    {}
 func (*QueryGetLastBlockHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{23}
+	return fileDescriptor_d00cb546ea76908b, []int{25}
 }
 func (m *QueryGetLastBlockHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1160,7 +1258,7 @@ func (m *QueryAllLastBlockHeightRequest) Reset()         { *m = QueryAllLastBloc
 func (m *QueryAllLastBlockHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllLastBlockHeightRequest) ProtoMessage()    {}
 func (*QueryAllLastBlockHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{24}
+	return fileDescriptor_d00cb546ea76908b, []int{26}
 }
 func (m *QueryAllLastBlockHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1205,7 +1303,7 @@ func (m *QueryAllLastBlockHeightResponse) Reset()         { *m = QueryAllLastBlo
 func (m *QueryAllLastBlockHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllLastBlockHeightResponse) ProtoMessage()    {}
 func (*QueryAllLastBlockHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{25}
+	return fileDescriptor_d00cb546ea76908b, []int{27}
 }
 func (m *QueryAllLastBlockHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1256,7 +1354,7 @@ func (m *QueryGetCctxRequest) Reset()         { *m = QueryGetCctxRequest{} }
 func (m *QueryGetCctxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCctxRequest) ProtoMessage()    {}
 func (*QueryGetCctxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{26}
+	return fileDescriptor_d00cb546ea76908b, []int{28}
 }
 func (m *QueryGetCctxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1301,7 +1399,7 @@ func (m *QueryGetCctxByNonceRequest) Reset()         { *m = QueryGetCctxByNonceR
 func (m *QueryGetCctxByNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCctxByNonceRequest) ProtoMessage()    {}
 func (*QueryGetCctxByNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{27}
+	return fileDescriptor_d00cb546ea76908b, []int{29}
 }
 func (m *QueryGetCctxByNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1352,7 +1450,7 @@ func (m *QueryGetCctxResponse) Reset()         { *m = QueryGetCctxResponse{} }
 func (m *QueryGetCctxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCctxResponse) ProtoMessage()    {}
 func (*QueryGetCctxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{28}
+	return fileDescriptor_d00cb546ea76908b, []int{30}
 }
 func (m *QueryGetCctxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1396,7 +1494,7 @@ func (m *QueryAllCctxRequest) Reset()         { *m = QueryAllCctxRequest{} }
 func (m *QueryAllCctxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllCctxRequest) ProtoMessage()    {}
 func (*QueryAllCctxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{29}
+	return fileDescriptor_d00cb546ea76908b, []int{31}
 }
 func (m *QueryAllCctxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1441,7 +1539,7 @@ func (m *QueryAllCctxResponse) Reset()         { *m = QueryAllCctxResponse{} }
 func (m *QueryAllCctxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllCctxResponse) ProtoMessage()    {}
 func (*QueryAllCctxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{30}
+	return fileDescriptor_d00cb546ea76908b, []int{32}
 }
 func (m *QueryAllCctxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1484,6 +1582,112 @@ func (m *QueryAllCctxResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAllCctxByFilterRequest combines the filters of the cctxs, a zero value
+// matches all the cctxs
+type QueryAllCctxByFilterRequest struct {
+	// sender of the inbound
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// receiver of the first outbound
+	Receiver      string       `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Statuses      []CctxStatus `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=zetachain.zetacore.crosschain.CctxStatus" json:"statuses,omitempty"`
+	SenderChainId int64        `protobuf:"varint,4,opt,name=sender_chain_id,json=senderChainId,proto3" json:"sender_chain_id,omitempty"`
+	// receiver chain of the first outbound
+	ReceiverChainId int64 `protobuf:"varint,5,opt,name=receiver_chain_id,json=receiverChainId,proto3" json:"receiver_chain_id,omitempty"`
+	// range of the zeta heights the cctxs were created at
+	MinHeight  uint64             `protobuf:"varint,6,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	MaxHeight  uint64             `protobuf:"varint,7,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllCctxByFilterRequest) Reset()         { *m = QueryAllCctxByFilterRequest{} }
+func (m *QueryAllCctxByFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllCctxByFilterRequest) ProtoMessage()    {}
+func (*QueryAllCctxByFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{33}
+}
+func (m *QueryAllCctxByFilterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllCctxByFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllCctxByFilterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllCctxByFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllCctxByFilterRequest.Merge(m, src)
+}
+func (m *QueryAllCctxByFilterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllCctxByFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllCctxByFilterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllCctxByFilterRequest proto.InternalMessageInfo
+
+func (m *QueryAllCctxByFilterRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QueryAllCctxByFilterRequest) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *QueryAllCctxByFilterRequest) GetStatuses() []CctxStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+func (m *QueryAllCctxByFilterRequest) GetSenderChainId() int64 {
+	if m != nil {
+		return m.SenderChainId
+	}
+	return 0
+}
+
+func (m *QueryAllCctxByFilterRequest) GetReceiverChainId() int64 {
+	if m != nil {
+		return m.ReceiverChainId
+	}
+	return 0
+}
+
+func (m *QueryAllCctxByFilterRequest) GetMinHeight() uint64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
+}
+
+func (m *QueryAllCctxByFilterRequest) GetMaxHeight() uint64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
+}
+
+func (m *QueryAllCctxByFilterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryListPendingCctxRequest struct {
 	ChainId int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	Limit   uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -1493,7 +1697,7 @@ func (m *QueryListPendingCctxRequest) Reset()         { *m = QueryListPendingCct
 func (m *QueryListPendingCctxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryListPendingCctxRequest) ProtoMessage()    {}
 func (*QueryListPendingCctxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{31}
+	return fileDescriptor_d00cb546ea76908b, []int{34}
 }
 func (m *QueryListPendingCctxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1545,7 +1749,7 @@ func (m *QueryListPendingCctxResponse) Reset()         { *m = QueryListPendingCc
 func (m *QueryListPendingCctxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryListPendingCctxResponse) ProtoMessage()    {}
 func (*QueryListPendingCctxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{32}
+	return fileDescriptor_d00cb546ea76908b, []int{35}
 }
 func (m *QueryListPendingCctxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1597,7 +1801,7 @@ func (m *QueryRateLimiterInputRequest) Reset()         { *m = QueryRateLimiterIn
 func (m *QueryRateLimiterInputRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterInputRequest) ProtoMessage()    {}
 func (*QueryRateLimiterInputRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{33}
+	return fileDescriptor_d00cb546ea76908b, []int{36}
 }
 func (m *QueryRateLimiterInputRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1641,20 +1845,21 @@ func (m *QueryRateLimiterInputRequest) GetWindow() int64 {
 }
 
 type QueryRateLimiterInputResponse struct {
-	Height                  int64           `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	CctxsMissed             []*CrossChainTx `protobuf:"bytes,2,rep,name=cctxs_missed,json=cctxsMissed,proto3" json:"cctxs_missed,omitempty"`
-	CctxsPending            []*CrossChainTx `protobuf:"bytes,3,rep,name=cctxs_pending,json=cctxsPending,proto3" json:"cctxs_pending,omitempty"`
-	TotalPending            uint64          `protobuf:"varint,4,opt,name=total_pending,json=totalPending,proto3" json:"total_pending,omitempty"`
-	PastCctxsValue          string          `protobuf:"bytes,5,opt,name=past_cctxs_value,json=pastCctxsValue,proto3" json:"past_cctxs_value,omitempty"`
-	PendingCctxsValue       string          `protobuf:"bytes,6,opt,name=pending_cctxs_value,json=pendingCctxsValue,proto3" json:"pending_cctxs_value,omitempty"`
-	LowestPendingCctxHeight int64           `protobuf:"varint,7,opt,name=lowest_pending_cctx_height,json=lowestPendingCctxHeight,proto3" json:"lowest_pending_cctx_height,omitempty"`
+	Height                  int64                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	CctxsMissed             []*CrossChainTx        `protobuf:"bytes,2,rep,name=cctxs_missed,json=cctxsMissed,proto3" json:"cctxs_missed,omitempty"`
+	CctxsPending            []*CrossChainTx        `protobuf:"bytes,3,rep,name=cctxs_pending,json=cctxsPending,proto3" json:"cctxs_pending,omitempty"`
+	TotalPending            uint64                 `protobuf:"varint,4,opt,name=total_pending,json=totalPending,proto3" json:"total_pending,omitempty"`
+	PastCctxsValue          string                 `protobuf:"bytes,5,opt,name=past_cctxs_value,json=pastCctxsValue,proto3" json:"past_cctxs_value,omitempty"`
+	PendingCctxsValue       string                 `protobuf:"bytes,6,opt,name=pending_cctxs_value,json=pendingCctxsValue,proto3" json:"pending_cctxs_value,omitempty"`
+	LowestPendingCctxHeight int64                  `protobuf:"varint,7,opt,name=lowest_pending_cctx_height,json=lowestPendingCctxHeight,proto3" json:"lowest_pending_cctx_height,omitempty"`
+	BucketUsages            []RateLimitBucketUsage `protobuf:"bytes,8,rep,name=bucket_usages,json=bucketUsages,proto3" json:"bucket_usages"`
 }
 
 func (m *QueryRateLimiterInputResponse) Reset()         { *m = QueryRateLimiterInputResponse{} }
 func (m *QueryRateLimiterInputResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterInputResponse) ProtoMessage()    {}
 func (*QueryRateLimiterInputResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{34}
+	return fileDescriptor_d00cb546ea76908b, []int{37}
 }
 func (m *QueryRateLimiterInputResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1732,26 +1937,28 @@ func (m *QueryRateLimiterInputResponse) GetLowestPendingCctxHeight() int64 {
 	return 0
 }
 
-type QueryListPendingCctxWithinRateLimitRequest struct {
-	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+func (m *QueryRateLimiterInputResponse) GetBucketUsages() []RateLimitBucketUsage {
+	if m != nil {
+		return m.BucketUsages
+	}
+	return nil
 }
 
-func (m *QueryListPendingCctxWithinRateLimitRequest) Reset() {
-	*m = QueryListPendingCctxWithinRateLimitRequest{}
-}
-func (m *QueryListPendingCctxWithinRateLimitRequest) String() string {
-	return proto.CompactTextString(m)
+type QueryRateLimiterUsageRequest struct {
 }
-func (*QueryListPendingCctxWithinRateLimitRequest) ProtoMessage() {}
-func (*QueryListPendingCctxWithinRateLimitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{35}
+
+func (m *QueryRateLimiterUsageRequest) Reset()         { *m = QueryRateLimiterUsageRequest{} }
+func (m *QueryRateLimiterUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimiterUsageRequest) ProtoMessage()    {}
+func (*QueryRateLimiterUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{38}
 }
-func (m *QueryListPendingCctxWithinRateLimitRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRateLimiterUsageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListPendingCctxWithinRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRateLimiterUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListPendingCctxWithinRateLimitRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRateLimiterUsageRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1761,49 +1968,40 @@ func (m *QueryListPendingCctxWithinRateLimitRequest) XXX_Marshal(b []byte, deter
 		return b[:n], nil
 	}
 }
-func (m *QueryListPendingCctxWithinRateLimitRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListPendingCctxWithinRateLimitRequest.Merge(m, src)
+func (m *QueryRateLimiterUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimiterUsageRequest.Merge(m, src)
 }
-func (m *QueryListPendingCctxWithinRateLimitRequest) XXX_Size() int {
+func (m *QueryRateLimiterUsageRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListPendingCctxWithinRateLimitRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListPendingCctxWithinRateLimitRequest.DiscardUnknown(m)
+func (m *QueryRateLimiterUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimiterUsageRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListPendingCctxWithinRateLimitRequest proto.InternalMessageInfo
-
-func (m *QueryListPendingCctxWithinRateLimitRequest) GetLimit() uint32 {
-	if m != nil {
-		return m.Limit
-	}
-	return 0
-}
+var xxx_messageInfo_QueryRateLimiterUsageRequest proto.InternalMessageInfo
 
-type QueryListPendingCctxWithinRateLimitResponse struct {
-	CrossChainTx          []*CrossChainTx `protobuf:"bytes,1,rep,name=cross_chain_tx,json=crossChainTx,proto3" json:"cross_chain_tx,omitempty"`
-	TotalPending          uint64          `protobuf:"varint,2,opt,name=total_pending,json=totalPending,proto3" json:"total_pending,omitempty"`
-	CurrentWithdrawWindow int64           `protobuf:"varint,3,opt,name=current_withdraw_window,json=currentWithdrawWindow,proto3" json:"current_withdraw_window,omitempty"`
-	CurrentWithdrawRate   string          `protobuf:"bytes,4,opt,name=current_withdraw_rate,json=currentWithdrawRate,proto3" json:"current_withdraw_rate,omitempty"`
-	RateLimitExceeded     bool            `protobuf:"varint,5,opt,name=rate_limit_exceeded,json=rateLimitExceeded,proto3" json:"rate_limit_exceeded,omitempty"`
+// RateLimitBucketUtilization is the current utilization of a rate limit bucket
+type RateLimitBucketUtilization struct {
+	Bucket                RateLimitBucket `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket"`
+	CurrentWithdrawWindow int64           `protobuf:"varint,2,opt,name=current_withdraw_window,json=currentWithdrawWindow,proto3" json:"current_withdraw_window,omitempty"`
+	CurrentWithdrawLimit  string          `protobuf:"bytes,3,opt,name=current_withdraw_limit,json=currentWithdrawLimit,proto3" json:"current_withdraw_limit,omitempty"`
+	CurrentWithdrawValue  string          `protobuf:"bytes,4,opt,name=current_withdraw_value,json=currentWithdrawValue,proto3" json:"current_withdraw_value,omitempty"`
+	CurrentWithdrawRate   string          `protobuf:"bytes,5,opt,name=current_withdraw_rate,json=currentWithdrawRate,proto3" json:"current_withdraw_rate,omitempty"`
+	RateLimitExceeded     bool            `protobuf:"varint,6,opt,name=rate_limit_exceeded,json=rateLimitExceeded,proto3" json:"rate_limit_exceeded,omitempty"`
 }
 
-func (m *QueryListPendingCctxWithinRateLimitResponse) Reset() {
-	*m = QueryListPendingCctxWithinRateLimitResponse{}
-}
-func (m *QueryListPendingCctxWithinRateLimitResponse) String() string {
-	return proto.CompactTextString(m)
-}
-func (*QueryListPendingCctxWithinRateLimitResponse) ProtoMessage() {}
-func (*QueryListPendingCctxWithinRateLimitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{36}
+func (m *RateLimitBucketUtilization) Reset()         { *m = RateLimitBucketUtilization{} }
+func (m *RateLimitBucketUtilization) String() string { return proto.CompactTextString(m) }
+func (*RateLimitBucketUtilization) ProtoMessage()    {}
+func (*RateLimitBucketUtilization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{39}
 }
-func (m *QueryListPendingCctxWithinRateLimitResponse) XXX_Unmarshal(b []byte) error {
+func (m *RateLimitBucketUtilization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryListPendingCctxWithinRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RateLimitBucketUtilization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryListPendingCctxWithinRateLimitResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RateLimitBucketUtilization.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1813,68 +2011,77 @@ func (m *QueryListPendingCctxWithinRateLimitResponse) XXX_Marshal(b []byte, dete
 		return b[:n], nil
 	}
 }
-func (m *QueryListPendingCctxWithinRateLimitResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryListPendingCctxWithinRateLimitResponse.Merge(m, src)
+func (m *RateLimitBucketUtilization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitBucketUtilization.Merge(m, src)
 }
-func (m *QueryListPendingCctxWithinRateLimitResponse) XXX_Size() int {
+func (m *RateLimitBucketUtilization) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryListPendingCctxWithinRateLimitResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryListPendingCctxWithinRateLimitResponse.DiscardUnknown(m)
+func (m *RateLimitBucketUtilization) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitBucketUtilization.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryListPendingCctxWithinRateLimitResponse proto.InternalMessageInfo
+var xxx_messageInfo_RateLimitBucketUtilization proto.InternalMessageInfo
 
-func (m *QueryListPendingCctxWithinRateLimitResponse) GetCrossChainTx() []*CrossChainTx {
+func (m *RateLimitBucketUtilization) GetBucket() RateLimitBucket {
 	if m != nil {
-		return m.CrossChainTx
+		return m.Bucket
 	}
-	return nil
+	return RateLimitBucket{}
 }
 
-func (m *QueryListPendingCctxWithinRateLimitResponse) GetTotalPending() uint64 {
+func (m *RateLimitBucketUtilization) GetCurrentWithdrawWindow() int64 {
 	if m != nil {
-		return m.TotalPending
+		return m.CurrentWithdrawWindow
 	}
 	return 0
 }
 
-func (m *QueryListPendingCctxWithinRateLimitResponse) GetCurrentWithdrawWindow() int64 {
+func (m *RateLimitBucketUtilization) GetCurrentWithdrawLimit() string {
 	if m != nil {
-		return m.CurrentWithdrawWindow
+		return m.CurrentWithdrawLimit
 	}
-	return 0
+	return ""
 }
 
-func (m *QueryListPendingCctxWithinRateLimitResponse) GetCurrentWithdrawRate() string {
+func (m *RateLimitBucketUtilization) GetCurrentWithdrawValue() string {
+	if m != nil {
+		return m.CurrentWithdrawValue
+	}
+	return ""
+}
+
+func (m *RateLimitBucketUtilization) GetCurrentWithdrawRate() string {
 	if m != nil {
 		return m.CurrentWithdrawRate
 	}
 	return ""
 }
 
-func (m *QueryListPendingCctxWithinRateLimitResponse) GetRateLimitExceeded() bool {
+func (m *RateLimitBucketUtilization) GetRateLimitExceeded() bool {
 	if m != nil {
 		return m.RateLimitExceeded
 	}
 	return false
 }
 
-type QueryLastZetaHeightRequest struct {
+type QueryRateLimiterUsageResponse struct {
+	Height  int64                        `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Buckets []RateLimitBucketUtilization `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets"`
 }
 
-func (m *QueryLastZetaHeightRequest) Reset()         { *m = QueryLastZetaHeightRequest{} }
-func (m *QueryLastZetaHeightRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLastZetaHeightRequest) ProtoMessage()    {}
-func (*QueryLastZetaHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{37}
+func (m *QueryRateLimiterUsageResponse) Reset()         { *m = QueryRateLimiterUsageResponse{} }
+func (m *QueryRateLimiterUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimiterUsageResponse) ProtoMessage()    {}
+func (*QueryRateLimiterUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{40}
 }
-func (m *QueryLastZetaHeightRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRateLimiterUsageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLastZetaHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRateLimiterUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLastZetaHeightRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRateLimiterUsageResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1884,27 +2091,205 @@ func (m *QueryLastZetaHeightRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryLastZetaHeightRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLastZetaHeightRequest.Merge(m, src)
+func (m *QueryRateLimiterUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimiterUsageResponse.Merge(m, src)
 }
-func (m *QueryLastZetaHeightRequest) XXX_Size() int {
+func (m *QueryRateLimiterUsageResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLastZetaHeightRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLastZetaHeightRequest.DiscardUnknown(m)
+func (m *QueryRateLimiterUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimiterUsageResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLastZetaHeightRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRateLimiterUsageResponse proto.InternalMessageInfo
 
-type QueryLastZetaHeightResponse struct {
-	Height int64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+func (m *QueryRateLimiterUsageResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *QueryLastZetaHeightResponse) Reset()         { *m = QueryLastZetaHeightResponse{} }
-func (m *QueryLastZetaHeightResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLastZetaHeightResponse) ProtoMessage()    {}
-func (*QueryLastZetaHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{38}
+func (m *QueryRateLimiterUsageResponse) GetBuckets() []RateLimitBucketUtilization {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type QueryListPendingCctxWithinRateLimitRequest struct {
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryListPendingCctxWithinRateLimitRequest) Reset() {
+	*m = QueryListPendingCctxWithinRateLimitRequest{}
+}
+func (m *QueryListPendingCctxWithinRateLimitRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryListPendingCctxWithinRateLimitRequest) ProtoMessage() {}
+func (*QueryListPendingCctxWithinRateLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{41}
+}
+func (m *QueryListPendingCctxWithinRateLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListPendingCctxWithinRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListPendingCctxWithinRateLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListPendingCctxWithinRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListPendingCctxWithinRateLimitRequest.Merge(m, src)
+}
+func (m *QueryListPendingCctxWithinRateLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListPendingCctxWithinRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListPendingCctxWithinRateLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListPendingCctxWithinRateLimitRequest proto.InternalMessageInfo
+
+func (m *QueryListPendingCctxWithinRateLimitRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type QueryListPendingCctxWithinRateLimitResponse struct {
+	CrossChainTx          []*CrossChainTx `protobuf:"bytes,1,rep,name=cross_chain_tx,json=crossChainTx,proto3" json:"cross_chain_tx,omitempty"`
+	TotalPending          uint64          `protobuf:"varint,2,opt,name=total_pending,json=totalPending,proto3" json:"total_pending,omitempty"`
+	CurrentWithdrawWindow int64           `protobuf:"varint,3,opt,name=current_withdraw_window,json=currentWithdrawWindow,proto3" json:"current_withdraw_window,omitempty"`
+	CurrentWithdrawRate   string          `protobuf:"bytes,4,opt,name=current_withdraw_rate,json=currentWithdrawRate,proto3" json:"current_withdraw_rate,omitempty"`
+	RateLimitExceeded     bool            `protobuf:"varint,5,opt,name=rate_limit_exceeded,json=rateLimitExceeded,proto3" json:"rate_limit_exceeded,omitempty"`
+}
+
+func (m *QueryListPendingCctxWithinRateLimitResponse) Reset() {
+	*m = QueryListPendingCctxWithinRateLimitResponse{}
+}
+func (m *QueryListPendingCctxWithinRateLimitResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryListPendingCctxWithinRateLimitResponse) ProtoMessage() {}
+func (*QueryListPendingCctxWithinRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{42}
+}
+func (m *QueryListPendingCctxWithinRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListPendingCctxWithinRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListPendingCctxWithinRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListPendingCctxWithinRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListPendingCctxWithinRateLimitResponse.Merge(m, src)
+}
+func (m *QueryListPendingCctxWithinRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListPendingCctxWithinRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListPendingCctxWithinRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListPendingCctxWithinRateLimitResponse proto.InternalMessageInfo
+
+func (m *QueryListPendingCctxWithinRateLimitResponse) GetCrossChainTx() []*CrossChainTx {
+	if m != nil {
+		return m.CrossChainTx
+	}
+	return nil
+}
+
+func (m *QueryListPendingCctxWithinRateLimitResponse) GetTotalPending() uint64 {
+	if m != nil {
+		return m.TotalPending
+	}
+	return 0
+}
+
+func (m *QueryListPendingCctxWithinRateLimitResponse) GetCurrentWithdrawWindow() int64 {
+	if m != nil {
+		return m.CurrentWithdrawWindow
+	}
+	return 0
+}
+
+func (m *QueryListPendingCctxWithinRateLimitResponse) GetCurrentWithdrawRate() string {
+	if m != nil {
+		return m.CurrentWithdrawRate
+	}
+	return ""
+}
+
+func (m *QueryListPendingCctxWithinRateLimitResponse) GetRateLimitExceeded() bool {
+	if m != nil {
+		return m.RateLimitExceeded
+	}
+	return false
+}
+
+type QueryLastZetaHeightRequest struct {
+}
+
+func (m *QueryLastZetaHeightRequest) Reset()         { *m = QueryLastZetaHeightRequest{} }
+func (m *QueryLastZetaHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastZetaHeightRequest) ProtoMessage()    {}
+func (*QueryLastZetaHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{43}
+}
+func (m *QueryLastZetaHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastZetaHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastZetaHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastZetaHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastZetaHeightRequest.Merge(m, src)
+}
+func (m *QueryLastZetaHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastZetaHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastZetaHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastZetaHeightRequest proto.InternalMessageInfo
+
+type QueryLastZetaHeightResponse struct {
+	Height int64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+}
+
+func (m *QueryLastZetaHeightResponse) Reset()         { *m = QueryLastZetaHeightResponse{} }
+func (m *QueryLastZetaHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastZetaHeightResponse) ProtoMessage()    {}
+func (*QueryLastZetaHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{44}
 }
 func (m *QueryLastZetaHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1949,7 +2334,7 @@ func (m *QueryConvertGasToZetaRequest) Reset()         { *m = QueryConvertGasToZ
 func (m *QueryConvertGasToZetaRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryConvertGasToZetaRequest) ProtoMessage()    {}
 func (*QueryConvertGasToZetaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{39}
+	return fileDescriptor_d00cb546ea76908b, []int{45}
 }
 func (m *QueryConvertGasToZetaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2002,7 +2387,7 @@ func (m *QueryConvertGasToZetaResponse) Reset()         { *m = QueryConvertGasTo
 func (m *QueryConvertGasToZetaResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConvertGasToZetaResponse) ProtoMessage()    {}
 func (*QueryConvertGasToZetaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{40}
+	return fileDescriptor_d00cb546ea76908b, []int{46}
 }
 func (m *QueryConvertGasToZetaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2059,7 +2444,7 @@ func (m *QueryMessagePassingProtocolFeeRequest) Reset()         { *m = QueryMess
 func (m *QueryMessagePassingProtocolFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMessagePassingProtocolFeeRequest) ProtoMessage()    {}
 func (*QueryMessagePassingProtocolFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{41}
+	return fileDescriptor_d00cb546ea76908b, []int{47}
 }
 func (m *QueryMessagePassingProtocolFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2098,7 +2483,7 @@ func (m *QueryMessagePassingProtocolFeeResponse) Reset() {
 func (m *QueryMessagePassingProtocolFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMessagePassingProtocolFeeResponse) ProtoMessage()    {}
 func (*QueryMessagePassingProtocolFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{42}
+	return fileDescriptor_d00cb546ea76908b, []int{48}
 }
 func (m *QueryMessagePassingProtocolFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2141,7 +2526,7 @@ func (m *QueryRateLimiterFlagsRequest) Reset()         { *m = QueryRateLimiterFl
 func (m *QueryRateLimiterFlagsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterFlagsRequest) ProtoMessage()    {}
 func (*QueryRateLimiterFlagsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{43}
+	return fileDescriptor_d00cb546ea76908b, []int{49}
 }
 func (m *QueryRateLimiterFlagsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2178,7 +2563,7 @@ func (m *QueryRateLimiterFlagsResponse) Reset()         { *m = QueryRateLimiterF
 func (m *QueryRateLimiterFlagsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterFlagsResponse) ProtoMessage()    {}
 func (*QueryRateLimiterFlagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{44}
+	return fileDescriptor_d00cb546ea76908b, []int{50}
 }
 func (m *QueryRateLimiterFlagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2214,6 +2599,86 @@ func (m *QueryRateLimiterFlagsResponse) GetRateLimiterFlags() RateLimiterFlags {
 	return RateLimiterFlags{}
 }
 
+type QueryCctxRetentionPolicyRequest struct {
+}
+
+func (m *QueryCctxRetentionPolicyRequest) Reset()         { *m = QueryCctxRetentionPolicyRequest{} }
+func (m *QueryCctxRetentionPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCctxRetentionPolicyRequest) ProtoMessage()    {}
+func (*QueryCctxRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{51}
+}
+func (m *QueryCctxRetentionPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCctxRetentionPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCctxRetentionPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCctxRetentionPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCctxRetentionPolicyRequest.Merge(m, src)
+}
+func (m *QueryCctxRetentionPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCctxRetentionPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCctxRetentionPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCctxRetentionPolicyRequest proto.InternalMessageInfo
+
+type QueryCctxRetentionPolicyResponse struct {
+	CctxRetentionPolicy CctxRetentionPolicy `protobuf:"bytes,1,opt,name=cctx_retention_policy,json=cctxRetentionPolicy,proto3" json:"cctx_retention_policy"`
+}
+
+func (m *QueryCctxRetentionPolicyResponse) Reset()         { *m = QueryCctxRetentionPolicyResponse{} }
+func (m *QueryCctxRetentionPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCctxRetentionPolicyResponse) ProtoMessage()    {}
+func (*QueryCctxRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{52}
+}
+func (m *QueryCctxRetentionPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCctxRetentionPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCctxRetentionPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCctxRetentionPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCctxRetentionPolicyResponse.Merge(m, src)
+}
+func (m *QueryCctxRetentionPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCctxRetentionPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCctxRetentionPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCctxRetentionPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryCctxRetentionPolicyResponse) GetCctxRetentionPolicy() CctxRetentionPolicy {
+	if m != nil {
+		return m.CctxRetentionPolicy
+	}
+	return CctxRetentionPolicy{}
+}
+
 func init() {
 	proto.RegisterType((*QueryZetaAccountingRequest)(nil), "zetachain.zetacore.crosschain.QueryZetaAccountingRequest")
 	proto.RegisterType((*QueryZetaAccountingResponse)(nil), "zetachain.zetacore.crosschain.QueryZetaAccountingResponse")
@@ -2233,6 +2698,8 @@ func init() {
 	proto.RegisterType((*QueryInboundHashToCctxDataResponse)(nil), "zetachain.zetacore.crosschain.QueryInboundHashToCctxDataResponse")
 	proto.RegisterType((*QueryAllInboundHashToCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInboundHashToCctxRequest")
 	proto.RegisterType((*QueryAllInboundHashToCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryAllInboundHashToCctxResponse")
+	proto.RegisterType((*QueryOutboundHashToCctxDataRequest)(nil), "zetachain.zetacore.crosschain.QueryOutboundHashToCctxDataRequest")
+	proto.RegisterType((*QueryOutboundHashToCctxDataResponse)(nil), "zetachain.zetacore.crosschain.QueryOutboundHashToCctxDataResponse")
 	proto.RegisterType((*QueryGetGasPriceRequest)(nil), "zetachain.zetacore.crosschain.QueryGetGasPriceRequest")
 	proto.RegisterType((*QueryGetGasPriceResponse)(nil), "zetachain.zetacore.crosschain.QueryGetGasPriceResponse")
 	proto.RegisterType((*QueryAllGasPriceRequest)(nil), "zetachain.zetacore.crosschain.QueryAllGasPriceRequest")
@@ -2246,10 +2713,14 @@ func init() {
 	proto.RegisterType((*QueryGetCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryGetCctxResponse")
 	proto.RegisterType((*QueryAllCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxRequest")
 	proto.RegisterType((*QueryAllCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryAllCctxResponse")
+	proto.RegisterType((*QueryAllCctxByFilterRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxByFilterRequest")
 	proto.RegisterType((*QueryListPendingCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryListPendingCctxRequest")
 	proto.RegisterType((*QueryListPendingCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryListPendingCctxResponse")
 	proto.RegisterType((*QueryRateLimiterInputRequest)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterInputRequest")
 	proto.RegisterType((*QueryRateLimiterInputResponse)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterInputResponse")
+	proto.RegisterType((*QueryRateLimiterUsageRequest)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterUsageRequest")
+	proto.RegisterType((*RateLimitBucketUtilization)(nil), "zetachain.zetacore.crosschain.RateLimitBucketUtilization")
+	proto.RegisterType((*QueryRateLimiterUsageResponse)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterUsageResponse")
 	proto.RegisterType((*QueryListPendingCctxWithinRateLimitRequest)(nil), "zetachain.zetacore.crosschain.QueryListPendingCctxWithinRateLimitRequest")
 	proto.RegisterType((*QueryListPendingCctxWithinRateLimitResponse)(nil), "zetachain.zetacore.crosschain.QueryListPendingCctxWithinRateLimitResponse")
 	proto.RegisterType((*QueryLastZetaHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryLastZetaHeightRequest")
@@ -2260,6 +2731,8 @@ func init() {
 	proto.RegisterType((*QueryMessagePassingProtocolFeeResponse)(nil), "zetachain.zetacore.crosschain.QueryMessagePassingProtocolFeeResponse")
 	proto.RegisterType((*QueryRateLimiterFlagsRequest)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterFlagsRequest")
 	proto.RegisterType((*QueryRateLimiterFlagsResponse)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterFlagsResponse")
+	proto.RegisterType((*QueryCctxRetentionPolicyRequest)(nil), "zetachain.zetacore.crosschain.QueryCctxRetentionPolicyRequest")
+	proto.RegisterType((*QueryCctxRetentionPolicyResponse)(nil), "zetachain.zetacore.crosschain.QueryCctxRetentionPolicyResponse")
 }
 
 func init() {
@@ -2267,150 +2740,179 @@ func init() {
 }
 
 var fileDescriptor_d00cb546ea76908b = []byte{
-	// 2277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0x14, 0xc9,
-	0x15, 0xa7, 0x3c, 0x98, 0x85, 0x67, 0x30, 0xb8, 0x30, 0xe0, 0x9d, 0x05, 0xc3, 0x36, 0x0b, 0xf6,
-	0x42, 0x3c, 0x03, 0x36, 0x36, 0x60, 0xd8, 0x65, 0xfd, 0xc1, 0x87, 0x13, 0x03, 0xde, 0x91, 0x15,
-	0x22, 0xa2, 0xa4, 0x55, 0xee, 0xa9, 0xed, 0xe9, 0x6c, 0xbb, 0x7b, 0x76, 0xba, 0x07, 0x9b, 0x45,
-	0x3e, 0x64, 0xa5, 0x1c, 0x72, 0x8b, 0xb4, 0x8a, 0x72, 0xc9, 0x35, 0x4a, 0x0e, 0x39, 0xe4, 0x10,
-	0xed, 0x25, 0x4a, 0xa4, 0x7c, 0xa3, 0x7c, 0x48, 0x64, 0x23, 0xad, 0xa2, 0x1c, 0xa2, 0x0d, 0x44,
-	0xc9, 0x3d, 0x7f, 0x41, 0xd4, 0xd5, 0xaf, 0x67, 0xfa, 0xbb, 0x7b, 0xc6, 0xb3, 0x8a, 0xf7, 0xe4,
-	0xe9, 0xae, 0x7a, 0xaf, 0x7e, 0xbf, 0xf7, 0x5e, 0x55, 0xbd, 0xfe, 0xc9, 0xf0, 0xfa, 0xfb, 0xdc,
-	0x66, 0x4a, 0x8d, 0x69, 0x46, 0x59, 0xfc, 0x32, 0x1b, 0xbc, 0xac, 0x34, 0x4c, 0xcb, 0x72, 0xdf,
-	0xbd, 0xd7, 0xe4, 0x8d, 0xc7, 0xa5, 0x7a, 0xc3, 0xb4, 0x4d, 0x7a, 0xa2, 0x35, 0xb5, 0xe4, 0x4d,
-	0x2d, 0xb5, 0xa7, 0x16, 0xcf, 0x29, 0xa6, 0xb5, 0x6e, 0x5a, 0xe5, 0x35, 0x66, 0x71, 0xd7, 0xae,
-	0xfc, 0xe8, 0xe2, 0x1a, 0xb7, 0xd9, 0xc5, 0x72, 0x9d, 0xa9, 0x9a, 0xc1, 0x6c, 0xcd, 0x34, 0x5c,
-	0x57, 0xc5, 0xc9, 0xf4, 0x55, 0xc5, 0x4f, 0x59, 0xfc, 0x96, 0xed, 0x4d, 0xb4, 0x99, 0x48, 0xb7,
-	0x51, 0x99, 0x25, 0xd7, 0x1b, 0x9a, 0xc2, 0x71, 0xfa, 0x95, 0xf4, 0xe9, 0x9a, 0xb1, 0x66, 0x36,
-	0x8d, 0xaa, 0x5c, 0x63, 0x56, 0x4d, 0xb6, 0x4d, 0x59, 0x51, 0x5a, 0x0b, 0x4d, 0xe5, 0xb3, 0xb4,
-	0x1b, 0x4c, 0x79, 0x97, 0x37, 0xd0, 0x68, 0x3a, 0xdd, 0x48, 0x67, 0x96, 0x2d, 0xaf, 0xe9, 0xa6,
-	0xf2, 0xae, 0x5c, 0xe3, 0x9a, 0x5a, 0xb3, 0xd1, 0xec, 0x52, 0xba, 0x99, 0xd9, 0xb4, 0xe3, 0x16,
-	0x9b, 0x49, 0xb7, 0x6a, 0x30, 0x9b, 0xcb, 0xba, 0xb6, 0xae, 0xd9, 0xbc, 0x21, 0xbf, 0xa3, 0x33,
-	0xd5, 0x42, 0xbb, 0x61, 0xd5, 0x54, 0x4d, 0xf1, 0xb3, 0xec, 0xfc, 0xc2, 0xb7, 0xc7, 0x55, 0xd3,
-	0x54, 0x75, 0x5e, 0x66, 0x75, 0xad, 0xcc, 0x0c, 0xc3, 0xb4, 0x45, 0xa6, 0xd0, 0x46, 0x3a, 0x0e,
-	0xc5, 0xb7, 0x9d, 0x64, 0x3e, 0xe4, 0x36, 0x9b, 0x53, 0x14, 0xb3, 0x69, 0xd8, 0x9a, 0xa1, 0x56,
-	0xf8, 0x7b, 0x4d, 0x6e, 0xd9, 0xd2, 0x5d, 0x78, 0x25, 0x76, 0xd4, 0xaa, 0x9b, 0x86, 0xc5, 0x69,
-	0x09, 0x0e, 0xb3, 0x35, 0xb3, 0x61, 0xf3, 0xaa, 0xec, 0x00, 0x95, 0xd9, 0xba, 0x33, 0x63, 0x84,
-	0x9c, 0x22, 0xe3, 0xfb, 0x2a, 0x43, 0x38, 0x24, 0x6c, 0xc5, 0x80, 0xb4, 0x02, 0xa3, 0xc2, 0xdd,
-	0x6d, 0x6e, 0xdf, 0x47, 0xea, 0xab, 0x2e, 0x73, 0x5c, 0x90, 0x8e, 0xc0, 0x4b, 0x82, 0xe4, 0xd2,
-	0xa2, 0xf0, 0x52, 0xa8, 0x78, 0x8f, 0x74, 0x18, 0xfa, 0x0d, 0xd3, 0x50, 0xf8, 0x48, 0xdf, 0x29,
-	0x32, 0xbe, 0xbb, 0xe2, 0x3e, 0x48, 0xdf, 0x24, 0x70, 0x32, 0xd1, 0x25, 0xa2, 0xfc, 0x3a, 0x1c,
-	0x34, 0x83, 0x43, 0xc2, 0xf7, 0xc0, 0x64, 0xa9, 0x94, 0x5a, 0xf2, 0xa5, 0x90, 0xc3, 0xf9, 0xdd,
-	0x4f, 0xff, 0x71, 0x72, 0x57, 0x25, 0xec, 0x4c, 0xaa, 0x21, 0xab, 0x39, 0x5d, 0x4f, 0x60, 0x75,
-	0x0b, 0xa0, 0xbd, 0x47, 0x70, 0xf1, 0xb3, 0x25, 0x77, 0x43, 0x95, 0x9c, 0x0d, 0x55, 0x72, 0x37,
-	0x22, 0x6e, 0xa8, 0xd2, 0x0a, 0x53, 0x39, 0xda, 0x56, 0x7c, 0x96, 0xd2, 0x1f, 0x3c, 0xb6, 0x71,
-	0x4b, 0xa5, 0xb1, 0x2d, 0xf4, 0x8c, 0x2d, 0xbd, 0x1d, 0xe0, 0xd2, 0x27, 0xb8, 0x8c, 0x65, 0x72,
-	0x71, 0xc1, 0x05, 0xc8, 0x7c, 0x8b, 0xc0, 0x99, 0x04, 0x32, 0xf3, 0x8f, 0x17, 0x1c, 0x48, 0x5e,
-	0xf8, 0x86, 0xa1, 0x5f, 0x40, 0xc4, 0x92, 0x70, 0x1f, 0x42, 0x41, 0xed, 0xeb, 0x3a, 0xa8, 0x7f,
-	0x21, 0x70, 0x36, 0x0b, 0xc7, 0xe7, 0x2d, 0xb6, 0xdf, 0x26, 0xf0, 0x9a, 0xc7, 0x69, 0xc9, 0x48,
-	0x09, 0xed, 0xcb, 0xb0, 0xd7, 0x3d, 0x87, 0xb5, 0x6a, 0x70, 0xc3, 0x55, 0x7b, 0x16, 0xdf, 0x3f,
-	0xfb, 0xf2, 0x9c, 0x80, 0x05, 0xc3, 0xfb, 0x55, 0x18, 0xd4, 0x8c, 0x98, 0xe8, 0x4e, 0x64, 0x44,
-	0x37, 0xe4, 0xd5, 0x0d, 0x6e, 0xc8, 0x55, 0xef, 0x62, 0xeb, 0xdb, 0xee, 0xc1, 0x85, 0xad, 0x5e,
-	0x6f, 0xf7, 0xdf, 0xfb, 0xb6, 0x7b, 0x64, 0xa9, 0xcf, 0x55, 0xcc, 0x16, 0xe1, 0x94, 0x77, 0x4a,
-	0xe3, 0xc2, 0x77, 0x98, 0x55, 0x5b, 0x35, 0x17, 0x14, 0x7b, 0xd3, 0x8b, 0xda, 0x29, 0x18, 0xd0,
-	0xda, 0x63, 0x78, 0x89, 0xf8, 0x5f, 0x39, 0x55, 0xfd, 0x6a, 0x8a, 0x1b, 0x8c, 0x48, 0x15, 0x86,
-	0xb4, 0xf0, 0x20, 0x26, 0xe1, 0x42, 0xbe, 0xa0, 0xb4, 0xed, 0x30, 0x2e, 0x51, 0x87, 0xd2, 0x4d,
-	0x84, 0x12, 0x31, 0x59, 0x64, 0x36, 0xcb, 0x4f, 0x69, 0x0b, 0xa4, 0x34, 0x37, 0x48, 0xe9, 0x01,
-	0x1c, 0x58, 0x70, 0x50, 0x8a, 0xed, 0xb2, 0xba, 0x69, 0x61, 0x8e, 0xcf, 0x67, 0xd0, 0xf1, 0xdb,
-	0x20, 0x93, 0xa0, 0x1f, 0xe9, 0x1b, 0x98, 0x97, 0x76, 0x81, 0x45, 0xf3, 0xd2, 0xab, 0x6a, 0xfe,
-	0xd8, 0xcb, 0x5e, 0xfc, 0x62, 0xe9, 0xd9, 0x2b, 0xf4, 0x34, 0x7b, 0xbd, 0x2b, 0xec, 0x32, 0x1c,
-	0xf3, 0x2a, 0xf2, 0x36, 0xb3, 0x56, 0x9c, 0x06, 0xd5, 0x77, 0x6b, 0x69, 0x46, 0x95, 0x6f, 0x62,
-	0xda, 0xdd, 0x07, 0x49, 0x86, 0x91, 0xa8, 0x01, 0x72, 0x5f, 0x80, 0xbd, 0xde, 0x3b, 0x8c, 0xf3,
-	0x58, 0x06, 0xe5, 0x96, 0x8b, 0x96, 0xa1, 0xc4, 0x10, 0xd1, 0x9c, 0xae, 0x87, 0x11, 0xf5, 0x2a,
-	0x93, 0x3f, 0x22, 0x48, 0x22, 0xb0, 0x46, 0x2c, 0x89, 0x42, 0x57, 0x24, 0x7a, 0x97, 0x9f, 0x99,
-	0x76, 0xc7, 0xb9, 0xcc, 0x2c, 0x7b, 0xde, 0x69, 0xd1, 0xef, 0x88, 0x0e, 0x3d, 0x3d, 0x4d, 0x4f,
-	0xda, 0x6d, 0x65, 0xc4, 0x0e, 0x89, 0x7e, 0x05, 0x0e, 0x86, 0x86, 0x72, 0xb6, 0x95, 0x61, 0x87,
-	0x61, 0x37, 0xfe, 0x1b, 0x26, 0x01, 0x74, 0xaf, 0x32, 0xf9, 0x1b, 0xdf, 0x0d, 0xd3, 0x11, 0xcf,
-	0x42, 0x0f, 0x78, 0xf6, 0x2e, 0xcb, 0xe7, 0xe1, 0xb0, 0x97, 0x2d, 0xff, 0xc9, 0x15, 0x9f, 0xda,
-	0x65, 0xfc, 0xe2, 0xc1, 0xc9, 0xf3, 0x8f, 0xef, 0x39, 0x5f, 0x12, 0xdd, 0x7e, 0x80, 0xa8, 0x30,
-	0x1c, 0x5c, 0x1a, 0xa3, 0x76, 0x1f, 0xf6, 0xfb, 0x8f, 0x5a, 0xcc, 0x51, 0x27, 0x27, 0x76, 0x25,
-	0xe0, 0x40, 0xfa, 0x1a, 0x72, 0x9c, 0xd3, 0xf5, 0xcf, 0xe2, 0x74, 0xfe, 0x09, 0x41, 0x22, 0x2d,
-	0xff, 0x89, 0x44, 0x0a, 0xdb, 0x22, 0xd2, 0xbb, 0xac, 0xdf, 0xc3, 0x8f, 0xd3, 0x65, 0xcd, 0xb2,
-	0x57, 0xb8, 0x51, 0xd5, 0x0c, 0xd5, 0x1f, 0x99, 0x94, 0xd6, 0x76, 0x18, 0xfa, 0xc5, 0xf7, 0xb3,
-	0x58, 0xfd, 0x40, 0xc5, 0x7d, 0x90, 0x3e, 0x24, 0x70, 0x3c, 0xde, 0xe1, 0x67, 0x15, 0x0a, 0x09,
-	0xf6, 0xdb, 0xa6, 0xcd, 0x74, 0x5c, 0x0c, 0x2b, 0x2b, 0xf0, 0x4e, 0x5a, 0x46, 0x50, 0x15, 0x66,
-	0xf3, 0x65, 0xf7, 0xa3, 0x7f, 0xc9, 0xa8, 0x37, 0xfd, 0xe7, 0x97, 0xcb, 0x85, 0xf8, 0xb8, 0xd0,
-	0xa3, 0xb0, 0x67, 0x43, 0x33, 0xaa, 0xe6, 0x86, 0xf0, 0x59, 0xa8, 0xe0, 0x93, 0xf4, 0xdd, 0x02,
-	0x9c, 0x48, 0x70, 0x87, 0x24, 0x8f, 0xc2, 0x9e, 0x5a, 0xfb, 0x34, 0x2b, 0x54, 0xf0, 0x89, 0xde,
-	0x83, 0xfd, 0x8a, 0x62, 0x6f, 0x5a, 0xf2, 0xba, 0x66, 0x59, 0xbc, 0x3a, 0xd2, 0xd7, 0x39, 0xf9,
-	0x01, 0xe1, 0xe0, 0xae, 0xb0, 0xa7, 0x2b, 0x70, 0xc0, 0xf5, 0x57, 0x47, 0xf2, 0x85, 0x2e, 0xa2,
-	0x29, 0x3c, 0x60, 0xa4, 0xe8, 0x69, 0x38, 0x20, 0x22, 0xd7, 0xf2, 0xb8, 0x3b, 0x1a, 0x4e, 0x3a,
-	0x0e, 0x87, 0xea, 0xcc, 0xb2, 0x65, 0x77, 0xed, 0x47, 0x4c, 0x6f, 0xf2, 0x91, 0x7e, 0x71, 0x3c,
-	0x0c, 0x3a, 0xef, 0x9d, 0x7c, 0x5b, 0x5f, 0x76, 0xde, 0xd2, 0x12, 0x1c, 0x46, 0x47, 0x81, 0xc9,
-	0x7b, 0x5c, 0x71, 0xa3, 0xde, 0xae, 0x0f, 0x9c, 0x7f, 0x0d, 0x8a, 0xba, 0xb9, 0xc1, 0x2d, 0x5b,
-	0xf6, 0x9b, 0xa1, 0x1e, 0x34, 0xf2, 0x92, 0x08, 0xe6, 0x31, 0x77, 0x86, 0xaf, 0xb8, 0xf0, 0xc8,
-	0x9f, 0x87, 0x73, 0x71, 0xa5, 0xf7, 0x40, 0xb3, 0x6b, 0x9a, 0xd1, 0xca, 0x55, 0x6a, 0xce, 0xa5,
-	0x5f, 0xf6, 0xc1, 0xf9, 0x5c, 0x4e, 0x30, 0xd3, 0x6f, 0xc3, 0x60, 0x50, 0x89, 0xeb, 0xaa, 0xa0,
-	0x15, 0x7f, 0x41, 0x47, 0x52, 0x10, 0x53, 0xd1, 0x74, 0x06, 0x8e, 0x29, 0xcd, 0x46, 0x83, 0x1b,
-	0xb6, 0xbc, 0xa1, 0xd9, 0xb5, 0x6a, 0x83, 0x6d, 0xc8, 0x58, 0xac, 0x05, 0x11, 0xa5, 0x23, 0x38,
-	0xfc, 0x00, 0x47, 0x1f, 0x88, 0x41, 0x3a, 0x09, 0x47, 0x22, 0x76, 0x0d, 0x66, 0x73, 0x91, 0xe7,
-	0x7d, 0x95, 0xc3, 0x21, 0x2b, 0x87, 0xb0, 0x93, 0xc4, 0xb6, 0x5c, 0x26, 0xf3, 0x4d, 0x85, 0xf3,
-	0x2a, 0xaf, 0x8a, 0x8c, 0xef, 0xad, 0x0c, 0x35, 0xbc, 0x98, 0xdc, 0xc4, 0x81, 0x96, 0x1c, 0xe6,
-	0x5c, 0x55, 0x0f, 0xb9, 0xcd, 0x02, 0xd7, 0xae, 0x34, 0xed, 0x9d, 0x38, 0xa1, 0xd1, 0xf6, 0xd6,
-	0xb9, 0x13, 0xd8, 0x3a, 0x98, 0xdc, 0x55, 0xdc, 0xc2, 0x0b, 0xa6, 0xf1, 0x88, 0x37, 0x9c, 0xbe,
-	0x6f, 0xd5, 0x74, 0xcc, 0x23, 0x77, 0x4e, 0xe4, 0xa0, 0x2a, 0xc2, 0x5e, 0x95, 0x59, 0xcb, 0xad,
-	0xb3, 0x6a, 0x5f, 0xa5, 0xf5, 0x2c, 0xfd, 0x80, 0xe0, 0x56, 0x8e, 0xba, 0x45, 0x3c, 0x5f, 0x80,
-	0x21, 0x4f, 0x61, 0xb8, 0xcd, 0xac, 0x25, 0xc3, 0x19, 0xf4, 0xc4, 0xb9, 0xc8, 0x80, 0x33, 0x5b,
-	0x48, 0x82, 0x8a, 0xa9, 0xdf, 0xe2, 0x1c, 0x67, 0xf7, 0x61, 0xb5, 0x87, 0x07, 0xe8, 0x38, 0x1c,
-	0x74, 0xfe, 0xfa, 0xbb, 0x82, 0x82, 0xc8, 0x75, 0xf8, 0xb5, 0x34, 0x86, 0x9f, 0xff, 0x77, 0xb9,
-	0x65, 0x31, 0x95, 0xaf, 0x30, 0xcb, 0xd2, 0x0c, 0x75, 0xa5, 0xed, 0xd1, 0x8b, 0xee, 0x2d, 0xd4,
-	0x61, 0x52, 0x26, 0x22, 0xb1, 0xe3, 0xb0, 0xef, 0x9d, 0x16, 0x44, 0x97, 0x50, 0xfb, 0x85, 0x34,
-	0x1a, 0x3d, 0x31, 0x6f, 0xe9, 0x4c, 0xf5, 0x3e, 0xcf, 0xa5, 0x0f, 0x48, 0xf4, 0x0c, 0xc4, 0x09,
-	0xe8, 0x9f, 0xc1, 0xa1, 0x46, 0x68, 0x0c, 0xaf, 0xd6, 0x72, 0xc6, 0xde, 0x08, 0xbb, 0xc4, 0x4f,
-	0x90, 0x88, 0xbb, 0xc9, 0x4f, 0x2e, 0x40, 0xbf, 0x00, 0x41, 0x3f, 0x26, 0x70, 0x30, 0xa4, 0x0f,
-	0xd1, 0x37, 0x32, 0x96, 0x49, 0x57, 0x51, 0x8b, 0x6f, 0x76, 0x6b, 0xee, 0xf2, 0x97, 0xde, 0xfa,
-	0xe0, 0xaf, 0xff, 0xfa, 0xb0, 0x6f, 0x96, 0x5e, 0x11, 0xfa, 0xf3, 0x84, 0x4f, 0xb5, 0x0f, 0xea,
-	0xd6, 0x68, 0x57, 0x7e, 0x82, 0x3d, 0xd2, 0x56, 0xf9, 0x89, 0xe8, 0x8a, 0xb6, 0xe8, 0xaf, 0x09,
-	0xd0, 0x90, 0xf7, 0x39, 0x5d, 0xcf, 0xc7, 0x2b, 0x51, 0x47, 0xcd, 0xc7, 0x2b, 0x59, 0x1b, 0x95,
-	0x4a, 0x82, 0xd7, 0x38, 0x3d, 0x9b, 0x8f, 0x17, 0xfd, 0x0f, 0x81, 0x97, 0xa3, 0x2c, 0x50, 0xb6,
-	0xa2, 0x8b, 0xdd, 0xa1, 0x09, 0x2a, 0x70, 0xc5, 0x9b, 0xdb, 0xf4, 0x82, 0xd4, 0xde, 0x10, 0xd4,
-	0x2e, 0xd3, 0xe9, 0x7c, 0xd4, 0xd0, 0x1c, 0x33, 0xb7, 0x45, 0xff, 0x4d, 0x60, 0x24, 0x28, 0x09,
-	0xf9, 0x88, 0x2e, 0xe4, 0x84, 0x98, 0xa6, 0x34, 0x16, 0x17, 0xb7, 0xe7, 0x04, 0x69, 0xde, 0x10,
-	0x34, 0xaf, 0xd2, 0xcb, 0x09, 0x34, 0x35, 0x23, 0x99, 0xa5, 0xac, 0x55, 0xb7, 0xe8, 0xaf, 0x08,
-	0x0c, 0x45, 0x88, 0xe6, 0xae, 0xcb, 0x78, 0xc1, 0x2f, 0x77, 0x5d, 0x26, 0x88, 0x78, 0x99, 0x75,
-	0x19, 0x64, 0x65, 0xd1, 0x4f, 0xda, 0x24, 0x7c, 0xa2, 0xc6, 0x8d, 0x9c, 0xbb, 0x3e, 0x49, 0xe9,
-	0x29, 0xbe, 0xd5, 0xbd, 0x03, 0x24, 0xf2, 0xa6, 0x20, 0x72, 0x85, 0xce, 0xa4, 0x13, 0x69, 0x5b,
-	0x96, 0x9f, 0xf8, 0x5e, 0x6d, 0xd1, 0x4f, 0x09, 0x1c, 0x89, 0x95, 0xc2, 0x68, 0x2e, 0x6c, 0x69,
-	0x62, 0x5c, 0x71, 0x6e, 0x1b, 0x1e, 0x90, 0xde, 0xbc, 0xa0, 0x77, 0x9d, 0xce, 0xe6, 0xa5, 0xe7,
-	0x58, 0x87, 0x28, 0xfe, 0x91, 0xc0, 0x70, 0x64, 0x15, 0xa7, 0x06, 0x6f, 0x74, 0x56, 0x44, 0x5d,
-	0xa6, 0x2f, 0x4d, 0x7c, 0x93, 0x2e, 0x08, 0x7e, 0xe7, 0xe8, 0x78, 0x5e, 0x7e, 0xf4, 0xc7, 0xa4,
-	0x2d, 0xf7, 0xd0, 0x99, 0x9c, 0xf5, 0x13, 0xd2, 0xa5, 0x8a, 0x97, 0x3b, 0xb6, 0x43, 0xbc, 0x65,
-	0x81, 0xf7, 0x75, 0x3a, 0x96, 0x80, 0x57, 0x45, 0x03, 0x27, 0x05, 0x55, 0xbe, 0xb9, 0x45, 0x7f,
-	0x48, 0x60, 0xc0, 0xf3, 0xe2, 0xc4, 0x7c, 0x26, 0x67, 0xc8, 0xba, 0x42, 0x1c, 0xa3, 0x8e, 0x49,
-	0x63, 0x02, 0xf1, 0xab, 0xf4, 0x64, 0x06, 0x62, 0xfa, 0x0b, 0x02, 0x87, 0xc2, 0x8d, 0x1d, 0xbd,
-	0x96, 0x67, 0xd9, 0x84, 0x2e, 0xb3, 0x78, 0xbd, 0x3b, 0xe3, 0x9c, 0xa1, 0x56, 0xc2, 0x58, 0x7f,
-	0x47, 0x60, 0xc0, 0xd7, 0xbb, 0xe5, 0xbb, 0x2d, 0xb3, 0x7a, 0xc4, 0x7c, 0xb7, 0x65, 0x66, 0x03,
-	0x29, 0x9d, 0x13, 0x6c, 0x5e, 0xa3, 0x52, 0x02, 0x1b, 0x5f, 0xbf, 0x4b, 0x9f, 0x92, 0x88, 0x00,
-	0x96, 0xbb, 0x3f, 0x8b, 0x97, 0xef, 0x72, 0xf7, 0x67, 0x09, 0x92, 0x9c, 0x34, 0x23, 0xe0, 0x5f,
-	0xa0, 0xa5, 0x04, 0xf8, 0x7a, 0xd0, 0xae, 0x55, 0xfe, 0x4e, 0x57, 0x16, 0xf2, 0xd9, 0xc9, 0xed,
-	0xb7, 0x1d, 0x36, 0xc9, 0x02, 0x63, 0xe6, 0xed, 0x17, 0x62, 0x43, 0xbf, 0x4f, 0x60, 0xb7, 0x38,
-	0x7c, 0x26, 0x73, 0x86, 0xd1, 0x7f, 0x48, 0x4e, 0x75, 0x64, 0x83, 0x08, 0xcf, 0x0b, 0x84, 0x67,
-	0xe8, 0xe9, 0xa4, 0xe2, 0xc7, 0x9b, 0x4c, 0x04, 0xf9, 0xa7, 0x04, 0x06, 0x7c, 0xc2, 0x22, 0xbd,
-	0xda, 0xc1, 0x8a, 0x41, 0x31, 0xb2, 0x3b, 0xb0, 0xd3, 0x02, 0x6c, 0x99, 0x4e, 0xa4, 0x82, 0x8d,
-	0x74, 0xec, 0xdf, 0x23, 0xf0, 0x92, 0x77, 0x15, 0x4d, 0xe6, 0xcc, 0x68, 0xc7, 0x81, 0x0d, 0x89,
-	0x8b, 0xd2, 0x69, 0x81, 0xf5, 0x04, 0x7d, 0x25, 0x05, 0x2b, 0xfd, 0xc8, 0xd9, 0x80, 0x41, 0x49,
-	0x83, 0xce, 0xe6, 0x59, 0x2d, 0x5e, 0x18, 0x2c, 0x5e, 0xeb, 0xca, 0x36, 0xef, 0xc9, 0xe1, 0x03,
-	0xf9, 0x5f, 0x02, 0xa3, 0xe9, 0x5a, 0x0c, 0x5d, 0xea, 0x02, 0x4b, 0xbc, 0x28, 0x54, 0xfc, 0x62,
-	0x2f, 0x5c, 0x21, 0xcb, 0xab, 0x82, 0xe5, 0x14, 0xbd, 0x98, 0xcd, 0x32, 0xcc, 0xe8, 0x23, 0x02,
-	0x83, 0xc1, 0x7f, 0x17, 0xca, 0xb7, 0x03, 0x62, 0xff, 0x01, 0xa9, 0x38, 0xdb, 0x8d, 0x29, 0x92,
-	0x98, 0x10, 0x24, 0xc6, 0xe8, 0x99, 0x04, 0x12, 0xef, 0x07, 0x51, 0x3a, 0xc0, 0x83, 0xc2, 0x4e,
-	0x3e, 0xe0, 0xb1, 0x52, 0x51, 0x3e, 0xe0, 0xf1, 0x3a, 0x52, 0x26, 0x70, 0x3d, 0x88, 0xd2, 0x69,
-	0x15, 0xc2, 0xba, 0x43, 0xbe, 0x56, 0x21, 0x41, 0x21, 0xc9, 0xd7, 0x2a, 0x24, 0xa9, 0x27, 0x99,
-	0xad, 0x42, 0x58, 0x0b, 0x09, 0x13, 0x10, 0x7a, 0x74, 0xc7, 0x04, 0xfc, 0xa2, 0x78, 0xc7, 0x04,
-	0x02, 0x12, 0x78, 0x27, 0x04, 0x5c, 0xac, 0x7f, 0x22, 0xb0, 0xff, 0x7e, 0xd3, 0x5e, 0xdd, 0xdc,
-	0x21, 0xfa, 0x4d, 0x0e, 0x31, 0xa0, 0x85, 0x35, 0xe6, 0x2a, 0xf8, 0xb9, 0xab, 0x48, 0xb5, 0xa6,
-	0xec, 0x00, 0xe5, 0x26, 0xeb, 0x06, 0xf6, 0x33, 0xa2, 0xff, 0x24, 0x70, 0x34, 0x84, 0x7f, 0x47,
-	0x6a, 0x36, 0xb3, 0x82, 0xd4, 0x25, 0x3a, 0x99, 0x83, 0x54, 0x58, 0xb0, 0x71, 0xbf, 0x94, 0xe3,
-	0x28, 0xee, 0x20, 0xb5, 0xe6, 0xba, 0x20, 0x38, 0x43, 0x2f, 0x25, 0x7e, 0x4f, 0x26, 0xf0, 0x13,
-	0x52, 0xcd, 0xcf, 0x08, 0x0c, 0x06, 0x29, 0xfe, 0xbf, 0x75, 0x9a, 0xac, 0xcb, 0xdf, 0xc7, 0x87,
-	0x3e, 0x43, 0xf4, 0x3b, 0x4b, 0xa0, 0xb9, 0x26, 0x18, 0x4c, 0xd3, 0xa9, 0x14, 0x06, 0x89, 0xea,
-	0xcc, 0xdf, 0x09, 0xd0, 0x20, 0xa5, 0x9d, 0x23, 0xcd, 0x64, 0x0b, 0x83, 0x61, 0xdc, 0x21, 0x72,
-	0xbf, 0x15, 0x9a, 0x9a, 0x7f, 0xd2, 0x0e, 0x11, 0x65, 0xb2, 0xba, 0x81, 0x20, 0xb3, 0xf9, 0x2f,
-	0x3d, 0x7d, 0x3e, 0x4a, 0x9e, 0x3d, 0x1f, 0x25, 0x9f, 0x3e, 0x1f, 0x25, 0xdf, 0x79, 0x31, 0xba,
-	0xeb, 0xd9, 0x8b, 0xd1, 0x5d, 0x7f, 0x7b, 0x31, 0xba, 0xeb, 0xe1, 0x45, 0x55, 0xb3, 0x6b, 0xcd,
-	0xb5, 0x92, 0x62, 0xae, 0xfb, 0x5d, 0xb5, 0xfe, 0xc5, 0x7c, 0xd3, 0xef, 0xd5, 0x7e, 0x5c, 0xe7,
-	0xd6, 0xda, 0x1e, 0xf1, 0x21, 0x3c, 0xf5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xf9, 0x7b,
-	0x83, 0x47, 0x30, 0x00, 0x00,
+	// 2746 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x5d, 0x6c, 0xd4, 0xd8,
+	0x15, 0xc6, 0x99, 0x24, 0x24, 0x27, 0x7f, 0xe4, 0x12, 0x20, 0x6b, 0x20, 0x04, 0xb3, 0x90, 0x10,
+	0x96, 0x19, 0x48, 0x20, 0x40, 0xc8, 0x86, 0xcd, 0x0f, 0x81, 0xb4, 0x01, 0xb2, 0xd3, 0xb4, 0xb4,
+	0x5b, 0x75, 0x2d, 0xc7, 0x73, 0x77, 0xe2, 0xe2, 0xd8, 0xb3, 0xb6, 0x07, 0x06, 0x50, 0x2a, 0x75,
+	0xa5, 0x3e, 0xf4, 0x6d, 0xd5, 0x7d, 0xe8, 0x4b, 0x5f, 0xab, 0xf6, 0xa1, 0x55, 0xfb, 0x50, 0xed,
+	0x4b, 0xd5, 0x4a, 0xfd, 0x47, 0xdb, 0x56, 0xa2, 0x5b, 0xa9, 0xaa, 0xfa, 0x50, 0x6d, 0xa1, 0x3f,
+	0xef, 0x48, 0x7d, 0xad, 0x2a, 0x5f, 0x1f, 0xcf, 0xd8, 0x1e, 0xdb, 0xe3, 0x71, 0x06, 0x29, 0xfb,
+	0x94, 0xf1, 0xbd, 0xf7, 0x9c, 0xfb, 0x7d, 0xe7, 0x9c, 0xfb, 0x77, 0x4e, 0xe0, 0xf4, 0x23, 0x6a,
+	0x49, 0xf2, 0xa6, 0xa4, 0x68, 0x39, 0xf6, 0x4b, 0x37, 0x68, 0x4e, 0x36, 0x74, 0xd3, 0x74, 0xda,
+	0xde, 0x2d, 0x53, 0xe3, 0x61, 0xb6, 0x64, 0xe8, 0x96, 0x4e, 0x8e, 0x56, 0x87, 0x66, 0xdd, 0xa1,
+	0xd9, 0xda, 0x50, 0x7e, 0x42, 0xd6, 0xcd, 0x2d, 0xdd, 0xcc, 0x6d, 0x48, 0x26, 0x75, 0xe4, 0x72,
+	0xf7, 0xcf, 0x6f, 0x50, 0x4b, 0x3a, 0x9f, 0x2b, 0x49, 0x45, 0x45, 0x93, 0x2c, 0x45, 0xd7, 0x1c,
+	0x55, 0xfc, 0x95, 0xf8, 0x59, 0x65, 0xd9, 0xaa, 0x88, 0x06, 0xb5, 0xa8, 0x66, 0xcb, 0x88, 0x25,
+	0x5d, 0x55, 0x64, 0x44, 0xc1, 0x4f, 0x36, 0x10, 0xb5, 0x7f, 0x8a, 0xec, 0xb7, 0x68, 0x55, 0x50,
+	0xe6, 0x6c, 0xbc, 0x4c, 0x51, 0x32, 0xc5, 0x92, 0xa1, 0xc8, 0x14, 0x87, 0x5f, 0x8e, 0x1f, 0xae,
+	0x68, 0x1b, 0x7a, 0x59, 0x2b, 0x88, 0x9b, 0x92, 0xb9, 0x29, 0x5a, 0xba, 0x68, 0xa3, 0x45, 0xc9,
+	0xa9, 0x64, 0x92, 0x96, 0x21, 0xc9, 0xf7, 0xa8, 0x81, 0x42, 0x17, 0xe3, 0x85, 0x54, 0xc9, 0xb4,
+	0xc4, 0x0d, 0x55, 0x97, 0xef, 0x89, 0x9b, 0x54, 0x29, 0x6e, 0x5a, 0x28, 0x76, 0x21, 0x5e, 0x4c,
+	0x2f, 0x5b, 0x61, 0x93, 0x4d, 0xc7, 0x4b, 0x19, 0x92, 0x45, 0x45, 0x55, 0xd9, 0x52, 0x2c, 0x6a,
+	0x88, 0xef, 0xa8, 0x52, 0xd1, 0x44, 0xb9, 0xa1, 0xa2, 0x5e, 0xd4, 0xd9, 0xcf, 0x9c, 0xfd, 0x0b,
+	0x5b, 0x8f, 0x14, 0x75, 0xbd, 0xa8, 0xd2, 0x9c, 0x54, 0x52, 0x72, 0x92, 0xa6, 0xe9, 0x16, 0x73,
+	0x32, 0xca, 0x08, 0x47, 0x80, 0x7f, 0xd3, 0x8e, 0x83, 0xb7, 0xa8, 0x25, 0xcd, 0xcb, 0xb2, 0x5e,
+	0xd6, 0x2c, 0x45, 0x2b, 0xe6, 0xe9, 0xbb, 0x65, 0x6a, 0x5a, 0xc2, 0x2d, 0x38, 0x1c, 0xda, 0x6b,
+	0x96, 0x74, 0xcd, 0xa4, 0x24, 0x0b, 0xfb, 0xa5, 0x0d, 0xdd, 0xb0, 0x68, 0x41, 0xb4, 0x81, 0x8a,
+	0xd2, 0x96, 0x3d, 0x62, 0x98, 0x1b, 0xe5, 0xc6, 0xbb, 0xf3, 0x83, 0xd8, 0xc5, 0x64, 0x59, 0x87,
+	0xb0, 0x06, 0x23, 0x4c, 0xdd, 0x0d, 0x6a, 0xdd, 0x41, 0xea, 0xeb, 0x0e, 0x73, 0x9c, 0x90, 0x0c,
+	0xc3, 0x5e, 0x46, 0x72, 0x65, 0x89, 0x69, 0xc9, 0xe4, 0xdd, 0x4f, 0x32, 0x04, 0x1d, 0x9a, 0xae,
+	0xc9, 0x74, 0xb8, 0x6d, 0x94, 0x1b, 0x6f, 0xcf, 0x3b, 0x1f, 0xc2, 0xd7, 0x39, 0x38, 0x16, 0xa9,
+	0x12, 0x51, 0xbe, 0x0d, 0x03, 0xba, 0xbf, 0x8b, 0xe9, 0xee, 0x99, 0xcc, 0x66, 0x63, 0x57, 0x4b,
+	0x36, 0xa0, 0x70, 0xa1, 0xfd, 0xc9, 0xdf, 0x8f, 0xed, 0xc9, 0x07, 0x95, 0x09, 0x9b, 0xc8, 0x6a,
+	0x5e, 0x55, 0x23, 0x58, 0x2d, 0x03, 0xd4, 0x96, 0x17, 0x4e, 0x7e, 0x2a, 0xeb, 0xac, 0xc5, 0xac,
+	0xbd, 0x16, 0xb3, 0xce, 0x1a, 0xc6, 0xb5, 0x98, 0x5d, 0x93, 0x8a, 0x14, 0x65, 0xf3, 0x1e, 0x49,
+	0xe1, 0x23, 0x97, 0x6d, 0xd8, 0x54, 0x71, 0x6c, 0x33, 0x2d, 0x63, 0x4b, 0x6e, 0xf8, 0xb8, 0xb4,
+	0x31, 0x2e, 0x63, 0x0d, 0xb9, 0x38, 0xe0, 0x7c, 0x64, 0xbe, 0xc1, 0xc1, 0xc9, 0x08, 0x32, 0x0b,
+	0x0f, 0x17, 0x6d, 0x48, 0xae, 0xf9, 0x86, 0xa0, 0x83, 0x41, 0xc4, 0x90, 0x70, 0x3e, 0xc8, 0x72,
+	0x08, 0x90, 0x34, 0x46, 0xfd, 0x13, 0x07, 0xa7, 0x1a, 0xe1, 0xf8, 0xb4, 0xd9, 0xf6, 0x9b, 0x1c,
+	0xbc, 0xea, 0x72, 0x5a, 0xd1, 0x62, 0x4c, 0xfb, 0x0a, 0x74, 0x39, 0xfb, 0xb0, 0x52, 0xf0, 0x2f,
+	0xb8, 0x42, 0xcb, 0xec, 0xfb, 0x47, 0x8f, 0x9f, 0x23, 0xb0, 0xa0, 0x79, 0xbf, 0x0c, 0xfd, 0x8a,
+	0x16, 0x62, 0xdd, 0xb3, 0x0d, 0xac, 0xbb, 0xa2, 0x85, 0x18, 0x37, 0xa0, 0xaa, 0x75, 0xb6, 0xf5,
+	0x2c, 0x77, 0xff, 0xc4, 0x66, 0xab, 0x97, 0xfb, 0xef, 0x3c, 0xcb, 0xbd, 0x6e, 0xaa, 0x4f, 0x95,
+	0xcd, 0x96, 0x60, 0xd4, 0xdd, 0xa5, 0x71, 0xe2, 0x9b, 0x92, 0xb9, 0xb9, 0xae, 0x2f, 0xca, 0x56,
+	0xc5, 0xb5, 0xda, 0x28, 0xf4, 0x28, 0xb5, 0x3e, 0x3c, 0x44, 0xbc, 0x4d, 0x76, 0x54, 0x1f, 0x8f,
+	0x51, 0x83, 0x16, 0x29, 0xc0, 0xa0, 0x12, 0xec, 0x44, 0x27, 0x9c, 0x4b, 0x66, 0x94, 0x9a, 0x1c,
+	0xda, 0xa5, 0x5e, 0xa1, 0x70, 0x1d, 0xa1, 0xd4, 0x89, 0x2c, 0x49, 0x96, 0x94, 0x9c, 0xd2, 0x0f,
+	0x39, 0x10, 0xe2, 0xf4, 0x20, 0xa7, 0xbb, 0xd0, 0xb7, 0x68, 0xc3, 0x64, 0xeb, 0x65, 0xbd, 0x62,
+	0xa2, 0x93, 0xcf, 0x34, 0xe0, 0xe3, 0x95, 0x41, 0x2a, 0x7e, 0x3d, 0xf6, 0x09, 0x5e, 0x32, 0xca,
+	0x1a, 0x2d, 0xb0, 0x1b, 0x92, 0xa8, 0x68, 0x05, 0x5a, 0xa1, 0xe6, 0x70, 0xdb, 0x68, 0xc6, 0x3e,
+	0xc1, 0x9d, 0x2e, 0x1b, 0xcd, 0x8a, 0xd3, 0x21, 0x7c, 0x15, 0x46, 0x03, 0x11, 0x59, 0xef, 0xc8,
+	0x56, 0x85, 0xff, 0xc7, 0xae, 0xbb, 0xc3, 0x27, 0x8b, 0x77, 0x77, 0xa6, 0xa5, 0xee, 0x6e, 0xdd,
+	0x4a, 0xb8, 0x89, 0xfe, 0x76, 0x4f, 0x84, 0xf0, 0xc0, 0x11, 0xa0, 0x57, 0xf7, 0x0c, 0xc0, 0xc8,
+	0xf1, 0xb5, 0x09, 0x5f, 0x83, 0x13, 0xb1, 0x9a, 0x5e, 0x72, 0xe8, 0x08, 0x39, 0x38, 0xe4, 0x2e,
+	0xc6, 0x1b, 0x92, 0xb9, 0x66, 0x28, 0x32, 0xf5, 0x1c, 0xd8, 0x2c, 0x92, 0x10, 0xb7, 0xf3, 0x21,
+	0x88, 0x30, 0x5c, 0x2f, 0x80, 0x28, 0x17, 0xa1, 0xcb, 0x6d, 0xc3, 0x88, 0x19, 0x6b, 0x00, 0xb0,
+	0xaa, 0xa2, 0x2a, 0x28, 0x48, 0x88, 0x68, 0x5e, 0x55, 0x83, 0x88, 0x5a, 0x15, 0x93, 0xdf, 0xe7,
+	0x60, 0xb8, 0x7e, 0x8e, 0x50, 0x12, 0x99, 0x54, 0x24, 0x5a, 0x17, 0x69, 0xd3, 0xb5, 0xcb, 0xf6,
+	0xaa, 0x64, 0x5a, 0x0b, 0xf6, 0xeb, 0xe4, 0x26, 0x7b, 0x9c, 0xc4, 0xbb, 0xe9, 0x31, 0x1c, 0x8b,
+	0x94, 0x43, 0xa2, 0x5f, 0x84, 0x81, 0x40, 0x57, 0xc2, 0x1b, 0x75, 0x50, 0x61, 0x50, 0x8d, 0xf7,
+	0x70, 0x8d, 0x00, 0xdd, 0x2a, 0x4f, 0xfe, 0xda, 0x73, 0xb8, 0x36, 0xc5, 0x33, 0xd3, 0x02, 0x9e,
+	0xad, 0xf3, 0xf2, 0x19, 0xd8, 0xef, 0x7a, 0xcb, 0xbb, 0x07, 0x87, 0xbb, 0x76, 0x15, 0x78, 0xef,
+	0xe0, 0x85, 0x87, 0xb7, 0x75, 0x4d, 0xa6, 0x69, 0xdf, 0x5e, 0x45, 0x18, 0xf2, 0x4f, 0x8d, 0x56,
+	0xbb, 0x03, 0xbd, 0xde, 0x9d, 0x02, 0x7d, 0xd4, 0xcc, 0x86, 0x93, 0xf7, 0x29, 0x10, 0xbe, 0x82,
+	0x1c, 0xe7, 0x55, 0xf5, 0x65, 0x9c, 0x33, 0x3f, 0xe6, 0x60, 0xc8, 0xaf, 0x3f, 0x92, 0x48, 0x66,
+	0x47, 0x44, 0x5a, 0xe7, 0xf5, 0x17, 0x6d, 0x70, 0xd8, 0x0b, 0x79, 0xe1, 0xe1, 0xb2, 0xa2, 0x5a,
+	0xb5, 0x07, 0xe7, 0x41, 0xe8, 0x34, 0xa9, 0x56, 0xc0, 0x97, 0x6e, 0x77, 0x1e, 0xbf, 0x08, 0x0f,
+	0x5d, 0x06, 0x95, 0xa9, 0x72, 0x9f, 0x1a, 0x6c, 0xfa, 0xee, 0x7c, 0xf5, 0x9b, 0x5c, 0x87, 0x2e,
+	0xd3, 0x92, 0xac, 0xb2, 0x49, 0xcd, 0xe1, 0xcc, 0x68, 0x66, 0xbc, 0x7f, 0xf2, 0x74, 0x23, 0xa6,
+	0xb2, 0x55, 0xf9, 0x1c, 0x13, 0xc9, 0x57, 0x45, 0xc9, 0x29, 0x18, 0x70, 0x26, 0x13, 0xab, 0x0f,
+	0x8b, 0x76, 0x16, 0x4d, 0x7d, 0x4e, 0xf3, 0x22, 0x3e, 0x2f, 0x26, 0x60, 0xd0, 0x9d, 0xba, 0x36,
+	0xb2, 0x83, 0x8d, 0x1c, 0x70, 0x3b, 0xdc, 0xb1, 0x47, 0x01, 0xb6, 0x14, 0x0d, 0x53, 0x2b, 0xc3,
+	0x9d, 0x2c, 0x08, 0xbb, 0xb7, 0x14, 0x0d, 0x17, 0x93, 0xdd, 0x2d, 0x55, 0xdc, 0xee, 0xbd, 0xd8,
+	0x2d, 0x55, 0xb0, 0xdb, 0x1f, 0x27, 0x5d, 0xa9, 0xe3, 0xe4, 0x36, 0xda, 0x7c, 0x55, 0x31, 0xad,
+	0x35, 0xaa, 0x15, 0x14, 0xad, 0xe8, 0x0d, 0xc7, 0x98, 0xa7, 0xd4, 0x10, 0x74, 0xb0, 0x7c, 0x0d,
+	0xb3, 0x79, 0x5f, 0xde, 0xf9, 0x10, 0x3e, 0xe0, 0xe0, 0x48, 0xb8, 0xc2, 0x97, 0x15, 0x7f, 0x02,
+	0xf4, 0x5a, 0xba, 0x25, 0xa9, 0x38, 0x19, 0x2e, 0x67, 0x5f, 0x9b, 0xb0, 0x8a, 0xa0, 0xf2, 0x92,
+	0x45, 0x57, 0x9d, 0x24, 0xd3, 0x8a, 0x56, 0x2a, 0x7b, 0x0f, 0x0d, 0x87, 0x0b, 0xe7, 0xe1, 0x62,
+	0x07, 0xdc, 0x03, 0x45, 0x2b, 0xe8, 0x0f, 0x98, 0xce, 0x4c, 0x1e, 0xbf, 0x84, 0xff, 0x66, 0xe0,
+	0x68, 0x84, 0x3a, 0x24, 0x79, 0x10, 0x3a, 0x37, 0x6b, 0x47, 0x48, 0x26, 0x8f, 0x5f, 0xe4, 0x36,
+	0xf4, 0xda, 0x57, 0x52, 0x53, 0xdc, 0x52, 0x4c, 0x93, 0x16, 0x86, 0xdb, 0x9a, 0x27, 0xdf, 0xc3,
+	0x14, 0xdc, 0x62, 0xf2, 0x64, 0x0d, 0xfa, 0x1c, 0x7d, 0x25, 0x24, 0x9f, 0x49, 0x61, 0x4d, 0xa6,
+	0x01, 0x2d, 0x45, 0x4e, 0x40, 0x1f, 0xb3, 0x5c, 0x55, 0x63, 0x7b, 0xbd, 0x39, 0xc9, 0x38, 0xec,
+	0x2b, 0xd9, 0xc9, 0x41, 0x67, 0xee, 0xfb, 0x92, 0x5a, 0xa6, 0x2c, 0xca, 0xbb, 0xf3, 0xfd, 0x76,
+	0xbb, 0xed, 0x6f, 0xf3, 0x0b, 0x76, 0x2b, 0xbb, 0x8a, 0x3b, 0x42, 0xbe, 0xc1, 0x9d, 0x4e, 0x32,
+	0xad, 0x54, 0x8b, 0x0f, 0x1c, 0x7f, 0x15, 0x78, 0x55, 0x7f, 0x40, 0x4d, 0x4b, 0xf4, 0x8a, 0x79,
+	0x57, 0x41, 0x26, 0x7f, 0xc8, 0x19, 0xe1, 0x09, 0x2e, 0x5c, 0x13, 0x6f, 0x43, 0xdf, 0x46, 0x59,
+	0xbe, 0x47, 0x2d, 0xb1, 0x6c, 0x4a, 0x45, 0x6a, 0x0e, 0x77, 0x31, 0x6b, 0x4c, 0x35, 0xb0, 0x46,
+	0xd5, 0x8b, 0x0b, 0x4c, 0xf8, 0xf3, 0xb6, 0x2c, 0xde, 0x0e, 0x7b, 0x37, 0x6a, 0x4d, 0xa6, 0x30,
+	0x52, 0x1f, 0x45, 0xac, 0xc7, 0x4d, 0x2c, 0xbe, 0x68, 0x03, 0x3e, 0xa8, 0xcc, 0x52, 0x54, 0xe5,
+	0x11, 0x5b, 0x6a, 0x64, 0x15, 0x3a, 0x1d, 0x75, 0x09, 0xef, 0x15, 0x01, 0x55, 0x08, 0x09, 0x75,
+	0x90, 0x69, 0x38, 0x24, 0x97, 0x0d, 0x83, 0x6a, 0x96, 0xf8, 0x40, 0xb1, 0x36, 0x0b, 0x86, 0xf4,
+	0x40, 0xf4, 0x45, 0xeb, 0x01, 0xec, 0xbe, 0x8b, 0xbd, 0x77, 0x59, 0x27, 0xb9, 0x00, 0x07, 0xeb,
+	0xe4, 0x9c, 0xd8, 0xcf, 0x30, 0xa7, 0x0c, 0x05, 0xc4, 0x18, 0x82, 0x50, 0x29, 0xc7, 0x95, 0xed,
+	0xa1, 0x52, 0x8e, 0x37, 0x27, 0xe1, 0x40, 0x9d, 0x94, 0x21, 0x59, 0x6e, 0xb0, 0xec, 0x0f, 0x08,
+	0xd9, 0x84, 0xed, 0x88, 0xa9, 0xe5, 0x82, 0x45, 0x5a, 0x91, 0x29, 0x2d, 0xd0, 0x02, 0x8b, 0x98,
+	0xae, 0xfc, 0xa0, 0xe1, 0xda, 0xe4, 0x3a, 0x76, 0x08, 0xdf, 0xe2, 0xe0, 0x68, 0x84, 0x57, 0x1a,
+	0x2c, 0xc6, 0x2f, 0xc1, 0x5e, 0xc7, 0x96, 0x26, 0xae, 0xc3, 0x2b, 0x4d, 0x06, 0x4a, 0xcd, 0xb7,
+	0xe8, 0x1b, 0x57, 0x9f, 0xb0, 0x00, 0x13, 0x61, 0x9b, 0xa0, 0x4d, 0x54, 0xd1, 0xaa, 0x6a, 0x62,
+	0x77, 0x1f, 0xe1, 0x17, 0x6d, 0x70, 0x26, 0x91, 0x12, 0xa4, 0xf9, 0x26, 0xf4, 0xfb, 0x6b, 0x10,
+	0xa9, 0xb6, 0x56, 0xd9, 0xf3, 0x55, 0xbf, 0x19, 0x84, 0xec, 0xad, 0x71, 0x81, 0x98, 0x89, 0x0b,
+	0xc4, 0xc8, 0xe0, 0x68, 0x6f, 0x3a, 0x38, 0x3a, 0xa2, 0x82, 0xc3, 0x2d, 0x04, 0xd8, 0x37, 0x55,
+	0x3b, 0x65, 0xef, 0xbb, 0x75, 0x0b, 0x17, 0xe1, 0x70, 0x68, 0x6f, 0x2d, 0x6e, 0x6e, 0xfa, 0xe2,
+	0xc6, 0xf9, 0x12, 0xd6, 0x71, 0x1b, 0x58, 0xd4, 0xb5, 0xfb, 0xd4, 0xb0, 0x9f, 0x7d, 0xeb, 0xba,
+	0x2d, 0x5e, 0x77, 0xe5, 0xac, 0x3b, 0x32, 0x79, 0xe8, 0x2a, 0x4a, 0xe6, 0x6a, 0xf5, 0xd4, 0xec,
+	0xce, 0x57, 0xbf, 0x85, 0xef, 0xba, 0x71, 0x5c, 0xaf, 0x16, 0xf1, 0xbc, 0x06, 0x83, 0xee, 0x5b,
+	0xf9, 0x86, 0x64, 0xae, 0x68, 0x76, 0xa7, 0x5b, 0x96, 0xa8, 0xeb, 0xb0, 0x47, 0xb3, 0x62, 0x88,
+	0xac, 0xab, 0xcb, 0x94, 0xe2, 0xe8, 0x36, 0xdc, 0x77, 0x83, 0x1d, 0x64, 0x1c, 0x06, 0xec, 0xbf,
+	0xde, 0x47, 0x41, 0x86, 0xf9, 0x3a, 0xd8, 0x2c, 0x8c, 0x61, 0xe2, 0xf3, 0x16, 0x35, 0xed, 0x55,
+	0xb6, 0x26, 0x99, 0xa6, 0xa2, 0x15, 0xd7, 0x6a, 0x1a, 0x5d, 0xeb, 0x2e, 0xc3, 0xa9, 0x46, 0x03,
+	0x91, 0xd8, 0x11, 0xe8, 0x7e, 0x87, 0x52, 0x1f, 0xa1, 0x5a, 0x43, 0xd8, 0xae, 0xbb, 0x6c, 0xd7,
+	0x87, 0xdc, 0x79, 0xde, 0x0b, 0xd9, 0x00, 0x70, 0x00, 0xea, 0x97, 0x60, 0x9f, 0x11, 0xe8, 0xc3,
+	0x2d, 0x38, 0x97, 0x74, 0xc5, 0xa3, 0x18, 0xae, 0xf3, 0x3a, 0x75, 0xc2, 0x71, 0x7c, 0x77, 0x39,
+	0x57, 0x1d, 0xac, 0x1f, 0xae, 0xb1, 0xf2, 0xa1, 0x8b, 0xf3, 0x7d, 0x0e, 0x46, 0xa3, 0xc7, 0x20,
+	0x54, 0x15, 0x0e, 0x84, 0xd6, 0x20, 0x11, 0xef, 0x64, 0x82, 0xcb, 0x6b, 0x40, 0x35, 0x42, 0xde,
+	0x2f, 0xd7, 0x77, 0x4d, 0xfe, 0x6f, 0x1a, 0x3a, 0x18, 0x24, 0xf2, 0x31, 0x07, 0x03, 0x81, 0x7c,
+	0x3e, 0x79, 0xbd, 0xc1, 0x64, 0xf1, 0x55, 0x2f, 0x7e, 0x2e, 0xad, 0xb8, 0x63, 0x0a, 0xe1, 0x8d,
+	0xf7, 0xfe, 0xfc, 0xcf, 0x0f, 0xda, 0x66, 0xc8, 0x65, 0x56, 0x2f, 0x3c, 0xeb, 0xa9, 0xb2, 0xfa,
+	0xeb, 0x8c, 0x28, 0x97, 0x7b, 0x8c, 0x0f, 0xbb, 0xed, 0xdc, 0x63, 0xf6, 0x94, 0xdb, 0x26, 0xbf,
+	0xe2, 0x80, 0x04, 0xb4, 0xcf, 0xab, 0x6a, 0x32, 0x5e, 0x91, 0x75, 0x2f, 0x7e, 0x2e, 0xad, 0x38,
+	0xf2, 0xca, 0x32, 0x5e, 0xe3, 0xe4, 0x54, 0x32, 0x5e, 0xe4, 0x3f, 0x1c, 0xbc, 0x52, 0xcf, 0x02,
+	0xcb, 0x0c, 0x64, 0x29, 0x1d, 0x1a, 0x7f, 0xc5, 0x84, 0xbf, 0xbe, 0x43, 0x2d, 0x48, 0xed, 0x75,
+	0x46, 0xed, 0x12, 0xb9, 0x98, 0x8c, 0x1a, 0x8a, 0xa3, 0xe7, 0xb6, 0xc9, 0xbf, 0x39, 0x18, 0x5e,
+	0xd1, 0x22, 0x88, 0x2e, 0x26, 0x84, 0x18, 0x57, 0x19, 0xe2, 0x97, 0x76, 0xa6, 0x04, 0x69, 0x5e,
+	0x63, 0x34, 0xaf, 0x90, 0x4b, 0x11, 0x34, 0x15, 0x2d, 0x9a, 0xa5, 0xa8, 0x14, 0xb6, 0xc9, 0x2f,
+	0x39, 0x18, 0x5c, 0xd1, 0xd2, 0xc6, 0x65, 0x78, 0x81, 0x86, 0x9f, 0x4b, 0x2b, 0x9e, 0x30, 0x2e,
+	0xfd, 0xac, 0x4c, 0xf2, 0x97, 0x1a, 0x09, 0x4f, 0x4e, 0xf9, 0x5a, 0xc2, 0x55, 0x1f, 0x95, 0x68,
+	0xe7, 0xdf, 0x48, 0xaf, 0x00, 0x89, 0xcc, 0x31, 0x22, 0x97, 0xc9, 0x74, 0x3c, 0x91, 0x9a, 0x64,
+	0xee, 0xb1, 0xa7, 0x69, 0x9b, 0x7c, 0xc2, 0xc1, 0x81, 0xd0, 0xca, 0x05, 0x49, 0x84, 0x2d, 0xae,
+	0x78, 0xc2, 0xcf, 0xef, 0x40, 0x03, 0xd2, 0x5b, 0x60, 0xf4, 0x66, 0xc9, 0x4c, 0x52, 0x7a, 0xb6,
+	0x74, 0x80, 0xe2, 0xef, 0x39, 0x18, 0xaa, 0x9b, 0xc5, 0x8e, 0xc1, 0x6b, 0xcd, 0x05, 0x51, 0x4a,
+	0xf7, 0xc5, 0xd5, 0x3e, 0x84, 0x73, 0x8c, 0xdf, 0x04, 0x19, 0x4f, 0xca, 0x8f, 0xfc, 0x8b, 0x83,
+	0x83, 0xe1, 0x05, 0x03, 0x92, 0xc8, 0xde, 0xb1, 0x65, 0x0b, 0x7e, 0x61, 0x27, 0x2a, 0x90, 0xd3,
+	0x12, 0xe3, 0x34, 0x47, 0x66, 0x1b, 0x6c, 0x8c, 0x41, 0xa7, 0x79, 0xdb, 0xb7, 0xc9, 0x0f, 0xb8,
+	0x5a, 0x2e, 0x9e, 0x4c, 0x27, 0x5c, 0x27, 0x81, 0xa2, 0x01, 0x7f, 0xa9, 0x69, 0x39, 0xe4, 0x90,
+	0x63, 0x1c, 0x4e, 0x93, 0xb1, 0x08, 0x0e, 0x45, 0x14, 0xb0, 0x43, 0xad, 0x40, 0x2b, 0xdb, 0xe4,
+	0x7b, 0x1c, 0xf4, 0xb8, 0x5a, 0xec, 0xd8, 0x9a, 0x4e, 0x18, 0x1a, 0xa9, 0x10, 0x87, 0x94, 0x2e,
+	0x84, 0x31, 0x86, 0xf8, 0x38, 0x39, 0xd6, 0x00, 0x31, 0xf9, 0x39, 0x07, 0xfb, 0x82, 0xd7, 0x6e,
+	0x72, 0x35, 0xc9, 0xb4, 0x11, 0x6f, 0x00, 0x7e, 0x36, 0x9d, 0x70, 0x42, 0x53, 0xcb, 0x41, 0xac,
+	0xbf, 0xe5, 0xa0, 0xc7, 0x73, 0xb3, 0x4e, 0x76, 0x2b, 0x68, 0x74, 0x83, 0xe7, 0xaf, 0xef, 0x50,
+	0x0b, 0xb2, 0x99, 0x60, 0x6c, 0x5e, 0x25, 0x42, 0x04, 0x1b, 0xcf, 0x6b, 0x84, 0x3c, 0xe1, 0xea,
+	0xaa, 0x13, 0x89, 0xef, 0xa1, 0xe1, 0xb5, 0x15, 0x7e, 0x2e, 0xad, 0x38, 0xc2, 0x9f, 0x66, 0xf0,
+	0xcf, 0x91, 0x6c, 0x04, 0x7c, 0xd5, 0x2f, 0x57, 0x0d, 0x7f, 0xfb, 0xf6, 0x19, 0xd0, 0xd9, 0xcc,
+	0x29, 0xbf, 0x13, 0x36, 0xd1, 0xd5, 0x9f, 0x86, 0xa7, 0x7c, 0x80, 0x0d, 0xf9, 0x0e, 0x07, 0xed,
+	0x6c, 0x93, 0x9d, 0x4c, 0x68, 0x46, 0xef, 0x61, 0x30, 0xd5, 0x94, 0x0c, 0x22, 0x3c, 0xc3, 0x10,
+	0x9e, 0x24, 0x27, 0xa2, 0x82, 0x1f, 0x4f, 0x6c, 0x66, 0xe4, 0x9f, 0x70, 0xd0, 0xe3, 0xa9, 0xfa,
+	0x90, 0x2b, 0x4d, 0xcc, 0xe8, 0xaf, 0x14, 0xa5, 0x03, 0x7b, 0x91, 0x81, 0xcd, 0x91, 0xb3, 0xb1,
+	0x60, 0xeb, 0x5e, 0x26, 0xdf, 0xe6, 0x60, 0xaf, 0x7b, 0xe4, 0x4e, 0x26, 0xf4, 0x68, 0xd3, 0x86,
+	0x0d, 0x54, 0x7e, 0x84, 0x13, 0x0c, 0xeb, 0x51, 0x72, 0x38, 0x06, 0x2b, 0xf9, 0x11, 0x07, 0x03,
+	0x88, 0xcc, 0xad, 0xbf, 0x90, 0x99, 0x26, 0x66, 0x0b, 0x14, 0x6d, 0xd2, 0x21, 0x4d, 0x12, 0x02,
+	0x55, 0x74, 0x1f, 0xda, 0x5b, 0x86, 0x3f, 0x45, 0x96, 0x0c, 0x71, 0x78, 0xc9, 0x83, 0xbf, 0x9a,
+	0x4a, 0x36, 0xe9, 0x5e, 0xe7, 0x01, 0xf9, 0x82, 0x83, 0x91, 0xf8, 0xdc, 0x1e, 0x59, 0x49, 0x81,
+	0x25, 0x3c, 0xc9, 0xc8, 0x7f, 0xa6, 0x15, 0xaa, 0x90, 0xe5, 0x15, 0xc6, 0x72, 0x8a, 0x9c, 0x6f,
+	0xcc, 0x32, 0xc8, 0xe8, 0x43, 0x0e, 0xfa, 0xfd, 0xff, 0x78, 0x9b, 0x6c, 0xcd, 0x86, 0xfe, 0x2b,
+	0x2f, 0x3f, 0x93, 0x46, 0x14, 0x49, 0x9c, 0x65, 0x24, 0xc6, 0xc8, 0xc9, 0x08, 0x12, 0x8f, 0xfc,
+	0x28, 0x6d, 0xe0, 0xfe, 0x44, 0x61, 0x32, 0xe0, 0xa1, 0xa9, 0x47, 0x7e, 0x26, 0x8d, 0x68, 0x42,
+	0xe0, 0xaa, 0x1f, 0xa5, 0x7d, 0xb9, 0x09, 0xe6, 0xb1, 0x92, 0x5d, 0x6e, 0x22, 0x32, 0x6e, 0xfc,
+	0x6c, 0x3a, 0xe1, 0x84, 0x97, 0x9b, 0x60, 0x6e, 0x8d, 0x7c, 0xc4, 0xc1, 0xfe, 0x90, 0xc4, 0x16,
+	0x49, 0x74, 0x14, 0x46, 0x27, 0xe4, 0xf8, 0x6b, 0xa9, 0xe5, 0x91, 0xc9, 0x24, 0x63, 0xf2, 0x1a,
+	0x99, 0x88, 0xd9, 0xa6, 0x82, 0xa0, 0x03, 0xde, 0x60, 0x95, 0x8a, 0xa6, 0xbd, 0xe1, 0xad, 0x3a,
+	0xf1, 0xb3, 0xe9, 0x84, 0x9b, 0xf7, 0x86, 0x83, 0x35, 0x40, 0x80, 0xd5, 0x3d, 0x9b, 0x26, 0xe0,
+	0x2d, 0xbe, 0xf2, 0xb3, 0xe9, 0x84, 0x9b, 0x27, 0xe0, 0x60, 0xfd, 0x03, 0x07, 0xbd, 0x77, 0xca,
+	0xd6, 0x7a, 0x65, 0x97, 0xe4, 0x39, 0x13, 0x24, 0xcd, 0xaa, 0x58, 0x43, 0xae, 0x12, 0x3f, 0x73,
+	0x32, 0xb7, 0xd5, 0x21, 0xbb, 0x20, 0xc3, 0xd9, 0xe8, 0xf8, 0xf6, 0x32, 0x22, 0xff, 0x70, 0x1e,
+	0xef, 0x5e, 0xfc, 0xbb, 0x32, 0xb7, 0x39, 0xc3, 0x48, 0x5d, 0x20, 0x93, 0x09, 0x48, 0x05, 0x13,
+	0x9b, 0x4e, 0x46, 0x69, 0xbd, 0xb2, 0xab, 0xb3, 0x9a, 0xb3, 0x8c, 0xe0, 0x34, 0xb9, 0x10, 0x99,
+	0x77, 0x59, 0xaf, 0x44, 0xa7, 0x34, 0x7f, 0xca, 0x41, 0xff, 0x8a, 0x96, 0x2a, 0x0a, 0x5f, 0x52,
+	0x3e, 0xb3, 0xd1, 0x55, 0xcc, 0xc3, 0x87, 0x3c, 0x45, 0xf4, 0xbb, 0x2b, 0x91, 0x79, 0x95, 0x31,
+	0xb8, 0x48, 0xa6, 0x62, 0x18, 0x44, 0x66, 0x31, 0xff, 0xc6, 0x01, 0xf1, 0x53, 0xda, 0x3d, 0x29,
+	0xcc, 0xc6, 0x09, 0xf4, 0x20, 0xee, 0x00, 0xb9, 0xdf, 0xb0, 0xdc, 0xb3, 0x77, 0xd0, 0x2e, 0x49,
+	0x5e, 0x36, 0xba, 0x9b, 0xf9, 0x99, 0x2d, 0x7c, 0xf6, 0xc9, 0xb3, 0x11, 0xee, 0xe9, 0xb3, 0x11,
+	0xee, 0x93, 0x67, 0x23, 0xdc, 0xfb, 0xcf, 0x47, 0xf6, 0x3c, 0x7d, 0x3e, 0xb2, 0xe7, 0xaf, 0xcf,
+	0x47, 0xf6, 0xbc, 0x75, 0xbe, 0xa8, 0x58, 0x9b, 0xe5, 0x8d, 0xac, 0xac, 0x6f, 0x79, 0x55, 0xb9,
+	0xa8, 0x72, 0x15, 0xaf, 0x56, 0xeb, 0x61, 0x89, 0x9a, 0x1b, 0x9d, 0x2c, 0x91, 0x32, 0xf5, 0xff,
+	0x01, 0x00, 0x12, 0x63, 0x98, 0x56, 0x5a, 0x39, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2438,6 +2940,8 @@ type QueryClient interface {
 	InboundHashToCctxData(ctx context.Context, in *QueryInboundHashToCctxDataRequest, opts ...grpc.CallOption) (*QueryInboundHashToCctxDataResponse, error)
 	// Queries a list of InboundHashToCctx items.
 	InboundHashToCctxAll(ctx context.Context, in *QueryAllInboundHashToCctxRequest, opts ...grpc.CallOption) (*QueryAllInboundHashToCctxResponse, error)
+	// Queries the data of all cctxs processed by an outbound hash.
+	OutboundHashToCctxData(ctx context.Context, in *QueryOutboundHashToCctxDataRequest, opts ...grpc.CallOption) (*QueryOutboundHashToCctxDataResponse, error)
 	// Queries a gasPrice by index.
 	GasPrice(ctx context.Context, in *QueryGetGasPriceRequest, opts ...grpc.CallOption) (*QueryGetGasPriceResponse, error)
 	// Queries a list of gasPrice items.
@@ -2454,6 +2958,8 @@ type QueryClient interface {
 	CctxByNonce(ctx context.Context, in *QueryGetCctxByNonceRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error)
 	// Queries a list of cctx items.
 	CctxAll(ctx context.Context, in *QueryAllCctxRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error)
+	// Queries a list of cctx items matching the filters.
+	CctxAllByFilter(ctx context.Context, in *QueryAllCctxByFilterRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error)
 	// Queries a list of pending cctxs.
 	ListPendingCctx(ctx context.Context, in *QueryListPendingCctxRequest, opts ...grpc.CallOption) (*QueryListPendingCctxResponse, error)
 	// Queries a list of pending cctxs within rate limit.
@@ -2463,6 +2969,10 @@ type QueryClient interface {
 	LastZetaHeight(ctx context.Context, in *QueryLastZetaHeightRequest, opts ...grpc.CallOption) (*QueryLastZetaHeightResponse, error)
 	// Queries the rate limiter flags
 	RateLimiterFlags(ctx context.Context, in *QueryRateLimiterFlagsRequest, opts ...grpc.CallOption) (*QueryRateLimiterFlagsResponse, error)
+	// Queries the retention policy of the cctxs
+	CctxRetentionPolicy(ctx context.Context, in *QueryCctxRetentionPolicyRequest, opts ...grpc.CallOption) (*QueryCctxRetentionPolicyResponse, error)
+	// Queries the current utilization of the rate limit buckets
+	RateLimiterUsage(ctx context.Context, in *QueryRateLimiterUsageRequest, opts ...grpc.CallOption) (*QueryRateLimiterUsageResponse, error)
 	// Queries the input data of rate limiter.
 	RateLimiterInput(ctx context.Context, in *QueryRateLimiterInputRequest, opts ...grpc.CallOption) (*QueryRateLimiterInputResponse, error)
 	// Deprecated(v17): use OutboundTracker
@@ -2563,6 +3073,15 @@ func (c *queryClient) InboundHashToCctxAll(ctx context.Context, in *QueryAllInbo
 	return out, nil
 }
 
+func (c *queryClient) OutboundHashToCctxData(ctx context.Context, in *QueryOutboundHashToCctxDataRequest, opts ...grpc.CallOption) (*QueryOutboundHashToCctxDataResponse, error) {
+	out := new(QueryOutboundHashToCctxDataResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutboundHashToCctxData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GasPrice(ctx context.Context, in *QueryGetGasPriceRequest, opts ...grpc.CallOption) (*QueryGetGasPriceResponse, error) {
 	out := new(QueryGetGasPriceResponse)
 	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GasPrice", in, out, opts...)
@@ -2644,6 +3163,15 @@ func (c *queryClient) CctxAll(ctx context.Context, in *QueryAllCctxRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) CctxAllByFilter(ctx context.Context, in *QueryAllCctxByFilterRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error) {
+	out := new(QueryAllCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxAllByFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ListPendingCctx(ctx context.Context, in *QueryListPendingCctxRequest, opts ...grpc.CallOption) (*QueryListPendingCctxResponse, error) {
 	out := new(QueryListPendingCctxResponse)
 	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ListPendingCctx", in, out, opts...)
@@ -2689,6 +3217,24 @@ func (c *queryClient) RateLimiterFlags(ctx context.Context, in *QueryRateLimiter
 	return out, nil
 }
 
+func (c *queryClient) CctxRetentionPolicy(ctx context.Context, in *QueryCctxRetentionPolicyRequest, opts ...grpc.CallOption) (*QueryCctxRetentionPolicyResponse, error) {
+	out := new(QueryCctxRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimiterUsage(ctx context.Context, in *QueryRateLimiterUsageRequest, opts ...grpc.CallOption) (*QueryRateLimiterUsageResponse, error) {
+	out := new(QueryRateLimiterUsageResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/RateLimiterUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) RateLimiterInput(ctx context.Context, in *QueryRateLimiterInputRequest, opts ...grpc.CallOption) (*QueryRateLimiterInputResponse, error) {
 	out := new(QueryRateLimiterInputResponse)
 	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/RateLimiterInput", in, out, opts...)
@@ -2785,6 +3331,8 @@ type QueryServer interface {
 	InboundHashToCctxData(context.Context, *QueryInboundHashToCctxDataRequest) (*QueryInboundHashToCctxDataResponse, error)
 	// Queries a list of InboundHashToCctx items.
 	InboundHashToCctxAll(context.Context, *QueryAllInboundHashToCctxRequest) (*QueryAllInboundHashToCctxResponse, error)
+	// Queries the data of all cctxs processed by an outbound hash.
+	OutboundHashToCctxData(context.Context, *QueryOutboundHashToCctxDataRequest) (*QueryOutboundHashToCctxDataResponse, error)
 	// Queries a gasPrice by index.
 	GasPrice(context.Context, *QueryGetGasPriceRequest) (*QueryGetGasPriceResponse, error)
 	// Queries a list of gasPrice items.
@@ -2801,6 +3349,8 @@ type QueryServer interface {
 	CctxByNonce(context.Context, *QueryGetCctxByNonceRequest) (*QueryGetCctxResponse, error)
 	// Queries a list of cctx items.
 	CctxAll(context.Context, *QueryAllCctxRequest) (*QueryAllCctxResponse, error)
+	// Queries a list of cctx items matching the filters.
+	CctxAllByFilter(context.Context, *QueryAllCctxByFilterRequest) (*QueryAllCctxResponse, error)
 	// Queries a list of pending cctxs.
 	ListPendingCctx(context.Context, *QueryListPendingCctxRequest) (*QueryListPendingCctxResponse, error)
 	// Queries a list of pending cctxs within rate limit.
@@ -2810,6 +3360,10 @@ type QueryServer interface {
 	LastZetaHeight(context.Context, *QueryLastZetaHeightRequest) (*QueryLastZetaHeightResponse, error)
 	// Queries the rate limiter flags
 	RateLimiterFlags(context.Context, *QueryRateLimiterFlagsRequest) (*QueryRateLimiterFlagsResponse, error)
+	// Queries the retention policy of the cctxs
+	CctxRetentionPolicy(context.Context, *QueryCctxRetentionPolicyRequest) (*QueryCctxRetentionPolicyResponse, error)
+	// Queries the current utilization of the rate limit buckets
+	RateLimiterUsage(context.Context, *QueryRateLimiterUsageRequest) (*QueryRateLimiterUsageResponse, error)
 	// Queries the input data of rate limiter.
 	RateLimiterInput(context.Context, *QueryRateLimiterInputRequest) (*QueryRateLimiterInputResponse, error)
 	// Deprecated(v17): use OutboundTracker
@@ -2858,6 +3412,9 @@ func (*UnimplementedQueryServer) InboundHashToCctxData(ctx context.Context, req
 func (*UnimplementedQueryServer) InboundHashToCctxAll(ctx context.Context, req *QueryAllInboundHashToCctxRequest) (*QueryAllInboundHashToCctxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InboundHashToCctxAll not implemented")
 }
+func (*UnimplementedQueryServer) OutboundHashToCctxData(ctx context.Context, req *QueryOutboundHashToCctxDataRequest) (*QueryOutboundHashToCctxDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutboundHashToCctxData not implemented")
+}
 func (*UnimplementedQueryServer) GasPrice(ctx context.Context, req *QueryGetGasPriceRequest) (*QueryGetGasPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GasPrice not implemented")
 }
@@ -2885,6 +3442,9 @@ func (*UnimplementedQueryServer) CctxByNonce(ctx context.Context, req *QueryGetC
 func (*UnimplementedQueryServer) CctxAll(ctx context.Context, req *QueryAllCctxRequest) (*QueryAllCctxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CctxAll not implemented")
 }
+func (*UnimplementedQueryServer) CctxAllByFilter(ctx context.Context, req *QueryAllCctxByFilterRequest) (*QueryAllCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxAllByFilter not implemented")
+}
 func (*UnimplementedQueryServer) ListPendingCctx(ctx context.Context, req *QueryListPendingCctxRequest) (*QueryListPendingCctxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPendingCctx not implemented")
 }
@@ -2900,6 +3460,12 @@ func (*UnimplementedQueryServer) LastZetaHeight(ctx context.Context, req *QueryL
 func (*UnimplementedQueryServer) RateLimiterFlags(ctx context.Context, req *QueryRateLimiterFlagsRequest) (*QueryRateLimiterFlagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RateLimiterFlags not implemented")
 }
+func (*UnimplementedQueryServer) CctxRetentionPolicy(ctx context.Context, req *QueryCctxRetentionPolicyRequest) (*QueryCctxRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxRetentionPolicy not implemented")
+}
+func (*UnimplementedQueryServer) RateLimiterUsage(ctx context.Context, req *QueryRateLimiterUsageRequest) (*QueryRateLimiterUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimiterUsage not implemented")
+}
 func (*UnimplementedQueryServer) RateLimiterInput(ctx context.Context, req *QueryRateLimiterInputRequest) (*QueryRateLimiterInputResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RateLimiterInput not implemented")
 }
@@ -3076,6 +3642,24 @@ func _Query_InboundHashToCctxAll_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OutboundHashToCctxData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutboundHashToCctxDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutboundHashToCctxData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/OutboundHashToCctxData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutboundHashToCctxData(ctx, req.(*QueryOutboundHashToCctxDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetGasPriceRequest)
 	if err := dec(in); err != nil {
@@ -3238,6 +3822,24 @@ func _Query_CctxAll_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CctxAllByFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCctxByFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CctxAllByFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxAllByFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxAllByFilter(ctx, req.(*QueryAllCctxByFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ListPendingCctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryListPendingCctxRequest)
 	if err := dec(in); err != nil {
@@ -3328,6 +3930,42 @@ func _Query_RateLimiterFlags_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CctxRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCctxRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CctxRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxRetentionPolicy(ctx, req.(*QueryCctxRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimiterUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimiterUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimiterUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/RateLimiterUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimiterUsage(ctx, req.(*QueryRateLimiterUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_RateLimiterInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRateLimiterInputRequest)
 	if err := dec(in); err != nil {
@@ -3526,6 +4164,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InboundHashToCctxAll",
 			Handler:    _Query_InboundHashToCctxAll_Handler,
 		},
+		{
+			MethodName: "OutboundHashToCctxData",
+			Handler:    _Query_OutboundHashToCctxData_Handler,
+		},
 		{
 			MethodName: "GasPrice",
 			Handler:    _Query_GasPrice_Handler,
@@ -3562,6 +4204,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CctxAll",
 			Handler:    _Query_CctxAll_Handler,
 		},
+		{
+			MethodName: "CctxAllByFilter",
+			Handler:    _Query_CctxAllByFilter_Handler,
+		},
 		{
 			MethodName: "ListPendingCctx",
 			Handler:    _Query_ListPendingCctx_Handler,
@@ -3582,6 +4228,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RateLimiterFlags",
 			Handler:    _Query_RateLimiterFlags_Handler,
 		},
+		{
+			MethodName: "CctxRetentionPolicy",
+			Handler:    _Query_CctxRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "RateLimiterUsage",
+			Handler:    _Query_RateLimiterUsage_Handler,
+		},
 		{
 			MethodName: "RateLimiterInput",
 			Handler:    _Query_RateLimiterInput_Handler,
@@ -4201,6 +4855,15 @@ func (m *QueryInboundHashToCctxDataResponse) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if len(m.PrunedCctxIndexes) > 0 {
+		for iNdEx := len(m.PrunedCctxIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PrunedCctxIndexes[iNdEx])
+			copy(dAtA[i:], m.PrunedCctxIndexes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PrunedCctxIndexes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.CrossChainTxs) > 0 {
 		for iNdEx := len(m.CrossChainTxs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4302,6 +4965,73 @@ func (m *QueryAllInboundHashToCctxResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryOutboundHashToCctxDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutboundHashToCctxDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutboundHashToCctxDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutboundHash) > 0 {
+		i -= len(m.OutboundHash)
+		copy(dAtA[i:], m.OutboundHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutboundHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutboundHashToCctxDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutboundHashToCctxDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutboundHashToCctxDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CrossChainTxs) > 0 {
+		for iNdEx := len(m.CrossChainTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryGetGasPriceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4782,6 +5512,93 @@ func (m *QueryAllCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAllCctxByFilterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCctxByFilterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCctxByFilterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ReceiverChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReceiverChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SenderChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SenderChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Statuses) > 0 {
+		dAtA24 := make([]byte, len(m.Statuses)*10)
+		var j23 int
+		for _, num := range m.Statuses {
+			for num >= 1<<7 {
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j23++
+			}
+			dAtA24[j23] = uint8(num)
+			j23++
+		}
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintQuery(dAtA, i, uint64(j23))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryListPendingCctxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4910,6 +5727,20 @@ func (m *QueryRateLimiterInputResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.BucketUsages) > 0 {
+		for iNdEx := len(m.BucketUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BucketUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.LowestPendingCctxHeight != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.LowestPendingCctxHeight))
 		i--
@@ -4970,7 +5801,7 @@ func (m *QueryRateLimiterInputResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListPendingCctxWithinRateLimitRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryRateLimiterUsageRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4980,25 +5811,20 @@ func (m *QueryListPendingCctxWithinRateLimitRequest) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *QueryListPendingCctxWithinRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRateLimiterUsageRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListPendingCctxWithinRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRateLimiterUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListPendingCctxWithinRateLimitResponse) Marshal() (dAtA []byte, err error) {
+func (m *RateLimitBucketUtilization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5008,12 +5834,12 @@ func (m *QueryListPendingCctxWithinRateLimitResponse) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *QueryListPendingCctxWithinRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RateLimitBucketUtilization) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListPendingCctxWithinRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RateLimitBucketUtilization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5026,43 +5852,48 @@ func (m *QueryListPendingCctxWithinRateLimitResponse) MarshalToSizedBuffer(dAtA
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x30
 	}
 	if len(m.CurrentWithdrawRate) > 0 {
 		i -= len(m.CurrentWithdrawRate)
 		copy(dAtA[i:], m.CurrentWithdrawRate)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrentWithdrawRate)))
 		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CurrentWithdrawValue) > 0 {
+		i -= len(m.CurrentWithdrawValue)
+		copy(dAtA[i:], m.CurrentWithdrawValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrentWithdrawValue)))
+		i--
 		dAtA[i] = 0x22
 	}
-	if m.CurrentWithdrawWindow != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentWithdrawWindow))
+	if len(m.CurrentWithdrawLimit) > 0 {
+		i -= len(m.CurrentWithdrawLimit)
+		copy(dAtA[i:], m.CurrentWithdrawLimit)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrentWithdrawLimit)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.TotalPending != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPending))
+	if m.CurrentWithdrawWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentWithdrawWindow))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.CrossChainTx) > 0 {
-		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Bucket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastZetaHeightRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryRateLimiterUsageResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5072,20 +5903,39 @@ func (m *QueryLastZetaHeightRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLastZetaHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryRateLimiterUsageResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLastZetaHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryRateLimiterUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastZetaHeightResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryListPendingCctxWithinRateLimitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5095,25 +5945,140 @@ func (m *QueryLastZetaHeightResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLastZetaHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryListPendingCctxWithinRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLastZetaHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryListPendingCctxWithinRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryConvertGasToZetaRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryListPendingCctxWithinRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPendingCctxWithinRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPendingCctxWithinRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RateLimitExceeded {
+		i--
+		if m.RateLimitExceeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CurrentWithdrawRate) > 0 {
+		i -= len(m.CurrentWithdrawRate)
+		copy(dAtA[i:], m.CurrentWithdrawRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrentWithdrawRate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CurrentWithdrawWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentWithdrawWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalPending != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPending))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CrossChainTx) > 0 {
+		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastZetaHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastZetaHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastZetaHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastZetaHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastZetaHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastZetaHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConvertGasToZetaRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5299,6 +6264,62 @@ func (m *QueryRateLimiterFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCctxRetentionPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCctxRetentionPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCctxRetentionPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCctxRetentionPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCctxRetentionPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCctxRetentionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CctxRetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -5541,6 +6562,12 @@ func (m *QueryInboundHashToCctxDataResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.PrunedCctxIndexes) > 0 {
+		for _, s := range m.PrunedCctxIndexes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5576,6 +6603,34 @@ func (m *QueryAllInboundHashToCctxResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryOutboundHashToCctxDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OutboundHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutboundHashToCctxDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTxs) > 0 {
+		for _, e := range m.CrossChainTxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryGetGasPriceRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5765,6 +6820,46 @@ func (m *QueryAllCctxResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAllCctxByFilterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Statuses) > 0 {
+		l = 0
+		for _, e := range m.Statuses {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.SenderChainId != 0 {
+		n += 1 + sovQuery(uint64(m.SenderChainId))
+	}
+	if m.ReceiverChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ReceiverChainId))
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryListPendingCctxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5848,39 +6943,43 @@ func (m *QueryRateLimiterInputResponse) Size() (n int) {
 	if m.LowestPendingCctxHeight != 0 {
 		n += 1 + sovQuery(uint64(m.LowestPendingCctxHeight))
 	}
+	if len(m.BucketUsages) > 0 {
+		for _, e := range m.BucketUsages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *QueryListPendingCctxWithinRateLimitRequest) Size() (n int) {
+func (m *QueryRateLimiterUsageRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
-	}
 	return n
 }
 
-func (m *QueryListPendingCctxWithinRateLimitResponse) Size() (n int) {
+func (m *RateLimitBucketUtilization) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.CrossChainTx) > 0 {
-		for _, e := range m.CrossChainTx {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.TotalPending != 0 {
-		n += 1 + sovQuery(uint64(m.TotalPending))
-	}
+	l = m.Bucket.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	if m.CurrentWithdrawWindow != 0 {
 		n += 1 + sovQuery(uint64(m.CurrentWithdrawWindow))
 	}
+	l = len(m.CurrentWithdrawLimit)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CurrentWithdrawValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	l = len(m.CurrentWithdrawRate)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
@@ -5891,28 +6990,86 @@ func (m *QueryListPendingCctxWithinRateLimitResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryLastZetaHeightRequest) Size() (n int) {
+func (m *QueryRateLimiterUsageResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *QueryLastZetaHeightResponse) Size() (n int) {
+func (m *QueryListPendingCctxWithinRateLimitRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
 	}
 	return n
 }
 
-func (m *QueryConvertGasToZetaRequest) Size() (n int) {
+func (m *QueryListPendingCctxWithinRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTx) > 0 {
+		for _, e := range m.CrossChainTx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalPending != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPending))
+	}
+	if m.CurrentWithdrawWindow != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentWithdrawWindow))
+	}
+	l = len(m.CurrentWithdrawRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RateLimitExceeded {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryLastZetaHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastZetaHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryConvertGasToZetaRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5990,6 +7147,26 @@ func (m *QueryRateLimiterFlagsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCctxRetentionPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCctxRetentionPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CctxRetentionPolicy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7471,6 +8648,38 @@ func (m *QueryInboundHashToCctxDataResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedCctxIndexes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrunedCctxIndexes = append(m.PrunedCctxIndexes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7698,6 +8907,172 @@ func (m *QueryAllInboundHashToCctxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryOutboundHashToCctxDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutboundHashToCctxDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutboundHashToCctxDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutboundHashToCctxDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutboundHashToCctxDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutboundHashToCctxDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossChainTxs = append(m.CrossChainTxs, CrossChainTx{})
+			if err := m.CrossChainTxs[len(m.CrossChainTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8908,7 +10283,302 @@ func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListPendingCctxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCctxByFilterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCctxByFilterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCctxByFilterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v CctxStatus
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CctxStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Statuses = append(m.Statuses, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Statuses) == 0 {
+					m.Statuses = make([]CctxStatus, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CctxStatus
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CctxStatus(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Statuses = append(m.Statuses, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderChainId", wireType)
+			}
+			m.SenderChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChainId", wireType)
+			}
+			m.ReceiverChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPendingCctxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9288,26 +10958,346 @@ func (m *QueryRateLimiterInputResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxsPending = append(m.CctxsPending, &CrossChainTx{})
+			if err := m.CctxsPending[len(m.CctxsPending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPending", wireType)
+			}
+			m.TotalPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPending |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PastCctxsValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PastCctxsValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCctxsValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingCctxsValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestPendingCctxHeight", wireType)
+			}
+			m.LowestPendingCctxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowestPendingCctxHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BucketUsages = append(m.BucketUsages, RateLimitBucketUsage{})
+			if err := m.BucketUsages[len(m.BucketUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimiterUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimiterUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimiterUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitBucketUtilization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitBucketUtilization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitBucketUtilization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWithdrawWindow", wireType)
+			}
+			m.CurrentWithdrawWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentWithdrawWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWithdrawLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CctxsPending = append(m.CctxsPending, &CrossChainTx{})
-			if err := m.CctxsPending[len(m.CctxsPending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CurrentWithdrawLimit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPending", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWithdrawValue", wireType)
 			}
-			m.TotalPending = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9317,14 +11307,27 @@ func (m *QueryRateLimiterInputResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalPending |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentWithdrawValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PastCctxsValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWithdrawRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9352,13 +11355,13 @@ func (m *QueryRateLimiterInputResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PastCctxsValue = string(dAtA[iNdEx:postIndex])
+			m.CurrentWithdrawRate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingCctxsValue", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitExceeded", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9368,29 +11371,67 @@ func (m *QueryRateLimiterInputResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			m.RateLimitExceeded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingCctxsValue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimiterUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimiterUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimiterUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowestPendingCctxHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.LowestPendingCctxHeight = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9400,11 +11441,45 @@ func (m *QueryRateLimiterInputResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LowestPendingCctxHeight |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, RateLimitBucketUtilization{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10287,6 +12362,139 @@ func (m *QueryRateLimiterFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCctxRetentionPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCctxRetentionPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCctxRetentionPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCctxRetentionPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCctxRetentionPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCctxRetentionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxRetentionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CctxRetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,213 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/zeta-chain/zetacore/pkg/coin"
+)
+
+// Name returns the name of the bucket used to identify it in logs and metrics
+func (b RateLimitBucket) Name() string {
+	switch b.Type {
+	case RateLimitBucketType_Chain:
+		return fmt.Sprintf("chain/%d", b.ChainId)
+	case RateLimitBucketType_Asset:
+		return fmt.Sprintf("asset/%s", b.Zrc20)
+	case RateLimitBucketType_AssetNative:
+		return fmt.Sprintf("asset_native/%s", b.Zrc20)
+	default:
+		return "global"
+	}
+}
+
+// IsUsable returns true if the bucket has a window and a rate to limit the withdrawals
+func (b RateLimitBucket) IsUsable() bool {
+	return b.Window > 0 && !b.Rate.IsNil() && !b.Rate.IsZero()
+}
+
+// LeftWindowBoundary returns the left boundary (inclusive) of the sliding window of the bucket at the given height
+func (b RateLimitBucket) LeftWindowBoundary(height int64) int64 {
+	leftWindowBoundary := height - b.Window + 1
+	if leftWindowBoundary < 1 {
+		leftWindowBoundary = 1
+	}
+	return leftWindowBoundary
+}
+
+// IsCctxInWindow returns true if the cctx falls within the sliding window of the bucket at the given height
+func (b RateLimitBucket) IsCctxInWindow(cctx *CrossChainTx, height int64) bool {
+	// #nosec G701 always positive
+	return cctx.InboundParams.ObservedExternalHeight >= uint64(b.LeftWindowBoundary(height))
+}
+
+// Matches returns true if the bucket applies to the withdrawal of the cctx
+func (b RateLimitBucket) Matches(cctx *CrossChainTx) bool {
+	if b.Type == RateLimitBucketType_Global {
+		return true
+	}
+	if cctx.GetCurrentOutboundParam().ReceiverChainId != b.ChainId {
+		return false
+	}
+	if b.Type == RateLimitBucketType_Chain {
+		return true
+	}
+
+	// asset buckets only apply to the withdrawals of the foreign coin
+	if cctx.InboundParams.CoinType != b.CoinType {
+		return false
+	}
+	switch b.CoinType {
+	case coin.CoinType_Gas:
+		return true
+	case coin.CoinType_ERC20:
+		return strings.EqualFold(cctx.InboundParams.Asset, b.Asset)
+	default:
+		return false
+	}
+}
+
+// CctxValue returns the value of the cctx in the unit of the bucket, in native units of the asset or in azeta
+func (b RateLimitBucket) CctxValue(
+	cctx *CrossChainTx,
+	gasAssetRateMap map[int64]AssetRate,
+	erc20AssetRateMap map[int64]map[string]AssetRate,
+) sdkmath.Int {
+	if b.Type == RateLimitBucketType_AssetNative {
+		return sdkmath.NewIntFromBigInt(cctx.GetCurrentOutboundParam().Amount.BigInt())
+	}
+	return ConvertCctxValueToAzeta(
+		cctx.GetCurrentOutboundParam().ReceiverChainId,
+		cctx,
+		gasAssetRateMap,
+		erc20AssetRateMap,
+	)
+}
+
+// NewRateLimitBucketUsages creates the empty usages of the given buckets
+func NewRateLimitBucketUsages(buckets []RateLimitBucket) []RateLimitBucketUsage {
+	usages := make([]RateLimitBucketUsage, 0, len(buckets))
+	for _, bucket := range buckets {
+		usages = append(usages, RateLimitBucketUsage{
+			Bucket:            bucket,
+			PastCctxsValue:    sdkmath.ZeroInt(),
+			PendingCctxsValue: sdkmath.ZeroInt(),
+		})
+	}
+	return usages
+}
+
+// AddPastCctx adds the value of the past cctx if it falls within the window of the bucket
+func (u *RateLimitBucketUsage) AddPastCctx(
+	cctx *CrossChainTx,
+	height int64,
+	gasAssetRateMap map[int64]AssetRate,
+	erc20AssetRateMap map[int64]map[string]AssetRate,
+) {
+	if u.Bucket.Matches(cctx) && u.Bucket.IsCctxInWindow(cctx, height) {
+		u.PastCctxsValue = u.PastCctxsValue.Add(u.Bucket.CctxValue(cctx, gasAssetRateMap, erc20AssetRateMap))
+	}
+}
+
+// AddPendingCctx adds the value of the pending cctx
+func (u *RateLimitBucketUsage) AddPendingCctx(
+	cctx *CrossChainTx,
+	gasAssetRateMap map[int64]AssetRate,
+	erc20AssetRateMap map[int64]map[string]AssetRate,
+) {
+	if u.Bucket.Matches(cctx) {
+		u.PendingCctxsValue = u.PendingCctxsValue.Add(u.Bucket.CctxValue(cctx, gasAssetRateMap, erc20AssetRateMap))
+	}
+}
+
+// UpdateLowestPendingCctxHeight updates the lowest height of the pending cctxs with the lowest pending cctx of a chain
+func (u *RateLimitBucketUsage) UpdateLowestPendingCctxHeight(chainID int64, height int64) {
+	if u.Bucket.Type != RateLimitBucketType_Global && u.Bucket.ChainId != chainID {
+		return
+	}
+	if u.LowestPendingCctxHeight == 0 || height < u.LowestPendingCctxHeight {
+		u.LowestPendingCctxHeight = height
+	}
+}
+
+// WithdrawWindow returns the current sliding window within which the withdrawals are considered
+// the window is widened to the lowest height of the pending cctxs if it is wider than the window of the bucket
+func (u RateLimitBucketUsage) WithdrawWindow(height int64) int64 {
+	withdrawWindow := u.Bucket.Window
+	if u.LowestPendingCctxHeight != 0 {
+		pendingCctxWindow := height - u.LowestPendingCctxHeight + 1
+		if pendingCctxWindow > withdrawWindow {
+			withdrawWindow = pendingCctxWindow
+		}
+	}
+	return withdrawWindow
+}
+
+// WithdrawLimit returns the withdraw limit within the current sliding window
+func (u RateLimitBucketUsage) WithdrawLimit(height int64) sdkmath.Int {
+	return sdkmath.NewIntFromBigInt(u.Bucket.Rate.BigInt()).Mul(sdkmath.NewInt(u.WithdrawWindow(height)))
+}
+
+// WithdrawValue returns the total value of the past and pending cctxs
+func (u RateLimitBucketUsage) WithdrawValue() sdkmath.Int {
+	return u.PastCctxsValue.Add(u.PendingCctxsValue)
+}
+
+// IsExceeded returns true if the total value of the cctxs exceeds the withdraw limit of the bucket
+func (u RateLimitBucketUsage) IsExceeded(height int64) bool {
+	if !u.Bucket.IsUsable() {
+		return false
+	}
+	return u.WithdrawValue().GT(u.WithdrawLimit(height))
+}
+
+// Utilization returns the current utilization of the bucket
+func (u RateLimitBucketUsage) Utilization(height int64) RateLimitBucketUtilization {
+	withdrawWindow := u.WithdrawWindow(height)
+	withdrawRate := sdkmath.ZeroInt()
+	if withdrawWindow > 0 {
+		withdrawRate = u.WithdrawValue().Quo(sdkmath.NewInt(withdrawWindow))
+	}
+	return RateLimitBucketUtilization{
+		Bucket:                u.Bucket,
+		CurrentWithdrawWindow: withdrawWindow,
+		CurrentWithdrawLimit:  u.WithdrawLimit(height).String(),
+		CurrentWithdrawValue:  u.WithdrawValue().String(),
+		CurrentWithdrawRate:   withdrawRate.String(),
+		RateLimitExceeded:     u.IsExceeded(height),
+	}
+}
+
+// RateLimitHeldNonces returns the lowest nonce of the pending cctxs held by the exceeded buckets for each chain
+// the outbounds of a chain are processed in nonce order, so the pending cctxs of the chain from this nonce are held
+func RateLimitHeldNonces(
+	cctxs []*CrossChainTx,
+	usages []RateLimitBucketUsage,
+	height int64,
+) map[int64]uint64 {
+	heldNonces := make(map[int64]uint64)
+	for _, usage := range usages {
+		if !usage.IsExceeded(height) {
+			continue
+		}
+		for _, cctx := range cctxs {
+			if !usage.Bucket.Matches(cctx) {
+				continue
+			}
+			chainID := cctx.GetCurrentOutboundParam().ReceiverChainId
+			nonce := cctx.GetCurrentOutboundParam().TssNonce
+			if heldNonce, found := heldNonces[chainID]; !found || nonce < heldNonce {
+				heldNonces[chainID] = nonce
+			}
+		}
+	}
+	return heldNonces
+}
+
+// IsRateLimitHeld returns true if the pending cctx is held by the exceeded buckets
+func IsRateLimitHeld(cctx *CrossChainTx, heldNonces map[int64]uint64) bool {
+	heldNonce, found := heldNonces[cctx.GetCurrentOutboundParam().ReceiverChainId]
+	return found && cctx.GetCurrentOutboundParam().TssNonce >= heldNonce
+}
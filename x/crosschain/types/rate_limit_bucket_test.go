@@ -0,0 +1,198 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/pkg/coin"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestRateLimitBucket_Name(t *testing.T) {
+	require.Equal(t, "global", types.RateLimitBucket{Type: types.RateLimitBucketType_Global}.Name())
+	require.Equal(t, "chain/1", types.RateLimitBucket{Type: types.RateLimitBucketType_Chain, ChainId: 1}.Name())
+	require.Equal(t, "asset/0xabc", types.RateLimitBucket{Type: types.RateLimitBucketType_Asset, Zrc20: "0xabc"}.Name())
+	require.Equal(
+		t,
+		"asset_native/0xabc",
+		types.RateLimitBucket{Type: types.RateLimitBucketType_AssetNative, Zrc20: "0xabc"}.Name(),
+	)
+}
+
+func TestRateLimitBucket_Matches(t *testing.T) {
+	ethChainID := chains.Ethereum.ChainId
+	btcChainID := chains.BitcoinMainnet.ChainId
+	zetaChainID := chains.ZetaChainMainnet.ChainId
+	usdtAsset := sample.EthAddress().Hex()
+
+	ethCctx := sample.CustomCctxsInBlockRange(
+		t, 1, 1, zetaChainID, ethChainID, coin.CoinType_Gas, "", 1e18, types.CctxStatus_PendingOutbound)[0]
+	usdtCctx := sample.CustomCctxsInBlockRange(
+		t, 2, 2, zetaChainID, ethChainID, coin.CoinType_ERC20, usdtAsset, 1e6, types.CctxStatus_PendingOutbound)[0]
+	btcCctx := sample.CustomCctxsInBlockRange(
+		t, 3, 3, zetaChainID, btcChainID, coin.CoinType_Gas, "", 1e8, types.CctxStatus_PendingOutbound)[0]
+
+	tests := []struct {
+		name     string
+		bucket   types.RateLimitBucket
+		expected []bool
+	}{
+		{
+			name:     "global bucket matches all cctxs",
+			bucket:   types.RateLimitBucket{Type: types.RateLimitBucketType_Global},
+			expected: []bool{true, true, true},
+		},
+		{
+			name:     "chain bucket matches the cctxs to the chain",
+			bucket:   types.RateLimitBucket{Type: types.RateLimitBucketType_Chain, ChainId: ethChainID},
+			expected: []bool{true, true, false},
+		},
+		{
+			name: "gas asset bucket matches the gas withdrawals to the chain",
+			bucket: types.RateLimitBucket{
+				Type:     types.RateLimitBucketType_Asset,
+				ChainId:  ethChainID,
+				CoinType: coin.CoinType_Gas,
+			},
+			expected: []bool{true, false, false},
+		},
+		{
+			name: "erc20 asset bucket matches the withdrawals of the asset",
+			bucket: types.RateLimitBucket{
+				Type:     types.RateLimitBucketType_AssetNative,
+				ChainId:  ethChainID,
+				CoinType: coin.CoinType_ERC20,
+				Asset:    usdtAsset,
+			},
+			expected: []bool{false, true, false},
+		},
+		{
+			name: "erc20 asset bucket does not match the withdrawals of another asset",
+			bucket: types.RateLimitBucket{
+				Type:     types.RateLimitBucketType_Asset,
+				ChainId:  ethChainID,
+				CoinType: coin.CoinType_ERC20,
+				Asset:    sample.EthAddress().Hex(),
+			},
+			expected: []bool{false, false, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, cctx := range []*types.CrossChainTx{ethCctx, usdtCctx, btcCctx} {
+				require.Equal(t, tt.expected[i], tt.bucket.Matches(cctx))
+			}
+		})
+	}
+}
+
+func TestRateLimitBucket_CctxValue(t *testing.T) {
+	ethChainID := chains.Ethereum.ChainId
+	zetaChainID := chains.ZetaChainMainnet.ChainId
+	assetRateList := []types.AssetRate{
+		sample.CustomAssetRate(ethChainID, sample.EthAddress().Hex(), 18, coin.CoinType_Gas, sdk.NewDec(2500)),
+	}
+	gasAssetRateMap, erc20AssetRateMap := types.BuildAssetRateMapFromList(assetRateList)
+
+	// 0.5 ETH
+	cctx := sample.CustomCctxsInBlockRange(
+		t, 1, 1, zetaChainID, ethChainID, coin.CoinType_Gas, "", 5e17, types.CctxStatus_PendingOutbound)[0]
+
+	t.Run("should return the value in azeta", func(t *testing.T) {
+		bucket := types.RateLimitBucket{Type: types.RateLimitBucketType_Asset}
+		value := bucket.CctxValue(cctx, gasAssetRateMap, erc20AssetRateMap)
+		require.Equal(t, sdk.NewInt(1250).Mul(sdk.NewInt(1e18)), value)
+	})
+
+	t.Run("should return the value in native units", func(t *testing.T) {
+		bucket := types.RateLimitBucket{Type: types.RateLimitBucketType_AssetNative}
+		value := bucket.CctxValue(cctx, gasAssetRateMap, erc20AssetRateMap)
+		require.Equal(t, sdk.NewInt(5e17), value)
+	})
+}
+
+func TestRateLimitBucketUsage(t *testing.T) {
+	ethChainID := chains.Ethereum.ChainId
+	btcChainID := chains.BitcoinMainnet.ChainId
+	zetaChainID := chains.ZetaChainMainnet.ChainId
+
+	// 1 BTC per cctx, in native units
+	btcBucket := types.RateLimitBucket{
+		Type:     types.RateLimitBucketType_AssetNative,
+		ChainId:  btcChainID,
+		CoinType: coin.CoinType_Gas,
+		Window:   50,
+		Rate:     sdkmath.NewUint(1e8),
+	}
+
+	// 20 past cctxs in block [31, 50] and 40 pending cctxs in block [51, 90]
+	btcCctxsPast := sample.CustomCctxsInBlockRange(
+		t, 31, 50, zetaChainID, btcChainID, coin.CoinType_Gas, "", 1e8, types.CctxStatus_OutboundMined)
+	btcCctxsPending := sample.CustomCctxsInBlockRange(
+		t, 51, 90, zetaChainID, btcChainID, coin.CoinType_Gas, "", 1e8, types.CctxStatus_PendingOutbound)
+	ethCctxsPending := sample.CustomCctxsInBlockRange(
+		t, 51, 90, zetaChainID, ethChainID, coin.CoinType_Gas, "", 1e18, types.CctxStatus_PendingOutbound)
+	allCctxsPending := append(append([]*types.CrossChainTx{}, btcCctxsPending...), ethCctxsPending...)
+
+	newUsage := func(height int64) types.RateLimitBucketUsage {
+		usage := types.NewRateLimitBucketUsages([]types.RateLimitBucket{btcBucket})[0]
+		for _, cctx := range btcCctxsPast {
+			usage.AddPastCctx(cctx, height, nil, nil)
+		}
+		for _, cctx := range allCctxsPending {
+			usage.AddPendingCctx(cctx, nil, nil)
+		}
+		usage.UpdateLowestPendingCctxHeight(ethChainID, 51)
+		usage.UpdateLowestPendingCctxHeight(btcChainID, 51)
+		return usage
+	}
+
+	t.Run("should hold the pending cctxs of the chain if the bucket is exceeded", func(t *testing.T) {
+		// window [41, 90] contains 10 past and 40 pending BTC cctxs, 50 BTC over 50 blocks is within the limit
+		usage := newUsage(90)
+		require.Equal(t, sdk.NewInt(10e8), usage.PastCctxsValue)
+		require.Equal(t, sdk.NewInt(40e8), usage.PendingCctxsValue)
+		require.False(t, usage.IsExceeded(90))
+
+		// add 1 more BTC to exceed the limit
+		usage.PendingCctxsValue = usage.PendingCctxsValue.Add(sdk.NewInt(1e8))
+		require.True(t, usage.IsExceeded(90))
+
+		heldNonces := types.RateLimitHeldNonces(allCctxsPending, []types.RateLimitBucketUsage{usage}, 90)
+		require.Equal(t, map[int64]uint64{btcChainID: 50}, heldNonces)
+		for _, cctx := range btcCctxsPending {
+			require.True(t, types.IsRateLimitHeld(cctx, heldNonces))
+		}
+		for _, cctx := range ethCctxsPending {
+			require.False(t, types.IsRateLimitHeld(cctx, heldNonces))
+		}
+
+		utilization := usage.Utilization(90)
+		require.Equal(t, btcBucket, utilization.Bucket)
+		require.Equal(t, int64(50), utilization.CurrentWithdrawWindow)
+		require.Equal(t, sdk.NewInt(50e8).String(), utilization.CurrentWithdrawLimit)
+		require.Equal(t, sdk.NewInt(51e8).String(), utilization.CurrentWithdrawValue)
+		require.Equal(t, sdk.NewInt(102e6).String(), utilization.CurrentWithdrawRate)
+		require.True(t, utilization.RateLimitExceeded)
+	})
+
+	t.Run("should widen the window to the lowest pending cctx height", func(t *testing.T) {
+		usage := newUsage(120)
+		require.Equal(t, int64(70), usage.WithdrawWindow(120))
+		require.Equal(t, sdk.NewInt(70e8), usage.WithdrawLimit(120))
+		require.False(t, usage.IsExceeded(120))
+	})
+
+	t.Run("should not be exceeded if the bucket is not usable", func(t *testing.T) {
+		usage := newUsage(90)
+		usage.Bucket.Rate = sdkmath.ZeroUint()
+		require.False(t, usage.IsExceeded(90))
+		require.Empty(t, types.RateLimitHeldNonces(allCctxsPending, []types.RateLimitBucketUsage{usage}, 90))
+	})
+}
@@ -37,9 +37,95 @@ func (r RateLimiterFlags) Validate() error {
 		}
 	}
 
+	seenChains := make(map[int64]bool)
+	for _, chainRateLimit := range r.ChainRateLimits {
+		// check no duplicated chain rate limit
+		if seenChains[chainRateLimit.ChainId] {
+			return fmt.Errorf("duplicated chain rate limit: %d", chainRateLimit.ChainId)
+		}
+		seenChains[chainRateLimit.ChainId] = true
+
+		if chainRateLimit.Window <= 0 {
+			return fmt.Errorf(
+				"window must be positive for chain rate limit %d: %d",
+				chainRateLimit.ChainId,
+				chainRateLimit.Window,
+			)
+		}
+		if chainRateLimit.Rate.IsNil() || chainRateLimit.Rate.IsZero() {
+			return fmt.Errorf("rate must be positive for chain rate limit: %d", chainRateLimit.ChainId)
+		}
+	}
+
+	seenAssets := make(map[string]bool)
+	for _, assetRateLimit := range r.AssetRateLimits {
+		// check address is valid and no duplicated asset rate limit
+		if !ethcommon.IsHexAddress(assetRateLimit.Zrc20) {
+			return fmt.Errorf("invalid zrc20 address (%s)", assetRateLimit.Zrc20)
+		}
+		zrc20 := strings.ToLower(assetRateLimit.Zrc20)
+		if seenAssets[zrc20] {
+			return fmt.Errorf("duplicated asset rate limit: %s", assetRateLimit.Zrc20)
+		}
+		seenAssets[zrc20] = true
+
+		if assetRateLimit.Window <= 0 {
+			return fmt.Errorf(
+				"window must be positive for asset rate limit %s: %d",
+				assetRateLimit.Zrc20,
+				assetRateLimit.Window,
+			)
+		}
+		hasRate := !assetRateLimit.Rate.IsNil() && !assetRateLimit.Rate.IsZero()
+		hasNativeRate := !assetRateLimit.NativeRate.IsNil() && !assetRateLimit.NativeRate.IsZero()
+		if !hasRate && !hasNativeRate {
+			return fmt.Errorf("rate or native rate must be positive for asset rate limit: %s", assetRateLimit.Zrc20)
+		}
+	}
+
 	return nil
 }
 
+// HasGlobalRateLimit returns true if the rate limit across all chains is set
+func (r RateLimiterFlags) HasGlobalRateLimit() bool {
+	return r.Window > 0 && !r.Rate.IsNil() && !r.Rate.IsZero()
+}
+
+// GlobalRateLimitBucket returns the bucket of the rate limit across all chains
+func (r RateLimiterFlags) GlobalRateLimitBucket() RateLimitBucket {
+	return RateLimitBucket{
+		Type:   RateLimitBucketType_Global,
+		Window: r.Window,
+		Rate:   r.Rate,
+	}
+}
+
+// HasBucketRateLimits returns true if any rate limit per chain or per asset is set
+func (r RateLimiterFlags) HasBucketRateLimits() bool {
+	return len(r.ChainRateLimits) > 0 || len(r.AssetRateLimits) > 0
+}
+
+// InputWindow returns the window to query the rate limiter input with
+// it is the global window if the global rate limit is set,
+// the largest window of the chain and asset rate limits otherwise
+func (r RateLimiterFlags) InputWindow() int64 {
+	if r.HasGlobalRateLimit() {
+		return r.Window
+	}
+	window := int64(0)
+	for _, chainRateLimit := range r.ChainRateLimits {
+		if chainRateLimit.Window > window {
+			window = chainRateLimit.Window
+		}
+	}
+	for _, assetRateLimit := range r.AssetRateLimits {
+		if assetRateLimit.Window > window {
+			window = assetRateLimit.Window
+		}
+	}
+	return window
+}
+
 // GetConversionRate returns the conversion rate for the given zrc20
 func (r RateLimiterFlags) GetConversionRate(zrc20 string) (sdk.Dec, bool) {
 	for _, conversion := range r.Conversions {
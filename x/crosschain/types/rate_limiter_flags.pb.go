@@ -25,6 +25,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RateLimitBucketType is the type of the withdrawals a rate limit bucket
+// applies to
+type RateLimitBucketType int32
+
+const (
+	RateLimitBucketType_Global      RateLimitBucketType = 0
+	RateLimitBucketType_Chain       RateLimitBucketType = 1
+	RateLimitBucketType_Asset       RateLimitBucketType = 2
+	RateLimitBucketType_AssetNative RateLimitBucketType = 3
+)
+
+var RateLimitBucketType_name = map[int32]string{
+	0: "Global",
+	1: "Chain",
+	2: "Asset",
+	3: "AssetNative",
+}
+
+var RateLimitBucketType_value = map[string]int32{
+	"Global":      0,
+	"Chain":       1,
+	"Asset":       2,
+	"AssetNative": 3,
+}
+
+func (x RateLimitBucketType) String() string {
+	return proto.EnumName(RateLimitBucketType_name, int32(x))
+}
+
+func (RateLimitBucketType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9c435f4c2dabc0eb, []int{0}
+}
+
 type RateLimiterFlags struct {
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	// window in blocks
@@ -33,6 +66,10 @@ type RateLimiterFlags struct {
 	Rate github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"rate"`
 	// conversion in azeta per token
 	Conversions []Conversion `protobuf:"bytes,4,rep,name=conversions,proto3" json:"conversions"`
+	// rate limits of the withdrawals to a receiver chain
+	ChainRateLimits []ChainRateLimit `protobuf:"bytes,5,rep,name=chain_rate_limits,json=chainRateLimits,proto3" json:"chain_rate_limits"`
+	// rate limits of the withdrawals of a zrc20 asset
+	AssetRateLimits []AssetRateLimit `protobuf:"bytes,6,rep,name=asset_rate_limits,json=assetRateLimits,proto3" json:"asset_rate_limits"`
 }
 
 func (m *RateLimiterFlags) Reset()         { *m = RateLimiterFlags{} }
@@ -89,6 +126,283 @@ func (m *RateLimiterFlags) GetConversions() []Conversion {
 	return nil
 }
 
+func (m *RateLimiterFlags) GetChainRateLimits() []ChainRateLimit {
+	if m != nil {
+		return m.ChainRateLimits
+	}
+	return nil
+}
+
+func (m *RateLimiterFlags) GetAssetRateLimits() []AssetRateLimit {
+	if m != nil {
+		return m.AssetRateLimits
+	}
+	return nil
+}
+
+type ChainRateLimit struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// window in blocks
+	Window int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// rate in azeta per block
+	Rate github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"rate"`
+}
+
+func (m *ChainRateLimit) Reset()         { *m = ChainRateLimit{} }
+func (m *ChainRateLimit) String() string { return proto.CompactTextString(m) }
+func (*ChainRateLimit) ProtoMessage()    {}
+func (*ChainRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c435f4c2dabc0eb, []int{1}
+}
+func (m *ChainRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainRateLimit.Merge(m, src)
+}
+func (m *ChainRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainRateLimit proto.InternalMessageInfo
+
+func (m *ChainRateLimit) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ChainRateLimit) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+type AssetRateLimit struct {
+	Zrc20 string `protobuf:"bytes,1,opt,name=zrc20,proto3" json:"zrc20,omitempty"`
+	// window in blocks
+	Window int64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// rate in azeta per block, the asset is not limited in azeta if zero
+	Rate github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"rate"`
+	// rate in native units of the asset per block, the asset is not limited in
+	// native units if zero
+	NativeRate github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=native_rate,json=nativeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"native_rate"`
+}
+
+func (m *AssetRateLimit) Reset()         { *m = AssetRateLimit{} }
+func (m *AssetRateLimit) String() string { return proto.CompactTextString(m) }
+func (*AssetRateLimit) ProtoMessage()    {}
+func (*AssetRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c435f4c2dabc0eb, []int{2}
+}
+func (m *AssetRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetRateLimit.Merge(m, src)
+}
+func (m *AssetRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetRateLimit proto.InternalMessageInfo
+
+func (m *AssetRateLimit) GetZrc20() string {
+	if m != nil {
+		return m.Zrc20
+	}
+	return ""
+}
+
+func (m *AssetRateLimit) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// RateLimitBucket is a rate limit applied to a set of withdrawals
+type RateLimitBucket struct {
+	Type RateLimitBucketType `protobuf:"varint,1,opt,name=type,proto3,enum=zetachain.zetacore.crosschain.RateLimitBucketType" json:"type,omitempty"`
+	// receiver chain of the withdrawals, zero for the global bucket
+	ChainId int64 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// zrc20 of the asset buckets
+	Zrc20 string `protobuf:"bytes,3,opt,name=zrc20,proto3" json:"zrc20,omitempty"`
+	// coin type and asset of the foreign coin of the asset buckets
+	CoinType coin.CoinType `protobuf:"varint,4,opt,name=coin_type,json=coinType,proto3,enum=zetachain.zetacore.pkg.coin.CoinType" json:"coin_type,omitempty"`
+	Asset    string        `protobuf:"bytes,5,opt,name=asset,proto3" json:"asset,omitempty"`
+	// window in blocks
+	Window int64 `protobuf:"varint,6,opt,name=window,proto3" json:"window,omitempty"`
+	// rate per block in the unit of the bucket
+	Rate github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,7,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"rate"`
+}
+
+func (m *RateLimitBucket) Reset()         { *m = RateLimitBucket{} }
+func (m *RateLimitBucket) String() string { return proto.CompactTextString(m) }
+func (*RateLimitBucket) ProtoMessage()    {}
+func (*RateLimitBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c435f4c2dabc0eb, []int{3}
+}
+func (m *RateLimitBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitBucket.Merge(m, src)
+}
+func (m *RateLimitBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitBucket proto.InternalMessageInfo
+
+func (m *RateLimitBucket) GetType() RateLimitBucketType {
+	if m != nil {
+		return m.Type
+	}
+	return RateLimitBucketType_Global
+}
+
+func (m *RateLimitBucket) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *RateLimitBucket) GetZrc20() string {
+	if m != nil {
+		return m.Zrc20
+	}
+	return ""
+}
+
+func (m *RateLimitBucket) GetCoinType() coin.CoinType {
+	if m != nil {
+		return m.CoinType
+	}
+	return coin.CoinType_Zeta
+}
+
+func (m *RateLimitBucket) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *RateLimitBucket) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// RateLimitBucketUsage is the value of the withdrawals of a bucket in the unit
+// of the bucket
+type RateLimitBucketUsage struct {
+	Bucket RateLimitBucket `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket"`
+	// total value of the past cctxs within the window of the bucket
+	PastCctxsValue github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=past_cctxs_value,json=pastCctxsValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"past_cctxs_value"`
+	// total value of the pending cctxs
+	PendingCctxsValue github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=pending_cctxs_value,json=pendingCctxsValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pending_cctxs_value"`
+	// the lowest height of the pending cctxs of the bucket
+	LowestPendingCctxHeight int64 `protobuf:"varint,4,opt,name=lowest_pending_cctx_height,json=lowestPendingCctxHeight,proto3" json:"lowest_pending_cctx_height,omitempty"`
+}
+
+func (m *RateLimitBucketUsage) Reset()         { *m = RateLimitBucketUsage{} }
+func (m *RateLimitBucketUsage) String() string { return proto.CompactTextString(m) }
+func (*RateLimitBucketUsage) ProtoMessage()    {}
+func (*RateLimitBucketUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c435f4c2dabc0eb, []int{4}
+}
+func (m *RateLimitBucketUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitBucketUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitBucketUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitBucketUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitBucketUsage.Merge(m, src)
+}
+func (m *RateLimitBucketUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitBucketUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitBucketUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitBucketUsage proto.InternalMessageInfo
+
+func (m *RateLimitBucketUsage) GetBucket() RateLimitBucket {
+	if m != nil {
+		return m.Bucket
+	}
+	return RateLimitBucket{}
+}
+
+func (m *RateLimitBucketUsage) GetLowestPendingCctxHeight() int64 {
+	if m != nil {
+		return m.LowestPendingCctxHeight
+	}
+	return 0
+}
+
 type Conversion struct {
 	Zrc20 string                                 `protobuf:"bytes,1,opt,name=zrc20,proto3" json:"zrc20,omitempty"`
 	Rate  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
@@ -98,7 +412,7 @@ func (m *Conversion) Reset()         { *m = Conversion{} }
 func (m *Conversion) String() string { return proto.CompactTextString(m) }
 func (*Conversion) ProtoMessage()    {}
 func (*Conversion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c435f4c2dabc0eb, []int{1}
+	return fileDescriptor_9c435f4c2dabc0eb, []int{5}
 }
 func (m *Conversion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,7 +460,7 @@ func (m *AssetRate) Reset()         { *m = AssetRate{} }
 func (m *AssetRate) String() string { return proto.CompactTextString(m) }
 func (*AssetRate) ProtoMessage()    {}
 func (*AssetRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c435f4c2dabc0eb, []int{2}
+	return fileDescriptor_9c435f4c2dabc0eb, []int{6}
 }
 func (m *AssetRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,7 +518,12 @@ func (m *AssetRate) GetCoinType() coin.CoinType {
 }
 
 func init() {
+	proto.RegisterEnum("zetachain.zetacore.crosschain.RateLimitBucketType", RateLimitBucketType_name, RateLimitBucketType_value)
 	proto.RegisterType((*RateLimiterFlags)(nil), "zetachain.zetacore.crosschain.RateLimiterFlags")
+	proto.RegisterType((*ChainRateLimit)(nil), "zetachain.zetacore.crosschain.ChainRateLimit")
+	proto.RegisterType((*AssetRateLimit)(nil), "zetachain.zetacore.crosschain.AssetRateLimit")
+	proto.RegisterType((*RateLimitBucket)(nil), "zetachain.zetacore.crosschain.RateLimitBucket")
+	proto.RegisterType((*RateLimitBucketUsage)(nil), "zetachain.zetacore.crosschain.RateLimitBucketUsage")
 	proto.RegisterType((*Conversion)(nil), "zetachain.zetacore.crosschain.Conversion")
 	proto.RegisterType((*AssetRate)(nil), "zetachain.zetacore.crosschain.AssetRate")
 }
@@ -214,35 +533,54 @@ func init() {
 }
 
 var fileDescriptor_9c435f4c2dabc0eb = []byte{
-	// 435 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x34, 0x69, 0xcd, 0x4e, 0x51, 0x64, 0x28, 0xb2, 0x04, 0xdc, 0x2e, 0x01, 0xeb, 0x7a,
-	0xe8, 0xac, 0x46, 0xf0, 0xee, 0x46, 0x04, 0xd1, 0x8b, 0x83, 0x5e, 0xbc, 0x84, 0xc9, 0xec, 0x74,
-	0x3b, 0x64, 0x77, 0x66, 0xd9, 0x19, 0xad, 0xed, 0xaf, 0xf0, 0x67, 0xf5, 0xd8, 0xa3, 0x88, 0x54,
-	0x49, 0xfe, 0x88, 0xcc, 0xcc, 0x66, 0x1b, 0x24, 0x88, 0xf4, 0x92, 0xbc, 0x6f, 0x79, 0xdf, 0xfb,
-	0xbe, 0xf7, 0xcd, 0x83, 0x2f, 0x2e, 0xb8, 0xa1, 0xec, 0x94, 0x0a, 0x99, 0xba, 0x4a, 0x35, 0x3c,
-	0x65, 0x8d, 0xd2, 0xda, 0x7f, 0x6b, 0xa8, 0xe1, 0xb3, 0x52, 0x54, 0xc2, 0xf0, 0x66, 0x76, 0x52,
-	0xd2, 0x42, 0xe3, 0xba, 0x51, 0x46, 0xa1, 0x87, 0x1d, 0x0f, 0xaf, 0x79, 0xf8, 0x86, 0x37, 0x3a,
-	0x28, 0x54, 0xa1, 0x5c, 0x67, 0x6a, 0x2b, 0x4f, 0x1a, 0x1d, 0x6d, 0x11, 0xab, 0x17, 0x45, 0xca,
-	0x94, 0x90, 0xee, 0xc7, 0xf7, 0x8d, 0x7f, 0x02, 0x78, 0x9f, 0x50, 0xc3, 0xdf, 0x79, 0xe1, 0xd7,
-	0x56, 0x17, 0x85, 0xf0, 0x0e, 0x97, 0x74, 0x5e, 0xf2, 0x3c, 0x04, 0x31, 0x48, 0x86, 0x64, 0x0d,
-	0xd1, 0x03, 0xb8, 0x77, 0x26, 0x64, 0xae, 0xce, 0xc2, 0x9d, 0x18, 0x24, 0x7d, 0xd2, 0x22, 0x34,
-	0x85, 0x03, 0xeb, 0x3f, 0xec, 0xc7, 0x20, 0x09, 0xb2, 0xf4, 0xf2, 0xfa, 0xb0, 0xf7, 0xe3, 0xfa,
-	0xf0, 0x71, 0x21, 0xcc, 0xe9, 0xe7, 0x39, 0x66, 0xaa, 0x4a, 0x99, 0xd2, 0x95, 0xd2, 0xed, 0xdf,
-	0xb1, 0xce, 0x17, 0xa9, 0x39, 0xaf, 0xb9, 0xc6, 0x1f, 0x85, 0x34, 0xc4, 0x91, 0xd1, 0x7b, 0xb8,
-	0xcf, 0x94, 0xfc, 0xc2, 0x1b, 0x2d, 0x94, 0xd4, 0xe1, 0x20, 0xee, 0x27, 0xfb, 0x93, 0x27, 0xf8,
-	0x9f, 0xeb, 0xe3, 0x69, 0xc7, 0xc8, 0x06, 0x56, 0x96, 0x6c, 0xce, 0x18, 0x9f, 0x40, 0x78, 0xd3,
-	0x80, 0x0e, 0xe0, 0xee, 0x45, 0xc3, 0x26, 0x4f, 0xdd, 0x56, 0x01, 0xf1, 0x00, 0x65, 0xad, 0xf7,
-	0x1d, 0xe7, 0x1d, 0xb7, 0xde, 0x8f, 0xfe, 0xc3, 0xfb, 0x2b, 0xce, 0xbc, 0xf5, 0xf1, 0x2f, 0x00,
-	0x83, 0x97, 0x5a, 0x73, 0x63, 0xb3, 0xb4, 0xf9, 0x39, 0x73, 0x6f, 0x7c, 0x7e, 0x7d, 0xb2, 0x86,
-	0xd6, 0x01, 0xb5, 0x6d, 0x5e, 0x8c, 0x78, 0x80, 0x46, 0x70, 0x98, 0x73, 0x26, 0x2a, 0x5a, 0x6a,
-	0x97, 0xe0, 0x5d, 0xd2, 0x61, 0x94, 0xc1, 0xc0, 0x3e, 0xd7, 0xcc, 0x2a, 0x86, 0x83, 0x18, 0x24,
-	0xf7, 0x26, 0x8f, 0xb6, 0x45, 0x52, 0x2f, 0x0a, 0xec, 0xde, 0x75, 0xaa, 0x84, 0xfc, 0x70, 0x5e,
-	0x73, 0x32, 0x64, 0x6d, 0xd5, 0x6d, 0xb8, 0x7b, 0xfb, 0x0d, 0xb3, 0xb7, 0x97, 0xcb, 0x08, 0x5c,
-	0x2d, 0x23, 0xf0, 0x7b, 0x19, 0x81, 0x6f, 0xab, 0xa8, 0x77, 0xb5, 0x8a, 0x7a, 0xdf, 0x57, 0x51,
-	0xef, 0xd3, 0xb3, 0x8d, 0x39, 0xd6, 0xce, 0xf1, 0x5f, 0x67, 0xf7, 0x75, 0xf3, 0xca, 0xdd, 0xd8,
-	0xf9, 0x9e, 0x3b, 0xbe, 0xe7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x20, 0xbc, 0x25, 0x58, 0x13,
-	0x03, 0x00, 0x00,
+	// 741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6f, 0xd3, 0x4a,
+	0x10, 0x8f, 0xe3, 0x24, 0x4d, 0x26, 0x7a, 0x69, 0xba, 0xad, 0xde, 0xf3, 0x8b, 0xf4, 0xd2, 0x28,
+	0xd2, 0x2b, 0x01, 0xa9, 0x0e, 0x04, 0x89, 0x0b, 0x27, 0x12, 0x54, 0xa8, 0xa8, 0xaa, 0x62, 0x51,
+	0x84, 0x38, 0x60, 0x39, 0xce, 0xd6, 0xb1, 0xe2, 0x78, 0xa3, 0xec, 0xf6, 0xef, 0x27, 0xe0, 0xc8,
+	0x27, 0xe0, 0xc4, 0x81, 0xaf, 0xc1, 0xad, 0xc7, 0x5e, 0x90, 0x10, 0x48, 0x05, 0xb5, 0x5f, 0x04,
+	0xed, 0xd8, 0x4d, 0xec, 0x10, 0xe8, 0x1f, 0xe8, 0xc5, 0xd9, 0xd9, 0xec, 0xfc, 0x7e, 0x33, 0xbf,
+	0x99, 0xd9, 0x85, 0x7b, 0x07, 0x54, 0x58, 0x76, 0xd7, 0x72, 0xfd, 0x3a, 0xae, 0xd8, 0x90, 0xd6,
+	0xed, 0x21, 0xe3, 0x3c, 0xd8, 0x1b, 0x5a, 0x82, 0x9a, 0x9e, 0xdb, 0x77, 0x05, 0x1d, 0x9a, 0x5b,
+	0x9e, 0xe5, 0x70, 0x7d, 0x30, 0x64, 0x82, 0x91, 0xff, 0x46, 0x7e, 0xfa, 0x99, 0x9f, 0x3e, 0xf6,
+	0x2b, 0x2d, 0x38, 0xcc, 0x61, 0x78, 0xb2, 0x2e, 0x57, 0x81, 0x53, 0x69, 0x69, 0x0a, 0xd9, 0xa0,
+	0xe7, 0xd4, 0x6d, 0xe6, 0xfa, 0xf8, 0x09, 0xce, 0x55, 0xdf, 0xaa, 0x50, 0x34, 0x2c, 0x41, 0xd7,
+	0x02, 0xe2, 0x15, 0xc9, 0x4b, 0x34, 0x98, 0xa1, 0xbe, 0xd5, 0xf6, 0x68, 0x47, 0x53, 0x2a, 0x4a,
+	0x2d, 0x6b, 0x9c, 0x99, 0xe4, 0x6f, 0xc8, 0xec, 0xba, 0x7e, 0x87, 0xed, 0x6a, 0xc9, 0x8a, 0x52,
+	0x53, 0x8d, 0xd0, 0x22, 0x2d, 0x48, 0xc9, 0xf8, 0x35, 0xb5, 0xa2, 0xd4, 0x72, 0xcd, 0xfa, 0xe1,
+	0xf1, 0x62, 0xe2, 0xf3, 0xf1, 0xe2, 0x0d, 0xc7, 0x15, 0xdd, 0xed, 0xb6, 0x6e, 0xb3, 0x7e, 0xdd,
+	0x66, 0xbc, 0xcf, 0x78, 0xf8, 0xb3, 0xcc, 0x3b, 0xbd, 0xba, 0xd8, 0x1f, 0x50, 0xae, 0x6f, 0xba,
+	0xbe, 0x30, 0xd0, 0x99, 0x3c, 0x85, 0xbc, 0xcd, 0xfc, 0x1d, 0x3a, 0xe4, 0x2e, 0xf3, 0xb9, 0x96,
+	0xaa, 0xa8, 0xb5, 0x7c, 0xe3, 0xa6, 0xfe, 0xcb, 0xf4, 0xf5, 0xd6, 0xc8, 0xa3, 0x99, 0x92, 0xb4,
+	0x46, 0x14, 0x83, 0x98, 0x30, 0x87, 0xc7, 0xcc, 0xb1, 0xba, 0x5c, 0x4b, 0x23, 0xf0, 0xf2, 0x79,
+	0xc0, 0xf2, 0x3b, 0x92, 0x26, 0x04, 0x9f, 0xb5, 0x63, 0xbb, 0x48, 0x60, 0x71, 0x4e, 0x45, 0x8c,
+	0x20, 0x73, 0x21, 0x82, 0x07, 0xd2, 0xef, 0x07, 0x02, 0x2b, 0xb6, 0xcb, 0xab, 0xaf, 0x15, 0x28,
+	0xc4, 0x43, 0x21, 0xff, 0x42, 0x36, 0x48, 0xca, 0x0d, 0xea, 0xa3, 0x1a, 0x33, 0x68, 0xaf, 0x5e,
+	0x6f, 0x7d, 0xaa, 0x1f, 0x15, 0x28, 0xc4, 0x83, 0x26, 0x0b, 0x90, 0x3e, 0x18, 0xda, 0x8d, 0xdb,
+	0x18, 0x47, 0xce, 0x08, 0x8c, 0xeb, 0xed, 0x92, 0x0d, 0xc8, 0xfb, 0x96, 0x70, 0x77, 0x28, 0x4a,
+	0xae, 0xa5, 0xae, 0x86, 0x05, 0x01, 0x86, 0xcc, 0xa4, 0xfa, 0x21, 0x09, 0xb3, 0xe3, 0x3a, 0x6c,
+	0xdb, 0x3d, 0x2a, 0xc8, 0x0a, 0xa4, 0xe4, 0x69, 0xcc, 0xab, 0xd0, 0x68, 0x9c, 0x53, 0xca, 0x09,
+	0xef, 0x67, 0xfb, 0x03, 0x6a, 0xa0, 0x7f, 0xac, 0x56, 0xc9, 0x78, 0xad, 0x46, 0xda, 0xa9, 0x51,
+	0xed, 0x9a, 0x90, 0x93, 0xe3, 0x69, 0x22, 0x7b, 0x0a, 0xd9, 0xff, 0x9f, 0xc6, 0x3e, 0xe8, 0x39,
+	0x3a, 0xce, 0x71, 0x8b, 0xb9, 0x3e, 0x12, 0x66, 0xed, 0x70, 0x25, 0x91, 0xb1, 0x8d, 0xb4, 0x74,
+	0x80, 0x8c, 0x46, 0xa4, 0x2a, 0x99, 0xa9, 0x55, 0x99, 0xf9, 0x9d, 0xde, 0xf8, 0x92, 0x84, 0x85,
+	0x09, 0x15, 0x36, 0xb9, 0xe5, 0x50, 0xb2, 0x06, 0x99, 0x36, 0x9a, 0x28, 0x65, 0xbe, 0xa1, 0x5f,
+	0x4e, 0xca, 0x70, 0x2c, 0x42, 0x0c, 0xf2, 0x02, 0x8a, 0x03, 0x8b, 0x0b, 0xd3, 0xb6, 0xc5, 0x1e,
+	0x37, 0x77, 0x2c, 0x6f, 0x9b, 0xa2, 0xac, 0xb9, 0xa6, 0x1e, 0xc6, 0xbd, 0x74, 0x81, 0xb8, 0x57,
+	0x7d, 0x61, 0x14, 0x24, 0x4e, 0x4b, 0xc2, 0x3c, 0x97, 0x28, 0xe4, 0x15, 0xcc, 0x0f, 0xa8, 0xdf,
+	0x71, 0x7d, 0x27, 0x06, 0xae, 0x5e, 0x09, 0x7c, 0x2e, 0x84, 0x8a, 0xe0, 0xdf, 0x87, 0x92, 0xc7,
+	0x76, 0x29, 0x17, 0x66, 0x94, 0xc6, 0xec, 0x52, 0xd7, 0xe9, 0x0a, 0x2c, 0xb4, 0x6a, 0xfc, 0x13,
+	0x9c, 0xd8, 0x18, 0x3b, 0x3f, 0xc6, 0xbf, 0xab, 0x5b, 0x00, 0xe3, 0x7b, 0xee, 0x27, 0x43, 0xd7,
+	0x0c, 0xcb, 0x78, 0x79, 0x39, 0x1e, 0x52, 0x3b, 0xac, 0xe2, 0x57, 0x05, 0x72, 0xa3, 0x09, 0x97,
+	0xcf, 0x40, 0xd8, 0xab, 0x93, 0xd7, 0xcc, 0xa8, 0xc1, 0x92, 0xd1, 0x06, 0x2b, 0x41, 0xb6, 0x43,
+	0x6d, 0xb7, 0x6f, 0x79, 0x1c, 0x75, 0xfb, 0xcb, 0x18, 0xd9, 0x7f, 0xa4, 0xad, 0xcf, 0x32, 0x4c,
+	0x5f, 0x3d, 0xc3, 0x5b, 0xeb, 0x30, 0x3f, 0x65, 0x58, 0x09, 0x40, 0xe6, 0x91, 0xc7, 0xda, 0x96,
+	0x57, 0x4c, 0x90, 0x1c, 0xa4, 0xf1, 0xc2, 0x2d, 0x2a, 0x72, 0x89, 0x72, 0x14, 0x93, 0x64, 0x16,
+	0xf2, 0xb8, 0x5c, 0xc7, 0x7b, 0xa3, 0xa8, 0x96, 0x52, 0xef, 0xdf, 0x95, 0x95, 0xe6, 0x93, 0xc3,
+	0x93, 0xb2, 0x72, 0x74, 0x52, 0x56, 0xbe, 0x9d, 0x94, 0x95, 0x37, 0xa7, 0xe5, 0xc4, 0xd1, 0x69,
+	0x39, 0xf1, 0xe9, 0xb4, 0x9c, 0x78, 0x79, 0x27, 0x12, 0x97, 0x4c, 0x6f, 0x79, 0xe2, 0x35, 0xde,
+	0x8b, 0x3e, 0xfe, 0x18, 0x66, 0x3b, 0x83, 0x6f, 0xf2, 0xdd, 0xef, 0x03, 0x00, 0x7f, 0xa1, 0x1a,
+	0xa2, 0x2a, 0x08, 0x00, 0x00,
 }
 
 func (m *RateLimiterFlags) Marshal() (dAtA []byte, err error) {
@@ -265,6 +603,34 @@ func (m *RateLimiterFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AssetRateLimits) > 0 {
+		for iNdEx := len(m.AssetRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssetRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ChainRateLimits) > 0 {
+		for iNdEx := len(m.ChainRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Conversions) > 0 {
 		for iNdEx := len(m.Conversions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -307,7 +673,7 @@ func (m *RateLimiterFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Conversion) Marshal() (dAtA []byte, err error) {
+func (m *ChainRateLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -317,12 +683,12 @@ func (m *Conversion) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Conversion) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainRateLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Conversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChainRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -336,18 +702,21 @@ func (m *Conversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Zrc20) > 0 {
-		i -= len(m.Zrc20)
-		copy(dAtA[i:], m.Zrc20)
-		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Zrc20)))
+	dAtA[i] = 0x1a
+	if m.Window != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.Window))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AssetRate) Marshal() (dAtA []byte, err error) {
+func (m *AssetRateLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -357,16 +726,26 @@ func (m *AssetRate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AssetRate) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetRateLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AssetRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.NativeRate.Size()
+		i -= size
+		if _, err := m.NativeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.Rate.Size()
 		i -= size
@@ -376,23 +755,233 @@ func (m *AssetRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if m.CoinType != 0 {
-		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.CoinType))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Decimals != 0 {
-		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.Decimals))
+	dAtA[i] = 0x1a
+	if m.Window != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.Window))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x10
 	}
-	if len(m.Asset) > 0 {
-		i -= len(m.Asset)
-		copy(dAtA[i:], m.Asset)
-		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Asset)))
+	if len(m.Zrc20) > 0 {
+		i -= len(m.Zrc20)
+		copy(dAtA[i:], m.Zrc20)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Zrc20)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Window != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CoinType != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.CoinType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Zrc20) > 0 {
+		i -= len(m.Zrc20)
+		copy(dAtA[i:], m.Zrc20)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Zrc20)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitBucketUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitBucketUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitBucketUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LowestPendingCctxHeight != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.LowestPendingCctxHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.PendingCctxsValue.Size()
+		i -= size
+		if _, err := m.PendingCctxsValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PastCctxsValue.Size()
+		i -= size
+		if _, err := m.PastCctxsValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Bucket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Conversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Conversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Conversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Zrc20) > 0 {
+		i -= len(m.Zrc20)
+		copy(dAtA[i:], m.Zrc20)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Zrc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.CoinType != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.CoinType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.ChainId != 0 {
 		i = encodeVarintRateLimiterFlags(dAtA, i, uint64(m.ChainId))
@@ -433,10 +1022,39 @@ func (m *RateLimiterFlags) Size() (n int) {
 			n += 1 + l + sovRateLimiterFlags(uint64(l))
 		}
 	}
+	if len(m.ChainRateLimits) > 0 {
+		for _, e := range m.ChainRateLimits {
+			l = e.Size()
+			n += 1 + l + sovRateLimiterFlags(uint64(l))
+		}
+	}
+	if len(m.AssetRateLimits) > 0 {
+		for _, e := range m.AssetRateLimits {
+			l = e.Size()
+			n += 1 + l + sovRateLimiterFlags(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *Conversion) Size() (n int) {
+func (m *ChainRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.ChainId))
+	}
+	if m.Window != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.Window))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *AssetRateLimit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -446,42 +1064,627 @@ func (m *Conversion) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRateLimiterFlags(uint64(l))
 	}
+	if m.Window != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.Window))
+	}
 	l = m.Rate.Size()
 	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	l = m.NativeRate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
 	return n
 }
 
-func (m *AssetRate) Size() (n int) {
+func (m *RateLimitBucket) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Type != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.Type))
+	}
 	if m.ChainId != 0 {
 		n += 1 + sovRateLimiterFlags(uint64(m.ChainId))
 	}
-	l = len(m.Asset)
+	l = len(m.Zrc20)
 	if l > 0 {
 		n += 1 + l + sovRateLimiterFlags(uint64(l))
 	}
-	if m.Decimals != 0 {
-		n += 1 + sovRateLimiterFlags(uint64(m.Decimals))
-	}
 	if m.CoinType != 0 {
 		n += 1 + sovRateLimiterFlags(uint64(m.CoinType))
 	}
-	l = m.Rate.Size()
-	n += 1 + l + sovRateLimiterFlags(uint64(l))
-	return n
-}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.Window))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *RateLimitBucketUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Bucket.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	l = m.PastCctxsValue.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	l = m.PendingCctxsValue.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	if m.LowestPendingCctxHeight != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.LowestPendingCctxHeight))
+	}
+	return n
+}
+
+func (m *Conversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Zrc20)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func (m *AssetRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.ChainId))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovRateLimiterFlags(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.Decimals))
+	}
+	if m.CoinType != 0 {
+		n += 1 + sovRateLimiterFlags(uint64(m.CoinType))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRateLimiterFlags(uint64(l))
+	return n
+}
+
+func sovRateLimiterFlags(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRateLimiterFlags(x uint64) (n int) {
+	return sovRateLimiterFlags(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimiterFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimiterFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conversions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conversions = append(m.Conversions, Conversion{})
+			if err := m.Conversions[len(m.Conversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainRateLimits = append(m.ChainRateLimits, ChainRateLimit{})
+			if err := m.ChainRateLimits[len(m.ChainRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetRateLimits = append(m.AssetRateLimits, AssetRateLimit{})
+			if err := m.AssetRateLimits[len(m.AssetRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovRateLimiterFlags(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRateLimiterFlags(x uint64) (n int) {
-	return sovRateLimiterFlags(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
+func (m *RateLimitBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -504,17 +1707,17 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RateLimiterFlags: wiretype end group for non-group")
+			return fmt.Errorf("proto: RateLimitBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RateLimiterFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RateLimitBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var v int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRateLimiterFlags
@@ -524,13 +1727,114 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Type |= RateLimitBucketType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinType", wireType)
+			}
+			m.CoinType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoinType |= coin.CoinType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
@@ -549,7 +1853,7 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
@@ -583,9 +1887,59 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitBucketUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimiterFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitBucketUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitBucketUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conversions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -612,11 +1966,97 @@ func (m *RateLimiterFlags) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conversions = append(m.Conversions, Conversion{})
-			if err := m.Conversions[len(m.Conversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PastCctxsValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PastCctxsValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCctxsValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimiterFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingCctxsValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowestPendingCctxHeight", wireType)
+			}
+			m.LowestPendingCctxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimiterFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowestPendingCctxHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRateLimiterFlags(dAtA[iNdEx:])
@@ -112,6 +112,147 @@ func TestRateLimiterFlags_Validate(t *testing.T) {
 			},
 			isErr: true,
 		},
+		{
+			name: "valid chain and asset rate limits",
+			flags: types.RateLimiterFlags{
+				Enabled: true,
+				ChainRateLimits: []types.ChainRateLimit{
+					{
+						ChainId: chains.Ethereum.ChainId,
+						Window:  100,
+						Rate:    sdk.NewUint(42),
+					},
+					{
+						ChainId: chains.BitcoinMainnet.ChainId,
+						Window:  200,
+						Rate:    sdk.NewUint(42),
+					},
+				},
+				AssetRateLimits: []types.AssetRateLimit{
+					{
+						Zrc20:      sample.EthAddress().String(),
+						Window:     100,
+						Rate:       sdk.NewUint(42),
+						NativeRate: sdkmath.ZeroUint(),
+					},
+					{
+						Zrc20:      sample.EthAddress().String(),
+						Window:     100,
+						Rate:       sdkmath.ZeroUint(),
+						NativeRate: sdk.NewUint(42),
+					},
+				},
+			},
+		},
+		{
+			name: "duplicated chain rate limit",
+			flags: types.RateLimiterFlags{
+				Enabled: true,
+				ChainRateLimits: []types.ChainRateLimit{
+					{
+						ChainId: chains.Ethereum.ChainId,
+						Window:  100,
+						Rate:    sdk.NewUint(42),
+					},
+					{
+						ChainId: chains.Ethereum.ChainId,
+						Window:  200,
+						Rate:    sdk.NewUint(42),
+					},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "chain rate limit with non-positive window",
+			flags: types.RateLimiterFlags{
+				Enabled: true,
+				ChainRateLimits: []types.ChainRateLimit{
+					{
+						ChainId: chains.Ethereum.ChainId,
+						Window:  0,
+						Rate:    sdk.NewUint(42),
+					},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "chain rate limit with zero rate",
+			flags: types.RateLimiterFlags{
+				Enabled: true,
+				ChainRateLimits: []types.ChainRateLimit{
+					{
+						ChainId: chains.Ethereum.ChainId,
+						Window:  100,
+						Rate:    sdkmath.ZeroUint(),
+					},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "asset rate limit with invalid zrc20 address",
+			flags: types.RateLimiterFlags{
+				Enabled: true,
+				AssetRateLimits: []types.AssetRateLimit{
+					{
+						Zrc20:  "invalid",
+						Window: 100,
+						Rate:   sdk.NewUint(42),
+					},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "duplicated asset rate limit",
+			flags: types.RateLimiterFlags{
+				Enabled: true,
+				AssetRateLimits: []types.AssetRateLimit{
+					{
+						Zrc20:  duplicatedAddress,
+						Window: 100,
+						Rate:   sdk.NewUint(42),
+					},
+					{
+						Zrc20:  strings.ToLower(duplicatedAddress),
+						Window: 100,
+						Rate:   sdk.NewUint(42),
+					},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "asset rate limit with non-positive window",
+			flags: types.RateLimiterFlags{
+				Enabled: true,
+				AssetRateLimits: []types.AssetRateLimit{
+					{
+						Zrc20:  sample.EthAddress().String(),
+						Window: -1,
+						Rate:   sdk.NewUint(42),
+					},
+				},
+			},
+			isErr: true,
+		},
+		{
+			name: "asset rate limit without rate",
+			flags: types.RateLimiterFlags{
+				Enabled: true,
+				AssetRateLimits: []types.AssetRateLimit{
+					{
+						Zrc20:      sample.EthAddress().String(),
+						Window:     100,
+						Rate:       sdkmath.ZeroUint(),
+						NativeRate: sdkmath.ZeroUint(),
+					},
+				},
+			},
+			isErr: true,
+		},
 	}
 
 	for _, tc := range tt {
@@ -127,6 +268,43 @@ func TestRateLimiterFlags_Validate(t *testing.T) {
 
 }
 
+func TestRateLimiterFlags_InputWindow(t *testing.T) {
+	t.Run("should return the global window if the global rate limit is set", func(t *testing.T) {
+		flags := types.RateLimiterFlags{
+			Window: 100,
+			Rate:   sdk.NewUint(42),
+			ChainRateLimits: []types.ChainRateLimit{
+				{ChainId: chains.Ethereum.ChainId, Window: 500, Rate: sdk.NewUint(42)},
+			},
+		}
+		require.True(t, flags.HasGlobalRateLimit())
+		require.Equal(t, int64(100), flags.InputWindow())
+	})
+
+	t.Run("should return the largest window of the chain and asset rate limits", func(t *testing.T) {
+		flags := types.RateLimiterFlags{
+			Window: 100,
+			Rate:   sdkmath.ZeroUint(),
+			ChainRateLimits: []types.ChainRateLimit{
+				{ChainId: chains.Ethereum.ChainId, Window: 200, Rate: sdk.NewUint(42)},
+			},
+			AssetRateLimits: []types.AssetRateLimit{
+				{Zrc20: sample.EthAddress().String(), Window: 300, Rate: sdk.NewUint(42)},
+			},
+		}
+		require.False(t, flags.HasGlobalRateLimit())
+		require.True(t, flags.HasBucketRateLimits())
+		require.Equal(t, int64(300), flags.InputWindow())
+	})
+
+	t.Run("should return 0 if no rate limit is set", func(t *testing.T) {
+		flags := types.RateLimiterFlags{}
+		require.False(t, flags.HasGlobalRateLimit())
+		require.False(t, flags.HasBucketRateLimits())
+		require.Equal(t, int64(0), flags.InputWindow())
+	})
+}
+
 func TestRateLimiterFlags_GetConversionRate(t *testing.T) {
 	dec, err := sdk.NewDecFromStr("0.00042")
 	require.NoError(t, err)
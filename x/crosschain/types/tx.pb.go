@@ -985,9 +985,9 @@ type MsgVoteInbound struct {
 	SenderChainId int64  `protobuf:"varint,3,opt,name=sender_chain_id,json=senderChainId,proto3" json:"sender_chain_id,omitempty"`
 	Receiver      string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	ReceiverChain int64  `protobuf:"varint,5,opt,name=receiver_chain,json=receiverChain,proto3" json:"receiver_chain,omitempty"`
-	// string zeta_burnt = 6;
+	//  string zeta_burnt = 6;
 	Amount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
-	// string mMint = 7;
+	//  string mMint = 7;
 	Message            string        `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
 	InboundHash        string        `protobuf:"bytes,9,opt,name=inbound_hash,json=inboundHash,proto3" json:"inbound_hash,omitempty"`
 	InboundBlockHeight uint64        `protobuf:"varint,10,opt,name=inbound_block_height,json=inboundBlockHeight,proto3" json:"inbound_block_height,omitempty"`
@@ -997,6 +997,9 @@ type MsgVoteInbound struct {
 	Asset              string        `protobuf:"bytes,14,opt,name=asset,proto3" json:"asset,omitempty"`
 	// event index of the sent asset in the observed tx
 	EventIndex uint64 `protobuf:"varint,15,opt,name=event_index,json=eventIndex,proto3" json:"event_index,omitempty"`
+	// address on the sender chain receiving the reverted funds, the sender if
+	// empty
+	RevertAddress string `protobuf:"bytes,16,opt,name=revert_address,json=revertAddress,proto3" json:"revert_address,omitempty"`
 }
 
 func (m *MsgVoteInbound) Reset()         { *m = MsgVoteInbound{} }
@@ -1123,6 +1126,13 @@ func (m *MsgVoteInbound) GetEventIndex() uint64 {
 	return 0
 }
 
+func (m *MsgVoteInbound) GetRevertAddress() string {
+	if m != nil {
+		return m.RevertAddress
+	}
+	return ""
+}
+
 type MsgVoteInboundResponse struct {
 }
 
@@ -1431,6 +1441,94 @@ func (m *MsgUpdateRateLimiterFlagsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateRateLimiterFlagsResponse proto.InternalMessageInfo
 
+type MsgUpdateCctxRetentionPolicy struct {
+	Creator             string              `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CctxRetentionPolicy CctxRetentionPolicy `protobuf:"bytes,2,opt,name=cctx_retention_policy,json=cctxRetentionPolicy,proto3" json:"cctx_retention_policy"`
+}
+
+func (m *MsgUpdateCctxRetentionPolicy) Reset()         { *m = MsgUpdateCctxRetentionPolicy{} }
+func (m *MsgUpdateCctxRetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCctxRetentionPolicy) ProtoMessage()    {}
+func (*MsgUpdateCctxRetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{24}
+}
+func (m *MsgUpdateCctxRetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCctxRetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCctxRetentionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCctxRetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCctxRetentionPolicy.Merge(m, src)
+}
+func (m *MsgUpdateCctxRetentionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCctxRetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCctxRetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCctxRetentionPolicy proto.InternalMessageInfo
+
+func (m *MsgUpdateCctxRetentionPolicy) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateCctxRetentionPolicy) GetCctxRetentionPolicy() CctxRetentionPolicy {
+	if m != nil {
+		return m.CctxRetentionPolicy
+	}
+	return CctxRetentionPolicy{}
+}
+
+type MsgUpdateCctxRetentionPolicyResponse struct {
+}
+
+func (m *MsgUpdateCctxRetentionPolicyResponse) Reset()         { *m = MsgUpdateCctxRetentionPolicyResponse{} }
+func (m *MsgUpdateCctxRetentionPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCctxRetentionPolicyResponse) ProtoMessage()    {}
+func (*MsgUpdateCctxRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{25}
+}
+func (m *MsgUpdateCctxRetentionPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCctxRetentionPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCctxRetentionPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCctxRetentionPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCctxRetentionPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateCctxRetentionPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCctxRetentionPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCctxRetentionPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCctxRetentionPolicyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgMigrateTssFunds)(nil), "zetachain.zetacore.crosschain.MsgMigrateTssFunds")
 	proto.RegisterType((*MsgMigrateTssFundsResponse)(nil), "zetachain.zetacore.crosschain.MsgMigrateTssFundsResponse")
@@ -1456,6 +1554,8 @@ func init() {
 	proto.RegisterType((*MsgRefundAbortedCCTXResponse)(nil), "zetachain.zetacore.crosschain.MsgRefundAbortedCCTXResponse")
 	proto.RegisterType((*MsgUpdateRateLimiterFlags)(nil), "zetachain.zetacore.crosschain.MsgUpdateRateLimiterFlags")
 	proto.RegisterType((*MsgUpdateRateLimiterFlagsResponse)(nil), "zetachain.zetacore.crosschain.MsgUpdateRateLimiterFlagsResponse")
+	proto.RegisterType((*MsgUpdateCctxRetentionPolicy)(nil), "zetachain.zetacore.crosschain.MsgUpdateCctxRetentionPolicy")
+	proto.RegisterType((*MsgUpdateCctxRetentionPolicyResponse)(nil), "zetachain.zetacore.crosschain.MsgUpdateCctxRetentionPolicyResponse")
 }
 
 func init() {
@@ -1463,104 +1563,110 @@ func init() {
 }
 
 var fileDescriptor_15f0860550897740 = []byte{
-	// 1546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xdd, 0x6e, 0x1b, 0xd5,
-	0x13, 0xcf, 0x36, 0x89, 0x63, 0x8f, 0xf3, 0xd1, 0xee, 0x3f, 0x4d, 0x9d, 0x4d, 0xe3, 0xa4, 0xee,
-	0xbf, 0x21, 0x42, 0xad, 0x9d, 0xba, 0xa5, 0x94, 0x16, 0x01, 0x8d, 0xe9, 0x47, 0x44, 0xdd, 0x46,
-	0xdb, 0x14, 0x10, 0x37, 0xab, 0xf5, 0xee, 0xc9, 0x7a, 0x65, 0x7b, 0xd7, 0xda, 0x73, 0x6c, 0x39,
-	0x15, 0x12, 0x08, 0x09, 0x89, 0x4b, 0x40, 0x48, 0x48, 0x5c, 0x70, 0xc7, 0x0b, 0xf0, 0x14, 0xbd,
-	0xac, 0xb8, 0x42, 0x5c, 0x54, 0xa8, 0x7d, 0x02, 0x78, 0x02, 0xb4, 0x73, 0xce, 0x6e, 0xbd, 0xeb,
-	0xcf, 0xb8, 0x42, 0xdc, 0xd8, 0x3b, 0x73, 0xe6, 0x37, 0x67, 0x66, 0xce, 0xcc, 0x99, 0xd9, 0x85,
-	0xad, 0x27, 0x84, 0xe9, 0x46, 0x55, 0xb7, 0x9d, 0x02, 0x3e, 0xb9, 0x1e, 0x29, 0x18, 0x9e, 0x4b,
-	0x29, 0xe7, 0xb1, 0x4e, 0xbe, 0xe9, 0xb9, 0xcc, 0x95, 0xd7, 0x43, 0xb9, 0x7c, 0x20, 0x97, 0x7f,
-	0x25, 0xa7, 0x2c, 0x5b, 0xae, 0xe5, 0xa2, 0x64, 0xc1, 0x7f, 0xe2, 0x20, 0xe5, 0xcd, 0x3e, 0xca,
-	0x9b, 0x35, 0xab, 0x80, 0x2c, 0x2a, 0xfe, 0x84, 0xec, 0xd6, 0x20, 0x59, 0xd7, 0x76, 0xf0, 0x67,
-	0x84, 0xce, 0xa6, 0xe7, 0xba, 0x87, 0x54, 0xfc, 0x09, 0xd9, 0x6b, 0xc3, 0x9d, 0xf3, 0x74, 0x46,
-	0xb4, 0xba, 0xdd, 0xb0, 0x19, 0xf1, 0xb4, 0xc3, 0xba, 0x6e, 0x09, 0x5c, 0xee, 0x7b, 0x09, 0xe4,
-	0x32, 0xb5, 0xca, 0xb6, 0xe5, 0x8b, 0x1c, 0x50, 0x7a, 0xa7, 0xe5, 0x98, 0x54, 0xce, 0xc0, 0x9c,
-	0xe1, 0x11, 0x9d, 0xb9, 0x5e, 0x46, 0xda, 0x94, 0xb6, 0x53, 0x6a, 0x40, 0xca, 0xab, 0x90, 0x44,
-	0x95, 0x9a, 0x6d, 0x66, 0x4e, 0x6c, 0x4a, 0xdb, 0xd3, 0xea, 0x1c, 0xd2, 0x7b, 0xa6, 0x7c, 0x17,
-	0x12, 0x7a, 0xc3, 0x6d, 0x39, 0x2c, 0x33, 0xed, 0x63, 0x76, 0x0b, 0x4f, 0x9f, 0x6f, 0x4c, 0xfd,
-	0xf1, 0x7c, 0xe3, 0x0d, 0xcb, 0x66, 0xd5, 0x56, 0x25, 0x6f, 0xb8, 0x8d, 0x82, 0xe1, 0xd2, 0x86,
-	0x4b, 0xc5, 0xdf, 0x25, 0x6a, 0xd6, 0x0a, 0xec, 0xa8, 0x49, 0x68, 0xfe, 0xb1, 0xed, 0x30, 0x55,
-	0xc0, 0x73, 0x67, 0x41, 0xe9, 0xb5, 0x49, 0x25, 0xb4, 0xe9, 0x3a, 0x94, 0xe4, 0x1e, 0xc0, 0xff,
-	0xca, 0xd4, 0x7a, 0xdc, 0x34, 0xf9, 0xe2, 0x2d, 0xd3, 0xf4, 0x08, 0x1d, 0x66, 0xf2, 0x3a, 0x00,
-	0xa3, 0x54, 0x6b, 0xb6, 0x2a, 0x35, 0x72, 0x84, 0x46, 0xa7, 0xd4, 0x14, 0xa3, 0x74, 0x1f, 0x19,
-	0xb9, 0x75, 0x58, 0xeb, 0xa3, 0x2f, 0xdc, 0xee, 0xe7, 0x13, 0xb0, 0x5c, 0xa6, 0xd6, 0x2d, 0xd3,
-	0xdc, 0x73, 0x2a, 0x6e, 0xcb, 0x31, 0x0f, 0x3c, 0xdd, 0xa8, 0x11, 0x6f, 0xb2, 0x18, 0x9d, 0x81,
-	0x39, 0xd6, 0xd1, 0xaa, 0x3a, 0xad, 0xf2, 0x20, 0xa9, 0x09, 0xd6, 0xb9, 0xa7, 0xd3, 0xaa, 0xbc,
-	0x0b, 0x29, 0xff, 0xe8, 0x35, 0x3f, 0x1c, 0x99, 0x99, 0x4d, 0x69, 0x7b, 0xb1, 0x78, 0x21, 0xdf,
-	0x27, 0x13, 0x9b, 0x35, 0x2b, 0x8f, 0x39, 0x52, 0x72, 0x6d, 0xe7, 0xe0, 0xa8, 0x49, 0xd4, 0xa4,
-	0x21, 0x9e, 0xe4, 0x1b, 0x30, 0x8b, 0x49, 0x91, 0x99, 0xdd, 0x94, 0xb6, 0xd3, 0xc5, 0xff, 0x0f,
-	0xc2, 0x8b, 0xcc, 0xd9, 0xf7, 0xff, 0x54, 0x0e, 0xf1, 0x83, 0x54, 0xa9, 0xbb, 0x46, 0x8d, 0xdb,
-	0x96, 0xe0, 0x41, 0x42, 0x0e, 0x9a, 0xb7, 0x0a, 0x49, 0xd6, 0xd1, 0x6c, 0xc7, 0x24, 0x9d, 0xcc,
-	0x1c, 0x77, 0x89, 0x75, 0xf6, 0x7c, 0x32, 0x97, 0x85, 0xb3, 0xfd, 0xe2, 0x13, 0x06, 0xf0, 0x37,
-	0x09, 0x4e, 0x95, 0xa9, 0xf5, 0x49, 0xd5, 0x66, 0xa4, 0x6e, 0x53, 0x76, 0x5b, 0x2d, 0x15, 0x77,
-	0x86, 0x44, 0xef, 0x3c, 0x2c, 0x10, 0xcf, 0x28, 0xee, 0x68, 0x3a, 0x3f, 0x09, 0x71, 0x62, 0xf3,
-	0xc8, 0x0c, 0x4e, 0xbb, 0x3b, 0xc4, 0xd3, 0xd1, 0x10, 0xcb, 0x30, 0xe3, 0xe8, 0x0d, 0x1e, 0xc4,
-	0x94, 0x8a, 0xcf, 0xf2, 0x0a, 0x24, 0xe8, 0x51, 0xa3, 0xe2, 0xd6, 0x31, 0x34, 0x29, 0x55, 0x50,
-	0xb2, 0x02, 0x49, 0x93, 0x18, 0x76, 0x43, 0xaf, 0x53, 0xf4, 0x79, 0x41, 0x0d, 0x69, 0x79, 0x0d,
-	0x52, 0x96, 0x4e, 0x79, 0xd5, 0x08, 0x9f, 0x93, 0x96, 0x4e, 0xef, 0xfb, 0x74, 0x4e, 0x83, 0xd5,
-	0x1e, 0x9f, 0x02, 0x8f, 0x7d, 0x0f, 0x9e, 0x44, 0x3c, 0xe0, 0x1e, 0xce, 0x3f, 0xe9, 0xf6, 0x60,
-	0x1d, 0xc0, 0x30, 0xc2, 0x98, 0x8a, 0xac, 0xf4, 0x39, 0x3c, 0xaa, 0x7f, 0x49, 0x70, 0x9a, 0x87,
-	0xf5, 0x61, 0x8b, 0xbd, 0x7e, 0xde, 0x2d, 0xc3, 0xac, 0xe3, 0x3a, 0x06, 0xc1, 0x60, 0xcd, 0xa8,
-	0x9c, 0xe8, 0xce, 0xc6, 0x99, 0x48, 0x36, 0xfe, 0x37, 0x99, 0xf4, 0x1e, 0xac, 0xf7, 0x75, 0x39,
-	0x0c, 0xec, 0x3a, 0x80, 0x4d, 0x35, 0x8f, 0x34, 0xdc, 0x36, 0x31, 0xd1, 0xfb, 0xa4, 0x9a, 0xb2,
-	0xa9, 0xca, 0x19, 0x39, 0x02, 0x99, 0x32, 0xb5, 0x38, 0xf5, 0xef, 0x45, 0x2d, 0x97, 0x83, 0xcd,
-	0x41, 0xdb, 0x84, 0x49, 0xff, 0xa3, 0x04, 0x4b, 0x65, 0x6a, 0x7d, 0xec, 0x32, 0x72, 0x57, 0xa7,
-	0xfb, 0x9e, 0x6d, 0x90, 0x89, 0x4d, 0x68, 0xfa, 0xe8, 0xc0, 0x04, 0x24, 0xe4, 0x73, 0x30, 0xcf,
-	0x63, 0xec, 0xb4, 0x1a, 0x15, 0xe2, 0xe1, 0xe9, 0xcd, 0xa8, 0x69, 0xe4, 0x3d, 0x40, 0x16, 0xa6,
-	0x7c, 0xab, 0xd9, 0xac, 0x1f, 0x85, 0x29, 0x8f, 0x54, 0x6e, 0x15, 0xce, 0xc4, 0x0c, 0x0b, 0x8d,
-	0xfe, 0x25, 0x11, 0x1a, 0x1d, 0xf8, 0x35, 0xc4, 0xe8, 0x35, 0xc0, 0x74, 0xe5, 0xc7, 0xcc, 0xf3,
-	0x37, 0xe9, 0x33, 0xf0, 0x94, 0xaf, 0xc2, 0x8a, 0x5b, 0xa1, 0xc4, 0x6b, 0x13, 0x53, 0x73, 0x85,
-	0xae, 0xee, 0x6b, 0x6f, 0x39, 0x58, 0x0d, 0x36, 0x42, 0x54, 0x09, 0xb2, 0xbd, 0x28, 0x91, 0x4c,
-	0xc4, 0xb6, 0xaa, 0x4c, 0x38, 0xba, 0x16, 0x47, 0xef, 0x62, 0x7a, 0xa1, 0x88, 0x7c, 0x13, 0x94,
-	0x5e, 0x25, 0x7e, 0x25, 0xb7, 0x28, 0x31, 0x33, 0x80, 0x0a, 0xce, 0xc4, 0x15, 0xdc, 0xd5, 0xe9,
-	0x63, 0x4a, 0x4c, 0xf9, 0x4b, 0x09, 0x2e, 0xf4, 0xa2, 0xc9, 0xe1, 0x21, 0x31, 0x98, 0xdd, 0x26,
-	0xa8, 0x87, 0x9f, 0x47, 0x1a, 0x7b, 0x5c, 0x5e, 0xf4, 0xb8, 0xad, 0x31, 0x7a, 0xdc, 0x9e, 0xc3,
-	0xd4, 0x73, 0xf1, 0x8d, 0x6f, 0x07, 0xaa, 0xc3, 0x34, 0xd9, 0x1f, 0x6d, 0x01, 0xbf, 0x93, 0xe6,
-	0xd1, 0x95, 0xa1, 0x1a, 0xf1, 0xb2, 0x92, 0x5d, 0x58, 0x6c, 0xeb, 0xf5, 0x16, 0xd1, 0x3c, 0x62,
-	0x10, 0xdb, 0x2f, 0x1d, 0x4c, 0x89, 0xdd, 0x7b, 0xc7, 0x6c, 0xd0, 0x7f, 0x3f, 0xdf, 0x38, 0x7d,
-	0xa4, 0x37, 0xea, 0x37, 0x72, 0x51, 0x75, 0x39, 0x75, 0x01, 0x19, 0xaa, 0xa0, 0xe5, 0x0f, 0x21,
-	0x41, 0x99, 0xce, 0x5a, 0xfc, 0x52, 0x5d, 0x2c, 0x5e, 0x1c, 0xd8, 0xc9, 0xf8, 0x5c, 0x24, 0x80,
-	0x8f, 0x10, 0xa3, 0x0a, 0xac, 0x7c, 0x01, 0x16, 0x43, 0xff, 0x51, 0x50, 0xdc, 0x17, 0x0b, 0x01,
-	0xb7, 0xe4, 0x33, 0xe5, 0x8b, 0x20, 0x87, 0x62, 0x7e, 0x9f, 0xe7, 0x15, 0x9b, 0xc4, 0xe0, 0x9c,
-	0x0c, 0x56, 0x0e, 0x28, 0x7d, 0x80, 0x57, 0x5e, 0xa4, 0xcf, 0xa6, 0x26, 0xea, 0xb3, 0x5d, 0x25,
-	0x14, 0xc4, 0x3c, 0x2c, 0xa1, 0x9f, 0x66, 0x60, 0x51, 0xac, 0x89, 0x76, 0x38, 0xa4, 0x82, 0xfc,
-	0x12, 0x25, 0x8e, 0x49, 0x3c, 0x51, 0x3e, 0x82, 0x92, 0xb7, 0x60, 0x89, 0x3f, 0x69, 0xb1, 0x1e,
-	0xb7, 0xc0, 0xd9, 0x25, 0x71, 0x37, 0x28, 0x90, 0x14, 0x47, 0xe0, 0x89, 0xfb, 0x3b, 0xa4, 0xfd,
-	0xe0, 0x05, 0xcf, 0x22, 0x78, 0xb3, 0x5c, 0x45, 0xc0, 0xe5, 0xc1, 0x7b, 0x35, 0xb3, 0x25, 0x5e,
-	0x6b, 0x66, 0xf3, 0xbd, 0x6c, 0x10, 0x4a, 0x75, 0x8b, 0x87, 0x3e, 0xa5, 0x06, 0xa4, 0x7f, 0x57,
-	0xd9, 0x4e, 0xd7, 0x05, 0x90, 0xc2, 0xe5, 0xb4, 0xe0, 0x61, 0xdd, 0xef, 0xc0, 0x72, 0x20, 0x12,
-	0xa9, 0x76, 0x5e, 0xac, 0xb2, 0x58, 0xeb, 0x2e, 0xf2, 0x48, 0x73, 0x4e, 0xa3, 0x58, 0xd8, 0x9c,
-	0xa3, 0x67, 0x3c, 0x3f, 0xd9, 0x2c, 0xb5, 0x06, 0x29, 0xd6, 0xd1, 0x5c, 0xcf, 0xb6, 0x6c, 0x27,
-	0xb3, 0xc0, 0x83, 0xcb, 0x3a, 0x0f, 0x91, 0xf6, 0x2f, 0x65, 0x9d, 0x52, 0xc2, 0x32, 0x8b, 0xb8,
-	0xc0, 0x09, 0x79, 0x03, 0xd2, 0xa4, 0x4d, 0x1c, 0x26, 0x9a, 0xdb, 0x12, 0x5a, 0x05, 0xc8, 0xe2,
-	0xfd, 0x2d, 0x03, 0x2b, 0xd1, 0xdc, 0x08, 0xd3, 0xe6, 0x3e, 0x8e, 0x48, 0xb7, 0x2a, 0xae, 0xc7,
-	0x1e, 0xb1, 0x96, 0x51, 0x2b, 0x95, 0x0e, 0x3e, 0x1d, 0x3e, 0xd1, 0x0e, 0x9b, 0x1d, 0xd6, 0x70,
-	0x38, 0x89, 0x6a, 0x0b, 0xb7, 0x6a, 0xe3, 0x38, 0xab, 0x92, 0xc3, 0x96, 0x63, 0xa2, 0x08, 0x31,
-	0x5f, 0x6b, 0x37, 0x9e, 0x69, 0xbe, 0xb6, 0x70, 0xdc, 0xe1, 0x57, 0xfc, 0x02, 0xe7, 0x8a, 0x79,
-	0x47, 0x8c, 0x89, 0x3d, 0xfb, 0xbe, 0xaa, 0x1c, 0x09, 0xad, 0xe6, 0x73, 0xb8, 0xaa, 0x33, 0x72,
-	0x9f, 0xbf, 0xae, 0xdc, 0xf1, 0xdf, 0x56, 0x86, 0x58, 0x67, 0x80, 0xdc, 0xfb, 0x76, 0x83, 0x56,
-	0xa6, 0x8b, 0x85, 0xfc, 0xd0, 0x77, 0xb9, 0x7c, 0x7c, 0x9b, 0xdd, 0x19, 0x3f, 0xfd, 0xd5, 0x93,
-	0x5e, 0x8c, 0x9f, 0x3b, 0x0f, 0xe7, 0x06, 0xda, 0x16, 0x78, 0x50, 0xfc, 0x75, 0x1e, 0xa6, 0xcb,
-	0xd4, 0x92, 0xbf, 0x91, 0x40, 0xee, 0x33, 0xb7, 0x5d, 0x1d, 0x61, 0x4c, 0xdf, 0xd1, 0x47, 0x79,
-	0x77, 0x12, 0x54, 0x38, 0x30, 0x7d, 0x2d, 0xc1, 0xa9, 0xde, 0x37, 0x97, 0x2b, 0x63, 0xe9, 0x8c,
-	0x82, 0x94, 0x9b, 0x13, 0x80, 0x42, 0x3b, 0xbe, 0x93, 0xe0, 0x74, 0xff, 0xb9, 0xec, 0xed, 0xd1,
-	0x6a, 0xfb, 0x02, 0x95, 0xf7, 0x27, 0x04, 0x86, 0x36, 0xb5, 0x61, 0x3e, 0x32, 0x9e, 0xe5, 0x47,
-	0x2b, 0xec, 0x96, 0x57, 0xae, 0x1d, 0x4f, 0x3e, 0xbe, 0x6f, 0x38, 0x61, 0x8d, 0xb9, 0x6f, 0x20,
-	0x3f, 0xee, 0xbe, 0xf1, 0xd6, 0x24, 0x53, 0x48, 0x77, 0xb7, 0xa5, 0x4b, 0xe3, 0xa9, 0x11, 0xe2,
-	0xca, 0x5b, 0xc7, 0x12, 0x0f, 0x37, 0xfd, 0x1c, 0x16, 0x63, 0x2f, 0x7e, 0x3b, 0xa3, 0x15, 0x45,
-	0x11, 0xca, 0xf5, 0xe3, 0x22, 0xc2, 0xdd, 0xbf, 0x92, 0xe0, 0x64, 0xcf, 0x87, 0x82, 0xe2, 0x68,
-	0x75, 0x71, 0x8c, 0x72, 0xe3, 0xf8, 0x98, 0xd0, 0x88, 0x2f, 0x60, 0x29, 0xfe, 0x79, 0xe5, 0xf2,
-	0x68, 0x75, 0x31, 0x88, 0xf2, 0xce, 0xb1, 0x21, 0xdd, 0x67, 0x10, 0xeb, 0x2c, 0x63, 0x9c, 0x41,
-	0x14, 0x31, 0xce, 0x19, 0xf4, 0xef, 0x37, 0x78, 0x05, 0xf5, 0x76, 0x9b, 0x2b, 0xe3, 0x54, 0x6f,
-	0x0c, 0x34, 0xce, 0x15, 0x34, 0xb0, 0xbf, 0xc8, 0x3f, 0x48, 0xb0, 0x32, 0xa0, 0xb9, 0x5c, 0x1f,
-	0xf7, 0x74, 0xe3, 0x48, 0xe5, 0x83, 0x49, 0x91, 0x81, 0x59, 0xbb, 0x1f, 0x3d, 0x7d, 0x91, 0x95,
-	0x9e, 0xbd, 0xc8, 0x4a, 0x7f, 0xbe, 0xc8, 0x4a, 0xdf, 0xbe, 0xcc, 0x4e, 0x3d, 0x7b, 0x99, 0x9d,
-	0xfa, 0xfd, 0x65, 0x76, 0xea, 0xb3, 0xcb, 0x5d, 0x23, 0x98, 0xaf, 0xfb, 0x52, 0xec, 0xf3, 0x5e,
-	0x27, 0xf2, 0xf5, 0xd2, 0x9f, 0xc8, 0x2a, 0x09, 0xfc, 0xa8, 0x77, 0xe5, 0x9f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xca, 0xdb, 0x59, 0x1b, 0xeb, 0x14, 0x00, 0x00,
+	// 1637 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xdf, 0x6f, 0x1b, 0xc5,
+	0x13, 0xcf, 0x35, 0x89, 0x63, 0x8f, 0xe3, 0x24, 0xbd, 0x26, 0xa9, 0x73, 0x69, 0x9c, 0xf4, 0xda,
+	0xe6, 0x1b, 0x7d, 0xd5, 0xda, 0xa9, 0x5b, 0x4a, 0xdb, 0x20, 0xa0, 0x31, 0xfd, 0x11, 0x51, 0xb7,
+	0xd1, 0x35, 0x05, 0xc4, 0xcb, 0xe9, 0x7c, 0xb7, 0xb9, 0x9c, 0x62, 0xdf, 0x59, 0xb7, 0x6b, 0xcb,
+	0xa9, 0x90, 0x40, 0x48, 0x48, 0x3c, 0x02, 0x42, 0x42, 0x42, 0x82, 0x37, 0xf8, 0x3f, 0x78, 0xeb,
+	0x63, 0xd5, 0x27, 0xc4, 0x43, 0x85, 0xda, 0xbf, 0x00, 0xfe, 0x02, 0x74, 0xbb, 0x7b, 0x1b, 0xfb,
+	0xfc, 0xdb, 0x11, 0xe2, 0xc5, 0xbe, 0x99, 0x9b, 0xcf, 0xec, 0xcc, 0xec, 0xcc, 0xce, 0xec, 0xc1,
+	0xfa, 0x33, 0x44, 0x0c, 0xf3, 0xc0, 0x70, 0xdc, 0x1c, 0x7d, 0xf2, 0x7c, 0x94, 0x33, 0x7d, 0x0f,
+	0x63, 0xc6, 0x23, 0x8d, 0x6c, 0xd5, 0xf7, 0x88, 0x27, 0xaf, 0x08, 0xb9, 0x6c, 0x28, 0x97, 0x3d,
+	0x96, 0x53, 0xe6, 0x6d, 0xcf, 0xf6, 0xa8, 0x64, 0x2e, 0x78, 0x62, 0x20, 0xe5, 0xff, 0x1d, 0x94,
+	0x57, 0x0f, 0xed, 0x1c, 0x65, 0x61, 0xfe, 0xc7, 0x65, 0xd7, 0xbb, 0xc9, 0x7a, 0x8e, 0x4b, 0x7f,
+	0xfa, 0xe8, 0xac, 0xfa, 0x9e, 0xb7, 0x8f, 0xf9, 0x1f, 0x97, 0xbd, 0xd1, 0xdb, 0x39, 0xdf, 0x20,
+	0x48, 0x2f, 0x3b, 0x15, 0x87, 0x20, 0x5f, 0xdf, 0x2f, 0x1b, 0x76, 0x88, 0xbb, 0xd5, 0x1b, 0x67,
+	0x9a, 0xa4, 0xa1, 0xfb, 0x88, 0x20, 0x97, 0x38, 0x9e, 0xab, 0x57, 0xbd, 0xb2, 0x63, 0x1e, 0x31,
+	0xa8, 0xfa, 0x9d, 0x04, 0x72, 0x11, 0xdb, 0x45, 0xc7, 0x0e, 0xb4, 0xef, 0x61, 0x7c, 0xaf, 0xe6,
+	0x5a, 0x58, 0x4e, 0xc3, 0x94, 0xe9, 0x23, 0x83, 0x78, 0x7e, 0x5a, 0x5a, 0x93, 0x36, 0x12, 0x5a,
+	0x48, 0xca, 0x4b, 0x10, 0xa7, 0x5a, 0x75, 0xc7, 0x4a, 0x9f, 0x5a, 0x93, 0x36, 0xc6, 0xb5, 0x29,
+	0x4a, 0xef, 0x58, 0xf2, 0x7d, 0x88, 0x19, 0x15, 0xaf, 0xe6, 0x92, 0xf4, 0x78, 0x80, 0xd9, 0xce,
+	0x3d, 0x7f, 0xb5, 0x3a, 0xf6, 0xc7, 0xab, 0xd5, 0xff, 0xd9, 0x0e, 0x39, 0xa8, 0x95, 0xb2, 0xa6,
+	0x57, 0xc9, 0x99, 0x1e, 0xae, 0x78, 0x98, 0xff, 0x5d, 0xc1, 0xd6, 0x61, 0x8e, 0x1c, 0x55, 0x11,
+	0xce, 0x3e, 0x75, 0x5c, 0xa2, 0x71, 0xb8, 0x7a, 0x0e, 0x94, 0x76, 0x9b, 0x34, 0x84, 0xab, 0x9e,
+	0x8b, 0x91, 0xfa, 0x08, 0xce, 0x14, 0xb1, 0xfd, 0xb4, 0x6a, 0xb1, 0x97, 0x77, 0x2c, 0xcb, 0x47,
+	0xb8, 0x97, 0xc9, 0x2b, 0x00, 0x04, 0x63, 0xbd, 0x5a, 0x2b, 0x1d, 0xa2, 0x23, 0x6a, 0x74, 0x42,
+	0x4b, 0x10, 0x8c, 0x77, 0x29, 0x43, 0x5d, 0x81, 0xe5, 0x0e, 0xfa, 0xc4, 0x72, 0x3f, 0x9f, 0x82,
+	0xf9, 0x22, 0xb6, 0xef, 0x58, 0xd6, 0x8e, 0x5b, 0xf2, 0x6a, 0xae, 0xb5, 0xe7, 0x1b, 0xe6, 0x21,
+	0xf2, 0x47, 0x8b, 0xd1, 0x59, 0x98, 0x22, 0x0d, 0xfd, 0xc0, 0xc0, 0x07, 0x2c, 0x48, 0x5a, 0x8c,
+	0x34, 0x1e, 0x18, 0xf8, 0x40, 0xde, 0x86, 0x44, 0x90, 0x35, 0x7a, 0x10, 0x8e, 0xf4, 0xc4, 0x9a,
+	0xb4, 0x31, 0x93, 0xbf, 0x94, 0xed, 0x90, 0xc4, 0xd5, 0x43, 0x3b, 0x4b, 0xd3, 0xab, 0xe0, 0x39,
+	0xee, 0xde, 0x51, 0x15, 0x69, 0x71, 0x93, 0x3f, 0xc9, 0xb7, 0x61, 0x92, 0xe6, 0x53, 0x7a, 0x72,
+	0x4d, 0xda, 0x48, 0xe6, 0x2f, 0x76, 0xc3, 0xf3, 0xa4, 0xdb, 0x0d, 0xfe, 0x34, 0x06, 0x09, 0x82,
+	0x54, 0x2a, 0x7b, 0xe6, 0x21, 0xb3, 0x2d, 0xc6, 0x82, 0x44, 0x39, 0xd4, 0xbc, 0x25, 0x88, 0x93,
+	0x86, 0xee, 0xb8, 0x16, 0x6a, 0xa4, 0xa7, 0x98, 0x4b, 0xa4, 0xb1, 0x13, 0x90, 0x6a, 0x06, 0xce,
+	0x75, 0x8a, 0x8f, 0x08, 0xe0, 0x4b, 0x09, 0x4e, 0x17, 0xb1, 0xfd, 0xf1, 0x81, 0x43, 0x50, 0xd9,
+	0xc1, 0xe4, 0xae, 0x56, 0xc8, 0x6f, 0xf6, 0x88, 0xde, 0x05, 0x48, 0x21, 0xdf, 0xcc, 0x6f, 0xea,
+	0x06, 0xdb, 0x09, 0xbe, 0x63, 0xd3, 0x94, 0x19, 0xee, 0x76, 0x73, 0x88, 0xc7, 0x5b, 0x43, 0x2c,
+	0xc3, 0x84, 0x6b, 0x54, 0x58, 0x10, 0x13, 0x1a, 0x7d, 0x96, 0x17, 0x21, 0x86, 0x8f, 0x2a, 0x25,
+	0xaf, 0x4c, 0x43, 0x93, 0xd0, 0x38, 0x25, 0x2b, 0x10, 0xb7, 0x90, 0xe9, 0x54, 0x8c, 0x32, 0xa6,
+	0x3e, 0xa7, 0x34, 0x41, 0xcb, 0xcb, 0x90, 0xb0, 0x0d, 0xcc, 0x0a, 0x8e, 0xfb, 0x1c, 0xb7, 0x0d,
+	0xfc, 0x30, 0xa0, 0x55, 0x1d, 0x96, 0xda, 0x7c, 0x0a, 0x3d, 0x0e, 0x3c, 0x78, 0xd6, 0xe2, 0x01,
+	0xf3, 0x70, 0xfa, 0x59, 0xb3, 0x07, 0x2b, 0x00, 0xa6, 0x29, 0x62, 0xca, 0xb3, 0xd2, 0x34, 0xc3,
+	0xa8, 0xfe, 0x25, 0xc1, 0x02, 0x0b, 0xeb, 0xe3, 0x1a, 0x39, 0x79, 0xde, 0xcd, 0xc3, 0xa4, 0xeb,
+	0xb9, 0x26, 0xa2, 0xc1, 0x9a, 0xd0, 0x18, 0xd1, 0x9c, 0x8d, 0x13, 0x2d, 0xd9, 0xf8, 0xdf, 0x64,
+	0xd2, 0xbb, 0xb0, 0xd2, 0xd1, 0x65, 0x11, 0xd8, 0x15, 0x00, 0x07, 0xeb, 0x3e, 0xaa, 0x78, 0x75,
+	0x64, 0x51, 0xef, 0xe3, 0x5a, 0xc2, 0xc1, 0x1a, 0x63, 0xa8, 0x08, 0xd2, 0x45, 0x6c, 0x33, 0xea,
+	0xdf, 0x8b, 0x9a, 0xaa, 0xc2, 0x5a, 0xb7, 0x65, 0x44, 0xd2, 0xff, 0x20, 0xc1, 0x6c, 0x11, 0xdb,
+	0x1f, 0x79, 0x04, 0xdd, 0x37, 0xf0, 0xae, 0xef, 0x98, 0x68, 0x64, 0x13, 0xaa, 0xbe, 0x73, 0x6c,
+	0x02, 0x25, 0xe4, 0xf3, 0x30, 0xcd, 0x62, 0xec, 0xd6, 0x2a, 0x25, 0xe4, 0xd3, 0xdd, 0x9b, 0xd0,
+	0x92, 0x94, 0xf7, 0x88, 0xb2, 0x68, 0xca, 0xd7, 0xaa, 0xd5, 0xf2, 0x91, 0x48, 0x79, 0x4a, 0xa9,
+	0x4b, 0x70, 0x36, 0x62, 0x98, 0x30, 0xfa, 0x97, 0x98, 0x30, 0x3a, 0xf4, 0xab, 0x87, 0xd1, 0xcb,
+	0x40, 0xd3, 0x95, 0x6d, 0x33, 0xcb, 0xdf, 0x78, 0xc0, 0xa0, 0xbb, 0x7c, 0x1d, 0x16, 0xbd, 0x12,
+	0x46, 0x7e, 0x1d, 0x59, 0xba, 0xc7, 0x75, 0x35, 0x1f, 0x7b, 0xf3, 0xe1, 0xdb, 0x70, 0x21, 0x8a,
+	0x2a, 0x40, 0xa6, 0x1d, 0xc5, 0x93, 0x09, 0x39, 0xf6, 0x01, 0xe1, 0x8e, 0x2e, 0x47, 0xd1, 0xdb,
+	0x34, 0xbd, 0xa8, 0x88, 0xbc, 0x05, 0x4a, 0xbb, 0x92, 0xa0, 0x92, 0x6b, 0x18, 0x59, 0x69, 0xa0,
+	0x0a, 0xce, 0x46, 0x15, 0xdc, 0x37, 0xf0, 0x53, 0x8c, 0x2c, 0xf9, 0x0b, 0x09, 0x2e, 0xb5, 0xa3,
+	0xd1, 0xfe, 0x3e, 0x32, 0x89, 0x53, 0x47, 0x54, 0x0f, 0xdb, 0x8f, 0x24, 0xed, 0x71, 0x59, 0xde,
+	0xe3, 0xd6, 0x07, 0xe8, 0x71, 0x3b, 0x2e, 0xd1, 0xce, 0x47, 0x17, 0xbe, 0x1b, 0xaa, 0x16, 0x69,
+	0xb2, 0xdb, 0xdf, 0x02, 0x76, 0x26, 0x4d, 0x53, 0x57, 0x7a, 0x6a, 0xa4, 0x87, 0x95, 0xec, 0xc1,
+	0x4c, 0xdd, 0x28, 0xd7, 0x90, 0xee, 0x23, 0x13, 0x39, 0x41, 0xe9, 0xd0, 0x94, 0xd8, 0x7e, 0x30,
+	0x64, 0x83, 0xfe, 0xfb, 0xd5, 0xea, 0xc2, 0x91, 0x51, 0x29, 0xdf, 0x56, 0x5b, 0xd5, 0xa9, 0x5a,
+	0x8a, 0x32, 0x34, 0x4e, 0xcb, 0x1f, 0x40, 0x0c, 0x13, 0x83, 0xd4, 0xd8, 0xa1, 0x3a, 0x93, 0xbf,
+	0xdc, 0xb5, 0x93, 0xb1, 0x91, 0x8a, 0x03, 0x9f, 0x50, 0x8c, 0xc6, 0xb1, 0xf2, 0x25, 0x98, 0x11,
+	0xfe, 0x53, 0x41, 0x7e, 0x5e, 0xa4, 0x42, 0x6e, 0x21, 0x60, 0xca, 0x97, 0x41, 0x16, 0x62, 0x41,
+	0x9f, 0x67, 0x15, 0x1b, 0xa7, 0xc1, 0x99, 0x0b, 0xdf, 0xec, 0x61, 0xfc, 0x28, 0xe0, 0xb7, 0xf6,
+	0xd9, 0xc4, 0x48, 0x7d, 0xb6, 0xa9, 0x84, 0xc2, 0x98, 0x8b, 0x12, 0xfa, 0x6d, 0x02, 0x66, 0xf8,
+	0xbb, 0x1d, 0xb7, 0x5f, 0x05, 0x05, 0x25, 0x8a, 0x5c, 0x0b, 0xf9, 0xbc, 0x7c, 0x38, 0x25, 0xaf,
+	0xc3, 0x2c, 0x7b, 0xd2, 0x23, 0x3d, 0x2e, 0xc5, 0xd8, 0x05, 0x7e, 0x36, 0x28, 0x10, 0xe7, 0x5b,
+	0xe0, 0xf3, 0xf3, 0x5b, 0xd0, 0x41, 0xf0, 0xc2, 0x67, 0x1e, 0xbc, 0x49, 0xa6, 0x22, 0xe4, 0xb2,
+	0xe0, 0x1d, 0xcf, 0x6c, 0xb1, 0x13, 0xcd, 0x6c, 0x81, 0x97, 0x15, 0x84, 0xb1, 0x61, 0xb3, 0xd0,
+	0x27, 0xb4, 0x90, 0x0c, 0xce, 0x2a, 0xc7, 0x6d, 0x3a, 0x00, 0x12, 0xf4, 0x75, 0xd2, 0x71, 0x8f,
+	0xeb, 0x7e, 0x13, 0xe6, 0x1d, 0xb7, 0x43, 0xb5, 0xb3, 0x62, 0x95, 0x1d, 0xb7, 0xad, 0xc8, 0x5b,
+	0x9a, 0x73, 0x92, 0x8a, 0x89, 0xe6, 0xdc, 0xba, 0xc7, 0xd3, 0xa3, 0xcd, 0x52, 0xcb, 0x90, 0x20,
+	0x0d, 0xdd, 0xf3, 0x1d, 0xdb, 0x71, 0xd3, 0x29, 0x16, 0x5c, 0xd2, 0x78, 0x4c, 0xe9, 0xe0, 0x50,
+	0x36, 0x30, 0x46, 0x24, 0x3d, 0x43, 0x5f, 0x30, 0x42, 0x5e, 0x85, 0x24, 0xaa, 0x23, 0x97, 0xf0,
+	0xe6, 0x36, 0x4b, 0xad, 0x02, 0xca, 0xa2, 0xfd, 0x8d, 0xed, 0x49, 0x1d, 0xf9, 0x44, 0x0c, 0x06,
+	0x73, 0x14, 0x9f, 0x62, 0x5c, 0x3e, 0x19, 0xa8, 0x69, 0x58, 0x6c, 0x4d, 0x21, 0x91, 0x5d, 0x0f,
+	0xe9, 0x24, 0x75, 0xa7, 0xe4, 0xf9, 0xe4, 0x09, 0xa9, 0x99, 0x87, 0x85, 0xc2, 0xde, 0x27, 0xbd,
+	0x07, 0xdf, 0x5e, 0x23, 0xc6, 0x32, 0x2c, 0xb5, 0x69, 0x13, 0x4b, 0xd5, 0xe9, 0xd4, 0xab, 0xa1,
+	0xfd, 0x9a, 0x6b, 0x51, 0x11, 0x64, 0x9d, 0x68, 0x35, 0xe6, 0x7c, 0xa0, 0x4d, 0x38, 0x3f, 0x1e,
+	0x3a, 0x4f, 0xd7, 0xe0, 0xce, 0xb3, 0x69, 0xb2, 0x6d, 0x5d, 0x61, 0xd7, 0x8f, 0x12, 0x2c, 0x89,
+	0x71, 0x5d, 0x33, 0x08, 0x7a, 0xc8, 0x2e, 0x44, 0xf7, 0x82, 0xfb, 0x50, 0x0f, 0xeb, 0x4c, 0x90,
+	0xdb, 0xef, 0x4f, 0xd4, 0xca, 0x64, 0x3e, 0x97, 0xed, 0x79, 0x5b, 0xcc, 0x46, 0x97, 0xd9, 0x9e,
+	0x08, 0xaa, 0x44, 0x9b, 0xf3, 0x23, 0x7c, 0xf5, 0x02, 0x9c, 0xef, 0x6a, 0x9b, 0xf0, 0xe0, 0x57,
+	0x09, 0xce, 0x09, 0xa9, 0x82, 0x49, 0x1a, 0x5a, 0x78, 0x35, 0xdb, 0xa5, 0x37, 0xb3, 0x1e, 0x4e,
+	0x94, 0x61, 0xa1, 0xe3, 0x65, 0x8e, 0xfb, 0x91, 0xef, 0xe3, 0x47, 0x87, 0xc5, 0xb8, 0x2b, 0x67,
+	0xcc, 0xf6, 0x57, 0xea, 0x3a, 0x5c, 0xec, 0x65, 0x67, 0xe8, 0x50, 0xfe, 0x65, 0x0a, 0xc6, 0x8b,
+	0xd8, 0x96, 0xbf, 0x96, 0x40, 0xee, 0x30, 0xaf, 0x5e, 0xef, 0x63, 0x55, 0xc7, 0x91, 0x4f, 0x79,
+	0x67, 0x14, 0x94, 0x18, 0x14, 0xbf, 0x92, 0xe0, 0x74, 0xfb, 0x8d, 0xed, 0xda, 0x40, 0x3a, 0x5b,
+	0x41, 0xca, 0xd6, 0x08, 0x20, 0x61, 0xc7, 0xb7, 0x12, 0x2c, 0x74, 0x9e, 0x47, 0xdf, 0xee, 0xaf,
+	0xb6, 0x23, 0x50, 0x79, 0x6f, 0x44, 0xa0, 0xb0, 0xa9, 0x0e, 0xd3, 0x2d, 0x63, 0x69, 0xb6, 0xbf,
+	0xc2, 0x66, 0x79, 0xe5, 0xc6, 0x70, 0xf2, 0xd1, 0x75, 0xc5, 0x64, 0x39, 0xe0, 0xba, 0xa1, 0xbc,
+	0x72, 0x63, 0x38, 0x79, 0xb1, 0x2e, 0x86, 0x64, 0x73, 0x3b, 0xbe, 0x32, 0x98, 0x1a, 0x2e, 0xae,
+	0xbc, 0x35, 0x94, 0xb8, 0x58, 0xf4, 0x33, 0x98, 0x89, 0x5c, 0x78, 0x37, 0xfb, 0x2b, 0x6a, 0x45,
+	0x28, 0x37, 0x87, 0x45, 0x88, 0xd5, 0xbf, 0x94, 0x60, 0xae, 0xed, 0x03, 0x49, 0xbe, 0xbf, 0xba,
+	0x28, 0x46, 0xb9, 0x3d, 0x3c, 0x46, 0x18, 0xf1, 0x39, 0xcc, 0x46, 0x3f, 0x2b, 0x5d, 0xed, 0xaf,
+	0x2e, 0x02, 0x51, 0x6e, 0x0d, 0x0d, 0x69, 0xde, 0x83, 0x48, 0xab, 0x1c, 0x60, 0x0f, 0x5a, 0x11,
+	0xca, 0xcd, 0x61, 0x11, 0x2d, 0x47, 0x50, 0x7b, 0xfb, 0xbc, 0x36, 0x48, 0xf5, 0x46, 0x40, 0xca,
+	0xd6, 0x08, 0x20, 0x61, 0xc7, 0xf7, 0x12, 0x2c, 0x76, 0xe9, 0x96, 0x37, 0x07, 0xdd, 0xdd, 0x28,
+	0x52, 0x79, 0x7f, 0x54, 0xa4, 0x30, 0xeb, 0x27, 0x09, 0x96, 0xba, 0xb7, 0xc0, 0xad, 0x41, 0xf5,
+	0x77, 0x00, 0x2b, 0x85, 0x13, 0x80, 0x43, 0xfb, 0xb6, 0x3f, 0x7c, 0xfe, 0x3a, 0x23, 0xbd, 0x78,
+	0x9d, 0x91, 0xfe, 0x7c, 0x9d, 0x91, 0xbe, 0x79, 0x93, 0x19, 0x7b, 0xf1, 0x26, 0x33, 0xf6, 0xfb,
+	0x9b, 0xcc, 0xd8, 0xa7, 0x57, 0x9b, 0x46, 0xe3, 0x40, 0xfd, 0x95, 0xc8, 0x97, 0xd7, 0x46, 0xcb,
+	0x07, 0xe9, 0x60, 0x52, 0x2e, 0xc5, 0xe8, 0xc7, 0xd6, 0x6b, 0xff, 0x0c, 0x00, 0x73, 0x8e, 0x76,
+	0x80, 0xbe, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1587,6 +1693,7 @@ type MsgClient interface {
 	AbortStuckCCTX(ctx context.Context, in *MsgAbortStuckCCTX, opts ...grpc.CallOption) (*MsgAbortStuckCCTXResponse, error)
 	RefundAbortedCCTX(ctx context.Context, in *MsgRefundAbortedCCTX, opts ...grpc.CallOption) (*MsgRefundAbortedCCTXResponse, error)
 	UpdateRateLimiterFlags(ctx context.Context, in *MsgUpdateRateLimiterFlags, opts ...grpc.CallOption) (*MsgUpdateRateLimiterFlagsResponse, error)
+	UpdateCctxRetentionPolicy(ctx context.Context, in *MsgUpdateCctxRetentionPolicy, opts ...grpc.CallOption) (*MsgUpdateCctxRetentionPolicyResponse, error)
 }
 
 type msgClient struct {
@@ -1705,6 +1812,15 @@ func (c *msgClient) UpdateRateLimiterFlags(ctx context.Context, in *MsgUpdateRat
 	return out, nil
 }
 
+func (c *msgClient) UpdateCctxRetentionPolicy(ctx context.Context, in *MsgUpdateCctxRetentionPolicy, opts ...grpc.CallOption) (*MsgUpdateCctxRetentionPolicyResponse, error) {
+	out := new(MsgUpdateCctxRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/UpdateCctxRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	AddOutboundTracker(context.Context, *MsgAddOutboundTracker) (*MsgAddOutboundTrackerResponse, error)
@@ -1719,6 +1835,7 @@ type MsgServer interface {
 	AbortStuckCCTX(context.Context, *MsgAbortStuckCCTX) (*MsgAbortStuckCCTXResponse, error)
 	RefundAbortedCCTX(context.Context, *MsgRefundAbortedCCTX) (*MsgRefundAbortedCCTXResponse, error)
 	UpdateRateLimiterFlags(context.Context, *MsgUpdateRateLimiterFlags) (*MsgUpdateRateLimiterFlagsResponse, error)
+	UpdateCctxRetentionPolicy(context.Context, *MsgUpdateCctxRetentionPolicy) (*MsgUpdateCctxRetentionPolicyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1761,6 +1878,9 @@ func (*UnimplementedMsgServer) RefundAbortedCCTX(ctx context.Context, req *MsgRe
 func (*UnimplementedMsgServer) UpdateRateLimiterFlags(ctx context.Context, req *MsgUpdateRateLimiterFlags) (*MsgUpdateRateLimiterFlagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRateLimiterFlags not implemented")
 }
+func (*UnimplementedMsgServer) UpdateCctxRetentionPolicy(ctx context.Context, req *MsgUpdateCctxRetentionPolicy) (*MsgUpdateCctxRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCctxRetentionPolicy not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1982,6 +2102,24 @@ func _Msg_UpdateRateLimiterFlags_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateCctxRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCctxRetentionPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCctxRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/UpdateCctxRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCctxRetentionPolicy(ctx, req.(*MsgUpdateCctxRetentionPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.crosschain.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2034,6 +2172,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateRateLimiterFlags",
 			Handler:    _Msg_UpdateRateLimiterFlags_Handler,
 		},
+		{
+			MethodName: "UpdateCctxRetentionPolicy",
+			Handler:    _Msg_UpdateCctxRetentionPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/crosschain/tx.proto",
@@ -2748,6 +2890,15 @@ func (m *MsgVoteInbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RevertAddress) > 0 {
+		i -= len(m.RevertAddress)
+		copy(dAtA[i:], m.RevertAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RevertAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.EventIndex != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.EventIndex))
 		i--
@@ -3053,6 +3204,69 @@ func (m *MsgUpdateRateLimiterFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateCctxRetentionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCctxRetentionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCctxRetentionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CctxRetentionPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCctxRetentionPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCctxRetentionPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCctxRetentionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -3429,6 +3643,10 @@ func (m *MsgVoteInbound) Size() (n int) {
 	if m.EventIndex != 0 {
 		n += 1 + sovTx(uint64(m.EventIndex))
 	}
+	l = len(m.RevertAddress)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3521,6 +3739,30 @@ func (m *MsgUpdateRateLimiterFlagsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateCctxRetentionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CctxRetentionPolicy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateCctxRetentionPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6012,6 +6254,38 @@ func (m *MsgVoteInbound) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevertAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6608,6 +6882,171 @@ func (m *MsgUpdateRateLimiterFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateCctxRetentionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCctxRetentionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCctxRetentionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxRetentionPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CctxRetentionPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCctxRetentionPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCctxRetentionPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCctxRetentionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15,6 +15,7 @@ import (
 )
 
 // VerifyInboundBody validates the tx body for an inbound
+// the proof-based trackers are only supported for the default chains
 func VerifyInboundBody(
 	msg MsgAddInboundTracker,
 	txBytes []byte,
@@ -22,7 +23,7 @@ func VerifyInboundBody(
 	tss observertypes.QueryGetTssAddressResponse,
 ) error {
 	// verify message against transaction body
-	if chains.IsEVMChain(msg.ChainId) {
+	if chains.IsEVMChain(msg.ChainId, []chains.Chain{}) {
 		return verifyInboundBodyEVM(msg, txBytes, chainParams, tss)
 	}
 
@@ -77,11 +78,12 @@ func verifyInboundBodyEVM(
 }
 
 // VerifyOutboundBody verifies the tx body for an outbound
+// the proof-based trackers are only supported for the default chains
 func VerifyOutboundBody(msg MsgAddOutboundTracker, txBytes []byte, tss observertypes.QueryGetTssAddressResponse) error {
 	// verify message against transaction body
-	if chains.IsEVMChain(msg.ChainId) {
+	if chains.IsEVMChain(msg.ChainId, []chains.Chain{}) {
 		return verifyOutboundBodyEVM(msg, txBytes, tss.Eth)
-	} else if chains.IsBitcoinChain(msg.ChainId) {
+	} else if chains.IsBitcoinChain(msg.ChainId, []chains.Chain{}) {
 		return verifyOutboundBodyBTC(msg, txBytes, tss.Btc)
 	}
 	return fmt.Errorf("cannot verify outbound body for chain %d", msg.ChainId)
@@ -122,7 +124,7 @@ func verifyOutboundBodyEVM(msg MsgAddOutboundTracker, txBytes []byte, tssEth str
 // TODO: Implement tests for the function
 // https://github.com/zeta-chain/node/issues/1994
 func verifyOutboundBodyBTC(msg MsgAddOutboundTracker, txBytes []byte, tssBtc string) error {
-	if !chains.IsBitcoinChain(msg.ChainId) {
+	if !chains.IsBitcoinChain(msg.ChainId, []chains.Chain{}) {
 		return fmt.Errorf("not a Bitcoin chain ID %d", msg.ChainId)
 	}
 	tx, err := btcutil.NewTxFromBytes(txBytes)
@@ -5,12 +5,17 @@ import (
 	"regexp"
 
 	"cosmossdk.io/errors"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/zeta-chain/zetacore/pkg/chains"
 )
 
+// solanaSignatureLen is the length in bytes of a Solana transaction signature
+const solanaSignatureLen = 64
+
 // ValidateCCTXIndex validates the CCTX index
 func ValidateCCTXIndex(index string) error {
 	if len(index) != CCTXIndexLength {
@@ -20,15 +25,16 @@ func ValidateCCTXIndex(index string) error {
 }
 
 // ValidateHashForChain validates the hash for the chain
-func ValidateHashForChain(hash string, chainID int64) error {
-	if chains.IsEthereumChain(chainID) || chains.IsZetaChain(chainID) {
+// additionalChains is the list of chains supported in addition to the default chains
+func ValidateHashForChain(hash string, chainID int64, additionalChains []chains.Chain) error {
+	if chains.IsEthereumChain(chainID, additionalChains) || chains.IsZetaChain(chainID, additionalChains) {
 		_, err := hexutil.Decode(hash)
 		if err != nil {
 			return fmt.Errorf("hash must be a valid ethereum hash %s", hash)
 		}
 		return nil
 	}
-	if chains.IsBitcoinChain(chainID) {
+	if chains.IsBitcoinChain(chainID, additionalChains) {
 		r, err := regexp.Compile("^[a-fA-F0-9]{64}$")
 		if err != nil {
 			return fmt.Errorf("error compiling regex")
@@ -38,22 +44,29 @@ func ValidateHashForChain(hash string, chainID int64) error {
 		}
 		return nil
 	}
+	if chains.IsSolanaChain(chainID, additionalChains) {
+		if len(base58.Decode(hash)) != solanaSignatureLen {
+			return fmt.Errorf("hash must be a valid solana signature %s", hash)
+		}
+		return nil
+	}
 	return fmt.Errorf("invalid chain id %d", chainID)
 }
 
 // ValidateAddressForChain validates the address for the chain
-func ValidateAddressForChain(address string, chainID int64) error {
+// additionalChains is the list of chains supported in addition to the default chains
+func ValidateAddressForChain(address string, chainID int64, additionalChains []chains.Chain) error {
 	// we do not validate the address for zeta chain as the address field can be btc or eth address
-	if chains.IsZetaChain(chainID) {
+	if chains.IsZetaChain(chainID, additionalChains) {
 		return nil
 	}
-	if chains.IsEthereumChain(chainID) {
+	if chains.IsEthereumChain(chainID, additionalChains) {
 		if !ethcommon.IsHexAddress(address) {
 			return fmt.Errorf("invalid address %s , chain %d", address, chainID)
 		}
 		return nil
 	}
-	if chains.IsBitcoinChain(chainID) {
+	if chains.IsBitcoinChain(chainID, additionalChains) {
 		addr, err := chains.DecodeBtcAddress(address, chainID)
 		if err != nil {
 			return fmt.Errorf("invalid address %s , chain %d: %s", address, chainID, err)
@@ -63,5 +76,17 @@ func ValidateAddressForChain(address string, chainID int64) error {
 		}
 		return nil
 	}
+	if chains.IsSolanaChain(chainID, additionalChains) {
+		if _, err := chains.DecodeSolanaAddress(address); err != nil {
+			return fmt.Errorf("invalid address %s , chain %d: %s", address, chainID, err)
+		}
+		return nil
+	}
+	if chains.IsIBCChain(chainID, additionalChains) {
+		if _, _, err := bech32.DecodeAndConvert(address); err != nil {
+			return fmt.Errorf("invalid address %s , chain %d: %s", address, chainID, err)
+		}
+		return nil
+	}
 	return fmt.Errorf("invalid chain id %d", chainID)
 }
@@ -12,12 +12,12 @@ import (
 
 func TestValidateAddressForChain(t *testing.T) {
 	// test for eth chain
-	require.Error(t, types.ValidateAddressForChain("0x123", chains.Goerli.ChainId))
-	require.Error(t, types.ValidateAddressForChain("", chains.Goerli.ChainId))
-	require.Error(t, types.ValidateAddressForChain("%%%%", chains.Goerli.ChainId))
+	require.Error(t, types.ValidateAddressForChain("0x123", chains.Goerli.ChainId, []chains.Chain{}))
+	require.Error(t, types.ValidateAddressForChain("", chains.Goerli.ChainId, []chains.Chain{}))
+	require.Error(t, types.ValidateAddressForChain("%%%%", chains.Goerli.ChainId, []chains.Chain{}))
 	require.NoError(
 		t,
-		types.ValidateAddressForChain("0x792c127Fa3AC1D52F904056Baf1D9257391e7D78", chains.Goerli.ChainId),
+		types.ValidateAddressForChain("0x792c127Fa3AC1D52F904056Baf1D9257391e7D78", chains.Goerli.ChainId, []chains.Chain{}),
 	)
 
 	// test for btc chain
@@ -26,38 +26,60 @@ func TestValidateAddressForChain(t *testing.T) {
 		types.ValidateAddressForChain(
 			"bc1p4scddlkkuw9486579autxumxmkvuphm5pz4jvf7f6pdh50p2uzqstawjt9",
 			chains.BitcoinMainnet.ChainId,
+			[]chains.Chain{},
 		),
 	)
 	require.NoError(
 		t,
-		types.ValidateAddressForChain("327z4GyFM8Y8DiYfasGKQWhRK4MvyMSEgE", chains.BitcoinMainnet.ChainId),
+		types.ValidateAddressForChain("327z4GyFM8Y8DiYfasGKQWhRK4MvyMSEgE", chains.BitcoinMainnet.ChainId, []chains.Chain{}),
 	)
 	require.NoError(
 		t,
-		types.ValidateAddressForChain("1EYVvXLusCxtVuEwoYvWRyN5EZTXwPVvo3", chains.BitcoinMainnet.ChainId),
+		types.ValidateAddressForChain("1EYVvXLusCxtVuEwoYvWRyN5EZTXwPVvo3", chains.BitcoinMainnet.ChainId, []chains.Chain{}),
 	)
 	require.Error(
 		t,
-		types.ValidateAddressForChain("bcrt1qs758ursh4q9z627kt3pp5yysm78ddny6txaqgw", chains.BitcoinMainnet.ChainId),
+		types.ValidateAddressForChain("bcrt1qs758ursh4q9z627kt3pp5yysm78ddny6txaqgw", chains.BitcoinMainnet.ChainId, []chains.Chain{}),
 	)
-	require.Error(t, types.ValidateAddressForChain("", chains.BitcoinRegtest.ChainId))
+	require.Error(t, types.ValidateAddressForChain("", chains.BitcoinRegtest.ChainId, []chains.Chain{}))
 	require.NoError(
 		t,
-		types.ValidateAddressForChain("bc1qysd4sp9q8my59ul9wsf5rvs9p387hf8vfwatzu", chains.BitcoinMainnet.ChainId),
+		types.ValidateAddressForChain("bc1qysd4sp9q8my59ul9wsf5rvs9p387hf8vfwatzu", chains.BitcoinMainnet.ChainId, []chains.Chain{}),
 	)
 	require.NoError(
 		t,
-		types.ValidateAddressForChain("bcrt1qs758ursh4q9z627kt3pp5yysm78ddny6txaqgw", chains.BitcoinRegtest.ChainId),
+		types.ValidateAddressForChain("bcrt1qs758ursh4q9z627kt3pp5yysm78ddny6txaqgw", chains.BitcoinRegtest.ChainId, []chains.Chain{}),
+	)
+
+	// test for solana chain
+	require.NoError(
+		t,
+		types.ValidateAddressForChain("9WzDXwBbmkg8ZTbNMqUxvQRAyrZzDsGYdLVL9zYtAWWM", chains.SolanaMainnet.ChainId, []chains.Chain{}),
+	)
+	require.Error(
+		t,
+		types.ValidateAddressForChain("0x792c127Fa3AC1D52F904056Baf1D9257391e7D78", chains.SolanaMainnet.ChainId, []chains.Chain{}),
 	)
 
 	// test for zeta chain
 	require.NoError(
 		t,
-		types.ValidateAddressForChain("bcrt1qs758ursh4q9z627kt3pp5yysm78ddny6txaqgw", chains.ZetaChainMainnet.ChainId),
+		types.ValidateAddressForChain("bcrt1qs758ursh4q9z627kt3pp5yysm78ddny6txaqgw", chains.ZetaChainMainnet.ChainId, []chains.Chain{}),
 	)
 	require.NoError(
 		t,
-		types.ValidateAddressForChain("0x792c127Fa3AC1D52F904056Baf1D9257391e7D78", chains.ZetaChainMainnet.ChainId),
+		types.ValidateAddressForChain("0x792c127Fa3AC1D52F904056Baf1D9257391e7D78", chains.ZetaChainMainnet.ChainId, []chains.Chain{}),
+	)
+
+	// test for ibc chain
+	ibcChain := sample.IBCChain(4200)
+	require.NoError(
+		t,
+		types.ValidateAddressForChain("cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu", ibcChain.ChainId, []chains.Chain{ibcChain}),
+	)
+	require.Error(
+		t,
+		types.ValidateAddressForChain("0x792c127Fa3AC1D52F904056Baf1D9257391e7D78", ibcChain.ChainId, []chains.Chain{ibcChain}),
 	)
 }
 
@@ -74,14 +96,16 @@ func TestValidateHashForChain(t *testing.T) {
 		types.ValidateHashForChain(
 			"0x84bd5c9922b63c52d8a9ca686e0a57ff978150b71be0583514d01c27aa341910",
 			chains.Goerli.ChainId,
+			[]chains.Chain{},
 		),
 	)
-	require.Error(t, types.ValidateHashForChain("", chains.Goerli.ChainId))
+	require.Error(t, types.ValidateHashForChain("", chains.Goerli.ChainId, []chains.Chain{}))
 	require.Error(
 		t,
 		types.ValidateHashForChain(
 			"a0fa5a82f106fb192e4c503bfa8d54b2de20a821e09338094ab825cc9b275059",
 			chains.Goerli.ChainId,
+			[]chains.Chain{},
 		),
 	)
 	require.NoError(
@@ -89,6 +113,7 @@ func TestValidateHashForChain(t *testing.T) {
 		types.ValidateHashForChain(
 			"15b7880f5d236e857a5e8f043ce9d56f5ef01e1c3f2a786baf740fc0bb7a22a3",
 			chains.BitcoinMainnet.ChainId,
+			[]chains.Chain{},
 		),
 	)
 	require.NoError(
@@ -96,6 +121,7 @@ func TestValidateHashForChain(t *testing.T) {
 		types.ValidateHashForChain(
 			"a0fa5a82f106fb192e4c503bfa8d54b2de20a821e09338094ab825cc9b275059",
 			chains.BitcoinTestnet.ChainId,
+			[]chains.Chain{},
 		),
 	)
 	require.Error(
@@ -103,6 +129,23 @@ func TestValidateHashForChain(t *testing.T) {
 		types.ValidateHashForChain(
 			"0x84bd5c9922b63c52d8a9ca686e0a57ff978150b71be0583514d01c27aa341910",
 			chains.BitcoinMainnet.ChainId,
+			[]chains.Chain{},
+		),
+	)
+	require.NoError(
+		t,
+		types.ValidateHashForChain(
+			"5VERv8NMvzbJMEkV8xnrLkEaWRtSz9CosKDYjCJjBRnbJLgp8uirBgmQpjKhoR4tjF3ZpRzrFmBV6UjKdiSZkQUW",
+			chains.SolanaMainnet.ChainId,
+			[]chains.Chain{},
+		),
+	)
+	require.Error(
+		t,
+		types.ValidateHashForChain(
+			"15b7880f5d236e857a5e8f043ce9d56f5ef01e1c3f2a786baf740fc0bb7a22a3",
+			chains.SolanaMainnet.ChainId,
+			[]chains.Chain{},
 		),
 	)
 }
@@ -109,8 +109,17 @@ func (k Keeper) GetGasCoinForForeignCoin(ctx sdk.Context, chainID int64) (types.
 }
 
 // GetForeignCoinFromAsset returns the foreign coin for a given asset for a given chain
+// the asset is either an address, or the denom of the tokens of an IBC chain
 func (k Keeper) GetForeignCoinFromAsset(ctx sdk.Context, asset string, chainID int64) (types.ForeignCoins, bool) {
 	if !ethcommon.IsHexAddress(asset) {
+		if asset == "" {
+			return types.ForeignCoins{}, false
+		}
+		for _, coin := range k.GetAllForeignCoinsForChain(ctx, chainID) {
+			if coin.Asset == asset && coin.ForeignChainId == chainID {
+				return coin, true
+			}
+		}
 		return types.ForeignCoins{}, false
 	}
 	assetAddr := ethcommon.HexToAddress(asset)
@@ -153,6 +153,43 @@ func TestKeeperGetForeignCoinFromAsset(t *testing.T) {
 		require.True(t, found)
 		require.Equal(t, "foo", fc.Name)
 	})
+
+	t.Run("can get foreign coin from denom", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+
+		setForeignCoins(ctx, k,
+			types.ForeignCoins{
+				Zrc20ContractAddress: sample.EthAddress().String(),
+				Asset:                "",
+				ForeignChainId:       4200,
+				CoinType:             coin.CoinType_Gas,
+				Name:                 "gas",
+			},
+			types.ForeignCoins{
+				Zrc20ContractAddress: sample.EthAddress().String(),
+				Asset:                "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+				ForeignChainId:       4200,
+				CoinType:             coin.CoinType_ERC20,
+				Name:                 "atom",
+			},
+		)
+
+		fc, found := k.GetForeignCoinFromAsset(
+			ctx,
+			"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+			4200,
+		)
+		require.True(t, found)
+		require.Equal(t, "atom", fc.Name)
+		_, found = k.GetForeignCoinFromAsset(
+			ctx,
+			"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+			4201,
+		)
+		require.False(t, found)
+		_, found = k.GetForeignCoinFromAsset(ctx, "", 4200)
+		require.False(t, found)
+	})
 }
 
 func TestKeeperGetAllForeignCoinMap(t *testing.T) {
@@ -28,7 +28,7 @@ func (k Keeper) SetupChainGasCoinAndPool(
 	decimals uint8,
 	gasLimit *big.Int,
 ) (ethcommon.Address, error) {
-	chain := chains.GetChainFromChainID(chainID)
+	chain := chains.GetChainFromChainID(chainID, k.GetAuthorityKeeper().GetAdditionalChainList(ctx))
 	if chain == nil {
 		return ethcommon.Address{}, zetaObserverTypes.ErrSupportedChains
 	}
@@ -45,8 +45,10 @@ func (k Keeper) SetupChainGasCoinAndPool(
 	// default values
 	if transferGasLimit == nil {
 		transferGasLimit = big.NewInt(21_000)
-		if chains.IsBitcoinChain(chain.ChainId) {
+		if chain.IsBitcoinChain() {
 			transferGasLimit = big.NewInt(100) // 100B for a typical tx
+		} else if chain.IsSolanaChain() {
+			transferGasLimit = big.NewInt(1) // Solana charges a fee per signature and a withdrawal has one signature
 		}
 	}
 
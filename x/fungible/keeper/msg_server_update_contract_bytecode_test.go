@@ -39,6 +39,7 @@ func TestKeeper_UpdateContractBytecode(t *testing.T) {
 		msgServer := keeper.NewMsgServerImpl(*k)
 
 		authorityMock := keepertest.GetFungibleAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 
 		// sample chainIDs and addresses
 		chainList := chains.DefaultChainsList()
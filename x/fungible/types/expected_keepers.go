@@ -61,4 +61,7 @@ type EVMKeeper interface {
 
 type AuthorityKeeper interface {
 	IsAuthorized(ctx sdk.Context, address string, policyType authoritytypes.PolicyType) bool
+
+	// GetAdditionalChainList returns the chains supported in addition to the default chains
+	GetAdditionalChainList(ctx sdk.Context) []chains.Chain
 }
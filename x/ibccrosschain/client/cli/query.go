@@ -20,7 +20,11 @@ func GetQueryCmd(_ string) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand()
+	cmd.AddCommand(
+		CmdListChainChannel(),
+		CmdShowChainChannel(),
+		CmdListOutboundPacket(),
+	)
 
 	return cmd
 }
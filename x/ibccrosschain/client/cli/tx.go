@@ -19,7 +19,9 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand()
+	cmd.AddCommand(
+		CmdUpdateChainChannel(),
+	)
 
 	return cmd
 }
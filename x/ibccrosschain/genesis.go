@@ -8,10 +8,22 @@ import (
 )
 
 // InitGenesis initializes the ibccrosschain module's state from a provided genesis state
-func InitGenesis(_ sdk.Context, _ keeper.Keeper, _ types.GenesisState) {}
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// set chain channels
+	for _, elem := range genState.ChainChannels {
+		k.SetChainChannel(ctx, elem)
+	}
+
+	// set outbound packets
+	for _, elem := range genState.OutboundPackets {
+		k.SetOutboundPacket(ctx, elem)
+	}
+}
 
 // ExportGenesis returns the ibccrosschain module's exported genesis.
-func ExportGenesis(_ sdk.Context, _ keeper.Keeper) *types.GenesisState {
-	var genesis types.GenesisState
-	return &genesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		ChainChannels:   k.GetAllChainChannels(ctx),
+		OutboundPackets: k.GetAllOutboundPackets(ctx),
+	}
 }
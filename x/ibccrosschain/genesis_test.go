@@ -0,0 +1,47 @@
+package ibccrosschain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/nullify"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/ibccrosschain"
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+func TestGenesis(t *testing.T) {
+	t.Run("can import and export genesis", func(t *testing.T) {
+		genesisState := types.GenesisState{
+			ChainChannels: []types.ChainChannel{
+				sample.ChainChannel(4200),
+				sample.ChainChannel(4201),
+			},
+			OutboundPackets: []types.OutboundPacket{
+				sample.OutboundPacket(t, "channel-0", 1),
+				sample.OutboundPacket(t, "channel-0", 2),
+			},
+		}
+
+		// Init and export
+		k, ctx, _, _ := keepertest.IBCCrosschainKeeper(t)
+		ibccrosschain.InitGenesis(ctx, *k, genesisState)
+		got := ibccrosschain.ExportGenesis(ctx, *k)
+		require.NotNil(t, got)
+
+		// Compare genesis after init and export
+		nullify.Fill(&genesisState)
+		nullify.Fill(got)
+		require.Equal(t, genesisState, *got)
+	})
+
+	t.Run("can export genesis with empty state", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.IBCCrosschainKeeper(t)
+		got := ibccrosschain.ExportGenesis(ctx, *k)
+		require.NotNil(t, got)
+		require.Empty(t, got.ChainChannels)
+		require.Empty(t, got.OutboundPackets)
+	})
+}
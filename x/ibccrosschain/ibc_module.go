@@ -1,34 +1,41 @@
 package ibccrosschain
 
 import (
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 
 	"github.com/zeta-chain/zetacore/x/ibccrosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
 )
 
 var (
 	_ porttypes.IBCModule = IBCModule{}
 )
 
-// IBCModule implements the ICS26 interface for transfer given the transfer keeper.
+// IBCModule implements the ICS26 interface as a middleware of the transfer module
+// the ICS-20 transfers with a deposit memo are deposited into zEVM, and the outbounds of the cctxs to IBC chains are
+// finalized from the acknowledgements of their transfers, the other transfers are processed by the transfer module only
 type IBCModule struct {
+	app    porttypes.IBCModule
 	keeper keeper.Keeper
 }
 
-// NewIBCModule creates a new IBCModule given the keeper
-func NewIBCModule(k keeper.Keeper) IBCModule {
+// NewIBCModule creates a new IBCModule given the transfer module and the keeper
+func NewIBCModule(app porttypes.IBCModule, k keeper.Keeper) IBCModule {
 	return IBCModule{
+		app:    app,
 		keeper: k,
 	}
 }
 
 // OnChanOpenInit implements the IBCModule interface
-// TODO: Implement the function as middleware
-// https://github.com/zeta-chain/node/issues/2163
 func (im IBCModule) OnChanOpenInit(
 	ctx sdk.Context,
 	order channeltypes.Order,
@@ -39,21 +46,10 @@ func (im IBCModule) OnChanOpenInit(
 	counterparty channeltypes.Counterparty,
 	version string,
 ) (string, error) {
-	// Set variable to blank to remove lint error so we can keep variable name for future use
-	_ = ctx
-	_ = order
-	_ = connectionHops
-	_ = portID
-	_ = channelID
-	_ = chanCap
-	_ = counterparty
-
-	return version, nil
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
 }
 
 // OnChanOpenTry implements the IBCModule interface.
-// TODO: Implement the function as middleware
-// https://github.com/zeta-chain/node/issues/2163
 func (im IBCModule) OnChanOpenTry(
 	ctx sdk.Context,
 	order channeltypes.Order,
@@ -64,22 +60,19 @@ func (im IBCModule) OnChanOpenTry(
 	counterparty channeltypes.Counterparty,
 	counterpartyVersion string,
 ) (string, error) {
-	// Set variable to blank to remove lint error so we can keep variable name for future us
-	_ = ctx
-	_ = order
-	_ = connectionHops
-	_ = portID
-	_ = channelID
-	_ = chanCap
-	_ = counterparty
-	_ = counterpartyVersion
-
-	return "", nil
+	return im.app.OnChanOpenTry(
+		ctx,
+		order,
+		connectionHops,
+		portID,
+		channelID,
+		chanCap,
+		counterparty,
+		counterpartyVersion,
+	)
 }
 
 // OnChanOpenAck implements the IBCModule interface
-// TODO: Implement the function as middleware
-// https://github.com/zeta-chain/node/issues/2163
 func (im IBCModule) OnChanOpenAck(
 	ctx sdk.Context,
 	portID,
@@ -87,112 +80,102 @@ func (im IBCModule) OnChanOpenAck(
 	counterpartyChannelID string,
 	counterpartyVersion string,
 ) error {
-	// Set variable to blank to remove lint error so we can keep variable name for future use
-	_ = ctx
-	_ = portID
-	_ = channelID
-	_ = counterpartyChannelID
-	_ = counterpartyVersion
-
-	return nil
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
 }
 
 // OnChanOpenConfirm implements the IBCModule interface
-// TODO: Implement the function as middleware
-// https://github.com/zeta-chain/node/issues/2163
 func (im IBCModule) OnChanOpenConfirm(
 	ctx sdk.Context,
 	portID,
 	channelID string,
 ) error {
-	// Set variable to blank to remove lint error so we can keep variable name for future use
-	_ = ctx
-	_ = portID
-	_ = channelID
-
-	return nil
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
 }
 
 // OnChanCloseInit implements the IBCModule interface
-// TODO: Implement the function as middleware
-// https://github.com/zeta-chain/node/issues/2163
 func (im IBCModule) OnChanCloseInit(
 	ctx sdk.Context,
 	portID,
 	channelID string,
 ) error {
-	// Set variable to blank to remove lint error so we can keep variable name for future use
-	_ = ctx
-	_ = portID
-	_ = channelID
-
-	return nil
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
 }
 
 // OnChanCloseConfirm implements the IBCModule interface
-// TODO: Implement the function as middleware
-// https://github.com/zeta-chain/node/issues/2163
 func (im IBCModule) OnChanCloseConfirm(
 	ctx sdk.Context,
 	portID,
 	channelID string,
 ) error {
-	// Set variable to blank to remove lint error so we can keep variable name for future use
-	_ = ctx
-	_ = portID
-	_ = channelID
-
-	return nil
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
 }
 
-// OnRecvPacket implements the IBCModule interface. A successful acknowledgement
-// is returned if the packet data is successfully decoded and the receive application
-// logic returns without error
-// TODO: Implement the function as middleware
-// https://github.com/zeta-chain/node/issues/2163
+// OnRecvPacket implements the IBCModule interface
+// the transfers with a deposit memo are received by the module account and the tokens are deposited into zEVM
+// an error acknowledgement is returned if the deposit fails, the tokens are then refunded on the sender chain
 func (im IBCModule) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	// Set variable to blank to remove lint error so we can keep variable name for future use
-	_ = ctx
-	_ = packet
-	_ = relayer
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	memo, isDeposit, err := types.ParseDepositMemo(data.Memo)
+	if !isDeposit {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	chainID, found := im.keeper.GetChainIDFromChannel(ctx, packet.GetDestChannel())
+	if !found {
+		return channeltypes.NewErrorAcknowledgement(
+			cosmoserrors.Wrapf(types.ErrChainChannelNotFound, "channel %s", packet.GetDestChannel()),
+		)
+	}
 
-	return nil
+	// the tokens are received by the module account, they are sent back with the outbounds to the chain
+	data.Receiver = types.ModuleAddress.String()
+	packet.Data = data.GetBytes()
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if !ack.Success() {
+		return ack
+	}
+
+	if err := im.keeper.ProcessDeposit(ctx, packet, data, memo, chainID); err != nil {
+		im.keeper.Logger(ctx).Error(fmt.Sprintf("deposit of packet %d failed: %s", packet.Sequence, err))
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return ack
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
-// TODO: Implement the function as middleware
-// https://github.com/zeta-chain/node/issues/2163
+// the outbound of the cctx is finalized once the transfer module processed the acknowledgement
 func (im IBCModule) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	// Set variable to blank to remove lint error so we can keep variable name for future use
-	_ = ctx
-	_ = packet
-	_ = acknowledgement
-	_ = relayer
-
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+	im.keeper.OnAcknowledgementPacket(ctx, packet, acknowledgement)
 	return nil
 }
 
 // OnTimeoutPacket implements the IBCModule interface
-// TODO: Implement the function as middleware
-// https://github.com/zeta-chain/node/issues/2163
+// the outbound of the cctx is finalized once the transfer module refunded the tokens
 func (im IBCModule) OnTimeoutPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	// Set variable to blank to remove lint error so we can keep variable name for future use
-	_ = ctx
-	_ = packet
-	_ = relayer
-
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+	im.keeper.OnTimeoutPacket(ctx, packet)
 	return nil
 }
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+// SetChainChannel set the channel linked to a chain in the store
+func (k Keeper) SetChainChannel(ctx sdk.Context, chainChannel types.ChainChannel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainChannelKey))
+	b := k.cdc.MustMarshal(&chainChannel)
+	store.Set(types.KeyPrefix(strconv.FormatInt(chainChannel.ChainId, 10)), b)
+}
+
+// GetChainChannel returns the channel linked to a chain from its chainID
+func (k Keeper) GetChainChannel(ctx sdk.Context, chainID int64) (val types.ChainChannel, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainChannelKey))
+
+	b := store.Get(types.KeyPrefix(strconv.FormatInt(chainID, 10)))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllChainChannels returns the channels of all the chains
+func (k Keeper) GetAllChainChannels(ctx sdk.Context) (list []types.ChainChannel) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChainChannelKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ChainChannel
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// GetChainIDFromChannel returns the chain linked to a channel
+func (k Keeper) GetChainIDFromChannel(ctx sdk.Context, channelID string) (int64, bool) {
+	for _, chainChannel := range k.GetAllChainChannels(ctx) {
+		if chainChannel.ChannelId == channelID {
+			return chainChannel.ChainId, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+)
+
+func TestKeeper_GetChainChannel(t *testing.T) {
+	k, ctx, _, _ := keepertest.IBCCrosschainKeeper(t)
+	_, found := k.GetChainChannel(ctx, 4200)
+	require.False(t, found)
+
+	chainChannel := sample.ChainChannel(4200)
+	k.SetChainChannel(ctx, chainChannel)
+	got, found := k.GetChainChannel(ctx, 4200)
+	require.True(t, found)
+	require.Equal(t, chainChannel, got)
+}
+
+func TestKeeper_GetAllChainChannels(t *testing.T) {
+	k, ctx, _, _ := keepertest.IBCCrosschainKeeper(t)
+	c1 := sample.ChainChannel(4200)
+	c2 := sample.ChainChannel(4201)
+
+	k.SetChainChannel(ctx, c1)
+	k.SetChainChannel(ctx, c2)
+
+	list := k.GetAllChainChannels(ctx)
+	require.Len(t, list, 2)
+	require.Contains(t, list, c1)
+	require.Contains(t, list, c2)
+}
+
+func TestKeeper_GetChainIDFromChannel(t *testing.T) {
+	k, ctx, _, _ := keepertest.IBCCrosschainKeeper(t)
+	k.SetChainChannel(ctx, sample.ChainChannel(4200))
+	k.SetChainChannel(ctx, sample.ChainChannel(4201))
+
+	chainID, found := k.GetChainIDFromChannel(ctx, "channel-4201")
+	require.True(t, found)
+	require.EqualValues(t, 4201, chainID)
+
+	_, found = k.GetChainIDFromChannel(ctx, "channel-0")
+	require.False(t, found)
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/pkg/coin"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+// ProcessDeposit deposits into zEVM the tokens of an ICS-20 transfer received from an IBC chain
+// the tokens must have been received by the module account, the ZRC20 of the tokens are deposited to the receiver of the memo
+// an error is returned if the deposit cctx is not mined, the packet is then acknowledged with an error and the tokens refunded
+func (k Keeper) ProcessDeposit(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	memo types.DepositMemo,
+	chainID int64,
+) error {
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok || !amount.IsPositive() {
+		return cosmoserrors.Wrapf(types.ErrDepositFailed, "invalid amount %s", data.Amount)
+	}
+	zetaChain, err := chains.ZetaChainFromChainID(ctx.ChainID())
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrDepositFailed, err.Error())
+	}
+
+	msg := crosschaintypes.NewMsgVoteInbound(
+		"",
+		data.Sender,
+		chainID,
+		data.Sender,
+		memo.Receiver,
+		zetaChain.ChainId,
+		sdkmath.NewUintFromBigInt(amount.BigInt()),
+		memo.RelayedMessage(),
+		types.PacketHash(packet.DestinationPort, packet.DestinationChannel, packet.Sequence),
+		// #nosec G701 always positive
+		uint64(ctx.BlockHeight()),
+		0,
+		coin.CoinType_ERC20,
+		ReceivedDenom(packet, data.Denom),
+		0,
+	)
+	cctx, err := k.crosschainKeeper.ProcessIBCInbound(ctx, *msg)
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrDepositFailed, err.Error())
+	}
+	if cctx.CctxStatus.Status != crosschaintypes.CctxStatus_OutboundMined {
+		return cosmoserrors.Wrapf(
+			types.ErrDepositFailed,
+			"cctx %s is %s: %s",
+			cctx.Index,
+			cctx.CctxStatus.Status,
+			cctx.CctxStatus.StatusMessage,
+		)
+	}
+	return nil
+}
+
+// ReceivedDenom returns the denom of the tokens received on ZetaChain for the denom of the packet
+// it follows the ICS-20 specification: the tokens coming back to ZetaChain are unprefixed, the other tokens are vouchers
+func ReceivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		unprefixedDenom := denom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+		denomTrace := transfertypes.ParseDenomTrace(unprefixedDenom)
+		if denomTrace.Path != "" {
+			return denomTrace.IBCDenom()
+		}
+		return unprefixedDenom
+	}
+
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/coin"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+func TestReceivedDenom(t *testing.T) {
+	packet := channeltypes.Packet{
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      "channel-1",
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: "channel-0",
+	}
+
+	t.Run("should return the voucher of a token from the sender chain", func(t *testing.T) {
+		require.Equal(t, ibcDenom, keeper.ReceivedDenom(packet, "uatom"))
+	})
+
+	t.Run("should return the native denom of a token coming back", func(t *testing.T) {
+		require.Equal(t, "azeta", keeper.ReceivedDenom(packet, "transfer/channel-1/azeta"))
+	})
+
+	t.Run("should return the voucher of a token coming back through several chains", func(t *testing.T) {
+		require.Equal(
+			t,
+			transfertypes.ParseDenomTrace("transfer/channel-2/uosmo").IBCDenom(),
+			keeper.ReceivedDenom(packet, "transfer/channel-1/transfer/channel-2/uosmo"),
+		)
+	})
+}
+
+func TestKeeper_ProcessDeposit(t *testing.T) {
+	packet := channeltypes.Packet{
+		Sequence:           3,
+		SourcePort:         transfertypes.PortID,
+		SourceChannel:      "channel-1",
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: "channel-0",
+	}
+	data := transfertypes.NewFungibleTokenPacketData(
+		"uatom",
+		"1000",
+		"cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+		types.ModuleAddress.String(),
+		"",
+	)
+	receiver := sample.EthAddress()
+	memo := types.DepositMemo{Receiver: receiver.Hex(), Message: "deadbeef"}
+
+	t.Run("should deposit the tokens into zEVM", func(t *testing.T) {
+		k, ctx := keepertest.IBCCrosschainKeeperAllMocks(t)
+
+		crosschainMock := keepertest.GetIBCCrosschainCrosschainMock(t, k)
+		crosschainMock.On("ProcessIBCInbound", mock.Anything, mock.MatchedBy(func(msg crosschaintypes.MsgVoteInbound) bool {
+			return msg.Sender == data.Sender &&
+				msg.SenderChainId == ibcChainID &&
+				msg.Receiver == receiver.Hex() &&
+				msg.Amount.Uint64() == 1000 &&
+				msg.Message == hex.EncodeToString(receiver.Bytes())+"deadbeef" &&
+				msg.InboundHash == "transfer/channel-0/3" &&
+				msg.CoinType == coin.CoinType_ERC20 &&
+				msg.Asset == ibcDenom
+		})).Return(crosschaintypes.CrossChainTx{
+			CctxStatus: &crosschaintypes.Status{Status: crosschaintypes.CctxStatus_OutboundMined},
+		}, nil)
+
+		err := k.ProcessDeposit(ctx, packet, data, memo, ibcChainID)
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail if the inbound can't be processed", func(t *testing.T) {
+		k, ctx := keepertest.IBCCrosschainKeeperAllMocks(t)
+
+		crosschainMock := keepertest.GetIBCCrosschainCrosschainMock(t, k)
+		crosschainMock.On("ProcessIBCInbound", mock.Anything, mock.Anything).
+			Return(crosschaintypes.CrossChainTx{}, errors.New("foreign coin not found"))
+
+		err := k.ProcessDeposit(ctx, packet, data, memo, ibcChainID)
+		require.ErrorIs(t, err, types.ErrDepositFailed)
+	})
+
+	t.Run("should fail if the deposit is not mined", func(t *testing.T) {
+		k, ctx := keepertest.IBCCrosschainKeeperAllMocks(t)
+
+		crosschainMock := keepertest.GetIBCCrosschainCrosschainMock(t, k)
+		crosschainMock.On("ProcessIBCInbound", mock.Anything, mock.Anything).Return(crosschaintypes.CrossChainTx{
+			CctxStatus: &crosschaintypes.Status{Status: crosschaintypes.CctxStatus_Aborted},
+		}, nil)
+
+		err := k.ProcessDeposit(ctx, packet, data, memo, ibcChainID)
+		require.ErrorIs(t, err, types.ErrDepositFailed)
+	})
+
+	t.Run("should fail on invalid amount", func(t *testing.T) {
+		k, ctx := keepertest.IBCCrosschainKeeperAllMocks(t)
+		invalidData := data
+		invalidData.Amount = "invalid"
+
+		err := k.ProcessDeposit(ctx, packet, invalidData, memo, ibcChainID)
+		require.ErrorIs(t, err, types.ErrDepositFailed)
+	})
+}
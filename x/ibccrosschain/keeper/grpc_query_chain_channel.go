@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+// ChainChannelAll queries the channels of all the chains
+func (k Keeper) ChainChannelAll(
+	c context.Context,
+	req *types.QueryAllChainChannelRequest,
+) (*types.QueryAllChainChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	chainChannelStore := prefix.NewStore(store, types.KeyPrefix(types.ChainChannelKey))
+
+	var chainChannels []types.ChainChannel
+	pageRes, err := query.Paginate(chainChannelStore, req.Pagination, func(_ []byte, value []byte) error {
+		var chainChannel types.ChainChannel
+		if err := k.cdc.Unmarshal(value, &chainChannel); err != nil {
+			return err
+		}
+
+		chainChannels = append(chainChannels, chainChannel)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAllChainChannelResponse{ChainChannels: chainChannels, Pagination: pageRes}, nil
+}
+
+// ChainChannel queries the channel of a chain
+func (k Keeper) ChainChannel(
+	c context.Context,
+	req *types.QueryGetChainChannelRequest,
+) (*types.QueryGetChainChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	chainChannel, found := k.GetChainChannel(sdk.UnwrapSDKContext(c), req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("not found: chain id %d", req.ChainId))
+	}
+
+	return &types.QueryGetChainChannelResponse{ChainChannel: chainChannel}, nil
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+func TestKeeper_ChainChannelAll(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.IBCCrosschainKeeper(t)
+
+		res, err := k.ChainChannelAll(sdk.WrapSDKContext(ctx), nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the chain channels", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.IBCCrosschainKeeper(t)
+		k.SetChainChannel(ctx, sample.ChainChannel(4200))
+		k.SetChainChannel(ctx, sample.ChainChannel(4201))
+		k.SetChainChannel(ctx, sample.ChainChannel(4202))
+
+		res, err := k.ChainChannelAll(sdk.WrapSDKContext(ctx), &types.QueryAllChainChannelRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.ChainChannels, 3)
+
+		res, err = k.ChainChannelAll(sdk.WrapSDKContext(ctx), &types.QueryAllChainChannelRequest{
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.ChainChannels, 2)
+		require.EqualValues(t, 3, res.Pagination.Total)
+	})
+}
+
+func TestKeeper_ChainChannel(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.IBCCrosschainKeeper(t)
+
+		res, err := k.ChainChannel(sdk.WrapSDKContext(ctx), nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.IBCCrosschainKeeper(t)
+
+		res, err := k.ChainChannel(sdk.WrapSDKContext(ctx), &types.QueryGetChainChannelRequest{ChainId: 4200})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the chain channel", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.IBCCrosschainKeeper(t)
+		chainChannel := sample.ChainChannel(4200)
+		k.SetChainChannel(ctx, chainChannel)
+
+		res, err := k.ChainChannel(sdk.WrapSDKContext(ctx), &types.QueryGetChainChannelRequest{ChainId: 4200})
+		require.NoError(t, err)
+		require.Equal(t, chainChannel, res.ChainChannel)
+	})
+}
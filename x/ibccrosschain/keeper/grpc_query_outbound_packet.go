@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+// OutboundPacketAll queries the packets sent for cctx outbounds and not yet acknowledged
+func (k Keeper) OutboundPacketAll(
+	c context.Context,
+	req *types.QueryAllOutboundPacketRequest,
+) (*types.QueryAllOutboundPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	outboundPacketStore := prefix.NewStore(store, types.KeyPrefix(types.OutboundPacketKey))
+
+	var outboundPackets []types.OutboundPacket
+	pageRes, err := query.Paginate(outboundPacketStore, req.Pagination, func(_ []byte, value []byte) error {
+		var outboundPacket types.OutboundPacket
+		if err := k.cdc.Unmarshal(value, &outboundPacket); err != nil {
+			return err
+		}
+
+		outboundPackets = append(outboundPackets, outboundPacket)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAllOutboundPacketResponse{OutboundPackets: outboundPackets, Pagination: pageRes}, nil
+}
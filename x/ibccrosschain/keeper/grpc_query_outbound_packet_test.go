@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+func TestKeeper_OutboundPacketAll(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.IBCCrosschainKeeper(t)
+
+		res, err := k.OutboundPacketAll(sdk.WrapSDKContext(ctx), nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the outbound packets", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.IBCCrosschainKeeper(t)
+		packet := sample.OutboundPacket(t, "channel-0", 1)
+		k.SetOutboundPacket(ctx, packet)
+
+		res, err := k.OutboundPacketAll(sdk.WrapSDKContext(ctx), &types.QueryAllOutboundPacketRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []types.OutboundPacket{packet}, res.OutboundPackets)
+	})
+}
@@ -18,6 +18,7 @@ type Keeper struct {
 	memKey            storetypes.StoreKey
 	crosschainKeeper  types.CrosschainKeeper
 	ibcTransferKeeper types.IBCTransferKeeper
+	authorityKeeper   types.AuthorityKeeper
 }
 
 // NewKeeper creates new instances of the ibccrosschain Keeper
@@ -27,6 +28,7 @@ func NewKeeper(
 	memKey storetypes.StoreKey,
 	crosschainKeeper types.CrosschainKeeper,
 	ibcTransferKeeper types.IBCTransferKeeper,
+	authorityKeeper types.AuthorityKeeper,
 ) *Keeper {
 	return &Keeper{
 		cdc:               cdc,
@@ -34,6 +36,7 @@ func NewKeeper(
 		memKey:            memKey,
 		crosschainKeeper:  crosschainKeeper,
 		ibcTransferKeeper: ibcTransferKeeper,
+		authorityKeeper:   authorityKeeper,
 	}
 }
 
@@ -66,3 +69,8 @@ func (k Keeper) GetCrosschainKeeper() types.CrosschainKeeper {
 func (k Keeper) GetIBCTransferKeeper() types.IBCTransferKeeper {
 	return k.ibcTransferKeeper
 }
+
+// GetAuthorityKeeper returns the authority keeper
+func (k Keeper) GetAuthorityKeeper() types.AuthorityKeeper {
+	return k.authorityKeeper
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+// UpdateChainChannel links an IBC chain to the ICS-20 channel used for its deposits and outbounds
+// Authorized: admin policy group 2
+func (k msgServer) UpdateChainChannel(goCtx context.Context, msg *types.MsgUpdateChainChannel) (
+	*types.MsgUpdateChainChannelResponse,
+	error,
+) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Creator, authoritytypes.PolicyType_groupAdmin) {
+		return nil, authoritytypes.ErrUnauthorized
+	}
+
+	if !chains.IsIBCChain(msg.ChainId, k.GetAuthorityKeeper().GetAdditionalChainList(ctx)) {
+		return nil, cosmoserrors.Wrapf(types.ErrNotIBCChain, "chain %d", msg.ChainId)
+	}
+
+	k.SetChainChannel(ctx, types.ChainChannel{
+		ChainId:   msg.ChainId,
+		ChannelId: msg.ChannelId,
+	})
+	return &types.MsgUpdateChainChannelResponse{}, nil
+}
@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+func TestMsgServer_UpdateChainChannel(t *testing.T) {
+	t.Run("admin can update the channel of an IBC chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.IBCCrosschainKeeperWithMocks(t, keepertest.IBCCroscchainMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+
+		authorityMock := keepertest.GetIBCCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockGetChainList(&authorityMock.Mock, []chains.Chain{sample.IBCChain(4200)})
+
+		_, err := srv.UpdateChainChannel(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateChainChannel(admin, 4200, "channel-0"),
+		)
+		require.NoError(t, err)
+		chainChannel, found := k.GetChainChannel(ctx, 4200)
+		require.True(t, found)
+		require.Equal(t, "channel-0", chainChannel.ChannelId)
+	})
+
+	t.Run("cannot update if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.IBCCrosschainKeeperWithMocks(t, keepertest.IBCCroscchainMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+
+		authorityMock := keepertest.GetIBCCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, false)
+
+		_, err := srv.UpdateChainChannel(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateChainChannel(admin, 4200, "channel-0"),
+		)
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+
+	t.Run("cannot update if the chain is not an IBC chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.IBCCrosschainKeeperWithMocks(t, keepertest.IBCCroscchainMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+
+		authorityMock := keepertest.GetIBCCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
+
+		_, err := srv.UpdateChainChannel(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateChainChannel(admin, chains.Ethereum.ChainId, "channel-0"),
+		)
+		require.ErrorIs(t, err, types.ErrNotIBCChain)
+		_, found := k.GetChainChannel(ctx, chains.Ethereum.ChainId)
+		require.False(t, found)
+	})
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+// InitiateOutbound sends the outbound of the cctx to an IBC chain as an ICS-20 transfer from the module account
+// the tokens transferred are the tokens received from the chain when the ZRC20 were deposited
+// the outbound is finalized when the packet is acknowledged or timed out
+func (k Keeper) InitiateOutbound(ctx sdk.Context, cctx *crosschaintypes.CrossChainTx) error {
+	outbound := cctx.GetCurrentOutboundParam()
+	chainChannel, found := k.GetChainChannel(ctx, outbound.ReceiverChainId)
+	if !found {
+		return cosmoserrors.Wrapf(types.ErrChainChannelNotFound, "chain %d", outbound.ReceiverChainId)
+	}
+
+	denom := cctx.InboundParams.Asset
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return cosmoserrors.Wrapf(types.ErrInvalidOutbound, "invalid denom %s: %s", denom, err)
+	}
+	if outbound.Amount.IsZero() {
+		return cosmoserrors.Wrap(types.ErrInvalidOutbound, "amount must be positive")
+	}
+
+	msg := transfertypes.NewMsgTransfer(
+		transfertypes.PortID,
+		chainChannel.ChannelId,
+		sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(outbound.Amount.BigInt())),
+		types.ModuleAddress.String(),
+		outbound.Receiver,
+		clienttypes.ZeroHeight(),
+		// #nosec G701 always positive
+		uint64(ctx.BlockTime().Add(types.OutboundTimeout).UnixNano()),
+		cctx.Index,
+	)
+	res, err := k.ibcTransferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrTransferFailed, err.Error())
+	}
+
+	k.SetOutboundPacket(ctx, types.OutboundPacket{
+		ChannelId: chainChannel.ChannelId,
+		Sequence:  res.Sequence,
+		CctxIndex: cctx.Index,
+	})
+	outbound.Hash = types.PacketHash(transfertypes.PortID, chainChannel.ChannelId, res.Sequence)
+	return nil
+}
+
+// OnAcknowledgementPacket finalizes the outbound of the cctx from the acknowledgement of its packet
+// the packets not sent for an outbound are ignored
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("cannot unmarshal acknowledgement of packet %d: %s", packet.Sequence, err))
+		return
+	}
+	k.finalizeOutbound(ctx, packet, ack.Success(), ack.GetError())
+}
+
+// OnTimeoutPacket finalizes the outbound of the cctx as failed when its packet timed out
+// the packets not sent for an outbound are ignored
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	k.finalizeOutbound(ctx, packet, false, "packet timed out")
+}
+
+// finalizeOutbound finalizes the outbound of the cctx sent with the packet
+// errors are logged and not returned, the result of the transfer must be processed by the transfer module regardless
+func (k Keeper) finalizeOutbound(ctx sdk.Context, packet channeltypes.Packet, success bool, message string) {
+	outboundPacket, found := k.GetOutboundPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+	k.RemoveOutboundPacket(ctx, packet.SourceChannel, packet.Sequence)
+
+	cctx, found := k.crosschainKeeper.GetCrossChainTx(ctx, outboundPacket.CctxIndex)
+	if !found {
+		k.Logger(ctx).Error(fmt.Sprintf("cctx %s of packet %d not found", outboundPacket.CctxIndex, packet.Sequence))
+		return
+	}
+	if err := k.crosschainKeeper.ProcessIBCOutbound(ctx, &cctx, success, message); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("cannot process outbound of cctx %s: %s", cctx.Index, err))
+	}
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+// SetOutboundPacket set the packet sent for a cctx outbound in the store
+func (k Keeper) SetOutboundPacket(ctx sdk.Context, packet types.OutboundPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OutboundPacketKey))
+	b := k.cdc.MustMarshal(&packet)
+	store.Set(types.KeyPrefix(types.OutboundPacketIndex(packet.ChannelId, packet.Sequence)), b)
+}
+
+// GetOutboundPacket returns the packet sent for a cctx outbound from its channel and sequence
+func (k Keeper) GetOutboundPacket(
+	ctx sdk.Context,
+	channelID string,
+	sequence uint64,
+) (val types.OutboundPacket, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OutboundPacketKey))
+
+	b := store.Get(types.KeyPrefix(types.OutboundPacketIndex(channelID, sequence)))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveOutboundPacket removes the packet sent for a cctx outbound from the store
+func (k Keeper) RemoveOutboundPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OutboundPacketKey))
+	store.Delete(types.KeyPrefix(types.OutboundPacketIndex(channelID, sequence)))
+}
+
+// GetAllOutboundPackets returns all the packets sent for cctx outbounds and not yet acknowledged
+func (k Keeper) GetAllOutboundPackets(ctx sdk.Context) (list []types.OutboundPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OutboundPacketKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.OutboundPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+)
+
+func TestKeeper_GetOutboundPacket(t *testing.T) {
+	k, ctx, _, _ := keepertest.IBCCrosschainKeeper(t)
+	_, found := k.GetOutboundPacket(ctx, "channel-0", 1)
+	require.False(t, found)
+
+	packet := sample.OutboundPacket(t, "channel-0", 1)
+	k.SetOutboundPacket(ctx, packet)
+	got, found := k.GetOutboundPacket(ctx, "channel-0", 1)
+	require.True(t, found)
+	require.Equal(t, packet, got)
+
+	// the packets are indexed by channel and sequence
+	_, found = k.GetOutboundPacket(ctx, "channel-1", 1)
+	require.False(t, found)
+	_, found = k.GetOutboundPacket(ctx, "channel-0", 2)
+	require.False(t, found)
+
+	k.RemoveOutboundPacket(ctx, "channel-0", 1)
+	_, found = k.GetOutboundPacket(ctx, "channel-0", 1)
+	require.False(t, found)
+}
+
+func TestKeeper_GetAllOutboundPackets(t *testing.T) {
+	k, ctx, _, _ := keepertest.IBCCrosschainKeeper(t)
+	p1 := sample.OutboundPacket(t, "channel-0", 1)
+	p2 := sample.OutboundPacket(t, "channel-0", 2)
+	p3 := sample.OutboundPacket(t, "channel-1", 1)
+
+	k.SetOutboundPacket(ctx, p1)
+	k.SetOutboundPacket(ctx, p2)
+	k.SetOutboundPacket(ctx, p3)
+
+	list := k.GetAllOutboundPackets(ctx)
+	require.Len(t, list, 3)
+	require.Contains(t, list, p1)
+	require.Contains(t, list, p2)
+	require.Contains(t, list, p3)
+}
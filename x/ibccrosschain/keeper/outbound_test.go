@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+const (
+	ibcChainID = 4200
+	ibcDenom   = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+)
+
+// ibcOutboundCctx returns a cctx with an outbound of 'amount' to the IBC chain
+func ibcOutboundCctx(t *testing.T, amount uint64) *crosschaintypes.CrossChainTx {
+	cctx := sample.CrossChainTx(t, "ibc")
+	cctx.CctxStatus.Status = crosschaintypes.CctxStatus_PendingOutbound
+	cctx.InboundParams.Asset = ibcDenom
+	cctx.OutboundParams = []*crosschaintypes.OutboundParams{
+		{
+			Receiver:        "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+			ReceiverChainId: ibcChainID,
+			Amount:          math.NewUint(amount),
+		},
+	}
+	return cctx
+}
+
+func TestKeeper_InitiateOutbound(t *testing.T) {
+	t.Run("should send the outbound as an ICS-20 transfer", func(t *testing.T) {
+		k, ctx := keepertest.IBCCrosschainKeeperAllMocks(t)
+		k.SetChainChannel(ctx, types.ChainChannel{ChainId: ibcChainID, ChannelId: "channel-0"})
+		cctx := ibcOutboundCctx(t, 1000)
+
+		transferMock := keepertest.GetIBCCrosschainTransferMock(t, k)
+		transferMock.On("Transfer", mock.Anything, mock.MatchedBy(func(msg *transfertypes.MsgTransfer) bool {
+			return msg.SourcePort == transfertypes.PortID &&
+				msg.SourceChannel == "channel-0" &&
+				msg.Token.Equal(sdk.NewInt64Coin(ibcDenom, 1000)) &&
+				msg.Sender == types.ModuleAddress.String() &&
+				msg.Receiver == cctx.GetCurrentOutboundParam().Receiver &&
+				msg.TimeoutTimestamp > 0 &&
+				msg.Memo == cctx.Index
+		})).Return(&transfertypes.MsgTransferResponse{Sequence: 5}, nil)
+
+		err := k.InitiateOutbound(ctx, cctx)
+		require.NoError(t, err)
+		require.Equal(t, "transfer/channel-0/5", cctx.GetCurrentOutboundParam().Hash)
+		packet, found := k.GetOutboundPacket(ctx, "channel-0", 5)
+		require.True(t, found)
+		require.Equal(t, cctx.Index, packet.CctxIndex)
+	})
+
+	t.Run("should fail if the chain has no channel", func(t *testing.T) {
+		k, ctx := keepertest.IBCCrosschainKeeperAllMocks(t)
+
+		err := k.InitiateOutbound(ctx, ibcOutboundCctx(t, 1000))
+		require.ErrorIs(t, err, types.ErrChainChannelNotFound)
+	})
+
+	t.Run("should fail if the asset is not a denom", func(t *testing.T) {
+		k, ctx := keepertest.IBCCrosschainKeeperAllMocks(t)
+		k.SetChainChannel(ctx, types.ChainChannel{ChainId: ibcChainID, ChannelId: "channel-0"})
+		cctx := ibcOutboundCctx(t, 1000)
+		cctx.InboundParams.Asset = ""
+
+		err := k.InitiateOutbound(ctx, cctx)
+		require.ErrorIs(t, err, types.ErrInvalidOutbound)
+	})
+
+	t.Run("should fail if the amount is zero", func(t *testing.T) {
+		k, ctx := keepertest.IBCCrosschainKeeperAllMocks(t)
+		k.SetChainChannel(ctx, types.ChainChannel{ChainId: ibcChainID, ChannelId: "channel-0"})
+
+		err := k.InitiateOutbound(ctx, ibcOutboundCctx(t, 0))
+		require.ErrorIs(t, err, types.ErrInvalidOutbound)
+	})
+
+	t.Run("should fail if the transfer fails", func(t *testing.T) {
+		k, ctx := keepertest.IBCCrosschainKeeperAllMocks(t)
+		k.SetChainChannel(ctx, types.ChainChannel{ChainId: ibcChainID, ChannelId: "channel-0"})
+
+		transferMock := keepertest.GetIBCCrosschainTransferMock(t, k)
+		transferMock.On("Transfer", mock.Anything, mock.Anything).Return(nil, errors.New("insufficient funds"))
+
+		err := k.InitiateOutbound(ctx, ibcOutboundCctx(t, 1000))
+		require.ErrorIs(t, err, types.ErrTransferFailed)
+		require.Empty(t, k.GetAllOutboundPackets(ctx))
+	})
+}
+
+func TestKeeper_OnAcknowledgementPacket(t *testing.T) {
+	packet := channeltypes.Packet{SourcePort: transfertypes.PortID, SourceChannel: "channel-0", Sequence: 5}
+
+	t.Run("should finalize the outbound of a successful transfer", func(t *testing.T) {
+		k, ctx := keepertest.IBCCrosschainKeeperAllMocks(t)
+		cctx := ibcOutboundCctx(t, 1000)
+		k.SetOutboundPacket(ctx, types.OutboundPacket{ChannelId: "channel-0", Sequence: 5, CctxIndex: cctx.Index})
+
+		crosschainMock := keepertest.GetIBCCrosschainCrosschainMock(t, k)
+		crosschainMock.On("GetCrossChainTx", mock.Anything, cctx.Index).Return(*cctx, true)
+		crosschainMock.On("ProcessIBCOutbound", mock.Anything, mock.Anything, true, "").Return(nil)
+
+		ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+		k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement())
+		_, found := k.GetOutboundPacket(ctx, "channel-0", 5)
+		require.False(t, found)
+	})
+
+	t.Run("should finalize the outbound of a failed transfer", func(t *testing.T) {
+		k, ctx := keepertest.IBCCrosschainKeeperAllMocks(t)
+		cctx := ibcOutboundCctx(t, 1000)
+		k.SetOutboundPacket(ctx, types.OutboundPacket{ChannelId: "channel-0", Sequence: 5, CctxIndex: cctx.Index})
+
+		crosschainMock := keepertest.GetIBCCrosschainCrosschainMock(t, k)
+		crosschainMock.On("GetCrossChainTx", mock.Anything, cctx.Index).Return(*cctx, true)
+		crosschainMock.On("ProcessIBCOutbound", mock.Anything, mock.Anything, false, mock.Anything).Return(nil)
+
+		ack := channeltypes.NewErrorAcknowledgement(errors.New("invalid receiver"))
+		k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement())
+		_, found := k.GetOutboundPacket(ctx, "channel-0", 5)
+		require.False(t, found)
+	})
+
+	t.Run("should ignore the packets not sent for an outbound", func(t *testing.T) {
+		k, ctx := keepertest.IBCCrosschainKeeperAllMocks(t)
+
+		// the crosschain keeper mock fails the test if called
+		ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+		k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement())
+	})
+}
+
+func TestKeeper_OnTimeoutPacket(t *testing.T) {
+	t.Run("should finalize the outbound as failed", func(t *testing.T) {
+		k, ctx := keepertest.IBCCrosschainKeeperAllMocks(t)
+		cctx := ibcOutboundCctx(t, 1000)
+		k.SetOutboundPacket(ctx, types.OutboundPacket{ChannelId: "channel-0", Sequence: 5, CctxIndex: cctx.Index})
+
+		crosschainMock := keepertest.GetIBCCrosschainCrosschainMock(t, k)
+		crosschainMock.On("GetCrossChainTx", mock.Anything, cctx.Index).Return(*cctx, true)
+		crosschainMock.On("ProcessIBCOutbound", mock.Anything, mock.Anything, false, "packet timed out").Return(nil)
+
+		k.OnTimeoutPacket(ctx, channeltypes.Packet{SourceChannel: "channel-0", Sequence: 5})
+		_, found := k.GetOutboundPacket(ctx, "channel-0", 5)
+		require.False(t, found)
+	})
+}
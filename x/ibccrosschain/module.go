@@ -1,6 +1,7 @@
 package ibccrosschain
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -74,17 +75,15 @@ func (AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, _ *runtime.ServeMux) {
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
 	err := types.RegisterClientCtx(clientCtx)
 	if err != nil {
 		fmt.Println("RegisterQueryHandlerClient err: %w", err)
 	}
-	// TODO: Uncomment once query created
-	// https://github.com/zeta-chain/node/issues/2166
-	//err = types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx))
-	//if err != nil {
-	//	fmt.Println("RegisterQueryHandlerClient err: %w", err)
-	//}
+	err = types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx))
+	if err != nil {
+		fmt.Println("RegisterQueryHandlerClient err: %w", err)
+	}
 }
 
 // GetTxCmd returns the ibccrosschain module's root tx command.
@@ -0,0 +1,18 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// Validate checks the chain ID is positive and the channel ID is a valid IBC channel identifier
+func (c ChainChannel) Validate() error {
+	if c.ChainId <= 0 {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidChainID, "chain ID must be positive (%d)", c.ChainId)
+	}
+	if err := host.ChannelIdentifierValidator(c.ChannelId); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid channel ID (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// DepositMemoKey is the key in the memo of an ICS-20 transfer depositing the tokens into zEVM
+// the memo is a JSON object so the deposit can be combined with the memos of other IBC middlewares
+const DepositMemoKey = "zeta_deposit"
+
+// DepositMemo describes the deposit into zEVM of the tokens of an ICS-20 transfer
+// Receiver is the zEVM address receiving the ZRC20, Message is the hex-encoded data of an optional contract call
+type DepositMemo struct {
+	Receiver string `json:"receiver"`
+	Message  string `json:"message,omitempty"`
+}
+
+// ParseDepositMemo parses the memo of an ICS-20 transfer
+// it returns false if the memo doesn't describe a deposit, the transfer is then a regular ICS-20 transfer
+func ParseDepositMemo(memo string) (DepositMemo, bool, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return DepositMemo{}, false, nil
+	}
+	raw, ok := fields[DepositMemoKey]
+	if !ok {
+		return DepositMemo{}, false, nil
+	}
+
+	var depositMemo DepositMemo
+	if err := json.Unmarshal(raw, &depositMemo); err != nil {
+		return DepositMemo{}, true, errorsmod.Wrap(ErrInvalidDepositMemo, err.Error())
+	}
+	if !ethcommon.IsHexAddress(depositMemo.Receiver) {
+		return DepositMemo{}, true, errorsmod.Wrapf(ErrInvalidDepositMemo, "invalid receiver %s", depositMemo.Receiver)
+	}
+	if _, err := hex.DecodeString(depositMemo.Message); err != nil {
+		return DepositMemo{}, true, errorsmod.Wrapf(ErrInvalidDepositMemo, "message must be hex-encoded: %s", err)
+	}
+	return depositMemo, true, nil
+}
+
+// RelayedMessage returns the relayed message of the deposit cctx
+// as for the other deposits, it is the receiver address followed by the contract call data
+func (m DepositMemo) RelayedMessage() string {
+	// the message is validated when parsing the memo
+	data, _ := hex.DecodeString(m.Message)
+	return hex.EncodeToString(append(ethcommon.HexToAddress(m.Receiver).Bytes(), data...))
+}
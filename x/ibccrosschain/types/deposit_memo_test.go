@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+func TestParseDepositMemo(t *testing.T) {
+	receiver := sample.EthAddress()
+
+	t.Run("should parse deposit memo", func(t *testing.T) {
+		memo, isDeposit, err := types.ParseDepositMemo(
+			`{"zeta_deposit":{"receiver":"` + receiver.Hex() + `","message":"deadbeef"},"forward":{}}`,
+		)
+		require.NoError(t, err)
+		require.True(t, isDeposit)
+		require.Equal(t, types.DepositMemo{Receiver: receiver.Hex(), Message: "deadbeef"}, memo)
+	})
+
+	t.Run("should ignore memo without deposit", func(t *testing.T) {
+		for _, memo := range []string{"", "hello", `{"forward":{}}`, `[]`} {
+			_, isDeposit, err := types.ParseDepositMemo(memo)
+			require.NoError(t, err)
+			require.False(t, isDeposit)
+		}
+	})
+
+	t.Run("should fail on invalid deposit memo", func(t *testing.T) {
+		for _, memo := range []string{
+			`{"zeta_deposit":"invalid"}`,
+			`{"zeta_deposit":{"receiver":"invalid"}}`,
+			`{"zeta_deposit":{"receiver":"` + receiver.Hex() + `","message":"invalid"}}`,
+		} {
+			_, isDeposit, err := types.ParseDepositMemo(memo)
+			require.ErrorIs(t, err, types.ErrInvalidDepositMemo)
+			require.True(t, isDeposit)
+		}
+	})
+}
+
+func TestDepositMemo_RelayedMessage(t *testing.T) {
+	receiver := sample.EthAddress()
+
+	memo := types.DepositMemo{Receiver: receiver.Hex()}
+	require.Equal(t, hex.EncodeToString(receiver.Bytes()), memo.RelayedMessage())
+
+	memo.Message = "deadbeef"
+	require.Equal(t, hex.EncodeToString(receiver.Bytes())+"deadbeef", memo.RelayedMessage())
+}
@@ -1 +1,12 @@
 package types
+
+import errorsmod "cosmossdk.io/errors"
+
+var (
+	ErrChainChannelNotFound = errorsmod.Register(ModuleName, 1101, "chain channel not found")
+	ErrNotIBCChain          = errorsmod.Register(ModuleName, 1102, "chain doesn't use the IBC CCTX gateway")
+	ErrInvalidDepositMemo   = errorsmod.Register(ModuleName, 1103, "invalid deposit memo")
+	ErrInvalidOutbound      = errorsmod.Register(ModuleName, 1104, "invalid outbound")
+	ErrTransferFailed       = errorsmod.Register(ModuleName, 1105, "ICS-20 transfer failed")
+	ErrDepositFailed        = errorsmod.Register(ModuleName, 1106, "deposit into zEVM failed")
+)
@@ -1,7 +1,29 @@
 package types
 
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
 type CrosschainKeeper interface {
+	GetCrossChainTx(ctx sdk.Context, index string) (crosschaintypes.CrossChainTx, bool)
+	ProcessIBCInbound(ctx sdk.Context, msg crosschaintypes.MsgVoteInbound) (crosschaintypes.CrossChainTx, error)
+	ProcessIBCOutbound(ctx sdk.Context, cctx *crosschaintypes.CrossChainTx, success bool, message string) error
 }
 
 type IBCTransferKeeper interface {
+	Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
+}
+
+type AuthorityKeeper interface {
+	IsAuthorized(ctx sdk.Context, address string, policyType authoritytypes.PolicyType) bool
+
+	// GetAdditionalChainList returns the chains supported in addition to the default chains
+	GetAdditionalChainList(ctx sdk.Context) []chains.Chain
 }
@@ -1,11 +1,35 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis returns the default ibccrosschain genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		ChainChannels:   []ChainChannel{},
+		OutboundPackets: []OutboundPacket{},
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any failure
 func (gs GenesisState) Validate() error {
+	chainIDs := make(map[int64]bool)
+	for _, chainChannel := range gs.ChainChannels {
+		if err := chainChannel.Validate(); err != nil {
+			return err
+		}
+		if chainIDs[chainChannel.ChainId] {
+			return fmt.Errorf("duplicated channel for chain %d", chainChannel.ChainId)
+		}
+		chainIDs[chainChannel.ChainId] = true
+	}
+
+	packets := make(map[string]bool)
+	for _, packet := range gs.OutboundPackets {
+		index := OutboundPacketIndex(packet.ChannelId, packet.Sequence)
+		if packets[index] {
+			return fmt.Errorf("duplicated outbound packet %s", index)
+		}
+		packets[index] = true
+	}
 	return nil
 }
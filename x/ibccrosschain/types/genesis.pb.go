@@ -25,6 +25,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the ibccrosschain module's genesis state.
 type GenesisState struct {
+	ChainChannels   []ChainChannel   `protobuf:"bytes,1,rep,name=chain_channels,json=chainChannels,proto3" json:"chain_channels"`
+	OutboundPackets []OutboundPacket `protobuf:"bytes,2,rep,name=outbound_packets,json=outboundPackets,proto3" json:"outbound_packets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -60,6 +62,20 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetChainChannels() []ChainChannel {
+	if m != nil {
+		return m.ChainChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOutboundPackets() []OutboundPacket {
+	if m != nil {
+		return m.OutboundPackets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.ibccrosschain.GenesisState")
 }
@@ -69,17 +85,24 @@ func init() {
 }
 
 var fileDescriptor_787966a214cc1ca3 = []byte{
-	// 158 bytes of a gzipped FileDescriptorProto
+	// 259 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xab, 0x4a, 0x2d, 0x49,
 	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb3, 0xf2, 0x8b, 0x52, 0xf5, 0x33, 0x93, 0x92, 0x93,
 	0x8b, 0xf2, 0x8b, 0x8b, 0x21, 0xc2, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45,
 	0xf9, 0x25, 0xf9, 0x42, 0x0a, 0x70, 0xf5, 0x7a, 0x30, 0xf5, 0x7a, 0x28, 0xea, 0xa5, 0x44, 0xd2,
-	0xf3, 0xd3, 0xf3, 0xc1, 0x8a, 0xf5, 0x41, 0x2c, 0x88, 0x3e, 0x25, 0x3e, 0x2e, 0x1e, 0x77, 0x88,
-	0x41, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x4e, 0x7e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
-	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c,
-	0xc7, 0x10, 0x65, 0x92, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0x0b, 0x76, 0x92,
-	0x2e, 0x9a, 0xeb, 0x2a, 0xd0, 0xdc, 0x57, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xb6, 0xc6,
-	0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x7b, 0x9e, 0xb1, 0xd0, 0x00, 0x00, 0x00,
+	0xf3, 0xd3, 0xf3, 0xc1, 0x8a, 0xf5, 0x41, 0x2c, 0x88, 0x3e, 0x29, 0x13, 0x82, 0xf6, 0x80, 0xc9,
+	0xf8, 0xe4, 0x8c, 0xc4, 0xbc, 0xbc, 0xd4, 0x1c, 0xa8, 0x2e, 0x33, 0x82, 0xba, 0xf2, 0x4b, 0x4b,
+	0x92, 0xf2, 0x4b, 0xf3, 0x52, 0xe2, 0x0b, 0x12, 0x93, 0xb3, 0x53, 0x4b, 0x20, 0xfa, 0x94, 0xce,
+	0x31, 0x72, 0xf1, 0xb8, 0x43, 0xdc, 0x1d, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x14, 0xcd, 0xc5, 0x87,
+	0x62, 0x7e, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x9e, 0x1e, 0x21, 0xff, 0xe8, 0x39,
+	0x83, 0x48, 0x67, 0x88, 0x36, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0x78, 0x93, 0x91, 0xc4,
+	0x8a, 0x85, 0x12, 0xb9, 0x04, 0xd0, 0x9c, 0x51, 0x2c, 0xc1, 0x04, 0x36, 0xde, 0x80, 0xb0, 0xf1,
+	0xfe, 0x50, 0x9d, 0x01, 0x60, 0x8d, 0x50, 0x0b, 0xf8, 0xf3, 0x51, 0x44, 0x8b, 0x9d, 0xfc, 0x4e,
+	0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18,
+	0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x24, 0x3d, 0xb3, 0x24, 0xa3, 0x34,
+	0x49, 0x2f, 0x39, 0x3f, 0x17, 0x1c, 0x46, 0xba, 0x68, 0xc1, 0x55, 0x81, 0x16, 0x60, 0x25, 0x95,
+	0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x70, 0x32, 0x06, 0x0c, 0x00, 0xe3, 0x61, 0xec, 0x00, 0xff,
+	0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -102,6 +125,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OutboundPackets) > 0 {
+		for iNdEx := len(m.OutboundPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutboundPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainChannels) > 0 {
+		for iNdEx := len(m.ChainChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -122,6 +173,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.ChainChannels) > 0 {
+		for _, e := range m.ChainChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OutboundPackets) > 0 {
+		for _, e := range m.OutboundPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -160,6 +223,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainChannels = append(m.ChainChannels, ChainChannel{})
+			if err := m.ChainChannels[len(m.ChainChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundPackets = append(m.OutboundPackets, OutboundPacket{})
+			if err := m.OutboundPackets[len(m.OutboundPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				ChainChannels: []types.ChainChannel{
+					sample.ChainChannel(4200),
+					sample.ChainChannel(4201),
+				},
+				OutboundPackets: []types.OutboundPacket{
+					sample.OutboundPacket(t, "channel-0", 1),
+					sample.OutboundPacket(t, "channel-0", 2),
+					sample.OutboundPacket(t, "channel-1", 1),
+				},
+			},
+			valid: true,
+		},
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "invalid chain channel is invalid",
+			genState: &types.GenesisState{
+				ChainChannels: []types.ChainChannel{
+					{ChainId: 4200, ChannelId: "invalid"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate chain channel is invalid",
+			genState: &types.GenesisState{
+				ChainChannels: []types.ChainChannel{
+					sample.ChainChannel(4200),
+					{ChainId: 4200, ChannelId: "channel-1"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate outbound packet is invalid",
+			genState: &types.GenesisState{
+				OutboundPackets: []types.OutboundPacket{
+					sample.OutboundPacket(t, "channel-0", 1),
+					sample.OutboundPacket(t, "channel-0", 1),
+				},
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"fmt"
+	"time"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
 const (
 	// ModuleName defines the module name
 	// NOTE: module name can't have the name of another module as a prefix
@@ -18,4 +25,31 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_" + ModuleName
+
+	// OutboundTimeout is the timeout of the ICS-20 transfers sent for the outbounds
+	OutboundTimeout = 10 * time.Minute
+)
+
+const (
+	ChainChannelKey   = "ChainChannel-value-"
+	OutboundPacketKey = "OutboundPacket-value-"
+)
+
+var (
+	// ModuleAddress is the address of the module account holding the tokens received from the IBC chains
+	ModuleAddress = authtypes.NewModuleAddress(ModuleName)
 )
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
+
+// OutboundPacketIndex returns the index of the outbound packet sent on the channel with the sequence
+func OutboundPacketIndex(channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s-%d", channelID, sequence)
+}
+
+// PacketHash returns the hash used in the cctxs for the ICS-20 packet with the sequence on the port and channel
+func PacketHash(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%d", portID, channelID, sequence)
+}
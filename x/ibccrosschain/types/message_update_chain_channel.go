@@ -0,0 +1,49 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgUpdateChainChannel = "update_chain_channel"
+)
+
+var _ sdk.Msg = &MsgUpdateChainChannel{}
+
+func NewMsgUpdateChainChannel(creator string, chainID int64, channelID string) *MsgUpdateChainChannel {
+	return &MsgUpdateChainChannel{
+		Creator:   creator,
+		ChainId:   chainID,
+		ChannelId: channelID,
+	}
+}
+
+func (msg *MsgUpdateChainChannel) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateChainChannel) Type() string {
+	return TypeMsgUpdateChainChannel
+}
+
+func (msg *MsgUpdateChainChannel) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateChainChannel) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateChainChannel) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return ChainChannel{ChainId: msg.ChainId, ChannelId: msg.ChannelId}.Validate()
+}
@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/ibccrosschain/types"
+)
+
+func TestMsgUpdateChainChannel_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateChainChannel
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg:  types.NewMsgUpdateChainChannel(sample.AccAddress(), 4200, "channel-0"),
+		},
+		{
+			name: "invalid address",
+			msg:  types.NewMsgUpdateChainChannel("invalid_address", 4200, "channel-0"),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid chain id",
+			msg:  types.NewMsgUpdateChainChannel(sample.AccAddress(), 0, "channel-0"),
+			err:  sdkerrors.ErrInvalidChainID,
+		},
+		{
+			name: "invalid channel id",
+			msg:  types.NewMsgUpdateChainChannel(sample.AccAddress(), 4200, "chan"),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateChainChannel_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	msg := types.NewMsgUpdateChainChannel(signer, 4200, "channel-0")
+	require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, msg.GetSigners())
+
+	msg.Creator = "invalid"
+	require.Panics(t, func() {
+		msg.GetSigners()
+	})
+}
+
+func TestMsgUpdateChainChannel_Type(t *testing.T) {
+	msg := types.NewMsgUpdateChainChannel(sample.AccAddress(), 4200, "channel-0")
+	require.Equal(t, types.TypeMsgUpdateChainChannel, msg.Type())
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
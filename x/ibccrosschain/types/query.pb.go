@@ -6,10 +6,17 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -23,21 +30,336 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type QueryAllChainChannelRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllChainChannelRequest) Reset()         { *m = QueryAllChainChannelRequest{} }
+func (m *QueryAllChainChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChainChannelRequest) ProtoMessage()    {}
+func (*QueryAllChainChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e06553cbe2eb6e6, []int{0}
+}
+func (m *QueryAllChainChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChainChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChainChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllChainChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChainChannelRequest.Merge(m, src)
+}
+func (m *QueryAllChainChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChainChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChainChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllChainChannelRequest proto.InternalMessageInfo
+
+func (m *QueryAllChainChannelRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllChainChannelResponse struct {
+	ChainChannels []ChainChannel      `protobuf:"bytes,1,rep,name=chain_channels,json=chainChannels,proto3" json:"chain_channels"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllChainChannelResponse) Reset()         { *m = QueryAllChainChannelResponse{} }
+func (m *QueryAllChainChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllChainChannelResponse) ProtoMessage()    {}
+func (*QueryAllChainChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e06553cbe2eb6e6, []int{1}
+}
+func (m *QueryAllChainChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllChainChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllChainChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllChainChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllChainChannelResponse.Merge(m, src)
+}
+func (m *QueryAllChainChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllChainChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllChainChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllChainChannelResponse proto.InternalMessageInfo
+
+func (m *QueryAllChainChannelResponse) GetChainChannels() []ChainChannel {
+	if m != nil {
+		return m.ChainChannels
+	}
+	return nil
+}
+
+func (m *QueryAllChainChannelResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryGetChainChannelRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryGetChainChannelRequest) Reset()         { *m = QueryGetChainChannelRequest{} }
+func (m *QueryGetChainChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainChannelRequest) ProtoMessage()    {}
+func (*QueryGetChainChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e06553cbe2eb6e6, []int{2}
+}
+func (m *QueryGetChainChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChainChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChainChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetChainChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainChannelRequest.Merge(m, src)
+}
+func (m *QueryGetChainChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChainChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetChainChannelRequest proto.InternalMessageInfo
+
+func (m *QueryGetChainChannelRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+type QueryGetChainChannelResponse struct {
+	ChainChannel ChainChannel `protobuf:"bytes,1,opt,name=chain_channel,json=chainChannel,proto3" json:"chain_channel"`
+}
+
+func (m *QueryGetChainChannelResponse) Reset()         { *m = QueryGetChainChannelResponse{} }
+func (m *QueryGetChainChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainChannelResponse) ProtoMessage()    {}
+func (*QueryGetChainChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e06553cbe2eb6e6, []int{3}
+}
+func (m *QueryGetChainChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetChainChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetChainChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetChainChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainChannelResponse.Merge(m, src)
+}
+func (m *QueryGetChainChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetChainChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetChainChannelResponse proto.InternalMessageInfo
+
+func (m *QueryGetChainChannelResponse) GetChainChannel() ChainChannel {
+	if m != nil {
+		return m.ChainChannel
+	}
+	return ChainChannel{}
+}
+
+type QueryAllOutboundPacketRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllOutboundPacketRequest) Reset()         { *m = QueryAllOutboundPacketRequest{} }
+func (m *QueryAllOutboundPacketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllOutboundPacketRequest) ProtoMessage()    {}
+func (*QueryAllOutboundPacketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e06553cbe2eb6e6, []int{4}
+}
+func (m *QueryAllOutboundPacketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllOutboundPacketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllOutboundPacketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllOutboundPacketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllOutboundPacketRequest.Merge(m, src)
+}
+func (m *QueryAllOutboundPacketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllOutboundPacketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllOutboundPacketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllOutboundPacketRequest proto.InternalMessageInfo
+
+func (m *QueryAllOutboundPacketRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllOutboundPacketResponse struct {
+	OutboundPackets []OutboundPacket    `protobuf:"bytes,1,rep,name=outbound_packets,json=outboundPackets,proto3" json:"outbound_packets"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllOutboundPacketResponse) Reset()         { *m = QueryAllOutboundPacketResponse{} }
+func (m *QueryAllOutboundPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllOutboundPacketResponse) ProtoMessage()    {}
+func (*QueryAllOutboundPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e06553cbe2eb6e6, []int{5}
+}
+func (m *QueryAllOutboundPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllOutboundPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllOutboundPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllOutboundPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllOutboundPacketResponse.Merge(m, src)
+}
+func (m *QueryAllOutboundPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllOutboundPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllOutboundPacketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllOutboundPacketResponse proto.InternalMessageInfo
+
+func (m *QueryAllOutboundPacketResponse) GetOutboundPackets() []OutboundPacket {
+	if m != nil {
+		return m.OutboundPackets
+	}
+	return nil
+}
+
+func (m *QueryAllOutboundPacketResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryAllChainChannelRequest)(nil), "zetachain.zetacore.ibccrosschain.QueryAllChainChannelRequest")
+	proto.RegisterType((*QueryAllChainChannelResponse)(nil), "zetachain.zetacore.ibccrosschain.QueryAllChainChannelResponse")
+	proto.RegisterType((*QueryGetChainChannelRequest)(nil), "zetachain.zetacore.ibccrosschain.QueryGetChainChannelRequest")
+	proto.RegisterType((*QueryGetChainChannelResponse)(nil), "zetachain.zetacore.ibccrosschain.QueryGetChainChannelResponse")
+	proto.RegisterType((*QueryAllOutboundPacketRequest)(nil), "zetachain.zetacore.ibccrosschain.QueryAllOutboundPacketRequest")
+	proto.RegisterType((*QueryAllOutboundPacketResponse)(nil), "zetachain.zetacore.ibccrosschain.QueryAllOutboundPacketResponse")
+}
+
 func init() {
 	proto.RegisterFile("zetachain/zetacore/ibccrosschain/query.proto", fileDescriptor_0e06553cbe2eb6e6)
 }
 
 var fileDescriptor_0e06553cbe2eb6e6 = []byte{
-	// 138 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xa9, 0x4a, 0x2d, 0x49,
-	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb3, 0xf2, 0x8b, 0x52, 0xf5, 0x33, 0x93, 0x92, 0x93,
-	0x8b, 0xf2, 0x8b, 0x8b, 0x21, 0xc2, 0x85, 0xa5, 0xa9, 0x45, 0x95, 0x7a, 0x05, 0x45, 0xf9, 0x25,
-	0xf9, 0x42, 0x0a, 0x70, 0xd5, 0x7a, 0x30, 0xd5, 0x7a, 0x28, 0xaa, 0x8d, 0xd8, 0xb9, 0x58, 0x03,
-	0x41, 0x1a, 0x9c, 0xfc, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x24,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x17, 0x6c, 0xa7, 0x2e, 0x9a, 0xf5, 0x15,
-	0x68, 0x0e, 0x28, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xbb, 0xc0, 0x18, 0x10, 0x00, 0x00,
-	0xff, 0xff, 0x03, 0xc2, 0x92, 0x0e, 0xb1, 0x00, 0x00, 0x00,
+	// 560 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0x6d, 0xfc, 0x90, 0xb7, 0x31, 0xb0, 0x38, 0x8c, 0x50, 0x42, 0x95, 0x03, 0xdb,
+	0xf8, 0x61, 0xd3, 0x52, 0x21, 0x84, 0xc4, 0x60, 0x9b, 0xc4, 0xc4, 0x05, 0x46, 0x6f, 0xc0, 0x61,
+	0x72, 0x52, 0x2b, 0x8d, 0xc8, 0xe2, 0xac, 0x76, 0x10, 0x05, 0x71, 0xe1, 0x2f, 0x40, 0xe2, 0x2f,
+	0x42, 0xe2, 0x30, 0x24, 0x0e, 0x93, 0xb8, 0x70, 0x42, 0xa8, 0xe5, 0x0f, 0x41, 0xb1, 0xdd, 0x2d,
+	0xa9, 0xb2, 0xa5, 0xaa, 0x7a, 0xa9, 0x5c, 0xc7, 0xdf, 0xe7, 0xf7, 0xf9, 0xbe, 0xf7, 0x0c, 0x6f,
+	0x7f, 0x60, 0x92, 0x7a, 0x1d, 0x1a, 0x44, 0x44, 0xad, 0x78, 0x97, 0x91, 0xc0, 0xf5, 0xbc, 0x2e,
+	0x17, 0x42, 0x6f, 0xef, 0x27, 0xac, 0xdb, 0xc3, 0x71, 0x97, 0x4b, 0x8e, 0x6a, 0x47, 0xa7, 0xf1,
+	0xf0, 0x34, 0xce, 0x9d, 0xb6, 0x6e, 0x7a, 0x5c, 0xec, 0x71, 0x41, 0x5c, 0x2a, 0x98, 0x96, 0x92,
+	0x77, 0x75, 0x97, 0x49, 0x5a, 0x27, 0x31, 0xf5, 0x83, 0x88, 0xca, 0x80, 0x47, 0x3a, 0x9a, 0x75,
+	0xd9, 0xe7, 0x3e, 0x57, 0x4b, 0x92, 0xae, 0xcc, 0x6e, 0xd5, 0xe7, 0xdc, 0x0f, 0x19, 0xa1, 0x71,
+	0x40, 0x68, 0x14, 0x71, 0xa9, 0x24, 0xc2, 0x7c, 0x6d, 0x96, 0xe6, 0xab, 0x7e, 0x77, 0xbd, 0x0e,
+	0x8d, 0x22, 0x16, 0x1a, 0xd5, 0xfd, 0x52, 0x15, 0x4f, 0xa4, 0xcb, 0x93, 0xa8, 0xbd, 0x1b, 0x53,
+	0xef, 0x2d, 0x93, 0x5a, 0xe7, 0x30, 0x78, 0xf5, 0x65, 0xca, 0xb0, 0x11, 0x86, 0x5b, 0xe9, 0xb1,
+	0x2d, 0x1d, 0xb5, 0xc5, 0xf6, 0x13, 0x26, 0x24, 0x7a, 0x0a, 0xe1, 0x31, 0xd4, 0x32, 0xa8, 0x81,
+	0xd5, 0xf9, 0xc6, 0x0d, 0xac, 0x1d, 0xc0, 0xa9, 0x03, 0x58, 0x9b, 0x67, 0x1c, 0xc0, 0x3b, 0xd4,
+	0x67, 0x46, 0xdb, 0xca, 0x28, 0x9d, 0xef, 0x00, 0x56, 0x8b, 0xef, 0x11, 0x31, 0x8f, 0x04, 0x43,
+	0x6f, 0xe0, 0x85, 0x1c, 0x96, 0x58, 0x06, 0xb5, 0xd9, 0xd5, 0xf9, 0x06, 0xc6, 0x65, 0x05, 0xc1,
+	0xd9, 0x78, 0x9b, 0x73, 0x07, 0x7f, 0xae, 0x57, 0x5a, 0x8b, 0x5e, 0x66, 0x4f, 0xa0, 0xed, 0x1c,
+	0xc5, 0x8c, 0xa2, 0x58, 0x29, 0xa5, 0xd0, 0x99, 0xe5, 0x30, 0x1e, 0x18, 0xb7, 0xb6, 0x99, 0x2c,
+	0x72, 0xeb, 0x0a, 0x3c, 0xaf, 0x21, 0x82, 0xb6, 0xf2, 0x6a, 0xb6, 0x75, 0x4e, 0xfd, 0x7f, 0xd6,
+	0x76, 0x7a, 0xb0, 0x5a, 0xac, 0x34, 0xfc, 0xaf, 0xe0, 0x62, 0x8e, 0xdf, 0x78, 0x3d, 0x19, 0xfe,
+	0x42, 0x16, 0xdf, 0xf1, 0xe1, 0xb5, 0xa1, 0xf5, 0x2f, 0x4c, 0x0f, 0xec, 0xa8, 0x16, 0x98, 0x76,
+	0x91, 0x7f, 0x02, 0x68, 0x9f, 0x74, 0x93, 0xc1, 0xa4, 0xf0, 0xe2, 0x48, 0x1f, 0x0e, 0x0b, 0x7d,
+	0xb7, 0x9c, 0x34, 0x1f, 0xd3, 0xb0, 0x2e, 0xf1, 0xdc, 0xee, 0xf4, 0x8a, 0xdd, 0x18, 0xcc, 0xc1,
+	0x33, 0x0a, 0x07, 0x7d, 0x03, 0x70, 0x29, 0x6b, 0xf3, 0x46, 0x18, 0xa2, 0x47, 0xe5, 0xf9, 0x9e,
+	0x32, 0x58, 0xd6, 0xfa, 0xa4, 0x72, 0x9d, 0xa8, 0x43, 0x3e, 0xff, 0xfa, 0xf7, 0x75, 0x66, 0x0d,
+	0xad, 0xa8, 0x71, 0xbf, 0xa3, 0x47, 0xfc, 0x94, 0x67, 0x02, 0xfd, 0x00, 0x70, 0x21, 0x1b, 0x69,
+	0x6c, 0x80, 0xe2, 0x5e, 0xb7, 0xd6, 0x27, 0x95, 0x1b, 0x80, 0x87, 0x0a, 0xa0, 0x89, 0x1a, 0x63,
+	0x02, 0x90, 0x8f, 0xc3, 0xd1, 0xfa, 0x94, 0xb2, 0x5c, 0xca, 0x37, 0x43, 0x5a, 0x91, 0xc7, 0xe3,
+	0x5b, 0x5a, 0x38, 0x07, 0xd6, 0x93, 0xc9, 0x03, 0x18, 0xa8, 0xba, 0x82, 0xba, 0x85, 0xd6, 0x4e,
+	0x86, 0x1a, 0x69, 0xff, 0xcd, 0xe7, 0x07, 0x7d, 0x1b, 0x1c, 0xf6, 0x6d, 0xf0, 0xb7, 0x6f, 0x83,
+	0x2f, 0x03, 0xbb, 0x72, 0x38, 0xb0, 0x2b, 0xbf, 0x07, 0x76, 0xe5, 0x75, 0xd3, 0x0f, 0x64, 0x27,
+	0x71, 0xb1, 0xc7, 0xf7, 0xb2, 0xe1, 0x8e, 0x9e, 0xf7, 0xf7, 0x23, 0x91, 0x65, 0x2f, 0x66, 0xc2,
+	0x3d, 0xab, 0xde, 0xf5, 0x7b, 0xff, 0x07, 0x00, 0x91, 0xf4, 0xbc, 0xd0, 0xf7, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -52,6 +374,9 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
+	ChainChannelAll(ctx context.Context, in *QueryAllChainChannelRequest, opts ...grpc.CallOption) (*QueryAllChainChannelResponse, error)
+	ChainChannel(ctx context.Context, in *QueryGetChainChannelRequest, opts ...grpc.CallOption) (*QueryGetChainChannelResponse, error)
+	OutboundPacketAll(ctx context.Context, in *QueryAllOutboundPacketRequest, opts ...grpc.CallOption) (*QueryAllOutboundPacketResponse, error)
 }
 
 type queryClient struct {
@@ -62,22 +387,1111 @@ func NewQueryClient(cc grpc1.ClientConn) QueryClient {
 	return &queryClient{cc}
 }
 
+func (c *queryClient) ChainChannelAll(ctx context.Context, in *QueryAllChainChannelRequest, opts ...grpc.CallOption) (*QueryAllChainChannelResponse, error) {
+	out := new(QueryAllChainChannelResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.ibccrosschain.Query/ChainChannelAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChainChannel(ctx context.Context, in *QueryGetChainChannelRequest, opts ...grpc.CallOption) (*QueryGetChainChannelResponse, error) {
+	out := new(QueryGetChainChannelResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.ibccrosschain.Query/ChainChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutboundPacketAll(ctx context.Context, in *QueryAllOutboundPacketRequest, opts ...grpc.CallOption) (*QueryAllOutboundPacketResponse, error) {
+	out := new(QueryAllOutboundPacketResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.ibccrosschain.Query/OutboundPacketAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
+	ChainChannelAll(context.Context, *QueryAllChainChannelRequest) (*QueryAllChainChannelResponse, error)
+	ChainChannel(context.Context, *QueryGetChainChannelRequest) (*QueryGetChainChannelResponse, error)
+	OutboundPacketAll(context.Context, *QueryAllOutboundPacketRequest) (*QueryAllOutboundPacketResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
 type UnimplementedQueryServer struct {
 }
 
+func (*UnimplementedQueryServer) ChainChannelAll(ctx context.Context, req *QueryAllChainChannelRequest) (*QueryAllChainChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainChannelAll not implemented")
+}
+func (*UnimplementedQueryServer) ChainChannel(ctx context.Context, req *QueryGetChainChannelRequest) (*QueryGetChainChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainChannel not implemented")
+}
+func (*UnimplementedQueryServer) OutboundPacketAll(ctx context.Context, req *QueryAllOutboundPacketRequest) (*QueryAllOutboundPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutboundPacketAll not implemented")
+}
+
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
+func _Query_ChainChannelAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChainChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainChannelAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.ibccrosschain.Query/ChainChannelAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainChannelAll(ctx, req.(*QueryAllChainChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChainChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.ibccrosschain.Query/ChainChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainChannel(ctx, req.(*QueryGetChainChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutboundPacketAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllOutboundPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutboundPacketAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.ibccrosschain.Query/OutboundPacketAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutboundPacketAll(ctx, req.(*QueryAllOutboundPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.ibccrosschain.Query",
 	HandlerType: (*QueryServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams:     []grpc.StreamDesc{},
-	Metadata:    "zetachain/zetacore/ibccrosschain/query.proto",
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ChainChannelAll",
+			Handler:    _Query_ChainChannelAll_Handler,
+		},
+		{
+			MethodName: "ChainChannel",
+			Handler:    _Query_ChainChannel_Handler,
+		},
+		{
+			MethodName: "OutboundPacketAll",
+			Handler:    _Query_OutboundPacketAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "zetachain/zetacore/ibccrosschain/query.proto",
+}
+
+func (m *QueryAllChainChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
+
+func (m *QueryAllChainChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChainChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllChainChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllChainChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllChainChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainChannels) > 0 {
+		for iNdEx := len(m.ChainChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ChainChannel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllOutboundPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllOutboundPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllOutboundPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllOutboundPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllOutboundPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllOutboundPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OutboundPackets) > 0 {
+		for iNdEx := len(m.OutboundPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutboundPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllChainChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllChainChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChainChannels) > 0 {
+		for _, e := range m.ChainChannels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChainChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetChainChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ChainChannel.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllOutboundPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllOutboundPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OutboundPackets) > 0 {
+		for _, e := range m.OutboundPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAllChainChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChainChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChainChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChainChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChainChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChainChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainChannels = append(m.ChainChannels, ChainChannel{})
+			if err := m.ChainChannels[len(m.ChainChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainChannel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainChannel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOutboundPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOutboundPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOutboundPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOutboundPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOutboundPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOutboundPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundPackets = append(m.OutboundPackets, OutboundPacket{})
+			if err := m.OutboundPackets[len(m.OutboundPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
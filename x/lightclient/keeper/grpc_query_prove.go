@@ -40,7 +40,7 @@ func (k Keeper) Prove(c context.Context, req *types.QueryProveRequest) (*types.Q
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	if err == nil {
-		if chains.IsEVMChain(req.ChainId) {
+		if chains.IsEVMChain(req.ChainId, []chains.Chain{}) {
 			var txx ethtypes.Transaction
 			err = txx.UnmarshalBinary(txBytes)
 			if err != nil {
@@ -53,7 +53,7 @@ func (k Keeper) Prove(c context.Context, req *types.QueryProveRequest) (*types.Q
 				)
 			}
 			proven = true
-		} else if chains.IsBitcoinChain(req.ChainId) {
+		} else if chains.IsBitcoinChain(req.ChainId, []chains.Chain{}) {
 			tx, err := btcutil.NewTxFromBytes(txBytes)
 			if err != nil {
 				return nil, status.Error(codes.Internal, fmt.Sprintf("failed to unmarshal btc transaction: %s", err))
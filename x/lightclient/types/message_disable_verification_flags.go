@@ -47,7 +47,8 @@ func (msg *MsgDisableHeaderVerification) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	chainListForHeaderSupport := chains.ChainListForHeaderSupport()
+	// block header verification is only supported for the default chains
+	chainListForHeaderSupport := chains.ChainListForHeaderSupport([]chains.Chain{})
 	if len(msg.ChainIdList) == 0 {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "chain id list cannot be empty")
 	}
@@ -30,6 +30,35 @@ func (k Keeper) GetBallot(ctx sdk.Context, index string) (val types.Ballot, foun
 	return val, true
 }
 
+// DeleteBallot removes a ballot from the store and from the list of ballots of its creation height
+// the list of the height is removed with its last ballot
+func (k Keeper) DeleteBallot(ctx sdk.Context, index string) {
+	ballot, found := k.GetBallot(ctx, index)
+	if !found {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VoterKey))
+	store.Delete(types.KeyPrefix(index))
+
+	list, found := k.GetBallotList(ctx, ballot.BallotCreationHeight)
+	if !found {
+		return
+	}
+	indexes := make([]string, 0, len(list.BallotsIndexList))
+	for _, ballotIndex := range list.BallotsIndexList {
+		if ballotIndex != index {
+			indexes = append(indexes, ballotIndex)
+		}
+	}
+	if len(indexes) == 0 {
+		listStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BallotListKey))
+		listStore.Delete(types.BallotListKeyPrefix(ballot.BallotCreationHeight))
+		return
+	}
+	list.BallotsIndexList = indexes
+	k.SetBallotList(ctx, &list)
+}
+
 func (k Keeper) GetBallotList(ctx sdk.Context, height int64) (val types.BallotListForHeight, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BallotListKey))
 	b := store.Get(types.BallotListKeyPrefix(height))
@@ -53,6 +53,53 @@ func TestKeeper_GetBallot(t *testing.T) {
 	require.Equal(t, *b, ballot)
 }
 
+func TestKeeper_DeleteBallot(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+	identifier := sample.ZetaIndex(t)
+	k.SetBallot(ctx, &types.Ballot{
+		BallotIdentifier: identifier,
+		BallotThreshold:  sdk.ZeroDec(),
+	})
+	_, found := k.GetBallot(ctx, identifier)
+	require.True(t, found)
+
+	k.DeleteBallot(ctx, identifier)
+	_, found = k.GetBallot(ctx, identifier)
+	require.False(t, found)
+
+	// deleting a missing ballot is a no-op
+	k.DeleteBallot(ctx, identifier)
+}
+
+func TestKeeper_DeleteBallot_BallotList(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+	newBallot := func() types.Ballot {
+		return types.Ballot{
+			BallotIdentifier:     sample.ZetaIndex(t),
+			BallotThreshold:      sdk.ZeroDec(),
+			BallotCreationHeight: 10,
+		}
+	}
+	first, second := newBallot(), newBallot()
+	for _, ballot := range []types.Ballot{first, second} {
+		k.SetBallot(ctx, &ballot)
+		k.AddBallotToList(ctx, ballot)
+	}
+
+	// the ballot is removed from the list of its height
+	k.DeleteBallot(ctx, first.BallotIdentifier)
+	list, found := k.GetBallotList(ctx, 10)
+	require.True(t, found)
+	require.Equal(t, []string{second.BallotIdentifier}, list.BallotsIndexList)
+
+	// the list is removed with its last ballot
+	k.DeleteBallot(ctx, second.BallotIdentifier)
+	_, found = k.GetBallotList(ctx, 10)
+	require.False(t, found)
+}
+
 func TestKeeper_GetBallotList(t *testing.T) {
 	k, ctx, _, _ := keepertest.ObserverKeeper(t)
 	identifier := sample.ZetaIndex(t)
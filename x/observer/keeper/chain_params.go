@@ -42,6 +42,7 @@ func (k Keeper) GetChainParamsByChainID(ctx sdk.Context, chainID int64) (*types.
 }
 
 // GetSupportedChainFromChainID returns the chain from the chain id
+// the chain is resolved from the default chains and the chains of the authority chain info
 // it returns nil if the chain doesn't exist or is not supported
 func (k Keeper) GetSupportedChainFromChainID(ctx sdk.Context, chainID int64) *chains.Chain {
 	cpl, found := k.GetChainParamsList(ctx)
@@ -51,7 +52,7 @@ func (k Keeper) GetSupportedChainFromChainID(ctx sdk.Context, chainID int64) *ch
 
 	for _, cp := range cpl.ChainParams {
 		if cp.ChainId == chainID && cp.IsSupported {
-			return chains.GetChainFromChainID(chainID)
+			return chains.GetChainFromChainID(chainID, k.GetAuthorityKeeper().GetAdditionalChainList(ctx))
 		}
 	}
 	return nil
@@ -64,10 +65,11 @@ func (k Keeper) GetSupportedChains(ctx sdk.Context) []*chains.Chain {
 		return []*chains.Chain{}
 	}
 
+	additionalChains := k.GetAuthorityKeeper().GetAdditionalChainList(ctx)
 	var c []*chains.Chain
 	for _, cp := range cpl.ChainParams {
 		if cp.IsSupported {
-			c = append(c, chains.GetChainFromChainID(cp.ChainId))
+			c = append(c, chains.GetChainFromChainID(cp.ChainId, additionalChains))
 		}
 	}
 	return c
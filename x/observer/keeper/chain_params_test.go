@@ -38,6 +38,21 @@ func TestKeeper_GetSupportedChainFromChainID(t *testing.T) {
 		require.NotNil(t, chain)
 		require.EqualValues(t, chainID, chain.ChainId)
 	})
+
+	t.Run("return chain added in the chain info", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.ObserverKeeper(t)
+		chainInfo := sample.ChainInfo(42)
+		zk.AuthorityKeeper.SetChainInfo(ctx, chainInfo)
+
+		// the chain is not supported until the chain params are set
+		require.Nil(t, k.GetSupportedChainFromChainID(ctx, 42))
+
+		setSupportedChain(ctx, *k, 42)
+		chain := k.GetSupportedChainFromChainID(ctx, 42)
+		require.NotNil(t, chain)
+		require.Equal(t, chainInfo.Chains[0], *chain)
+		require.Len(t, k.GetSupportedChains(ctx), 1)
+	})
 }
 
 func TestKeeper_GetChainParamsByChainID(t *testing.T) {
@@ -78,12 +93,12 @@ func TestKeeper_GetSupportedChains(t *testing.T) {
 	t.Run("return list containing supported chains", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.ObserverKeeper(t)
 
-		require.Greater(t, len(chains.ExternalChainList()), 5)
-		supported1 := chains.ExternalChainList()[0]
-		supported2 := chains.ExternalChainList()[1]
-		unsupported := chains.ExternalChainList()[2]
-		supported3 := chains.ExternalChainList()[3]
-		supported4 := chains.ExternalChainList()[4]
+		require.Greater(t, len(chains.ExternalChainList([]chains.Chain{})), 5)
+		supported1 := chains.ExternalChainList([]chains.Chain{})[0]
+		supported2 := chains.ExternalChainList([]chains.Chain{})[1]
+		unsupported := chains.ExternalChainList([]chains.Chain{})[2]
+		supported3 := chains.ExternalChainList([]chains.Chain{})[3]
+		supported4 := chains.ExternalChainList([]chains.Chain{})[4]
 
 		var chainParamsList []*types.ChainParams
 		chainParamsList = append(chainParamsList, sample.ChainParamsSupported(supported1.ChainId))
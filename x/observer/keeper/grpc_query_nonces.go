@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
@@ -55,7 +56,17 @@ func (k Keeper) ChainNonces(
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	val, found := k.GetChainNonces(ctx, req.Index)
+	// the chain nonces are stored by chain name, the index of the chain of the chain id
+	index := req.Index
+	if index == "" {
+		chain := chains.GetChainFromChainID(req.ChainId, k.GetAuthorityKeeper().GetAdditionalChainList(ctx))
+		if chain == nil {
+			return nil, status.Error(codes.InvalidArgument, "chain not found")
+		}
+		index = chain.ChainName.String()
+	}
+
+	val, found := k.GetChainNonces(ctx, index)
 	if !found {
 		return nil, status.Error(codes.InvalidArgument, "not found")
 	}
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/zeta-chain/zetacore/pkg/chains"
 	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
 	"github.com/zeta-chain/zetacore/testutil/sample"
 	"github.com/zeta-chain/zetacore/x/observer/types"
@@ -21,6 +22,12 @@ func TestChainNoncesQuerySingle(t *testing.T) {
 	for _, nonce := range chainNonces {
 		k.SetChainNonces(ctx, nonce)
 	}
+	goerliNonces := types.ChainNonces{
+		Index:   chains.GoerliLocalnet.ChainName.String(),
+		ChainId: chains.GoerliLocalnet.ChainId,
+		Nonce:   42,
+	}
+	k.SetChainNonces(ctx, goerliNonces)
 	for _, tc := range []struct {
 		desc     string
 		request  *types.QueryGetChainNoncesRequest
@@ -42,6 +49,16 @@ func TestChainNoncesQuerySingle(t *testing.T) {
 			request: &types.QueryGetChainNoncesRequest{Index: "missing"},
 			err:     status.Error(codes.InvalidArgument, "not found"),
 		},
+		{
+			desc:     "ByChainID",
+			request:  &types.QueryGetChainNoncesRequest{ChainId: chains.GoerliLocalnet.ChainId},
+			response: &types.QueryGetChainNoncesResponse{ChainNonces: goerliNonces},
+		},
+		{
+			desc:    "ChainNotFound",
+			request: &types.QueryGetChainNoncesRequest{ChainId: 999999},
+			err:     status.Error(codes.InvalidArgument, "chain not found"),
+		},
 		{
 			desc: "InvalidRequest",
 			err:  status.Error(codes.InvalidArgument, "invalid request"),
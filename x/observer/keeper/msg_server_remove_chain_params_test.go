@@ -24,9 +24,9 @@ func TestMsgServer_RemoveChainParams(t *testing.T) {
 		// mock the authority keeper for authorization
 		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
 
-		chain1 := chains.ExternalChainList()[0].ChainId
-		chain2 := chains.ExternalChainList()[1].ChainId
-		chain3 := chains.ExternalChainList()[2].ChainId
+		chain1 := chains.ExternalChainList([]chains.Chain{})[0].ChainId
+		chain2 := chains.ExternalChainList([]chains.Chain{})[1].ChainId
+		chain3 := chains.ExternalChainList([]chains.Chain{})[2].ChainId
 
 		// set admin
 		admin := sample.AccAddress()
@@ -96,7 +96,7 @@ func TestMsgServer_RemoveChainParams(t *testing.T) {
 
 		_, err := srv.RemoveChainParams(sdk.WrapSDKContext(ctx), &types.MsgRemoveChainParams{
 			Creator: admin,
-			ChainId: chains.ExternalChainList()[0].ChainId,
+			ChainId: chains.ExternalChainList([]chains.Chain{})[0].ChainId,
 		})
 		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
 	})
@@ -118,16 +118,16 @@ func TestMsgServer_RemoveChainParams(t *testing.T) {
 
 		_, err := srv.RemoveChainParams(sdk.WrapSDKContext(ctx), &types.MsgRemoveChainParams{
 			Creator: admin,
-			ChainId: chains.ExternalChainList()[0].ChainId,
+			ChainId: chains.ExternalChainList([]chains.Chain{})[0].ChainId,
 		})
 		require.ErrorIs(t, err, types.ErrChainParamsNotFound)
 
 		// add chain params
 		k.SetChainParamsList(ctx, types.ChainParamsList{
 			ChainParams: []*types.ChainParams{
-				sample.ChainParams(chains.ExternalChainList()[0].ChainId),
-				sample.ChainParams(chains.ExternalChainList()[1].ChainId),
-				sample.ChainParams(chains.ExternalChainList()[2].ChainId),
+				sample.ChainParams(chains.ExternalChainList([]chains.Chain{})[0].ChainId),
+				sample.ChainParams(chains.ExternalChainList([]chains.Chain{})[1].ChainId),
+				sample.ChainParams(chains.ExternalChainList([]chains.Chain{})[2].ChainId),
 			},
 		})
 
@@ -136,7 +136,7 @@ func TestMsgServer_RemoveChainParams(t *testing.T) {
 		// not found if chain ID not in list
 		_, err = srv.RemoveChainParams(sdk.WrapSDKContext(ctx), &types.MsgRemoveChainParams{
 			Creator: admin,
-			ChainId: chains.ExternalChainList()[3].ChainId,
+			ChainId: chains.ExternalChainList([]chains.Chain{})[3].ChainId,
 		})
 		require.ErrorIs(t, err, types.ErrChainParamsNotFound)
 	})
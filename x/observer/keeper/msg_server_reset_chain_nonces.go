@@ -25,7 +25,7 @@ func (k msgServer) ResetChainNonces(
 		return nil, types.ErrTssNotFound
 	}
 
-	chain := chains.GetChainFromChainID(msg.ChainId)
+	chain := chains.GetChainFromChainID(msg.ChainId, k.GetAuthorityKeeper().GetAdditionalChainList(ctx))
 	if chain == nil {
 		return nil, types.ErrSupportedChains
 	}
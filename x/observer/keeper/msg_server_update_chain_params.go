@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	cosmoserrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
@@ -24,6 +25,12 @@ func (k msgServer) UpdateChainParams(
 		return &types.MsgUpdateChainParamsResponse{}, authoritytypes.ErrUnauthorized
 	}
 
+	// validate the chain params, the chain can be a default chain or a chain added in the authority chain info
+	err := types.ValidateChainParams(msg.ChainParams, k.GetAuthorityKeeper().GetAdditionalChainList(ctx))
+	if err != nil {
+		return &types.MsgUpdateChainParamsResponse{}, cosmoserrors.Wrap(types.ErrInvalidChainParams, err.Error())
+	}
+
 	// find current chain params list or initialize a new one
 	chainParamsList, found := k.GetChainParamsList(ctx)
 	if !found {
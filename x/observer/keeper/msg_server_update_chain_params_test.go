@@ -21,13 +21,14 @@ func TestMsgServer_UpdateChainParams(t *testing.T) {
 		})
 		srv := keeper.NewMsgServerImpl(*k)
 
-		chain1 := chains.ExternalChainList()[0].ChainId
-		chain2 := chains.ExternalChainList()[1].ChainId
-		chain3 := chains.ExternalChainList()[2].ChainId
+		chain1 := chains.ExternalChainList([]chains.Chain{})[0].ChainId
+		chain2 := chains.ExternalChainList([]chains.Chain{})[1].ChainId
+		chain3 := chains.ExternalChainList([]chains.Chain{})[2].ChainId
 
 		// set admin
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 
 		// check list initially empty
 		_, found := k.GetChainParamsList(ctx)
@@ -115,8 +116,52 @@ func TestMsgServer_UpdateChainParams(t *testing.T) {
 
 		_, err := srv.UpdateChainParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateChainParams{
 			Creator:     admin,
-			ChainParams: sample.ChainParams(chains.ExternalChainList()[0].ChainId),
+			ChainParams: sample.ChainParams(chains.ExternalChainList([]chains.Chain{})[0].ChainId),
 		})
 		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
 	})
+
+	t.Run("can update chain params for a chain added in the chain info", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		newChain := chains.Ethereum
+		newChain.ChainId = 42
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		authorityMock.On("GetAdditionalChainList", ctx).Return([]chains.Chain{newChain})
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupOperational, true)
+
+		chainParams := sample.ChainParams(newChain.ChainId)
+		_, err := srv.UpdateChainParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateChainParams{
+			Creator:     admin,
+			ChainParams: chainParams,
+		})
+		require.NoError(t, err)
+
+		chainParamsList, found := k.GetChainParamsList(ctx)
+		require.True(t, found)
+		require.Equal(t, []*types.ChainParams{chainParams}, chainParamsList.ChainParams)
+	})
+
+	t.Run("cannot update chain params for an unknown chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
+		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupOperational, true)
+
+		_, err := srv.UpdateChainParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateChainParams{
+			Creator:     admin,
+			ChainParams: sample.ChainParams(42),
+		})
+		require.ErrorIs(t, err, types.ErrInvalidChainParams)
+	})
 }
@@ -91,6 +91,8 @@ func TestMsgServer_VoteBlockHeader(t *testing.T) {
 		observer := sample.AccAddress()
 
 		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		slashingMock := keepertest.GetObserverSlashingMock(t, k)
 		lightclientMock := keepertest.GetObserverLightclientMock(t, k)
 
@@ -129,6 +131,8 @@ func TestMsgServer_VoteBlockHeader(t *testing.T) {
 		observer := sample.AccAddress()
 
 		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		slashingMock := keepertest.GetObserverSlashingMock(t, k)
 		lightclientMock := keepertest.GetObserverLightclientMock(t, k)
 
@@ -174,6 +178,8 @@ func TestMsgServer_VoteBlockHeader(t *testing.T) {
 		blockHash := sample.Hash().Bytes()
 
 		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		slashingMock := keepertest.GetObserverSlashingMock(t, k)
 		lightclientMock := keepertest.GetObserverLightclientMock(t, k)
 
@@ -248,6 +254,8 @@ func TestMsgServer_VoteBlockHeader(t *testing.T) {
 		blockHash := sample.Hash().Bytes()
 
 		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		slashingMock := keepertest.GetObserverSlashingMock(t, k)
 		lightclientMock := keepertest.GetObserverLightclientMock(t, k)
 
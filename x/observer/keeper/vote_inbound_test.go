@@ -113,6 +113,8 @@ func TestKeeper_VoteOnInboundBallot(t *testing.T) {
 
 		observer := sample.AccAddress()
 		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		slashingMock := keepertest.GetObserverSlashingMock(t, k)
 
 		k.SetCrosschainFlags(ctx, types.CrosschainFlags{
@@ -178,6 +180,8 @@ func TestKeeper_VoteOnInboundBallot(t *testing.T) {
 
 		observer := sample.AccAddress()
 		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		slashingMock := keepertest.GetObserverSlashingMock(t, k)
 
 		k.SetCrosschainFlags(ctx, types.CrosschainFlags{
@@ -220,6 +224,8 @@ func TestKeeper_VoteOnInboundBallot(t *testing.T) {
 
 		observer := sample.AccAddress()
 		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		slashingMock := keepertest.GetObserverSlashingMock(t, k)
 
 		k.SetCrosschainFlags(ctx, types.CrosschainFlags{
@@ -264,6 +270,8 @@ func TestKeeper_VoteOnInboundBallot(t *testing.T) {
 
 		observer := sample.AccAddress()
 		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		slashingMock := keepertest.GetObserverSlashingMock(t, k)
 
 		k.SetCrosschainFlags(ctx, types.CrosschainFlags{
@@ -315,6 +323,8 @@ func TestKeeper_VoteOnInboundBallot(t *testing.T) {
 
 		observer := sample.AccAddress()
 		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		slashingMock := keepertest.GetObserverSlashingMock(t, k)
 
 		// threshold high enough to not finalize ballot
@@ -368,6 +378,8 @@ func TestKeeper_VoteOnInboundBallot(t *testing.T) {
 
 		observer := sample.AccAddress()
 		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		slashingMock := keepertest.GetObserverSlashingMock(t, k)
 
 		k.SetCrosschainFlags(ctx, types.CrosschainFlags{
@@ -432,6 +444,8 @@ func TestKeeper_VoteOnInboundBallot(t *testing.T) {
 
 		observer := sample.AccAddress()
 		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		slashingMock := keepertest.GetObserverSlashingMock(t, k)
 
 		k.SetCrosschainFlags(ctx, types.CrosschainFlags{
@@ -101,6 +101,8 @@ func TestKeeper_VoteOnOutboundBallot(t *testing.T) {
 
 		observer := sample.AccAddress()
 		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		slashingMock := keepertest.GetObserverSlashingMock(t, k)
 
 		k.SetChainParamsList(ctx, types.ChainParamsList{
@@ -139,6 +141,8 @@ func TestKeeper_VoteOnOutboundBallot(t *testing.T) {
 
 		observer := sample.AccAddress()
 		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		slashingMock := keepertest.GetObserverSlashingMock(t, k)
 
 		k.SetChainParamsList(ctx, types.ChainParamsList{
@@ -181,6 +185,8 @@ func TestKeeper_VoteOnOutboundBallot(t *testing.T) {
 
 		observer := sample.AccAddress()
 		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		slashingMock := keepertest.GetObserverSlashingMock(t, k)
 
 		// threshold high enough to not finalize the ballot
@@ -227,6 +233,8 @@ func TestKeeper_VoteOnOutboundBallot(t *testing.T) {
 
 		observer := sample.AccAddress()
 		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		slashingMock := keepertest.GetObserverSlashingMock(t, k)
 
 		k.SetChainParamsList(ctx, types.ChainParamsList{
@@ -285,6 +293,8 @@ func TestKeeper_VoteOnOutboundBallot(t *testing.T) {
 
 		observer := sample.AccAddress()
 		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockGetChainListEmpty(&authorityMock.Mock)
 		slashingMock := keepertest.GetObserverSlashingMock(t, k)
 
 		k.SetChainParamsList(ctx, types.ChainParamsList{
@@ -14,6 +14,9 @@ import (
 
 const (
 	zeroAddress = "0x0000000000000000000000000000000000000000"
+
+	// maxOutboundBatchSize is the maximum number of outbounds that can be batched into one transaction
+	maxOutboundBatchSize = 20
 )
 
 var (
@@ -21,7 +24,7 @@ var (
 	DefaultBallotThreshold       = sdk.MustNewDecFromStr("0.66")
 )
 
-// Validate checks all chain params correspond to a chain and there is no duplicate chain id
+// Validate checks all chain params correspond to a default chain and there is no duplicate chain id
 func (cpl ChainParamsList) Validate() error {
 	// check all chain params correspond to a chain
 	chainMap := make(map[int64]struct{})
@@ -34,7 +37,7 @@ func (cpl ChainParamsList) Validate() error {
 
 	// validate the chain params and check for duplicates
 	for _, chainParam := range cpl.ChainParams {
-		if err := ValidateChainParams(chainParam); err != nil {
+		if err := ValidateChainParams(chainParam, []chains.Chain{}); err != nil {
 			return err
 		}
 
@@ -50,11 +53,12 @@ func (cpl ChainParamsList) Validate() error {
 }
 
 // ValidateChainParams performs some basic checks on chain params
-func ValidateChainParams(params *ChainParams) error {
+// additionalChains is the list of chains supported in addition to the default chains
+func ValidateChainParams(params *ChainParams, additionalChains []chains.Chain) error {
 	if params == nil {
 		return fmt.Errorf("chain params cannot be nil")
 	}
-	chain := chains.GetChainFromChainID(params.ChainId)
+	chain := chains.GetChainFromChainID(params.ChainId, additionalChains)
 	if chain == nil {
 		return fmt.Errorf("ChainId %d not supported", params.ChainId)
 	}
@@ -91,7 +95,7 @@ func ValidateChainParams(params *ChainParams) error {
 	}
 
 	// chain type specific checks
-	if chains.IsBitcoinChain(params.ChainId) {
+	if chain.IsBitcoinChain() {
 		if params.WatchUtxoTicker == 0 || params.WatchUtxoTicker > 300 {
 			return errorsmod.Wrapf(
 				sdkerrors.ErrInvalidRequest,
@@ -99,8 +103,24 @@ func ValidateChainParams(params *ChainParams) error {
 				params.WatchUtxoTicker,
 			)
 		}
+		if params.OutboundBatchSize > maxOutboundBatchSize {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"OutboundBatchSize %d out of range",
+				params.OutboundBatchSize,
+			)
+		}
 	}
-	if chains.IsEVMChain(params.ChainId) {
+	if chain.IsSolanaChain() {
+		if _, err := chains.DecodeSolanaAddress(params.GatewayAddress); err != nil {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"invalid GatewayAddress %s",
+				params.GatewayAddress,
+			)
+		}
+	}
+	if chain.IsEVMChain() {
 		if !validChainContractAddress(params.ZetaTokenContractAddress) {
 			return errorsmod.Wrapf(
 				sdkerrors.ErrInvalidRequest,
@@ -157,6 +177,7 @@ func GetDefaultChainParams() ChainParamsList {
 			GetDefaultBtcTestnetChainParams(),
 			GetDefaultBtcRegtestChainParams(),
 			GetDefaultGoerliLocalnetChainParams(),
+			GetDefaultSolanaLocalnetChainParams(),
 			GetDefaultZetaPrivnetChainParams(),
 		},
 	}
@@ -305,6 +326,7 @@ func GetDefaultBtcRegtestChainParams() *ChainParams {
 		BallotThreshold:             DefaultBallotThreshold,
 		MinObserverDelegation:       DefaultMinObserverDelegation,
 		IsSupported:                 false,
+		MultiOutputDepositHeight:    1,
 	}
 }
 func GetDefaultGoerliLocalnetChainParams() *ChainParams {
@@ -325,6 +347,25 @@ func GetDefaultGoerliLocalnetChainParams() *ChainParams {
 		IsSupported:                 false,
 	}
 }
+func GetDefaultSolanaLocalnetChainParams() *ChainParams {
+	return &ChainParams{
+		ChainId:                     chains.SolanaLocalnet.ChainId,
+		ConfirmationCount:           32,
+		ZetaTokenContractAddress:    zeroAddress,
+		ConnectorContractAddress:    zeroAddress,
+		Erc20CustodyContractAddress: zeroAddress,
+		GasPriceTicker:              5,
+		WatchUtxoTicker:             0,
+		InboundTicker:               2,
+		OutboundTicker:              2,
+		OutboundScheduleInterval:    2,
+		OutboundScheduleLookahead:   5,
+		BallotThreshold:             DefaultBallotThreshold,
+		MinObserverDelegation:       DefaultMinObserverDelegation,
+		IsSupported:                 false,
+		GatewayAddress:              "94U5AHQMKkV5txNJ17QPXWoh474PheGou6cNP2FEuL1d",
+	}
+}
 func GetDefaultZetaPrivnetChainParams() *ChainParams {
 	return &ChainParams{
 		ChainId:                     chains.ZetaChainPrivnet.ChainId,
@@ -359,5 +400,9 @@ func ChainParamsEqual(params1, params2 ChainParams) bool {
 		params1.OutboundScheduleLookahead == params2.OutboundScheduleLookahead &&
 		params1.BallotThreshold.Equal(params2.BallotThreshold) &&
 		params1.MinObserverDelegation.Equal(params2.MinObserverDelegation) &&
-		params1.IsSupported == params2.IsSupported
+		params1.IsSupported == params2.IsSupported &&
+		params1.IsEip1559Enabled == params2.IsEip1559Enabled &&
+		params1.OutboundBatchSize == params2.OutboundBatchSize &&
+		params1.GatewayAddress == params2.GatewayAddress &&
+		params1.MultiOutputDepositHeight == params2.MultiOutputDepositHeight
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	. "gopkg.in/check.v1"
 
+	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
@@ -88,9 +89,9 @@ func (s *UpdateChainParamsSuite) SetupTest() {
 }
 
 func (s *UpdateChainParamsSuite) TestValidParams() {
-	err := types.ValidateChainParams(s.evmParams)
+	err := types.ValidateChainParams(s.evmParams, []chains.Chain{})
 	require.Nil(s.T(), err)
-	err = types.ValidateChainParams(s.btcParams)
+	err = types.ValidateChainParams(s.btcParams, []chains.Chain{})
 	require.Nil(s.T(), err)
 }
 
@@ -102,119 +103,138 @@ func (s *UpdateChainParamsSuite) TestCommonParams() {
 func (s *UpdateChainParamsSuite) TestBTCParams() {
 	copy := *s.btcParams
 	copy.WatchUtxoTicker = 0
-	err := types.ValidateChainParams(&copy)
+	err := types.ValidateChainParams(&copy, []chains.Chain{})
+	require.NotNil(s.T(), err)
+
+	copy = *s.btcParams
+	copy.OutboundBatchSize = 21
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
+	require.NotNil(s.T(), err)
+}
+
+func (s *UpdateChainParamsSuite) TestSolanaParams() {
+	params := types.GetDefaultSolanaLocalnetChainParams()
+	err := types.ValidateChainParams(params, []chains.Chain{})
+	require.Nil(s.T(), err)
+
+	params.GatewayAddress = "0x733aB8b06DDDEf27Eaa72294B0d7c9cEF7f12db9"
+	err = types.ValidateChainParams(params, []chains.Chain{})
+	require.NotNil(s.T(), err)
+
+	params.GatewayAddress = ""
+	err = types.ValidateChainParams(params, []chains.Chain{})
 	require.NotNil(s.T(), err)
 }
 
 func (s *UpdateChainParamsSuite) TestCoreContractAddresses() {
 	copy := *s.evmParams
 	copy.ZetaTokenContractAddress = "0x123"
-	err := types.ValidateChainParams(&copy)
+	err := types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 
 	copy = *s.evmParams
 	copy.ZetaTokenContractAddress = "733aB8b06DDDEf27Eaa72294B0d7c9cEF7f12db9"
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 
 	copy = *s.evmParams
 	copy.ConnectorContractAddress = "0x123"
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 
 	copy = *s.evmParams
 	copy.ConnectorContractAddress = "733aB8b06DDDEf27Eaa72294B0d7c9cEF7f12db9"
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 
 	copy = *s.evmParams
 	copy.Erc20CustodyContractAddress = "0x123"
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 
 	copy = *s.evmParams
 	copy.Erc20CustodyContractAddress = "733aB8b06DDDEf27Eaa72294B0d7c9cEF7f12db9"
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 }
 
 func (s *UpdateChainParamsSuite) Validate(params *types.ChainParams) {
 	copy := *params
 	copy.ConfirmationCount = 0
-	err := types.ValidateChainParams(&copy)
+	err := types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 
 	copy = *params
 	copy.GasPriceTicker = 0
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 	copy.GasPriceTicker = 300
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.Nil(s.T(), err)
 	copy.GasPriceTicker = 301
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 
 	copy = *params
 	copy.InboundTicker = 0
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 	copy.InboundTicker = 300
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.Nil(s.T(), err)
 	copy.InboundTicker = 301
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 
 	copy = *params
 	copy.OutboundTicker = 0
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 	copy.OutboundTicker = 300
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.Nil(s.T(), err)
 	copy.OutboundTicker = 301
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 
 	copy = *params
 	copy.OutboundScheduleInterval = 0
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 	copy.OutboundScheduleInterval = 100
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.Nil(s.T(), err)
 	copy.OutboundScheduleInterval = 101
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 
 	copy = *params
 	copy.OutboundScheduleLookahead = 0
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 	copy.OutboundScheduleLookahead = 500
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.Nil(s.T(), err)
 	copy.OutboundScheduleLookahead = 501
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 
 	copy = *params
 	copy.BallotThreshold = sdk.Dec{}
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 	copy.BallotThreshold = sdk.MustNewDecFromStr("1.2")
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 	copy.BallotThreshold = sdk.MustNewDecFromStr("0.9")
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.Nil(s.T(), err)
 
 	copy = *params
 	copy.MinObserverDelegation = sdk.Dec{}
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.NotNil(s.T(), err)
 	copy.MinObserverDelegation = sdk.MustNewDecFromStr("0.9")
-	err = types.ValidateChainParams(&copy)
+	err = types.ValidateChainParams(&copy, []chains.Chain{})
 	require.Nil(s.T(), err)
 }
@@ -22,14 +22,14 @@ func TestMsgRemoveChainParams_ValidateBasic(t *testing.T) {
 			name: "valid message",
 			msg: types.NewMsgRemoveChainParams(
 				sample.AccAddress(),
-				chains.ExternalChainList()[0].ChainId,
+				chains.ExternalChainList([]chains.Chain{})[0].ChainId,
 			),
 		},
 		{
 			name: "invalid address",
 			msg: types.NewMsgRemoveChainParams(
 				"invalid_address",
-				chains.ExternalChainList()[0].ChainId,
+				chains.ExternalChainList([]chains.Chain{})[0].ChainId,
 			),
 			err: sdkerrors.ErrInvalidAddress,
 		},
@@ -38,7 +38,7 @@ func TestMsgRemoveChainParams_ValidateBasic(t *testing.T) {
 			name: "invalid chain ID",
 			msg: types.NewMsgRemoveChainParams(
 				sample.AccAddress(),
-				999,
+				-1,
 			),
 			err: sdkerrors.ErrInvalidChainID,
 		},
@@ -6,8 +6,6 @@ import (
 	cosmoserrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-
-	"github.com/zeta-chain/zetacore/pkg/chains"
 )
 
 const TypeMsgResetChainNonces = "reset_chain_nonces"
@@ -55,9 +53,8 @@ func (msg *MsgResetChainNonces) ValidateBasic() error {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
 
-	// Check if chain exists
-	chain := chains.GetChainFromChainID(msg.ChainId)
-	if chain == nil {
+	// the chain can be added by the authority chain info, its existence is checked by the message server
+	if msg.ChainId <= 0 {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidChainID, "invalid chain id (%d)", msg.ChainId)
 	}
 
@@ -21,7 +21,7 @@ func TestMsgResetChainNonces_ValidateBasic(t *testing.T) {
 			name: "valid message chain nonce high greater than nonce low",
 			msg: types.MsgResetChainNonces{
 				Creator:        sample.AccAddress(),
-				ChainId:        chains.ExternalChainList()[0].ChainId,
+				ChainId:        chains.ExternalChainList([]chains.Chain{})[0].ChainId,
 				ChainNonceLow:  1,
 				ChainNonceHigh: 5,
 			},
@@ -31,7 +31,7 @@ func TestMsgResetChainNonces_ValidateBasic(t *testing.T) {
 			name: "valid message chain nonce high same as nonce low",
 			msg: types.MsgResetChainNonces{
 				Creator:        sample.AccAddress(),
-				ChainId:        chains.ExternalChainList()[0].ChainId,
+				ChainId:        chains.ExternalChainList([]chains.Chain{})[0].ChainId,
 				ChainNonceLow:  1,
 				ChainNonceHigh: 1,
 			},
@@ -41,7 +41,7 @@ func TestMsgResetChainNonces_ValidateBasic(t *testing.T) {
 			name: "invalid address",
 			msg: types.MsgResetChainNonces{
 				Creator: "invalid_address",
-				ChainId: chains.ExternalChainList()[0].ChainId,
+				ChainId: chains.ExternalChainList([]chains.Chain{})[0].ChainId,
 			},
 			wantErr: true,
 		},
@@ -49,7 +49,7 @@ func TestMsgResetChainNonces_ValidateBasic(t *testing.T) {
 			name: "invalid chain ID",
 			msg: types.MsgResetChainNonces{
 				Creator: sample.AccAddress(),
-				ChainId: 999,
+				ChainId: -1,
 			},
 			wantErr: true,
 		},
@@ -57,7 +57,7 @@ func TestMsgResetChainNonces_ValidateBasic(t *testing.T) {
 			name: "invalid chain nonce low",
 			msg: types.MsgResetChainNonces{
 				Creator:       sample.AccAddress(),
-				ChainId:       chains.ExternalChainList()[0].ChainId,
+				ChainId:       chains.ExternalChainList([]chains.Chain{})[0].ChainId,
 				ChainNonceLow: -1,
 			},
 			wantErr: true,
@@ -66,7 +66,7 @@ func TestMsgResetChainNonces_ValidateBasic(t *testing.T) {
 			name: "invalid chain nonce high",
 			msg: types.MsgResetChainNonces{
 				Creator:        sample.AccAddress(),
-				ChainId:        chains.ExternalChainList()[0].ChainId,
+				ChainId:        chains.ExternalChainList([]chains.Chain{})[0].ChainId,
 				ChainNonceLow:  1,
 				ChainNonceHigh: -1,
 			},
@@ -76,7 +76,7 @@ func TestMsgResetChainNonces_ValidateBasic(t *testing.T) {
 			name: "invalid chain nonce low greater than chain nonce high",
 			msg: types.MsgResetChainNonces{
 				Creator:        sample.AccAddress(),
-				ChainId:        chains.ExternalChainList()[0].ChainId,
+				ChainId:        chains.ExternalChainList([]chains.Chain{})[0].ChainId,
 				ChainNonceLow:  1,
 				ChainNonceHigh: 0,
 			},
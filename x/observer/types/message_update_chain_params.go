@@ -44,8 +44,9 @@ func (msg *MsgUpdateChainParams) ValidateBasic() error {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
 
-	if err := ValidateChainParams(msg.ChainParams); err != nil {
-		return cosmoserrors.Wrapf(ErrInvalidChainParams, err.Error())
+	// the chain params are validated against the supported chains by the message server
+	if msg.ChainParams == nil {
+		return cosmoserrors.Wrapf(ErrInvalidChainParams, "chain params cannot be nil")
 	}
 
 	return nil
@@ -22,14 +22,14 @@ func TestMsgUpdateChainParams_ValidateBasic(t *testing.T) {
 			name: "valid message",
 			msg: types.NewMsgUpdateChainParams(
 				sample.AccAddress(),
-				sample.ChainParams(chains.ExternalChainList()[0].ChainId),
+				sample.ChainParams(chains.ExternalChainList([]chains.Chain{})[0].ChainId),
 			),
 		},
 		{
 			name: "invalid address",
 			msg: types.NewMsgUpdateChainParams(
 				"invalid_address",
-				sample.ChainParams(chains.ExternalChainList()[0].ChainId),
+				sample.ChainParams(chains.ExternalChainList([]chains.Chain{})[0].ChainId),
 			),
 			err: sdkerrors.ErrInvalidAddress,
 		},
@@ -59,7 +59,8 @@ func (msg *MsgVoteBlockHeader) ValidateBasic() error {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, err.Error())
 	}
 
-	if !chains.IsHeaderSupportedChain(msg.ChainId) {
+	// block header verification is only supported for the default chains
+	if !chains.IsHeaderSupportedChain(msg.ChainId, []chains.Chain{}) {
 		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid chain id (%d)", msg.ChainId)
 	}
 
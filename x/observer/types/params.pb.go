@@ -84,6 +84,19 @@ type ChainParams struct {
 	BallotThreshold             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=ballot_threshold,json=ballotThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ballot_threshold"`
 	MinObserverDelegation       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=min_observer_delegation,json=minObserverDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_observer_delegation"`
 	IsSupported                 bool                                   `protobuf:"varint,16,opt,name=is_supported,json=isSupported,proto3" json:"is_supported,omitempty"`
+	// is_eip1559_enabled selects EIP-1559 dynamic fee transactions for outbounds
+	// on EVM chains, legacy transactions are used otherwise
+	IsEip1559Enabled bool `protobuf:"varint,17,opt,name=is_eip1559_enabled,json=isEip1559Enabled,proto3" json:"is_eip1559_enabled,omitempty"`
+	// outbound_batch_size is the maximum number of outbounds paid by a single
+	// transaction on Bitcoin chains, 0 or 1 means no batching
+	OutboundBatchSize uint64 `protobuf:"varint,18,opt,name=outbound_batch_size,json=outboundBatchSize,proto3" json:"outbound_batch_size,omitempty"`
+	// gateway_address is the address of the gateway program on Solana chains
+	GatewayAddress string `protobuf:"bytes,19,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
+	// multi_output_deposit_height is the Bitcoin block height from which the
+	// deposits are detected regardless of the output order and credited with the
+	// value of all the outputs paying the TSS address, 0 keeps detecting only the
+	// deposits paid by the 1st output with the memo in the 2nd output
+	MultiOutputDepositHeight uint64 `protobuf:"varint,20,opt,name=multi_output_deposit_height,json=multiOutputDepositHeight,proto3" json:"multi_output_deposit_height,omitempty"`
 }
 
 func (m *ChainParams) Reset()         { *m = ChainParams{} }
@@ -203,6 +216,34 @@ func (m *ChainParams) GetIsSupported() bool {
 	return false
 }
 
+func (m *ChainParams) GetIsEip1559Enabled() bool {
+	if m != nil {
+		return m.IsEip1559Enabled
+	}
+	return false
+}
+
+func (m *ChainParams) GetOutboundBatchSize() uint64 {
+	if m != nil {
+		return m.OutboundBatchSize
+	}
+	return 0
+}
+
+func (m *ChainParams) GetGatewayAddress() string {
+	if m != nil {
+		return m.GatewayAddress
+	}
+	return ""
+}
+
+func (m *ChainParams) GetMultiOutputDepositHeight() uint64 {
+	if m != nil {
+		return m.MultiOutputDepositHeight
+	}
+	return 0
+}
+
 // Deprecated(v17)
 type Params struct {
 	// Deprecated(v17):Moved into the emissions module
@@ -260,47 +301,53 @@ func init() {
 }
 
 var fileDescriptor_e7fa4666eddf88e5 = []byte{
-	// 626 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x4e, 0xd4, 0x40,
-	0x18, 0xc7, 0xb7, 0x2e, 0x22, 0xcc, 0x02, 0x0b, 0x0d, 0x6a, 0x81, 0xa4, 0xac, 0x24, 0x6a, 0x43,
-	0x42, 0x6b, 0xd0, 0xa3, 0x92, 0xc8, 0x72, 0x21, 0x62, 0x24, 0x05, 0x0f, 0x7a, 0x70, 0x32, 0x3b,
-	0x1d, 0xda, 0xc9, 0xb6, 0xfd, 0x9a, 0x99, 0x29, 0x82, 0x4f, 0xe1, 0x3b, 0xf8, 0x32, 0x1c, 0x39,
-	0x1a, 0x0f, 0xc4, 0xc0, 0x8b, 0x98, 0x4e, 0xa7, 0xcb, 0x0a, 0x86, 0x83, 0xa7, 0xce, 0x7c, 0xff,
-	0xdf, 0xff, 0xbf, 0xdf, 0x6c, 0xbf, 0x0e, 0xf2, 0xbe, 0x31, 0x45, 0x68, 0x42, 0x78, 0x1e, 0xe8,
-	0x15, 0x08, 0x16, 0xc0, 0x40, 0x32, 0x71, 0xcc, 0x44, 0x50, 0x10, 0x41, 0x32, 0xe9, 0x17, 0x02,
-	0x14, 0xd8, 0x2b, 0x23, 0xd2, 0x6f, 0x48, 0xbf, 0x21, 0x97, 0x17, 0x63, 0x88, 0x41, 0x73, 0x41,
-	0xb5, 0xaa, 0x2d, 0xcb, 0xeb, 0x77, 0x85, 0x37, 0x8b, 0x3b, 0xd8, 0x62, 0x18, 0x07, 0xba, 0x24,
-	0xcd, 0xa3, 0x66, 0xd7, 0xbe, 0xa0, 0x6e, 0xbf, 0xda, 0xef, 0xeb, 0xfe, 0xf6, 0xb8, 0x54, 0xf6,
-	0x3b, 0x34, 0xa3, 0x11, 0x5c, 0xf7, 0xec, 0x58, 0xbd, 0xb6, 0xd7, 0xd9, 0xf4, 0xfc, 0x3b, 0x9a,
-	0xf6, 0xc7, 0x32, 0xc2, 0x0e, 0xbd, 0xde, 0xac, 0xfd, 0x98, 0x44, 0x9d, 0x31, 0xd1, 0x5e, 0x42,
-	0x53, 0x75, 0x38, 0x8f, 0x9c, 0x4e, 0xcf, 0xf2, 0xda, 0xe1, 0x03, 0xbd, 0xdf, 0x8d, 0xec, 0x0d,
-	0x64, 0x53, 0xc8, 0x8f, 0xb8, 0xc8, 0x88, 0xe2, 0x90, 0x63, 0x0a, 0x65, 0xae, 0x1c, 0xab, 0x67,
-	0x79, 0x13, 0xe1, 0xc2, 0xb8, 0xd2, 0xaf, 0x04, 0xdb, 0x43, 0xf3, 0x31, 0x91, 0xb8, 0x10, 0x9c,
-	0x32, 0xac, 0x38, 0x1d, 0x32, 0xe1, 0xdc, 0xd3, 0xf0, 0x5c, 0x4c, 0xe4, 0x7e, 0x55, 0x3e, 0xd4,
-	0x55, 0xfb, 0x29, 0x9a, 0xe3, 0xf9, 0x00, 0xca, 0x3c, 0x6a, 0xb8, 0xb6, 0xe6, 0x66, 0x4d, 0xd5,
-	0x60, 0xcf, 0x51, 0x17, 0x4a, 0xf5, 0x17, 0x37, 0x51, 0xe7, 0x35, 0x65, 0x03, 0xae, 0xa3, 0x85,
-	0xaf, 0x44, 0xd1, 0x04, 0x97, 0xea, 0x04, 0x1a, 0xf4, 0xbe, 0x46, 0xbb, 0x5a, 0xf8, 0xa8, 0x4e,
-	0xc0, 0xb0, 0x6f, 0x90, 0x7e, 0xd9, 0x58, 0xc1, 0x90, 0x55, 0x47, 0xca, 0x95, 0x20, 0x54, 0x61,
-	0x12, 0x45, 0x82, 0x49, 0xe9, 0x4c, 0xf5, 0x2c, 0x6f, 0x3a, 0x74, 0x2a, 0xe4, 0xb0, 0x22, 0xfa,
-	0x06, 0x78, 0x5b, 0xeb, 0xf6, 0x6b, 0xb4, 0x4c, 0x21, 0xcf, 0x19, 0x55, 0x20, 0x6e, 0xbb, 0xa7,
-	0x6b, 0xf7, 0x88, 0xb8, 0xe9, 0xee, 0x23, 0x97, 0x09, 0xba, 0xf9, 0x02, 0xd3, 0x52, 0x2a, 0x88,
-	0x4e, 0x6f, 0x27, 0x20, 0x9d, 0xb0, 0xa2, 0xa9, 0x7e, 0x0d, 0xfd, 0xa3, 0x85, 0xd1, 0xdf, 0x22,
-	0x69, 0xc2, 0xa2, 0x32, 0x65, 0x98, 0xe7, 0x8a, 0x89, 0x63, 0x92, 0x3a, 0x33, 0xfa, 0x1d, 0x3a,
-	0x0d, 0x71, 0x60, 0x80, 0x5d, 0xa3, 0xdb, 0x5b, 0x68, 0xe5, 0xb6, 0x3b, 0x05, 0x18, 0x92, 0x84,
-	0x91, 0xc8, 0x99, 0xd5, 0xf6, 0xa5, 0x9b, 0xf6, 0xbd, 0x06, 0xb0, 0x3f, 0xa1, 0xf9, 0x01, 0x49,
-	0x53, 0x50, 0x58, 0x25, 0x82, 0xc9, 0x04, 0xd2, 0xc8, 0x99, 0xab, 0x9a, 0xde, 0xf6, 0xcf, 0x2e,
-	0x56, 0x5b, 0xbf, 0x2e, 0x56, 0x9f, 0xc5, 0x5c, 0x25, 0xe5, 0xc0, 0xa7, 0x90, 0x05, 0x14, 0x64,
-	0x06, 0xd2, 0x3c, 0x36, 0x64, 0x34, 0x0c, 0xd4, 0x69, 0xc1, 0xa4, 0xbf, 0xc3, 0x68, 0xd8, 0xad,
-	0x73, 0x0e, 0x9b, 0x18, 0xfb, 0x08, 0x3d, 0xce, 0x78, 0x8e, 0x9b, 0x19, 0xc6, 0x11, 0x4b, 0x59,
-	0xac, 0x07, 0xcc, 0xe9, 0xfe, 0xd7, 0x2f, 0x3c, 0xcc, 0x78, 0xfe, 0xc1, 0xa4, 0xed, 0x8c, 0xc2,
-	0xec, 0x27, 0x68, 0x86, 0x4b, 0x2c, 0xcb, 0xa2, 0x00, 0xa1, 0x58, 0xe4, 0xcc, 0xf7, 0x2c, 0x6f,
-	0x2a, 0xec, 0x70, 0x79, 0xd0, 0x94, 0xd6, 0xb6, 0xd0, 0xa4, 0xf9, 0x3e, 0x5e, 0xa1, 0x47, 0xe6,
-	0xbc, 0x19, 0x51, 0xa5, 0xe0, 0xea, 0x14, 0x0f, 0x52, 0xa0, 0x43, 0xa9, 0x67, 0xb6, 0x1d, 0x2e,
-	0xd6, 0xea, 0x7b, 0x23, 0x6e, 0x6b, 0x6d, 0x7b, 0xf7, 0xec, 0xd2, 0xb5, 0xce, 0x2f, 0x5d, 0xeb,
-	0xf7, 0xa5, 0x6b, 0x7d, 0xbf, 0x72, 0x5b, 0xe7, 0x57, 0x6e, 0xeb, 0xe7, 0x95, 0xdb, 0xfa, 0x1c,
-	0x8c, 0xf5, 0x5e, 0x4d, 0xd9, 0xc6, 0x8d, 0x7b, 0xe1, 0xe4, 0xfa, 0x16, 0xd1, 0x07, 0x19, 0x4c,
-	0xea, 0x7b, 0xe1, 0xe5, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x11, 0xb3, 0xd5, 0xce, 0x04,
-	0x00, 0x00,
+	// 732 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x4e, 0xec, 0x36,
+	0x14, 0xc6, 0x27, 0x1d, 0xca, 0x1f, 0x0f, 0xcc, 0x0c, 0x86, 0xb6, 0x86, 0x91, 0x86, 0x29, 0x52,
+	0xdb, 0x08, 0x95, 0xa4, 0xa5, 0x65, 0x51, 0xa9, 0x20, 0x75, 0x06, 0xa4, 0xa2, 0x52, 0x81, 0x06,
+	0xba, 0x68, 0x17, 0xb5, 0x1c, 0xc7, 0x24, 0xd6, 0x24, 0x71, 0x64, 0x3b, 0xc0, 0xf0, 0x14, 0x7d,
+	0x2c, 0x96, 0x2c, 0xab, 0xbb, 0x40, 0x57, 0xb0, 0xba, 0x6f, 0x71, 0x15, 0x27, 0x19, 0xe6, 0xc2,
+	0x15, 0x8b, 0xbb, 0x8a, 0x7d, 0xbe, 0xdf, 0xf9, 0x72, 0x92, 0xe3, 0x63, 0x60, 0xdf, 0x30, 0x4d,
+	0x68, 0x48, 0x78, 0xe2, 0x9a, 0x95, 0x90, 0xcc, 0x15, 0x9e, 0x62, 0xf2, 0x92, 0x49, 0x37, 0x25,
+	0x92, 0xc4, 0xca, 0x49, 0xa5, 0xd0, 0x02, 0x76, 0x26, 0xa4, 0x53, 0x91, 0x4e, 0x45, 0xae, 0xaf,
+	0x06, 0x22, 0x10, 0x86, 0x73, 0xf3, 0x55, 0x91, 0xb2, 0xbe, 0xf5, 0x9a, 0x79, 0xb5, 0x78, 0x85,
+	0x4d, 0x47, 0x81, 0x6b, 0x42, 0xaa, 0x7c, 0x14, 0xec, 0xe6, 0xbf, 0xa0, 0x35, 0xc8, 0xf7, 0xa7,
+	0xa6, 0xbe, 0x63, 0xae, 0x34, 0xfc, 0x03, 0x2c, 0x1a, 0x04, 0x17, 0x35, 0x23, 0xab, 0x57, 0xb7,
+	0x1b, 0x3b, 0xb6, 0xf3, 0x4a, 0xd1, 0xce, 0x94, 0xc7, 0xb0, 0x41, 0x9f, 0x36, 0x9b, 0xef, 0xe6,
+	0x40, 0x63, 0x4a, 0x84, 0x6b, 0x60, 0xbe, 0x30, 0xe7, 0x3e, 0x6a, 0xf4, 0x2c, 0xbb, 0x3e, 0x9c,
+	0x33, 0xfb, 0x23, 0x1f, 0x6e, 0x03, 0x48, 0x45, 0x72, 0xc1, 0x65, 0x4c, 0x34, 0x17, 0x09, 0xa6,
+	0x22, 0x4b, 0x34, 0xb2, 0x7a, 0x96, 0x3d, 0x33, 0x5c, 0x9e, 0x56, 0x06, 0xb9, 0x00, 0x6d, 0xd0,
+	0x0e, 0x88, 0xc2, 0xa9, 0xe4, 0x94, 0x61, 0xcd, 0xe9, 0x88, 0x49, 0xf4, 0x99, 0x81, 0x9b, 0x01,
+	0x51, 0xa7, 0x79, 0xf8, 0xdc, 0x44, 0xe1, 0x37, 0xa0, 0xc9, 0x13, 0x4f, 0x64, 0x89, 0x5f, 0x71,
+	0x75, 0xc3, 0x2d, 0x95, 0xd1, 0x12, 0xfb, 0x0e, 0xb4, 0x44, 0xa6, 0x3f, 0xe0, 0x66, 0x0a, 0xbf,
+	0x2a, 0x5c, 0x82, 0x5b, 0x60, 0xf9, 0x8a, 0x68, 0x1a, 0xe2, 0x4c, 0x5f, 0x8b, 0x0a, 0xfd, 0xdc,
+	0xa0, 0x2d, 0x23, 0xfc, 0xa5, 0xaf, 0x45, 0xc9, 0xee, 0x01, 0xd3, 0x6c, 0xac, 0xc5, 0x88, 0xe5,
+	0x9f, 0x94, 0x68, 0x49, 0xa8, 0xc6, 0xc4, 0xf7, 0x25, 0x53, 0x0a, 0xcd, 0xf7, 0x2c, 0x7b, 0x61,
+	0x88, 0x72, 0xe4, 0x3c, 0x27, 0x06, 0x25, 0xf0, 0x5b, 0xa1, 0xc3, 0x5f, 0xc1, 0x3a, 0x15, 0x49,
+	0xc2, 0xa8, 0x16, 0xf2, 0x65, 0xf6, 0x42, 0x91, 0x3d, 0x21, 0x9e, 0x67, 0x0f, 0x40, 0x97, 0x49,
+	0xba, 0xf3, 0x03, 0xa6, 0x99, 0xd2, 0xc2, 0x1f, 0xbf, 0x74, 0x00, 0xc6, 0xa1, 0x63, 0xa8, 0x41,
+	0x01, 0x7d, 0xa4, 0x84, 0xc9, 0x6f, 0x51, 0x34, 0x64, 0x7e, 0x16, 0x31, 0xcc, 0x13, 0xcd, 0xe4,
+	0x25, 0x89, 0xd0, 0xa2, 0xe9, 0x21, 0xaa, 0x88, 0xb3, 0x12, 0x38, 0x2a, 0x75, 0xb8, 0x0f, 0x3a,
+	0x2f, 0xb3, 0x23, 0x21, 0x46, 0x24, 0x64, 0xc4, 0x47, 0x4b, 0x26, 0x7d, 0xed, 0x79, 0xfa, 0x71,
+	0x05, 0xc0, 0xbf, 0x41, 0xdb, 0x23, 0x51, 0x24, 0x34, 0xd6, 0xa1, 0x64, 0x2a, 0x14, 0x91, 0x8f,
+	0x9a, 0x79, 0xd1, 0x7d, 0xe7, 0xf6, 0x7e, 0xa3, 0xf6, 0xe6, 0x7e, 0xe3, 0xdb, 0x80, 0xeb, 0x30,
+	0xf3, 0x1c, 0x2a, 0x62, 0x97, 0x0a, 0x15, 0x0b, 0x55, 0x3e, 0xb6, 0x95, 0x3f, 0x72, 0xf5, 0x38,
+	0x65, 0xca, 0x39, 0x60, 0x74, 0xd8, 0x2a, 0x7c, 0xce, 0x2b, 0x1b, 0x78, 0x01, 0xbe, 0x8a, 0x79,
+	0x82, 0xab, 0x33, 0x8c, 0x7d, 0x16, 0xb1, 0xc0, 0x1c, 0x30, 0xd4, 0xfa, 0xa4, 0x37, 0x7c, 0x11,
+	0xf3, 0xe4, 0xa4, 0x74, 0x3b, 0x98, 0x98, 0xc1, 0xaf, 0xc1, 0x22, 0x57, 0x58, 0x65, 0x69, 0x2a,
+	0xa4, 0x66, 0x3e, 0x6a, 0xf7, 0x2c, 0x7b, 0x7e, 0xd8, 0xe0, 0xea, 0xac, 0x0a, 0xc1, 0xef, 0x01,
+	0xe4, 0x0a, 0x33, 0x9e, 0xfe, 0xb8, 0xbb, 0xfb, 0x0b, 0x66, 0x09, 0xf1, 0x22, 0xe6, 0xa3, 0x65,
+	0x03, 0xb6, 0xb9, 0x3a, 0x2c, 0x84, 0xc3, 0x22, 0x0e, 0x1d, 0xb0, 0x32, 0xf9, 0xa7, 0x9e, 0x39,
+	0x88, 0x8a, 0xdf, 0x30, 0x04, 0x8b, 0x49, 0xa9, 0xa4, 0x7e, 0xae, 0x9c, 0xf1, 0x1b, 0x96, 0x1f,
+	0xec, 0x80, 0x68, 0x76, 0x45, 0xc6, 0x93, 0xbe, 0xaf, 0x98, 0xbe, 0x37, 0xcb, 0x70, 0xd5, 0xea,
+	0x3d, 0xd0, 0x89, 0xb3, 0x48, 0x73, 0x2c, 0x32, 0x9d, 0x66, 0x1a, 0xfb, 0x2c, 0x15, 0x8a, 0x6b,
+	0x1c, 0x32, 0x1e, 0x84, 0x1a, 0xad, 0x9a, 0x17, 0x20, 0x83, 0x9c, 0x18, 0xe2, 0xa0, 0x00, 0x7e,
+	0x37, 0xfa, 0xe6, 0x3e, 0x98, 0x2d, 0xa7, 0xfc, 0x67, 0xf0, 0x65, 0xd9, 0xb5, 0x98, 0xe8, 0x4c,
+	0x72, 0x3d, 0xc6, 0x5e, 0x24, 0xe8, 0x48, 0x99, 0xc9, 0xab, 0x0f, 0x57, 0x0b, 0xf5, 0xcf, 0x52,
+	0xec, 0x1b, 0xad, 0x7f, 0x74, 0xfb, 0xd0, 0xb5, 0xee, 0x1e, 0xba, 0xd6, 0xdb, 0x87, 0xae, 0xf5,
+	0xdf, 0x63, 0xb7, 0x76, 0xf7, 0xd8, 0xad, 0xfd, 0xff, 0xd8, 0xad, 0xfd, 0xe3, 0x4e, 0x75, 0x20,
+	0x9f, 0x95, 0xed, 0x67, 0xb7, 0xdb, 0xf5, 0xd3, 0x5d, 0x68, 0xda, 0xe1, 0xcd, 0x9a, 0xdb, 0xed,
+	0xa7, 0xf7, 0x03, 0x00, 0x75, 0xf8, 0xdf, 0x5d, 0x94, 0x05, 0x00, 0x00,
 }
 
 func (m *ChainParamsList) Marshal() (dAtA []byte, err error) {
@@ -360,6 +407,41 @@ func (m *ChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MultiOutputDepositHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MultiOutputDepositHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.GatewayAddress) > 0 {
+		i -= len(m.GatewayAddress)
+		copy(dAtA[i:], m.GatewayAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.GatewayAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.OutboundBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.OutboundBatchSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.IsEip1559Enabled {
+		i--
+		if m.IsEip1559Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.IsSupported {
 		i--
 		if m.IsSupported {
@@ -559,6 +641,19 @@ func (m *ChainParams) Size() (n int) {
 	if m.IsSupported {
 		n += 3
 	}
+	if m.IsEip1559Enabled {
+		n += 3
+	}
+	if m.OutboundBatchSize != 0 {
+		n += 2 + sovParams(uint64(m.OutboundBatchSize))
+	}
+	l = len(m.GatewayAddress)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.MultiOutputDepositHeight != 0 {
+		n += 2 + sovParams(uint64(m.MultiOutputDepositHeight))
+	}
 	return n
 }
 
@@ -1029,6 +1124,96 @@ func (m *ChainParams) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsSupported = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEip1559Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEip1559Enabled = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundBatchSize", wireType)
+			}
+			m.OutboundBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutboundBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiOutputDepositHeight", wireType)
+			}
+			m.MultiOutputDepositHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MultiOutputDepositHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -34,6 +34,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type QueryGetChainNoncesRequest struct {
 	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// chain_id is the id of the chain of the nonces, used if index is empty
+	ChainId int64 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (m *QueryGetChainNoncesRequest) Reset()         { *m = QueryGetChainNoncesRequest{} }
@@ -76,6 +78,13 @@ func (m *QueryGetChainNoncesRequest) GetIndex() string {
 	return ""
 }
 
+func (m *QueryGetChainNoncesRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
 type QueryGetChainNoncesResponse struct {
 	ChainNonces ChainNonces `protobuf:"bytes,1,opt,name=ChainNonces,proto3" json:"ChainNonces"`
 }
@@ -2129,140 +2138,141 @@ func init() {
 }
 
 var fileDescriptor_25b2aa420449a0c0 = []byte{
-	// 2128 bytes of a gzipped FileDescriptorProto
+	// 2143 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcf, 0x6f, 0x1b, 0xc7,
-	0x15, 0xf6, 0x4a, 0x89, 0x22, 0x3d, 0xd9, 0x92, 0x3c, 0x96, 0x1d, 0x87, 0x76, 0x64, 0x79, 0x65,
-	0xc7, 0xb2, 0x62, 0x73, 0x6d, 0x3a, 0xa9, 0x7f, 0xc7, 0x16, 0xdd, 0x58, 0xb2, 0x93, 0xda, 0x0e,
-	0xa9, 0x36, 0x80, 0x91, 0x96, 0x5d, 0x92, 0x43, 0x72, 0xeb, 0xd5, 0x0e, 0xb3, 0x33, 0x72, 0xc2,
-	0xa8, 0x02, 0x8a, 0x1e, 0x73, 0x2a, 0x50, 0xa0, 0xbd, 0x15, 0xbd, 0xf4, 0x58, 0xa0, 0x08, 0x50,
-	0xb4, 0x40, 0xd1, 0x43, 0x4e, 0xcd, 0xa1, 0x87, 0x14, 0x05, 0x8a, 0x9e, 0xda, 0xc0, 0xee, 0xff,
-	0xd1, 0x62, 0x67, 0xde, 0x92, 0xbb, 0xcb, 0xe5, 0x72, 0x28, 0xab, 0x27, 0xee, 0xce, 0xbe, 0xf7,
-	0xe6, 0xfb, 0xde, 0xce, 0xcc, 0xfb, 0x66, 0x87, 0x70, 0xe6, 0x33, 0x2a, 0xec, 0x5a, 0xcb, 0x76,
-	0x3c, 0x4b, 0x5e, 0x31, 0x9f, 0x5a, 0xac, 0xca, 0xa9, 0xff, 0x94, 0xfa, 0xd6, 0xc7, 0x5b, 0xd4,
-	0xef, 0xe4, 0xdb, 0x3e, 0x13, 0x8c, 0x1c, 0xeb, 0x1a, 0xe6, 0x43, 0xc3, 0x7c, 0x68, 0x98, 0x5b,
-	0xa9, 0x31, 0xbe, 0xc9, 0xb8, 0x55, 0xb5, 0x39, 0x55, 0x5e, 0xd6, 0xd3, 0x8b, 0x55, 0x2a, 0xec,
-	0x8b, 0x56, 0xdb, 0x6e, 0x3a, 0x9e, 0x2d, 0x1c, 0xe6, 0xa9, 0x40, 0xb9, 0xf9, 0x26, 0x6b, 0x32,
-	0x79, 0x69, 0x05, 0x57, 0xd8, 0x7a, 0xbc, 0xc9, 0x58, 0xd3, 0xa5, 0x96, 0xdd, 0x76, 0x2c, 0xdb,
-	0xf3, 0x98, 0x90, 0x2e, 0x1c, 0x9f, 0x2e, 0x67, 0xa1, 0xac, 0xda, 0xae, 0xcb, 0x04, 0x5a, 0x66,
-	0xf2, 0xa9, 0xba, 0xf6, 0x26, 0x45, 0xc3, 0x7c, 0x96, 0xa1, 0x6c, 0xaf, 0x78, 0xcc, 0xab, 0xd1,
-	0x10, 0x42, 0x21, 0xd3, 0xde, 0x67, 0x9c, 0x2b, 0xa7, 0x86, 0x6b, 0x37, 0xb5, 0x60, 0x3f, 0xa1,
-	0x9d, 0x26, 0xf5, 0x74, 0xd0, 0x78, 0xac, 0x4e, 0x2b, 0x76, 0xad, 0xc6, 0xb6, 0xbc, 0x90, 0xe6,
-	0x4a, 0x96, 0x7d, 0x78, 0xa1, 0x83, 0xa2, 0x6d, 0xfb, 0xf6, 0x66, 0x88, 0xf7, 0x42, 0xa6, 0x25,
-	0xf5, 0xea, 0x8e, 0xd7, 0x8c, 0x67, 0xe5, 0x74, 0x96, 0x87, 0xe0, 0x3c, 0x03, 0x6e, 0xfb, 0x49,
-	0x53, 0xe5, 0x99, 0xe3, 0xcf, 0x10, 0xdb, 0xb6, 0xcf, 0x58, 0x83, 0xe3, 0x8f, 0xb2, 0x35, 0x0b,
-	0x90, 0xfb, 0x20, 0x18, 0x6d, 0x6b, 0x54, 0xdc, 0x09, 0x3c, 0x1e, 0x48, 0x6c, 0x25, 0xfa, 0xf1,
-	0x16, 0xe5, 0x82, 0xcc, 0xc3, 0xcb, 0x8e, 0x57, 0xa7, 0x9f, 0x1e, 0x35, 0x16, 0x8d, 0xe5, 0xa9,
-	0x92, 0xba, 0x31, 0x19, 0x1c, 0x4b, 0xf5, 0xe1, 0x6d, 0xe6, 0x71, 0x4a, 0x1e, 0xc1, 0x74, 0xa4,
-	0x59, 0xba, 0x4e, 0x17, 0x96, 0xf3, 0x19, 0xa3, 0x3f, 0x1f, 0xb1, 0x2f, 0xbe, 0xf4, 0xd5, 0xbf,
-	0x4e, 0xec, 0x2b, 0x45, 0x43, 0x98, 0x75, 0x04, 0xb9, 0xea, 0xba, 0x29, 0x20, 0xef, 0x02, 0xf4,
-	0xa6, 0x08, 0x76, 0xf7, 0x46, 0x5e, 0xcd, 0xa7, 0x7c, 0x30, 0x9f, 0xf2, 0x6a, 0x16, 0xe2, 0x7c,
-	0xca, 0x3f, 0xb2, 0x9b, 0x14, 0x7d, 0x4b, 0x11, 0x4f, 0xf3, 0x8f, 0x06, 0xf2, 0x4a, 0x76, 0x33,
-	0x88, 0xd7, 0xf8, 0x0b, 0xf2, 0x22, 0x6b, 0x31, 0xe4, 0x63, 0x12, 0xf9, 0x99, 0xa1, 0xc8, 0x15,
-	0x9c, 0x18, 0xf4, 0x06, 0x1c, 0x0f, 0x91, 0x3f, 0x52, 0x83, 0xec, 0xff, 0x93, 0xa2, 0x2f, 0x0d,
-	0x78, 0x7d, 0x40, 0x47, 0x98, 0xa4, 0x0f, 0x61, 0x26, 0x3e, 0xcc, 0x31, 0x4f, 0x2b, 0x99, 0x79,
-	0x8a, 0xc5, 0xc2, 0x4c, 0x1d, 0x68, 0x47, 0x1b, 0xf7, 0x2e, 0x57, 0x37, 0x61, 0x51, 0x52, 0x88,
-	0xf7, 0xd9, 0x91, 0xef, 0x25, 0xcc, 0xd7, 0x6b, 0x30, 0xa9, 0xd6, 0x22, 0xa7, 0x2e, 0xb3, 0x35,
-	0x5e, 0x7a, 0x45, 0xde, 0xdf, 0xab, 0x9b, 0x3f, 0x86, 0x93, 0x19, 0xee, 0x19, 0x59, 0x30, 0xf6,
-	0x20, 0x0b, 0xe6, 0x3c, 0x90, 0x70, 0xea, 0x6d, 0x94, 0xcb, 0x08, 0xd7, 0x7c, 0x08, 0x87, 0x62,
-	0xad, 0x88, 0xe2, 0x0a, 0x8c, 0x6f, 0x94, 0xcb, 0xd8, 0xf5, 0x62, 0x66, 0xd7, 0x1b, 0xe5, 0x32,
-	0x76, 0x18, 0xb8, 0x98, 0xef, 0xc2, 0x6b, 0xdd, 0x80, 0x9c, 0xaf, 0xd6, 0xeb, 0x3e, 0xe5, 0xdd,
-	0xc1, 0xb4, 0x0c, 0x73, 0x55, 0x47, 0xd4, 0x98, 0xe3, 0x55, 0xba, 0x49, 0x1a, 0x93, 0x49, 0x9a,
-	0xc1, 0xf6, 0x3b, 0x98, 0xab, 0xdb, 0xbd, 0xc5, 0x25, 0x1a, 0x06, 0xe1, 0xcd, 0xc1, 0x38, 0x15,
-	0x2d, 0x5c, 0x5a, 0x82, 0xcb, 0xa0, 0xa5, 0x2a, 0x6a, 0x32, 0xd8, 0x54, 0x29, 0xb8, 0x34, 0x3f,
-	0x37, 0x60, 0xa5, 0x3f, 0x44, 0xb1, 0x73, 0xd7, 0xf1, 0x6c, 0xd7, 0xf9, 0x8c, 0xd6, 0xd7, 0xa9,
-	0xd3, 0x6c, 0x89, 0x10, 0x5a, 0x01, 0x0e, 0x37, 0xc2, 0x27, 0x95, 0x80, 0x65, 0xa5, 0x25, 0x9f,
-	0xe3, 0x4b, 0x3c, 0xd4, 0x7d, 0xf8, 0x98, 0x0a, 0x5b, 0xb9, 0x8e, 0x40, 0xe7, 0x03, 0x78, 0x53,
-	0x0b, 0xcb, 0x08, 0xfc, 0x7e, 0x08, 0x47, 0x64, 0xc8, 0x0d, 0xce, 0xd7, 0x1d, 0x2e, 0x98, 0xdf,
-	0xd9, 0xeb, 0x29, 0xfb, 0x1b, 0x03, 0x5e, 0xed, 0xeb, 0x02, 0x11, 0xae, 0xc2, 0xa4, 0xe0, 0xbc,
-	0xe2, 0x3a, 0x5c, 0xe0, 0x34, 0xd5, 0x1d, 0x25, 0xaf, 0x08, 0xce, 0xdf, 0x77, 0xb8, 0xd8, 0xbb,
-	0x69, 0xd9, 0x82, 0x79, 0x09, 0x73, 0xdd, 0xe6, 0xdf, 0x63, 0x82, 0xd6, 0xc3, 0x3c, 0xbc, 0x09,
-	0x07, 0x95, 0x3c, 0xa9, 0x38, 0x75, 0xea, 0x09, 0xa7, 0xe1, 0x50, 0x1f, 0x73, 0x3a, 0xa7, 0x1e,
-	0xdc, 0xeb, 0xb6, 0x93, 0x25, 0x38, 0xf0, 0x94, 0x09, 0xea, 0x57, 0x6c, 0xf5, 0x72, 0x30, 0xd5,
-	0xfb, 0x65, 0x23, 0xbe, 0x30, 0xf3, 0x2d, 0x38, 0x9c, 0xe8, 0x09, 0xd3, 0x71, 0x0c, 0xa6, 0x5a,
-	0x36, 0xaf, 0x04, 0xc6, 0x6a, 0xda, 0x4f, 0x96, 0x26, 0x5b, 0x68, 0x64, 0x7e, 0x07, 0x16, 0xa4,
-	0x57, 0x51, 0xf6, 0x59, 0xec, 0xf4, 0x7a, 0xdd, 0x0d, 0x52, 0x53, 0xc0, 0x54, 0x10, 0xd7, 0x97,
-	0x49, 0xec, 0x83, 0x6d, 0xf4, 0xc3, 0x26, 0x45, 0x98, 0x0a, 0xee, 0x2b, 0xa2, 0xd3, 0xa6, 0x92,
-	0xd7, 0x4c, 0xe1, 0x74, 0xe6, 0xdb, 0x0a, 0xe2, 0x6f, 0x74, 0xda, 0xb4, 0x34, 0xf9, 0x14, 0xaf,
-	0xcc, 0x3f, 0x8c, 0xc1, 0x89, 0x81, 0x2c, 0x30, 0x0b, 0x23, 0x25, 0xfc, 0x1d, 0x98, 0x90, 0x20,
-	0x83, 0x4c, 0x8f, 0xcb, 0x11, 0x3a, 0x0c, 0x91, 0x64, 0x5c, 0x42, 0x2f, 0xf2, 0x21, 0xcc, 0xa9,
-	0xa7, 0x72, 0x10, 0x28, 0x6e, 0xe3, 0x92, 0xdb, 0xb9, 0xcc, 0x48, 0x0f, 0x7b, 0x4e, 0x92, 0xe2,
-	0x2c, 0x8b, 0x37, 0x90, 0x07, 0x70, 0x00, 0x59, 0x70, 0x61, 0x8b, 0x2d, 0x7e, 0xf4, 0x25, 0x19,
-	0xf5, 0x6c, 0x66, 0x54, 0x95, 0x95, 0xb2, 0x74, 0x28, 0xed, 0xaf, 0x46, 0xee, 0x4c, 0x02, 0x73,
-	0x32, 0x71, 0x0f, 0xd1, 0xb6, 0x4c, 0x85, 0x79, 0x05, 0x8e, 0x26, 0xdb, 0xba, 0x59, 0x3c, 0x0e,
-	0x53, 0x61, 0x58, 0x55, 0x02, 0xa7, 0x4a, 0xbd, 0x06, 0xf3, 0x08, 0x0e, 0xf6, 0xf2, 0x56, 0xbb,
-	0xcd, 0x7c, 0x41, 0xeb, 0x72, 0x89, 0xe1, 0xe6, 0x47, 0x58, 0xc7, 0x13, 0xed, 0xdd, 0xa8, 0x37,
-	0x60, 0x42, 0x29, 0x3d, 0x9c, 0xae, 0xa7, 0xd2, 0xe8, 0xb4, 0x9f, 0x34, 0xf3, 0xa8, 0x07, 0x55,
-	0x55, 0x42, 0x1f, 0xf3, 0x16, 0x98, 0x31, 0xdd, 0xf6, 0x48, 0x2a, 0xd7, 0xbb, 0xcc, 0xd7, 0xad,
-	0x7d, 0x3e, 0x2c, 0x65, 0x06, 0x40, 0x94, 0xef, 0xc1, 0x7e, 0x15, 0x41, 0x49, 0x63, 0x7d, 0x05,
-	0xa8, 0xe2, 0x95, 0xa6, 0x6b, 0xbd, 0x1b, 0xf3, 0x78, 0x42, 0xa0, 0xa2, 0x0d, 0x56, 0x3e, 0x2f,
-	0x21, 0x45, 0xc3, 0xa7, 0x88, 0xe4, 0x61, 0x2a, 0x92, 0x73, 0xba, 0x48, 0xe4, 0x50, 0x8d, 0xa1,
-	0x89, 0xc8, 0xe5, 0x07, 0xac, 0x4e, 0x57, 0xd5, 0x96, 0x22, 0x5b, 0x2e, 0xff, 0xa8, 0x87, 0x31,
-	0xe6, 0xd3, 0xcb, 0x56, 0x74, 0x7b, 0xa2, 0x95, 0xad, 0x68, 0x9c, 0x69, 0xaf, 0x77, 0x13, 0x55,
-	0xca, 0x29, 0xf8, 0xf6, 0xaa, 0xa6, 0x7c, 0x11, 0x51, 0xca, 0x69, 0x94, 0xee, 0xc3, 0x74, 0xa4,
-	0x59, 0x4b, 0x29, 0xc7, 0x18, 0x45, 0x6e, 0xf6, 0xae, 0xc0, 0x2c, 0xe2, 0x02, 0x1e, 0x0c, 0x95,
-	0xee, 0x66, 0xf3, 0x6e, 0xb0, 0xd7, 0x0c, 0x07, 0xd3, 0x4f, 0x0c, 0x5c, 0x1d, 0xd3, 0x4c, 0x90,
-	0xda, 0xf7, 0x61, 0x2e, 0xb9, 0x55, 0xd5, 0x1b, 0x55, 0xf1, 0x78, 0x58, 0x46, 0x67, 0x6b, 0xf1,
-	0x66, 0xf3, 0x55, 0xac, 0x4d, 0x6b, 0x54, 0xbc, 0x27, 0x77, 0xb7, 0x21, 0xb6, 0xef, 0xa2, 0x50,
-	0x88, 0x3c, 0x40, 0x44, 0xd7, 0x61, 0x42, 0x6d, 0x84, 0x11, 0xc7, 0x52, 0x26, 0x0e, 0x74, 0x46,
-	0x17, 0xf3, 0x04, 0xea, 0xf9, 0x72, 0x8b, 0x7d, 0x12, 0x2e, 0x63, 0x77, 0x22, 0x43, 0x26, 0xc8,
-	0xc9, 0xc2, 0x20, 0x0b, 0x04, 0xf0, 0x03, 0x38, 0xe4, 0xda, 0x5c, 0x54, 0xc2, 0x3e, 0x2a, 0xd1,
-	0x71, 0x9c, 0xcf, 0x44, 0xf3, 0xbe, 0xcd, 0x45, 0x3c, 0xe8, 0x41, 0x37, 0xd9, 0x64, 0xde, 0x47,
-	0x8c, 0x45, 0xd7, 0xde, 0xa4, 0x69, 0x85, 0xf7, 0x2c, 0xcc, 0xc9, 0xef, 0x12, 0xfd, 0x05, 0x6b,
-	0x56, 0xb6, 0x47, 0xca, 0x6e, 0x2d, 0xac, 0xe2, 0xfd, 0xb1, 0xba, 0x9a, 0x08, 0x30, 0x98, 0xd7,
-	0x60, 0x48, 0xc2, 0xcc, 0xae, 0x1a, 0x81, 0x79, 0x69, 0x4a, 0x75, 0xe5, 0x35, 0x98, 0x49, 0x7b,
-	0xb3, 0x43, 0x3d, 0xa3, 0x35, 0xe6, 0xd7, 0xf7, 0x7c, 0x33, 0xf6, 0x3b, 0xa3, 0xb7, 0xeb, 0x8b,
-	0xf7, 0x83, 0x54, 0xd6, 0x12, 0x54, 0xc6, 0xf5, 0xa8, 0xe0, 0xd8, 0xec, 0x11, 0xda, 0xbb, 0x39,
-	0x58, 0xc6, 0xbd, 0x17, 0xa6, 0x5f, 0x2e, 0xb5, 0xab, 0x5e, 0x5d, 0x6e, 0x6e, 0x86, 0xd7, 0x9f,
-	0x60, 0x7d, 0x95, 0xdb, 0x29, 0xd4, 0xe7, 0xea, 0xc6, 0x6c, 0xe0, 0x8e, 0x2c, 0x3d, 0xe8, 0x80,
-	0xd7, 0x3a, 0x3e, 0xf2, 0x6b, 0x2d, 0xfc, 0x77, 0x11, 0x5e, 0x96, 0x1d, 0x91, 0x3f, 0x1b, 0x30,
-	0x19, 0xaa, 0x47, 0x72, 0x31, 0x33, 0x4a, 0x9a, 0xa6, 0xcd, 0x15, 0x46, 0x71, 0x51, 0x04, 0xcc,
-	0xfb, 0x3f, 0xfd, 0xfb, 0x7f, 0x7e, 0x3e, 0xf6, 0x6d, 0x52, 0x94, 0xdf, 0x74, 0xce, 0xab, 0xcf,
-	0x3b, 0xdd, 0x0f, 0x45, 0x5d, 0xdd, 0x6a, 0x6d, 0xf7, 0x89, 0xb7, 0x1d, 0x6b, 0x3b, 0xa6, 0x2e,
-	0x77, 0xc8, 0x3f, 0x0c, 0x20, 0xfd, 0x0a, 0x90, 0x5c, 0x1f, 0x0e, 0x6b, 0xa0, 0xfa, 0xcd, 0xdd,
-	0xd8, 0x9d, 0x33, 0xb2, 0x7b, 0x57, 0xb2, 0xbb, 0x45, 0x6e, 0xa6, 0xb2, 0x43, 0x4a, 0xd5, 0x4e,
-	0x84, 0x55, 0x1a, 0x51, 0xf2, 0x2b, 0x03, 0xa6, 0x23, 0x6a, 0x8c, 0x9c, 0x1f, 0x0e, 0x2a, 0x62,
-	0x9e, 0x7b, 0x7b, 0x24, 0xf3, 0x2e, 0xf8, 0xb3, 0x12, 0xfc, 0x12, 0x39, 0x99, 0x0a, 0xbe, 0xbb,
-	0x2c, 0x72, 0x2a, 0xc8, 0x6f, 0x0d, 0x98, 0x4d, 0x88, 0x3b, 0x9d, 0x01, 0x94, 0x70, 0xc9, 0x5d,
-	0x1d, 0xd9, 0xa5, 0x0b, 0xf6, 0x9c, 0x04, 0xfb, 0x06, 0x39, 0x95, 0x0a, 0x96, 0x27, 0xb0, 0xfd,
-	0xdb, 0x80, 0x23, 0xe9, 0x6a, 0x8f, 0xdc, 0x1a, 0x8e, 0x21, 0x53, 0x68, 0xe6, 0x6e, 0xef, 0x3e,
-	0x00, 0x72, 0x29, 0x4a, 0x2e, 0x37, 0xc8, 0xb5, 0x54, 0x2e, 0x4d, 0x2a, 0x2a, 0x51, 0xf5, 0x57,
-	0x69, 0x30, 0x5f, 0x35, 0x58, 0xdb, 0xe1, 0x0a, 0xb3, 0x43, 0xbe, 0x30, 0x60, 0x26, 0xde, 0x0d,
-	0xb9, 0x3c, 0x2a, 0xb0, 0x90, 0xd1, 0x95, 0xd1, 0x1d, 0x91, 0xc9, 0x79, 0xc9, 0xe4, 0x0c, 0x39,
-	0xad, 0xc5, 0x24, 0x00, 0x1d, 0x13, 0x49, 0x7a, 0x88, 0xfb, 0x15, 0xa1, 0x26, 0xe2, 0x14, 0x8d,
-	0x67, 0x5e, 0x90, 0x88, 0x57, 0xc8, 0x72, 0x2a, 0xe2, 0x88, 0x26, 0xb5, 0xb6, 0xa5, 0x0c, 0xde,
-	0x09, 0xc6, 0xfe, 0x4c, 0x24, 0xd2, 0xaa, 0xeb, 0xea, 0xe0, 0x4e, 0x55, 0xb2, 0x3a, 0xb8, 0xd3,
-	0xb5, 0xa9, 0xb9, 0x2c, 0x71, 0x9b, 0x64, 0x71, 0x18, 0x6e, 0xf2, 0x27, 0x03, 0x66, 0x13, 0xb2,
-	0x4d, 0x67, 0x89, 0x1c, 0xa8, 0x2f, 0x75, 0x96, 0xc8, 0xc1, 0xca, 0x73, 0xc8, 0x10, 0x49, 0x8a,
-	0x52, 0xf2, 0x0b, 0x03, 0x26, 0x94, 0xd8, 0x23, 0x05, 0xad, 0x7e, 0x63, 0x7a, 0x33, 0x77, 0x69,
-	0x24, 0x1f, 0x84, 0xb8, 0x24, 0x21, 0xbe, 0x4e, 0x8e, 0xa5, 0x42, 0x54, 0x92, 0x93, 0xfc, 0xc5,
-	0x80, 0x83, 0x7d, 0x62, 0x92, 0x5c, 0xd3, 0x58, 0xd1, 0x06, 0x68, 0xd4, 0xdc, 0xf5, 0x5d, 0xf9,
-	0x22, 0xe6, 0xab, 0x12, 0xf3, 0x25, 0x72, 0x31, 0x8a, 0xb9, 0xff, 0x24, 0x86, 0xb7, 0xd8, 0x27,
-	0x09, 0x85, 0x4b, 0xfe, 0x66, 0xc0, 0xc1, 0x3e, 0x21, 0xa9, 0xc3, 0x64, 0x90, 0x92, 0xd5, 0x61,
-	0x32, 0x50, 0xb9, 0x9a, 0x77, 0x24, 0x93, 0x9b, 0xe4, 0x7a, 0x7a, 0x0d, 0x95, 0xea, 0x27, 0x59,
-	0x42, 0x13, 0xb2, 0x79, 0x27, 0x90, 0x36, 0x64, 0x8d, 0x8a, 0x84, 0xa4, 0x24, 0x7a, 0xf3, 0x2d,
-	0x45, 0xed, 0xea, 0x94, 0xaa, 0x01, 0xfa, 0xd5, 0x2c, 0x48, 0x42, 0xe7, 0xc8, 0xca, 0xc0, 0x45,
-	0xd1, 0x76, 0xdd, 0x8a, 0xe2, 0xe0, 0x23, 0xd0, 0x6f, 0x0c, 0x38, 0x2c, 0x83, 0xf1, 0x84, 0x12,
-	0x24, 0x37, 0xb5, 0x73, 0x9b, 0x26, 0x4b, 0x73, 0xef, 0xec, 0xd6, 0x1d, 0xc9, 0xac, 0x4b, 0x32,
-	0x45, 0x72, 0x3b, 0xfb, 0xed, 0xa8, 0x29, 0x6c, 0x7b, 0x75, 0x75, 0x70, 0x10, 0xa9, 0x54, 0xd6,
-	0xb6, 0x6c, 0xd9, 0x21, 0x5f, 0x1a, 0x70, 0x20, 0xf6, 0x09, 0x9a, 0x7c, 0x4b, 0x6b, 0xb2, 0xf6,
-	0x7d, 0xc9, 0xcf, 0x5d, 0x1e, 0xd9, 0x0f, 0xc9, 0xdc, 0x92, 0x64, 0xae, 0x92, 0xcb, 0x03, 0xdf,
-	0x8c, 0xe0, 0x3c, 0xd4, 0x9b, 0xd6, 0x76, 0xf2, 0xfb, 0xfa, 0x0e, 0xf9, 0xe5, 0x18, 0x2c, 0x64,
-	0x7f, 0x46, 0x27, 0x6b, 0x23, 0x82, 0x1b, 0x74, 0x28, 0x90, 0x5b, 0x7f, 0xf1, 0x40, 0x48, 0xbb,
-	0x2a, 0x69, 0x7f, 0x44, 0x1e, 0xeb, 0xd0, 0xae, 0xb4, 0xe4, 0xd7, 0x76, 0xa7, 0x66, 0xbb, 0xd6,
-	0x76, 0xea, 0xa9, 0xc4, 0x4e, 0x5a, 0x66, 0x3e, 0x37, 0xe4, 0xa9, 0x0d, 0xb1, 0xf4, 0x50, 0x77,
-	0x0f, 0x81, 0x72, 0x17, 0xf4, 0x1d, 0x90, 0xce, 0xa2, 0xa4, 0x93, 0x23, 0x47, 0x53, 0xe9, 0x04,
-	0x20, 0x7e, 0x6d, 0x00, 0xf4, 0xce, 0x0d, 0x88, 0x46, 0x51, 0xe8, 0x3b, 0xc8, 0xc8, 0xbd, 0x35,
-	0x9a, 0x13, 0x62, 0x3b, 0x23, 0xb1, 0x9d, 0x24, 0x27, 0x52, 0xb1, 0x89, 0x1e, 0xa6, 0xdf, 0x1b,
-	0x30, 0x17, 0x3b, 0x38, 0x0b, 0x74, 0x85, 0xde, 0xa2, 0x93, 0x76, 0x54, 0x9a, 0xbb, 0xb6, 0x1b,
-	0x57, 0x04, 0xbd, 0x22, 0x41, 0x9f, 0x22, 0x66, 0x2a, 0xe8, 0xf8, 0x79, 0xe6, 0x5f, 0x0d, 0x98,
-	0x4f, 0x3b, 0x43, 0xd4, 0x59, 0xa7, 0x32, 0x8e, 0x2e, 0x75, 0xd6, 0xa9, 0xac, 0xa3, 0x4b, 0xf3,
-	0x6d, 0xc9, 0xc1, 0x22, 0xe7, 0x87, 0x73, 0x48, 0xc8, 0xe8, 0xd8, 0xd1, 0xf6, 0x08, 0x1a, 0x3a,
-	0x9e, 0xff, 0x2b, 0xa3, 0x3b, 0x6a, 0x29, 0xd2, 0x5a, 0xcf, 0x23, 0xa6, 0x48, 0x23, 0x91, 0xf4,
-	0x15, 0xe9, 0xee, 0x70, 0xa7, 0xff, 0xaf, 0x60, 0x88, 0x22, 0x8d, 0xe0, 0x2e, 0xde, 0xfb, 0xea,
-	0xd9, 0x82, 0xf1, 0xf5, 0xb3, 0x05, 0xe3, 0x9b, 0x67, 0x0b, 0xc6, 0xcf, 0x9e, 0x2f, 0xec, 0xfb,
-	0xfa, 0xf9, 0xc2, 0xbe, 0x7f, 0x3e, 0x5f, 0xd8, 0xf7, 0xd8, 0x6a, 0x3a, 0xa2, 0xb5, 0x55, 0xcd,
-	0xd7, 0xd8, 0x66, 0xaa, 0x8e, 0xf9, 0x34, 0x32, 0x77, 0x3a, 0x6d, 0xca, 0xab, 0x13, 0xf2, 0xef,
-	0x1f, 0x97, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x19, 0xf9, 0xb3, 0x69, 0xbe, 0x24, 0x00, 0x00,
+	0x15, 0xf6, 0x4a, 0x89, 0x22, 0x3d, 0xd9, 0x92, 0x3c, 0x96, 0x1d, 0x65, 0xa5, 0x50, 0xd2, 0xca,
+	0x8e, 0x65, 0xc5, 0xe6, 0xda, 0xb2, 0x13, 0xcb, 0x96, 0x7f, 0x49, 0x6e, 0x2c, 0xd9, 0x49, 0x6c,
+	0x87, 0x54, 0x1b, 0xc0, 0x48, 0xcb, 0x2e, 0xc9, 0x21, 0xb9, 0x35, 0xbd, 0xcb, 0xec, 0x8c, 0x9c,
+	0x30, 0xaa, 0x80, 0xa2, 0xc7, 0x9c, 0x0a, 0x14, 0x68, 0x6f, 0x45, 0x2f, 0x3d, 0x16, 0x28, 0x02,
+	0x14, 0x29, 0x50, 0xf4, 0x90, 0x53, 0x73, 0xe8, 0x21, 0x45, 0x81, 0xa2, 0xa7, 0x36, 0xb0, 0x7b,
+	0xea, 0x5f, 0x51, 0xec, 0xcc, 0x5b, 0x72, 0x77, 0x39, 0x5c, 0x2e, 0x69, 0xe5, 0xc4, 0xdd, 0xd9,
+	0xf7, 0xde, 0x7c, 0xdf, 0x9b, 0x5f, 0xdf, 0xcc, 0x10, 0x4e, 0x7f, 0x46, 0xb9, 0x55, 0xaa, 0x59,
+	0xb6, 0x63, 0x8a, 0x27, 0xd7, 0xa3, 0xa6, 0x5b, 0x64, 0xd4, 0x7b, 0x4a, 0x3d, 0xf3, 0xe3, 0x5d,
+	0xea, 0x35, 0xb3, 0x0d, 0xcf, 0xe5, 0x2e, 0x99, 0x6d, 0x19, 0x66, 0x03, 0xc3, 0x6c, 0x60, 0xa8,
+	0xaf, 0x94, 0x5c, 0xf6, 0xc4, 0x65, 0x66, 0xd1, 0x62, 0x54, 0x7a, 0x99, 0x4f, 0x2f, 0x14, 0x29,
+	0xb7, 0x2e, 0x98, 0x0d, 0xab, 0x6a, 0x3b, 0x16, 0xb7, 0x5d, 0x47, 0x06, 0xd2, 0xa7, 0xab, 0x6e,
+	0xd5, 0x15, 0x8f, 0xa6, 0xff, 0x84, 0xa5, 0x73, 0x55, 0xd7, 0xad, 0xd6, 0xa9, 0x69, 0x35, 0x6c,
+	0xd3, 0x72, 0x1c, 0x97, 0x0b, 0x17, 0x86, 0x5f, 0x97, 0x93, 0x50, 0x16, 0xad, 0x7a, 0xdd, 0xe5,
+	0x68, 0x99, 0xc8, 0xa7, 0x58, 0xb7, 0x9e, 0x50, 0x34, 0xcc, 0x26, 0x19, 0x8a, 0xf2, 0x82, 0xe3,
+	0x3a, 0x25, 0x1a, 0x40, 0x58, 0x4d, 0xb4, 0xf7, 0x5c, 0xc6, 0xa4, 0x53, 0xa5, 0x6e, 0x55, 0x53,
+	0xc1, 0x7e, 0x4c, 0x9b, 0x55, 0xea, 0xa4, 0x41, 0xe3, 0xb8, 0x65, 0x5a, 0xb0, 0x4a, 0x25, 0x77,
+	0xd7, 0x09, 0x68, 0xae, 0x24, 0xd9, 0x07, 0x0f, 0x69, 0x50, 0x34, 0x2c, 0xcf, 0x7a, 0x12, 0xe0,
+	0x3d, 0x9f, 0x68, 0x49, 0x9d, 0xb2, 0xed, 0x54, 0xa3, 0x59, 0x39, 0x95, 0xe4, 0xc1, 0x19, 0x4b,
+	0x80, 0xdb, 0x78, 0x5c, 0x95, 0x79, 0x66, 0xf8, 0xd3, 0xc3, 0xb6, 0xe1, 0xb9, 0x6e, 0x85, 0xe1,
+	0x8f, 0xb4, 0x35, 0xde, 0x07, 0xfd, 0x03, 0xbf, 0xb7, 0x6d, 0x51, 0x7e, 0xdb, 0xf7, 0xb8, 0x2f,
+	0xb0, 0xe5, 0xe8, 0xc7, 0xbb, 0x94, 0x71, 0x32, 0x0d, 0x2f, 0xdb, 0x4e, 0x99, 0x7e, 0x3a, 0xa3,
+	0x2d, 0x68, 0xcb, 0x63, 0x39, 0xf9, 0x42, 0x5e, 0x83, 0x51, 0xd9, 0x52, 0x76, 0x79, 0x66, 0x68,
+	0x41, 0x5b, 0x1e, 0xce, 0xbd, 0x22, 0xde, 0xef, 0x96, 0x0d, 0x17, 0x66, 0x95, 0xe1, 0x58, 0xc3,
+	0x75, 0x18, 0x25, 0x0f, 0x61, 0x3c, 0x54, 0x2c, 0xa2, 0x8e, 0xaf, 0x2e, 0x67, 0x13, 0x06, 0x46,
+	0x36, 0x64, 0xbf, 0xf9, 0xd2, 0xd7, 0xff, 0x9e, 0x3f, 0x94, 0x0b, 0x87, 0x30, 0xca, 0x88, 0x7f,
+	0xa3, 0x5e, 0x57, 0xe0, 0xbf, 0x03, 0xd0, 0x1e, 0x3d, 0x58, 0xdd, 0x1b, 0x59, 0x39, 0xd4, 0xb2,
+	0xfe, 0x50, 0xcb, 0xca, 0x01, 0x8a, 0x43, 0x2d, 0xfb, 0xd0, 0xaa, 0x52, 0xf4, 0xcd, 0x85, 0x3c,
+	0x8d, 0x3f, 0x69, 0x30, 0xab, 0xac, 0xa6, 0x1b, 0xaf, 0xe1, 0x17, 0xe4, 0x45, 0xb6, 0x22, 0xc8,
+	0x87, 0x04, 0xf2, 0xd3, 0x3d, 0x91, 0x4b, 0x38, 0x11, 0xe8, 0x15, 0x98, 0x0b, 0x90, 0x3f, 0x94,
+	0xfd, 0xef, 0xbb, 0x49, 0xd1, 0x57, 0x1a, 0xbc, 0xde, 0xa5, 0x22, 0x4c, 0xd2, 0x87, 0x30, 0x11,
+	0x1d, 0x01, 0x98, 0xa7, 0x95, 0xc4, 0x3c, 0x45, 0x62, 0x61, 0xa6, 0x8e, 0x34, 0xc2, 0x85, 0x07,
+	0x97, 0xab, 0xeb, 0xb0, 0x20, 0x28, 0x44, 0xeb, 0x6c, 0x8a, 0x76, 0x09, 0xf2, 0x15, 0xee, 0xfc,
+	0x5a, 0xb4, 0xf3, 0xff, 0x14, 0x16, 0x13, 0xdc, 0x13, 0xb2, 0xa0, 0x1d, 0x40, 0x16, 0x8c, 0x69,
+	0x20, 0xc1, 0xd0, 0xdb, 0xc9, 0xe7, 0x11, 0xae, 0xf1, 0x00, 0x8e, 0x45, 0x4a, 0x11, 0xc5, 0x1a,
+	0x0c, 0xef, 0xe4, 0xf3, 0x58, 0xf5, 0x42, 0x62, 0xd5, 0x3b, 0xf9, 0x3c, 0x56, 0xe8, 0xbb, 0x18,
+	0xef, 0xc0, 0x6b, 0xad, 0x80, 0x8c, 0x6d, 0x94, 0xcb, 0x1e, 0x65, 0xad, 0xce, 0xb4, 0x0c, 0x53,
+	0x45, 0x9b, 0x97, 0x5c, 0xdb, 0x29, 0xc4, 0x66, 0x88, 0x09, 0x2c, 0xbf, 0x8d, 0xb9, 0xba, 0x05,
+	0xba, 0x2a, 0x0c, 0xc2, 0x9b, 0x82, 0x61, 0xca, 0x6b, 0x38, 0xeb, 0xf8, 0x8f, 0x7e, 0x49, 0x91,
+	0x97, 0x44, 0xb0, 0xb1, 0x9c, 0xff, 0x68, 0x7c, 0xae, 0xc1, 0x4a, 0x67, 0x88, 0xcd, 0xe6, 0x1d,
+	0xdb, 0xb1, 0xea, 0xf6, 0x67, 0xb4, 0xbc, 0x4d, 0xed, 0x6a, 0x8d, 0x07, 0xd0, 0x56, 0xe1, 0x78,
+	0x25, 0xf8, 0x52, 0xf0, 0x59, 0x16, 0x6a, 0xe2, 0x3b, 0x36, 0xe2, 0xb1, 0xd6, 0xc7, 0x47, 0x94,
+	0x5b, 0xd2, 0xb5, 0x0f, 0x3a, 0x1f, 0xc0, 0x9b, 0xa9, 0xb0, 0xf4, 0xc1, 0xef, 0xc7, 0x70, 0x42,
+	0x84, 0xdc, 0x61, 0x6c, 0xdb, 0x66, 0xdc, 0xf5, 0x9a, 0x07, 0x3d, 0x64, 0x7f, 0xa7, 0xc1, 0xab,
+	0x1d, 0x55, 0x20, 0xc2, 0x0d, 0x18, 0xe5, 0x8c, 0x15, 0xea, 0x36, 0xe3, 0x38, 0x4c, 0xd3, 0xf6,
+	0x92, 0x57, 0x38, 0x63, 0xef, 0xd9, 0x8c, 0x1f, 0xdc, 0xb0, 0xac, 0xc1, 0xb4, 0x80, 0xb9, 0x6d,
+	0xb1, 0x1f, 0xb8, 0x9c, 0x96, 0x83, 0x3c, 0xbc, 0x09, 0x47, 0xa5, 0x72, 0x29, 0xd8, 0x65, 0xea,
+	0x70, 0xbb, 0x62, 0x53, 0x0f, 0x73, 0x3a, 0x25, 0x3f, 0xdc, 0x6d, 0x95, 0x93, 0x25, 0x38, 0xf2,
+	0xd4, 0xe5, 0xd4, 0x2b, 0x58, 0xb2, 0x71, 0x30, 0xd5, 0x87, 0x45, 0x21, 0x36, 0x98, 0x71, 0x09,
+	0x8e, 0xc7, 0x6a, 0xc2, 0x74, 0xcc, 0xc2, 0x58, 0xcd, 0x62, 0x05, 0xdf, 0x58, 0x0e, 0xfb, 0xd1,
+	0xdc, 0x68, 0x0d, 0x8d, 0x8c, 0xf7, 0x21, 0x23, 0xbc, 0x36, 0x45, 0x9d, 0x9b, 0xcd, 0x76, 0xad,
+	0x83, 0x20, 0x35, 0x38, 0x8c, 0xf9, 0x71, 0x3d, 0x91, 0xc4, 0x0e, 0xd8, 0x5a, 0x27, 0x6c, 0xb2,
+	0x09, 0x63, 0xfe, 0x7b, 0x81, 0x37, 0x1b, 0x54, 0xf0, 0x9a, 0x58, 0x3d, 0x95, 0xd8, 0x5a, 0x7e,
+	0xfc, 0x9d, 0x66, 0x83, 0xe6, 0x46, 0x9f, 0xe2, 0x93, 0xf1, 0xe5, 0x10, 0xcc, 0x77, 0x65, 0x81,
+	0x59, 0xe8, 0x2b, 0xe1, 0x37, 0x60, 0x44, 0x80, 0xf4, 0x33, 0x3d, 0x2c, 0x7a, 0x68, 0x2f, 0x44,
+	0x82, 0x71, 0x0e, 0xbd, 0xc8, 0x87, 0x30, 0x25, 0xbf, 0x8a, 0x4e, 0x20, 0xb9, 0x0d, 0x0b, 0x6e,
+	0x67, 0x13, 0x23, 0x3d, 0x68, 0x3b, 0x09, 0x8a, 0x93, 0x6e, 0xb4, 0x80, 0xdc, 0x87, 0x23, 0xc8,
+	0x82, 0x71, 0x8b, 0xef, 0xb2, 0x99, 0x97, 0x44, 0xd4, 0x33, 0x89, 0x51, 0x65, 0x56, 0xf2, 0xc2,
+	0x21, 0x77, 0xb8, 0x18, 0x7a, 0x33, 0x08, 0x4c, 0x89, 0xc4, 0x3d, 0x40, 0xdb, 0x3c, 0xe5, 0xc6,
+	0x1a, 0xcc, 0xc4, 0xcb, 0x5a, 0x59, 0x9c, 0x83, 0xb1, 0x20, 0xac, 0x5c, 0x02, 0xc7, 0x72, 0xed,
+	0x02, 0xe3, 0x04, 0x76, 0xf6, 0xfc, 0x6e, 0xa3, 0xe1, 0x7a, 0x9c, 0x96, 0xc5, 0x14, 0xc3, 0x8c,
+	0x8f, 0x60, 0x4e, 0x55, 0xde, 0x8a, 0x7a, 0x0d, 0x46, 0x04, 0xf6, 0x60, 0x55, 0x3d, 0xa9, 0xa2,
+	0xd3, 0x78, 0x5c, 0xcd, 0x4a, 0x2b, 0xa9, 0x3f, 0x72, 0xe8, 0x63, 0xdc, 0x04, 0x23, 0xa2, 0xdb,
+	0x1e, 0x0a, 0x51, 0x7b, 0xc7, 0xf5, 0xd2, 0xae, 0x7d, 0x1e, 0x2c, 0x25, 0x06, 0x40, 0x94, 0xef,
+	0xc2, 0x61, 0x19, 0x41, 0xaa, 0xe6, 0xf4, 0x0a, 0x50, 0xc6, 0xcb, 0x8d, 0x97, 0xda, 0x2f, 0xc6,
+	0x5c, 0x4c, 0xbb, 0xa2, 0x0d, 0xae, 0x7c, 0x0e, 0xcc, 0x2a, 0xbf, 0x22, 0x92, 0x07, 0x4a, 0x24,
+	0x67, 0xd3, 0x22, 0x11, 0x5d, 0x35, 0x82, 0x66, 0xb5, 0x8d, 0xe6, 0xbe, 0x5b, 0xa6, 0x1b, 0x72,
+	0xb7, 0x91, 0xa8, 0xa4, 0x8d, 0x9f, 0xc0, 0xac, 0xd2, 0xa7, 0x9d, 0xad, 0xf0, 0xce, 0x25, 0x55,
+	0xb6, 0xc2, 0x71, 0xc6, 0x9d, 0xf6, 0x4b, 0x58, 0x29, 0x2b, 0xf0, 0x1d, 0xd4, 0x9a, 0xf2, 0x45,
+	0x48, 0x29, 0xab, 0x28, 0xdd, 0x83, 0xf1, 0x50, 0x71, 0x2a, 0xa5, 0x1c, 0x61, 0x14, 0x7a, 0x39,
+	0xb8, 0x05, 0x66, 0x01, 0x32, 0xad, 0xae, 0xd2, 0xda, 0x87, 0xde, 0xf1, 0xb7, 0xa1, 0x41, 0x67,
+	0xfa, 0x99, 0x06, 0xf3, 0x5d, 0x4d, 0x90, 0xda, 0x0f, 0x61, 0x2a, 0xbe, 0x8b, 0x4d, 0xd7, 0xab,
+	0xa2, 0xf1, 0x70, 0x19, 0x9d, 0x2c, 0x45, 0x8b, 0x8d, 0x57, 0x71, 0x6d, 0xda, 0xa2, 0xfc, 0x5d,
+	0xb1, 0xf1, 0x0d, 0xb0, 0x7d, 0x1f, 0x4e, 0xc4, 0x3f, 0x20, 0xa2, 0x75, 0x18, 0x91, 0x7b, 0x64,
+	0xc4, 0xb1, 0x94, 0x88, 0x03, 0x9d, 0xd1, 0xc5, 0x98, 0x47, 0x3d, 0x9f, 0xaf, 0xb9, 0x9f, 0x04,
+	0xd3, 0xd8, 0xed, 0x50, 0x97, 0xf1, 0x73, 0x92, 0xe9, 0x66, 0x81, 0x00, 0x7e, 0x04, 0xc7, 0xea,
+	0x16, 0xe3, 0x85, 0xa0, 0x8e, 0x42, 0xb8, 0x1f, 0x67, 0x13, 0xd1, 0xbc, 0x67, 0x31, 0x1e, 0x0d,
+	0x7a, 0xb4, 0x1e, 0x2f, 0x32, 0xee, 0x21, 0xc6, 0x4d, 0xff, 0x58, 0x42, 0xb5, 0xf0, 0x9e, 0x81,
+	0x29, 0x71, 0x64, 0xd1, 0xb9, 0x60, 0x4d, 0x8a, 0xf2, 0xd0, 0xb2, 0x5b, 0x0a, 0x56, 0xf1, 0xce,
+	0x58, 0x2d, 0x4d, 0x04, 0x18, 0xcc, 0xa9, 0xb8, 0x48, 0xc2, 0x48, 0x5e, 0x35, 0x7c, 0xf3, 0xdc,
+	0x98, 0xac, 0xca, 0xa9, 0xb8, 0x06, 0x6d, 0x8f, 0x0e, 0xf9, 0x8d, 0x96, 0x5c, 0xaf, 0x7c, 0xe0,
+	0x9b, 0xb1, 0x3f, 0x68, 0x30, 0xa7, 0xae, 0x07, 0xa9, 0x6c, 0xc5, 0xa8, 0x0c, 0xa7, 0xa3, 0x82,
+	0x7d, 0xb3, 0x4d, 0xe8, 0xe0, 0xc6, 0x60, 0x1e, 0xf7, 0x5e, 0x98, 0x7e, 0x31, 0xd5, 0x6e, 0x38,
+	0x65, 0xb1, 0xb9, 0xe9, 0xbd, 0xfe, 0xf8, 0xf3, 0xab, 0xd8, 0x4e, 0xa1, 0x3e, 0x97, 0x2f, 0x46,
+	0x05, 0x16, 0x13, 0x82, 0x76, 0x69, 0xd6, 0xe1, 0xbe, 0x9b, 0x75, 0xf5, 0xcb, 0x45, 0x78, 0x59,
+	0x54, 0x44, 0xfe, 0xa2, 0xc1, 0x68, 0xa0, 0x1e, 0xc9, 0x85, 0xc4, 0x28, 0x2a, 0x4d, 0xab, 0xaf,
+	0xf6, 0xe3, 0x22, 0x09, 0x18, 0xf7, 0x7e, 0xfe, 0x8f, 0xff, 0xfe, 0x72, 0xe8, 0x7b, 0x64, 0x53,
+	0x1c, 0xf7, 0x9c, 0x13, 0xce, 0xed, 0x33, 0xa4, 0x96, 0x6e, 0x35, 0xf7, 0x3a, 0xc4, 0xdb, 0xbe,
+	0xb9, 0x17, 0x51, 0x97, 0xfb, 0xe4, 0x9f, 0x1a, 0x90, 0x4e, 0x05, 0x48, 0xd6, 0x7b, 0xc3, 0xea,
+	0xaa, 0x7e, 0xf5, 0x6b, 0x83, 0x39, 0x23, 0xbb, 0x77, 0x04, 0xbb, 0x9b, 0xe4, 0xba, 0x92, 0x1d,
+	0x52, 0x2a, 0x36, 0x43, 0xac, 0x54, 0x44, 0xc9, 0x6f, 0x34, 0x18, 0x0f, 0xa9, 0x31, 0x72, 0xae,
+	0x37, 0xa8, 0x90, 0xb9, 0xfe, 0x56, 0x5f, 0xe6, 0x2d, 0xf0, 0x67, 0x04, 0xf8, 0x25, 0xb2, 0xa8,
+	0x04, 0x1f, 0x3c, 0x14, 0x18, 0xe5, 0xe4, 0xf7, 0x1a, 0x4c, 0xc6, 0xc4, 0x5d, 0x9a, 0x0e, 0x14,
+	0x73, 0xd1, 0xaf, 0xf4, 0xed, 0xd2, 0x02, 0x7b, 0x56, 0x80, 0x7d, 0x83, 0x9c, 0x54, 0x82, 0x65,
+	0x31, 0x6c, 0xff, 0xd1, 0xe0, 0x84, 0x5a, 0xed, 0x91, 0x9b, 0xbd, 0x31, 0x24, 0x0a, 0x4d, 0xfd,
+	0xd6, 0xe0, 0x01, 0x90, 0xcb, 0xa6, 0xe0, 0x72, 0x8d, 0x5c, 0x55, 0x72, 0xa9, 0x52, 0x5e, 0x08,
+	0xab, 0xbf, 0x42, 0xc5, 0xf5, 0x64, 0x81, 0xb9, 0x17, 0xcc, 0x30, 0xfb, 0xe4, 0x0b, 0x0d, 0x26,
+	0xa2, 0xd5, 0x90, 0xcb, 0xfd, 0x02, 0x0b, 0x18, 0xad, 0xf5, 0xef, 0x88, 0x4c, 0xce, 0x09, 0x26,
+	0xa7, 0xc9, 0xa9, 0x54, 0x4c, 0x7c, 0xd0, 0x11, 0x91, 0x94, 0x0e, 0x71, 0xa7, 0x22, 0xd4, 0xd7,
+	0xfa, 0x77, 0x44, 0xc4, 0xe7, 0x05, 0xe2, 0x15, 0xb2, 0xac, 0x44, 0x1c, 0xd2, 0xa4, 0xe6, 0x9e,
+	0x90, 0xc1, 0xfb, 0x7e, 0xdf, 0x9f, 0x08, 0x45, 0xda, 0xa8, 0xd7, 0xd3, 0xe0, 0x56, 0x2a, 0x59,
+	0x7d, 0xad, 0x7f, 0x47, 0xc4, 0xbd, 0x2c, 0x70, 0x1b, 0x64, 0xa1, 0x17, 0x6e, 0xf2, 0x67, 0x0d,
+	0x26, 0x63, 0xb2, 0x8d, 0xac, 0xa7, 0x6b, 0x61, 0xa5, 0xbe, 0xd4, 0xaf, 0x0d, 0xe6, 0x9c, 0xaa,
+	0x8b, 0xc4, 0x45, 0x29, 0xf9, 0x95, 0x06, 0x23, 0x52, 0xec, 0x91, 0xd5, 0x54, 0xf5, 0x46, 0xf4,
+	0xa6, 0x7e, 0xb1, 0x2f, 0x1f, 0x84, 0xb8, 0x24, 0x20, 0xbe, 0x4e, 0x66, 0x95, 0x10, 0xa5, 0xe4,
+	0x24, 0x7f, 0xd5, 0xe0, 0x68, 0x87, 0x98, 0x24, 0x57, 0x53, 0xcc, 0x68, 0x5d, 0x34, 0xaa, 0xbe,
+	0x3e, 0x90, 0x2f, 0x62, 0xbe, 0x22, 0x30, 0x5f, 0x24, 0x17, 0xc2, 0x98, 0x3b, 0x2f, 0x69, 0x58,
+	0xcd, 0xfd, 0x24, 0xa6, 0x70, 0xc9, 0xdf, 0x35, 0x38, 0xda, 0x21, 0x24, 0xd3, 0x30, 0xe9, 0xa6,
+	0x64, 0xf5, 0xf5, 0x81, 0x7c, 0x91, 0xc9, 0x6d, 0xc1, 0xe4, 0x3a, 0x59, 0x57, 0xaf, 0xa1, 0x42,
+	0xfd, 0xc4, 0x97, 0xd0, 0x98, 0x6c, 0xde, 0xf7, 0xa5, 0x0d, 0xd9, 0xa2, 0x3c, 0x26, 0x29, 0x49,
+	0xba, 0xf1, 0xa6, 0x50, 0xbb, 0xfa, 0x95, 0x01, 0x3c, 0x91, 0xd0, 0xaa, 0x20, 0x74, 0x96, 0xac,
+	0x74, 0x9d, 0x14, 0xad, 0x7a, 0xbd, 0x20, 0x39, 0x78, 0x08, 0xf4, 0x5b, 0x0d, 0x8e, 0x8b, 0x60,
+	0x2c, 0xa6, 0x04, 0xc9, 0xf5, 0xd4, 0xb9, 0x55, 0xc9, 0x52, 0xfd, 0xc6, 0xa0, 0xee, 0x48, 0x66,
+	0x5b, 0x90, 0xd9, 0x24, 0xb7, 0x92, 0x5b, 0x47, 0x0e, 0x61, 0xcb, 0x29, 0xcb, 0x8b, 0x83, 0xd0,
+	0x4a, 0x65, 0xee, 0x89, 0x92, 0x7d, 0xf2, 0x95, 0x06, 0x47, 0x22, 0x47, 0xd0, 0xe4, 0xed, 0x54,
+	0x83, 0xb5, 0xe3, 0x24, 0x5f, 0xbf, 0xdc, 0xb7, 0x1f, 0x92, 0xb9, 0x29, 0xc8, 0x5c, 0x21, 0x97,
+	0xbb, 0xb6, 0x8c, 0x7f, 0xae, 0x8c, 0x7a, 0xd3, 0xdc, 0x8b, 0x9f, 0xaf, 0xef, 0x93, 0x5f, 0x0f,
+	0x41, 0x26, 0xf9, 0x18, 0x9d, 0x6c, 0xf5, 0x09, 0xae, 0xdb, 0xa5, 0x80, 0xbe, 0xfd, 0xe2, 0x81,
+	0x90, 0x76, 0x51, 0xd0, 0xfe, 0x88, 0x3c, 0x4a, 0x43, 0xbb, 0x50, 0x13, 0xa7, 0xed, 0x76, 0xc9,
+	0xaa, 0x9b, 0x7b, 0xca, 0x5b, 0x89, 0x7d, 0x55, 0x66, 0x3e, 0xd7, 0xc4, 0xad, 0x0d, 0x31, 0xd3,
+	0xa1, 0x6e, 0x5d, 0x02, 0xe9, 0xe7, 0xd3, 0x3b, 0x20, 0x9d, 0x05, 0x41, 0x47, 0x27, 0x33, 0x4a,
+	0x3a, 0x3e, 0x88, 0xdf, 0x6a, 0x00, 0xed, 0x7b, 0x03, 0x92, 0x62, 0x51, 0xe8, 0xb8, 0xc8, 0xd0,
+	0x2f, 0xf5, 0xe7, 0x84, 0xd8, 0x4e, 0x0b, 0x6c, 0x8b, 0x64, 0x5e, 0x89, 0x8d, 0xb7, 0x31, 0xfd,
+	0x51, 0x83, 0xa9, 0xc8, 0xc5, 0x99, 0xaf, 0x2b, 0xd2, 0x4d, 0x3a, 0xaa, 0xab, 0x52, 0xfd, 0xea,
+	0x20, 0xae, 0x08, 0x7a, 0x45, 0x80, 0x3e, 0x49, 0x0c, 0x25, 0xe8, 0xe8, 0x7d, 0xe6, 0xdf, 0x34,
+	0x98, 0x56, 0xdd, 0x21, 0xa6, 0x99, 0xa7, 0x12, 0xae, 0x2e, 0xf5, 0x1b, 0x83, 0xba, 0x23, 0x87,
+	0xb7, 0x04, 0x07, 0x93, 0x9c, 0xeb, 0xcd, 0x21, 0x2c, 0xa3, 0xff, 0xa7, 0x45, 0x6e, 0xc7, 0xfb,
+	0xd1, 0xd0, 0xd1, 0xfc, 0xaf, 0xf5, 0xef, 0x88, 0xc8, 0x6b, 0x02, 0x79, 0xf1, 0xd1, 0xdb, 0xe4,
+	0x92, 0x5a, 0x22, 0xb5, 0x7d, 0x90, 0xf4, 0xdd, 0x72, 0x98, 0xc2, 0x72, 0x2f, 0xaf, 0x88, 0x92,
+	0x0d, 0x21, 0x48, 0xaf, 0x64, 0x07, 0xe3, 0xab, 0xfe, 0x3f, 0x42, 0x0f, 0x25, 0x1b, 0x66, 0x7b,
+	0xf7, 0xeb, 0x67, 0x19, 0xed, 0x9b, 0x67, 0x19, 0xed, 0xdb, 0x67, 0x19, 0xed, 0x17, 0xcf, 0x33,
+	0x87, 0xbe, 0x79, 0x9e, 0x39, 0xf4, 0xaf, 0xe7, 0x99, 0x43, 0x8f, 0xcc, 0xaa, 0xcd, 0x6b, 0xbb,
+	0xc5, 0x6c, 0xc9, 0x7d, 0xa2, 0xd4, 0x3f, 0x9f, 0xb6, 0x03, 0xfa, 0x77, 0x33, 0xac, 0x38, 0x22,
+	0xfe, 0x51, 0x72, 0xf1, 0xff, 0x03, 0x00, 0xfc, 0x12, 0x60, 0xbd, 0x11, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2311,7 +2321,7 @@ type QueryClient interface {
 	TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error)
 	PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error)
 	PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error)
-	// Queries a chainNonces by index.
+	// Queries a chainNonces by index or by chain id.
 	ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error)
 	// Queries a list of chainNonces items.
 	ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error)
@@ -2559,7 +2569,7 @@ type QueryServer interface {
 	TssHistory(context.Context, *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error)
 	PendingNoncesAll(context.Context, *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error)
 	PendingNoncesByChain(context.Context, *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error)
-	// Queries a chainNonces by index.
+	// Queries a chainNonces by index or by chain id.
 	ChainNonces(context.Context, *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error)
 	// Queries a list of chainNonces items.
 	ChainNoncesAll(context.Context, *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error)
@@ -3153,6 +3163,11 @@ func (m *QueryGetChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Index) > 0 {
 		i -= len(m.Index)
 		copy(dAtA[i:], m.Index)
@@ -4686,6 +4701,9 @@ func (m *QueryGetChainNoncesRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
 	return n
 }
 
@@ -5355,6 +5373,25 @@ func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,508 @@
+// Package base implements the lifecycle, persistence, tickers and metrics shared by the chain observers and signers
+// the chain-specific observers embed the base observer and plug in the fetching of the chain data
+package base
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	clientcommon "github.com/zeta-chain/zetacore/zetaclient/common"
+	clientcontext "github.com/zeta-chain/zetacore/zetaclient/context"
+	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+	"github.com/zeta-chain/zetacore/zetaclient/rpcpool"
+	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
+)
+
+const (
+	// DefaultBlockCacheSize is the default number of blocks cached by an observer
+	DefaultBlockCacheSize = 1000
+
+	// EnvVarScanFromSuffix is the suffix of the environment variable '<chain name>_SCAN_FROM' overriding the block
+	// the observer starts scanning from, the value is a block height or 'latest'
+	EnvVarScanFromSuffix = "_SCAN_FROM"
+)
+
+// ObserverLogger contains the loggers of a chain observer
+type ObserverLogger struct {
+	// Chain is the parent logger for the chain
+	Chain zerolog.Logger
+
+	// Inbound is the logger for incoming transactions
+	Inbound zerolog.Logger
+
+	// Outbound is the logger for outgoing transactions
+	Outbound zerolog.Logger
+
+	// GasPrice is the logger for gas prices
+	GasPrice zerolog.Logger
+
+	// Compliance is the logger for compliance checks
+	Compliance zerolog.Logger
+}
+
+// ChainPlugin is the chain-specific part of an observer, it fetches the chain data the base observer works on
+type ChainPlugin interface {
+	// LatestBlockHeight returns the height of the latest block of the chain
+	LatestBlockHeight() (uint64, error)
+
+	// CheckRPCStatus returns an error if the RPC of the chain is down or stale
+	CheckRPCStatus() error
+
+	// PostGasPrice posts the gas price of the chain to zetacore
+	PostGasPrice() error
+
+	// CheckInboundTracker checks the inbound of the tracker and posts its vote to zetacore
+	CheckInboundTracker(tracker crosschaintypes.InboundTracker) error
+}
+
+// RPCPool is implemented by the RPC clients balancing the requests over a pool of endpoints
+type RPCPool interface {
+	CheckHealth(ctx context.Context) int
+	Stats() []rpcpool.Stats
+}
+
+// Observer is the base of the chain observers
+// it tracks the last block heights, persists the last scanned block and runs the tickers common to all chains
+type Observer struct {
+	chain          chains.Chain
+	chainParams    observertypes.ChainParams
+	coreContext    *clientcontext.ZetacoreContext
+	zetacoreClient interfaces.ZetacoreClient
+	tss            interfaces.TSSSigner
+	plugin         ChainPlugin
+
+	lastBlock        uint64
+	lastBlockScanned uint64
+
+	blockCache *lru.Cache
+	db         *gorm.DB
+	ts         *metrics.TelemetryServer
+	logger     ObserverLogger
+
+	// mu protects the chain params and the chain-specific state of the observer
+	mu   *sync.Mutex
+	stop chan struct{}
+
+	// watchers are the goroutines started by Go, the database is closed once they return
+	watchers sync.WaitGroup
+}
+
+// NewObserver creates a base observer caching up to 'blockCacheSize' blocks
+func NewObserver(
+	chain chains.Chain,
+	chainParams observertypes.ChainParams,
+	coreContext *clientcontext.ZetacoreContext,
+	zetacoreClient interfaces.ZetacoreClient,
+	tss interfaces.TSSSigner,
+	blockCacheSize int,
+	ts *metrics.TelemetryServer,
+	loggers clientcommon.ClientLogger,
+) (*Observer, error) {
+	blockCache, err := lru.New(blockCacheSize)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating block cache for chain %d", chain.ChainId)
+	}
+
+	ob := &Observer{
+		chain:          chain,
+		chainParams:    chainParams,
+		coreContext:    coreContext,
+		zetacoreClient: zetacoreClient,
+		tss:            tss,
+		blockCache:     blockCache,
+		ts:             ts,
+		mu:             &sync.Mutex{},
+		stop:           make(chan struct{}),
+	}
+	ob.WithLogger(loggers.Std.With().Str("chain", chain.ChainName.String()).Logger())
+	ob.logger.Compliance = loggers.Compliance
+
+	return ob, nil
+}
+
+// WithPlugin attaches the chain-specific part to the observer
+func (ob *Observer) WithPlugin(plugin ChainPlugin) {
+	ob.plugin = plugin
+}
+
+// WithChain attaches a new chain to the observer
+// the chain is not expected to change once the observer is started
+func (ob *Observer) WithChain(chain chains.Chain) {
+	ob.chain = chain
+}
+
+// Chain returns the chain of the observer
+func (ob *Observer) Chain() chains.Chain {
+	return ob.chain
+}
+
+// SetChainParams sets the chain params for the observer
+func (ob *Observer) SetChainParams(params observertypes.ChainParams) {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+	ob.chainParams = params
+}
+
+// GetChainParams returns the chain params for the observer
+func (ob *Observer) GetChainParams() observertypes.ChainParams {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+	return ob.chainParams
+}
+
+// CoreContext returns the zetacore context of the observer
+func (ob *Observer) CoreContext() *clientcontext.ZetacoreContext {
+	return ob.coreContext
+}
+
+// WithZetacoreClient attaches a new client to interact with zetacore to the observer
+func (ob *Observer) WithZetacoreClient(client interfaces.ZetacoreClient) {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+	ob.zetacoreClient = client
+}
+
+// ZetacoreClient returns the client to interact with zetacore
+func (ob *Observer) ZetacoreClient() interfaces.ZetacoreClient {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+	return ob.zetacoreClient
+}
+
+// TSS returns the TSS signer of the observer
+func (ob *Observer) TSS() interfaces.TSSSigner {
+	return ob.tss
+}
+
+// WithBlockCache attaches a new block cache to the observer
+func (ob *Observer) WithBlockCache(cache *lru.Cache) {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+	ob.blockCache = cache
+}
+
+// BlockCache returns the block cache of the observer
+func (ob *Observer) BlockCache() *lru.Cache {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+	return ob.blockCache
+}
+
+// DB returns the database of the observer, nil if not opened
+func (ob *Observer) DB() *gorm.DB {
+	return ob.db
+}
+
+// TelemetryServer returns the telemetry server of the observer
+func (ob *Observer) TelemetryServer() *metrics.TelemetryServer {
+	return ob.ts
+}
+
+// WithLogger attaches a new logger to the observer
+func (ob *Observer) WithLogger(logger zerolog.Logger) {
+	ob.logger = ObserverLogger{
+		Chain:      logger,
+		Inbound:    logger.With().Str("module", "WatchInbound").Logger(),
+		Outbound:   logger.With().Str("module", "WatchOutbound").Logger(),
+		GasPrice:   logger.With().Str("module", "WatchGasPrice").Logger(),
+		Compliance: ob.logger.Compliance,
+	}
+}
+
+// Logger returns the loggers of the observer
+func (ob *Observer) Logger() *ObserverLogger {
+	return &ob.logger
+}
+
+// Mu returns the lock protecting the chain params and the chain-specific state of the observer
+func (ob *Observer) Mu() *sync.Mutex {
+	return ob.mu
+}
+
+// StopChannel returns the channel closed when the observer is stopped
+func (ob *Observer) StopChannel() chan struct{} {
+	return ob.stop
+}
+
+// Go runs the watcher in a goroutine until the observer is stopped
+func (ob *Observer) Go(watcher func()) {
+	ob.watchers.Add(1)
+	go func() {
+		defer ob.watchers.Done()
+		watcher()
+	}()
+}
+
+// Stop notifies all the goroutines of the observer to stop, waits for the watchers to return and closes the database
+func (ob *Observer) Stop() {
+	ob.logger.Chain.Info().Msgf("observer %s is stopping", ob.chain.String())
+	close(ob.stop)
+	ob.watchers.Wait()
+
+	if ob.db != nil {
+		dbInst, err := ob.db.DB()
+		if err != nil {
+			ob.logger.Chain.Error().Err(err).Msg("error getting database instance")
+		} else if err := dbInst.Close(); err != nil {
+			ob.logger.Chain.Error().Err(err).Msg("error closing database")
+		}
+	}
+
+	ob.logger.Chain.Info().Msgf("observer %s stopped", ob.chain.String())
+}
+
+// SetLastBlockHeight sets the external last block height
+func (ob *Observer) SetLastBlockHeight(height uint64) {
+	atomic.StoreUint64(&ob.lastBlock, height)
+}
+
+// GetLastBlockHeight returns the external last block height
+func (ob *Observer) GetLastBlockHeight() uint64 {
+	return atomic.LoadUint64(&ob.lastBlock)
+}
+
+// SetLastBlockHeightScanned sets the last block height scanned (not necessarily caught up with external block; could be slow/paused)
+func (ob *Observer) SetLastBlockHeightScanned(height uint64) {
+	atomic.StoreUint64(&ob.lastBlockScanned, height)
+	metrics.LastScannedBlockNumber.WithLabelValues(ob.chain.ChainName.String()).Set(float64(height))
+}
+
+// GetLastBlockHeightScanned returns the last block height scanned (not necessarily caught up with external block; could be slow/paused)
+func (ob *Observer) GetLastBlockHeightScanned() uint64 {
+	return atomic.LoadUint64(&ob.lastBlockScanned)
+}
+
+// OpenDB opens the sqlite database 'dbName' in the directory 'dbPath' and migrates the last block and given models
+func (ob *Observer) OpenDB(dbPath string, dbName string, models ...interface{}) error {
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		if err := os.MkdirAll(dbPath, os.ModePerm); err != nil {
+			return err
+		}
+	}
+	path := fmt.Sprintf("%s/%s", dbPath, dbName)
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		ob.logger.Chain.Error().Err(err).Msgf("failed to open observer database for %s", ob.chain.ChainName.String())
+		return err
+	}
+
+	models = append([]interface{}{&clienttypes.LastBlockSQLType{}}, models...)
+	if err := db.AutoMigrate(models...); err != nil {
+		ob.logger.Chain.Error().Err(err).Msg("error migrating db")
+		return err
+	}
+	ob.db = db
+	return nil
+}
+
+// LoadLastScannedBlock loads the last scanned block from the environment variable '<chain name>_SCAN_FROM',
+// from the database otherwise, or from the latest block of the chain if not found in the database
+// The last scanned block is the height from which the observer should continue scanning for inbound transactions
+func (ob *Observer) LoadLastScannedBlock() error {
+	envvar := ob.chain.ChainName.String() + EnvVarScanFromSuffix
+	scanFromBlock := os.Getenv(envvar)
+
+	switch {
+	case scanFromBlock == clienttypes.EnvVarLatest:
+		ob.logger.Chain.Info().Msgf("LoadLastScannedBlock: envvar %s is set; scan from latest block", envvar)
+		latest, err := ob.plugin.LatestBlockHeight()
+		if err != nil {
+			return err
+		}
+		ob.SetLastBlockHeightScanned(latest)
+	case scanFromBlock != "":
+		ob.logger.Chain.Info().Msgf("LoadLastScannedBlock: envvar %s is set; scan from block %s", envvar, scanFromBlock)
+		height, err := strconv.ParseUint(scanFromBlock, 10, 64)
+		if err != nil {
+			return err
+		}
+		ob.SetLastBlockHeightScanned(height)
+	default:
+		var lastBlock clienttypes.LastBlockSQLType
+		if err := ob.db.First(&lastBlock, clienttypes.LastBlockNumID).Error; err != nil {
+			ob.logger.Chain.Info().Msg("LoadLastScannedBlock: last scanned block not found in DB, scan from latest")
+			latest, err := ob.plugin.LatestBlockHeight()
+			if err != nil {
+				return err
+			}
+			ob.SaveLastBlockScanned(latest)
+		} else {
+			ob.SetLastBlockHeightScanned(lastBlock.Num)
+		}
+	}
+	ob.logger.Chain.Info().
+		Msgf("LoadLastScannedBlock: chain %d starts scanning from block %d", ob.chain.ChainId, ob.GetLastBlockHeightScanned())
+
+	return nil
+}
+
+// SaveLastBlockScanned sets the last block height scanned and persists it to the database
+// an error writing the database is only logged, the height is persisted again by the next scan
+func (ob *Observer) SaveLastBlockScanned(height uint64) {
+	ob.SetLastBlockHeightScanned(height)
+	if ob.db == nil {
+		return
+	}
+	if err := ob.db.Save(clienttypes.ToLastBlockSQLType(height)).Error; err != nil {
+		ob.logger.Chain.Error().Err(err).Msgf("SaveLastBlockScanned: error writing last scanned block %d to db", height)
+	}
+}
+
+// WatchRPCStatus checks the RPC status of the chain at every health check interval
+func (ob *Observer) WatchRPCStatus() {
+	ob.logger.Chain.Info().Msgf("WatchRPCStatus started for chain %d", ob.chain.ChainId)
+	ticker := time.NewTicker(rpcpool.HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if !ob.GetChainParams().IsSupported {
+				continue
+			}
+			if err := ob.plugin.CheckRPCStatus(); err != nil {
+				ob.logger.Chain.Error().Err(err).Msg("RPC status check: RPC down?")
+			}
+		case <-ob.stop:
+			ob.logger.Chain.Info().Msgf("WatchRPCStatus stopped for chain %d", ob.chain.ChainId)
+			return
+		}
+	}
+}
+
+// CheckRPCHealth checks the health of the endpoints of the client so that the requests are routed to the healthiest one
+// it does nothing if the client doesn't balance the requests over a pool of endpoints
+func (ob *Observer) CheckRPCHealth(client interface{}) {
+	pool, ok := client.(RPCPool)
+	if !ok {
+		return
+	}
+	healthy := pool.CheckHealth(context.Background())
+	if healthy == 0 {
+		ob.logger.Chain.Error().Msg("RPC health check: all endpoints are down")
+		return
+	}
+	best := pool.Stats()[0]
+	ob.logger.Chain.Debug().
+		Msgf("RPC health check: %d healthy endpoints, best %s at block %d (%s)", healthy, best.Name, best.Height, best.Latency)
+}
+
+// ChainStatus returns the status of the chain reported by the telemetry server
+// the endpoint statuses are reported if the client balances the requests over a pool of endpoints
+func (ob *Observer) ChainStatus(client interface{}) metrics.ChainStatus {
+	lastBlock := ob.GetLastBlockHeight()
+	lastConfirmed := uint64(0)
+	if confirmationCount := ob.GetChainParams().ConfirmationCount; lastBlock >= confirmationCount {
+		lastConfirmed = lastBlock - confirmationCount
+	}
+	status := metrics.ChainStatus{
+		ChainID:            ob.chain.ChainId,
+		ChainName:          ob.chain.ChainName.String(),
+		LastBlock:          lastBlock,
+		LastScannedBlock:   ob.GetLastBlockHeightScanned(),
+		LastConfirmedBlock: lastConfirmed,
+	}
+	if pool, ok := client.(RPCPool); ok {
+		status.HealthyRPCEndpoints, status.RPCEndpoints = rpcpool.EndpointStatuses(pool.Stats())
+	}
+	return status
+}
+
+// WatchGasPrice posts the gas price of the chain to zetacore at every gas price ticker
+func (ob *Observer) WatchGasPrice() {
+	// report gas price right away as the ticker takes time to kick in
+	if err := ob.plugin.PostGasPrice(); err != nil {
+		ob.logger.GasPrice.Error().Err(err).Msgf("PostGasPrice error for chain %d", ob.chain.ChainId)
+	}
+
+	ticker, err := clienttypes.NewDynamicTicker(
+		fmt.Sprintf("%s_WatchGasPrice", ob.chain.ChainName.String()),
+		ob.GetChainParams().GasPriceTicker,
+	)
+	if err != nil {
+		ob.logger.GasPrice.Error().Err(err).Msg("NewDynamicTicker error")
+		return
+	}
+	ob.logger.GasPrice.Info().Msgf("WatchGasPrice started for chain %d with interval %d",
+		ob.chain.ChainId, ob.GetChainParams().GasPriceTicker)
+
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C():
+			if !ob.GetChainParams().IsSupported {
+				continue
+			}
+			if err := ob.plugin.PostGasPrice(); err != nil {
+				ob.logger.GasPrice.Error().Err(err).Msgf("PostGasPrice error for chain %d", ob.chain.ChainId)
+			}
+			ticker.UpdateInterval(ob.GetChainParams().GasPriceTicker, ob.logger.GasPrice)
+		case <-ob.stop:
+			ob.logger.GasPrice.Info().Msgf("WatchGasPrice stopped for chain %d", ob.chain.ChainId)
+			return
+		}
+	}
+}
+
+// WatchInboundTracker gets the inbound trackers of the chain from zetacore at every inbound ticker and votes on
+// the confirmed inbounds. If this zetaclient has previously broadcast the vote, the tx would be rejected
+func (ob *Observer) WatchInboundTracker() {
+	ticker, err := clienttypes.NewDynamicTicker(
+		fmt.Sprintf("%s_WatchInboundTracker", ob.chain.ChainName.String()),
+		ob.GetChainParams().InboundTicker,
+	)
+	if err != nil {
+		ob.logger.Inbound.Err(err).Msg("error creating ticker")
+		return
+	}
+	defer ticker.Stop()
+
+	ob.logger.Inbound.Info().Msgf("WatchInboundTracker started for chain %d", ob.chain.ChainId)
+	for {
+		select {
+		case <-ticker.C():
+			if !clientcontext.IsInboundObservationEnabled(ob.coreContext, ob.GetChainParams()) {
+				continue
+			}
+			if err := ob.ProcessInboundTrackers(); err != nil {
+				ob.logger.Inbound.Error().Err(err).Msgf("error processing inbound trackers for chain %d", ob.chain.ChainId)
+			}
+			ticker.UpdateInterval(ob.GetChainParams().InboundTicker, ob.logger.Inbound)
+		case <-ob.stop:
+			ob.logger.Inbound.Info().Msgf("WatchInboundTracker stopped for chain %d", ob.chain.ChainId)
+			return
+		}
+	}
+}
+
+// ProcessInboundTrackers checks the inbound trackers of the chain and votes on their inbounds
+// the processing stops at the first tracker failing, it is retried at the next tick
+func (ob *Observer) ProcessInboundTrackers() error {
+	trackers, err := ob.zetacoreClient.GetInboundTrackersForChain(ob.chain.ChainId)
+	if err != nil {
+		return err
+	}
+	for _, tracker := range trackers {
+		ob.logger.Inbound.Info().
+			Msgf("checking tracker for inbound %s coin-type %s chain %d", tracker.TxHash, tracker.CoinType, ob.chain.ChainId)
+		if err := ob.plugin.CheckInboundTracker(tracker); err != nil {
+			return errors.Wrapf(err, "error checking tracker for inbound %s chain %d", tracker.TxHash, ob.chain.ChainId)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package base_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/pkg/coin"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/base"
+	clientcommon "github.com/zeta-chain/zetacore/zetaclient/common"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/context"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
+	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
+)
+
+// fakePlugin is a chain plugin at block 'latest' recording the inbound trackers checked
+type fakePlugin struct {
+	latest     uint64
+	err        error
+	checkedTxs []string
+}
+
+func (p *fakePlugin) LatestBlockHeight() (uint64, error) {
+	return p.latest, p.err
+}
+
+func (p *fakePlugin) CheckRPCStatus() error {
+	return p.err
+}
+
+func (p *fakePlugin) PostGasPrice() error {
+	return p.err
+}
+
+func (p *fakePlugin) CheckInboundTracker(tracker crosschaintypes.InboundTracker) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.checkedTxs = append(p.checkedTxs, tracker.TxHash)
+	return nil
+}
+
+// newTestObserver creates a base observer of Ethereum with the plugin
+func newTestObserver(t *testing.T, plugin *fakePlugin, zetacoreClient *mocks.MockZetacoreClient) *base.Observer {
+	ob, err := base.NewObserver(
+		chains.Ethereum,
+		observertypes.ChainParams{ChainId: chains.Ethereum.ChainId},
+		context.NewZetacoreContext(config.NewConfig()),
+		zetacoreClient,
+		nil,
+		base.DefaultBlockCacheSize,
+		nil,
+		clientcommon.DefaultLoggers(),
+	)
+	require.NoError(t, err)
+	ob.WithPlugin(plugin)
+	return ob
+}
+
+func TestNewObserver(t *testing.T) {
+	t.Run("should create observer", func(t *testing.T) {
+		ob := newTestObserver(t, &fakePlugin{}, mocks.NewMockZetacoreClient())
+		require.Equal(t, chains.Ethereum, ob.Chain())
+		require.Equal(t, chains.Ethereum.ChainId, ob.GetChainParams().ChainId)
+		require.NotNil(t, ob.BlockCache())
+		require.Nil(t, ob.DB())
+	})
+	t.Run("should fail on invalid block cache size", func(t *testing.T) {
+		ob, err := base.NewObserver(
+			chains.Ethereum,
+			observertypes.ChainParams{},
+			nil,
+			nil,
+			nil,
+			0,
+			nil,
+			clientcommon.DefaultLoggers(),
+		)
+		require.ErrorContains(t, err, "error creating block cache")
+		require.Nil(t, ob)
+	})
+}
+
+func TestObserver_Stop(t *testing.T) {
+	dbPath := t.TempDir()
+	ob := newTestObserver(t, &fakePlugin{latest: 100}, mocks.NewMockZetacoreClient())
+	require.NoError(t, ob.OpenDB(dbPath, "test.db"))
+
+	// the watcher persists the last scanned block once stopped, before the database is closed
+	ob.Go(func() {
+		<-ob.StopChannel()
+		time.Sleep(10 * time.Millisecond)
+		ob.SaveLastBlockScanned(90)
+	})
+	ob.Stop()
+
+	// reopen the db
+	ob = newTestObserver(t, &fakePlugin{latest: 100}, mocks.NewMockZetacoreClient())
+	require.NoError(t, ob.OpenDB(dbPath, "test.db"))
+	var lastBlock clienttypes.LastBlockSQLType
+	require.NoError(t, ob.DB().First(&lastBlock, clienttypes.LastBlockNumID).Error)
+	require.EqualValues(t, 90, lastBlock.Num)
+}
+
+func TestLoadLastScannedBlock(t *testing.T) {
+	envvar := chains.Ethereum.ChainName.String() + base.EnvVarScanFromSuffix
+
+	t.Run("should scan from latest block if not found in db", func(t *testing.T) {
+		ob := newTestObserver(t, &fakePlugin{latest: 100}, mocks.NewMockZetacoreClient())
+		require.NoError(t, ob.OpenDB(t.TempDir(), "test.db"))
+		require.NoError(t, ob.LoadLastScannedBlock())
+		require.EqualValues(t, 100, ob.GetLastBlockHeightScanned())
+
+		// the latest block is persisted
+		var lastBlock clienttypes.LastBlockSQLType
+		require.NoError(t, ob.DB().First(&lastBlock, clienttypes.LastBlockNumID).Error)
+		require.EqualValues(t, 100, lastBlock.Num)
+	})
+	t.Run("should scan from block in db", func(t *testing.T) {
+		dbPath := t.TempDir()
+		ob := newTestObserver(t, &fakePlugin{latest: 100}, mocks.NewMockZetacoreClient())
+		require.NoError(t, ob.OpenDB(dbPath, "test.db"))
+		ob.SaveLastBlockScanned(90)
+		ob.Stop()
+
+		// reopen the db
+		ob = newTestObserver(t, &fakePlugin{latest: 100}, mocks.NewMockZetacoreClient())
+		require.NoError(t, ob.OpenDB(dbPath, "test.db"))
+		require.NoError(t, ob.LoadLastScannedBlock())
+		require.EqualValues(t, 90, ob.GetLastBlockHeightScanned())
+	})
+	t.Run("should scan from block in env var", func(t *testing.T) {
+		t.Setenv(envvar, "80")
+		ob := newTestObserver(t, &fakePlugin{latest: 100}, mocks.NewMockZetacoreClient())
+		require.NoError(t, ob.OpenDB(t.TempDir(), "test.db"))
+		ob.SaveLastBlockScanned(90)
+		require.NoError(t, ob.LoadLastScannedBlock())
+		require.EqualValues(t, 80, ob.GetLastBlockHeightScanned())
+	})
+	t.Run("should scan from latest block if set in env var", func(t *testing.T) {
+		t.Setenv(envvar, clienttypes.EnvVarLatest)
+		ob := newTestObserver(t, &fakePlugin{latest: 100}, mocks.NewMockZetacoreClient())
+		require.NoError(t, ob.OpenDB(t.TempDir(), "test.db"))
+		ob.SaveLastBlockScanned(90)
+		require.NoError(t, ob.LoadLastScannedBlock())
+		require.EqualValues(t, 100, ob.GetLastBlockHeightScanned())
+	})
+	t.Run("should fail on invalid env var", func(t *testing.T) {
+		t.Setenv(envvar, "invalid")
+		ob := newTestObserver(t, &fakePlugin{latest: 100}, mocks.NewMockZetacoreClient())
+		require.NoError(t, ob.OpenDB(t.TempDir(), "test.db"))
+		require.Error(t, ob.LoadLastScannedBlock())
+	})
+	t.Run("should fail if latest block can't be fetched", func(t *testing.T) {
+		ob := newTestObserver(t, &fakePlugin{err: errors.New("rpc failed")}, mocks.NewMockZetacoreClient())
+		require.NoError(t, ob.OpenDB(t.TempDir(), "test.db"))
+		require.ErrorContains(t, ob.LoadLastScannedBlock(), "rpc failed")
+	})
+}
+
+func TestProcessInboundTrackers(t *testing.T) {
+	tracker := func(chainID int64) crosschaintypes.InboundTracker {
+		return crosschaintypes.InboundTracker{
+			ChainId:  chainID,
+			TxHash:   sample.Hash().Hex(),
+			CoinType: coin.CoinType_Gas,
+		}
+	}
+	t1, t2 := tracker(chains.Ethereum.ChainId), tracker(chains.Ethereum.ChainId)
+	zetacoreClient := mocks.NewMockZetacoreClient().
+		WithInboundTracker(t1).
+		WithInboundTracker(t2).
+		WithInboundTracker(tracker(chains.BscMainnet.ChainId))
+
+	t.Run("should check the inbound trackers of the chain", func(t *testing.T) {
+		plugin := &fakePlugin{}
+		ob := newTestObserver(t, plugin, zetacoreClient)
+		require.NoError(t, ob.ProcessInboundTrackers())
+		require.Equal(t, []string{t1.TxHash, t2.TxHash}, plugin.checkedTxs)
+	})
+	t.Run("should fail if a tracker can't be checked", func(t *testing.T) {
+		ob := newTestObserver(t, &fakePlugin{err: errors.New("rpc failed")}, zetacoreClient)
+		err := ob.ProcessInboundTrackers()
+		require.ErrorContains(t, err, "rpc failed")
+		require.ErrorContains(t, err, t1.TxHash)
+	})
+}
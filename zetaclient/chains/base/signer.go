@@ -0,0 +1,108 @@
+package base
+
+import (
+	"sync"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	clientcommon "github.com/zeta-chain/zetacore/zetaclient/common"
+	clientcontext "github.com/zeta-chain/zetacore/zetaclient/context"
+	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+)
+
+// Signer is the base of the chain signers
+// the chain-specific signers embed the base signer and implement the building and broadcasting of the outbounds
+type Signer struct {
+	chain       chains.Chain
+	coreContext *clientcontext.ZetacoreContext
+	tss         interfaces.TSSSigner
+	ts          *metrics.TelemetryServer
+	logger      clientcommon.ClientLogger
+
+	// mu protects the outbounds being reported and the chain-specific state of the signer
+	mu                    *sync.Mutex
+	outboundBeingReported map[string]bool
+}
+
+// NewSigner creates a base signer logging with the module name 'module'
+func NewSigner(
+	chain chains.Chain,
+	coreContext *clientcontext.ZetacoreContext,
+	tss interfaces.TSSSigner,
+	ts *metrics.TelemetryServer,
+	loggers clientcommon.ClientLogger,
+	module string,
+) *Signer {
+	return &Signer{
+		chain:       chain,
+		coreContext: coreContext,
+		tss:         tss,
+		ts:          ts,
+		logger: clientcommon.ClientLogger{
+			Std:        loggers.Std.With().Str("chain", chain.ChainName.String()).Str("module", module).Logger(),
+			Compliance: loggers.Compliance,
+		},
+		mu:                    &sync.Mutex{},
+		outboundBeingReported: make(map[string]bool),
+	}
+}
+
+// Chain returns the chain of the signer
+func (s *Signer) Chain() chains.Chain {
+	return s.chain
+}
+
+// CoreContext returns the zetacore context of the signer
+func (s *Signer) CoreContext() *clientcontext.ZetacoreContext {
+	return s.coreContext
+}
+
+// TSS returns the TSS signer of the signer
+func (s *Signer) TSS() interfaces.TSSSigner {
+	return s.tss
+}
+
+// TelemetryServer returns the telemetry server of the signer
+func (s *Signer) TelemetryServer() *metrics.TelemetryServer {
+	return s.ts
+}
+
+// Logger returns the loggers of the signer
+func (s *Signer) Logger() *clientcommon.ClientLogger {
+	return &s.logger
+}
+
+// Mu returns the lock protecting the chain-specific state of the signer
+func (s *Signer) Mu() *sync.Mutex {
+	return s.mu
+}
+
+// SetBeingReportedFlag marks the outbound as being reported to the outbound tracker
+// returns true if the outbound was already being reported
+func (s *Signer) SetBeingReportedFlag(outboundHash string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.outboundBeingReported[outboundHash] {
+		return true
+	}
+	s.outboundBeingReported[outboundHash] = true
+	return false
+}
+
+// ClearBeingReportedFlag unmarks the outbound as being reported to the outbound tracker
+func (s *Signer) ClearBeingReportedFlag(outboundHash string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.outboundBeingReported, outboundHash)
+}
+
+// GetReportedTxList returns the outbounds being reported to the outbound tracker
+func (s *Signer) GetReportedTxList() map[string]bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := make(map[string]bool, len(s.outboundBeingReported))
+	for outboundHash := range s.outboundBeingReported {
+		list[outboundHash] = true
+	}
+	return list
+}
@@ -0,0 +1,28 @@
+package base_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/base"
+	clientcommon "github.com/zeta-chain/zetacore/zetaclient/common"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
+)
+
+func TestSigner_BeingReportedFlag(t *testing.T) {
+	signer := base.NewSigner(chains.Ethereum, nil, mocks.NewTSSMainnet(), nil, clientcommon.DefaultLoggers(), "test")
+	require.Equal(t, chains.Ethereum, signer.Chain())
+
+	// the first report sets the flag
+	require.False(t, signer.SetBeingReportedFlag("0x1"))
+	require.True(t, signer.SetBeingReportedFlag("0x1"))
+	require.False(t, signer.SetBeingReportedFlag("0x2"))
+	require.Equal(t, map[string]bool{"0x1": true, "0x2": true}, signer.GetReportedTxList())
+
+	// the outbound can be reported again once cleared
+	signer.ClearBeingReportedFlag("0x1")
+	require.Equal(t, map[string]bool{"0x2": true}, signer.GetReportedTxList())
+	require.False(t, signer.SetBeingReportedFlag("0x1"))
+}
@@ -127,6 +127,14 @@ func GetOutputSizeByAddress(to btcutil.Address) (uint64, error) {
 	}
 }
 
+// OutboundSizeMax returns the maximum size (in vBytes) of an outbound paying given number of payees
+func OutboundSizeMax(numPayees uint64) uint64 {
+	if numPayees <= 1 {
+		return OutboundBytesMax
+	}
+	return OutboundBytesMax + (numPayees-1)*bytesPerOutputP2TR
+}
+
 // OutboundSizeDepositor returns outbound size (68vB) incurred by the depositor
 func OutboundSizeDepositor() uint64 {
 	return bytesPerInput + bytesPerWitness/blockchain.WitnessScaleFactor
@@ -239,3 +247,36 @@ func CalcDepositorFee(
 
 	return DepositorFee(feeRate)
 }
+
+// GetTxFeeRate calculates the fee rate (in sat/vByte) paid by a transaction spending the given previous outputs
+func GetTxFeeRate(rawTx *btcjson.TxRawResult, prevOuts []btcjson.ListUnspentResult) (int64, error) {
+	if rawTx.Vsize <= 0 {
+		return 0, fmt.Errorf("invalid vsize %d for tx %s", rawTx.Vsize, rawTx.Txid)
+	}
+	if len(rawTx.Vin) != len(prevOuts) {
+		return 0, fmt.Errorf("tx %s has %d inputs, got %d previous outputs", rawTx.Txid, len(rawTx.Vin), len(prevOuts))
+	}
+
+	// sum up the value of inputs and outputs
+	totalIn := int64(0)
+	for _, prevOut := range prevOuts {
+		amount, err := GetSatoshis(prevOut.Amount)
+		if err != nil {
+			return 0, err
+		}
+		totalIn += amount
+	}
+	totalOut := int64(0)
+	for _, vout := range rawTx.Vout {
+		amount, err := GetSatoshis(vout.Value)
+		if err != nil {
+			return 0, err
+		}
+		totalOut += amount
+	}
+	if totalIn < totalOut {
+		return 0, fmt.Errorf("tx %s spends %d satoshis, more than inputs %d", rawTx.Txid, totalOut, totalIn)
+	}
+
+	return (totalIn - totalOut) / int64(rawTx.Vsize), nil
+}
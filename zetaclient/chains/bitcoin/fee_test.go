@@ -6,6 +6,7 @@ import (
 
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
@@ -462,3 +463,51 @@ func TestOutboundSizeMinMaxError(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, uint64(0), size)
 }
+
+func TestOutboundSizeMax(t *testing.T) {
+	// P2TR output is the largest in size
+	toP2TR := getTestAddrScript(t, ScriptTypeP2TR)
+
+	// Estimate the largest outbound size paying 5 payees in vByte
+	payees := []btcutil.Address{toP2TR, toP2TR, toP2TR, toP2TR, toP2TR}
+	sizeMax, err := EstimateOutboundSize(21, payees)
+	require.NoError(t, err)
+	require.Equal(t, OutboundSizeMax(uint64(len(payees))), sizeMax)
+
+	// single payee outbound
+	require.Equal(t, OutboundBytesMax, OutboundSizeMax(1))
+	require.Equal(t, OutboundBytesMax, OutboundSizeMax(0))
+}
+
+func TestGetTxFeeRate(t *testing.T) {
+	prevOuts := []btcjson.ListUnspentResult{{Amount: 0.0001}, {Amount: 0.0002}}
+	rawTx := &btcjson.TxRawResult{
+		Txid:  "test",
+		Vsize: 200,
+		Vin:   []btcjson.Vin{{}, {}},
+		Vout:  []btcjson.Vout{{Value: 0.00001}, {Value: 0.00025}},
+	}
+
+	t.Run("should calculate fee rate", func(t *testing.T) {
+		// fee = 30000 - 26000 = 4000 sats
+		feeRate, err := GetTxFeeRate(rawTx, prevOuts)
+		require.NoError(t, err)
+		require.Equal(t, int64(20), feeRate)
+	})
+	t.Run("should fail on invalid vsize", func(t *testing.T) {
+		invalidTx := *rawTx
+		invalidTx.Vsize = 0
+		_, err := GetTxFeeRate(&invalidTx, prevOuts)
+		require.ErrorContains(t, err, "invalid vsize")
+	})
+	t.Run("should fail on mismatched inputs", func(t *testing.T) {
+		_, err := GetTxFeeRate(rawTx, prevOuts[:1])
+		require.ErrorContains(t, err, "previous outputs")
+	})
+	t.Run("should fail if outputs exceed inputs", func(t *testing.T) {
+		invalidTx := *rawTx
+		invalidTx.Vout = []btcjson.Vout{{Value: 0.0004}}
+		_, err := GetTxFeeRate(&invalidTx, prevOuts)
+		require.ErrorContains(t, err, "more than inputs")
+	})
+}
@@ -0,0 +1,196 @@
+package bitcoin
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/txscript"
+)
+
+const (
+	// MaxInscriptionMemoLength is the maximum length of a memo inscribed in a taproot witness
+	// the memo [ receiver 20B, payload ] is hex encoded in the inbound message limited to 10240 characters
+	MaxInscriptionMemoLength = 5120
+
+	// taprootAnnexTag is the first byte of the optional annex, the last item of a taproot witness
+	taprootAnnexTag = 0x50
+
+	// taprootLeafMask masks the leaf version out of the first byte of the control block
+	taprootLeafMask = 0xfe
+
+	// BaseLeafVersion is the tapscript leaf version defined in BIP342
+	BaseLeafVersion = 0xc0
+
+	// controlBlockBaseSize is the size of a control block without merkle path [ leaf version | parity, internal key ]
+	controlBlockBaseSize = 33
+
+	// controlBlockNodeSize is the size of a node in the merkle path of a control block
+	controlBlockNodeSize = 32
+
+	// xOnlyPubKeyLength is the length of a BIP340 x-only public key
+	xOnlyPubKeyLength = 32
+)
+
+// EncodeInscriptionScript encodes the memo in the tapscript envelope of a commit/reveal deposit
+//
+// <x-only pubkey> OP_CHECKSIG OP_FALSE OP_IF <memo chunk 1> ... <memo chunk n> OP_ENDIF
+//
+// the memo is split in chunks of at most 520 bytes, the maximum size of a script element
+// the chunks are always pushed with data opcodes, never with the small integer opcodes
+func EncodeInscriptionScript(xOnlyPubKey []byte, memo []byte) ([]byte, error) {
+	if len(xOnlyPubKey) != xOnlyPubKeyLength {
+		return nil, fmt.Errorf("invalid x-only public key length %d", len(xOnlyPubKey))
+	}
+	if len(memo) == 0 || len(memo) > MaxInscriptionMemoLength {
+		return nil, fmt.Errorf("invalid inscription memo length %d", len(memo))
+	}
+
+	script := []byte{txscript.OP_DATA_32}
+	script = append(script, xOnlyPubKey...)
+	script = append(script, txscript.OP_CHECKSIG, txscript.OP_FALSE, txscript.OP_IF)
+	for start := 0; start < len(memo); start += txscript.MaxScriptElementSize {
+		end := start + txscript.MaxScriptElementSize
+		if end > len(memo) {
+			end = len(memo)
+		}
+		chunk := memo[start:end]
+		switch {
+		case len(chunk) < txscript.OP_PUSHDATA1:
+			script = append(script, byte(len(chunk)))
+		case len(chunk) <= 0xff:
+			script = append(script, txscript.OP_PUSHDATA1, byte(len(chunk)))
+		default:
+			script = append(script, txscript.OP_PUSHDATA2, 0, 0)
+			binary.LittleEndian.PutUint16(script[len(script)-2:], uint16(len(chunk)))
+		}
+		script = append(script, chunk...)
+	}
+	return append(script, txscript.OP_ENDIF), nil
+}
+
+// DecodeInscriptionMemo decodes the memo inscribed in the witness of a taproot script-path spend
+// 'witness' is the hex encoded witness [ ..., script, control block, (annex) ] of the input
+// returns (memo, found, error), 'found' is false if the witness is not a tapscript envelope
+func DecodeInscriptionMemo(witness []string) ([]byte, bool, error) {
+	items := make([][]byte, 0, len(witness))
+	for _, item := range witness {
+		data, err := hex.DecodeString(item)
+		if err != nil {
+			return nil, false, fmt.Errorf("error hex decoding witness item %s: %w", item, err)
+		}
+		items = append(items, data)
+	}
+
+	// the annex is present if there are at least two items and the last one starts with the annex tag
+	if len(items) >= 2 && len(items[len(items)-1]) > 0 && items[len(items)-1][0] == taprootAnnexTag {
+		items = items[:len(items)-1]
+	}
+
+	// script-path spend [ ..., script, control block ], key-path spend and segwit v0 witnesses are skipped
+	if len(items) < 2 {
+		return nil, false, nil
+	}
+	controlBlock := items[len(items)-1]
+	if !isControlBlock(controlBlock) {
+		return nil, false, nil
+	}
+	return decodeInscriptionScript(items[len(items)-2])
+}
+
+// isControlBlock returns true if the witness item is a control block of a tapscript leaf
+func isControlBlock(item []byte) bool {
+	if len(item) < controlBlockBaseSize || (len(item)-controlBlockBaseSize)%controlBlockNodeSize != 0 {
+		return false
+	}
+	return item[0]&taprootLeafMask == BaseLeafVersion
+}
+
+// decodeInscriptionScript decodes the memo from the tapscript envelope, see EncodeInscriptionScript
+func decodeInscriptionScript(script []byte) ([]byte, bool, error) {
+	// envelope header [ OP_DATA_32 <x-only pubkey> OP_CHECKSIG OP_FALSE OP_IF ]
+	headerLength := 1 + xOnlyPubKeyLength + 3
+	if len(script) < headerLength ||
+		script[0] != txscript.OP_DATA_32 ||
+		script[1+xOnlyPubKeyLength] != txscript.OP_CHECKSIG ||
+		script[2+xOnlyPubKeyLength] != txscript.OP_FALSE ||
+		script[3+xOnlyPubKeyLength] != txscript.OP_IF {
+		return nil, false, nil
+	}
+
+	memo := make([]byte, 0)
+	offset := headerLength
+	for {
+		if offset >= len(script) {
+			return nil, false, fmt.Errorf("inscription envelope not terminated by OP_ENDIF")
+		}
+		if script[offset] == txscript.OP_ENDIF {
+			offset++
+			break
+		}
+		data, next, err := readScriptPush(script, offset)
+		if err != nil {
+			return nil, false, err
+		}
+		memo = append(memo, data...)
+		if len(memo) > MaxInscriptionMemoLength {
+			return nil, false, fmt.Errorf("inscription memo exceeds %d bytes", MaxInscriptionMemoLength)
+		}
+		offset = next
+	}
+
+	if offset != len(script) {
+		return nil, false, fmt.Errorf("unexpected %d bytes after inscription envelope", len(script)-offset)
+	}
+	if len(memo) == 0 {
+		return nil, false, fmt.Errorf("empty inscription memo")
+	}
+	return memo, true, nil
+}
+
+// readScriptPush reads the data push at 'offset' of the script
+// returns (data, offset of the next opcode, error)
+func readScriptPush(script []byte, offset int) ([]byte, int, error) {
+	opcode := script[offset]
+	offset++
+
+	var length int
+	switch {
+	case opcode == txscript.OP_0:
+		return nil, offset, nil
+	case opcode >= txscript.OP_1 && opcode <= txscript.OP_16:
+		return []byte{opcode - (txscript.OP_1 - 1)}, offset, nil
+	case opcode == txscript.OP_1NEGATE:
+		return []byte{0x81}, offset, nil
+	case opcode >= txscript.OP_DATA_1 && opcode <= txscript.OP_DATA_75:
+		length = int(opcode)
+	case opcode == txscript.OP_PUSHDATA1:
+		if len(script) < offset+1 {
+			return nil, 0, fmt.Errorf("truncated OP_PUSHDATA1 length")
+		}
+		length = int(script[offset])
+		offset++
+	case opcode == txscript.OP_PUSHDATA2:
+		if len(script) < offset+2 {
+			return nil, 0, fmt.Errorf("truncated OP_PUSHDATA2 length")
+		}
+		length = int(binary.LittleEndian.Uint16(script[offset:]))
+		offset += 2
+	case opcode == txscript.OP_PUSHDATA4:
+		if len(script) < offset+4 {
+			return nil, 0, fmt.Errorf("truncated OP_PUSHDATA4 length")
+		}
+		length = int(binary.LittleEndian.Uint32(script[offset:]))
+		offset += 4
+	default:
+		return nil, 0, fmt.Errorf("unexpected opcode 0x%02x in inscription envelope", opcode)
+	}
+
+	if length > txscript.MaxScriptElementSize {
+		return nil, 0, fmt.Errorf("data push of %d bytes exceeds %d bytes", length, txscript.MaxScriptElementSize)
+	}
+	if len(script) < offset+length {
+		return nil, 0, fmt.Errorf("truncated data push of %d bytes", length)
+	}
+	return script[offset : offset+length], offset + length, nil
+}
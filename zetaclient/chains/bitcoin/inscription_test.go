@@ -0,0 +1,132 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/testutil/sample"
+)
+
+// inscriptionWitness creates the hex encoded witness [ signature, script, control block ] of a reveal tx
+func inscriptionWitness(script []byte) []string {
+	signature := bytes.Repeat([]byte{0x01}, 64)
+	controlBlock := append([]byte{BaseLeafVersion | 0x01}, bytes.Repeat([]byte{0x02}, 32)...)
+	return []string{
+		hex.EncodeToString(signature),
+		hex.EncodeToString(script),
+		hex.EncodeToString(controlBlock),
+	}
+}
+
+func TestDecodeInscriptionMemo(t *testing.T) {
+	xOnlyPubKey := bytes.Repeat([]byte{0x03}, 32)
+	receiver := sample.EthAddress()
+	payload := bytes.Repeat([]byte{0xab}, 1200)
+	memo := EncodeInboundMemo(MemoOpDepositAndCall, receiver, nil, payload)
+
+	script, err := EncodeInscriptionScript(xOnlyPubKey, memo)
+	require.NoError(t, err)
+
+	t.Run("should decode memo larger than a script element", func(t *testing.T) {
+		decoded, found, err := DecodeInscriptionMemo(inscriptionWitness(script))
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, memo, decoded)
+	})
+	t.Run("should decode memo ending with a single byte chunk", func(t *testing.T) {
+		memo := append(bytes.Repeat([]byte{0xcd}, txscript.MaxScriptElementSize), 0x00)
+		script, err := EncodeInscriptionScript(xOnlyPubKey, memo)
+		require.NoError(t, err)
+
+		decoded, found, err := DecodeInscriptionMemo(inscriptionWitness(script))
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, memo, decoded)
+	})
+	t.Run("should decode memo with annex", func(t *testing.T) {
+		witness := append(inscriptionWitness(script), "50aa")
+		decoded, found, err := DecodeInscriptionMemo(witness)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, memo, decoded)
+	})
+	t.Run("should decode memo pushed with small integer opcodes", func(t *testing.T) {
+		script := append([]byte{txscript.OP_DATA_32}, xOnlyPubKey...)
+		script = append(script, txscript.OP_CHECKSIG, txscript.OP_FALSE, txscript.OP_IF)
+		script = append(script, txscript.OP_DATA_2, 0x5a, 0x00, txscript.OP_1, txscript.OP_16, txscript.OP_ENDIF)
+
+		decoded, found, err := DecodeInscriptionMemo(inscriptionWitness(script))
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, []byte{0x5a, 0x00, 0x01, 0x10}, decoded)
+	})
+
+	// witnesses that are not tapscript envelopes
+	notFound := map[string][]string{
+		"empty witness":    nil,
+		"key-path spend":   {hex.EncodeToString(bytes.Repeat([]byte{0x01}, 64))},
+		"P2WPKH spend":     {hex.EncodeToString(bytes.Repeat([]byte{0x01}, 71)), "02" + hex.EncodeToString(xOnlyPubKey)},
+		"invalid leaf":     {hex.EncodeToString(script), "c2" + hex.EncodeToString(xOnlyPubKey)},
+		"non-envelope tap": inscriptionWitness([]byte{txscript.OP_TRUE}),
+	}
+	for name, witness := range notFound {
+		t.Run("should not find memo: "+name, func(t *testing.T) {
+			decoded, found, err := DecodeInscriptionMemo(witness)
+			require.NoError(t, err)
+			require.False(t, found)
+			require.Nil(t, decoded)
+		})
+	}
+}
+
+func TestDecodeInscriptionMemoErrors(t *testing.T) {
+	xOnlyPubKey := bytes.Repeat([]byte{0x03}, 32)
+	header := append([]byte{txscript.OP_DATA_32}, xOnlyPubKey...)
+	header = append(header, txscript.OP_CHECKSIG, txscript.OP_FALSE, txscript.OP_IF)
+	envelope := func(body ...byte) []byte {
+		return append(append([]byte{}, header...), body...)
+	}
+
+	// memo of the maximum length plus one byte
+	oversized, err := EncodeInscriptionScript(xOnlyPubKey, bytes.Repeat([]byte{0x01}, MaxInscriptionMemoLength))
+	require.NoError(t, err)
+	oversized = append(oversized[:len(oversized)-1], txscript.OP_DATA_1, 0x01, txscript.OP_ENDIF)
+
+	invalidScripts := map[string]struct {
+		script   []byte
+		errorMsg string
+	}{
+		"missing OP_ENDIF":   {envelope(txscript.OP_DATA_1, 0x01), "not terminated by OP_ENDIF"},
+		"truncated push":     {envelope(txscript.OP_DATA_2, 0x01), "truncated data push"},
+		"truncated length":   {envelope(txscript.OP_PUSHDATA2, 0x01), "truncated OP_PUSHDATA2 length"},
+		"oversized push":     {envelope(txscript.OP_PUSHDATA2, 0x09, 0x02), "exceeds 520 bytes"},
+		"unexpected opcode":  {envelope(txscript.OP_CHECKSIG, txscript.OP_ENDIF), "unexpected opcode"},
+		"trailing opcodes":   {envelope(txscript.OP_DATA_1, 0x01, txscript.OP_ENDIF, txscript.OP_TRUE), "after inscription"},
+		"empty memo":         {envelope(txscript.OP_ENDIF), "empty inscription memo"},
+		"memo exceeds limit": {oversized, "exceeds 5120 bytes"},
+	}
+	for name, tt := range invalidScripts {
+		t.Run("should fail on "+name, func(t *testing.T) {
+			decoded, found, err := DecodeInscriptionMemo(inscriptionWitness(tt.script))
+			require.ErrorContains(t, err, tt.errorMsg)
+			require.False(t, found)
+			require.Nil(t, decoded)
+		})
+	}
+	t.Run("should fail on invalid hex witness", func(t *testing.T) {
+		_, _, err := DecodeInscriptionMemo([]string{"0x"})
+		require.ErrorContains(t, err, "error hex decoding witness item")
+	})
+	t.Run("should fail to encode invalid memo", func(t *testing.T) {
+		_, err := EncodeInscriptionScript(xOnlyPubKey, nil)
+		require.Error(t, err)
+		_, err = EncodeInscriptionScript(xOnlyPubKey, make([]byte, MaxInscriptionMemoLength+1))
+		require.Error(t, err)
+		_, err = EncodeInscriptionScript(xOnlyPubKey[:31], []byte{0x01})
+		require.Error(t, err)
+	})
+}
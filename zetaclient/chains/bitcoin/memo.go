@@ -0,0 +1,170 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+)
+
+// MemoOpCode is the operation requested by the depositor in the memo
+type MemoOpCode uint8
+
+const (
+	// MemoIdentifier is the first byte of a structured memo
+	MemoIdentifier = 'Z'
+
+	// MemoVersion0 is the version of the structured memo format
+	//
+	// [0]           identifier 'Z'
+	// [1]           version 0
+	// [2]           op code, see MemoOpCode
+	// [3]           flags, bit 0 is set if the revert address is present, the other bits must be zero
+	// [4:24]        receiver address on ZetaChain
+	// [24]          revert script length n, if the revert address is present
+	// [25:25+n]     revert address pkScript on the sender chain, if the revert address is present
+	// [...]         payload, the call data of a deposit and call, must be empty for a deposit
+	MemoVersion0 = 0
+
+	// MemoVersionLegacy is the version of the legacy memo [ receiver 20B, payload ]
+	MemoVersionLegacy = 0xff
+
+	// MemoFlagRevertAddress is the flag set if the memo contains a revert address
+	MemoFlagRevertAddress = 1 << 0
+
+	// memoHeaderLength is the length of the structured memo header [identifier, version, op code, flags]
+	memoHeaderLength = 4
+
+	// MemoOpDeposit deposits the BTC to the receiver
+	MemoOpDeposit MemoOpCode = 0
+
+	// MemoOpDepositAndCall deposits the BTC to the receiver contract and calls it with the payload
+	MemoOpDepositAndCall MemoOpCode = 1
+)
+
+// InboundMemo is the memo of a BTC deposit
+type InboundMemo struct {
+	// Version is the version of the memo format, MemoVersionLegacy for the legacy memos
+	Version uint8
+
+	// OpCode is the operation requested by the depositor
+	OpCode MemoOpCode
+
+	// Receiver is the receiver address on ZetaChain
+	Receiver ethcommon.Address
+
+	// RevertAddress is the address on the sender chain receiving the reverted BTC, empty to revert to the sender
+	RevertAddress string
+
+	// Payload is the call data of a deposit and call
+	Payload []byte
+}
+
+// DecodeInboundMemo decodes the memo of a BTC deposit
+// the memo is decoded with the structured format if it starts with the structured memo header, and is rejected
+// if invalid, it is decoded with the legacy format [ receiver 20B, payload ] otherwise
+func DecodeInboundMemo(memo []byte, net *chaincfg.Params) (*InboundMemo, error) {
+	if len(memo) >= 2 && memo[0] == MemoIdentifier && memo[1] == MemoVersion0 {
+		if len(memo) < memoHeaderLength {
+			return nil, fmt.Errorf("memo too short for header: %d bytes", len(memo))
+		}
+		inboundMemo, err := decodeMemoVersion0(memo, net)
+		if err != nil {
+			return nil, fmt.Errorf("invalid structured memo: %w", err)
+		}
+		return inboundMemo, nil
+	}
+
+	receiver, payload, err := chains.ParseAddressAndData(hex.EncodeToString(memo))
+	if err != nil {
+		return nil, err
+	}
+	opCode := MemoOpDeposit
+	if len(payload) > 0 {
+		opCode = MemoOpDepositAndCall
+	}
+	return &InboundMemo{
+		Version:  MemoVersionLegacy,
+		OpCode:   opCode,
+		Receiver: receiver,
+		Payload:  payload,
+	}, nil
+}
+
+// decodeMemoVersion0 decodes a structured memo of version 0
+func decodeMemoVersion0(memo []byte, net *chaincfg.Params) (*InboundMemo, error) {
+	opCode := MemoOpCode(memo[2])
+	if opCode != MemoOpDeposit && opCode != MemoOpDepositAndCall {
+		return nil, fmt.Errorf("invalid op code %d", opCode)
+	}
+	flags := memo[3]
+	if flags&^MemoFlagRevertAddress != 0 {
+		return nil, fmt.Errorf("invalid flags %08b", flags)
+	}
+
+	offset := memoHeaderLength
+	if len(memo) < offset+ethcommon.AddressLength {
+		return nil, fmt.Errorf("memo too short for receiver: %d bytes", len(memo))
+	}
+	inboundMemo := &InboundMemo{
+		Version:  MemoVersion0,
+		OpCode:   opCode,
+		Receiver: ethcommon.BytesToAddress(memo[offset : offset+ethcommon.AddressLength]),
+	}
+	if inboundMemo.Receiver == (ethcommon.Address{}) {
+		return nil, fmt.Errorf("empty receiver")
+	}
+	offset += ethcommon.AddressLength
+
+	if flags&MemoFlagRevertAddress != 0 {
+		if len(memo) < offset+1 {
+			return nil, fmt.Errorf("memo too short for revert address length")
+		}
+		scriptLength := int(memo[offset])
+		offset++
+		if len(memo) < offset+scriptLength {
+			return nil, fmt.Errorf("memo too short for revert address: %d bytes", len(memo))
+		}
+		revertAddress, err := DecodeScriptAddress(memo[offset:offset+scriptLength], net)
+		if err != nil {
+			return nil, fmt.Errorf("invalid revert address: %w", err)
+		}
+		if revertAddress == "" {
+			return nil, fmt.Errorf("unsupported revert address script %x", memo[offset:offset+scriptLength])
+		}
+		inboundMemo.RevertAddress = revertAddress
+		offset += scriptLength
+	}
+
+	if len(memo) > offset {
+		if opCode == MemoOpDeposit {
+			return nil, fmt.Errorf("unexpected payload for deposit")
+		}
+		inboundMemo.Payload = memo[offset:]
+	}
+	return inboundMemo, nil
+}
+
+// EncodeMessage encodes the receiver and payload into the hex message [ receiver 20B, payload ] of the inbound vote
+func (m *InboundMemo) EncodeMessage() string {
+	return hex.EncodeToString(append(m.Receiver.Bytes(), m.Payload...))
+}
+
+// EncodeInboundMemo encodes a structured memo of version 0
+// 'revertScript' is the pkScript of the revert address, nil to revert to the sender
+func EncodeInboundMemo(opCode MemoOpCode, receiver ethcommon.Address, revertScript []byte, payload []byte) []byte {
+	var flags byte
+	if len(revertScript) > 0 {
+		flags |= MemoFlagRevertAddress
+	}
+	memo := []byte{MemoIdentifier, MemoVersion0, byte(opCode), flags}
+	memo = append(memo, receiver.Bytes()...)
+	if len(revertScript) > 0 {
+		memo = append(memo, byte(len(revertScript)))
+		memo = append(memo, revertScript...)
+	}
+	return append(memo, payload...)
+}
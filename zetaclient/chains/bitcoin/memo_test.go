@@ -0,0 +1,95 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/testutil/sample"
+)
+
+func TestDecodeInboundMemo(t *testing.T) {
+	net := &chaincfg.MainNetParams
+	receiver := sample.EthAddress()
+	payload := []byte("hello satoshi")
+
+	// revert addresses and their pkScripts
+	revertP2WPKH := "bc1qysd4sp9q8my59ul9wsf5rvs9p387hf8vfwatzu"
+	addrP2WPKH, err := btcutil.DecodeAddress(revertP2WPKH, net)
+	require.NoError(t, err)
+	scriptP2WPKH, err := PayToAddrScript(addrP2WPKH)
+	require.NoError(t, err)
+
+	addrP2PKH, err := btcutil.NewAddressPubKeyHash(receiver.Bytes(), net)
+	require.NoError(t, err)
+	scriptP2PKH, err := PayToAddrScript(addrP2PKH)
+	require.NoError(t, err)
+
+	t.Run("should decode deposit memo", func(t *testing.T) {
+		memo, err := DecodeInboundMemo(EncodeInboundMemo(MemoOpDeposit, receiver, nil, nil), net)
+		require.NoError(t, err)
+		require.EqualValues(t, MemoVersion0, memo.Version)
+		require.Equal(t, MemoOpDeposit, memo.OpCode)
+		require.Equal(t, receiver, memo.Receiver)
+		require.Empty(t, memo.RevertAddress)
+		require.Empty(t, memo.Payload)
+		require.Equal(t, hex.EncodeToString(receiver.Bytes()), memo.EncodeMessage())
+	})
+	t.Run("should decode deposit memo with revert address", func(t *testing.T) {
+		memo, err := DecodeInboundMemo(EncodeInboundMemo(MemoOpDeposit, receiver, scriptP2WPKH, nil), net)
+		require.NoError(t, err)
+		require.Equal(t, receiver, memo.Receiver)
+		require.Equal(t, revertP2WPKH, memo.RevertAddress)
+	})
+	t.Run("should decode deposit and call memo with revert address", func(t *testing.T) {
+		memo, err := DecodeInboundMemo(EncodeInboundMemo(MemoOpDepositAndCall, receiver, scriptP2PKH, payload), net)
+		require.NoError(t, err)
+		require.Equal(t, MemoOpDepositAndCall, memo.OpCode)
+		require.Equal(t, receiver, memo.Receiver)
+		require.Equal(t, addrP2PKH.EncodeAddress(), memo.RevertAddress)
+		require.Equal(t, payload, memo.Payload)
+		require.Equal(t, hex.EncodeToString(append(receiver.Bytes(), payload...)), memo.EncodeMessage())
+	})
+	t.Run("should decode legacy memo", func(t *testing.T) {
+		memo, err := DecodeInboundMemo(append(receiver.Bytes(), payload...), net)
+		require.NoError(t, err)
+		require.EqualValues(t, MemoVersionLegacy, memo.Version)
+		require.Equal(t, MemoOpDepositAndCall, memo.OpCode)
+		require.Equal(t, receiver, memo.Receiver)
+		require.Equal(t, payload, memo.Payload)
+		require.Empty(t, memo.RevertAddress)
+	})
+	t.Run("should decode legacy memo shorter than an address", func(t *testing.T) {
+		memo, err := DecodeInboundMemo([]byte{0x01, 0x02}, net)
+		require.NoError(t, err)
+		require.EqualValues(t, MemoVersionLegacy, memo.Version)
+		require.Equal(t, MemoOpDepositAndCall, memo.OpCode)
+		require.Equal(t, []byte{0x01, 0x02}, memo.Payload)
+	})
+
+	// invalid structured memos are rejected
+	invalidMemos := map[string][]byte{
+		"invalid op code":     append([]byte{MemoIdentifier, MemoVersion0, 2, 0}, receiver.Bytes()...),
+		"invalid flags":       append([]byte{MemoIdentifier, MemoVersion0, 0, 2}, receiver.Bytes()...),
+		"empty receiver":      EncodeInboundMemo(MemoOpDeposit, [20]byte{}, nil, nil),
+		"payload for deposit": EncodeInboundMemo(MemoOpDeposit, receiver, nil, payload),
+		"missing revert address": append(
+			[]byte{MemoIdentifier, MemoVersion0, 0, MemoFlagRevertAddress},
+			receiver.Bytes()...,
+		),
+		"truncated revert address":   EncodeInboundMemo(MemoOpDeposit, receiver, scriptP2WPKH, nil)[:30],
+		"unsupported revert address": EncodeInboundMemo(MemoOpDeposit, receiver, []byte{0x6a, 0x01, 0x01}, nil),
+		"truncated header":           {MemoIdentifier, MemoVersion0, byte(MemoOpDeposit)},
+		"truncated receiver":         EncodeInboundMemo(MemoOpDeposit, receiver, nil, nil)[:20],
+	}
+	for name, raw := range invalidMemos {
+		t.Run("should reject structured memo: "+name, func(t *testing.T) {
+			memo, err := DecodeInboundMemo(raw, net)
+			require.ErrorContains(t, err, "memo")
+			require.Nil(t, memo)
+		})
+	}
+}
@@ -9,18 +9,18 @@ import (
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 
-	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/pkg/coin"
 	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/bitcoin"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/zetacore/zetaclient/compliance"
-	"github.com/zeta-chain/zetacore/zetaclient/config"
 	"github.com/zeta-chain/zetacore/zetaclient/context"
+	"github.com/zeta-chain/zetacore/zetaclient/rescan"
 	"github.com/zeta-chain/zetacore/zetaclient/types"
 	"github.com/zeta-chain/zetacore/zetaclient/zetacore"
 )
@@ -29,29 +29,29 @@ import (
 func (ob *Observer) WatchInbound() {
 	ticker, err := types.NewDynamicTicker("Bitcoin_WatchInbound", ob.GetChainParams().InboundTicker)
 	if err != nil {
-		ob.logger.Inbound.Error().Err(err).Msg("error creating ticker")
+		ob.Logger().Inbound.Error().Err(err).Msg("error creating ticker")
 		return
 	}
 	defer ticker.Stop()
 
-	ob.logger.Inbound.Info().Msgf("WatchInbound started for chain %d", ob.chain.ChainId)
-	sampledLogger := ob.logger.Inbound.Sample(&zerolog.BasicSampler{N: 10})
+	ob.Logger().Inbound.Info().Msgf("WatchInbound started for chain %d", ob.Chain().ChainId)
+	sampledLogger := ob.Logger().Inbound.Sample(&zerolog.BasicSampler{N: 10})
 
 	for {
 		select {
 		case <-ticker.C():
-			if !context.IsInboundObservationEnabled(ob.coreContext, ob.GetChainParams()) {
+			if !context.IsInboundObservationEnabled(ob.CoreContext(), ob.GetChainParams()) {
 				sampledLogger.Info().
-					Msgf("WatchInbound: inbound observation is disabled for chain %d", ob.chain.ChainId)
+					Msgf("WatchInbound: inbound observation is disabled for chain %d", ob.Chain().ChainId)
 				continue
 			}
 			err := ob.ObserveInbound()
 			if err != nil {
-				ob.logger.Inbound.Error().Err(err).Msg("WatchInbound error observing in tx")
+				ob.Logger().Inbound.Error().Err(err).Msg("WatchInbound error observing in tx")
 			}
-			ticker.UpdateInterval(ob.GetChainParams().InboundTicker, ob.logger.Inbound)
-		case <-ob.stop:
-			ob.logger.Inbound.Info().Msgf("WatchInbound stopped for chain %d", ob.chain.ChainId)
+			ticker.UpdateInterval(ob.GetChainParams().InboundTicker, ob.Logger().Inbound)
+		case <-ob.StopChannel():
+			ob.Logger().Inbound.Info().Msgf("WatchInbound stopped for chain %d", ob.Chain().ChainId)
 			return
 		}
 	}
@@ -66,14 +66,21 @@ func (ob *Observer) ObserveInbound() error {
 	if cnt < 0 {
 		return fmt.Errorf("observeInboundBTC: block number is negative: %d", cnt)
 	}
-	if cnt < ob.GetLastBlockHeight() {
+	// #nosec G701 always positive
+	if uint64(cnt) < ob.GetLastBlockHeight() {
 		return fmt.Errorf(
 			"observeInboundBTC: block number should not decrease: current %d last %d",
 			cnt,
 			ob.GetLastBlockHeight(),
 		)
 	}
-	ob.SetLastBlockHeight(cnt)
+	// #nosec G701 always positive
+	ob.SetLastBlockHeight(uint64(cnt))
+
+	// re-scan the blocks replaced by a chain reorg
+	if err := ob.CheckReorg(); err != nil {
+		return err
+	}
 
 	// skip if current height is too low
 	// #nosec G701 always in range
@@ -83,7 +90,8 @@ func (ob *Observer) ObserveInbound() error {
 	}
 
 	// skip if no new block is confirmed
-	lastScanned := ob.GetLastBlockHeightScanned()
+	// #nosec G701 always in range
+	lastScanned := int64(ob.GetLastBlockHeightScanned())
 	if lastScanned >= confirmedBlockNum {
 		return nil
 	}
@@ -92,10 +100,10 @@ func (ob *Observer) ObserveInbound() error {
 	blockNumber := lastScanned + 1
 	res, err := ob.GetBlockByNumberCached(blockNumber)
 	if err != nil {
-		ob.logger.Inbound.Error().Err(err).Msgf("observeInboundBTC: error getting bitcoin block %d", blockNumber)
+		ob.Logger().Inbound.Error().Err(err).Msgf("observeInboundBTC: error getting bitcoin block %d", blockNumber)
 		return err
 	}
-	ob.logger.Inbound.Info().Msgf("observeInboundBTC: block %d has %d txs, current block %d, last block %d",
+	ob.Logger().Inbound.Info().Msgf("observeInboundBTC: block %d has %d txs, current block %d, last block %d",
 		blockNumber, len(res.Block.Tx), cnt, lastScanned)
 
 	// add block header to zetacore
@@ -103,120 +111,151 @@ func (ob *Observer) ObserveInbound() error {
 	// https://github.com/zeta-chain/node/issues/1847
 	// TODO: move this logic in its own routine
 	// https://github.com/zeta-chain/node/issues/2204
-	blockHeaderVerification, found := ob.coreContext.GetBlockHeaderEnabledChains(ob.chain.ChainId)
+	blockHeaderVerification, found := ob.CoreContext().GetBlockHeaderEnabledChains(ob.Chain().ChainId)
 	if found && blockHeaderVerification.Enabled {
 		err = ob.postBlockHeader(blockNumber)
 		if err != nil {
-			ob.logger.Inbound.Warn().Err(err).Msgf("observeInboundBTC: error posting block header %d", blockNumber)
+			ob.Logger().Inbound.Warn().Err(err).Msgf("observeInboundBTC: error posting block header %d", blockNumber)
 		}
 	}
 
-	if len(res.Block.Tx) > 1 {
-		// get depositor fee
-		depositorFee := bitcoin.CalcDepositorFee(res.Block, ob.chain.ChainId, ob.netParams, ob.logger.Inbound)
+	if err := ob.observeInboundInBlock(blockNumber, res, ob.postVoteInbound); err != nil {
+		return err // we have to re-scan this block next time
+	}
 
-		// filter incoming txs to TSS address
-		tssAddress := ob.Tss.BTCAddress()
+	// Save LastBlockHeight
+	// #nosec G701 always positive
+	ob.reorgDetector.Record(uint64(blockNumber), res.Block.Hash)
+	// #nosec G701 always positive
+	ob.SaveLastBlockScanned(uint64(blockNumber))
 
-		// #nosec G701 always positive
-		inbounds, err := FilterAndParseIncomingTx(
-			ob.rpcClient,
-			res.Block.Tx,
-			uint64(res.Block.Height),
-			tssAddress,
-			ob.logger.Inbound,
-			ob.netParams,
-			depositorFee,
-		)
+	return nil
+}
+
+// RescanInbound re-observes the inbounds of the blocks [fromBlock, toBlock] and posts the votes to zetacore
+// the last scanned block of the observer is left unchanged
+func (ob *Observer) RescanInbound(fromBlock, toBlock uint64, dryRun bool) (*rescan.Report, error) {
+	if fromBlock == 0 || fromBlock > toBlock {
+		return nil, fmt.Errorf("invalid block range [%d, %d]", fromBlock, toBlock)
+	}
+
+	// refuse to rescan the blocks not confirmed yet
+	cnt, err := ob.rpcClient.GetBlockCount()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting block count for chain %d", ob.Chain().ChainId)
+	}
+	// #nosec G701 always in range
+	confirmedBlockNum := cnt - int64(ob.GetChainParams().ConfirmationCount)
+	// #nosec G701 always in range
+	if confirmedBlockNum < 0 || int64(toBlock) > confirmedBlockNum {
+		return nil, fmt.Errorf("block %d is not confirmed on chain %d, current block %d", toBlock, ob.Chain().ChainId, cnt)
+	}
+
+	session := rescan.NewSession(ob.ZetacoreClient(), ob.Chain().ChainId, fromBlock, toBlock, dryRun)
+	voter := func(msg *crosschaintypes.MsgVoteInbound) (string, error) {
+		return session.Vote(msg, func() (string, error) {
+			return ob.postVoteInbound(msg)
+		})
+	}
+
+	for bn := fromBlock; bn <= toBlock; bn++ {
+		// #nosec G701 always in range
+		blockNumber := int64(bn)
+		res, err := ob.GetBlockByNumberCached(blockNumber)
 		if err != nil {
-			ob.logger.Inbound.Error().
-				Err(err).
-				Msgf("observeInboundBTC: error filtering incoming txs for block %d", blockNumber)
-			return err // we have to re-scan this block next time
+			return nil, errors.Wrapf(err, "error getting bitcoin block %d", blockNumber)
 		}
-
-		// post inbound vote message to zetacore
-		for _, inbound := range inbounds {
-			msg := ob.GetInboundVoteMessageFromBtcEvent(inbound)
-			if msg != nil {
-				zetaHash, ballot, err := ob.zetacoreClient.PostVoteInbound(
-					zetacore.PostVoteInboundGasLimit,
-					zetacore.PostVoteInboundExecutionGasLimit,
-					msg,
-				)
-				if err != nil {
-					ob.logger.Inbound.Error().
-						Err(err).
-						Msgf("observeInboundBTC: error posting to zetacore for tx %s", inbound.TxHash)
-					return err // we have to re-scan this block next time
-				} else if zetaHash != "" {
-					ob.logger.Inbound.Info().Msgf("observeInboundBTC: PostVoteInbound zeta tx hash: %s inbound %s ballot %s fee %v",
-						zetaHash, inbound.TxHash, ballot, depositorFee)
-				}
-			}
+		if err := ob.observeInboundInBlock(blockNumber, res, voter); err != nil {
+			return nil, errors.Wrapf(err, "error rescanning bitcoin block %d", blockNumber)
 		}
 	}
+	ob.Logger().Inbound.Info().Msgf("RescanInbound: rescanned blocks %d to %d for chain %d", fromBlock, toBlock, ob.Chain().ChainId)
+	return session.Report(), nil
+}
 
-	// Save LastBlockHeight
-	ob.SetLastBlockHeightScanned(blockNumber)
+// inboundVoter posts a vote for the given vote message and returns the ballot
+type inboundVoter func(msg *crosschaintypes.MsgVoteInbound) (string, error)
 
-	// #nosec G701 always positive
-	if err := ob.db.Save(types.ToLastBlockSQLType(uint64(blockNumber))).Error; err != nil {
-		ob.logger.Inbound.Error().
+// postVoteInbound posts a vote for the given vote message to zetacore
+func (ob *Observer) postVoteInbound(msg *crosschaintypes.MsgVoteInbound) (string, error) {
+	zetaHash, ballot, err := ob.ZetacoreClient().PostVoteInbound(
+		zetacore.PostVoteInboundGasLimit,
+		zetacore.PostVoteInboundExecutionGasLimit,
+		msg,
+	)
+	if err != nil {
+		ob.Logger().Inbound.Error().
 			Err(err).
-			Msgf("observeInboundBTC: error writing last scanned block %d to db", blockNumber)
+			Msgf("observeInboundInBlock: error posting to zetacore for tx %s", msg.InboundHash)
+		return "", err
+	} else if zetaHash != "" {
+		ob.Logger().Inbound.Info().Msgf("observeInboundInBlock: PostVoteInbound zeta tx hash: %s inbound %s ballot %s",
+			zetaHash, msg.InboundHash, ballot)
 	}
-
-	return nil
+	return ballot, nil
 }
 
-// WatchInboundTracker watches zetacore for bitcoin inbound trackers
-func (ob *Observer) WatchInboundTracker() {
-	ticker, err := types.NewDynamicTicker("Bitcoin_WatchInboundTracker", ob.GetChainParams().InboundTicker)
+// observeInboundInBlock filters the inbounds of the block and posts the votes with the given voter
+func (ob *Observer) observeInboundInBlock(blockNumber int64, res *BTCBlockNHeader, voter inboundVoter) error {
+	if len(res.Block.Tx) <= 1 {
+		return nil
+	}
+
+	// get depositor fee
+	depositorFee := bitcoin.CalcDepositorFee(res.Block, ob.Chain().ChainId, ob.netParams, ob.Logger().Inbound)
+
+	// filter incoming txs to TSS address
+	tssAddress := ob.TSS().BTCAddress(ob.Chain().ChainId)
+
+	// #nosec G701 always positive
+	inbounds, err := FilterAndParseIncomingTx(
+		ob.rpcClient,
+		res.Block.Tx,
+		uint64(res.Block.Height),
+		tssAddress,
+		ob.Logger().Inbound,
+		ob.netParams,
+		depositorFee,
+		ob.GetChainParams().MultiOutputDepositHeight,
+	)
 	if err != nil {
-		ob.logger.Inbound.Err(err).Msg("error creating ticker")
-		return
+		ob.Logger().Inbound.Error().
+			Err(err).
+			Msgf("observeInboundInBlock: error filtering incoming txs for block %d", blockNumber)
+		return err // we have to re-scan this block next time
 	}
 
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C():
-			if !context.IsInboundObservationEnabled(ob.coreContext, ob.GetChainParams()) {
-				continue
-			}
-			err := ob.ProcessInboundTrackers()
-			if err != nil {
-				ob.logger.Inbound.Error().
-					Err(err).
-					Msgf("error observing inbound tracker for chain %d", ob.chain.ChainId)
+	// refuse to vote on the inbounds if the block has been replaced by a chain reorg
+	if len(inbounds) > 0 {
+		if err := ob.CheckBlockCanonical(blockNumber, res.Block.Hash); err != nil {
+			ob.BlockCache().Remove(blockNumber)
+			return err // we have to re-scan this block next time
+		}
+	}
+
+	// post inbound vote message to zetacore
+	for _, inbound := range inbounds {
+		msg, err := ob.GetInboundVoteMessageFromBtcEvent(inbound)
+		if err != nil {
+			return err // we have to re-scan this block next time
+		}
+		if msg != nil {
+			if _, err := voter(msg); err != nil {
+				return err // we have to re-scan this block next time
 			}
-			ticker.UpdateInterval(ob.GetChainParams().InboundTicker, ob.logger.Inbound)
-		case <-ob.stop:
-			ob.logger.Inbound.Info().Msgf("WatchInboundTracker stopped for chain %d", ob.chain.ChainId)
-			return
 		}
 	}
+	return nil
 }
 
-// ProcessInboundTrackers processes inbound trackers
-func (ob *Observer) ProcessInboundTrackers() error {
-	trackers, err := ob.zetacoreClient.GetInboundTrackersForChain(ob.chain.ChainId)
+// CheckInboundTracker checks the deposit of the inbound tracker and votes on it
+func (ob *Observer) CheckInboundTracker(tracker crosschaintypes.InboundTracker) error {
+	ballotIdentifier, err := ob.CheckReceiptForBtcTxHash(tracker.TxHash, true)
 	if err != nil {
 		return err
 	}
-
-	for _, tracker := range trackers {
-		ob.logger.Inbound.Info().
-			Msgf("checking tracker with hash :%s and coin-type :%s ", tracker.TxHash, tracker.CoinType)
-		ballotIdentifier, err := ob.CheckReceiptForBtcTxHash(tracker.TxHash, true)
-		if err != nil {
-			return err
-		}
-		ob.logger.Inbound.Info().
-			Msgf("Vote submitted for inbound Tracker, Chain : %s,Ballot Identifier : %s, coin-type %s", ob.chain.ChainName, ballotIdentifier, coin.CoinType_Gas.String())
-	}
-
+	ob.Logger().Inbound.Info().
+		Msgf("Vote submitted for inbound Tracker, Chain : %s,Ballot Identifier : %s, coin-type %s", ob.Chain().ChainName, ballotIdentifier, coin.CoinType_Gas.String())
 	return nil
 }
 
@@ -246,21 +285,22 @@ func (ob *Observer) CheckReceiptForBtcTxHash(txHash string, vote bool) (string,
 		return "", fmt.Errorf("block %d has no transactions", blockVb.Height)
 	}
 
-	depositorFee := bitcoin.CalcDepositorFee(blockVb, ob.chain.ChainId, ob.netParams, ob.logger.Inbound)
-	tss, err := ob.zetacoreClient.GetBtcTssAddress(ob.chain.ChainId)
+	depositorFee := bitcoin.CalcDepositorFee(blockVb, ob.Chain().ChainId, ob.netParams, ob.Logger().Inbound)
+	tss, err := ob.ZetacoreClient().GetBtcTssAddress(ob.Chain().ChainId)
 	if err != nil {
 		return "", err
 	}
 
 	// #nosec G701 always positive
-	event, err := GetBtcEvent(
+	event, err := GetBtcEventFromTracker(
 		ob.rpcClient,
 		*tx,
 		tss,
 		uint64(blockVb.Height),
-		ob.logger.Inbound,
+		ob.Logger().Inbound,
 		ob.netParams,
 		depositorFee,
+		ob.GetChainParams().MultiOutputDepositHeight,
 	)
 	if err != nil {
 		return "", err
@@ -270,7 +310,10 @@ func (ob *Observer) CheckReceiptForBtcTxHash(txHash string, vote bool) (string,
 		return "", errors.New("no btc deposit event found")
 	}
 
-	msg := ob.GetInboundVoteMessageFromBtcEvent(event)
+	msg, err := ob.GetInboundVoteMessageFromBtcEvent(event)
+	if err != nil {
+		return "", err
+	}
 	if msg == nil {
 		return "", errors.New("no message built for btc sent to TSS")
 	}
@@ -279,16 +322,21 @@ func (ob *Observer) CheckReceiptForBtcTxHash(txHash string, vote bool) (string,
 		return msg.Digest(), nil
 	}
 
-	zetaHash, ballot, err := ob.zetacoreClient.PostVoteInbound(
+	// refuse to vote on the inbound if its block has been replaced by a chain reorg
+	if err := ob.CheckBlockCanonical(blockVb.Height, blockVb.Hash); err != nil {
+		return "", err
+	}
+
+	zetaHash, ballot, err := ob.ZetacoreClient().PostVoteInbound(
 		zetacore.PostVoteInboundGasLimit,
 		zetacore.PostVoteInboundExecutionGasLimit,
 		msg,
 	)
 	if err != nil {
-		ob.logger.Inbound.Error().Err(err).Msg("error posting to zetacore")
+		ob.Logger().Inbound.Error().Err(err).Msg("error posting to zetacore")
 		return "", err
 	} else if zetaHash != "" {
-		ob.logger.Inbound.Info().Msgf("BTC deposit detected and reported: PostVoteInbound zeta tx hash: %s inbound %s ballot %s fee %v",
+		ob.Logger().Inbound.Info().Msgf("BTC deposit detected and reported: PostVoteInbound zeta tx hash: %s inbound %s ballot %s fee %v",
 			zetaHash, txHash, ballot, depositorFee)
 	}
 
@@ -296,9 +344,10 @@ func (ob *Observer) CheckReceiptForBtcTxHash(txHash string, vote bool) (string,
 }
 
 // FilterAndParseIncomingTx given txs list returned by the "getblock 2" RPC command, return the txs that are relevant to us
-// relevant tx must have the following vouts as the first two vouts:
-// vout0: p2wpkh to the TSS address (targetAddress)
-// vout1: OP_RETURN memo, base64 encoded
+// relevant tx must have, in any order, the following vouts:
+// one or more outputs to the TSS address (targetAddress)
+// an OP_RETURN memo, unless the memo is inscribed in the vin0 taproot witness (commit/reveal deposits of large memos)
+// see GetBtcEvent for the sender resolution rules and the blocks below 'multiOutputDepositHeight'
 func FilterAndParseIncomingTx(
 	rpcClient interfaces.BTCRPCClient,
 	txs []btcjson.TxRawResult,
@@ -307,6 +356,7 @@ func FilterAndParseIncomingTx(
 	logger zerolog.Logger,
 	netParams *chaincfg.Params,
 	depositorFee float64,
+	multiOutputDepositHeight uint64,
 ) ([]*BTCInboundEvent, error) {
 	inbounds := make([]*BTCInboundEvent, 0)
 	for idx, tx := range txs {
@@ -314,7 +364,16 @@ func FilterAndParseIncomingTx(
 			continue // the first tx is coinbase; we do not process coinbase tx
 		}
 
-		inbound, err := GetBtcEvent(rpcClient, tx, tssAddress, blockNumber, logger, netParams, depositorFee)
+		inbound, err := GetBtcEvent(
+			rpcClient,
+			tx,
+			tssAddress,
+			blockNumber,
+			logger,
+			netParams,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
 		if err != nil {
 			// unable to parse the tx, the caller should retry
 			return nil, errors.Wrapf(err, "error getting btc event for tx %s in block %d", tx.Txid, blockNumber)
@@ -328,25 +387,43 @@ func FilterAndParseIncomingTx(
 	return inbounds, nil
 }
 
-func (ob *Observer) GetInboundVoteMessageFromBtcEvent(inbound *BTCInboundEvent) *crosschaintypes.MsgVoteInbound {
-	ob.logger.Inbound.Debug().Msgf("Processing inbound: %s", inbound.TxHash)
+// GetInboundVoteMessageFromBtcEvent returns the inbound vote message of a deposit
+// no message is returned for the restricted and invalid deposits, an error is returned if the screening fails
+func (ob *Observer) GetInboundVoteMessageFromBtcEvent(
+	inbound *BTCInboundEvent,
+) (*crosschaintypes.MsgVoteInbound, error) {
+	ob.Logger().Inbound.Debug().Msgf("Processing inbound: %s", inbound.TxHash)
 	amount := big.NewFloat(inbound.Value)
 	amount = amount.Mul(amount, big.NewFloat(1e8))
 	amountInt, _ := amount.Int(nil)
+
+	// decode the memo, the legacy memo is passed as is and the structured memo as [ receiver, payload ]
+	memo, err := bitcoin.DecodeInboundMemo(inbound.MemoBytes, ob.netParams)
+	if err != nil {
+		ob.Logger().Inbound.Error().Err(err).Msgf("error decoding memo of inbound %s", inbound.TxHash)
+		return nil, nil
+	}
 	message := hex.EncodeToString(inbound.MemoBytes)
+	if memo.Version != bitcoin.MemoVersionLegacy {
+		message = memo.EncodeMessage()
+	}
 
 	// compliance check
 	// if the inbound contains restricted addresses, return nil
-	if ob.DoesInboundContainsRestrictedAddress(inbound) {
-		return nil
+	restricted, err := ob.DoesInboundContainsRestrictedAddress(inbound, memo)
+	if err != nil {
+		return nil, err
+	}
+	if restricted {
+		return nil, nil
 	}
 
-	return zetacore.GetInBoundVoteMessage(
+	msg := zetacore.GetInBoundVoteMessage(
 		inbound.FromAddress,
-		ob.chain.ChainId,
+		ob.Chain().ChainId,
 		inbound.FromAddress,
 		inbound.FromAddress,
-		ob.zetacoreClient.Chain().ChainId,
+		ob.ZetacoreClient().Chain().ChainId,
 		cosmosmath.NewUintFromBigInt(amountInt),
 		message,
 		inbound.TxHash,
@@ -354,27 +431,40 @@ func (ob *Observer) GetInboundVoteMessageFromBtcEvent(inbound *BTCInboundEvent)
 		0,
 		coin.CoinType_Gas,
 		"",
-		ob.zetacoreClient.GetKeys().GetOperatorAddress().String(),
+		ob.ZetacoreClient().GetKeys().GetOperatorAddress().String(),
 		0,
 	)
+	msg.RevertAddress = memo.RevertAddress
+	return msg, nil
 }
 
 // DoesInboundContainsRestrictedAddress returns true if the inbound contains restricted addresses
-func (ob *Observer) DoesInboundContainsRestrictedAddress(inTx *BTCInboundEvent) bool {
+// an error is returned if the addresses fail to be screened
+func (ob *Observer) DoesInboundContainsRestrictedAddress(
+	inTx *BTCInboundEvent,
+	memo *bitcoin.InboundMemo,
+) (bool, error) {
 	receiver := ""
-	parsedAddress, _, err := chains.ParseAddressAndData(hex.EncodeToString(inTx.MemoBytes))
-	if err == nil && parsedAddress != (ethcommon.Address{}) {
-		receiver = parsedAddress.Hex()
+	if memo.Receiver != (ethcommon.Address{}) {
+		receiver = memo.Receiver.Hex()
 	}
-	if config.ContainRestrictedAddress(inTx.FromAddress, receiver) {
-		compliance.PrintComplianceLog(ob.logger.Inbound, ob.logger.Compliance,
-			false, ob.chain.ChainId, inTx.TxHash, inTx.FromAddress, receiver, "BTC")
-		return true
+	restricted, err := compliance.ContainRestrictedAddress(inTx.FromAddress, receiver, memo.RevertAddress)
+	if err != nil {
+		return false, errors.Wrapf(err, "error screening inbound %s", inTx.TxHash)
 	}
-	return false
+	if restricted {
+		compliance.PrintComplianceLog(ob.Logger().Inbound, ob.Logger().Compliance,
+			false, ob.Chain().ChainId, inTx.TxHash, inTx.FromAddress, receiver, "BTC")
+	}
+	return restricted, nil
 }
 
 // GetBtcEvent either returns a valid BTCInboundEvent or nil
+// the deposit is the sum of the outputs paying to the TSS address, in any order, and the memo is either the first
+// OP_RETURN output or, for the commit/reveal deposits of large memos, inscribed in the vin0 taproot witness
+// the sender is the address of the output spent by vin0, see GetSenderAddress
+// the deposits of the blocks below 'multiOutputDepositHeight', or of all blocks if it is 0, are detected with the
+// legacy rules instead, see getLegacyBtcEvent, for all observers to vote the same deposits until the upgrade
 // Note: the caller should retry the tx on error (e.g., GetSenderAddressByVin failed)
 func GetBtcEvent(
 	rpcClient interfaces.BTCRPCClient,
@@ -384,11 +474,56 @@ func GetBtcEvent(
 	logger zerolog.Logger,
 	netParams *chaincfg.Params,
 	depositorFee float64,
+	multiOutputDepositHeight uint64,
+) (*BTCInboundEvent, error) {
+	if !isMultiOutputDeposit(blockNumber, multiOutputDepositHeight) {
+		return getLegacyBtcEvent(rpcClient, tx, tssAddress, blockNumber, logger, netParams, depositorFee)
+	}
+	return getBtcEvent(rpcClient, tx, tssAddress, blockNumber, logger, netParams, depositorFee, false)
+}
+
+// GetBtcEventFromTracker returns the BTCInboundEvent of a deposit reported by an inbound tracker, or nil
+// unlike GetBtcEvent, the sender can be resolved from any input and a deposit without standard input is
+// credited if its memo carries a revert address, the only address the deposit can then be refunded to
+// the deposits of the blocks below 'multiOutputDepositHeight' are detected with the legacy rules as in GetBtcEvent
+func GetBtcEventFromTracker(
+	rpcClient interfaces.BTCRPCClient,
+	tx btcjson.TxRawResult,
+	tssAddress string,
+	blockNumber uint64,
+	logger zerolog.Logger,
+	netParams *chaincfg.Params,
+	depositorFee float64,
+	multiOutputDepositHeight uint64,
+) (*BTCInboundEvent, error) {
+	if !isMultiOutputDeposit(blockNumber, multiOutputDepositHeight) {
+		return getLegacyBtcEvent(rpcClient, tx, tssAddress, blockNumber, logger, netParams, depositorFee)
+	}
+	return getBtcEvent(rpcClient, tx, tssAddress, blockNumber, logger, netParams, depositorFee, true)
+}
+
+// isMultiOutputDeposit returns true if the deposits of the block are detected regardless of the output order
+// and credited with the value of all the outputs paying the TSS address
+func isMultiOutputDeposit(blockNumber uint64, multiOutputDepositHeight uint64) bool {
+	return multiOutputDepositHeight > 0 && blockNumber >= multiOutputDepositHeight
+}
+
+// getLegacyBtcEvent returns the BTCInboundEvent of the tx with the rules preceding the multi-output deposits
+// the 1st vout must pay the TSS address with a p2wpkh script and the 2nd vout must be the OP_RETURN memo,
+// unless the memo is inscribed in the vin0 taproot witness, the sender is the address of the output spent by vin0
+func getLegacyBtcEvent(
+	rpcClient interfaces.BTCRPCClient,
+	tx btcjson.TxRawResult,
+	tssAddress string,
+	blockNumber uint64,
+	logger zerolog.Logger,
+	netParams *chaincfg.Params,
+	depositorFee float64,
 ) (*BTCInboundEvent, error) {
 	found := false
 	var value float64
 	var memo []byte
-	if len(tx.Vout) >= 2 {
+	if len(tx.Vout) >= 1 {
 		// 1st vout must have tss address as receiver with p2wpkh scriptPubKey
 		vout0 := tx.Vout[0]
 		script := vout0.ScriptPubKey.Hex
@@ -413,11 +548,22 @@ func GetBtcEvent(
 			value = vout0.Value - depositorFee
 
 			// 2nd vout must be a valid OP_RETURN memo
-			vout1 := tx.Vout[1]
-			memo, found, err = bitcoin.DecodeOpReturnMemo(vout1.ScriptPubKey.Hex, tx.Txid)
-			if err != nil {
-				logger.Error().Err(err).Msgf("GetBtcEvent: error decoding OP_RETURN memo: %s", vout1.ScriptPubKey.Hex)
-				return nil, nil
+			if len(tx.Vout) >= 2 {
+				vout1 := tx.Vout[1]
+				memo, found, err = bitcoin.DecodeOpReturnMemo(vout1.ScriptPubKey.Hex, tx.Txid)
+				if err != nil {
+					logger.Error().Err(err).Msgf("GetBtcEvent: error decoding OP_RETURN memo: %s", vout1.ScriptPubKey.Hex)
+					return nil, nil
+				}
+			}
+
+			// otherwise the memo can be inscribed in the witness of the 1st vin (reveal tx of a commit/reveal deposit)
+			if !found && len(tx.Vin) > 0 {
+				memo, found, err = bitcoin.DecodeInscriptionMemo(tx.Vin[0].Witness)
+				if err != nil {
+					logger.Error().Err(err).Msgf("GetBtcEvent: error decoding inscription memo in txid %s", tx.Txid)
+					return nil, nil
+				}
 			}
 		}
 	}
@@ -443,3 +589,105 @@ func GetBtcEvent(
 	}
 	return nil, nil
 }
+
+// getBtcEvent returns the BTCInboundEvent of the tx, 'fromTracker' relaxes the sender resolution rules
+func getBtcEvent(
+	rpcClient interfaces.BTCRPCClient,
+	tx btcjson.TxRawResult,
+	tssAddress string,
+	blockNumber uint64,
+	logger zerolog.Logger,
+	netParams *chaincfg.Params,
+	depositorFee float64,
+	fromTracker bool,
+) (*BTCInboundEvent, error) {
+	var (
+		value      float64
+		tssOutputs int
+		memo       []byte
+		found      bool
+	)
+	for _, vout := range tx.Vout {
+		script, err := hex.DecodeString(vout.ScriptPubKey.Hex)
+		if err != nil { // should never happen
+			return nil, errors.Wrapf(err, "error decoding vout script %s of tx %s", vout.ScriptPubKey.Hex, tx.Txid)
+		}
+
+		// the first OP_RETURN output must be a valid memo
+		if !found && len(script) > 0 && script[0] == txscript.OP_RETURN {
+			memo, found, err = bitcoin.DecodeOpReturnMemo(vout.ScriptPubKey.Hex, tx.Txid)
+			if err != nil {
+				logger.Error().Err(err).Msgf("GetBtcEvent: error decoding OP_RETURN memo: %s", vout.ScriptPubKey.Hex)
+				return nil, nil
+			}
+			continue
+		}
+
+		// outputs of any standard script type can pay to the TSS address
+		receiver, err := bitcoin.DecodeScriptAddress(script, netParams)
+		if err != nil { // should never happen
+			return nil, errors.Wrapf(err, "error decoding vout script %s of tx %s", vout.ScriptPubKey.Hex, tx.Txid)
+		}
+		if receiver == tssAddress {
+			value += vout.Value
+			tssOutputs++
+		}
+	}
+
+	// skip irrelevant tx to us
+	if tssOutputs == 0 {
+		return nil, nil
+	}
+
+	// deposit amount has to be no less than the minimum depositor fee
+	if value < depositorFee {
+		logger.Info().
+			Msgf("GetBtcEvent: btc deposit amount %v in txid %s is less than depositor fee %v", value, tx.Txid, depositorFee)
+		return nil, nil
+	}
+
+	// otherwise the memo can be inscribed in the witness of the 1st vin (reveal tx of a commit/reveal deposit)
+	if !found && len(tx.Vin) > 0 {
+		var err error
+		memo, found, err = bitcoin.DecodeInscriptionMemo(tx.Vin[0].Witness)
+		if err != nil {
+			logger.Error().Err(err).Msgf("GetBtcEvent: error decoding inscription memo in txid %s", tx.Txid)
+			return nil, nil
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+
+	// event found, get sender address
+	fromAddress, err := GetSenderAddress(rpcClient, tx, netParams, fromTracker)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting sender address for inbound: %s", tx.Txid)
+	}
+	if fromAddress == tssAddress {
+		logger.Info().Msgf("GetBtcEvent: skipping tx %s sent by the TSS address", tx.Txid)
+		return nil, nil
+	}
+	if fromAddress == "" {
+		if !fromTracker {
+			logger.Warn().
+				Msgf("GetBtcEvent: no sender address for deposit %s from non-standard input, an inbound tracker can credit it", tx.Txid)
+			return nil, nil
+		}
+		inboundMemo, err := bitcoin.DecodeInboundMemo(memo, netParams)
+		if err != nil || inboundMemo.RevertAddress == "" {
+			logger.Warn().
+				Msgf("GetBtcEvent: deposit %s without sender address requires a revert address in the memo", tx.Txid)
+			return nil, nil
+		}
+	}
+
+	return &BTCInboundEvent{
+		FromAddress: fromAddress,
+		ToAddress:   tssAddress,
+		Value:       value - depositorFee,
+		MemoBytes:   memo,
+		BlockNumber: blockNumber,
+		TxHash:      tx.Txid,
+	}, nil
+}
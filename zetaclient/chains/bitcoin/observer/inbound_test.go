@@ -17,8 +17,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/testutil/sample"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/bitcoin"
 	clientcommon "github.com/zeta-chain/zetacore/zetaclient/common"
+	"github.com/zeta-chain/zetacore/zetaclient/keys"
 	"github.com/zeta-chain/zetacore/zetaclient/testutils"
 	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
 )
@@ -39,6 +41,15 @@ func createRPCClientAndLoadTx(t *testing.T, chainId int64, txHash string) *mocks
 	return rpcClient
 }
 
+// createPrevTx creates a previous tx paying to the pkScript at vout 0
+func createPrevTx(t *testing.T, pkScriptHex string) *btcutil.Tx {
+	pkScript, err := hex.DecodeString(pkScriptHex)
+	require.NoError(t, err)
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	msgTx.AddTxOut(wire.NewTxOut(10000, pkScript))
+	return btcutil.NewTx(msgTx)
+}
+
 func TestAvgFeeRateBlock828440(t *testing.T) {
 	// load archived block 828440
 	var blockVb btcjson.GetBlockVerboseTxResult
@@ -321,6 +332,7 @@ func TestGetBtcEvent(t *testing.T) {
 	tx := testutils.LoadBTCInboundRawResult(t, TestDataDir, chain.ChainId, txHash, false)
 	tssAddress := testutils.TSSAddressBTCMainnet
 	blockNumber := uint64(835640)
+	multiOutputDepositHeight := blockNumber
 	net := &chaincfg.MainNetParams
 	// 2.992e-05, see avgFeeRate https://mempool.space/api/v1/blocks/835640
 	depositorFee := bitcoin.DepositorFee(22 * clientcommon.BTCOutboundGasPriceMultiplier)
@@ -347,7 +359,16 @@ func TestGetBtcEvent(t *testing.T) {
 		rpcClient := createRPCClientAndLoadTx(t, chain.ChainId, preHash)
 
 		// get BTC event
-		event, err := GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee)
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
 		require.NoError(t, err)
 		require.Equal(t, eventExpected, event)
 	})
@@ -362,7 +383,16 @@ func TestGetBtcEvent(t *testing.T) {
 		rpcClient := createRPCClientAndLoadTx(t, chain.ChainId, preHash)
 
 		// get BTC event
-		event, err := GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee)
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
 		require.NoError(t, err)
 		require.Equal(t, eventExpected, event)
 	})
@@ -377,7 +407,16 @@ func TestGetBtcEvent(t *testing.T) {
 		rpcClient := createRPCClientAndLoadTx(t, chain.ChainId, preHash)
 
 		// get BTC event
-		event, err := GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee)
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
 		require.NoError(t, err)
 		require.Equal(t, eventExpected, event)
 	})
@@ -392,7 +431,16 @@ func TestGetBtcEvent(t *testing.T) {
 		rpcClient := createRPCClientAndLoadTx(t, chain.ChainId, preHash)
 
 		// get BTC event
-		event, err := GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee)
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
 		require.NoError(t, err)
 		require.Equal(t, eventExpected, event)
 	})
@@ -407,18 +455,214 @@ func TestGetBtcEvent(t *testing.T) {
 		rpcClient := createRPCClientAndLoadTx(t, chain.ChainId, preHash)
 
 		// get BTC event
-		event, err := GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee)
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
 		require.NoError(t, err)
 		require.Equal(t, eventExpected, event)
 	})
-	t.Run("should skip tx if len(tx.Vout) < 2", func(t *testing.T) {
+	t.Run("should get BTC inbound event with outputs in any order", func(t *testing.T) {
+		// swap the TSS output and the OP_RETURN memo
+		preHash := "c5d224963832fc0b9a597251c2342a17b25e481a88cc9119008e8f8296652697"
+		tx := testutils.LoadBTCInboundRawResult(t, TestDataDir, chain.ChainId, txHash, false)
+		tx.Vin[0].Txid = preHash
+		tx.Vin[0].Vout = 2
+		tx.Vout[0], tx.Vout[1] = tx.Vout[1], tx.Vout[0]
+		rpcClient := createRPCClientAndLoadTx(t, chain.ChainId, preHash)
+
+		// get BTC event
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
+		require.NoError(t, err)
+		require.Equal(t, "bc1q68kxnq52ahz5vd6c8czevsawu0ux9nfrzzrh6e", event.FromAddress)
+		require.Equal(t, memo, event.MemoBytes)
+		require.Equal(t, tx.Vout[1].Value-depositorFee, event.Value)
+	})
+	t.Run("should sum the outputs to TSS address", func(t *testing.T) {
+		preHash := "c5d224963832fc0b9a597251c2342a17b25e481a88cc9119008e8f8296652697"
+		tx := testutils.LoadBTCInboundRawResult(t, TestDataDir, chain.ChainId, txHash, false)
+		tx.Vin[0].Txid = preHash
+		tx.Vin[0].Vout = 2
+		tx.Vout = append(tx.Vout, tx.Vout[0])
+		rpcClient := createRPCClientAndLoadTx(t, chain.ChainId, preHash)
+
+		// get BTC event
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
+		require.NoError(t, err)
+		require.InDelta(t, 2*tx.Vout[0].Value-depositorFee, event.Value, 1e-12)
+	})
+	t.Run("should detect deposits with the legacy rules below the multi-output deposit height", func(t *testing.T) {
+		preHash := "c5d224963832fc0b9a597251c2342a17b25e481a88cc9119008e8f8296652697"
+		for _, height := range []uint64{0, blockNumber + 1} {
+			// the deposit paid by the 1st output with the memo in the 2nd output is detected
+			tx := testutils.LoadBTCInboundRawResult(t, TestDataDir, chain.ChainId, txHash, false)
+			tx.Vin[0].Txid = preHash
+			tx.Vin[0].Vout = 2
+			rpcClient := createRPCClientAndLoadTx(t, chain.ChainId, preHash)
+			event, err := GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee, height)
+			require.NoError(t, err)
+			require.Equal(t, "bc1q68kxnq52ahz5vd6c8czevsawu0ux9nfrzzrh6e", event.FromAddress)
+			require.Equal(t, memo, event.MemoBytes)
+			require.Equal(t, tx.Vout[0].Value-depositorFee, event.Value)
+
+			// only the 1st output to TSS address is credited
+			tx.Vout = append(tx.Vout, tx.Vout[0])
+			rpcClient = createRPCClientAndLoadTx(t, chain.ChainId, preHash)
+			event, err = GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee, height)
+			require.NoError(t, err)
+			require.Equal(t, tx.Vout[0].Value-depositorFee, event.Value)
+
+			// the outputs in another order are not detected
+			tx.Vout = tx.Vout[:2]
+			tx.Vout[0], tx.Vout[1] = tx.Vout[1], tx.Vout[0]
+			event, err = GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee, height)
+			require.NoError(t, err)
+			require.Nil(t, event)
+		}
+	})
+	t.Run("should skip tx sent by TSS address", func(t *testing.T) {
+		tx := testutils.LoadBTCInboundRawResult(t, TestDataDir, chain.ChainId, txHash, false)
+		tx.Vin[0].Vout = 0
+		rpcClient := mocks.NewMockBTCRPCClient().WithRawTransaction(createPrevTx(t, tx.Vout[0].ScriptPubKey.Hex))
+
+		// get BTC event
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
+		require.NoError(t, err)
+		require.Nil(t, event)
+	})
+	t.Run("should skip tx from non-standard input", func(t *testing.T) {
+		// P2PK input [ OP_DATA_33 <pubkey> OP_CHECKSIG ]
+		tx := testutils.LoadBTCInboundRawResult(t, TestDataDir, chain.ChainId, txHash, false)
+		p2pkScript := "210279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798ac"
+		tx.Vin[0].Vout = 0
+		rpcClient := mocks.NewMockBTCRPCClient().WithRawTransaction(createPrevTx(t, p2pkScript))
+
+		// get BTC event
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
+		require.NoError(t, err)
+		require.Nil(t, event)
+	})
+	t.Run("should get BTC inbound event with memo inscribed in taproot witness", func(t *testing.T) {
+		// replace vin with the reveal of a P2TR commit, drop the OP_RETURN and inscribe a memo larger than 80 bytes
+		// https://mempool.space/tx/3618e869f9e87863c0f1cc46dbbaa8b767b4a5d6d60b143c2c50af52b257e867
+		preHash := "3618e869f9e87863c0f1cc46dbbaa8b767b4a5d6d60b143c2c50af52b257e867"
+		tx := testutils.LoadBTCInboundRawResult(t, TestDataDir, chain.ChainId, txHash, false)
+		tx.Vin[0].Txid = preHash
+		tx.Vin[0].Vout = 2
+		tx.Vout = tx.Vout[:1]
+
+		memo := bitcoin.EncodeInboundMemo(bitcoin.MemoOpDepositAndCall, sample.EthAddress(), nil, bytes.Repeat([]byte{0x01}, 600))
+		script, err := bitcoin.EncodeInscriptionScript(bytes.Repeat([]byte{0x02}, 32), memo)
+		require.NoError(t, err)
+		tx.Vin[0].Witness = []string{
+			hex.EncodeToString(bytes.Repeat([]byte{0x03}, 64)),
+			hex.EncodeToString(script),
+			"c0" + hex.EncodeToString(bytes.Repeat([]byte{0x04}, 32)),
+		}
+		rpcClient := createRPCClientAndLoadTx(t, chain.ChainId, preHash)
+
+		// get BTC event
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
+		require.NoError(t, err)
+		require.NotNil(t, event)
+		require.Equal(t, "bc1px3peqcd60hk7wqyqk36697u9hzugq0pd5lzvney93yzzrqy4fkpq6cj7m3", event.FromAddress)
+		require.Equal(t, memo, event.MemoBytes)
+		require.Equal(t, tx.Vout[0].Value-depositorFee, event.Value)
+	})
+	t.Run("should skip tx if invalid memo inscribed in taproot witness", func(t *testing.T) {
+		// load tx and inscribe an envelope not terminated by OP_ENDIF
+		tx := testutils.LoadBTCInboundRawResult(t, TestDataDir, chain.ChainId, txHash, false)
+		tx.Vout = tx.Vout[:1]
+		script, err := bitcoin.EncodeInscriptionScript(bytes.Repeat([]byte{0x02}, 32), []byte{0x01})
+		require.NoError(t, err)
+		tx.Vin[0].Witness = []string{
+			hex.EncodeToString(script[:len(script)-1]),
+			"c0" + hex.EncodeToString(bytes.Repeat([]byte{0x04}, 32)),
+		}
+
+		// get BTC event
+		rpcClient := mocks.NewMockBTCRPCClient()
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
+		require.NoError(t, err)
+		require.Nil(t, event)
+	})
+	t.Run("should skip tx if len(tx.Vout) < 2 and no memo inscribed", func(t *testing.T) {
 		// load tx and modify the tx to have only 1 vout
 		tx := testutils.LoadBTCInboundRawResult(t, TestDataDir, chain.ChainId, txHash, false)
 		tx.Vout = tx.Vout[:1]
 
 		// get BTC event
 		rpcClient := mocks.NewMockBTCRPCClient()
-		event, err := GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee)
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
 		require.NoError(t, err)
 		require.Nil(t, event)
 	})
@@ -429,13 +673,31 @@ func TestGetBtcEvent(t *testing.T) {
 
 		// modify the tx to have Vout[0] a P2SH output
 		tx.Vout[0].ScriptPubKey.Hex = strings.Replace(tx.Vout[0].ScriptPubKey.Hex, "0014", "a914", 1)
-		event, err := GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee)
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
 		require.NoError(t, err)
 		require.Nil(t, event)
 
 		// append 1 byte to script to make it longer than 22 bytes
 		tx.Vout[0].ScriptPubKey.Hex = tx.Vout[0].ScriptPubKey.Hex + "00"
-		event, err = GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee)
+		event, err = GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
 		require.NoError(t, err)
 		require.Nil(t, event)
 	})
@@ -446,7 +708,16 @@ func TestGetBtcEvent(t *testing.T) {
 
 		// get BTC event
 		rpcClient := mocks.NewMockBTCRPCClient()
-		event, err := GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee)
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
 		require.NoError(t, err)
 		require.Nil(t, event)
 	})
@@ -457,7 +728,16 @@ func TestGetBtcEvent(t *testing.T) {
 
 		// get BTC event
 		rpcClient := mocks.NewMockBTCRPCClient()
-		event, err := GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee)
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
 		require.NoError(t, err)
 		require.Nil(t, event)
 	})
@@ -468,7 +748,16 @@ func TestGetBtcEvent(t *testing.T) {
 
 		// get BTC event
 		rpcClient := mocks.NewMockBTCRPCClient()
-		event, err := GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee)
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
 		require.NoError(t, err)
 		require.Nil(t, event)
 	})
@@ -479,7 +768,97 @@ func TestGetBtcEvent(t *testing.T) {
 
 		// get BTC event
 		rpcClient := mocks.NewMockBTCRPCClient()
-		event, err := GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee)
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
+		require.NoError(t, err)
+		require.Nil(t, event)
+	})
+}
+
+func TestGetBtcEventFromTracker(t *testing.T) {
+	// load archived inbound P2WPKH raw result
+	// https://mempool.space/tx/847139aa65aa4a5ee896375951cbf7417cfc8a4d6f277ec11f40cd87319f04aa
+	txHash := "847139aa65aa4a5ee896375951cbf7417cfc8a4d6f277ec11f40cd87319f04aa"
+	chain := chains.BitcoinMainnet
+	net := &chaincfg.MainNetParams
+	tssAddress := testutils.TSSAddressBTCMainnet
+	blockNumber := uint64(835640)
+	multiOutputDepositHeight := blockNumber
+	depositorFee := bitcoin.DepositorFee(22 * clientcommon.BTCOutboundGasPriceMultiplier)
+
+	// P2PK input [ OP_DATA_33 <pubkey> OP_CHECKSIG ] and P2WPKH input
+	p2pkScript := "210279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798ac"
+	p2wpkhScript := "001471dc3cd95bf4fe0fb7ffd6bb29b865ddf5581196"
+
+	t.Run("should resolve sender from the first standard input", func(t *testing.T) {
+		tx := testutils.LoadBTCInboundRawResult(t, TestDataDir, chain.ChainId, txHash, false)
+		tx.Vin[0].Vout = 0
+		tx.Vin = append(tx.Vin, tx.Vin[0])
+
+		// the mock rpc client pops the last tx first
+		rpcClient := mocks.NewMockBTCRPCClient().
+			WithRawTransaction(createPrevTx(t, p2wpkhScript)).
+			WithRawTransaction(createPrevTx(t, p2pkScript))
+
+		event, err := GetBtcEventFromTracker(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
+		require.NoError(t, err)
+		require.Equal(t, "bc1qw8wrek2m7nlqldll66ajnwr9mh64syvkt67zlu", event.FromAddress)
+	})
+	t.Run("should credit deposit without sender if memo has a revert address", func(t *testing.T) {
+		tx := testutils.LoadBTCInboundRawResult(t, TestDataDir, chain.ChainId, txHash, false)
+		revertScript, err := hex.DecodeString(p2wpkhScript)
+		require.NoError(t, err)
+		memo := bitcoin.EncodeInboundMemo(bitcoin.MemoOpDeposit, sample.EthAddress(), revertScript, nil)
+		tx.Vout[1].ScriptPubKey.Hex = "6a" + hex.EncodeToString([]byte{byte(len(memo))}) + hex.EncodeToString(memo)
+		tx.Vin[0].Vout = 0
+		rpcClient := mocks.NewMockBTCRPCClient().WithRawTransaction(createPrevTx(t, p2pkScript))
+
+		event, err := GetBtcEventFromTracker(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
+		require.NoError(t, err)
+		require.Empty(t, event.FromAddress)
+		require.Equal(t, memo, event.MemoBytes)
+	})
+	t.Run("should skip deposit without sender nor revert address", func(t *testing.T) {
+		tx := testutils.LoadBTCInboundRawResult(t, TestDataDir, chain.ChainId, txHash, false)
+		tx.Vin[0].Vout = 0
+		rpcClient := mocks.NewMockBTCRPCClient().WithRawTransaction(createPrevTx(t, p2pkScript))
+
+		event, err := GetBtcEventFromTracker(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
 		require.NoError(t, err)
 		require.Nil(t, event)
 	})
@@ -493,6 +872,7 @@ func TestGetBtcEventErrors(t *testing.T) {
 	net := &chaincfg.MainNetParams
 	tssAddress := testutils.TSSAddressBTCMainnet
 	blockNumber := uint64(835640)
+	multiOutputDepositHeight := blockNumber
 	depositorFee := bitcoin.DepositorFee(22 * clientcommon.BTCOutboundGasPriceMultiplier)
 
 	t.Run("should return error on invalid Vout[0] script", func(t *testing.T) {
@@ -502,7 +882,16 @@ func TestGetBtcEventErrors(t *testing.T) {
 
 		// get BTC event
 		rpcClient := mocks.NewMockBTCRPCClient()
-		event, err := GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee)
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
 		require.Error(t, err)
 		require.Nil(t, event)
 	})
@@ -513,7 +902,16 @@ func TestGetBtcEventErrors(t *testing.T) {
 
 		// get BTC event
 		rpcClient := mocks.NewMockBTCRPCClient()
-		event, err := GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee)
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
 		require.Error(t, err)
 		require.Nil(t, event)
 	})
@@ -523,8 +921,58 @@ func TestGetBtcEventErrors(t *testing.T) {
 		rpcClient := mocks.NewMockBTCRPCClient()
 
 		// get BTC event
-		event, err := GetBtcEvent(rpcClient, *tx, tssAddress, blockNumber, log.Logger, net, depositorFee)
+		event, err := GetBtcEvent(
+			rpcClient,
+			*tx,
+			tssAddress,
+			blockNumber,
+			log.Logger,
+			net,
+			depositorFee,
+			multiOutputDepositHeight,
+		)
 		require.Error(t, err)
 		require.Nil(t, event)
 	})
 }
+
+func TestGetInboundVoteMessageFromBtcEvent(t *testing.T) {
+	chain := chains.BitcoinMainnet
+	ob := newTestObserver(t, chain, nil)
+	ob.WithZetacoreClient(mocks.NewMockZetacoreClient().WithKeys(&keys.Keys{}))
+	receiver := sample.EthAddress()
+	payload := []byte("hello satoshi")
+	revertAddress := "bc1qysd4sp9q8my59ul9wsf5rvs9p387hf8vfwatzu"
+	inbound := func(memo []byte) *BTCInboundEvent {
+		return &BTCInboundEvent{
+			FromAddress: "bc1q68kxnq52ahz5vd6c8czevsawu0ux9nfrzzrh6e",
+			ToAddress:   "bc1qm24wp577nk8aacckv8np465z3dvmu7ry45el6y",
+			Value:       0.001,
+			MemoBytes:   memo,
+			BlockNumber: 100,
+			TxHash:      sample.Hash().Hex(),
+		}
+	}
+
+	t.Run("should pass legacy memo as is", func(t *testing.T) {
+		memo := append(receiver.Bytes(), payload...)
+		msg, err := ob.GetInboundVoteMessageFromBtcEvent(inbound(memo))
+		require.NoError(t, err)
+		require.NotNil(t, msg)
+		require.Equal(t, hex.EncodeToString(memo), msg.Message)
+		require.Empty(t, msg.RevertAddress)
+	})
+	t.Run("should pass structured memo with revert address", func(t *testing.T) {
+		addr, err := btcutil.DecodeAddress(revertAddress, &chaincfg.MainNetParams)
+		require.NoError(t, err)
+		revertScript, err := bitcoin.PayToAddrScript(addr)
+		require.NoError(t, err)
+
+		memo := bitcoin.EncodeInboundMemo(bitcoin.MemoOpDepositAndCall, receiver, revertScript, payload)
+		msg, err := ob.GetInboundVoteMessageFromBtcEvent(inbound(memo))
+		require.NoError(t, err)
+		require.NotNil(t, msg)
+		require.Equal(t, hex.EncodeToString(append(receiver.Bytes(), payload...)), msg.Message)
+		require.Equal(t, revertAddress, msg.RevertAddress)
+	})
+}
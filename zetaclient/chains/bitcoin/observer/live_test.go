@@ -161,6 +161,7 @@ func (suite *BitcoinObserverTestSuite) Test1() {
 		log.Logger,
 		&chaincfg.TestNet3Params,
 		0.0,
+		0,
 	)
 	suite.Require().NoError(err)
 	suite.Require().Equal(1, len(inbounds))
@@ -198,6 +199,7 @@ func (suite *BitcoinObserverTestSuite) Test2() {
 		log.Logger,
 		&chaincfg.TestNet3Params,
 		0.0,
+		0,
 	)
 	suite.Require().NoError(err)
 	suite.Require().Equal(0, len(inbounds))
@@ -2,40 +2,33 @@ package observer
 
 import (
 	"bytes"
-	"encoding/hex"
+	"context"
 	"fmt"
 	"math"
 	"math/big"
-	"os"
 	"sort"
-	"sync"
-	"sync/atomic"
 	"time"
 
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
-	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
-	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
-	"gorm.io/driver/sqlite"
-	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 
 	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/pkg/proofs"
-	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/base"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/bitcoin"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
 	clientcommon "github.com/zeta-chain/zetacore/zetaclient/common"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
-	"github.com/zeta-chain/zetacore/zetaclient/context"
+	clientcontext "github.com/zeta-chain/zetacore/zetaclient/context"
 	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+	"github.com/zeta-chain/zetacore/zetaclient/reorg"
+	"github.com/zeta-chain/zetacore/zetaclient/rpcpool"
 	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
-	"github.com/zeta-chain/zetacore/zetaclient/zetacore"
 )
 
 const (
@@ -49,34 +42,17 @@ const (
 	bigValueConfirmationCount = 6
 )
 
-var _ interfaces.ChainObserver = &Observer{}
-
-// Logger contains list of loggers used by Bitcoin chain observer
-// TODO: Merge this logger with the one in evm
-// https://github.com/zeta-chain/node/issues/2022
-type Logger struct {
-	// Chain is the parent logger for the chain
-	Chain zerolog.Logger
-
-	// Inbound is the logger for incoming transactions
-	Inbound zerolog.Logger // The logger for incoming transactions
-
-	// Outbound is the logger for outgoing transactions
-	Outbound zerolog.Logger // The logger for outgoing transactions
-
-	// UTXOS is the logger for UTXOs management
-	UTXOS zerolog.Logger // The logger for UTXOs management
-
-	// GasPrice is the logger for gas price
-	GasPrice zerolog.Logger // The logger for gas price
-
-	// Compliance is the logger for compliance checks
-	Compliance zerolog.Logger // The logger for compliance checks
-}
+var (
+	_ interfaces.ChainObserver       = &Observer{}
+	_ interfaces.ChainObserverStatus = &Observer{}
+	_ interfaces.InboundRescanner    = &Observer{}
+	_ base.ChainPlugin               = &Observer{}
+)
 
 // BTCInboundEvent represents an incoming transaction event
 type BTCInboundEvent struct {
-	// FromAddress is the first input address
+	// FromAddress is the sender address resolved from the inputs, see GetSenderAddress
+	// it is empty for the deposits credited from an inbound tracker and refunded to the memo revert address
 	FromAddress string
 
 	// ToAddress is the TSS address
@@ -98,23 +74,12 @@ type BTCBlockNHeader struct {
 
 // Observer is the Bitcoin chain observer
 type Observer struct {
-	BlockCache *lru.Cache
-
-	// Mu is lock for all the maps, utxos and core params
-	Mu *sync.Mutex
-
-	Tss interfaces.TSSSigner
+	*base.Observer
 
-	chain            chains.Chain
-	netParams        *chaincfg.Params
-	rpcClient        interfaces.BTCRPCClient
-	zetacoreClient   interfaces.ZetacoreClient
-	lastBlock        int64
-	lastBlockScanned int64
-	pendingNonce     uint64
-	utxos            []btcjson.ListUnspentResult
-	params           observertypes.ChainParams
-	coreContext      *context.ZetacoreContext
+	netParams    *chaincfg.Params
+	rpcClient    interfaces.BTCRPCClient
+	pendingNonce uint64
+	utxos        []btcjson.ListUnspentResult
 
 	// includedTxHashes indexes included tx with tx hash
 	includedTxHashes map[string]bool
@@ -122,18 +87,19 @@ type Observer struct {
 	// includedTxResults indexes tx results with the outbound tx identifier
 	includedTxResults map[string]*btcjson.GetTransactionResult
 
+	// includedTxInputs indexes the inputs ("txid:vout") spent by included tx with tx hash
+	includedTxInputs map[string][]string
+
 	// broadcastedTx indexes the outbound hash with the outbound tx identifier
 	broadcastedTx map[string]string
 
-	db     *gorm.DB
-	stop   chan struct{}
-	logger Logger
-	ts     *metrics.TelemetryServer
+	// reorgDetector tracks the hashes of the scanned blocks to detect chain reorgs
+	reorgDetector *reorg.Detector
 }
 
 // NewObserver returns a new Bitcoin chain observer
 func NewObserver(
-	appcontext *context.AppContext,
+	appcontext *clientcontext.AppContext,
 	chain chains.Chain,
 	zetacoreClient interfaces.ZetacoreClient,
 	tss interfaces.TSSSigner,
@@ -142,72 +108,45 @@ func NewObserver(
 	btcCfg config.BTCConfig,
 	ts *metrics.TelemetryServer,
 ) (*Observer, error) {
-	// initialize the observer
-	ob := Observer{
-		ts: ts,
-	}
-	ob.stop = make(chan struct{})
-	ob.chain = chain
-
 	// get the bitcoin network params
-	netParams, err := chains.BitcoinNetParamsFromChainID(ob.chain.ChainId)
+	netParams, err := chains.BitcoinNetParamsFromChainID(chain.ChainId)
 	if err != nil {
-		return nil, fmt.Errorf("error getting net params for chain %d: %s", ob.chain.ChainId, err)
+		return nil, fmt.Errorf("error getting net params for chain %d: %s", chain.ChainId, err)
 	}
-	ob.netParams = netParams
 
-	ob.Mu = &sync.Mutex{}
-
-	chainLogger := loggers.Std.With().Str("chain", chain.ChainName.String()).Logger()
-	ob.logger = Logger{
-		Chain:      chainLogger,
-		Inbound:    chainLogger.With().Str("module", "WatchInbound").Logger(),
-		Outbound:   chainLogger.With().Str("module", "WatchOutbound").Logger(),
-		UTXOS:      chainLogger.With().Str("module", "WatchUTXOS").Logger(),
-		GasPrice:   chainLogger.With().Str("module", "WatchGasPrice").Logger(),
-		Compliance: loggers.Compliance,
-	}
-
-	ob.zetacoreClient = zetacoreClient
-	ob.Tss = tss
-	ob.coreContext = appcontext.ZetacoreContext()
-	ob.includedTxHashes = make(map[string]bool)
-	ob.includedTxResults = make(map[string]*btcjson.GetTransactionResult)
-	ob.broadcastedTx = make(map[string]string)
-
-	// set the Bitcoin chain params
-	_, chainParams, found := appcontext.ZetacoreContext().GetBTCChainParams()
+	// get the Bitcoin chain params
+	_, chainParams, found := appcontext.ZetacoreContext().GetBTCChainParams(chain.ChainId)
 	if !found {
 		return nil, fmt.Errorf("btc chains params not initialized")
 	}
-	ob.params = *chainParams
 
-	// create the RPC client
-	ob.logger.Chain.Info().Msgf("Chain %s endpoint %s", ob.chain.String(), btcCfg.RPCHost)
-	connCfg := &rpcclient.ConnConfig{
-		Host:         btcCfg.RPCHost,
-		User:         btcCfg.RPCUsername,
-		Pass:         btcCfg.RPCPassword,
-		HTTPPostMode: true,
-		DisableTLS:   true,
-		Params:       btcCfg.RPCParams,
-	}
-	rpcClient, err := rpcclient.New(connCfg, nil)
+	baseObserver, err := base.NewObserver(
+		chain,
+		*chainParams,
+		appcontext.ZetacoreContext(),
+		zetacoreClient,
+		tss,
+		btcBlocksPerDay,
+		ts,
+		loggers,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("error creating rpc client: %s", err)
+		return nil, err
 	}
+	ob := NewObserverFromBase(baseObserver, netParams)
 
-	// try connection
-	ob.rpcClient = rpcClient
-	err = rpcClient.Ping()
+	// create the RPC client
+	ob.Logger().Chain.Info().Msgf("Chain %s endpoints %v", chain.ChainName.String(), rpcpool.EndpointNames(btcCfg.GetRPCHosts()))
+	rpcClient, err := bitcoin.NewRPCClient(btcCfg, chain.ChainName.String(), rpcpool.ModuleObserver, ob.Logger().Chain)
 	if err != nil {
-		return nil, fmt.Errorf("error ping the bitcoin server: %s", err)
+		return nil, fmt.Errorf("error creating rpc client: %s", err)
 	}
 
-	ob.BlockCache, err = lru.New(btcBlocksPerDay)
-	if err != nil {
-		ob.logger.Chain.Error().Err(err).Msg("failed to create bitcoin block cache")
-		return nil, err
+	// try connection, the wallet requests are routed to the hosts watching the TSS address
+	ob.rpcClient = rpcClient
+	rpcClient.SetWalletAddress(tss.BTCAddress(chain.ChainId))
+	if rpcClient.CheckHealth(context.Background()) == 0 {
+		return nil, fmt.Errorf("error ping the bitcoin server: no healthy rpc host")
 	}
 
 	// load btc chain observer DB
@@ -216,156 +155,121 @@ func NewObserver(
 		return nil, err
 	}
 
-	return &ob, nil
+	return ob, nil
 }
 
-func (ob *Observer) WithZetacoreClient(client *zetacore.Client) {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
-	ob.zetacoreClient = client
-}
-
-func (ob *Observer) WithLogger(logger zerolog.Logger) {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
-	ob.logger = Logger{
-		Chain:    logger,
-		Inbound:  logger.With().Str("module", "WatchInbound").Logger(),
-		Outbound: logger.With().Str("module", "WatchOutbound").Logger(),
-		UTXOS:    logger.With().Str("module", "WatchUTXOS").Logger(),
-		GasPrice: logger.With().Str("module", "WatchGasPrice").Logger(),
+// NewObserverFromBase returns a Bitcoin chain observer built on the base observer, without RPC client
+func NewObserverFromBase(baseObserver *base.Observer, netParams *chaincfg.Params) *Observer {
+	ob := &Observer{
+		Observer:          baseObserver,
+		netParams:         netParams,
+		includedTxHashes:  make(map[string]bool),
+		includedTxResults: make(map[string]*btcjson.GetTransactionResult),
+		includedTxInputs:  make(map[string][]string),
+		broadcastedTx:     make(map[string]string),
+		reorgDetector:     reorg.NewDetector(baseObserver.Chain().ChainName.String(), reorg.DefaultWindow),
 	}
+	ob.WithPlugin(ob)
+	return ob
 }
 
-func (ob *Observer) WithBtcClient(client *rpcclient.Client) {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
+// WithBtcClient attaches a new bitcoin rpc client to the observer
+func (ob *Observer) WithBtcClient(client interfaces.BTCRPCClient) {
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
 	ob.rpcClient = client
 }
 
-func (ob *Observer) WithChain(chain chains.Chain) {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
-	ob.chain = chain
-}
-
-func (ob *Observer) Chain() chains.Chain {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
-	return ob.chain
-}
-
-func (ob *Observer) SetChainParams(params observertypes.ChainParams) {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
-	ob.params = params
-}
-
-func (ob *Observer) GetChainParams() observertypes.ChainParams {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
-	return ob.params
-}
-
 // Start starts the Go routine to observe the Bitcoin chain
 func (ob *Observer) Start() {
-	ob.logger.Chain.Info().Msgf("Bitcoin client is starting")
-	go ob.WatchInbound()        // watch bitcoin chain for incoming txs and post votes to zetacore
-	go ob.WatchOutbound()       // watch bitcoin chain for outgoing txs status
-	go ob.WatchUTXOS()          // watch bitcoin chain for UTXOs owned by the TSS address
-	go ob.WatchGasPrice()       // watch bitcoin chain for gas rate and post to zetacore
-	go ob.WatchInboundTracker() // watch zetacore for bitcoin inbound trackers
-	go ob.WatchRPCStatus()      // watch the RPC status of the bitcoin chain
+	ob.Logger().Chain.Info().Msgf("Bitcoin client is starting")
+	ob.Go(ob.WatchInbound)        // watch bitcoin chain for incoming txs and post votes to zetacore
+	ob.Go(ob.WatchOutbound)       // watch bitcoin chain for outgoing txs status
+	ob.Go(ob.WatchUTXOS)          // watch bitcoin chain for UTXOs owned by the TSS address
+	ob.Go(ob.WatchGasPrice)       // watch bitcoin chain for gas rate and post to zetacore
+	ob.Go(ob.WatchInboundTracker) // watch zetacore for bitcoin inbound trackers
+	ob.Go(ob.WatchRPCStatus)      // watch the RPC status of the bitcoin chain
 }
 
-// WatchRPCStatus watches the RPC status of the Bitcoin chain
-func (ob *Observer) WatchRPCStatus() {
-	ob.logger.Chain.Info().Msgf("RPCStatus is starting")
-	ticker := time.NewTicker(60 * time.Second)
-
-	for {
-		select {
-		case <-ticker.C:
-			if !ob.GetChainParams().IsSupported {
-				continue
-			}
-
-			bn, err := ob.rpcClient.GetBlockCount()
-			if err != nil {
-				ob.logger.Chain.Error().Err(err).Msg("RPC status check: RPC down? ")
-				continue
-			}
-
-			hash, err := ob.rpcClient.GetBlockHash(bn)
-			if err != nil {
-				ob.logger.Chain.Error().Err(err).Msg("RPC status check: RPC down? ")
-				continue
-			}
-
-			header, err := ob.rpcClient.GetBlockHeader(hash)
-			if err != nil {
-				ob.logger.Chain.Error().Err(err).Msg("RPC status check: RPC down? ")
-				continue
-			}
-
-			blockTime := header.Timestamp
-			elapsedSeconds := time.Since(blockTime).Seconds()
-			if elapsedSeconds > 1200 {
-				ob.logger.Chain.Error().Err(err).Msg("RPC status check: RPC down? ")
-				continue
-			}
-
-			tssAddr := ob.Tss.BTCAddressWitnessPubkeyHash()
-			res, err := ob.rpcClient.ListUnspentMinMaxAddresses(0, 1000000, []btcutil.Address{tssAddr})
-			if err != nil {
-				ob.logger.Chain.Error().
-					Err(err).
-					Msg("RPC status check: can't list utxos of TSS address; wallet or loaded? TSS address is not imported? ")
-				continue
-			}
-
-			if len(res) == 0 {
-				ob.logger.Chain.Error().
-					Err(err).
-					Msg("RPC status check: TSS address has no utxos; TSS address is not imported? ")
-				continue
-			}
+// LatestBlockHeight returns the height of the latest block of the Bitcoin chain
+func (ob *Observer) LatestBlockHeight() (uint64, error) {
+	bn, err := ob.rpcClient.GetBlockCount()
+	if err != nil {
+		return 0, err
+	}
+	if bn < 0 {
+		return 0, fmt.Errorf("negative block number %d", bn)
+	}
+	// #nosec G701 always positive
+	return uint64(bn), nil
+}
 
-			ob.logger.Chain.Info().
-				Msgf("[OK] RPC status check: latest block number %d, timestamp %s (%.fs ago), tss addr %s, #utxos: %d", bn, blockTime, elapsedSeconds, tssAddr, len(res))
+// CheckRPCStatus returns an error if the RPC of the Bitcoin chain is down or stale, or if the TSS address is not
+// watched by the wallet of the node
+func (ob *Observer) CheckRPCStatus() error {
+	// the TSS address changes on TSS migration
+	if client, ok := ob.rpcClient.(interface{ SetWalletAddress(string) }); ok {
+		client.SetWalletAddress(ob.TSS().BTCAddress(ob.Chain().ChainId))
+	}
+	ob.CheckRPCHealth(ob.rpcClient)
 
-		case <-ob.stop:
-			return
-		}
+	bn, err := ob.rpcClient.GetBlockCount()
+	if err != nil {
+		return err
+	}
+	hash, err := ob.rpcClient.GetBlockHash(bn)
+	if err != nil {
+		return err
+	}
+	header, err := ob.rpcClient.GetBlockHeader(hash)
+	if err != nil {
+		return err
 	}
-}
 
-func (ob *Observer) Stop() {
-	ob.logger.Chain.Info().Msgf("ob %s is stopping", ob.chain.String())
-	close(ob.stop) // this notifies all goroutines to stop
-	ob.logger.Chain.Info().Msgf("%s observer stopped", ob.chain.String())
-}
+	blockTime := header.Timestamp
+	elapsedSeconds := time.Since(blockTime).Seconds()
+	if elapsedSeconds > 1200 {
+		return fmt.Errorf("latest block %d timestamp is %.0fs ago", bn, elapsedSeconds)
+	}
 
-func (ob *Observer) SetLastBlockHeight(height int64) {
-	atomic.StoreInt64(&ob.lastBlock, height)
-}
+	tssAddr := ob.TSS().BTCAddressWitnessPubkeyHash(ob.Chain().ChainId)
+	res, err := ob.rpcClient.ListUnspentMinMaxAddresses(0, 1000000, []btcutil.Address{tssAddr})
+	if err != nil {
+		return errors.Wrap(err, "can't list utxos of TSS address; wallet or loaded? TSS address is not imported?")
+	}
+	if len(res) == 0 {
+		return errors.New("TSS address has no utxos; TSS address is not imported?")
+	}
 
-func (ob *Observer) GetLastBlockHeight() int64 {
-	return atomic.LoadInt64(&ob.lastBlock)
+	ob.Logger().Chain.Info().
+		Msgf("[OK] RPC status check: latest block number %d, timestamp %s (%.fs ago), tss addr %s, #utxos: %d", bn, blockTime, elapsedSeconds, tssAddr, len(res))
+	return nil
 }
 
-func (ob *Observer) SetLastBlockHeightScanned(height int64) {
-	atomic.StoreInt64(&ob.lastBlockScanned, height)
-	metrics.LastScannedBlockNumber.WithLabelValues(ob.chain.ChainName.String()).Set(float64(height))
-}
+// Status returns the status of the chain reported by the telemetry server
+func (ob *Observer) Status() metrics.ChainStatus {
+	status := ob.ChainStatus(ob.rpcClient)
 
-func (ob *Observer) GetLastBlockHeightScanned() int64 {
-	return atomic.LoadInt64(&ob.lastBlockScanned)
+	// summarize the UTXOs good to spend
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
+	summary := &metrics.UTXOSummary{Count: len(ob.utxos)}
+	for i, utxo := range ob.utxos {
+		summary.Total += utxo.Amount
+		if i == 0 || utxo.Amount < summary.Min {
+			summary.Min = utxo.Amount
+		}
+		if utxo.Amount > summary.Max {
+			summary.Max = utxo.Amount
+		}
+	}
+	status.UTXOs = summary
+	return status
 }
 
 func (ob *Observer) GetPendingNonce() uint64 {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
 	return ob.pendingNonce
 }
 
@@ -389,53 +293,18 @@ func (ob *Observer) ConfirmationsThreshold(amount *big.Int) int64 {
 	return int64(ob.GetChainParams().ConfirmationCount)
 }
 
-// WatchGasPrice watches Bitcoin chain for gas rate and post to zetacore
-func (ob *Observer) WatchGasPrice() {
-	// report gas price right away as the ticker takes time to kick in
-	err := ob.PostGasPrice()
-	if err != nil {
-		ob.logger.GasPrice.Error().Err(err).Msgf("PostGasPrice error for chain %d", ob.chain.ChainId)
-	}
-
-	// start gas price ticker
-	ticker, err := clienttypes.NewDynamicTicker("Bitcoin_WatchGasPrice", ob.GetChainParams().GasPriceTicker)
-	if err != nil {
-		ob.logger.GasPrice.Error().Err(err).Msg("error creating ticker")
-		return
-	}
-	ob.logger.GasPrice.Info().Msgf("WatchGasPrice started for chain %d with interval %d",
-		ob.chain.ChainId, ob.GetChainParams().GasPriceTicker)
-
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C():
-			if !ob.GetChainParams().IsSupported {
-				continue
-			}
-			err := ob.PostGasPrice()
-			if err != nil {
-				ob.logger.GasPrice.Error().Err(err).Msgf("PostGasPrice error for chain %d", ob.chain.ChainId)
-			}
-			ticker.UpdateInterval(ob.GetChainParams().GasPriceTicker, ob.logger.GasPrice)
-		case <-ob.stop:
-			ob.logger.GasPrice.Info().Msgf("WatchGasPrice stopped for chain %d", ob.chain.ChainId)
-			return
-		}
-	}
-}
-
+// PostGasPrice posts the gas rate of the Bitcoin chain to zetacore
 func (ob *Observer) PostGasPrice() error {
-	if ob.chain.ChainId == 18444 { //bitcoin regtest; hardcode here since this RPC is not available on regtest
+	if ob.Chain().ChainId == 18444 { //bitcoin regtest; hardcode here since this RPC is not available on regtest
 		blockNumber, err := ob.rpcClient.GetBlockCount()
 		if err != nil {
 			return err
 		}
 
 		// #nosec G701 always in range
-		_, err = ob.zetacoreClient.PostGasPrice(ob.chain, 1, "100", uint64(blockNumber))
+		_, err = ob.ZetacoreClient().PostGasPrice(ob.Chain(), 1, "100", uint64(blockNumber))
 		if err != nil {
-			ob.logger.GasPrice.Err(err).Msg("PostGasPrice:")
+			ob.Logger().GasPrice.Err(err).Msg("PostGasPrice:")
 			return err
 		}
 		return nil
@@ -460,9 +329,9 @@ func (ob *Observer) PostGasPrice() error {
 	}
 
 	// #nosec G701 always positive
-	_, err = ob.zetacoreClient.PostGasPrice(ob.chain, feeRatePerByte.Uint64(), "100", uint64(blockNumber))
+	_, err = ob.ZetacoreClient().PostGasPrice(ob.Chain(), feeRatePerByte.Uint64(), "100", uint64(blockNumber))
 	if err != nil {
-		ob.logger.GasPrice.Err(err).Msg("PostGasPrice:")
+		ob.Logger().GasPrice.Err(err).Msg("PostGasPrice:")
 		return err
 	}
 
@@ -489,25 +358,38 @@ func GetSenderAddressByVin(rpcClient interfaces.BTCRPCClient, vin btcjson.Vin, n
 	}
 
 	// decode sender address from previous pkScript
+	// sender address not found if the script type is not supported, return empty and move on to the next tx
 	pkScript := tx.MsgTx().TxOut[vin.Vout].PkScript
-	scriptHex := hex.EncodeToString(pkScript)
-	if bitcoin.IsPkScriptP2TR(pkScript) {
-		return bitcoin.DecodeScriptP2TR(scriptHex, net)
-	}
-	if bitcoin.IsPkScriptP2WSH(pkScript) {
-		return bitcoin.DecodeScriptP2WSH(scriptHex, net)
-	}
-	if bitcoin.IsPkScriptP2WPKH(pkScript) {
-		return bitcoin.DecodeScriptP2WPKH(scriptHex, net)
-	}
-	if bitcoin.IsPkScriptP2SH(pkScript) {
-		return bitcoin.DecodeScriptP2SH(scriptHex, net)
-	}
-	if bitcoin.IsPkScriptP2PKH(pkScript) {
-		return bitcoin.DecodeScriptP2PKH(scriptHex, net)
+	return bitcoin.DecodeScriptAddress(pkScript, net)
+}
+
+// GetSenderAddress resolves the sender address of a deposit
+// the sender is the address of the output spent by vin0 if it is a P2TR, P2WSH, P2WPKH, P2SH or P2PKH output
+// if 'anyInput' is true and vin0 spends a non-standard output, the sender is the address of the first input spending a
+// standard output
+// returns empty address if no input qualifies, the deposit then has no address to be refunded to
+func GetSenderAddress(
+	rpcClient interfaces.BTCRPCClient,
+	tx btcjson.TxRawResult,
+	net *chaincfg.Params,
+	anyInput bool,
+) (string, error) {
+	if len(tx.Vin) == 0 { // should never happen
+		return "", fmt.Errorf("no input found for tx %s", tx.Txid)
 	}
 
-	// sender address not found, return nil and move on to the next tx
+	for i, vin := range tx.Vin {
+		if i > 0 && !anyInput {
+			break
+		}
+		fromAddress, err := GetSenderAddressByVin(rpcClient, vin, net)
+		if err != nil {
+			return "", err
+		}
+		if fromAddress != "" {
+			return fromAddress, nil
+		}
+	}
 	return "", nil
 }
 
@@ -515,7 +397,7 @@ func GetSenderAddressByVin(rpcClient interfaces.BTCRPCClient, vin btcjson.Vin, n
 func (ob *Observer) WatchUTXOS() {
 	ticker, err := clienttypes.NewDynamicTicker("Bitcoin_WatchUTXOS", ob.GetChainParams().WatchUtxoTicker)
 	if err != nil {
-		ob.logger.UTXOS.Error().Err(err).Msg("error creating ticker")
+		ob.utxosLogger().Error().Err(err).Msg("error creating ticker")
 		return
 	}
 
@@ -528,11 +410,11 @@ func (ob *Observer) WatchUTXOS() {
 			}
 			err := ob.FetchUTXOS()
 			if err != nil {
-				ob.logger.UTXOS.Error().Err(err).Msg("error fetching btc utxos")
+				ob.utxosLogger().Error().Err(err).Msg("error fetching btc utxos")
 			}
-			ticker.UpdateInterval(ob.GetChainParams().WatchUtxoTicker, ob.logger.UTXOS)
-		case <-ob.stop:
-			ob.logger.UTXOS.Info().Msgf("WatchUTXOS stopped for chain %d", ob.chain.ChainId)
+			ticker.UpdateInterval(ob.GetChainParams().WatchUtxoTicker, *ob.utxosLogger())
+		case <-ob.StopChannel():
+			ob.utxosLogger().Info().Msgf("WatchUTXOS stopped for chain %d", ob.Chain().ChainId)
 			return
 		}
 	}
@@ -541,7 +423,7 @@ func (ob *Observer) WatchUTXOS() {
 func (ob *Observer) FetchUTXOS() error {
 	defer func() {
 		if err := recover(); err != nil {
-			ob.logger.UTXOS.Error().Msgf("BTC FetchUTXOS: caught panic error: %v", err)
+			ob.utxosLogger().Error().Msgf("BTC FetchUTXOS: caught panic error: %v", err)
 		}
 	}()
 
@@ -556,8 +438,8 @@ func (ob *Observer) FetchUTXOS() error {
 	maxConfirmations := int(bh)
 
 	// List all unspent UTXOs (160ms)
-	tssAddr := ob.Tss.BTCAddress()
-	address, err := chains.DecodeBtcAddress(tssAddr, ob.chain.ChainId)
+	tssAddr := ob.TSS().BTCAddress(ob.Chain().ChainId)
+	address, err := chains.DecodeBtcAddress(tssAddr, ob.Chain().ChainId)
 	if err != nil {
 		return fmt.Errorf("btc: error decoding wallet address (%s) : %s", tssAddr, err.Error())
 	}
@@ -593,27 +475,48 @@ func (ob *Observer) FetchUTXOS() error {
 		utxosFiltered = append(utxosFiltered, utxo)
 	}
 
-	ob.Mu.Lock()
+	ob.Mu().Lock()
 	metrics.NumberOfUTXO.Set(float64(len(utxosFiltered)))
 	ob.utxos = utxosFiltered
-	ob.Mu.Unlock()
+	ob.Mu().Unlock()
 	return nil
 }
 
 // SaveBroadcastedTx saves successfully broadcasted transaction
 func (ob *Observer) SaveBroadcastedTx(txHash string, nonce uint64) {
 	outboundID := ob.GetTxID(nonce)
-	ob.Mu.Lock()
+	ob.Mu().Lock()
 	ob.broadcastedTx[outboundID] = txHash
-	ob.Mu.Unlock()
+	ob.Mu().Unlock()
 
 	broadcastEntry := clienttypes.ToOutboundHashSQLType(txHash, outboundID)
-	if err := ob.db.Save(&broadcastEntry).Error; err != nil {
-		ob.logger.Outbound.Error().
+	if err := ob.DB().Save(&broadcastEntry).Error; err != nil {
+		ob.Logger().Outbound.Error().
 			Err(err).
 			Msgf("SaveBroadcastedTx: error saving broadcasted txHash %s for outbound %s", txHash, outboundID)
 	}
-	ob.logger.Outbound.Info().Msgf("SaveBroadcastedTx: saved broadcasted txHash %s for outbound %s", txHash, outboundID)
+	ob.Logger().Outbound.Info().Msgf("SaveBroadcastedTx: saved broadcasted txHash %s for outbound %s", txHash, outboundID)
+}
+
+// GetBroadcastedOutbound returns the outbound of the nonce broadcast by this zetaclient
+func (ob *Observer) GetBroadcastedOutbound(nonce uint64) (*wire.MsgTx, error) {
+	outboundID := ob.GetTxID(nonce)
+	ob.Mu().Lock()
+	txHash, found := ob.broadcastedTx[outboundID]
+	ob.Mu().Unlock()
+	if !found {
+		return nil, fmt.Errorf("no broadcasted outbound %s", outboundID)
+	}
+
+	hash, err := chainhash.NewHashFromStr(txHash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding hash of outbound %s", outboundID)
+	}
+	tx, err := ob.rpcClient.GetRawTransaction(hash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting raw tx %s of outbound %s", txHash, outboundID)
+	}
+	return tx.MsgTx(), nil
 }
 
 // GetTxResultByHash gets the transaction result by hash
@@ -691,8 +594,8 @@ func GetRawTxResult(
 
 func (ob *Observer) BuildBroadcastedTxMap() error {
 	var broadcastedTransactions []clienttypes.OutboundHashSQLType
-	if err := ob.db.Find(&broadcastedTransactions).Error; err != nil {
-		ob.logger.Chain.Error().Err(err).Msg("error iterating over db")
+	if err := ob.DB().Find(&broadcastedTransactions).Error; err != nil {
+		ob.Logger().Chain.Error().Err(err).Msg("error iterating over db")
 		return err
 	}
 	for _, entry := range broadcastedTransactions {
@@ -701,41 +604,8 @@ func (ob *Observer) BuildBroadcastedTxMap() error {
 	return nil
 }
 
-// LoadLastScannedBlock loads last scanned block from database
-// The last scanned block is the height from which the observer should continue scanning for inbound transactions
-func (ob *Observer) LoadLastScannedBlock() error {
-	// Get the latest block number from node
-	bn, err := ob.rpcClient.GetBlockCount()
-	if err != nil {
-		return err
-	}
-	if bn < 0 {
-		return fmt.Errorf("LoadLastScannedBlock: negative block number %d", bn)
-	}
-
-	//Load persisted block number
-	var lastBlockNum clienttypes.LastBlockSQLType
-	if err := ob.db.First(&lastBlockNum, clienttypes.LastBlockNumID).Error; err != nil {
-		ob.logger.Chain.Info().Msg("LoadLastScannedBlock: last scanned block not found in DB, scan from latest")
-		ob.SetLastBlockHeightScanned(bn)
-	} else {
-		// #nosec G701 always in range
-		lastBN := int64(lastBlockNum.Num)
-		ob.SetLastBlockHeightScanned(lastBN)
-	}
-
-	// bitcoin regtest starts from block 100
-	if chains.IsBitcoinRegnet(ob.chain.ChainId) {
-		ob.SetLastBlockHeightScanned(100)
-	}
-	ob.logger.Chain.Info().
-		Msgf("LoadLastScannedBlock: chain %d starts scanning from block %d", ob.chain.ChainId, ob.GetLastBlockHeightScanned())
-
-	return nil
-}
-
 func (ob *Observer) GetBlockByNumberCached(blockNumber int64) (*BTCBlockNHeader, error) {
-	if result, ok := ob.BlockCache.Get(blockNumber); ok {
+	if result, ok := ob.BlockCache().Get(blockNumber); ok {
 		return result.(*BTCBlockNHeader), nil
 	}
 	// Get the block hash
@@ -757,11 +627,55 @@ func (ob *Observer) GetBlockByNumberCached(blockNumber int64) (*BTCBlockNHeader,
 		Header: header,
 		Block:  block,
 	}
-	ob.BlockCache.Add(blockNumber, blockNheader)
-	ob.BlockCache.Add(hash, blockNheader)
+	ob.BlockCache().Add(blockNumber, blockNheader)
+	ob.BlockCache().Add(hash, blockNheader)
 	return blockNheader, nil
 }
 
+// CheckReorg detects the scanned blocks replaced by a chain reorg and rewinds the inbound scanning to the fork height
+func (ob *Observer) CheckReorg() error {
+	forkHeight, found, err := ob.reorgDetector.Detect(func(height uint64) (string, error) {
+		// #nosec G701 always in range
+		hash, err := ob.rpcClient.GetBlockHash(int64(height))
+		if err != nil {
+			return "", err
+		}
+		return hash.String(), nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error checking reorg for chain %d", ob.Chain().ChainId)
+	}
+	if !found {
+		return nil
+	}
+
+	// drop the replaced blocks from the cache
+	for height := forkHeight; height <= ob.GetLastBlockHeight(); height++ {
+		// #nosec G701 always in range
+		ob.BlockCache().Remove(int64(height))
+	}
+
+	// re-scan the replaced blocks
+	lastScanned := ob.GetLastBlockHeightScanned()
+	ob.Logger().Inbound.Error().
+		Msgf("CheckReorg: chain %d reorg detected from block %d, re-scanning from last scanned block %d",
+			ob.Chain().ChainId, forkHeight, lastScanned)
+	if lastScanned >= forkHeight {
+		ob.SaveLastBlockScanned(forkHeight - 1)
+	}
+	return nil
+}
+
+// CheckBlockCanonical returns an error if the block of an inbound is no longer in the canonical chain
+func (ob *Observer) CheckBlockCanonical(blockNumber int64, blockHash string) error {
+	hash, err := ob.rpcClient.GetBlockHash(blockNumber)
+	if err != nil {
+		return errors.Wrapf(err, "error getting block hash %d for chain %d", blockNumber, ob.Chain().ChainId)
+	}
+	// #nosec G701 always positive
+	return ob.reorgDetector.CheckCanonical(uint64(blockNumber), blockHash, hash.String())
+}
+
 // isTssTransaction checks if a given transaction was sent by TSS itself.
 // An unconfirmed transaction is safe to spend only if it was sent by TSS and verified by ourselves.
 func (ob *Observer) isTssTransaction(txid string) bool {
@@ -771,9 +685,9 @@ func (ob *Observer) isTssTransaction(txid string) bool {
 
 // postBlockHeader posts block header to zetacore
 func (ob *Observer) postBlockHeader(tip int64) error {
-	ob.logger.Inbound.Info().Msgf("postBlockHeader: tip %d", tip)
+	ob.Logger().Inbound.Info().Msgf("postBlockHeader: tip %d", tip)
 	bn := tip
-	res, err := ob.zetacoreClient.GetBlockHeaderChainState(ob.chain.ChainId)
+	res, err := ob.ZetacoreClient().GetBlockHeaderChainState(ob.Chain().ChainId)
 	if err == nil && res.ChainState != nil && res.ChainState.EarliestHeight > 0 {
 		bn = res.ChainState.LatestHeight + 1
 	}
@@ -788,41 +702,28 @@ func (ob *Observer) postBlockHeader(tip int64) error {
 	var headerBuf bytes.Buffer
 	err = res2.Header.Serialize(&headerBuf)
 	if err != nil { // should never happen
-		ob.logger.Inbound.Error().Err(err).Msgf("error serializing bitcoin block header: %d", bn)
+		ob.Logger().Inbound.Error().Err(err).Msgf("error serializing bitcoin block header: %d", bn)
 		return err
 	}
 	blockHash := res2.Header.BlockHash()
-	_, err = ob.zetacoreClient.PostVoteBlockHeader(
-		ob.chain.ChainId,
+	_, err = ob.ZetacoreClient().PostVoteBlockHeader(
+		ob.Chain().ChainId,
 		blockHash[:],
 		res2.Block.Height,
 		proofs.NewBitcoinHeader(headerBuf.Bytes()),
 	)
-	ob.logger.Inbound.Info().Msgf("posted block header %d: %s", bn, blockHash)
+	ob.Logger().Inbound.Info().Msgf("posted block header %d: %s", bn, blockHash)
 	if err != nil { // error shouldn't block the process
-		ob.logger.Inbound.Error().Err(err).Msgf("error posting bitcoin block header: %d", bn)
+		ob.Logger().Inbound.Error().Err(err).Msgf("error posting bitcoin block header: %d", bn)
 	}
 	return err
 }
 
+// loadDB opens the database of the observer and loads the last scanned block and the broadcasted transactions
 func (ob *Observer) loadDB(dbpath string) error {
-	if _, err := os.Stat(dbpath); os.IsNotExist(err) {
-		err := os.MkdirAll(dbpath, os.ModePerm)
-		if err != nil {
-			return err
-		}
-	}
-	path := fmt.Sprintf("%s/btc_chain_client", dbpath)
-	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
-	if err != nil {
-		ob.logger.Chain.Error().Err(err).Msgf("failed to open observer database for %s", ob.chain.ChainName.String())
-		return err
-	}
-	ob.db = db
-
-	err = db.AutoMigrate(&clienttypes.TransactionResultSQLType{},
-		&clienttypes.OutboundHashSQLType{},
-		&clienttypes.LastBlockSQLType{})
+	err := ob.OpenDB(dbpath, "btc_chain_client",
+		&clienttypes.TransactionResultSQLType{},
+		&clienttypes.OutboundHashSQLType{})
 	if err != nil {
 		return err
 	}
@@ -833,8 +734,17 @@ func (ob *Observer) loadDB(dbpath string) error {
 		return err
 	}
 
+	// bitcoin regtest starts from block 100
+	if chains.IsBitcoinRegnet(ob.Chain().ChainId) {
+		ob.SetLastBlockHeightScanned(100)
+	}
+
 	//Load broadcasted transactions
-	err = ob.BuildBroadcastedTxMap()
+	return ob.BuildBroadcastedTxMap()
+}
 
-	return err
+// utxosLogger returns the logger for UTXOs management
+func (ob *Observer) utxosLogger() *zerolog.Logger {
+	logger := ob.Logger().Chain.With().Str("module", "WatchUTXOS").Logger()
+	return &logger
 }
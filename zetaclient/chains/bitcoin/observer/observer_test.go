@@ -1,12 +1,14 @@
 package observer
 
 import (
+	"errors"
 	"math/big"
 	"strconv"
-	"sync"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -14,10 +16,13 @@ import (
 	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/testutil/sample"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/base"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
 	clientcommon "github.com/zeta-chain/zetacore/zetaclient/common"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 	"github.com/zeta-chain/zetacore/zetaclient/context"
 	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+	"github.com/zeta-chain/zetacore/zetaclient/reorg"
 	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
 	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
 )
@@ -32,6 +37,26 @@ var (
 	TestDataDir = "../../../"
 )
 
+// newTestObserver creates an observer of the chain without RPC client
+func newTestObserver(t *testing.T, chain chains.Chain, tss interfaces.TSSSigner) *Observer {
+	baseObserver, err := base.NewObserver(
+		chain,
+		observertypes.ChainParams{},
+		context.NewZetacoreContext(config.NewConfig()),
+		mocks.NewMockZetacoreClient(),
+		tss,
+		btcBlocksPerDay,
+		nil,
+		clientcommon.ClientLogger{Std: zerolog.Nop(), Compliance: zerolog.Nop()},
+	)
+	require.NoError(t, err)
+	netParams, err := chains.BitcoinNetParamsFromChainID(chain.ChainId)
+	if err != nil {
+		netParams = chains.BitcoinMainnetParams
+	}
+	return NewObserverFromBase(baseObserver, netParams)
+}
+
 // setupDBTxResults creates a new SQLite database and populates it with some transaction results.
 func setupDBTxResults(t *testing.T) (*gorm.DB, map[string]btcjson.GetTransactionResult) {
 	submittedTx := map[string]btcjson.GetTransactionResult{}
@@ -76,8 +101,8 @@ func TestNewBitcoinObserver(t *testing.T) {
 		zetacoreClient := mocks.NewMockZetacoreClient()
 		tss := mocks.NewMockTSS(chains.BitcoinTestnet, sample.EthAddress().String(), "")
 		loggers := clientcommon.ClientLogger{}
-		btcCfg := cfg.BitcoinConfig
-		ts := metrics.NewTelemetryServer()
+		btcCfg, _ := cfg.GetBTCConfig(chain.ChainId)
+		ts := metrics.NewTelemetryServer("")
 
 		client, err := NewObserver(appContext, chain, zetacoreClient, tss, tempSQLiteDbPath, loggers, btcCfg, ts)
 		require.ErrorContains(t, err, "btc chains params not initialized")
@@ -86,7 +111,7 @@ func TestNewBitcoinObserver(t *testing.T) {
 }
 
 func TestConfirmationThreshold(t *testing.T) {
-	ob := &Observer{Mu: &sync.Mutex{}}
+	ob := newTestObserver(t, chains.BitcoinMainnet, nil)
 	t.Run("should return confirmations in chain param", func(t *testing.T) {
 		ob.SetChainParams(observertypes.ChainParams{ConfirmationCount: 3})
 		require.Equal(t, int64(3), ob.ConfirmationsThreshold(big.NewInt(1000)))
@@ -120,3 +145,146 @@ func TestSubmittedTx(t *testing.T) {
 		require.Equal(t, want, have)
 	}
 }
+
+// mockBlockHashClient is a mock rpc client returning the canonical block hashes
+type mockBlockHashClient struct {
+	*mocks.MockBTCRPCClient
+	hashes map[int64]*chainhash.Hash
+}
+
+func (c *mockBlockHashClient) GetBlockHash(height int64) (*chainhash.Hash, error) {
+	hash, found := c.hashes[height]
+	if !found {
+		return nil, errors.New("block not found")
+	}
+	return hash, nil
+}
+
+// newReorgTestObserver creates an observer that has scanned the blocks [from, to] of the given hashes
+func newReorgTestObserver(t *testing.T, from, to int64, hashes map[int64]*chainhash.Hash) *Observer {
+	ob := newTestObserver(t, chains.BitcoinMainnet, nil)
+	ob.rpcClient = &mockBlockHashClient{MockBTCRPCClient: mocks.NewMockBTCRPCClient(), hashes: hashes}
+	require.NoError(t, ob.OpenDB(t.TempDir(), "btc_chain_client"))
+	for height := from; height <= to; height++ {
+		ob.reorgDetector.Record(uint64(height), hashes[height].String())
+		ob.BlockCache().Add(height, &BTCBlockNHeader{})
+	}
+	ob.SetLastBlockHeight(uint64(to))
+	ob.SetLastBlockHeightScanned(uint64(to))
+	return ob
+}
+
+func TestCheckReorg(t *testing.T) {
+	hashes := map[int64]*chainhash.Hash{}
+	for height := int64(100); height <= 105; height++ {
+		hash := chainhash.Hash(sample.Hash())
+		hashes[height] = &hash
+	}
+
+	t.Run("should not rewind if the scanned blocks are canonical", func(t *testing.T) {
+		ob := newReorgTestObserver(t, 100, 105, hashes)
+		require.NoError(t, ob.CheckReorg())
+		require.EqualValues(t, 105, ob.GetLastBlockHeightScanned())
+	})
+	t.Run("should rewind to the block before the fork", func(t *testing.T) {
+		ob := newReorgTestObserver(t, 100, 105, hashes)
+
+		// blocks 103~105 are replaced
+		forked := map[int64]*chainhash.Hash{}
+		for height, hash := range hashes {
+			forked[height] = hash
+			if height >= 103 {
+				forkHash := chainhash.Hash(sample.Hash())
+				forked[height] = &forkHash
+			}
+		}
+		ob.rpcClient = &mockBlockHashClient{MockBTCRPCClient: mocks.NewMockBTCRPCClient(), hashes: forked}
+
+		require.NoError(t, ob.CheckReorg())
+		require.EqualValues(t, 102, ob.GetLastBlockHeightScanned())
+		require.True(t, ob.BlockCache().Contains(int64(102)))
+		require.False(t, ob.BlockCache().Contains(int64(103)))
+
+		// the rewound height is persisted
+		var lastBlock clienttypes.LastBlockSQLType
+		require.NoError(t, ob.DB().First(&lastBlock, clienttypes.LastBlockNumID).Error)
+		require.EqualValues(t, 102, lastBlock.Num)
+
+		// the block replaced is refused
+		err := ob.CheckBlockCanonical(103, hashes[103].String())
+		require.ErrorIs(t, err, reorg.ErrNotCanonical)
+		require.NoError(t, ob.CheckBlockCanonical(103, forked[103].String()))
+	})
+	t.Run("should return error if the block hash can't be queried", func(t *testing.T) {
+		ob := newReorgTestObserver(t, 100, 105, hashes)
+		ob.rpcClient = mocks.NewMockBTCRPCClient()
+		require.ErrorContains(t, ob.CheckReorg(), "error checking reorg")
+	})
+}
+
+// mockBlockCountClient is a mock rpc client returning the latest block height
+type mockBlockCountClient struct {
+	*mocks.MockBTCRPCClient
+	count int64
+}
+
+func (c *mockBlockCountClient) GetBlockCount() (int64, error) {
+	return c.count, nil
+}
+
+// newRescanTestObserver creates an observer of a chain at block 'count' having the cached blocks [from, to]
+func newRescanTestObserver(t *testing.T, count, from, to int64) *Observer {
+	ob := newTestObserver(t, chains.BitcoinMainnet, nil)
+	ob.rpcClient = &mockBlockCountClient{MockBTCRPCClient: mocks.NewMockBTCRPCClient(), count: count}
+	ob.SetChainParams(observertypes.ChainParams{ConfirmationCount: 2})
+	for height := from; height <= to; height++ {
+		// blocks with the coinbase tx only
+		block := &btcjson.GetBlockVerboseTxResult{Height: height, Tx: []btcjson.TxRawResult{{}}}
+		ob.BlockCache().Add(height, &BTCBlockNHeader{Block: block})
+	}
+	ob.SetLastBlockHeightScanned(uint64(to))
+	return ob
+}
+
+func TestRescanInbound(t *testing.T) {
+	t.Run("should rescan confirmed blocks", func(t *testing.T) {
+		ob := newRescanTestObserver(t, 110, 100, 105)
+		report, err := ob.RescanInbound(100, 105, true)
+		require.NoError(t, err)
+		require.EqualValues(t, 100, report.FromBlock)
+		require.EqualValues(t, 105, report.ToBlock)
+		require.True(t, report.DryRun)
+		require.Empty(t, report.Inbounds)
+		require.EqualValues(t, 105, ob.GetLastBlockHeightScanned())
+	})
+	t.Run("should not rescan invalid block range", func(t *testing.T) {
+		ob := newRescanTestObserver(t, 110, 100, 105)
+		_, err := ob.RescanInbound(105, 100, false)
+		require.ErrorContains(t, err, "invalid block range")
+		_, err = ob.RescanInbound(0, 100, false)
+		require.ErrorContains(t, err, "invalid block range")
+	})
+	t.Run("should not rescan unconfirmed blocks", func(t *testing.T) {
+		ob := newRescanTestObserver(t, 106, 100, 105)
+		_, err := ob.RescanInbound(100, 105, false)
+		require.ErrorContains(t, err, "is not confirmed")
+	})
+	t.Run("should stop rescan on error getting block", func(t *testing.T) {
+		ob := newRescanTestObserver(t, 110, 100, 105)
+		_, err := ob.RescanInbound(100, 107, false)
+		require.ErrorContains(t, err, "error getting bitcoin block 106")
+	})
+}
+
+func TestStatus(t *testing.T) {
+	ob := newRescanTestObserver(t, 110, 100, 105)
+	ob.SetLastBlockHeight(110)
+	ob.utxos = []btcjson.ListUnspentResult{{Amount: 0.2}, {Amount: 0.05}, {Amount: 1.5}}
+
+	status := ob.Status()
+	require.Equal(t, chains.BitcoinMainnet.ChainId, status.ChainID)
+	require.EqualValues(t, 110, status.LastBlock)
+	require.EqualValues(t, 105, status.LastScannedBlock)
+	require.EqualValues(t, 108, status.LastConfirmedBlock)
+	require.Equal(t, &metrics.UTXOSummary{Count: 3, Total: 1.75, Min: 0.05, Max: 1.5}, status.UTXOs)
+}
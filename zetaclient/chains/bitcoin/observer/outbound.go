@@ -21,88 +21,93 @@ import (
 
 // GetTxID returns a unique id for outbound tx
 func (ob *Observer) GetTxID(nonce uint64) string {
-	tssAddr := ob.Tss.BTCAddress()
-	return fmt.Sprintf("%d-%s-%d", ob.chain.ChainId, tssAddr, nonce)
+	tssAddr := ob.TSS().BTCAddress(ob.Chain().ChainId)
+	return fmt.Sprintf("%d-%s-%d", ob.Chain().ChainId, tssAddr, nonce)
 }
 
 // WatchOutbound watches Bitcoin chain for outgoing txs status
 func (ob *Observer) WatchOutbound() {
 	ticker, err := types.NewDynamicTicker("Bitcoin_WatchOutbound", ob.GetChainParams().OutboundTicker)
 	if err != nil {
-		ob.logger.Outbound.Error().Err(err).Msg("error creating ticker ")
+		ob.Logger().Outbound.Error().Err(err).Msg("error creating ticker ")
 		return
 	}
 	defer ticker.Stop()
 
-	ob.logger.Outbound.Info().Msgf("WatchInbound started for chain %d", ob.chain.ChainId)
-	sampledLogger := ob.logger.Outbound.Sample(&zerolog.BasicSampler{N: 10})
+	ob.Logger().Outbound.Info().Msgf("WatchInbound started for chain %d", ob.Chain().ChainId)
+	sampledLogger := ob.Logger().Outbound.Sample(&zerolog.BasicSampler{N: 10})
 
 	for {
 		select {
 		case <-ticker.C():
-			if !context.IsOutboundObservationEnabled(ob.coreContext, ob.GetChainParams()) {
+			if !context.IsOutboundObservationEnabled(ob.CoreContext(), ob.GetChainParams()) {
 				sampledLogger.Info().
-					Msgf("WatchOutbound: outbound observation is disabled for chain %d", ob.chain.ChainId)
+					Msgf("WatchOutbound: outbound observation is disabled for chain %d", ob.Chain().ChainId)
 				continue
 			}
-			trackers, err := ob.zetacoreClient.GetAllOutboundTrackerByChain(ob.chain.ChainId, interfaces.Ascending)
+			trackers, err := ob.ZetacoreClient().GetAllOutboundTrackerByChain(ob.Chain().ChainId, interfaces.Ascending)
 			if err != nil {
-				ob.logger.Outbound.Error().
+				ob.Logger().Outbound.Error().
 					Err(err).
-					Msgf("WatchOutbound: error GetAllOutboundTrackerByChain for chain %d", ob.chain.ChainId)
+					Msgf("WatchOutbound: error GetAllOutboundTrackerByChain for chain %d", ob.Chain().ChainId)
 				continue
 			}
 			for _, tracker := range trackers {
 				// get original cctx parameters
 				outboundID := ob.GetTxID(tracker.Nonce)
-				cctx, err := ob.zetacoreClient.GetCctxByNonce(ob.chain.ChainId, tracker.Nonce)
+				cctx, err := ob.ZetacoreClient().GetCctxByNonce(ob.Chain().ChainId, tracker.Nonce)
 				if err != nil {
-					ob.logger.Outbound.Info().
+					ob.Logger().Outbound.Info().
 						Err(err).
-						Msgf("WatchOutbound: can't find cctx for chain %d nonce %d", ob.chain.ChainId, tracker.Nonce)
+						Msgf("WatchOutbound: can't find cctx for chain %d nonce %d", ob.Chain().ChainId, tracker.Nonce)
 					break
 				}
 
 				nonce := cctx.GetCurrentOutboundParam().TssNonce
 				if tracker.Nonce != nonce { // Tanmay: it doesn't hurt to check
-					ob.logger.Outbound.Error().
+					ob.Logger().Outbound.Error().
 						Msgf("WatchOutbound: tracker nonce %d not match cctx nonce %d", tracker.Nonce, nonce)
 					break
 				}
 
 				if len(tracker.HashList) > 1 {
-					ob.logger.Outbound.Warn().
+					ob.Logger().Outbound.Warn().
 						Msgf("WatchOutbound: oops, outboundID %s got multiple (%d) outbound hashes", outboundID, len(tracker.HashList))
 				}
 
 				// iterate over all txHashes to find the truly included one.
 				// we do it this (inefficient) way because we don't rely on the first one as it may be a false positive (for unknown reason).
 				txCount := 0
-				var txResult *btcjson.GetTransactionResult
+				txResults := make([]*btcjson.GetTransactionResult, 0, len(tracker.HashList))
 				for _, txHash := range tracker.HashList {
 					result, inMempool := ob.checkIncludedTx(cctx, txHash.TxHash)
 					if result != nil && !inMempool { // included
 						txCount++
-						txResult = result
-						ob.logger.Outbound.Info().
-							Msgf("WatchOutbound: included outbound %s for chain %d nonce %d", txHash.TxHash, ob.chain.ChainId, tracker.Nonce)
+						txResults = append(txResults, result)
+						ob.Logger().Outbound.Info().
+							Msgf("WatchOutbound: included outbound %s for chain %d nonce %d", txHash.TxHash, ob.Chain().ChainId, tracker.Nonce)
 						if txCount > 1 {
-							ob.logger.Outbound.Error().Msgf(
-								"WatchOutbound: checkIncludedTx passed, txCount %d chain %d nonce %d result %v", txCount, ob.chain.ChainId, tracker.Nonce, result)
+							ob.Logger().Outbound.Error().Msgf(
+								"WatchOutbound: checkIncludedTx passed, txCount %d chain %d nonce %d result %v", txCount, ob.Chain().ChainId, tracker.Nonce, result)
 						}
 					}
 				}
 
 				if txCount == 1 { // should be only one txHash included for each nonce
-					ob.setIncludedTx(tracker.Nonce, txResult)
+					ob.setIncludedTx(tracker.Nonce, txResults[0])
 				} else if txCount > 1 {
-					ob.removeIncludedTx(tracker.Nonce) // we can't tell which txHash is true, so we remove all (if any) to be safe
-					ob.logger.Outbound.Error().Msgf("WatchOutbound: included multiple (%d) outbound for chain %d nonce %d", txCount, ob.chain.ChainId, tracker.Nonce)
+					// an outbound replaced by fee (RBF) and its replacement are both tracked, only one of them can be mined
+					if txResult := ob.getReplacementTx(txResults); txResult != nil {
+						ob.setIncludedTx(tracker.Nonce, txResult)
+					} else {
+						ob.removeIncludedTx(tracker.Nonce) // we can't tell which txHash is true, so we remove all (if any) to be safe
+						ob.Logger().Outbound.Error().Msgf("WatchOutbound: included multiple (%d) outbound for chain %d nonce %d", txCount, ob.Chain().ChainId, tracker.Nonce)
+					}
 				}
 			}
-			ticker.UpdateInterval(ob.GetChainParams().OutboundTicker, ob.logger.Outbound)
-		case <-ob.stop:
-			ob.logger.Outbound.Info().Msgf("WatchOutbound stopped for chain %d", ob.chain.ChainId)
+			ticker.UpdateInterval(ob.GetChainParams().OutboundTicker, ob.Logger().Outbound)
+		case <-ob.StopChannel():
+			ob.Logger().Outbound.Info().Msgf("WatchOutbound stopped for chain %d", ob.Chain().ChainId)
 			return
 		}
 	}
@@ -118,10 +123,10 @@ func (ob *Observer) IsOutboundProcessed(cctx *crosschaintypes.CrossChainTx, logg
 	outboundID := ob.GetTxID(nonce)
 	logger.Info().Msgf("IsOutboundProcessed %s", outboundID)
 
-	ob.Mu.Lock()
+	ob.Mu().Lock()
 	txnHash, broadcasted := ob.broadcastedTx[outboundID]
 	res, included := ob.includedTxResults[outboundID]
-	ob.Mu.Unlock()
+	ob.Mu().Unlock()
 
 	if !included {
 		if !broadcasted {
@@ -141,7 +146,7 @@ func (ob *Observer) IsOutboundProcessed(cctx *crosschaintypes.CrossChainTx, logg
 		if txResult == nil { // check failed, try again next time
 			return false, false, nil
 		} else if inMempool { // still in mempool (should avoid unnecessary Tss keysign)
-			ob.logger.Outbound.Info().Msgf("IsOutboundProcessed: outbound %s is still in mempool", outboundID)
+			ob.Logger().Outbound.Info().Msgf("IsOutboundProcessed: outbound %s is still in mempool", outboundID)
 			return true, false, nil
 		}
 		// included
@@ -152,7 +157,7 @@ func (ob *Observer) IsOutboundProcessed(cctx *crosschaintypes.CrossChainTx, logg
 		if res == nil {
 			return false, false, nil
 		}
-		ob.logger.Outbound.Info().Msgf("IsOutboundProcessed: setIncludedTx succeeded for outbound %s", outboundID)
+		ob.Logger().Outbound.Info().Msgf("IsOutboundProcessed: setIncludedTx succeeded for outbound %s", outboundID)
 	}
 
 	// It's safe to use cctx's amount to post confirmation because it has already been verified in observeOutbound()
@@ -172,7 +177,7 @@ func (ob *Observer) IsOutboundProcessed(cctx *crosschaintypes.CrossChainTx, logg
 	}
 
 	logger.Debug().Msgf("Bitcoin outbound confirmed: txid %s, amount %s\n", res.TxID, amountInSat.String())
-	zetaHash, ballot, err := ob.zetacoreClient.PostVoteOutbound(
+	zetaHash, ballot, err := ob.ZetacoreClient().PostVoteOutbound(
 		sendHash,
 		res.TxID,
 		// #nosec G701 always positive
@@ -182,7 +187,7 @@ func (ob *Observer) IsOutboundProcessed(cctx *crosschaintypes.CrossChainTx, logg
 		0,   // gas limit not used with Bitcoin
 		amountInSat,
 		chains.ReceiveStatus_success,
-		ob.chain,
+		ob.Chain(),
 		nonce,
 		coin.CoinType_Gas,
 	)
@@ -197,6 +202,103 @@ func (ob *Observer) IsOutboundProcessed(cctx *crosschaintypes.CrossChainTx, logg
 	return true, true, nil
 }
 
+// IsOutboundReplaceable returns true if the outbound of given nonce is still in mempool and is the latest outbound.
+// Only the latest outbound is replaced by fee (RBF). As each outbound spends the nonce-mark of its prior outbound,
+// replacing the latest outbound with a higher fee rate also bumps its unconfirmed ancestors (CPFP).
+func (ob *Observer) IsOutboundReplaceable(nonce uint64) bool {
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
+
+	res, included := ob.includedTxResults[ob.GetTxID(nonce)]
+	if !included || res.Confirmations != 0 {
+		return false
+	}
+
+	// a batched outbound pays several nonces and is never replaced, it's bumped by CPFP of the next outbound
+	if prev, found := ob.includedTxResults[ob.GetTxID(nonce-1)]; found && nonce > 0 && prev.TxID == res.TxID {
+		return false
+	}
+
+	// the outbound is no longer the latest if next outbound is already broadcasted or included
+	_, broadcasted := ob.broadcastedTx[ob.GetTxID(nonce+1)]
+	_, includedNext := ob.includedTxResults[ob.GetTxID(nonce+1)]
+	return !broadcasted && !includedNext
+}
+
+// GetReplaceableOutbound returns the inputs of the pending outbound of given nonce if the outbound can be
+// replaced by a new outbound paying given fee rate (in sat/vByte).
+// The new fee rate has to be at least 'minFeeBump' sat/vByte higher than the fee rate of the pending outbound.
+func (ob *Observer) GetReplaceableOutbound(
+	nonce uint64,
+	feeRate int64,
+	minFeeBump int64,
+) ([]btcjson.ListUnspentResult, bool) {
+	if !ob.IsOutboundReplaceable(nonce) {
+		return nil, false
+	}
+	res := ob.getIncludedTx(nonce)
+	if res == nil {
+		return nil, false
+	}
+
+	// get the pending outbound and its inputs
+	hash, err := chainhash.NewHashFromStr(res.TxID)
+	if err != nil {
+		ob.Logger().Outbound.Error().Err(err).Msgf("GetReplaceableOutbound: error NewHashFromStr: %s", res.TxID)
+		return nil, false
+	}
+	rawResult, err := GetRawTxResult(ob.rpcClient, hash, res)
+	if err != nil {
+		ob.Logger().Outbound.Error().Err(err).Msgf("GetReplaceableOutbound: error GetRawTxResult: %s", res.TxID)
+		return nil, false
+	}
+	prevOuts, err := ob.getPrevOuts(rawResult.Vin)
+	if err != nil {
+		ob.Logger().Outbound.Error().Err(err).Msgf("GetReplaceableOutbound: error getting inputs of outbound %s", res.TxID)
+		return nil, false
+	}
+
+	// the replacement has to pay a higher fee rate than the pending outbound
+	pendingFeeRate, err := bitcoin.GetTxFeeRate(&rawResult, prevOuts)
+	if err != nil {
+		ob.Logger().Outbound.Error().Err(err).Msgf("GetReplaceableOutbound: error GetTxFeeRate: %s", res.TxID)
+		return nil, false
+	}
+	if feeRate < pendingFeeRate+minFeeBump {
+		return nil, false
+	}
+	ob.Logger().Outbound.Info().
+		Msgf("GetReplaceableOutbound: outbound %s nonce %d can be replaced, fee rate %d => %d", res.TxID, nonce, pendingFeeRate, feeRate)
+
+	return prevOuts, true
+}
+
+// getPrevOuts returns the previous outputs spent by given inputs
+func (ob *Observer) getPrevOuts(vins []btcjson.Vin) ([]btcjson.ListUnspentResult, error) {
+	prevOuts := make([]btcjson.ListUnspentResult, 0, len(vins))
+	for _, vin := range vins {
+		hash, err := chainhash.NewHashFromStr(vin.Txid)
+		if err != nil {
+			return nil, err
+		}
+		prevTx, err := ob.rpcClient.GetRawTransactionVerbose(hash)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getPrevOuts: error GetRawTransactionVerbose %s", vin.Txid)
+		}
+		if int(vin.Vout) >= len(prevTx.Vout) {
+			return nil, fmt.Errorf("getPrevOuts: vout index %d out of range for tx %s", vin.Vout, vin.Txid)
+		}
+		vout := prevTx.Vout[vin.Vout]
+		prevOuts = append(prevOuts, btcjson.ListUnspentResult{
+			TxID:         vin.Txid,
+			Vout:         vin.Vout,
+			ScriptPubKey: vout.ScriptPubKey.Hex,
+			Amount:       vout.Value,
+		})
+	}
+	return prevOuts, nil
+}
+
 // SelectUTXOs selects a sublist of utxos to be used as inputs.
 //
 // Parameters:
@@ -221,16 +323,16 @@ func (ob *Observer) SelectUTXOs(
 	idx := -1
 	if nonce == 0 {
 		// for nonce = 0; make exception; no need to include nonce-mark utxo
-		ob.Mu.Lock()
-		defer ob.Mu.Unlock()
+		ob.Mu().Lock()
+		defer ob.Mu().Unlock()
 	} else {
 		// for nonce > 0; we proceed only when we see the nonce-mark utxo
 		preTxid, err := ob.getOutboundIDByNonce(nonce-1, test)
 		if err != nil {
 			return nil, 0, 0, 0, err
 		}
-		ob.Mu.Lock()
-		defer ob.Mu.Unlock()
+		ob.Mu().Lock()
+		defer ob.Mu().Unlock()
 		idx, err = ob.findNonceMarkUTXO(nonce-1, preTxid)
 		if err != nil {
 			return nil, 0, 0, 0, err
@@ -299,15 +401,15 @@ func (ob *Observer) SelectUTXOs(
 // 2. The tracker is missing in zetacore.
 func (ob *Observer) refreshPendingNonce() {
 	// get pending nonces from zetacore
-	p, err := ob.zetacoreClient.GetPendingNoncesByChain(ob.chain.ChainId)
+	p, err := ob.ZetacoreClient().GetPendingNoncesByChain(ob.Chain().ChainId)
 	if err != nil {
-		ob.logger.Chain.Error().Err(err).Msg("refreshPendingNonce: error getting pending nonces")
+		ob.Logger().Chain.Error().Err(err).Msg("refreshPendingNonce: error getting pending nonces")
 	}
 
 	// increase pending nonce if lagged behind
-	ob.Mu.Lock()
+	ob.Mu().Lock()
 	pendingNonce := ob.pendingNonce
-	ob.Mu.Unlock()
+	ob.Mu().Unlock()
 
 	// #nosec G701 always non-negative
 	nonceLow := uint64(p.NonceLow)
@@ -315,14 +417,14 @@ func (ob *Observer) refreshPendingNonce() {
 		// get the last included outbound hash
 		txid, err := ob.getOutboundIDByNonce(nonceLow-1, false)
 		if err != nil {
-			ob.logger.Chain.Error().Err(err).Msg("refreshPendingNonce: error getting last outbound txid")
+			ob.Logger().Chain.Error().Err(err).Msg("refreshPendingNonce: error getting last outbound txid")
 		}
 
 		// set 'NonceLow' as the new pending nonce
-		ob.Mu.Lock()
-		defer ob.Mu.Unlock()
+		ob.Mu().Lock()
+		defer ob.Mu().Unlock()
 		ob.pendingNonce = nonceLow
-		ob.logger.Chain.Info().
+		ob.Logger().Chain.Info().
 			Msgf("refreshPendingNonce: increase pending nonce to %d with txid %s", ob.pendingNonce, txid)
 	}
 }
@@ -336,7 +438,7 @@ func (ob *Observer) getOutboundIDByNonce(nonce uint64, test bool) (string, error
 		return res.TxID, nil
 	}
 	if !test { // if not unit test, get cctx from zetacore
-		send, err := ob.zetacoreClient.GetCctxByNonce(ob.chain.ChainId, nonce)
+		send, err := ob.ZetacoreClient().GetCctxByNonce(ob.Chain().ChainId, nonce)
 		if err != nil {
 			return "", errors.Wrapf(err, "getOutboundIDByNonce: error getting cctx for nonce %d", nonce)
 		}
@@ -363,15 +465,15 @@ func (ob *Observer) getOutboundIDByNonce(nonce uint64, test bool) (string, error
 }
 
 func (ob *Observer) findNonceMarkUTXO(nonce uint64, txid string) (int, error) {
-	tssAddress := ob.Tss.BTCAddressWitnessPubkeyHash().EncodeAddress()
+	tssAddress := ob.TSS().BTCAddressWitnessPubkeyHash(ob.Chain().ChainId).EncodeAddress()
 	amount := chains.NonceMarkAmount(nonce)
 	for i, utxo := range ob.utxos {
 		sats, err := bitcoin.GetSatoshis(utxo.Amount)
 		if err != nil {
-			ob.logger.Outbound.Error().Err(err).Msgf("findNonceMarkUTXO: error getting satoshis for utxo %v", utxo)
+			ob.Logger().Outbound.Error().Err(err).Msgf("findNonceMarkUTXO: error getting satoshis for utxo %v", utxo)
 		}
 		if utxo.Address == tssAddress && sats == amount && utxo.TxID == txid && utxo.Vout == 0 {
-			ob.logger.Outbound.Info().
+			ob.Logger().Outbound.Info().
 				Msgf("findNonceMarkUTXO: found nonce-mark utxo with txid %s, amount %d satoshi", utxo.TxID, sats)
 			return i, nil
 		}
@@ -388,12 +490,12 @@ func (ob *Observer) checkIncludedTx(
 	outboundID := ob.GetTxID(cctx.GetCurrentOutboundParam().TssNonce)
 	hash, getTxResult, err := GetTxResultByHash(ob.rpcClient, txHash)
 	if err != nil {
-		ob.logger.Outbound.Error().Err(err).Msgf("checkIncludedTx: error GetTxResultByHash: %s", txHash)
+		ob.Logger().Outbound.Error().Err(err).Msgf("checkIncludedTx: error GetTxResultByHash: %s", txHash)
 		return nil, false
 	}
 
 	if txHash != getTxResult.TxID { // just in case, we'll use getTxResult.TxID later
-		ob.logger.Outbound.Error().
+		ob.Logger().Outbound.Error().
 			Msgf("checkIncludedTx: inconsistent txHash %s and getTxResult.TxID %s", txHash, getTxResult.TxID)
 		return nil, false
 	}
@@ -401,7 +503,7 @@ func (ob *Observer) checkIncludedTx(
 	if getTxResult.Confirmations >= 0 { // check included tx only
 		err = ob.checkTssOutboundResult(cctx, hash, getTxResult)
 		if err != nil {
-			ob.logger.Outbound.Error().
+			ob.Logger().Outbound.Error().
 				Err(err).
 				Msgf("checkIncludedTx: error verify bitcoin outbound %s outboundID %s", txHash, outboundID)
 			return nil, false
@@ -416,8 +518,8 @@ func (ob *Observer) setIncludedTx(nonce uint64, getTxResult *btcjson.GetTransact
 	txHash := getTxResult.TxID
 	outboundID := ob.GetTxID(nonce)
 
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
 	res, found := ob.includedTxResults[outboundID]
 
 	if !found { // not found.
@@ -426,35 +528,102 @@ func (ob *Observer) setIncludedTx(nonce uint64, getTxResult *btcjson.GetTransact
 		if nonce >= ob.pendingNonce {                  // try increasing pending nonce on every newly included outbound
 			ob.pendingNonce = nonce + 1
 		}
-		ob.logger.Outbound.Info().
+		ob.Logger().Outbound.Info().
 			Msgf("setIncludedTx: included new bitcoin outbound %s outboundID %s pending nonce %d", txHash, outboundID, ob.pendingNonce)
 	} else if txHash == res.TxID { // found same hash.
 		ob.includedTxResults[outboundID] = getTxResult // update tx result as confirmations may increase
 		if getTxResult.Confirmations > res.Confirmations {
-			ob.logger.Outbound.Info().Msgf("setIncludedTx: bitcoin outbound %s got confirmations %d", txHash, getTxResult.Confirmations)
+			ob.Logger().Outbound.Info().Msgf("setIncludedTx: bitcoin outbound %s got confirmations %d", txHash, getTxResult.Confirmations)
 		}
+	} else if res.Confirmations == 0 && getTxResult.Confirmations >= 0 && ob.spendSameInputs(res.TxID, txHash) {
+		// found other hash replacing the pending one.
+		// the pending outbound was replaced by fee (RBF), they spend same inputs so only one of them can be mined
+		delete(ob.includedTxHashes, res.TxID)
+		delete(ob.includedTxInputs, res.TxID)
+		ob.includedTxHashes[txHash] = true
+		ob.includedTxResults[outboundID] = getTxResult
+		ob.Logger().Outbound.Info().
+			Msgf("setIncludedTx: bitcoin outbound %s replaced prior outbound %s outboundID %s", txHash, res.TxID, outboundID)
 	} else { // found other hash.
 		// be alert for duplicate payment!!! As we got a new hash paying same cctx (for whatever reason).
 		delete(ob.includedTxResults, outboundID) // we can't tell which txHash is true, so we remove all to be safe
-		ob.logger.Outbound.Error().Msgf("setIncludedTx: duplicate payment by bitcoin outbound %s outboundID %s, prior outbound %s", txHash, outboundID, res.TxID)
+		ob.Logger().Outbound.Error().Msgf("setIncludedTx: duplicate payment by bitcoin outbound %s outboundID %s, prior outbound %s", txHash, outboundID, res.TxID)
+	}
+}
+
+// getReplacementTx returns the tx that replaces the others among given included txs of the same nonce.
+// The txs are all replacements (RBF) of each other only if they spend same inputs, then the mined one
+// or the latest one (the last in tracker) is returned. Returns nil if they don't spend same inputs.
+func (ob *Observer) getReplacementTx(txResults []*btcjson.GetTransactionResult) *btcjson.GetTransactionResult {
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
+
+	for _, res := range txResults[1:] {
+		if !ob.spendSameInputs(txResults[0].TxID, res.TxID) {
+			return nil
+		}
 	}
+	for _, res := range txResults {
+		if res.Confirmations > 0 {
+			return res
+		}
+	}
+	return txResults[len(txResults)-1]
+}
+
+// setTxInputs saves the inputs spent by given tx in memory
+func (ob *Observer) setTxInputs(txHash string, vins []btcjson.Vin) {
+	inputs := make([]string, 0, len(vins))
+	for _, vin := range vins {
+		inputs = append(inputs, fmt.Sprintf("%s:%d", vin.Txid, vin.Vout))
+	}
+
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
+	ob.includedTxInputs[txHash] = inputs
+}
+
+// spendSameInputs returns true if the two txs spend a common input (e.g. the nonce-mark), so only one of them can be mined.
+// Note: the caller should hold the lock
+func (ob *Observer) spendSameInputs(txHashA string, txHashB string) bool {
+	inputsA, foundA := ob.includedTxInputs[txHashA]
+	inputsB, foundB := ob.includedTxInputs[txHashB]
+	if !foundA || !foundB {
+		return false
+	}
+	for _, inputA := range inputsA {
+		for _, inputB := range inputsB {
+			if inputA == inputB {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // getIncludedTx gets the receipt and transaction from memory
 func (ob *Observer) getIncludedTx(nonce uint64) *btcjson.GetTransactionResult {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
 	return ob.includedTxResults[ob.GetTxID(nonce)]
 }
 
 // removeIncludedTx removes included tx from memory
 func (ob *Observer) removeIncludedTx(nonce uint64) {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
 	txResult, found := ob.includedTxResults[ob.GetTxID(nonce)]
 	if found {
-		delete(ob.includedTxHashes, txResult.TxID)
 		delete(ob.includedTxResults, ob.GetTxID(nonce))
+
+		// a batched outbound is still included by other nonces it pays
+		for _, res := range ob.includedTxResults {
+			if res.TxID == txResult.TxID {
+				return
+			}
+		}
+		delete(ob.includedTxHashes, txResult.TxID)
+		delete(ob.includedTxInputs, txResult.TxID)
 	}
 }
 
@@ -474,13 +643,13 @@ func (ob *Observer) checkTssOutboundResult(
 	if err != nil {
 		return errors.Wrapf(err, "checkTssOutboundResult: error GetRawTxResultByHash %s", hash.String())
 	}
-	err = ob.checkTSSVin(rawResult.Vin, nonce)
-	if err != nil {
-		return errors.Wrapf(err, "checkTssOutboundResult: invalid TSS Vin in outbound %s nonce %d", hash, nonce)
-	}
 
 	// differentiate between normal and restricted cctx
 	if compliance.IsCctxRestricted(cctx) {
+		err = ob.checkTSSVin(rawResult.Vin, nonce)
+		if err != nil {
+			return errors.Wrapf(err, "checkTssOutboundResult: invalid TSS Vin in outbound %s nonce %d", hash, nonce)
+		}
 		err = ob.checkTSSVoutCancelled(params, rawResult.Vout)
 		if err != nil {
 			return errors.Wrapf(
@@ -491,14 +660,61 @@ func (ob *Observer) checkTssOutboundResult(
 			)
 		}
 	} else {
-		err = ob.checkTSSVout(params, rawResult.Vout)
+		// the outbound may pay a batch of cctxs, the 1st input is the nonce-mark prior to the 1st nonce in the batch
+		firstNonce, err := GetOutboundFirstNonce(rawResult.Vout, nonce)
+		if err != nil {
+			return errors.Wrapf(err, "checkTssOutboundResult: invalid batch in outbound %s nonce %d", hash, nonce)
+		}
+		err = ob.checkTSSVin(rawResult.Vin, firstNonce)
+		if err != nil {
+			return errors.Wrapf(err, "checkTssOutboundResult: invalid TSS Vin in outbound %s nonce %d", hash, nonce)
+		}
+		err = ob.checkTSSVout(params, rawResult.Vout, firstNonce)
 		if err != nil {
 			return errors.Wrapf(err, "checkTssOutboundResult: invalid TSS Vout in outbound %s nonce %d", hash, nonce)
 		}
 	}
+
+	// save the inputs to tell if the outbound is replaced by fee (RBF)
+	ob.setTxInputs(hash.String(), rawResult.Vin)
 	return nil
 }
 
+// GetOutboundFirstNonce returns the 1st nonce paid by the outbound that pays given nonce.
+//   - a single outbound pays only one nonce: [nonce-mark, payment, change (optional)]
+//   - a batched outbound pays consecutive nonces: [nonce-mark of last nonce, payment1, ..., paymentN, change]
+func GetOutboundFirstNonce(vouts []btcjson.Vout, nonce uint64) (uint64, error) {
+	if len(vouts) < 2 {
+		return 0, fmt.Errorf("GetOutboundFirstNonce: invalid number of vouts: %d", len(vouts))
+	}
+
+	// the 1st output is the nonce-mark of the last nonce
+	amount, err := bitcoin.GetSatoshis(vouts[0].Value)
+	if err != nil {
+		return 0, errors.Wrap(err, "GetOutboundFirstNonce: error getting nonce-mark satoshis")
+	}
+	if amount < chains.NonceMarkAmount(0) {
+		return 0, fmt.Errorf("GetOutboundFirstNonce: invalid nonce-mark amount %d", amount)
+	}
+	// #nosec G701 always positive
+	lastNonce := uint64(amount - chains.NonceMarkAmount(0))
+
+	// number of payments made by the outbound
+	numPayments := uint64(1)
+	if len(vouts) > 3 {
+		// #nosec G701 always in range
+		numPayments = uint64(len(vouts) - 2)
+	}
+	if lastNonce+1 < numPayments {
+		return 0, fmt.Errorf("GetOutboundFirstNonce: last nonce %d less than %d payments", lastNonce, numPayments)
+	}
+	firstNonce := lastNonce + 1 - numPayments
+	if nonce < firstNonce || nonce > lastNonce {
+		return 0, fmt.Errorf("GetOutboundFirstNonce: nonce %d not in range [%d, %d]", nonce, firstNonce, lastNonce)
+	}
+	return firstNonce, nil
+}
+
 // checkTSSVin checks vin is valid if:
 //   - The first input is the nonce-mark
 //   - All inputs are from TSS address
@@ -507,7 +723,7 @@ func (ob *Observer) checkTSSVin(vins []btcjson.Vin, nonce uint64) error {
 	if nonce > 0 && len(vins) <= 1 {
 		return fmt.Errorf("checkTSSVin: len(vins) <= 1")
 	}
-	pubKeyTss := hex.EncodeToString(ob.Tss.PubKeyCompressedBytes())
+	pubKeyTss := hex.EncodeToString(ob.TSS().PubKeyCompressedBytes())
 	for i, vin := range vins {
 		// The length of the Witness should be always 2 for SegWit inputs.
 		if len(vin.Witness) != 2 {
@@ -537,25 +753,48 @@ func (ob *Observer) checkTSSVin(vins []btcjson.Vin, nonce uint64) error {
 }
 
 // checkTSSVout vout is valid if:
-//   - The first output is the nonce-mark
-//   - The second output is the correct payment to recipient
-//   - The third output is the change to TSS (optional)
-func (ob *Observer) checkTSSVout(params *crosschaintypes.OutboundParams, vouts []btcjson.Vout) error {
+//   - The first output is the nonce-mark of the last nonce paid by the outbound
+//   - The output of given nonce is the correct payment to recipient
+//   - The last output is the change to TSS (optional for a single outbound)
+//
+// The outbound pays consecutive nonces starting from 'firstNonce', it's a single outbound if firstNonce == nonce.
+func (ob *Observer) checkTSSVout(
+	params *crosschaintypes.OutboundParams,
+	vouts []btcjson.Vout,
+	firstNonce uint64,
+) error {
 	// vouts: [nonce-mark, payment to recipient, change to TSS (optional)]
-	if !(len(vouts) == 2 || len(vouts) == 3) {
+	// batched vouts: [nonce-mark, payment1, ..., paymentN, change to TSS]
+	nonce := params.TssNonce
+	numPayments := 1
+	if len(vouts) > 3 {
+		numPayments = len(vouts) - 2
+	}
+	if !(len(vouts) == 2 || len(vouts) == numPayments+2) {
 		return fmt.Errorf("checkTSSVout: invalid number of vouts: %d", len(vouts))
 	}
 
-	nonce := params.TssNonce
-	tssAddress := ob.Tss.BTCAddress()
+	// #nosec G701 always in range
+	lastNonce := firstNonce + uint64(numPayments) - 1
+	if nonce < firstNonce || nonce > lastNonce {
+		return fmt.Errorf("checkTSSVout: nonce %d not in range [%d, %d]", nonce, firstNonce, lastNonce)
+	}
+	// #nosec G701 always in range
+	paymentN := uint32(nonce-firstNonce) + 1
+	tssAddress := ob.TSS().BTCAddress(ob.Chain().ChainId)
 	for _, vout := range vouts {
+		// skip the payments to other recipients in the batch
+		if vout.N != 0 && vout.N != paymentN && int(vout.N) != len(vouts)-1 {
+			continue
+		}
+
 		// decode receiver and amount from vout
 		receiverExpected := tssAddress
-		if vout.N == 1 {
-			// the 2nd output is the payment to recipient
+		if vout.N == paymentN {
+			// the payment to recipient
 			receiverExpected = params.Receiver
 		}
-		receiverVout, amount, err := bitcoin.DecodeTSSVout(vout, receiverExpected, ob.chain)
+		receiverVout, amount, err := bitcoin.DecodeTSSVout(vout, receiverExpected, ob.Chain())
 		if err != nil {
 			return err
 		}
@@ -568,14 +807,14 @@ func (ob *Observer) checkTSSVout(params *crosschaintypes.OutboundParams, vouts [
 					tssAddress,
 				)
 			}
-			if amount != chains.NonceMarkAmount(nonce) {
+			if amount != chains.NonceMarkAmount(lastNonce) {
 				return fmt.Errorf(
 					"checkTSSVout: nonce-mark amount %d not match nonce-mark amount %d",
 					amount,
-					chains.NonceMarkAmount(nonce),
+					chains.NonceMarkAmount(lastNonce),
 				)
 			}
-		case 1: // 2nd vout: payment to recipient
+		case paymentN: // payment to recipient
 			if receiverVout != params.Receiver {
 				return fmt.Errorf(
 					"checkTSSVout: output address %s not match params receiver %s",
@@ -587,7 +826,7 @@ func (ob *Observer) checkTSSVout(params *crosschaintypes.OutboundParams, vouts [
 			if uint64(amount) != params.Amount.Uint64() {
 				return fmt.Errorf("checkTSSVout: output amount %d not match params amount %d", amount, params.Amount)
 			}
-		case 2: // 3rd vout: change to TSS (optional)
+		default: // last vout: change to TSS
 			if receiverVout != tssAddress {
 				return fmt.Errorf("checkTSSVout: change address %s not match TSS address %s", receiverVout, tssAddress)
 			}
@@ -606,10 +845,10 @@ func (ob *Observer) checkTSSVoutCancelled(params *crosschaintypes.OutboundParams
 	}
 
 	nonce := params.TssNonce
-	tssAddress := ob.Tss.BTCAddress()
+	tssAddress := ob.TSS().BTCAddress(ob.Chain().ChainId)
 	for _, vout := range vouts {
 		// decode receiver and amount from vout
-		receiverVout, amount, err := bitcoin.DecodeTSSVout(vout, tssAddress, ob.chain)
+		receiverVout, amount, err := bitcoin.DecodeTSSVout(vout, tssAddress, ob.Chain())
 		if err != nil {
 			return errors.Wrap(err, "checkTSSVoutCancelled: error decoding P2WPKH vout")
 		}
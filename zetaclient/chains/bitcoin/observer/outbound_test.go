@@ -3,7 +3,6 @@ package observer
 import (
 	"math"
 	"sort"
-	"sync"
 	"testing"
 
 	"github.com/btcsuite/btcd/btcjson"
@@ -11,22 +10,12 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/zeta-chain/zetacore/pkg/chains"
-	"github.com/zeta-chain/zetacore/zetaclient/config"
-	"github.com/zeta-chain/zetacore/zetaclient/context"
 	"github.com/zeta-chain/zetacore/zetaclient/testutils"
 	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
 )
 
-func MockBTCObserverMainnet() *Observer {
-	cfg := config.NewConfig()
-	coreContext := context.NewZetacoreContext(cfg)
-
-	return &Observer{
-		chain:          chains.BitcoinMainnet,
-		zetacoreClient: mocks.NewMockZetacoreClient(),
-		Tss:            mocks.NewTSSMainnet(),
-		coreContext:    coreContext,
-	}
+func MockBTCObserverMainnet(t *testing.T) *Observer {
+	return newTestObserver(t, chains.BitcoinMainnet, mocks.NewTSSMainnet())
 }
 
 // helper function to create a test Bitcoin observer
@@ -37,18 +26,14 @@ func createObserverWithPrivateKey(t *testing.T) *Observer {
 	tss := &mocks.TSS{
 		PrivKey: privateKey,
 	}
-	return &Observer{
-		Tss:               tss,
-		Mu:                &sync.Mutex{},
-		includedTxResults: make(map[string]*btcjson.GetTransactionResult),
-	}
+	return newTestObserver(t, chains.Chain{}, tss)
 }
 
 // helper function to create a test Bitcoin observer with UTXOs
 func createObserverWithUTXOs(t *testing.T) *Observer {
 	// Create Bitcoin observer
 	client := createObserverWithPrivateKey(t)
-	tssAddress := client.Tss.BTCAddressWitnessPubkeyHash().EncodeAddress()
+	tssAddress := client.TSS().BTCAddressWitnessPubkeyHash(client.Chain().ChainId).EncodeAddress()
 
 	// Create 10 dummy UTXOs (22.44 BTC in total)
 	client.utxos = make([]btcjson.ListUnspentResult, 0, 10)
@@ -65,7 +50,7 @@ func mineTxNSetNonceMark(ob *Observer, nonce uint64, txid string, preMarkIndex i
 	ob.includedTxResults[outboundID] = &btcjson.GetTransactionResult{TxID: txid}
 
 	// Set nonce mark
-	tssAddress := ob.Tss.BTCAddressWitnessPubkeyHash().EncodeAddress()
+	tssAddress := ob.TSS().BTCAddressWitnessPubkeyHash(ob.Chain().ChainId).EncodeAddress()
 	nonceMark := btcjson.ListUnspentResult{
 		TxID:    txid,
 		Address: tssAddress,
@@ -90,23 +75,40 @@ func TestCheckTSSVout(t *testing.T) {
 	nonce := uint64(148)
 
 	// create mainnet mock client
-	btcClient := MockBTCObserverMainnet()
+	btcClient := MockBTCObserverMainnet(t)
 
 	t.Run("valid TSS vout should pass", func(t *testing.T) {
 		rawResult, cctx := testutils.LoadBTCTxRawResultNCctx(t, TestDataDir, chainID, nonce)
 		params := cctx.GetCurrentOutboundParam()
-		err := btcClient.checkTSSVout(params, rawResult.Vout)
+		err := btcClient.checkTSSVout(params, rawResult.Vout, nonce)
 		require.NoError(t, err)
 	})
-	t.Run("should fail if vout length < 2 or > 3", func(t *testing.T) {
+	t.Run("valid TSS vout in batched outbound should pass", func(t *testing.T) {
+		rawResult, cctx := testutils.LoadBTCTxRawResultNCctx(t, TestDataDir, chainID, nonce)
+		params := cctx.GetCurrentOutboundParam()
+
+		// batched outbound paying nonce 147 and 148: [nonce-mark, payment 147, payment 148, change]
+		vouts := []btcjson.Vout{rawResult.Vout[0], rawResult.Vout[1], rawResult.Vout[1], rawResult.Vout[2]}
+		for i := range vouts {
+			vouts[i].N = uint32(i)
+		}
+		err := btcClient.checkTSSVout(params, vouts, nonce-1)
+		require.NoError(t, err)
+	})
+	t.Run("should fail if vout length < 2", func(t *testing.T) {
 		_, cctx := testutils.LoadBTCTxRawResultNCctx(t, TestDataDir, chainID, nonce)
 		params := cctx.GetCurrentOutboundParam()
 
-		err := btcClient.checkTSSVout(params, []btcjson.Vout{{}})
+		err := btcClient.checkTSSVout(params, []btcjson.Vout{{}}, nonce)
 		require.ErrorContains(t, err, "invalid number of vouts")
+	})
+	t.Run("should fail if nonce is not paid by the outbound", func(t *testing.T) {
+		_, cctx := testutils.LoadBTCTxRawResultNCctx(t, TestDataDir, chainID, nonce)
+		params := cctx.GetCurrentOutboundParam()
 
-		err = btcClient.checkTSSVout(params, []btcjson.Vout{{}, {}, {}, {}})
-		require.ErrorContains(t, err, "invalid number of vouts")
+		// the batched outbound pays nonce 145 and 146 only
+		err := btcClient.checkTSSVout(params, []btcjson.Vout{{}, {}, {}, {}}, nonce-3)
+		require.ErrorContains(t, err, "not in range")
 	})
 	t.Run("should fail on invalid TSS vout", func(t *testing.T) {
 		rawResult, cctx := testutils.LoadBTCTxRawResultNCctx(t, TestDataDir, chainID, nonce)
@@ -114,7 +116,7 @@ func TestCheckTSSVout(t *testing.T) {
 
 		// invalid TSS vout
 		rawResult.Vout[0].ScriptPubKey.Hex = "invalid script"
-		err := btcClient.checkTSSVout(params, rawResult.Vout)
+		err := btcClient.checkTSSVout(params, rawResult.Vout, nonce)
 		require.Error(t, err)
 	})
 	t.Run("should fail if vout 0 is not to the TSS address", func(t *testing.T) {
@@ -123,7 +125,7 @@ func TestCheckTSSVout(t *testing.T) {
 
 		// not TSS address, bc1qh297vdt8xq6df5xae9z8gzd4jsu9a392mp0dus
 		rawResult.Vout[0].ScriptPubKey.Hex = "0014ba8be635673034d4d0ddc9447409b594385ec4aa"
-		err := btcClient.checkTSSVout(params, rawResult.Vout)
+		err := btcClient.checkTSSVout(params, rawResult.Vout, nonce)
 		require.ErrorContains(t, err, "not match TSS address")
 	})
 	t.Run("should fail if vout 0 not match nonce mark", func(t *testing.T) {
@@ -132,7 +134,7 @@ func TestCheckTSSVout(t *testing.T) {
 
 		// not match nonce mark
 		rawResult.Vout[0].Value = 0.00000147
-		err := btcClient.checkTSSVout(params, rawResult.Vout)
+		err := btcClient.checkTSSVout(params, rawResult.Vout, nonce)
 		require.ErrorContains(t, err, "not match nonce-mark amount")
 	})
 	t.Run("should fail if vout 1 is not to the receiver address", func(t *testing.T) {
@@ -141,7 +143,7 @@ func TestCheckTSSVout(t *testing.T) {
 
 		// not receiver address, bc1qh297vdt8xq6df5xae9z8gzd4jsu9a392mp0dus
 		rawResult.Vout[1].ScriptPubKey.Hex = "0014ba8be635673034d4d0ddc9447409b594385ec4aa"
-		err := btcClient.checkTSSVout(params, rawResult.Vout)
+		err := btcClient.checkTSSVout(params, rawResult.Vout, nonce)
 		require.ErrorContains(t, err, "not match params receiver")
 	})
 	t.Run("should fail if vout 1 not match payment amount", func(t *testing.T) {
@@ -150,7 +152,7 @@ func TestCheckTSSVout(t *testing.T) {
 
 		// not match payment amount
 		rawResult.Vout[1].Value = 0.00011000
-		err := btcClient.checkTSSVout(params, rawResult.Vout)
+		err := btcClient.checkTSSVout(params, rawResult.Vout, nonce)
 		require.ErrorContains(t, err, "not match params amount")
 	})
 	t.Run("should fail if vout 2 is not to the TSS address", func(t *testing.T) {
@@ -159,11 +161,40 @@ func TestCheckTSSVout(t *testing.T) {
 
 		// not TSS address, bc1qh297vdt8xq6df5xae9z8gzd4jsu9a392mp0dus
 		rawResult.Vout[2].ScriptPubKey.Hex = "0014ba8be635673034d4d0ddc9447409b594385ec4aa"
-		err := btcClient.checkTSSVout(params, rawResult.Vout)
+		err := btcClient.checkTSSVout(params, rawResult.Vout, nonce)
 		require.ErrorContains(t, err, "not match TSS address")
 	})
 }
 
+func TestGetOutboundFirstNonce(t *testing.T) {
+	// nonce-mark of nonce 148
+	nonceMark := float64(chains.NonceMarkAmount(148)) * 1e-8
+
+	t.Run("should return nonce for single outbound", func(t *testing.T) {
+		firstNonce, err := GetOutboundFirstNonce([]btcjson.Vout{{Value: nonceMark}, {}, {}}, 148)
+		require.NoError(t, err)
+		require.Equal(t, uint64(148), firstNonce)
+	})
+	t.Run("should return first nonce for batched outbound", func(t *testing.T) {
+		vouts := []btcjson.Vout{{Value: nonceMark}, {}, {}, {}, {}}
+		firstNonce, err := GetOutboundFirstNonce(vouts, 147)
+		require.NoError(t, err)
+		require.Equal(t, uint64(146), firstNonce)
+	})
+	t.Run("should fail if nonce is not paid by the outbound", func(t *testing.T) {
+		_, err := GetOutboundFirstNonce([]btcjson.Vout{{Value: nonceMark}, {}, {}, {}}, 146)
+		require.ErrorContains(t, err, "not in range")
+	})
+	t.Run("should fail on invalid nonce-mark", func(t *testing.T) {
+		_, err := GetOutboundFirstNonce([]btcjson.Vout{{Value: 0.00001}, {}}, 0)
+		require.ErrorContains(t, err, "invalid nonce-mark amount")
+	})
+	t.Run("should fail if vout length < 2", func(t *testing.T) {
+		_, err := GetOutboundFirstNonce([]btcjson.Vout{{Value: nonceMark}}, 148)
+		require.ErrorContains(t, err, "invalid number of vouts")
+	})
+}
+
 func TestCheckTSSVoutCancelled(t *testing.T) {
 	// the archived outbound raw result file and cctx file
 	// https://blockstream.info/tx/030cd813443f7b70cc6d8a544d320c6d8465e4528fc0f3410b599dc0b26753a0
@@ -172,7 +203,7 @@ func TestCheckTSSVoutCancelled(t *testing.T) {
 	nonce := uint64(148)
 
 	// create mainnet mock client
-	btcClient := MockBTCObserverMainnet()
+	btcClient := MockBTCObserverMainnet(t)
 
 	t.Run("valid TSS vout should pass", func(t *testing.T) {
 		// remove change vout to simulate cancelled tx
@@ -443,3 +474,116 @@ func TestUTXOConsolidation(t *testing.T) {
 		require.Equal(t, 22.31, clsdtValue)
 	})
 }
+
+func TestIsOutboundReplaceable(t *testing.T) {
+	t.Run("should be replaceable if latest outbound is in mempool", func(t *testing.T) {
+		ob := createObserverWithPrivateKey(t)
+		ob.broadcastedTx = make(map[string]string)
+		ob.includedTxResults[ob.GetTxID(1)] = &btcjson.GetTransactionResult{TxID: "pending", Confirmations: 0}
+		require.True(t, ob.IsOutboundReplaceable(1))
+	})
+	t.Run("should not be replaceable if outbound is not included", func(t *testing.T) {
+		ob := createObserverWithPrivateKey(t)
+		ob.broadcastedTx = make(map[string]string)
+		require.False(t, ob.IsOutboundReplaceable(1))
+	})
+	t.Run("should not be replaceable if outbound is mined", func(t *testing.T) {
+		ob := createObserverWithPrivateKey(t)
+		ob.broadcastedTx = make(map[string]string)
+		ob.includedTxResults[ob.GetTxID(1)] = &btcjson.GetTransactionResult{TxID: "pending", Confirmations: 1}
+		require.False(t, ob.IsOutboundReplaceable(1))
+	})
+	t.Run("should not be replaceable if next outbound is broadcasted", func(t *testing.T) {
+		ob := createObserverWithPrivateKey(t)
+		ob.broadcastedTx = map[string]string{ob.GetTxID(2): "next"}
+		ob.includedTxResults[ob.GetTxID(1)] = &btcjson.GetTransactionResult{TxID: "pending", Confirmations: 0}
+		require.False(t, ob.IsOutboundReplaceable(1))
+	})
+	t.Run("should not be replaceable if next outbound is included", func(t *testing.T) {
+		ob := createObserverWithPrivateKey(t)
+		ob.broadcastedTx = make(map[string]string)
+		ob.includedTxResults[ob.GetTxID(1)] = &btcjson.GetTransactionResult{TxID: "pending", Confirmations: 0}
+		ob.includedTxResults[ob.GetTxID(2)] = &btcjson.GetTransactionResult{TxID: "next", Confirmations: 0}
+		require.False(t, ob.IsOutboundReplaceable(1))
+	})
+	t.Run("should not be replaceable if outbound is batched", func(t *testing.T) {
+		ob := createObserverWithPrivateKey(t)
+		ob.broadcastedTx = make(map[string]string)
+		ob.includedTxResults[ob.GetTxID(1)] = &btcjson.GetTransactionResult{TxID: "batch", Confirmations: 0}
+		ob.includedTxResults[ob.GetTxID(2)] = &btcjson.GetTransactionResult{TxID: "batch", Confirmations: 0}
+		require.False(t, ob.IsOutboundReplaceable(2))
+	})
+}
+
+func TestSetIncludedTx(t *testing.T) {
+	t.Run("should replace pending outbound with replacement", func(t *testing.T) {
+		ob := createObserverWithPrivateKey(t)
+		ob.includedTxHashes = make(map[string]bool)
+		ob.setTxInputs("original", []btcjson.Vin{{Txid: "prior", Vout: 0}, {Txid: "utxo", Vout: 1}})
+		ob.setTxInputs("replacement", []btcjson.Vin{{Txid: "prior", Vout: 0}, {Txid: "utxo", Vout: 1}})
+		ob.setIncludedTx(1, &btcjson.GetTransactionResult{TxID: "original", Confirmations: 0})
+		ob.setIncludedTx(1, &btcjson.GetTransactionResult{TxID: "replacement", Confirmations: 0})
+
+		require.Equal(t, "replacement", ob.getIncludedTx(1).TxID)
+		require.False(t, ob.includedTxHashes["original"])
+		require.True(t, ob.includedTxHashes["replacement"])
+	})
+	t.Run("should remove pending outbound paid by another hash spending other inputs", func(t *testing.T) {
+		ob := createObserverWithPrivateKey(t)
+		ob.includedTxHashes = make(map[string]bool)
+		ob.setTxInputs("original", []btcjson.Vin{{Txid: "prior", Vout: 0}})
+		ob.setTxInputs("duplicate", []btcjson.Vin{{Txid: "other", Vout: 0}})
+		ob.setIncludedTx(1, &btcjson.GetTransactionResult{TxID: "original", Confirmations: 0})
+		ob.setIncludedTx(1, &btcjson.GetTransactionResult{TxID: "duplicate", Confirmations: 0})
+
+		require.Nil(t, ob.getIncludedTx(1))
+	})
+	t.Run("should remove mined outbound paid by another hash", func(t *testing.T) {
+		ob := createObserverWithPrivateKey(t)
+		ob.includedTxHashes = make(map[string]bool)
+		ob.setIncludedTx(1, &btcjson.GetTransactionResult{TxID: "original", Confirmations: 1})
+		ob.setIncludedTx(1, &btcjson.GetTransactionResult{TxID: "duplicate", Confirmations: 0})
+
+		require.Nil(t, ob.getIncludedTx(1))
+	})
+	t.Run("should keep batched outbound hash until all nonces removed", func(t *testing.T) {
+		ob := createObserverWithPrivateKey(t)
+		ob.includedTxHashes = make(map[string]bool)
+		ob.setIncludedTx(1, &btcjson.GetTransactionResult{TxID: "batch", Confirmations: 1})
+		ob.setIncludedTx(2, &btcjson.GetTransactionResult{TxID: "batch", Confirmations: 1})
+
+		ob.removeIncludedTx(1)
+		require.True(t, ob.isTssTransaction("batch"))
+		ob.removeIncludedTx(2)
+		require.False(t, ob.isTssTransaction("batch"))
+	})
+}
+
+func TestGetReplacementTx(t *testing.T) {
+	ob := createObserverWithPrivateKey(t)
+	ob.setTxInputs("original", []btcjson.Vin{{Txid: "prior", Vout: 0}, {Txid: "utxo", Vout: 1}})
+	ob.setTxInputs("replacement", []btcjson.Vin{{Txid: "prior", Vout: 0}, {Txid: "utxo", Vout: 2}})
+	ob.setTxInputs("duplicate", []btcjson.Vin{{Txid: "other", Vout: 0}})
+
+	t.Run("should return the latest replacement if none is mined", func(t *testing.T) {
+		res := ob.getReplacementTx([]*btcjson.GetTransactionResult{
+			{TxID: "original", Confirmations: 0},
+			{TxID: "replacement", Confirmations: 0},
+		})
+		require.Equal(t, "replacement", res.TxID)
+	})
+	t.Run("should return the mined one", func(t *testing.T) {
+		res := ob.getReplacementTx([]*btcjson.GetTransactionResult{
+			{TxID: "original", Confirmations: 1},
+			{TxID: "replacement", Confirmations: 0},
+		})
+		require.Equal(t, "original", res.TxID)
+	})
+	t.Run("should return nil if txs spend other inputs", func(t *testing.T) {
+		res := ob.getReplacementTx([]*btcjson.GetTransactionResult{
+			{TxID: "original", Confirmations: 0},
+			{TxID: "duplicate", Confirmations: 0},
+		})
+		require.Nil(t, res)
+	})
+}
@@ -0,0 +1,359 @@
+package bitcoin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/rpcpool"
+)
+
+// RPCMaxBlockLag is the number of blocks behind the highest host above which a Bitcoin host is considered down
+const RPCMaxBlockLag = 2
+
+var _ interfaces.BTCRPCClient = &RPCClient{}
+
+// RPCClient is a Bitcoin RPC client routing the requests to the healthiest of several hosts
+// the block hashes, blocks and raw transactions are cross-checked against the RPC quorum of hosts
+//
+// Note: the wallet requests (e.g. ListUnspent, GetTransaction) are only routed to the hosts that have imported the
+// wallet address (TSS address), as the other hosts would answer them with an empty wallet
+type RPCClient struct {
+	pool *rpcpool.Pool[interfaces.BTCRPCClient]
+
+	mu            sync.RWMutex
+	walletAddress string
+	walletHosts   map[string]bool
+	logger        zerolog.Logger
+}
+
+// NewRPCClient creates the RPC clients of all the hosts of the Bitcoin config for the module (observer or signer)
+func NewRPCClient(btcCfg config.BTCConfig, chainName string, module string, logger zerolog.Logger) (*RPCClient, error) {
+	hosts := btcCfg.GetRPCHosts()
+	if len(hosts) == 0 {
+		// an empty config still creates a client, whose requests fail like a single unreachable host
+		hosts = []string{btcCfg.RPCHost}
+	}
+
+	names := rpcpool.EndpointNames(hosts)
+	endpoints := make([]*rpcpool.Endpoint[interfaces.BTCRPCClient], len(hosts))
+	for i, host := range hosts {
+		connCfg := &rpcclient.ConnConfig{
+			Host:         host,
+			User:         btcCfg.RPCUsername,
+			Pass:         btcCfg.RPCPassword,
+			HTTPPostMode: true,
+			DisableTLS:   true,
+			Params:       RPCClientParams(btcCfg.RPCParams),
+		}
+
+		// the host can override the credentials
+		if at := strings.LastIndex(host, "@"); at >= 0 {
+			user, pass, _ := strings.Cut(host[:at], ":")
+			connCfg.Host, connCfg.User, connCfg.Pass = host[at+1:], user, pass
+		}
+
+		client, err := rpcclient.New(connCfg, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating rpc client for host %s: %s", names[i], err)
+		}
+		endpoints[i] = rpcpool.NewEndpoint[interfaces.BTCRPCClient](names[i], client)
+	}
+
+	return NewRPCClientFromEndpoints(chainName, module, endpoints, btcCfg.RPCQuorum, logger)
+}
+
+// RPCClientParams returns the network name understood by rpcclient for the rpc params of the config
+// signet and testnet4 share the address encoding of testnet3, which rpcclient only uses to decode addresses
+func RPCClientParams(rpcParams string) string {
+	switch rpcParams {
+	case chains.BitcoinSignetParams.Name, chains.BitcoinTestnet4Params.Name:
+		return chains.BitcoinTestnetParams.Name
+	default:
+		return rpcParams
+	}
+}
+
+// NewRPCClientFromEndpoints creates a Bitcoin RPC client from the given endpoints
+func NewRPCClientFromEndpoints(
+	chainName string,
+	module string,
+	endpoints []*rpcpool.Endpoint[interfaces.BTCRPCClient],
+	quorum int,
+	logger zerolog.Logger,
+) (*RPCClient, error) {
+	probe := func(_ context.Context, client interfaces.BTCRPCClient) (uint64, error) {
+		height, err := client.GetBlockCount()
+		if err != nil {
+			return 0, err
+		}
+		if height < 0 {
+			return 0, fmt.Errorf("invalid block count %d", height)
+		}
+		return uint64(height), nil
+	}
+	pool, err := rpcpool.New(chainName, module, endpoints, probe, IsRPCHostFailure, quorum, RPCMaxBlockLag, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &RPCClient{pool: pool, walletHosts: make(map[string]bool), logger: logger}, nil
+}
+
+// IsRPCHostFailure returns true if the error is caused by the host rather than the request
+// JSON-RPC error responses (e.g. invalid address, no such transaction) are request errors
+func IsRPCHostFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	var rpcErr *btcjson.RPCError
+	return !errors.As(err, &rpcErr)
+}
+
+// CheckHealth probes all the hosts and returns the number of healthy hosts
+// the hosts that have imported the wallet address are checked again as well
+func (c *RPCClient) CheckHealth(ctx context.Context) int {
+	healthy := c.pool.CheckHealth(ctx)
+	c.checkWalletHosts()
+	return healthy
+}
+
+// SetWalletAddress sets the address (TSS address) that must be imported by the hosts serving the wallet requests
+func (c *RPCClient) SetWalletAddress(address string) {
+	c.mu.Lock()
+	changed := c.walletAddress != address
+	c.walletAddress = address
+	c.mu.Unlock()
+
+	if changed {
+		c.checkWalletHosts()
+	}
+}
+
+// WalletHosts returns the names of the hosts that have imported the wallet address
+func (c *RPCClient) WalletHosts() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	hosts := make([]string, 0, len(c.walletHosts))
+	for _, endpoint := range c.pool.Endpoints() {
+		if c.walletHosts[endpoint.Name] {
+			hosts = append(hosts, endpoint.Name)
+		}
+	}
+	return hosts
+}
+
+// checkWalletHosts checks which hosts have imported the wallet address (as owned or watch-only)
+func (c *RPCClient) checkWalletHosts() {
+	c.mu.RLock()
+	address := c.walletAddress
+	c.mu.RUnlock()
+	if address == "" {
+		return
+	}
+
+	walletHosts := make(map[string]bool)
+	for _, endpoint := range c.pool.Endpoints() {
+		info, err := endpoint.Client.GetAddressInfo(address)
+		switch {
+		case err != nil:
+			c.logger.Warn().Err(err).Msgf("error getting info of wallet address %s from host %s", address, endpoint.Name)
+		case !info.IsMine && !info.IsWatchOnly:
+			c.logger.Warn().Msgf("wallet address %s is not imported by host %s", address, endpoint.Name)
+		default:
+			walletHosts[endpoint.Name] = true
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.walletAddress == address {
+		c.walletHosts = walletHosts
+	}
+}
+
+// isWalletHost returns true if the host serves the wallet requests
+// all the hosts serve them until the wallet address is set
+func (c *RPCClient) isWalletHost(endpoint *rpcpool.Endpoint[interfaces.BTCRPCClient]) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.walletAddress == "" || c.walletHosts[endpoint.Name]
+}
+
+// callWallet calls fn on the healthiest host that has imported the wallet address
+// the hosts are checked again if none of them has imported it, e.g. if the address was imported after the last check
+func callWallet[T any](c *RPCClient, fn func(client interfaces.BTCRPCClient) (T, error)) (T, error) {
+	if len(c.WalletHosts()) == 0 {
+		c.checkWalletHosts()
+	}
+	return rpcpool.CallWhere(c.pool, c.isWalletHost, fn)
+}
+
+// Stats returns the health stats of the hosts, the healthiest first
+func (c *RPCClient) Stats() []rpcpool.Stats {
+	return c.pool.Stats()
+}
+
+// GetNetworkInfo returns the network info
+func (c *RPCClient) GetNetworkInfo() (*btcjson.GetNetworkInfoResult, error) {
+	return rpcpool.Call(c.pool, func(client interfaces.BTCRPCClient) (*btcjson.GetNetworkInfoResult, error) {
+		return client.GetNetworkInfo()
+	})
+}
+
+// CreateWallet creates a wallet on the healthiest host
+func (c *RPCClient) CreateWallet(name string, opts ...rpcclient.CreateWalletOpt) (*btcjson.CreateWalletResult, error) {
+	return rpcpool.Call(c.pool, func(client interfaces.BTCRPCClient) (*btcjson.CreateWalletResult, error) {
+		return client.CreateWallet(name, opts...)
+	})
+}
+
+// GetNewAddress returns a new address of the wallet
+func (c *RPCClient) GetNewAddress(account string) (btcutil.Address, error) {
+	return rpcpool.Call(c.pool, func(client interfaces.BTCRPCClient) (btcutil.Address, error) {
+		return client.GetNewAddress(account)
+	})
+}
+
+// GenerateToAddress mines blocks to the address (regtest only)
+func (c *RPCClient) GenerateToAddress(
+	numBlocks int64,
+	address btcutil.Address,
+	maxTries *int64,
+) ([]*chainhash.Hash, error) {
+	return rpcpool.Call(c.pool, func(client interfaces.BTCRPCClient) ([]*chainhash.Hash, error) {
+		return client.GenerateToAddress(numBlocks, address, maxTries)
+	})
+}
+
+// GetBalance returns the balance of the wallet
+func (c *RPCClient) GetBalance(account string) (btcutil.Amount, error) {
+	return callWallet(c, func(client interfaces.BTCRPCClient) (btcutil.Amount, error) {
+		return client.GetBalance(account)
+	})
+}
+
+// SendRawTransaction broadcasts the transaction
+func (c *RPCClient) SendRawTransaction(tx *wire.MsgTx, allowHighFees bool) (*chainhash.Hash, error) {
+	return rpcpool.Call(c.pool, func(client interfaces.BTCRPCClient) (*chainhash.Hash, error) {
+		return client.SendRawTransaction(tx, allowHighFees)
+	})
+}
+
+// ListUnspent returns the unspent outputs of the wallet
+func (c *RPCClient) ListUnspent() ([]btcjson.ListUnspentResult, error) {
+	return callWallet(c, func(client interfaces.BTCRPCClient) ([]btcjson.ListUnspentResult, error) {
+		return client.ListUnspent()
+	})
+}
+
+// ListUnspentMinMaxAddresses returns the unspent outputs of the addresses
+func (c *RPCClient) ListUnspentMinMaxAddresses(
+	minConf int,
+	maxConf int,
+	addrs []btcutil.Address,
+) ([]btcjson.ListUnspentResult, error) {
+	return callWallet(c, func(client interfaces.BTCRPCClient) ([]btcjson.ListUnspentResult, error) {
+		return client.ListUnspentMinMaxAddresses(minConf, maxConf, addrs)
+	})
+}
+
+// EstimateSmartFee returns the estimated fee rate
+func (c *RPCClient) EstimateSmartFee(
+	confTarget int64,
+	mode *btcjson.EstimateSmartFeeMode,
+) (*btcjson.EstimateSmartFeeResult, error) {
+	return rpcpool.Call(c.pool, func(client interfaces.BTCRPCClient) (*btcjson.EstimateSmartFeeResult, error) {
+		return client.EstimateSmartFee(confTarget, mode)
+	})
+}
+
+// GetTransaction returns the wallet transaction
+func (c *RPCClient) GetTransaction(txHash *chainhash.Hash) (*btcjson.GetTransactionResult, error) {
+	return callWallet(c, func(client interfaces.BTCRPCClient) (*btcjson.GetTransactionResult, error) {
+		return client.GetTransaction(txHash)
+	})
+}
+
+// GetRawTransaction returns the raw transaction
+func (c *RPCClient) GetRawTransaction(txHash *chainhash.Hash) (*btcutil.Tx, error) {
+	return rpcpool.Call(c.pool, func(client interfaces.BTCRPCClient) (*btcutil.Tx, error) {
+		return client.GetRawTransaction(txHash)
+	})
+}
+
+// GetRawTransactionVerbose returns the raw transaction, cross-checked by witness hash and block hash
+func (c *RPCClient) GetRawTransactionVerbose(txHash *chainhash.Hash) (*btcjson.TxRawResult, error) {
+	fn := func(client interfaces.BTCRPCClient) (*btcjson.TxRawResult, error) {
+		return client.GetRawTransactionVerbose(txHash)
+	}
+	return rpcpool.CallQuorum(c.pool, fn, func(tx *btcjson.TxRawResult) string {
+		return tx.Hash + ":" + tx.BlockHash
+	})
+}
+
+// GetBlockCount returns the latest block height
+func (c *RPCClient) GetBlockCount() (int64, error) {
+	return rpcpool.Call(c.pool, func(client interfaces.BTCRPCClient) (int64, error) {
+		return client.GetBlockCount()
+	})
+}
+
+// GetBlockHash returns the block hash at the height, cross-checked
+func (c *RPCClient) GetBlockHash(blockHeight int64) (*chainhash.Hash, error) {
+	fn := func(client interfaces.BTCRPCClient) (*chainhash.Hash, error) {
+		return client.GetBlockHash(blockHeight)
+	}
+	return rpcpool.CallQuorum(c.pool, fn, func(hash *chainhash.Hash) string {
+		return hash.String()
+	})
+}
+
+// GetBlockVerbose returns the block, cross-checked by hash
+func (c *RPCClient) GetBlockVerbose(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseResult, error) {
+	fn := func(client interfaces.BTCRPCClient) (*btcjson.GetBlockVerboseResult, error) {
+		return client.GetBlockVerbose(blockHash)
+	}
+	return rpcpool.CallQuorum(c.pool, fn, func(block *btcjson.GetBlockVerboseResult) string {
+		return fmt.Sprintf("%s:%d", block.Hash, block.Height)
+	})
+}
+
+// GetBlockVerboseTx returns the block with its transactions, cross-checked by hash
+func (c *RPCClient) GetBlockVerboseTx(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseTxResult, error) {
+	fn := func(client interfaces.BTCRPCClient) (*btcjson.GetBlockVerboseTxResult, error) {
+		return client.GetBlockVerboseTx(blockHash)
+	}
+	return rpcpool.CallQuorum(c.pool, fn, func(block *btcjson.GetBlockVerboseTxResult) string {
+		return fmt.Sprintf("%s:%d:%d", block.Hash, block.Height, len(block.Tx))
+	})
+}
+
+// GetBlockHeader returns the block header, cross-checked by hash
+func (c *RPCClient) GetBlockHeader(blockHash *chainhash.Hash) (*wire.BlockHeader, error) {
+	fn := func(client interfaces.BTCRPCClient) (*wire.BlockHeader, error) {
+		return client.GetBlockHeader(blockHash)
+	}
+	return rpcpool.CallQuorum(c.pool, fn, func(header *wire.BlockHeader) string {
+		return header.BlockHash().String()
+	})
+}
+
+// GetAddressInfo returns the info of the address known by the wallet of the healthiest host
+func (c *RPCClient) GetAddressInfo(address string) (*btcjson.GetAddressInfoResult, error) {
+	return rpcpool.Call(c.pool, func(client interfaces.BTCRPCClient) (*btcjson.GetAddressInfoResult, error) {
+		return client.GetAddressInfo(address)
+	})
+}
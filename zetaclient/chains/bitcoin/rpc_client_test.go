@@ -0,0 +1,172 @@
+package bitcoin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/rpcpool"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
+)
+
+// testHost is a Bitcoin RPC host serving a block count, block hashes and the utxos of the wallet
+type testHost struct {
+	*mocks.MockBTCRPCClient
+	blockCount int64
+	blockHash  chainhash.Hash
+	err        error
+	calls      int
+
+	// imported is true if the host has imported the wallet address
+	imported bool
+	utxos    []btcjson.ListUnspentResult
+}
+
+func (h *testHost) GetAddressInfo(_ string) (*btcjson.GetAddressInfoResult, error) {
+	return &btcjson.GetAddressInfoResult{IsWatchOnly: h.imported}, nil
+}
+
+func (h *testHost) ListUnspentMinMaxAddresses(_ int, _ int, _ []btcutil.Address) ([]btcjson.ListUnspentResult, error) {
+	if !h.imported {
+		return nil, nil
+	}
+	return h.utxos, nil
+}
+
+func (h *testHost) GetBlockCount() (int64, error) {
+	return h.blockCount, h.err
+}
+
+func (h *testHost) GetBlockHash(_ int64) (*chainhash.Hash, error) {
+	h.calls++
+	if h.err != nil {
+		return nil, h.err
+	}
+	return &h.blockHash, nil
+}
+
+func newTestRPCClient(t *testing.T, quorum int, hosts ...*testHost) *RPCClient {
+	endpoints := make([]*rpcpool.Endpoint[interfaces.BTCRPCClient], len(hosts))
+	for i, host := range hosts {
+		endpoints[i] = rpcpool.NewEndpoint[interfaces.BTCRPCClient](string(rune('a'+i)), host)
+	}
+	client, err := NewRPCClientFromEndpoints("btc_regtest", rpcpool.ModuleObserver, endpoints, quorum, zerolog.Nop())
+	require.NoError(t, err)
+	return client
+}
+
+func TestRPCClient_GetBlockHash(t *testing.T) {
+	hash := chainhash.Hash{1}
+
+	t.Run("should fail over to the next host if the host is down", func(t *testing.T) {
+		down := &testHost{MockBTCRPCClient: mocks.NewMockBTCRPCClient(), err: errors.New("connection refused")}
+		up := &testHost{MockBTCRPCClient: mocks.NewMockBTCRPCClient(), blockHash: hash}
+		client := newTestRPCClient(t, 1, down, up)
+
+		result, err := client.GetBlockHash(100)
+		require.NoError(t, err)
+		require.Equal(t, hash, *result)
+	})
+	t.Run("should not fail over on JSON-RPC error", func(t *testing.T) {
+		rpcErr := &btcjson.RPCError{Code: btcjson.ErrRPCOutOfRange, Message: "Block height out of range"}
+		outOfRange := &testHost{MockBTCRPCClient: mocks.NewMockBTCRPCClient(), err: rpcErr}
+		other := &testHost{MockBTCRPCClient: mocks.NewMockBTCRPCClient(), blockHash: hash}
+		client := newTestRPCClient(t, 1, outOfRange, other)
+
+		_, err := client.GetBlockHash(100)
+		require.ErrorIs(t, err, rpcErr)
+		require.Zero(t, other.calls)
+	})
+	t.Run("should cross-check block hash against the quorum", func(t *testing.T) {
+		forked := &testHost{MockBTCRPCClient: mocks.NewMockBTCRPCClient(), blockHash: chainhash.Hash{2}}
+		client := newTestRPCClient(t, 2,
+			forked,
+			&testHost{MockBTCRPCClient: mocks.NewMockBTCRPCClient(), blockHash: hash},
+			&testHost{MockBTCRPCClient: mocks.NewMockBTCRPCClient(), blockHash: hash},
+		)
+		result, err := client.GetBlockHash(100)
+		require.NoError(t, err)
+		require.Equal(t, hash, *result)
+
+		client = newTestRPCClient(t, 2, forked, &testHost{MockBTCRPCClient: mocks.NewMockBTCRPCClient(), blockHash: hash})
+		_, err = client.GetBlockHash(100)
+		require.ErrorIs(t, err, rpcpool.ErrNoQuorum)
+	})
+}
+
+func TestRPCClient_CheckHealth(t *testing.T) {
+	lagging := &testHost{MockBTCRPCClient: mocks.NewMockBTCRPCClient(), blockCount: 95, blockHash: chainhash.Hash{2}}
+	tip := &testHost{MockBTCRPCClient: mocks.NewMockBTCRPCClient(), blockCount: 100, blockHash: chainhash.Hash{1}}
+	client := newTestRPCClient(t, 1, lagging, tip)
+
+	require.Equal(t, 1, client.CheckHealth(context.Background()))
+	require.Equal(t, "b", client.Stats()[0].Name)
+
+	result, err := client.GetBlockHash(100)
+	require.NoError(t, err)
+	require.Equal(t, chainhash.Hash{1}, *result)
+}
+
+func TestRPCClient_Wallet(t *testing.T) {
+	utxos := []btcjson.ListUnspentResult{{TxID: "txid", Amount: 1}}
+
+	t.Run("should route the wallet requests to the hosts that imported the wallet address", func(t *testing.T) {
+		// the healthiest host hasn't imported the TSS address
+		notImported := &testHost{MockBTCRPCClient: mocks.NewMockBTCRPCClient(), blockCount: 100}
+		imported := &testHost{MockBTCRPCClient: mocks.NewMockBTCRPCClient(), blockCount: 99, imported: true, utxos: utxos}
+		client := newTestRPCClient(t, 1, notImported, imported)
+		client.SetWalletAddress("bcrt1qtss")
+		require.Equal(t, 2, client.CheckHealth(context.Background()))
+		require.Equal(t, "a", client.Stats()[0].Name)
+		require.Equal(t, []string{"b"}, client.WalletHosts())
+
+		result, err := client.ListUnspentMinMaxAddresses(0, 100, nil)
+		require.NoError(t, err)
+		require.Equal(t, utxos, result)
+	})
+
+	t.Run("should fail the wallet requests if no host imported the wallet address", func(t *testing.T) {
+		host := &testHost{MockBTCRPCClient: mocks.NewMockBTCRPCClient(), blockCount: 100}
+		client := newTestRPCClient(t, 1, host)
+		client.SetWalletAddress("bcrt1qtss")
+
+		_, err := client.ListUnspentMinMaxAddresses(0, 100, nil)
+		require.ErrorContains(t, err, "no eligible rpc endpoint")
+
+		// the hosts are checked again once the address is imported
+		host.imported = true
+		host.utxos = utxos
+		result, err := client.ListUnspentMinMaxAddresses(0, 100, nil)
+		require.NoError(t, err)
+		require.Equal(t, utxos, result)
+	})
+}
+
+func TestNewRPCClient(t *testing.T) {
+	t.Run("should create a client for an empty config", func(t *testing.T) {
+		_, err := NewRPCClient(config.BTCConfig{}, "btc_regtest", rpcpool.ModuleSigner, zerolog.Nop())
+		require.NoError(t, err)
+	})
+	t.Run("should create a client per host", func(t *testing.T) {
+		btcCfg := config.BTCConfig{
+			RPCHost:   "bitcoin:18443",
+			RPCHosts:  []string{"user:password@bitcoin-backup:18443"},
+			RPCQuorum: 2,
+		}
+		client, err := NewRPCClient(btcCfg, "btc_regtest", rpcpool.ModuleObserver, zerolog.Nop())
+		require.NoError(t, err)
+
+		stats := client.Stats()
+		require.Len(t, stats, 2)
+		require.Equal(t, "bitcoin:18443", stats[0].Name)
+		require.Equal(t, "bitcoin-backup:18443", stats[1].Name)
+	})
+}
@@ -9,18 +9,20 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
-	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	ethcommon "github.com/ethereum/go-ethereum/common"
-	"github.com/rs/zerolog"
+	"github.com/pkg/errors"
 
 	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/pkg/coin"
+	crosschainkeeper "github.com/zeta-chain/zetacore/x/crosschain/keeper"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/base"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/bitcoin"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/bitcoin/observer"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
@@ -30,6 +32,7 @@ import (
 	"github.com/zeta-chain/zetacore/zetaclient/context"
 	"github.com/zeta-chain/zetacore/zetaclient/metrics"
 	"github.com/zeta-chain/zetacore/zetaclient/outboundprocessor"
+	"github.com/zeta-chain/zetacore/zetaclient/rpcpool"
 	"github.com/zeta-chain/zetacore/zetaclient/tss"
 )
 
@@ -39,46 +42,46 @@ const (
 
 	// the rank below (or equal to) which we consolidate UTXOs
 	consolidationRank = 10
+
+	// the input sequence number signaling the outbound is replaceable by fee (BIP125)
+	rbfSequenceNum = wire.MaxTxInSequenceNum - 2
+)
+
+var (
+	_ interfaces.ChainSigner           = &Signer{}
+	_ interfaces.OutboundRebroadcaster = &Signer{}
 )
 
-var _ interfaces.ChainSigner = &Signer{}
+// Payment is a payment to a recipient made by an outbound
+type Payment struct {
+	To     btcutil.Address
+	Amount float64 // in BTC
+}
 
 // Signer deals with signing BTC transactions and implements the ChainSigner interface
 type Signer struct {
-	tssSigner        interfaces.TSSSigner
-	rpcClient        interfaces.BTCRPCClient
-	logger           zerolog.Logger
-	loggerCompliance zerolog.Logger
-	ts               *metrics.TelemetryServer
-	coreContext      *context.ZetacoreContext
+	// base.Signer implements the base chain signer
+	*base.Signer
+
+	rpcClient interfaces.BTCRPCClient
 }
 
 func NewSigner(
+	chain chains.Chain,
 	cfg config.BTCConfig,
 	tssSigner interfaces.TSSSigner,
 	loggers clientcommon.ClientLogger,
 	ts *metrics.TelemetryServer,
 	coreContext *context.ZetacoreContext) (*Signer, error) {
-	connCfg := &rpcclient.ConnConfig{
-		Host:         cfg.RPCHost,
-		User:         cfg.RPCUsername,
-		Pass:         cfg.RPCPassword,
-		HTTPPostMode: true,
-		DisableTLS:   true,
-		Params:       cfg.RPCParams,
-	}
-	client, err := rpcclient.New(connCfg, nil)
+	baseSigner := base.NewSigner(chain, coreContext, tssSigner, ts, loggers, "BTCSigner")
+	client, err := bitcoin.NewRPCClient(cfg, chain.ChainName.String(), rpcpool.ModuleSigner, baseSigner.Logger().Std)
 	if err != nil {
 		return nil, fmt.Errorf("error creating bitcoin rpc client: %s", err)
 	}
 
 	return &Signer{
-		tssSigner:        tssSigner,
-		rpcClient:        client,
-		logger:           loggers.Std.With().Str("chain", "BTC").Str("module", "BTCSigner").Logger(),
-		loggerCompliance: loggers.Compliance,
-		ts:               ts,
-		coreContext:      coreContext,
+		Signer:    baseSigner,
+		rpcClient: client,
 	}, nil
 }
 
@@ -130,12 +133,12 @@ func (signer *Signer) AddWithdrawTxOutputs(
 	if remainingSats < 0 {
 		return fmt.Errorf("remainder value is negative: %d", remainingSats)
 	} else if remainingSats == nonceMark {
-		signer.logger.Info().Msgf("adjust remainder value to avoid duplicate nonce-mark: %d", remainingSats)
+		signer.Logger().Std.Info().Msgf("adjust remainder value to avoid duplicate nonce-mark: %d", remainingSats)
 		remainingSats--
 	}
 
 	// 1st output: the nonce-mark btc to TSS self
-	tssAddrP2WPKH := signer.tssSigner.BTCAddressWitnessPubkeyHash()
+	tssAddrP2WPKH := signer.TSS().BTCAddressWitnessPubkeyHash(signer.Chain().ChainId)
 	payToSelfScript, err := bitcoin.PayToAddrScript(tssAddrP2WPKH)
 	if err != nil {
 		return err
@@ -164,6 +167,65 @@ func (signer *Signer) AddWithdrawTxOutputs(
 	return nil
 }
 
+// AddBatchWithdrawTxOutputs adds the outputs to the batched withdraw tx
+// 1st output: the nonce-mark btc of the last nonce in the batch to TSS itself
+// 2nd to (N+1)th outputs: the payments to the recipients in nonce order
+// last output: the remaining btc to TSS itself, which is mandatory for a batched withdraw tx
+func (signer *Signer) AddBatchWithdrawTxOutputs(
+	tx *wire.MsgTx,
+	payments []Payment,
+	total float64,
+	nonceMark int64,
+	fees *big.Int,
+) error {
+	// calculate remaining btc (the change) to TSS self
+	remainingSats, err := bitcoin.GetSatoshis(total)
+	if err != nil {
+		return err
+	}
+	for _, payment := range payments {
+		amountSatoshis, err := bitcoin.GetSatoshis(payment.Amount)
+		if err != nil {
+			return err
+		}
+		remainingSats -= amountSatoshis
+	}
+	remainingSats -= fees.Int64()
+	remainingSats -= nonceMark
+	if remainingSats <= 0 {
+		// the change output tells the observers how many payments are made by the batched withdraw tx
+		return fmt.Errorf("remainder value is not positive: %d", remainingSats)
+	} else if remainingSats == nonceMark {
+		signer.Logger().Std.Info().Msgf("adjust remainder value to avoid duplicate nonce-mark: %d", remainingSats)
+		remainingSats--
+	}
+
+	// 1st output: the nonce-mark btc to TSS self
+	tssAddrP2WPKH := signer.TSS().BTCAddressWitnessPubkeyHash(signer.Chain().ChainId)
+	payToSelfScript, err := bitcoin.PayToAddrScript(tssAddrP2WPKH)
+	if err != nil {
+		return err
+	}
+	tx.AddTxOut(wire.NewTxOut(nonceMark, payToSelfScript))
+
+	// 2nd to (N+1)th outputs: the payments to the recipients
+	for _, payment := range payments {
+		amountSatoshis, err := bitcoin.GetSatoshis(payment.Amount)
+		if err != nil {
+			return err
+		}
+		pkScript, err := bitcoin.PayToAddrScript(payment.To)
+		if err != nil {
+			return err
+		}
+		tx.AddTxOut(wire.NewTxOut(amountSatoshis, pkScript))
+	}
+
+	// last output: the remaining btc to TSS self
+	tx.AddTxOut(wire.NewTxOut(remainingSats, payToSelfScript))
+	return nil
+}
+
 // SignWithdrawTx receives utxos sorted by value, amount in BTC, feeRate in BTC per Kb
 func (signer *Signer) SignWithdrawTx(
 	to btcutil.Address,
@@ -182,7 +244,7 @@ func (signer *Signer) SignWithdrawTx(
 	// refresh unspent UTXOs and continue with keysign regardless of error
 	err := observer.FetchUTXOS()
 	if err != nil {
-		signer.logger.Error().Err(err).Msgf("SignWithdrawTx: FetchUTXOS error: nonce %d chain %d", nonce, chain.ChainId)
+		signer.Logger().Std.Error().Err(err).Msgf("SignWithdrawTx: FetchUTXOS error: nonce %d chain %d", nonce, chain.ChainId)
 	}
 
 	// select N UTXOs to cover the total expense
@@ -196,6 +258,99 @@ func (signer *Signer) SignWithdrawTx(
 	if err != nil {
 		return nil, err
 	}
+	signer.Logger().Std.Info().Msgf("bitcoin outbound nonce %d consolidated %d utxos of value %v",
+		nonce, consolidatedUtxo, consolidatedValue)
+
+	payments := []Payment{{To: to, Amount: amount}}
+	return signer.signTx(prevOuts, total, payments, gasPrice, sizeLimit, height, nonce, nonce, chain, cancelTx)
+}
+
+// SignBatchWithdrawTx signs one withdraw tx paying the outbounds of consecutive nonces from 'nonce' to 'lastNonce'
+// Only the nonce-mark of the last nonce is created, the next outbound will spend it as the 1st input.
+func (signer *Signer) SignBatchWithdrawTx(
+	payments []Payment,
+	gasPrice *big.Int,
+	sizeLimit uint64,
+	observer *observer.Observer,
+	height uint64,
+	nonce uint64,
+	lastNonce uint64,
+	chain chains.Chain,
+) (*wire.MsgTx, error) {
+	// #nosec G701 always in range
+	if lastNonce < nonce || lastNonce-nonce+1 != uint64(len(payments)) {
+		return nil, fmt.Errorf("%d payments not match nonce %d to %d", len(payments), nonce, lastNonce)
+	}
+	// #nosec G701 always positive
+	estimateFee := float64(gasPrice.Uint64()*bitcoin.OutboundSizeMax(uint64(len(payments)))) / 1e8
+	nonceMark := chains.NonceMarkAmount(lastNonce)
+	amount := 0.0
+	for _, payment := range payments {
+		amount += payment.Amount
+	}
+
+	// refresh unspent UTXOs and continue with keysign regardless of error
+	err := observer.FetchUTXOS()
+	if err != nil {
+		signer.Logger().Std.Error().Err(err).Msgf("SignBatchWithdrawTx: FetchUTXOS error: nonce %d chain %d", nonce, chain.ChainId)
+	}
+
+	// select N UTXOs to cover the total expense, the nonce-mark of prior outbound is the 1st input
+	prevOuts, total, consolidatedUtxo, consolidatedValue, err := observer.SelectUTXOs(
+		amount+estimateFee+float64(nonceMark)*1e-8,
+		maxNoOfInputsPerTx,
+		nonce,
+		consolidationRank,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	signer.Logger().Std.Info().Msgf("bitcoin batched outbound nonce %d to %d consolidated %d utxos of value %v",
+		nonce, lastNonce, consolidatedUtxo, consolidatedValue)
+
+	return signer.signTx(prevOuts, total, payments, gasPrice, sizeLimit, height, nonce, lastNonce, chain, false)
+}
+
+// SignReplacementTx signs a new outbound spending same inputs of the pending outbound with a higher fee rate.
+// The replacement pays same amount to the recipient, the increased fees are deducted from the change to TSS self.
+func (signer *Signer) SignReplacementTx(
+	prevOuts []btcjson.ListUnspentResult,
+	to btcutil.Address,
+	amount float64,
+	gasPrice *big.Int,
+	sizeLimit uint64,
+	height uint64,
+	nonce uint64,
+	chain chains.Chain,
+	cancelTx bool,
+) (*wire.MsgTx, error) {
+	total := 0.0
+	for _, prevOut := range prevOuts {
+		total += prevOut.Amount
+	}
+	payments := []Payment{{To: to, Amount: amount}}
+	return signer.signTx(prevOuts, total, payments, gasPrice, sizeLimit, height, nonce, nonce, chain, cancelTx)
+}
+
+// signTx builds and signs the outbound spending given UTXOs and paying the outbounds from 'nonce' to 'lastNonce'
+func (signer *Signer) signTx(
+	prevOuts []btcjson.ListUnspentResult,
+	total float64,
+	payments []Payment,
+	gasPrice *big.Int,
+	sizeLimit uint64,
+	height uint64,
+	nonce uint64,
+	lastNonce uint64,
+	chain chains.Chain,
+	cancelTx bool,
+) (*wire.MsgTx, error) {
+	nonceMark := chains.NonceMarkAmount(lastNonce)
+	payees := make([]btcutil.Address, 0, len(payments))
+	for _, payment := range payments {
+		payees = append(payees, payment.To)
+	}
 
 	// build tx with selected unspents
 	tx := wire.NewMsgTx(wire.TxVersion)
@@ -206,38 +361,45 @@ func (signer *Signer) SignWithdrawTx(
 		}
 		outpoint := wire.NewOutPoint(hash, prevOut.Vout)
 		txIn := wire.NewTxIn(outpoint, nil, nil)
+		txIn.Sequence = rbfSequenceNum
 		tx.AddTxIn(txIn)
 	}
 
 	// size checking
 	// #nosec G701 always positive
-	txSize, err := bitcoin.EstimateOutboundSize(uint64(len(prevOuts)), []btcutil.Address{to})
+	txSize, err := bitcoin.EstimateOutboundSize(uint64(len(prevOuts)), payees)
 	if err != nil {
 		return nil, err
 	}
 	if sizeLimit < bitcoin.BtcOutboundBytesWithdrawer { // ZRC20 'withdraw' charged less fee from end user
-		signer.logger.Info().
+		signer.Logger().Std.Info().
 			Msgf("sizeLimit %d is less than BtcOutboundBytesWithdrawer %d for nonce %d", sizeLimit, txSize, nonce)
 	}
 	if txSize < bitcoin.OutboundBytesMin { // outbound shouldn't be blocked a low sizeLimit
-		signer.logger.Warn().
+		signer.Logger().Std.Warn().
 			Msgf("txSize %d is less than outboundBytesMin %d; use outboundBytesMin", txSize, bitcoin.OutboundBytesMin)
 		txSize = bitcoin.OutboundBytesMin
 	}
-	if txSize > bitcoin.OutboundBytesMax { // in case of accident
-		signer.logger.Warn().
-			Msgf("txSize %d is greater than outboundBytesMax %d; use outboundBytesMax", txSize, bitcoin.OutboundBytesMax)
-		txSize = bitcoin.OutboundBytesMax
+	// #nosec G701 always positive
+	sizeMax := bitcoin.OutboundSizeMax(uint64(len(payees)))
+	if txSize > sizeMax { // in case of accident
+		signer.Logger().Std.Warn().
+			Msgf("txSize %d is greater than outboundBytesMax %d; use outboundBytesMax", txSize, sizeMax)
+		txSize = sizeMax
 	}
 
 	// fee calculation
 	// #nosec G701 always in range (checked above)
 	fees := new(big.Int).Mul(big.NewInt(int64(txSize)), gasPrice)
-	signer.logger.Info().Msgf("bitcoin outbound nonce %d gasPrice %s size %d fees %s consolidated %d utxos of value %v",
-		nonce, gasPrice.String(), txSize, fees.String(), consolidatedUtxo, consolidatedValue)
+	signer.Logger().Std.Info().Msgf("bitcoin outbound nonce %d gasPrice %s size %d fees %s",
+		nonce, gasPrice.String(), txSize, fees.String())
 
 	// add tx outputs
-	err = signer.AddWithdrawTxOutputs(tx, to, total, amount, nonceMark, fees, cancelTx)
+	if len(payments) == 1 {
+		err = signer.AddWithdrawTxOutputs(tx, payments[0].To, total, payments[0].Amount, nonceMark, fees, cancelTx)
+	} else {
+		err = signer.AddBatchWithdrawTxOutputs(tx, payments, total, nonceMark, fees)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -260,7 +422,7 @@ func (signer *Signer) SignWithdrawTx(
 		}
 	}
 
-	tssSigner, ok := signer.tssSigner.(*tss.TSS)
+	tssSigner, ok := signer.TSS().(*tss.TSS)
 	if !ok {
 		return nil, fmt.Errorf("tssSigner is not a TSS")
 	}
@@ -278,7 +440,7 @@ func (signer *Signer) SignWithdrawTx(
 			S: S,
 		}
 
-		pkCompressed := signer.tssSigner.PubKeyCompressedBytes()
+		pkCompressed := signer.TSS().PubKeyCompressedBytes()
 		hashType := txscript.SigHashAll
 		txWitness := wire.TxWitness{append(sig.Serialize(), byte(hashType)), pkCompressed}
 		tx.TxIn[ix].Witness = txWitness
@@ -303,10 +465,27 @@ func (signer *Signer) Broadcast(signedTx *wire.MsgTx) error {
 		return err
 	}
 
-	signer.logger.Info().Msgf("Broadcasting BTC tx , hash %s ", hash)
+	signer.Logger().Std.Info().Msgf("Broadcasting BTC tx , hash %s ", hash)
 	return nil
 }
 
+// RebroadcastOutbound re-broadcasts the outbound of the nonce broadcast by this zetaclient and returns its hash
+func (signer *Signer) RebroadcastOutbound(chainObserver interfaces.ChainObserver, nonce uint64) (string, error) {
+	btcObserver, ok := chainObserver.(*observer.Observer)
+	if !ok {
+		return "", fmt.Errorf("chain observer is not a bitcoin observer")
+	}
+	tx, err := btcObserver.GetBroadcastedOutbound(nonce)
+	if err != nil {
+		return "", err
+	}
+	if err := signer.Broadcast(tx); err != nil {
+		return "", errors.Wrapf(err, "error re-broadcasting outbound %s", tx.TxHash())
+	}
+	return tx.TxHash().String(), nil
+}
+
+// TryProcessOutbound signs and broadcasts the outbound of given cctx
 func (signer *Signer) TryProcessOutbound(
 	cctx *types.CrossChainTx,
 	outboundProcessor *outboundprocessor.Processor,
@@ -314,65 +493,101 @@ func (signer *Signer) TryProcessOutbound(
 	chainObserver interfaces.ChainObserver,
 	zetacoreClient interfaces.ZetacoreClient,
 	height uint64,
+) {
+	signer.TryProcessOutbounds(
+		[]*types.CrossChainTx{cctx},
+		outboundProcessor,
+		outboundID,
+		chainObserver,
+		zetacoreClient,
+		height,
+	)
+}
+
+// TryProcessOutbounds signs and broadcasts one outbound paying the given cctxs of consecutive nonces.
+// More than one cctx are batched into a multi-output transaction, restricted cctxs can't be batched.
+func (signer *Signer) TryProcessOutbounds(
+	cctxs []*types.CrossChainTx,
+	outboundProcessor *outboundprocessor.Processor,
+	outboundID string,
+	chainObserver interfaces.ChainObserver,
+	zetacoreClient interfaces.ZetacoreClient,
+	height uint64,
 ) {
 	defer func() {
 		outboundProcessor.EndTryProcess(outboundID)
 		if err := recover(); err != nil {
-			signer.logger.Error().Msgf("BTC TryProcessOutbound: %s, caught panic error: %v", cctx.Index, err)
+			signer.Logger().Std.Error().Msgf("BTC TryProcessOutbound: %s, caught panic error: %v", outboundID, err)
 		}
 	}()
 
-	logger := signer.logger.With().
+	if len(cctxs) == 0 {
+		return
+	}
+	cctx := cctxs[0]
+	logger := signer.Logger().Std.With().
 		Str("OutboundID", outboundID).
 		Str("SendHash", cctx.Index).
 		Logger()
 
-	params := cctx.GetCurrentOutboundParam()
-	coinType := cctx.InboundParams.CoinType
-	if coinType == coin.CoinType_Zeta || coinType == coin.CoinType_ERC20 {
-		logger.Error().Msgf("BTC TryProcessOutbound: can only send BTC to a BTC network")
-		return
-	}
-
-	logger.Info().
-		Msgf("BTC TryProcessOutbound: %s, value %d to %s", cctx.Index, params.Amount.BigInt(), params.Receiver)
 	btcObserver, ok := chainObserver.(*observer.Observer)
 	if !ok {
 		logger.Error().Msgf("chain observer is not a bitcoin observer")
 		return
 	}
-	flags := signer.coreContext.GetCrossChainFlags()
+	flags := signer.CoreContext().GetCrossChainFlags()
 	if !flags.IsOutboundEnabled {
 		logger.Info().Msgf("outbound is disabled")
 		return
 	}
 	chain := btcObserver.Chain()
-	outboundTssNonce := params.TssNonce
 	signerAddress, err := zetacoreClient.GetKeys().GetAddress()
 	if err != nil {
 		logger.Error().Err(err).Msgf("cannot get signer address")
 		return
 	}
 
-	// get size limit and gas price
-	sizelimit := params.GasLimit
-	gasprice, ok := new(big.Int).SetString(params.GasPrice, 10)
-	if !ok || gasprice.Cmp(big.NewInt(0)) < 0 {
-		logger.Error().Msgf("cannot convert gas price  %s ", params.GasPrice)
-		return
-	}
+	// get the payments, size limit and the highest gas price of the cctxs
+	payments := make([]Payment, 0, len(cctxs))
+	nonces := make([]uint64, 0, len(cctxs))
+	sizelimit := uint64(0)
+	gasprice := big.NewInt(0)
+	cancelTx := false
+	for i, cctx := range cctxs {
+		params := cctx.GetCurrentOutboundParam()
+		if i > 0 && params.TssNonce != nonces[i-1]+1 {
+			logger.Error().Msgf("BTC TryProcessOutbound: nonce %d is not consecutive to %d", params.TssNonce, nonces[i-1])
+			return
+		}
+		payment, cctxGasPrice, err := signer.getPayment(cctx)
+		if err != nil {
+			logger.Error().Err(err).Msgf("BTC TryProcessOutbound: invalid cctx %s", cctx.Index)
+			return
+		}
+		logger.Info().
+			Msgf("BTC TryProcessOutbound: %s, value %d to %s", cctx.Index, params.Amount.BigInt(), params.Receiver)
 
-	// Check receiver P2WPKH address
-	to, err := chains.DecodeBtcAddress(params.Receiver, params.ReceiverChainId)
-	if err != nil {
-		logger.Error().Err(err).Msgf("cannot decode address %s ", params.Receiver)
-		return
-	}
-	if !chains.IsBtcAddressSupported(to) {
-		logger.Error().Msgf("unsupported address %s", params.Receiver)
-		return
+		// compliance check
+		if compliance.IsCctxRestricted(cctx) {
+			if len(cctxs) > 1 {
+				logger.Error().Msgf("BTC TryProcessOutbound: restricted cctx %s can't be batched", cctx.Index)
+				return
+			}
+			compliance.PrintComplianceLog(logger, signer.Logger().Compliance,
+				true, chain.ChainId, cctx.Index, cctx.InboundParams.Sender, params.Receiver, "BTC")
+			cancelTx = true
+			payment.Amount = 0.0 // zero out the amount to cancel the tx
+		}
+
+		payments = append(payments, payment)
+		nonces = append(nonces, params.TssNonce)
+		sizelimit += params.GasLimit
+		if cctxGasPrice.Cmp(gasprice) > 0 {
+			gasprice = cctxGasPrice
+		}
 	}
-	amount := float64(params.Amount.Uint64()) / 1e8
+	outboundTssNonce := nonces[0]
+	lastTssNonce := nonces[len(nonces)-1]
 
 	// Add 1 satoshi/byte to gasPrice to avoid minRelayTxFee issue
 	networkInfo, err := signer.rpcClient.GetNetworkInfo()
@@ -383,33 +598,68 @@ func (signer *Signer) TryProcessOutbound(
 	satPerByte := bitcoin.FeeRateToSatPerByte(networkInfo.RelayFee)
 	gasprice.Add(gasprice, satPerByte)
 
-	// compliance check
-	cancelTx := compliance.IsCctxRestricted(cctx)
-	if cancelTx {
-		compliance.PrintComplianceLog(logger, signer.loggerCompliance,
-			true, chain.ChainId, cctx.Index, cctx.InboundParams.Sender, params.Receiver, "BTC")
-		amount = 0.0 // zero out the amount to cancel the tx
-	}
-	logger.Info().Msgf("SignWithdrawTx: to %s, value %d sats", to.EncodeAddress(), params.Amount.Uint64())
-
-	// sign withdraw tx
-	tx, err := signer.SignWithdrawTx(
-		to,
-		amount,
-		gasprice,
-		sizelimit,
-		btcObserver,
-		height,
-		outboundTssNonce,
-		chain,
-		cancelTx,
-	)
+	// replace the pending outbound by fee if it's stuck in mempool, otherwise sign withdraw tx
+	var tx *wire.MsgTx
+	var prevOuts []btcjson.ListUnspentResult
+	replaceable := false
+	if len(payments) == 1 {
+		prevOuts, replaceable = signer.getReplaceableOutbound(
+			btcObserver,
+			zetacoreClient,
+			outboundTssNonce,
+			gasprice,
+			satPerByte,
+		)
+	}
+	if replaceable {
+		logger.Info().Msgf("SignReplacementTx: nonce %d gasPrice %s", outboundTssNonce, gasprice)
+		tx, err = signer.SignReplacementTx(
+			prevOuts,
+			payments[0].To,
+			payments[0].Amount,
+			gasprice,
+			sizelimit,
+			height,
+			outboundTssNonce,
+			chain,
+			cancelTx,
+		)
+	} else if btcObserver.IsOutboundReplaceable(outboundTssNonce) {
+		// never sign a new withdraw tx for an outbound pending in mempool
+		logger.Info().Msgf("outbound nonce %d is pending in mempool; no need to replace", outboundTssNonce)
+		return
+	} else if len(payments) == 1 {
+		logger.Info().Msgf("SignWithdrawTx: to %s, value %v BTC", payments[0].To.EncodeAddress(), payments[0].Amount)
+		tx, err = signer.SignWithdrawTx(
+			payments[0].To,
+			payments[0].Amount,
+			gasprice,
+			sizelimit,
+			btcObserver,
+			height,
+			outboundTssNonce,
+			chain,
+			cancelTx,
+		)
+	} else {
+		logger.Info().Msgf("SignBatchWithdrawTx: %d payments, nonce %d to %d", len(payments), outboundTssNonce, lastTssNonce)
+		tx, err = signer.SignBatchWithdrawTx(
+			payments,
+			gasprice,
+			sizelimit,
+			btcObserver,
+			height,
+			outboundTssNonce,
+			lastTssNonce,
+			chain,
+		)
+	}
 	if err != nil {
-		logger.Warn().Err(err).Msgf("SignOutbound error: nonce %d chain %d", outboundTssNonce, params.ReceiverChainId)
+		logger.Warn().Err(err).Msgf("SignOutbound error: nonce %d chain %d", outboundTssNonce, chain.ChainId)
 		return
 	}
 	logger.Info().
-		Msgf("Key-sign success: %d => %s, nonce %d", cctx.InboundParams.SenderChainId, chain.ChainName, outboundTssNonce)
+		Msgf("Key-sign success: %d => %s, nonce %d to %d", cctx.InboundParams.SenderChainId, chain.ChainName, outboundTssNonce, lastTssNonce)
 
 	// FIXME: add prometheus metrics
 	_, err = zetacoreClient.GetObserverList()
@@ -421,7 +671,7 @@ func (signer *Signer) TryProcessOutbound(
 	if tx != nil {
 		outboundHash := tx.TxHash().String()
 		logger.Info().
-			Msgf("on chain %s nonce %d, outboundHash %s signer %s", chain.ChainName, outboundTssNonce, outboundHash, signerAddress)
+			Msgf("on chain %s nonce %d to %d, outboundHash %s signer %s", chain.ChainName, outboundTssNonce, lastTssNonce, outboundHash, signerAddress)
 		// TODO: pick a few broadcasters.
 		//if len(signers) == 0 || myid == signers[send.OutboundParams.Broadcaster] || myid == signers[int(send.OutboundParams.Broadcaster+1)%len(signers)] {
 		// retry loop: 1s, 2s, 4s, 8s, 16s in case of RPC error
@@ -437,24 +687,80 @@ func (signer *Signer) TryProcessOutbound(
 			}
 			logger.Info().
 				Msgf("Broadcast success: nonce %d to chain %s outboundHash %s", outboundTssNonce, chain.String(), outboundHash)
-			zetaHash, err := zetacoreClient.AddOutboundTracker(
-				chain.ChainId,
-				outboundTssNonce,
-				outboundHash,
-				nil,
-				"",
-				-1,
-			)
-			if err != nil {
-				logger.Err(err).
-					Msgf("Unable to add to tracker on zetacore: nonce %d chain %s outboundHash %s", outboundTssNonce, chain.ChainName, outboundHash)
-			}
-			logger.Info().Msgf("Broadcast to core successful %s", zetaHash)
 
-			// Save successfully broadcasted transaction to btc chain observer
-			btcObserver.SaveBroadcastedTx(outboundHash, outboundTssNonce)
+			// the outbound hash is tracked for each nonce it pays
+			for _, nonce := range nonces {
+				zetaHash, err := zetacoreClient.AddOutboundTracker(
+					chain.ChainId,
+					nonce,
+					outboundHash,
+					nil,
+					"",
+					-1,
+				)
+				if err != nil {
+					logger.Err(err).
+						Msgf("Unable to add to tracker on zetacore: nonce %d chain %s outboundHash %s", nonce, chain.ChainName, outboundHash)
+				}
+				logger.Info().Msgf("Broadcast to core successful %s", zetaHash)
+
+				// Save successfully broadcasted transaction to btc chain observer
+				btcObserver.SaveBroadcastedTx(outboundHash, nonce)
+			}
 
 			break // successful broadcast; no need to retry
 		}
 	}
 }
+
+// getPayment returns the payment to the receiver of the cctx and the gas price of the cctx
+func (signer *Signer) getPayment(cctx *types.CrossChainTx) (Payment, *big.Int, error) {
+	params := cctx.GetCurrentOutboundParam()
+	coinType := cctx.InboundParams.CoinType
+	if coinType == coin.CoinType_Zeta || coinType == coin.CoinType_ERC20 {
+		return Payment{}, nil, fmt.Errorf("can only send BTC to a BTC network")
+	}
+
+	gasprice, ok := new(big.Int).SetString(params.GasPrice, 10)
+	if !ok || gasprice.Cmp(big.NewInt(0)) < 0 {
+		return Payment{}, nil, fmt.Errorf("cannot convert gas price %s", params.GasPrice)
+	}
+
+	// Check receiver P2WPKH address
+	to, err := chains.DecodeBtcAddress(params.Receiver, params.ReceiverChainId)
+	if err != nil {
+		return Payment{}, nil, errors.Wrapf(err, "cannot decode address %s", params.Receiver)
+	}
+	if !chains.IsBtcAddressSupported(to) {
+		return Payment{}, nil, fmt.Errorf("unsupported address %s", params.Receiver)
+	}
+
+	return Payment{
+		To:     to,
+		Amount: float64(params.Amount.Uint64()) / 1e8,
+	}, gasprice, nil
+}
+
+// getReplaceableOutbound returns the inputs of the pending outbound if it can be replaced by fee (RBF)
+// the hash of the replacement has to fit in the outbound tracker, otherwise observers won't be able to find it
+func (signer *Signer) getReplaceableOutbound(
+	btcObserver *observer.Observer,
+	zetacoreClient interfaces.ZetacoreClient,
+	nonce uint64,
+	gasPrice *big.Int,
+	minFeeBump *big.Int,
+) ([]btcjson.ListUnspentResult, bool) {
+	if !btcObserver.IsOutboundReplaceable(nonce) {
+		return nil, false
+	}
+	tracker, err := zetacoreClient.GetOutboundTracker(btcObserver.Chain(), nonce)
+	if err != nil {
+		signer.Logger().Std.Warn().Err(err).Msgf("getReplaceableOutbound: cannot get outbound tracker for nonce %d", nonce)
+		return nil, false
+	}
+	if len(tracker.HashList) >= crosschainkeeper.MaxOutboundTrackerHashes {
+		signer.Logger().Std.Warn().Msgf("getReplaceableOutbound: outbound tracker is full for nonce %d", nonce)
+		return nil, false
+	}
+	return btcObserver.GetReplaceableOutbound(nonce, gasPrice.Int64(), minFeeBump.Int64())
+}
@@ -38,7 +38,7 @@ func (suite *BTCSignTestSuite) SetupTest() {
 	suite.testSigner = &mocks.TSS{ // fake TSS
 		PrivKey: privateKey.ToECDSA(),
 	}
-	addr := suite.testSigner.BTCAddressWitnessPubkeyHash()
+	addr := suite.testSigner.BTCAddressWitnessPubkeyHash(chains.BitcoinTestnet.ChainId)
 	suite.T().Logf("segwit addr: %s", addr)
 }
 
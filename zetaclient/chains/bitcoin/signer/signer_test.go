@@ -50,6 +50,7 @@ func (s *BTCSignerSuite) SetUpTest(c *C) {
 	}
 	cfg := config.NewConfig()
 	s.btcSigner, err = NewSigner(
+		chains.BitcoinTestnet,
 		config.BTCConfig{},
 		tss,
 		clientcommon.DefaultLoggers(),
@@ -231,6 +232,7 @@ func (s *BTCSignerSuite) TestP2WPH(c *C) {
 func TestAddWithdrawTxOutputs(t *testing.T) {
 	// Create test signer and receiver address
 	signer, err := NewSigner(
+		chains.BitcoinMainnet,
 		config.BTCConfig{},
 		mocks.NewTSSMainnet(),
 		clientcommon.DefaultLoggers(),
@@ -240,7 +242,7 @@ func TestAddWithdrawTxOutputs(t *testing.T) {
 	require.NoError(t, err)
 
 	// tss address and script
-	tssAddr := signer.tssSigner.BTCAddressWitnessPubkeyHash()
+	tssAddr := signer.TSS().BTCAddressWitnessPubkeyHash(signer.Chain().ChainId)
 	tssScript, err := bitcoin.PayToAddrScript(tssAddr)
 	require.NoError(t, err)
 	fmt.Printf("tss address: %s", tssAddr.EncodeAddress())
@@ -381,6 +383,122 @@ func TestAddWithdrawTxOutputs(t *testing.T) {
 	}
 }
 
+func TestAddBatchWithdrawTxOutputs(t *testing.T) {
+	// Create test signer and receiver addresses
+	signer, err := NewSigner(
+		chains.BitcoinMainnet,
+		config.BTCConfig{},
+		mocks.NewTSSMainnet(),
+		clientcommon.DefaultLoggers(),
+		&metrics.TelemetryServer{},
+		nil,
+	)
+	require.NoError(t, err)
+
+	// tss address and script
+	tssAddr := signer.TSS().BTCAddressWitnessPubkeyHash(signer.Chain().ChainId)
+	tssScript, err := bitcoin.PayToAddrScript(tssAddr)
+	require.NoError(t, err)
+
+	// receiver addresses
+	to1, err := chains.DecodeBtcAddress("bc1qaxf82vyzy8y80v000e7t64gpten7gawewzu42y", chains.BitcoinMainnet.ChainId)
+	require.NoError(t, err)
+	to1Script, err := bitcoin.PayToAddrScript(to1)
+	require.NoError(t, err)
+	to2, err := chains.DecodeBtcAddress("bc1qh297vdt8xq6df5xae9z8gzd4jsu9a392mp0dus", chains.BitcoinMainnet.ChainId)
+	require.NoError(t, err)
+	to2Script, err := bitcoin.PayToAddrScript(to2)
+	require.NoError(t, err)
+	payments := []Payment{{To: to1, Amount: 0.2}, {To: to2, Amount: 0.3}}
+
+	// test cases
+	tests := []struct {
+		name     string
+		tx       *wire.MsgTx
+		payments []Payment
+		total    float64
+		nonce    int64
+		fees     *big.Int
+		fail     bool
+		message  string
+		txout    []*wire.TxOut
+	}{
+		{
+			name:     "should add outputs successfully",
+			tx:       wire.NewMsgTx(wire.TxVersion),
+			payments: payments,
+			total:    1.00012000,
+			nonce:    10000,
+			fees:     big.NewInt(2000),
+			fail:     false,
+			txout: []*wire.TxOut{
+				{Value: 10000, PkScript: tssScript},
+				{Value: 20000000, PkScript: to1Script},
+				{Value: 30000000, PkScript: to2Script},
+				{Value: 50000000, PkScript: tssScript},
+			},
+		},
+		{
+			name:     "should fail without change",
+			tx:       wire.NewMsgTx(wire.TxVersion),
+			payments: payments,
+			total:    0.50012000,
+			nonce:    10000,
+			fees:     big.NewInt(2000),
+			fail:     true,
+			message:  "remainder value is not positive",
+		},
+		{
+			name:     "should not produce duplicate nonce mark",
+			tx:       wire.NewMsgTx(wire.TxVersion),
+			payments: payments,
+			total:    0.50022000, //  0.5 + fee + nonceMark * 2
+			nonce:    10000,
+			fees:     big.NewInt(2000),
+			fail:     false,
+			txout: []*wire.TxOut{
+				{Value: 10000, PkScript: tssScript},
+				{Value: 20000000, PkScript: to1Script},
+				{Value: 30000000, PkScript: to2Script},
+				{Value: 9999, PkScript: tssScript}, // nonceMark - 1
+			},
+		},
+		{
+			name:     "should fail on invalid amount",
+			tx:       wire.NewMsgTx(wire.TxVersion),
+			payments: []Payment{{To: to1, Amount: 0.2}, {To: to2, Amount: -0.5}},
+			total:    1.00012000,
+			nonce:    10000,
+			fees:     big.NewInt(2000),
+			fail:     true,
+		},
+		{
+			name:     "should fail on invalid to address",
+			tx:       wire.NewMsgTx(wire.TxVersion),
+			payments: []Payment{{To: to1, Amount: 0.2}, {To: nil, Amount: 0.3}},
+			total:    1.00012000,
+			nonce:    10000,
+			fees:     big.NewInt(2000),
+			fail:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := signer.AddBatchWithdrawTxOutputs(tt.tx, tt.payments, tt.total, tt.nonce, tt.fees)
+			if tt.fail {
+				require.Error(t, err)
+				if tt.message != "" {
+					require.Contains(t, err.Error(), tt.message)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, reflect.DeepEqual(tt.txout, tt.tx.TxOut))
+		})
+	}
+}
+
 // Coverage doesn't seem to pick this up from the suite
 func TestNewBTCSigner(t *testing.T) {
 	// test private key with EVM address
@@ -394,6 +512,7 @@ func TestNewBTCSigner(t *testing.T) {
 	}
 	cfg := config.NewConfig()
 	btcSigner, err := NewSigner(
+		chains.BitcoinTestnet,
 		config.BTCConfig{},
 		tss,
 		clientcommon.DefaultLoggers(),
@@ -166,6 +166,28 @@ func DecodeScriptP2PKH(scriptHex string, net *chaincfg.Params) (string, error) {
 	return EncodeAddress(pubKeyHash, net.PubKeyHashAddrID), nil
 }
 
+// DecodeScriptAddress decodes address from P2TR, P2WSH, P2WPKH, P2SH or P2PKH script
+// returns empty address if the script is none of these types
+func DecodeScriptAddress(pkScript []byte, net *chaincfg.Params) (string, error) {
+	scriptHex := hex.EncodeToString(pkScript)
+	if IsPkScriptP2TR(pkScript) {
+		return DecodeScriptP2TR(scriptHex, net)
+	}
+	if IsPkScriptP2WSH(pkScript) {
+		return DecodeScriptP2WSH(scriptHex, net)
+	}
+	if IsPkScriptP2WPKH(pkScript) {
+		return DecodeScriptP2WPKH(scriptHex, net)
+	}
+	if IsPkScriptP2SH(pkScript) {
+		return DecodeScriptP2SH(scriptHex, net)
+	}
+	if IsPkScriptP2PKH(pkScript) {
+		return DecodeScriptP2PKH(scriptHex, net)
+	}
+	return "", nil
+}
+
 // DecodeOpReturnMemo decodes memo from OP_RETURN script
 // returns (memo, found, error)
 func DecodeOpReturnMemo(scriptHex string, txid string) ([]byte, bool, error) {
@@ -29,122 +29,82 @@ import (
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 	clientcontext "github.com/zeta-chain/zetacore/zetaclient/context"
 	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+	"github.com/zeta-chain/zetacore/zetaclient/rescan"
 	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
 	"github.com/zeta-chain/zetacore/zetaclient/zetacore"
 )
 
 // WatchInbound watches evm chain for incoming txs and post votes to zetacore
+// if a websocket endpoint is configured, the inbound is observed at each new head and only polled while disconnected
 func (ob *Observer) WatchInbound() {
 	ticker, err := clienttypes.NewDynamicTicker(
-		fmt.Sprintf("EVM_WatchInbound_%d", ob.chain.ChainId),
+		fmt.Sprintf("EVM_WatchInbound_%d", ob.Chain().ChainId),
 		ob.GetChainParams().InboundTicker,
 	)
 	if err != nil {
-		ob.logger.Inbound.Error().Err(err).Msg("error creating ticker")
+		ob.Logger().Inbound.Error().Err(err).Msg("error creating ticker")
 		return
 	}
 	defer ticker.Stop()
 
-	ob.logger.Inbound.Info().Msgf("WatchInbound started for chain %d", ob.chain.ChainId)
-	sampledLogger := ob.logger.Inbound.Sample(&zerolog.BasicSampler{N: 10})
+	ob.Logger().Inbound.Info().Msgf("WatchInbound started for chain %d", ob.Chain().ChainId)
+	sampledLogger := ob.Logger().Inbound.Sample(&zerolog.BasicSampler{N: 10})
 
 	for {
 		select {
 		case <-ticker.C():
-			if !clientcontext.IsInboundObservationEnabled(ob.coreContext, ob.GetChainParams()) {
+			if !clientcontext.IsInboundObservationEnabled(ob.CoreContext(), ob.GetChainParams()) {
 				sampledLogger.Info().
-					Msgf("WatchInbound: inbound observation is disabled for chain %d", ob.chain.ChainId)
+					Msgf("WatchInbound: inbound observation is disabled for chain %d", ob.Chain().ChainId)
 				continue
 			}
+
+			// block on the subscription until it is interrupted, then poll and re-subscribe at next tick
+			if ob.wsEndpoint != "" {
+				err := ob.WatchInboundSubscription(sampledLogger)
+				if err == nil {
+					ob.Logger().Inbound.Info().Msgf("WatchInbound stopped for chain %d", ob.Chain().ChainId)
+					return
+				}
+				ob.Logger().Inbound.Warn().Err(err).Msg("WatchInbound: subscription interrupted, falling back to polling")
+			}
+
 			err := ob.ObserveInbound(sampledLogger)
 			if err != nil {
-				ob.logger.Inbound.Err(err).Msg("WatchInbound: observeInbound error")
+				ob.Logger().Inbound.Err(err).Msg("WatchInbound: observeInbound error")
 			}
-			ticker.UpdateInterval(ob.GetChainParams().InboundTicker, ob.logger.Inbound)
-		case <-ob.stop:
-			ob.logger.Inbound.Info().Msgf("WatchInbound stopped for chain %d", ob.chain.ChainId)
+			ticker.UpdateInterval(ob.GetChainParams().InboundTicker, ob.Logger().Inbound)
+		case <-ob.StopChannel():
+			ob.Logger().Inbound.Info().Msgf("WatchInbound stopped for chain %d", ob.Chain().ChainId)
 			return
 		}
 	}
 }
 
-// WatchInboundTracker gets a list of Inbound tracker suggestions from zeta-core at each tick and tries to check if the in-tx was confirmed.
-// If it was, it tries to broadcast the confirmation vote. If this zeta client has previously broadcast the vote, the tx would be rejected
-func (ob *Observer) WatchInboundTracker() {
-	ticker, err := clienttypes.NewDynamicTicker(
-		fmt.Sprintf("EVM_WatchInboundTracker_%d", ob.chain.ChainId),
-		ob.GetChainParams().InboundTicker,
-	)
+// CheckInboundTracker checks the ZetaSent, Deposited or TSS received inbound of the tracker and votes on it
+func (ob *Observer) CheckInboundTracker(tracker types.InboundTracker) error {
+	// query tx and receipt
+	tx, _, err := ob.TransactionByHash(tracker.TxHash)
 	if err != nil {
-		ob.logger.Inbound.Err(err).Msg("error creating ticker")
-		return
-	}
-	defer ticker.Stop()
-
-	ob.logger.Inbound.Info().Msgf("Inbound tracker watcher started for chain %d", ob.chain.ChainId)
-	for {
-		select {
-		case <-ticker.C():
-			if !clientcontext.IsInboundObservationEnabled(ob.coreContext, ob.GetChainParams()) {
-				continue
-			}
-			err := ob.ProcessInboundTrackers()
-			if err != nil {
-				ob.logger.Inbound.Err(err).Msg("ProcessInboundTrackers error")
-			}
-			ticker.UpdateInterval(ob.GetChainParams().InboundTicker, ob.logger.Inbound)
-		case <-ob.stop:
-			ob.logger.Inbound.Info().Msgf("WatchInboundTracker stopped for chain %d", ob.chain.ChainId)
-			return
-		}
+		return errors.Wrap(err, "error getting transaction")
 	}
-}
-
-// ProcessInboundTrackers processes inbound trackers from zetacore
-func (ob *Observer) ProcessInboundTrackers() error {
-	trackers, err := ob.zetacoreClient.GetInboundTrackersForChain(ob.chain.ChainId)
+	receipt, err := ob.evmClient.TransactionReceipt(context.Background(), ethcommon.HexToHash(tracker.TxHash))
 	if err != nil {
-		return err
+		return errors.Wrap(err, "error getting receipt")
 	}
-	for _, tracker := range trackers {
-		// query tx and receipt
-		tx, _, err := ob.TransactionByHash(tracker.TxHash)
-		if err != nil {
-			return errors.Wrapf(
-				err,
-				"error getting transaction for inbound %s chain %d",
-				tracker.TxHash,
-				ob.chain.ChainId,
-			)
-		}
 
-		receipt, err := ob.evmClient.TransactionReceipt(context.Background(), ethcommon.HexToHash(tracker.TxHash))
-		if err != nil {
-			return errors.Wrapf(err, "error getting receipt for inbound %s chain %d", tracker.TxHash, ob.chain.ChainId)
-		}
-		ob.logger.Inbound.Info().Msgf("checking tracker for inbound %s chain %d", tracker.TxHash, ob.chain.ChainId)
-
-		// check and vote on inbound tx
-		switch tracker.CoinType {
-		case coin.CoinType_Zeta:
-			_, err = ob.CheckAndVoteInboundTokenZeta(tx, receipt, true)
-		case coin.CoinType_ERC20:
-			_, err = ob.CheckAndVoteInboundTokenERC20(tx, receipt, true)
-		case coin.CoinType_Gas:
-			_, err = ob.CheckAndVoteInboundTokenGas(tx, receipt, true)
-		default:
-			return fmt.Errorf(
-				"unknown coin type %s for inbound %s chain %d",
-				tracker.CoinType,
-				tx.Hash,
-				ob.chain.ChainId,
-			)
-		}
-		if err != nil {
-			return errors.Wrapf(err, "error checking and voting for inbound %s chain %d", tx.Hash, ob.chain.ChainId)
-		}
+	// check and vote on inbound tx
+	switch tracker.CoinType {
+	case coin.CoinType_Zeta:
+		_, err = ob.CheckAndVoteInboundTokenZeta(tx, receipt, true)
+	case coin.CoinType_ERC20:
+		_, err = ob.CheckAndVoteInboundTokenERC20(tx, receipt, true)
+	case coin.CoinType_Gas:
+		_, err = ob.CheckAndVoteInboundTokenGas(tx, receipt, true)
+	default:
+		return fmt.Errorf("unknown coin type %s", tracker.CoinType)
 	}
-	return nil
+	return err
 }
 
 func (ob *Observer) ObserveInbound(sampledLogger zerolog.Logger) error {
@@ -163,7 +123,12 @@ func (ob *Observer) ObserveInbound(sampledLogger zerolog.Logger) error {
 	ob.SetLastBlockHeight(blockNumber)
 
 	// increment prom counter
-	metrics.GetBlockByNumberPerChain.WithLabelValues(ob.chain.ChainName.String()).Inc()
+	metrics.GetBlockByNumberPerChain.WithLabelValues(ob.Chain().ChainName.String()).Inc()
+
+	// re-scan the blocks replaced by a chain reorg
+	if err := ob.CheckReorg(); err != nil {
+		return err
+	}
 
 	// skip if current height is too low
 	if blockNumber < ob.GetChainParams().ConfirmationCount {
@@ -175,7 +140,7 @@ func (ob *Observer) ObserveInbound(sampledLogger zerolog.Logger) error {
 	lastScanned := ob.GetLastBlockHeightScanned()
 	if lastScanned >= confirmedBlockNum {
 		sampledLogger.Debug().
-			Msgf("observeInbound: skipping observer, no new block is produced for chain %d", ob.chain.ChainId)
+			Msgf("observeInbound: skipping observer, no new block is produced for chain %d", ob.Chain().ChainId)
 		return nil
 	}
 
@@ -192,6 +157,16 @@ func (ob *Observer) ObserveInbound(sampledLogger zerolog.Logger) error {
 	// task 3: query the incoming tx to TSS address (read at most 100 blocks in one go)
 	lastScannedTssRecvd := ob.ObserverTSSReceive(startBlock, toBlock)
 
+	ob.updateLastBlockScanned(lastScanned, lastScannedZetaSent, lastScannedDeposited, lastScannedTssRecvd, sampledLogger)
+	return nil
+}
+
+// updateLastBlockScanned updates the last scanned block height to the lowest height scanned for the 3 events
+// returns the updated last scanned block height
+func (ob *Observer) updateLastBlockScanned(
+	lastScanned, lastScannedZetaSent, lastScannedDeposited, lastScannedTssRecvd uint64,
+	sampledLogger zerolog.Logger,
+) uint64 {
 	// note: using lowest height for all 3 events is not perfect, but it's simple and good enough
 	lastScannedLowest := lastScannedZetaSent
 	if lastScannedDeposited < lastScannedLowest {
@@ -202,27 +177,88 @@ func (ob *Observer) ObserveInbound(sampledLogger zerolog.Logger) error {
 	}
 
 	// update last scanned block height for all 3 events (ZetaSent, Deposited, TssRecvd), ignore db error
-	if lastScannedLowest > lastScanned {
-		sampledLogger.Info().
-			Msgf("observeInbound: lasstScanned heights for chain %d ZetaSent %d ERC20Deposited %d TssRecvd %d",
-				ob.chain.ChainId, lastScannedZetaSent, lastScannedDeposited, lastScannedTssRecvd)
-		ob.SetLastBlockHeightScanned(lastScannedLowest)
-		if err := ob.db.Save(clienttypes.ToLastBlockSQLType(lastScannedLowest)).Error; err != nil {
-			ob.logger.Inbound.Error().
-				Err(err).
-				Msgf("observeInbound: error writing lastScannedLowest %d to db", lastScannedLowest)
+	if lastScannedLowest <= lastScanned {
+		return lastScanned
+	}
+	sampledLogger.Info().
+		Msgf("observeInbound: lasstScanned heights for chain %d ZetaSent %d ERC20Deposited %d TssRecvd %d",
+			ob.Chain().ChainId, lastScannedZetaSent, lastScannedDeposited, lastScannedTssRecvd)
+	ob.SaveLastBlockScanned(lastScannedLowest)
+	return lastScannedLowest
+}
+
+// RescanInbound re-observes the ZetaSent, Deposited and TSS received inbounds of the blocks [fromBlock, toBlock]
+// and posts the votes of the inbounds without cctx to zetacore, the last scanned block of the observer is left unchanged
+// in dry-run, the inbounds are only reported
+func (ob *Observer) RescanInbound(fromBlock, toBlock uint64, dryRun bool) (*rescan.Report, error) {
+	if fromBlock == 0 || fromBlock > toBlock {
+		return nil, fmt.Errorf("invalid block range [%d, %d]", fromBlock, toBlock)
+	}
+
+	// refuse to rescan the blocks not confirmed yet
+	blockNumber, err := ob.evmClient.BlockNumber(context.Background())
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting block number for chain %d", ob.Chain().ChainId)
+	}
+	confirmationCount := ob.GetChainParams().ConfirmationCount
+	if blockNumber < confirmationCount || toBlock > blockNumber-confirmationCount {
+		return nil, fmt.Errorf(
+			"block %d is not confirmed on chain %d, current block %d",
+			toBlock,
+			ob.Chain().ChainId,
+			blockNumber,
+		)
+	}
+
+	session := rescan.NewSession(ob.ZetacoreClient(), ob.Chain().ChainId, fromBlock, toBlock, dryRun)
+	voter := func(msg *types.MsgVoteInbound, coinType coin.CoinType, retryGasLimit uint64) (string, error) {
+		return session.Vote(msg, func() (string, error) {
+			return ob.PostVoteInbound(msg, coinType, retryGasLimit)
+		})
+	}
+
+	for startBlock := fromBlock; startBlock <= toBlock; startBlock += config.MaxBlocksPerPeriod {
+		endBlock := startBlock + config.MaxBlocksPerPeriod - 1
+		if endBlock > toBlock {
+			endBlock = toBlock
+		}
+		if scanned := ob.observeZetaSent(startBlock, endBlock, voter); scanned < endBlock {
+			return nil, fmt.Errorf(
+				"error rescanning ZetaSent events on chain %d, stopped at block %d",
+				ob.Chain().ChainId,
+				scanned+1,
+			)
+		}
+		if scanned := ob.observeERC20Deposited(startBlock, endBlock, voter); scanned < endBlock {
+			return nil, fmt.Errorf(
+				"error rescanning Deposited events on chain %d, stopped at block %d",
+				ob.Chain().ChainId,
+				scanned+1,
+			)
+		}
+		for bn := startBlock; bn <= endBlock; bn++ {
+			if err := ob.observeTSSReceiveInBlock(bn, voter); err != nil {
+				return nil, errors.Wrapf(err, "error rescanning TSS received token in block %d", bn)
+			}
 		}
+		ob.Logger().Inbound.Info().
+			Msgf("RescanInbound: rescanned blocks %d to %d for chain %d", startBlock, endBlock, ob.Chain().ChainId)
 	}
-	return nil
+	return session.Report(), nil
 }
 
 // ObserveZetaSent queries the ZetaSent event from the connector contract and posts to zetacore
 // returns the last block successfully scanned
 func (ob *Observer) ObserveZetaSent(startBlock, toBlock uint64) uint64 {
+	return ob.observeZetaSent(startBlock, toBlock, ob.PostVoteInbound)
+}
+
+// observeZetaSent queries the ZetaSent event from the connector contract and posts the votes with the given voter
+func (ob *Observer) observeZetaSent(startBlock, toBlock uint64, voter inboundVoter) uint64 {
 	// filter ZetaSent logs
 	addrConnector, connector, err := ob.GetConnectorContract()
 	if err != nil {
-		ob.logger.Chain.Warn().Err(err).Msgf("ObserveZetaSent: GetConnectorContract error:")
+		ob.Logger().Chain.Warn().Err(err).Msgf("ObserveZetaSent: GetConnectorContract error:")
 		return startBlock - 1 // lastScanned
 	}
 	iter, err := connector.FilterZetaSent(&bind.FilterOpts{
@@ -231,24 +267,43 @@ func (ob *Observer) ObserveZetaSent(startBlock, toBlock uint64) uint64 {
 		Context: context.TODO(),
 	}, []ethcommon.Address{}, []*big.Int{})
 	if err != nil {
-		ob.logger.Chain.Warn().Err(err).Msgf(
-			"ObserveZetaSent: FilterZetaSent error from block %d to %d for chain %d", startBlock, toBlock, ob.chain.ChainId)
+		ob.Logger().Chain.Warn().Err(err).Msgf(
+			"ObserveZetaSent: FilterZetaSent error from block %d to %d for chain %d", startBlock, toBlock, ob.Chain().ChainId)
 		return startBlock - 1 // lastScanned
 	}
 
-	// collect and sort events by block number, then tx index, then log index (ascending)
 	events := make([]*zetaconnector.ZetaConnectorNonEthZetaSent, 0)
 	for iter.Next() {
+		events = append(events, iter.Event)
+	}
+
+	// increment prom counter
+	metrics.GetFilterLogsPerChain.WithLabelValues(ob.Chain().ChainName.String()).Inc()
+
+	return ob.processZetaSentEvents(addrConnector, events, toBlock, voter)
+}
+
+// processZetaSentEvents validates the ZetaSent events up to block 'toBlock' and posts them to zetacore in order
+// returns the last block successfully scanned
+func (ob *Observer) processZetaSentEvents(
+	addrConnector ethcommon.Address,
+	allEvents []*zetaconnector.ZetaConnectorNonEthZetaSent,
+	toBlock uint64,
+	voter inboundVoter,
+) uint64 {
+	// collect and sort events by block number, then tx index, then log index (ascending)
+	events := make([]*zetaconnector.ZetaConnectorNonEthZetaSent, 0, len(allEvents))
+	for _, event := range allEvents {
 		// sanity check tx event
-		err := evm.ValidateEvmTxLog(&iter.Event.Raw, addrConnector, "", evm.TopicsZetaSent)
+		err := evm.ValidateEvmTxLog(&event.Raw, addrConnector, "", evm.TopicsZetaSent)
 		if err == nil {
-			events = append(events, iter.Event)
+			events = append(events, event)
 			continue
 		}
-		ob.logger.Inbound.Warn().
+		ob.Logger().Inbound.Warn().
 			Err(err).
 			Msgf("ObserveZetaSent: invalid ZetaSent event in tx %s on chain %d at height %d",
-				iter.Event.Raw.TxHash.Hex(), ob.chain.ChainId, iter.Event.Raw.BlockNumber)
+				event.Raw.TxHash.Hex(), ob.Chain().ChainId, event.Raw.BlockNumber)
 	}
 	sort.SliceStable(events, func(i, j int) bool {
 		if events[i].Raw.BlockNumber == events[j].Raw.BlockNumber {
@@ -260,9 +315,6 @@ func (ob *Observer) ObserveZetaSent(startBlock, toBlock uint64) uint64 {
 		return events[i].Raw.BlockNumber < events[j].Raw.BlockNumber
 	})
 
-	// increment prom counter
-	metrics.GetFilterLogsPerChain.WithLabelValues(ob.chain.ChainName.String()).Inc()
-
 	// post to zetacore
 	beingScanned := uint64(0)
 	guard := make(map[string]bool)
@@ -273,15 +325,23 @@ func (ob *Observer) ObserveZetaSent(startBlock, toBlock uint64) uint64 {
 		}
 		// guard against multiple events in the same tx
 		if guard[event.Raw.TxHash.Hex()] {
-			ob.logger.Inbound.Warn().
+			ob.Logger().Inbound.Warn().
 				Msgf("ObserveZetaSent: multiple remote call events detected in tx %s", event.Raw.TxHash)
 			continue
 		}
 		guard[event.Raw.TxHash.Hex()] = true
 
-		msg := ob.BuildInboundVoteMsgForZetaSentEvent(event)
+		msg, err := ob.BuildInboundVoteMsgForZetaSentEvent(event)
+		if err != nil {
+			ob.Logger().Inbound.Error().Err(err).Msgf("ObserveZetaSent: error building vote for inbound %s", event.Raw.TxHash)
+			return beingScanned - 1 // we have to re-scan from this block next time
+		}
 		if msg != nil {
-			_, err = ob.PostVoteInbound(
+			if err := ob.CheckBlockCanonical(event.Raw.BlockNumber, event.Raw.BlockHash); err != nil {
+				ob.Logger().Inbound.Error().Err(err).Msgf("ObserveZetaSent: refused to vote on inbound %s", event.Raw.TxHash)
+				return beingScanned - 1 // we have to re-scan from this block next time
+			}
+			_, err := voter(
 				msg,
 				coin.CoinType_Zeta,
 				zetacore.PostVoteInboundMessagePassingExecutionGasLimit,
@@ -298,10 +358,16 @@ func (ob *Observer) ObserveZetaSent(startBlock, toBlock uint64) uint64 {
 // ObserveERC20Deposited queries the ERC20CustodyDeposited event from the ERC20Custody contract and posts to zetacore
 // returns the last block successfully scanned
 func (ob *Observer) ObserveERC20Deposited(startBlock, toBlock uint64) uint64 {
+	return ob.observeERC20Deposited(startBlock, toBlock, ob.PostVoteInbound)
+}
+
+// observeERC20Deposited queries the ERC20CustodyDeposited event from the ERC20Custody contract
+// and posts the votes with the given voter
+func (ob *Observer) observeERC20Deposited(startBlock, toBlock uint64, voter inboundVoter) uint64 {
 	// filter ERC20CustodyDeposited logs
 	addrCustody, erc20custodyContract, err := ob.GetERC20CustodyContract()
 	if err != nil {
-		ob.logger.Inbound.Warn().Err(err).Msgf("ObserveERC20Deposited: GetERC20CustodyContract error:")
+		ob.Logger().Inbound.Warn().Err(err).Msgf("ObserveERC20Deposited: GetERC20CustodyContract error:")
 		return startBlock - 1 // lastScanned
 	}
 
@@ -311,24 +377,43 @@ func (ob *Observer) ObserveERC20Deposited(startBlock, toBlock uint64) uint64 {
 		Context: context.TODO(),
 	}, []ethcommon.Address{})
 	if err != nil {
-		ob.logger.Inbound.Warn().Err(err).Msgf(
-			"ObserveERC20Deposited: FilterDeposited error from block %d to %d for chain %d", startBlock, toBlock, ob.chain.ChainId)
+		ob.Logger().Inbound.Warn().Err(err).Msgf(
+			"ObserveERC20Deposited: FilterDeposited error from block %d to %d for chain %d", startBlock, toBlock, ob.Chain().ChainId)
 		return startBlock - 1 // lastScanned
 	}
 
-	// collect and sort events by block number, then tx index, then log index (ascending)
 	events := make([]*erc20custody.ERC20CustodyDeposited, 0)
 	for iter.Next() {
+		events = append(events, iter.Event)
+	}
+
+	// increment prom counter
+	metrics.GetFilterLogsPerChain.WithLabelValues(ob.Chain().ChainName.String()).Inc()
+
+	return ob.processDepositedEvents(addrCustody, events, toBlock, voter)
+}
+
+// processDepositedEvents validates the Deposited events up to block 'toBlock' and posts them to zetacore in order
+// returns the last block successfully scanned
+func (ob *Observer) processDepositedEvents(
+	addrCustody ethcommon.Address,
+	allEvents []*erc20custody.ERC20CustodyDeposited,
+	toBlock uint64,
+	voter inboundVoter,
+) uint64 {
+	// collect and sort events by block number, then tx index, then log index (ascending)
+	events := make([]*erc20custody.ERC20CustodyDeposited, 0, len(allEvents))
+	for _, event := range allEvents {
 		// sanity check tx event
-		err := evm.ValidateEvmTxLog(&iter.Event.Raw, addrCustody, "", evm.TopicsDeposited)
+		err := evm.ValidateEvmTxLog(&event.Raw, addrCustody, "", evm.TopicsDeposited)
 		if err == nil {
-			events = append(events, iter.Event)
+			events = append(events, event)
 			continue
 		}
-		ob.logger.Inbound.Warn().
+		ob.Logger().Inbound.Warn().
 			Err(err).
 			Msgf("ObserveERC20Deposited: invalid Deposited event in tx %s on chain %d at height %d",
-				iter.Event.Raw.TxHash.Hex(), ob.chain.ChainId, iter.Event.Raw.BlockNumber)
+				event.Raw.TxHash.Hex(), ob.Chain().ChainId, event.Raw.BlockNumber)
 	}
 	sort.SliceStable(events, func(i, j int) bool {
 		if events[i].Raw.BlockNumber == events[j].Raw.BlockNumber {
@@ -340,9 +425,6 @@ func (ob *Observer) ObserveERC20Deposited(startBlock, toBlock uint64) uint64 {
 		return events[i].Raw.BlockNumber < events[j].Raw.BlockNumber
 	})
 
-	// increment prom counter
-	metrics.GetFilterLogsPerChain.WithLabelValues(ob.chain.ChainName.String()).Inc()
-
 	// post to zeatcore
 	guard := make(map[string]bool)
 	beingScanned := uint64(0)
@@ -353,23 +435,31 @@ func (ob *Observer) ObserveERC20Deposited(startBlock, toBlock uint64) uint64 {
 		}
 		tx, _, err := ob.TransactionByHash(event.Raw.TxHash.Hex())
 		if err != nil {
-			ob.logger.Inbound.Error().Err(err).Msgf(
-				"ObserveERC20Deposited: error getting transaction for inbound %s chain %d", event.Raw.TxHash, ob.chain.ChainId)
+			ob.Logger().Inbound.Error().Err(err).Msgf(
+				"ObserveERC20Deposited: error getting transaction for inbound %s chain %d", event.Raw.TxHash, ob.Chain().ChainId)
 			return beingScanned - 1 // we have to re-scan from this block next time
 		}
 		sender := ethcommon.HexToAddress(tx.From)
 
 		// guard against multiple events in the same tx
 		if guard[event.Raw.TxHash.Hex()] {
-			ob.logger.Inbound.Warn().
+			ob.Logger().Inbound.Warn().
 				Msgf("ObserveERC20Deposited: multiple remote call events detected in tx %s", event.Raw.TxHash)
 			continue
 		}
 		guard[event.Raw.TxHash.Hex()] = true
 
-		msg := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
+		msg, err := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
+		if err != nil {
+			ob.Logger().Inbound.Error().Err(err).Msgf("ObserveERC20Deposited: error building vote for inbound %s", event.Raw.TxHash)
+			return beingScanned - 1 // we have to re-scan from this block next time
+		}
 		if msg != nil {
-			_, err = ob.PostVoteInbound(msg, coin.CoinType_ERC20, zetacore.PostVoteInboundExecutionGasLimit)
+			if err := ob.CheckBlockCanonical(event.Raw.BlockNumber, event.Raw.BlockHash); err != nil {
+				ob.Logger().Inbound.Error().Err(err).Msgf("ObserveERC20Deposited: refused to vote on inbound %s", event.Raw.TxHash)
+				return beingScanned - 1 // we have to re-scan from this block next time
+			}
+			_, err = voter(msg, coin.CoinType_ERC20, zetacore.PostVoteInboundExecutionGasLimit)
 			if err != nil {
 				return beingScanned - 1 // we have to re-scan from this block next time
 			}
@@ -387,23 +477,23 @@ func (ob *Observer) ObserverTSSReceive(startBlock, toBlock uint64) uint64 {
 		// post new block header (if any) to zetacore and ignore error
 		// TODO: consider having a independent ticker(from TSS scaning) for posting block headers
 		// https://github.com/zeta-chain/node/issues/1847
-		blockHeaderVerification, found := ob.coreContext.GetBlockHeaderEnabledChains(ob.chain.ChainId)
+		blockHeaderVerification, found := ob.CoreContext().GetBlockHeaderEnabledChains(ob.Chain().ChainId)
 		if found && blockHeaderVerification.Enabled {
 			// post block header for supported chains
 			// TODO: move this logic in its own routine
 			// https://github.com/zeta-chain/node/issues/2204
 			err := ob.postBlockHeader(toBlock)
 			if err != nil {
-				ob.logger.Inbound.Error().Err(err).Msg("error posting block header")
+				ob.Logger().Inbound.Error().Err(err).Msg("error posting block header")
 			}
 		}
 
 		// observe TSS received gas token in block 'bn'
 		err := ob.ObserveTSSReceiveInBlock(bn)
 		if err != nil {
-			ob.logger.Inbound.Error().
+			ob.Logger().Inbound.Error().
 				Err(err).
-				Msgf("ObserverTSSReceive: error observing TSS received token in block %d for chain %d", bn, ob.chain.ChainId)
+				Msgf("ObserverTSSReceive: error observing TSS received token in block %d for chain %d", bn, ob.Chain().ChainId)
 			return bn - 1 // we have to re-scan from this block next time
 		}
 	}
@@ -440,9 +530,12 @@ func (ob *Observer) CheckAndVoteInboundTokenZeta(
 			// sanity check tx event
 			err = evm.ValidateEvmTxLog(&event.Raw, addrConnector, tx.Hash, evm.TopicsZetaSent)
 			if err == nil {
-				msg = ob.BuildInboundVoteMsgForZetaSentEvent(event)
+				msg, err = ob.BuildInboundVoteMsgForZetaSentEvent(event)
+				if err != nil {
+					return "", err
+				}
 			} else {
-				ob.logger.Inbound.Error().Err(err).Msgf("CheckEvmTxLog error on inbound %s chain %d", tx.Hash, ob.chain.ChainId)
+				ob.Logger().Inbound.Error().Err(err).Msgf("CheckEvmTxLog error on inbound %s chain %d", tx.Hash, ob.Chain().ChainId)
 				return "", err
 			}
 			break // only one event is allowed per tx
@@ -450,10 +543,14 @@ func (ob *Observer) CheckAndVoteInboundTokenZeta(
 	}
 	if msg == nil {
 		// no event, restricted tx, etc.
-		ob.logger.Inbound.Info().Msgf("no ZetaSent event found for inbound %s chain %d", tx.Hash, ob.chain.ChainId)
+		ob.Logger().Inbound.Info().Msgf("no ZetaSent event found for inbound %s chain %d", tx.Hash, ob.Chain().ChainId)
 		return "", nil
 	}
 	if vote {
+		// refuse to vote on the inbound if its block has been replaced by a chain reorg
+		if err := ob.CheckBlockCanonical(receipt.BlockNumber.Uint64(), receipt.BlockHash); err != nil {
+			return "", err
+		}
 		return ob.PostVoteInbound(msg, coin.CoinType_Zeta, zetacore.PostVoteInboundMessagePassingExecutionGasLimit)
 	}
 
@@ -490,9 +587,12 @@ func (ob *Observer) CheckAndVoteInboundTokenERC20(
 			// sanity check tx event
 			err = evm.ValidateEvmTxLog(&zetaDeposited.Raw, addrCustory, tx.Hash, evm.TopicsDeposited)
 			if err == nil {
-				msg = ob.BuildInboundVoteMsgForDepositedEvent(zetaDeposited, sender)
+				msg, err = ob.BuildInboundVoteMsgForDepositedEvent(zetaDeposited, sender)
+				if err != nil {
+					return "", err
+				}
 			} else {
-				ob.logger.Inbound.Error().Err(err).Msgf("CheckEvmTxLog error on inbound %s chain %d", tx.Hash, ob.chain.ChainId)
+				ob.Logger().Inbound.Error().Err(err).Msgf("CheckEvmTxLog error on inbound %s chain %d", tx.Hash, ob.Chain().ChainId)
 				return "", err
 			}
 			break // only one event is allowed per tx
@@ -500,10 +600,14 @@ func (ob *Observer) CheckAndVoteInboundTokenERC20(
 	}
 	if msg == nil {
 		// no event, donation, restricted tx, etc.
-		ob.logger.Inbound.Info().Msgf("no Deposited event found for inbound %s chain %d", tx.Hash, ob.chain.ChainId)
+		ob.Logger().Inbound.Info().Msgf("no Deposited event found for inbound %s chain %d", tx.Hash, ob.Chain().ChainId)
 		return "", nil
 	}
 	if vote {
+		// refuse to vote on the inbound if its block has been replaced by a chain reorg
+		if err := ob.CheckBlockCanonical(receipt.BlockNumber.Uint64(), receipt.BlockHash); err != nil {
+			return "", err
+		}
 		return ob.PostVoteInbound(msg, coin.CoinType_ERC20, zetacore.PostVoteInboundExecutionGasLimit)
 	}
 
@@ -515,6 +619,16 @@ func (ob *Observer) CheckAndVoteInboundTokenGas(
 	tx *ethrpc.Transaction,
 	receipt *ethtypes.Receipt,
 	vote bool,
+) (string, error) {
+	return ob.checkAndVoteInboundTokenGas(tx, receipt, vote, ob.PostVoteInbound)
+}
+
+// checkAndVoteInboundTokenGas checks the given inbound gas token and votes on it with the given voter
+func (ob *Observer) checkAndVoteInboundTokenGas(
+	tx *ethrpc.Transaction,
+	receipt *ethtypes.Receipt,
+	vote bool,
+	voter inboundVoter,
 ) (string, error) {
 	// check confirmations
 	if confirmed := ob.HasEnoughConfirmations(receipt, ob.GetLastBlockHeight()); !confirmed {
@@ -526,7 +640,7 @@ func (ob *Observer) CheckAndVoteInboundTokenGas(
 	}
 
 	// checks receiver and tx status
-	if ethcommon.HexToAddress(tx.To) != ob.Tss.EVMAddress() {
+	if ethcommon.HexToAddress(tx.To) != ob.TSS().EVMAddress() {
 		return "", fmt.Errorf("tx.To %s is not TSS address", tx.To)
 	}
 	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
@@ -535,19 +649,29 @@ func (ob *Observer) CheckAndVoteInboundTokenGas(
 	sender := ethcommon.HexToAddress(tx.From)
 
 	// build inbound vote message and post vote
-	msg := ob.BuildInboundVoteMsgForTokenSentToTSS(tx, sender, receipt.BlockNumber.Uint64())
+	msg, err := ob.BuildInboundVoteMsgForTokenSentToTSS(tx, sender, receipt.BlockNumber.Uint64())
+	if err != nil {
+		return "", err
+	}
 	if msg == nil {
 		// donation, restricted tx, etc.
-		ob.logger.Inbound.Info().Msgf("no vote message built for inbound %s chain %d", tx.Hash, ob.chain.ChainId)
+		ob.Logger().Inbound.Info().Msgf("no vote message built for inbound %s chain %d", tx.Hash, ob.Chain().ChainId)
 		return "", nil
 	}
 	if vote {
-		return ob.PostVoteInbound(msg, coin.CoinType_Gas, zetacore.PostVoteInboundExecutionGasLimit)
+		// refuse to vote on the inbound if its block has been replaced by a chain reorg
+		if err := ob.CheckBlockCanonical(receipt.BlockNumber.Uint64(), receipt.BlockHash); err != nil {
+			return "", err
+		}
+		return voter(msg, coin.CoinType_Gas, zetacore.PostVoteInboundExecutionGasLimit)
 	}
 
 	return msg.Digest(), nil
 }
 
+// inboundVoter posts a vote for the given vote message and returns the ballot
+type inboundVoter func(msg *types.MsgVoteInbound, coinType coin.CoinType, retryGasLimit uint64) (string, error)
+
 // PostVoteInbound posts a vote for the given vote message
 func (ob *Observer) PostVoteInbound(
 	msg *types.MsgVoteInbound,
@@ -555,16 +679,16 @@ func (ob *Observer) PostVoteInbound(
 	retryGasLimit uint64,
 ) (string, error) {
 	txHash := msg.InboundHash
-	chainID := ob.chain.ChainId
-	zetaHash, ballot, err := ob.zetacoreClient.PostVoteInbound(zetacore.PostVoteInboundGasLimit, retryGasLimit, msg)
+	chainID := ob.Chain().ChainId
+	zetaHash, ballot, err := ob.ZetacoreClient().PostVoteInbound(zetacore.PostVoteInboundGasLimit, retryGasLimit, msg)
 	if err != nil {
-		ob.logger.Inbound.Err(err).
+		ob.Logger().Inbound.Err(err).
 			Msgf("inbound detected: error posting vote for chain %d token %s inbound %s", chainID, coinType, txHash)
 		return "", err
 	} else if zetaHash != "" {
-		ob.logger.Inbound.Info().Msgf("inbound detected: chain %d token %s inbound %s vote %s ballot %s", chainID, coinType, txHash, zetaHash, ballot)
+		ob.Logger().Inbound.Info().Msgf("inbound detected: chain %d token %s inbound %s vote %s ballot %s", chainID, coinType, txHash, zetaHash, ballot)
 	} else {
-		ob.logger.Inbound.Info().Msgf("inbound detected: chain %d token %s inbound %s already voted on ballot %s", chainID, coinType, txHash, ballot)
+		ob.Logger().Inbound.Info().Msgf("inbound detected: chain %d token %s inbound %s already voted on ballot %s", chainID, coinType, txHash, ballot)
 	}
 
 	return ballot, err
@@ -577,47 +701,56 @@ func (ob *Observer) HasEnoughConfirmations(receipt *ethtypes.Receipt, lastHeight
 }
 
 // BuildInboundVoteMsgForDepositedEvent builds a inbound vote message for a Deposited event
+// no message is returned for the restricted and donation inbounds, an error is returned if the screening fails
 func (ob *Observer) BuildInboundVoteMsgForDepositedEvent(
 	event *erc20custody.ERC20CustodyDeposited,
 	sender ethcommon.Address,
-) *types.MsgVoteInbound {
+) (*types.MsgVoteInbound, error) {
 	// compliance check
 	maybeReceiver := ""
 	parsedAddress, _, err := chains.ParseAddressAndData(hex.EncodeToString(event.Message))
 	if err == nil && parsedAddress != (ethcommon.Address{}) {
 		maybeReceiver = parsedAddress.Hex()
 	}
-	if config.ContainRestrictedAddress(sender.Hex(), clienttypes.BytesToEthHex(event.Recipient), maybeReceiver) {
+	restricted, err := compliance.ContainRestrictedAddress(
+		sender.Hex(),
+		clienttypes.BytesToEthHex(event.Recipient),
+		maybeReceiver,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if restricted {
 		compliance.PrintComplianceLog(
-			ob.logger.Inbound,
-			ob.logger.Compliance,
+			ob.Logger().Inbound,
+			ob.Logger().Compliance,
 			false,
-			ob.chain.ChainId,
+			ob.Chain().ChainId,
 			event.Raw.TxHash.Hex(),
 			sender.Hex(),
 			clienttypes.BytesToEthHex(event.Recipient),
 			"ERC20",
 		)
-		return nil
+		return nil, nil
 	}
 
 	// donation check
 	if bytes.Equal(event.Message, []byte(constant.DonationMessage)) {
-		ob.logger.Inbound.Info().
-			Msgf("thank you rich folk for your donation! tx %s chain %d", event.Raw.TxHash.Hex(), ob.chain.ChainId)
-		return nil
+		ob.Logger().Inbound.Info().
+			Msgf("thank you rich folk for your donation! tx %s chain %d", event.Raw.TxHash.Hex(), ob.Chain().ChainId)
+		return nil, nil
 	}
 	message := hex.EncodeToString(event.Message)
-	ob.logger.Inbound.Info().
+	ob.Logger().Inbound.Info().
 		Msgf("ERC20CustodyDeposited inbound detected on chain %d tx %s block %d from %s value %s message %s",
-			ob.chain.ChainId, event.Raw.TxHash.Hex(), event.Raw.BlockNumber, sender.Hex(), event.Amount.String(), message)
+			ob.Chain().ChainId, event.Raw.TxHash.Hex(), event.Raw.BlockNumber, sender.Hex(), event.Amount.String(), message)
 
 	return zetacore.GetInBoundVoteMessage(
 		sender.Hex(),
-		ob.chain.ChainId,
+		ob.Chain().ChainId,
 		"",
 		clienttypes.BytesToEthHex(event.Recipient),
-		ob.zetacoreClient.Chain().ChainId,
+		ob.ZetacoreClient().Chain().ChainId,
 		sdkmath.NewUintFromBigInt(event.Amount),
 		hex.EncodeToString(event.Message),
 		event.Raw.TxHash.Hex(),
@@ -625,51 +758,59 @@ func (ob *Observer) BuildInboundVoteMsgForDepositedEvent(
 		1_500_000,
 		coin.CoinType_ERC20,
 		event.Asset.String(),
-		ob.zetacoreClient.GetKeys().GetOperatorAddress().String(),
+		ob.ZetacoreClient().GetKeys().GetOperatorAddress().String(),
 		event.Raw.Index,
-	)
+	), nil
 }
 
 // BuildInboundVoteMsgForZetaSentEvent builds a inbound vote message for a ZetaSent event
+// no message is returned for the restricted and invalid inbounds, an error is returned if the screening fails
 func (ob *Observer) BuildInboundVoteMsgForZetaSentEvent(
 	event *zetaconnector.ZetaConnectorNonEthZetaSent,
-) *types.MsgVoteInbound {
-	destChain := chains.GetChainFromChainID(event.DestinationChainId.Int64())
+) (*types.MsgVoteInbound, error) {
+	destChain := chains.GetChainFromChainID(
+		event.DestinationChainId.Int64(),
+		ob.CoreContext().GetAdditionalChains(),
+	)
 	if destChain == nil {
-		ob.logger.Inbound.Warn().Msgf("chain id not supported  %d", event.DestinationChainId.Int64())
-		return nil
+		ob.Logger().Inbound.Warn().Msgf("chain id not supported  %d", event.DestinationChainId.Int64())
+		return nil, nil
 	}
 	destAddr := clienttypes.BytesToEthHex(event.DestinationAddress)
 
 	// compliance check
 	sender := event.ZetaTxSenderAddress.Hex()
-	if config.ContainRestrictedAddress(sender, destAddr, event.SourceTxOriginAddress.Hex()) {
-		compliance.PrintComplianceLog(ob.logger.Inbound, ob.logger.Compliance,
-			false, ob.chain.ChainId, event.Raw.TxHash.Hex(), sender, destAddr, "Zeta")
-		return nil
+	restricted, err := compliance.ContainRestrictedAddress(sender, destAddr, event.SourceTxOriginAddress.Hex())
+	if err != nil {
+		return nil, err
+	}
+	if restricted {
+		compliance.PrintComplianceLog(ob.Logger().Inbound, ob.Logger().Compliance,
+			false, ob.Chain().ChainId, event.Raw.TxHash.Hex(), sender, destAddr, "Zeta")
+		return nil, nil
 	}
 
 	if !destChain.IsZetaChain() {
-		paramsDest, found := ob.coreContext.GetEVMChainParams(destChain.ChainId)
+		paramsDest, found := ob.CoreContext().GetEVMChainParams(destChain.ChainId)
 		if !found {
-			ob.logger.Inbound.Warn().
+			ob.Logger().Inbound.Warn().
 				Msgf("chain id not present in EVMChainParams  %d", event.DestinationChainId.Int64())
-			return nil
+			return nil, nil
 		}
 
 		if strings.EqualFold(destAddr, paramsDest.ZetaTokenContractAddress) {
-			ob.logger.Inbound.Warn().
+			ob.Logger().Inbound.Warn().
 				Msgf("potential attack attempt: %s destination address is ZETA token contract address %s", destChain, destAddr)
-			return nil
+			return nil, nil
 		}
 	}
 	message := base64.StdEncoding.EncodeToString(event.Message)
-	ob.logger.Inbound.Info().Msgf("ZetaSent inbound detected on chain %d tx %s block %d from %s value %s message %s",
-		ob.chain.ChainId, event.Raw.TxHash.Hex(), event.Raw.BlockNumber, sender, event.ZetaValueAndGas.String(), message)
+	ob.Logger().Inbound.Info().Msgf("ZetaSent inbound detected on chain %d tx %s block %d from %s value %s message %s",
+		ob.Chain().ChainId, event.Raw.TxHash.Hex(), event.Raw.BlockNumber, sender, event.ZetaValueAndGas.String(), message)
 
 	return zetacore.GetInBoundVoteMessage(
 		sender,
-		ob.chain.ChainId,
+		ob.Chain().ChainId,
 		event.SourceTxOriginAddress.Hex(),
 		destAddr,
 		destChain.ChainId,
@@ -680,17 +821,18 @@ func (ob *Observer) BuildInboundVoteMsgForZetaSentEvent(
 		event.DestinationGasLimit.Uint64(),
 		coin.CoinType_Zeta,
 		"",
-		ob.zetacoreClient.GetKeys().GetOperatorAddress().String(),
+		ob.ZetacoreClient().GetKeys().GetOperatorAddress().String(),
 		event.Raw.Index,
-	)
+	), nil
 }
 
 // BuildInboundVoteMsgForTokenSentToTSS builds a inbound vote message for a token sent to TSS
+// no message is returned for the restricted and donation inbounds, an error is returned if the screening fails
 func (ob *Observer) BuildInboundVoteMsgForTokenSentToTSS(
 	tx *ethrpc.Transaction,
 	sender ethcommon.Address,
 	blockNumber uint64,
-) *types.MsgVoteInbound {
+) (*types.MsgVoteInbound, error) {
 	message := tx.Input
 
 	// compliance check
@@ -699,29 +841,33 @@ func (ob *Observer) BuildInboundVoteMsgForTokenSentToTSS(
 	if err == nil && parsedAddress != (ethcommon.Address{}) {
 		maybeReceiver = parsedAddress.Hex()
 	}
-	if config.ContainRestrictedAddress(sender.Hex(), maybeReceiver) {
-		compliance.PrintComplianceLog(ob.logger.Inbound, ob.logger.Compliance,
-			false, ob.chain.ChainId, tx.Hash, sender.Hex(), sender.Hex(), "Gas")
-		return nil
+	restricted, err := compliance.ContainRestrictedAddress(sender.Hex(), maybeReceiver)
+	if err != nil {
+		return nil, err
+	}
+	if restricted {
+		compliance.PrintComplianceLog(ob.Logger().Inbound, ob.Logger().Compliance,
+			false, ob.Chain().ChainId, tx.Hash, sender.Hex(), sender.Hex(), "Gas")
+		return nil, nil
 	}
 
 	// donation check
 	// #nosec G703 err is already checked
 	data, _ := hex.DecodeString(message)
 	if bytes.Equal(data, []byte(constant.DonationMessage)) {
-		ob.logger.Inbound.Info().
-			Msgf("thank you rich folk for your donation! tx %s chain %d", tx.Hash, ob.chain.ChainId)
-		return nil
+		ob.Logger().Inbound.Info().
+			Msgf("thank you rich folk for your donation! tx %s chain %d", tx.Hash, ob.Chain().ChainId)
+		return nil, nil
 	}
-	ob.logger.Inbound.Info().Msgf("TSS inbound detected on chain %d tx %s block %d from %s value %s message %s",
-		ob.chain.ChainId, tx.Hash, blockNumber, sender.Hex(), tx.Value.String(), message)
+	ob.Logger().Inbound.Info().Msgf("TSS inbound detected on chain %d tx %s block %d from %s value %s message %s",
+		ob.Chain().ChainId, tx.Hash, blockNumber, sender.Hex(), tx.Value.String(), message)
 
 	return zetacore.GetInBoundVoteMessage(
 		sender.Hex(),
-		ob.chain.ChainId,
+		ob.Chain().ChainId,
 		sender.Hex(),
 		sender.Hex(),
-		ob.zetacoreClient.Chain().ChainId,
+		ob.ZetacoreClient().Chain().ChainId,
 		sdkmath.NewUintFromBigInt(&tx.Value),
 		message,
 		tx.Hash,
@@ -729,33 +875,40 @@ func (ob *Observer) BuildInboundVoteMsgForTokenSentToTSS(
 		90_000,
 		coin.CoinType_Gas,
 		"",
-		ob.zetacoreClient.GetKeys().GetOperatorAddress().String(),
+		ob.ZetacoreClient().GetKeys().GetOperatorAddress().String(),
 		0, // not a smart contract call
-	)
+	), nil
 }
 
 // ObserveTSSReceiveInBlock queries the incoming gas asset to TSS address in a single block and posts votes
 func (ob *Observer) ObserveTSSReceiveInBlock(blockNumber uint64) error {
+	return ob.observeTSSReceiveInBlock(blockNumber, ob.PostVoteInbound)
+}
+
+// observeTSSReceiveInBlock queries the incoming gas asset to TSS address in a single block
+// and posts the votes with the given voter
+func (ob *Observer) observeTSSReceiveInBlock(blockNumber uint64, voter inboundVoter) error {
 	block, err := ob.GetBlockByNumberCached(blockNumber)
 	if err != nil {
-		return errors.Wrapf(err, "error getting block %d for chain %d", blockNumber, ob.chain.ChainId)
+		return errors.Wrapf(err, "error getting block %d for chain %d", blockNumber, ob.Chain().ChainId)
 	}
+	ob.reorgDetector.Record(blockNumber, block.Hash)
 
 	for i := range block.Transactions {
 		tx := block.Transactions[i]
-		if ethcommon.HexToAddress(tx.To) == ob.Tss.EVMAddress() {
+		if ethcommon.HexToAddress(tx.To) == ob.TSS().EVMAddress() {
 			receipt, err := ob.evmClient.TransactionReceipt(context.Background(), ethcommon.HexToHash(tx.Hash))
 			if err != nil {
-				return errors.Wrapf(err, "error getting receipt for inbound %s chain %d", tx.Hash, ob.chain.ChainId)
+				return errors.Wrapf(err, "error getting receipt for inbound %s chain %d", tx.Hash, ob.Chain().ChainId)
 			}
 
-			_, err = ob.CheckAndVoteInboundTokenGas(&tx, receipt, true)
+			_, err = ob.checkAndVoteInboundTokenGas(&tx, receipt, true, voter)
 			if err != nil {
 				return errors.Wrapf(
 					err,
 					"error checking and voting inbound gas asset for inbound %s chain %d",
 					tx.Hash,
-					ob.chain.ChainId,
+					ob.Chain().ChainId,
 				)
 			}
 		}
@@ -0,0 +1,379 @@
+package observer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/erc20custody.sol"
+	"github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/zetaconnector.non-eth.sol"
+
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	clientcontext "github.com/zeta-chain/zetacore/zetaclient/context"
+)
+
+const (
+	// SubscriptionDialTimeout is the timeout for dialing the websocket endpoint
+	SubscriptionDialTimeout = 10 * time.Second
+
+	// subscriptionBufferSize is the size of the channels receiving the new heads and logs
+	subscriptionBufferSize = 256
+)
+
+// SubscriptionDialer dials the websocket endpoint to subscribe to new heads and logs
+type SubscriptionDialer func(ctx context.Context, endpoint string) (interfaces.EVMSubscriptionClient, error)
+
+// DialSubscriptionClient dials the websocket endpoint with the go-ethereum client
+func DialSubscriptionClient(ctx context.Context, endpoint string) (interfaces.EVMSubscriptionClient, error) {
+	client, err := ethclient.DialContext(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// InboundLogBuffer buffers the inbound logs received from the subscription until their blocks are confirmed
+type InboundLogBuffer struct {
+	// coveredFrom is the first block whose logs are all delivered by the subscription, 0 until the first head
+	coveredFrom uint64
+	logs        map[uint64][]ethtypes.Log
+}
+
+// NewInboundLogBuffer creates a new empty inbound log buffer
+func NewInboundLogBuffer() *InboundLogBuffer {
+	return &InboundLogBuffer{
+		logs: make(map[uint64][]ethtypes.Log),
+	}
+}
+
+// AddHead records a new head, the blocks after the first head are covered by the subscription
+func (b *InboundLogBuffer) AddHead(blockNumber uint64) {
+	if b.coveredFrom == 0 {
+		b.coveredFrom = blockNumber + 1
+		b.Prune(blockNumber)
+	}
+}
+
+// AddLog buffers the log, or drops the buffered log if it is removed by a chain reorg
+func (b *InboundLogBuffer) AddLog(log ethtypes.Log) {
+	if log.Removed {
+		logs := b.logs[log.BlockNumber]
+		for i := range logs {
+			if logs[i].TxHash == log.TxHash && logs[i].Index == log.Index {
+				b.logs[log.BlockNumber] = append(logs[:i], logs[i+1:]...)
+				break
+			}
+		}
+		return
+	}
+
+	// the blocks before the first head are back-filled by polling
+	if b.coveredFrom > 0 && log.BlockNumber < b.coveredFrom {
+		return
+	}
+	b.logs[log.BlockNumber] = append(b.logs[log.BlockNumber], log)
+}
+
+// CoveredFrom returns the first block whose logs are all delivered by the subscription
+func (b *InboundLogBuffer) CoveredFrom() uint64 {
+	return b.coveredFrom
+}
+
+// Logs returns the buffered logs of the blocks in range [startBlock, toBlock]
+func (b *InboundLogBuffer) Logs(startBlock, toBlock uint64) []ethtypes.Log {
+	logs := make([]ethtypes.Log, 0)
+	for bn, blockLogs := range b.logs {
+		if bn >= startBlock && bn <= toBlock {
+			logs = append(logs, blockLogs...)
+		}
+	}
+	return logs
+}
+
+// Prune drops the buffered logs of the blocks up to 'lastScanned'
+func (b *InboundLogBuffer) Prune(lastScanned uint64) {
+	for bn := range b.logs {
+		if bn <= lastScanned {
+			delete(b.logs, bn)
+		}
+	}
+}
+
+// WatchInboundSubscription subscribes to the new heads and inbound logs on the websocket endpoint
+// and observes the inbound as soon as the blocks are confirmed
+// it returns when the subscription is interrupted, or nil when the observer is stopped
+func (ob *Observer) WatchInboundSubscription(sampledLogger zerolog.Logger) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dialCtx, dialCancel := context.WithTimeout(ctx, SubscriptionDialTimeout)
+	client, err := ob.wsDial(dialCtx, ob.wsEndpoint)
+	dialCancel()
+	if err != nil {
+		return errors.Wrapf(err, "error dialing websocket endpoint for chain %d", ob.Chain().ChainId)
+	}
+	defer client.Close()
+
+	// subscribe to the ZetaSent and Deposited logs of the connector and custody contracts
+	addrConnector := ethcommon.HexToAddress(ob.GetChainParams().ConnectorContractAddress)
+	addrCustody := ethcommon.HexToAddress(ob.GetChainParams().Erc20CustodyContractAddress)
+	topics, err := inboundEventTopics()
+	if err != nil {
+		return err
+	}
+	logCh := make(chan ethtypes.Log, subscriptionBufferSize)
+	logSub, err := client.SubscribeFilterLogs(ctx, ethereum.FilterQuery{
+		Addresses: []ethcommon.Address{addrConnector, addrCustody},
+		Topics:    [][]ethcommon.Hash{topics},
+	}, logCh)
+	if err != nil {
+		return errors.Wrapf(err, "error subscribing to inbound logs for chain %d", ob.Chain().ChainId)
+	}
+	defer logSub.Unsubscribe()
+
+	// subscribe to new heads after the logs, so that the logs of the blocks after the first head are all received
+	headCh := make(chan *ethtypes.Header, subscriptionBufferSize)
+	headSub, err := client.SubscribeNewHead(ctx, headCh)
+	if err != nil {
+		return errors.Wrapf(err, "error subscribing to new heads for chain %d", ob.Chain().ChainId)
+	}
+	defer headSub.Unsubscribe()
+
+	ob.Logger().Inbound.Info().Msgf("WatchInboundSubscription started for chain %d", ob.Chain().ChainId)
+	buffer := NewInboundLogBuffer()
+	for {
+		select {
+		case log := <-logCh:
+			buffer.AddLog(log)
+		case header := <-headCh:
+			blockNumber := header.Number.Uint64()
+			buffer.AddHead(blockNumber)
+			if !clientcontext.IsInboundObservationEnabled(ob.CoreContext(), ob.GetChainParams()) {
+				sampledLogger.Info().
+					Msgf("WatchInboundSubscription: inbound observation is disabled for chain %d", ob.Chain().ChainId)
+				continue
+			}
+
+			// re-subscribe if the contracts are updated in the chain params
+			if addrConnector != ethcommon.HexToAddress(ob.GetChainParams().ConnectorContractAddress) ||
+				addrCustody != ethcommon.HexToAddress(ob.GetChainParams().Erc20CustodyContractAddress) {
+				return fmt.Errorf("inbound contracts updated for chain %d, re-subscribing", ob.Chain().ChainId)
+			}
+
+			err := ob.ObserveInboundFromSubscription(buffer, blockNumber, sampledLogger)
+			if err != nil {
+				ob.Logger().Inbound.Err(err).Msg("WatchInboundSubscription: observeInbound error")
+			}
+		case err := <-logSub.Err():
+			return fmt.Errorf("inbound logs subscription interrupted for chain %d: %v", ob.Chain().ChainId, err)
+		case err := <-headSub.Err():
+			return fmt.Errorf("new heads subscription interrupted for chain %d: %v", ob.Chain().ChainId, err)
+		case <-ob.StopChannel():
+			ob.Logger().Inbound.Info().Msgf("WatchInboundSubscription stopped for chain %d", ob.Chain().ChainId)
+			return nil
+		}
+	}
+}
+
+// ObserveInboundFromSubscription observes the inbound in the blocks confirmed by the new head 'blockNumber'
+// the blocks covered by the subscription are scanned from the buffered logs, the blocks before are back-filled by polling
+func (ob *Observer) ObserveInboundFromSubscription(
+	buffer *InboundLogBuffer,
+	blockNumber uint64,
+	sampledLogger zerolog.Logger,
+) error {
+	// the websocket endpoint may lag behind the rpc endpoints, the last block height never decreases
+	if blockNumber > ob.GetLastBlockHeight() {
+		ob.SetLastBlockHeight(blockNumber)
+	}
+
+	// re-scan the blocks replaced by a chain reorg
+	if err := ob.CheckReorg(); err != nil {
+		return err
+	}
+
+	// skip if current height is too low
+	confirmationCount := ob.GetChainParams().ConfirmationCount
+	if blockNumber < confirmationCount || blockNumber == 0 {
+		return fmt.Errorf("observeInbound: skipping observer, current block number %d is too low", blockNumber)
+	}
+	confirmedBlockNum := blockNumber - confirmationCount
+
+	// the logs of the latest block may be received after its head, so it is scanned at the next head
+	if confirmedBlockNum == blockNumber {
+		confirmedBlockNum--
+	}
+
+	// scan the newly confirmed blocks, at most 100 blocks in one go
+	lastScanned := ob.GetLastBlockHeightScanned()
+	for lastScanned < confirmedBlockNum {
+		startBlock, toBlock := ob.calcBlockRangeToScan(confirmedBlockNum, lastScanned, config.MaxBlocksPerPeriod)
+
+		var lastScannedZetaSent, lastScannedDeposited uint64
+		if startBlock < buffer.CoveredFrom() {
+			// back-fill the blocks mined before the subscription by polling
+			if toBlock >= buffer.CoveredFrom() {
+				toBlock = buffer.CoveredFrom() - 1
+			}
+			lastScannedZetaSent = ob.ObserveZetaSent(startBlock, toBlock)
+			lastScannedDeposited = ob.ObserveERC20Deposited(startBlock, toBlock)
+		} else {
+			lastScannedZetaSent, lastScannedDeposited = ob.observeBufferedLogs(buffer, startBlock, toBlock)
+		}
+		lastScannedTssRecvd := ob.ObserverTSSReceive(startBlock, toBlock)
+
+		scanned := ob.updateLastBlockScanned(
+			lastScanned,
+			lastScannedZetaSent,
+			lastScannedDeposited,
+			lastScannedTssRecvd,
+			sampledLogger,
+		)
+		lastScanned = scanned
+		if scanned < toBlock {
+			// re-scan from the failed block at next head
+			break
+		}
+	}
+	buffer.Prune(lastScanned)
+
+	return nil
+}
+
+// observeBufferedLogs posts the buffered ZetaSent and Deposited events in range [startBlock, toBlock] to zetacore
+// returns the last block successfully scanned for ZetaSent and Deposited events
+func (ob *Observer) observeBufferedLogs(buffer *InboundLogBuffer, startBlock, toBlock uint64) (uint64, uint64) {
+	addrConnector, connector, err := ob.GetConnectorContract()
+	if err != nil {
+		ob.Logger().Inbound.Warn().Err(err).Msgf("observeBufferedLogs: GetConnectorContract error:")
+		return startBlock - 1, startBlock - 1
+	}
+	addrCustody, custody, err := ob.GetERC20CustodyContract()
+	if err != nil {
+		ob.Logger().Inbound.Warn().Err(err).Msgf("observeBufferedLogs: GetERC20CustodyContract error:")
+		return startBlock - 1, startBlock - 1
+	}
+
+	// the logs are received from a single websocket endpoint, confirm them against the rpc endpoints before voting
+	logs, confirmedTo := ob.confirmBufferedLogs(buffer.Logs(startBlock, toBlock), toBlock)
+	if confirmedTo < startBlock {
+		return startBlock - 1, startBlock - 1
+	}
+	toBlock = confirmedTo
+
+	// parse the buffered logs into the events emitted by the connector and custody contracts
+	zetaSentEvents := make([]*zetaconnector.ZetaConnectorNonEthZetaSent, 0)
+	depositedEvents := make([]*erc20custody.ERC20CustodyDeposited, 0)
+	for _, log := range logs {
+		if len(log.Topics) == 0 {
+			continue
+		}
+		switch log.Address {
+		case addrConnector:
+			event, err := connector.ParseZetaSent(log)
+			if err != nil {
+				ob.Logger().Inbound.Warn().Err(err).Msgf("observeBufferedLogs: error parsing ZetaSent in tx %s", log.TxHash)
+				continue
+			}
+			zetaSentEvents = append(zetaSentEvents, event)
+		case addrCustody:
+			event, err := custody.ParseDeposited(log)
+			if err != nil {
+				ob.Logger().Inbound.Warn().Err(err).Msgf("observeBufferedLogs: error parsing Deposited in tx %s", log.TxHash)
+				continue
+			}
+			depositedEvents = append(depositedEvents, event)
+		}
+	}
+
+	lastScannedZetaSent := ob.processZetaSentEvents(addrConnector, zetaSentEvents, toBlock, ob.PostVoteInbound)
+	lastScannedDeposited := ob.processDepositedEvents(addrCustody, depositedEvents, toBlock, ob.PostVoteInbound)
+	return lastScannedZetaSent, lastScannedDeposited
+}
+
+// confirmBufferedLogs re-fetches the receipts of the buffered logs through the rpc endpoints (cross-checked by quorum)
+// and keeps the logs found in the receipts, the logs not found are dropped
+// returns the confirmed logs and the last block confirmed, the block before the first receipt failed to fetch
+func (ob *Observer) confirmBufferedLogs(logs []ethtypes.Log, toBlock uint64) ([]ethtypes.Log, uint64) {
+	sort.SliceStable(logs, func(i, j int) bool {
+		return logs[i].BlockNumber < logs[j].BlockNumber
+	})
+
+	confirmed := make([]ethtypes.Log, 0, len(logs))
+	receipts := make(map[ethcommon.Hash]*ethtypes.Receipt)
+	for _, log := range logs {
+		receipt, found := receipts[log.TxHash]
+		if !found {
+			var err error
+			receipt, err = ob.evmClient.TransactionReceipt(context.Background(), log.TxHash)
+			if err != nil || receipt == nil {
+				ob.Logger().Inbound.Warn().Err(err).Msgf("confirmBufferedLogs: error getting receipt for tx %s", log.TxHash)
+				return filterLogsBefore(confirmed, log.BlockNumber), log.BlockNumber - 1
+			}
+			receipts[log.TxHash] = receipt
+		}
+
+		if !receiptContainsLog(receipt, log) {
+			ob.Logger().Inbound.Warn().
+				Msgf("confirmBufferedLogs: log %d of tx %s not found in receipt, dropped", log.Index, log.TxHash)
+			continue
+		}
+		confirmed = append(confirmed, log)
+	}
+	return confirmed, toBlock
+}
+
+// receiptContainsLog returns true if the receipt contains the log in the same block
+func receiptContainsLog(receipt *ethtypes.Receipt, log ethtypes.Log) bool {
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful || receipt.BlockHash != log.BlockHash {
+		return false
+	}
+	for _, l := range receipt.Logs {
+		if l == nil || l.Index != log.Index || l.Address != log.Address || !bytes.Equal(l.Data, log.Data) {
+			continue
+		}
+		if len(l.Topics) != len(log.Topics) {
+			return false
+		}
+		for i := range l.Topics {
+			if l.Topics[i] != log.Topics[i] {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// filterLogsBefore returns the logs of the blocks before 'blockNumber'
+func filterLogsBefore(logs []ethtypes.Log, blockNumber uint64) []ethtypes.Log {
+	filtered := make([]ethtypes.Log, 0, len(logs))
+	for _, log := range logs {
+		if log.BlockNumber < blockNumber {
+			filtered = append(filtered, log)
+		}
+	}
+	return filtered
+}
+
+// inboundEventTopics returns the signatures of the ZetaSent and Deposited events
+func inboundEventTopics() ([]ethcommon.Hash, error) {
+	connectorABI, err := zetaconnector.ZetaConnectorNonEthMetaData.GetAbi()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting connector abi")
+	}
+	custodyABI, err := erc20custody.ERC20CustodyMetaData.GetAbi()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting custody abi")
+	}
+	return []ethcommon.Hash{connectorABI.Events["ZetaSent"].ID, custodyABI.Events["Deposited"].ID}, nil
+}
@@ -0,0 +1,178 @@
+package observer_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/onrik/ethrpc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/pkg/coin"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/evm/observer"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/zetacore/zetaclient/keys"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
+)
+
+// dialMock returns a dialer connecting to the mock websocket client
+func dialMock(client *mocks.MockEvmSubscriptionClient) observer.SubscriptionDialer {
+	return func(_ context.Context, _ string) (interfaces.EVMSubscriptionClient, error) {
+		return client, nil
+	}
+}
+
+func Test_InboundLogBuffer(t *testing.T) {
+	newLog := func(blockNumber uint64) ethtypes.Log {
+		return ethtypes.Log{BlockNumber: blockNumber, TxHash: sample.Hash(), Index: 1}
+	}
+
+	t.Run("should drop the logs before the first head", func(t *testing.T) {
+		buffer := observer.NewInboundLogBuffer()
+		buffer.AddLog(newLog(100))
+		buffer.AddLog(newLog(101))
+		buffer.AddHead(100)
+		buffer.AddLog(newLog(100))
+		buffer.AddHead(101)
+
+		require.EqualValues(t, 101, buffer.CoveredFrom())
+		logs := buffer.Logs(0, 200)
+		require.Len(t, logs, 1)
+		require.EqualValues(t, 101, logs[0].BlockNumber)
+	})
+	t.Run("should drop the logs removed by a chain reorg", func(t *testing.T) {
+		buffer := observer.NewInboundLogBuffer()
+		buffer.AddHead(100)
+		log := newLog(101)
+		buffer.AddLog(log)
+		buffer.AddLog(newLog(101))
+
+		log.Removed = true
+		buffer.AddLog(log)
+		logs := buffer.Logs(101, 101)
+		require.Len(t, logs, 1)
+		require.NotEqual(t, log.TxHash, logs[0].TxHash)
+	})
+	t.Run("should prune the scanned blocks", func(t *testing.T) {
+		buffer := observer.NewInboundLogBuffer()
+		buffer.AddHead(100)
+		buffer.AddLog(newLog(101))
+		buffer.AddLog(newLog(102))
+		buffer.AddLog(newLog(103))
+
+		buffer.Prune(102)
+		require.Empty(t, buffer.Logs(101, 102))
+		require.Len(t, buffer.Logs(101, 103), 1)
+	})
+}
+
+func Test_WatchInboundSubscription(t *testing.T) {
+	// https://etherscan.io/tx/0xeaec67d5dd5d85f27b21bef83e01cbdf59154fd793ea7a22c297f7c3a722c532
+	chain := chains.Ethereum
+	chainID := chain.ChainId
+	confirmation := uint64(1)
+	chainParam := mocks.MockChainParams(chain.ChainId, confirmation)
+	inboundHash := "0xeaec67d5dd5d85f27b21bef83e01cbdf59154fd793ea7a22c297f7c3a722c532"
+
+	// load archived tx, receipt and block
+	_, receipt := testutils.LoadEVMInboundNReceipt(t, TestDataDir, chainID, inboundHash, coin.CoinType_Gas)
+	blockNumber := receipt.BlockNumber.Uint64()
+	block := testutils.LoadEVMBlock(t, TestDataDir, chainID, blockNumber, true)
+
+	// create mock clients
+	evmClient := mocks.NewMockEvmClient().WithHeader(&ethtypes.Header{Number: receipt.BlockNumber})
+	evmJSONRPC := mocks.NewMockJSONRPCClient()
+	zetacoreClient := mocks.NewMockZetacoreClient().WithKeys(&keys.Keys{})
+	tss := mocks.NewTSSMainnet()
+
+	t.Run("should return error if dialing fails", func(t *testing.T) {
+		ob := MockEVMObserver(t, chain, evmClient, evmJSONRPC, zetacoreClient, tss, blockNumber, chainParam)
+		ob.WithSubscriptionClient(
+			"ws://localhost:8546",
+			func(_ context.Context, _ string) (interfaces.EVMSubscriptionClient, error) {
+				return nil, errors.New("connection refused")
+			},
+		)
+		err := ob.WatchInboundSubscription(zerolog.Nop())
+		require.ErrorContains(t, err, "error dialing websocket endpoint")
+	})
+	t.Run("should return error if subscribing fails", func(t *testing.T) {
+		ob := MockEVMObserver(t, chain, evmClient, evmJSONRPC, zetacoreClient, tss, blockNumber, chainParam)
+		wsClient := mocks.NewMockEvmSubscriptionClient().WithError(errors.New("notifications not supported"))
+		ob.WithSubscriptionClient("ws://localhost:8546", dialMock(wsClient))
+
+		err := ob.WatchInboundSubscription(zerolog.Nop())
+		require.ErrorContains(t, err, "error subscribing to inbound logs")
+	})
+	t.Run("should back-fill the blocks before the subscription and return on interruption", func(t *testing.T) {
+		ob := MockEVMObserver(t, chain, evmClient, evmJSONRPC, zetacoreClient, tss, blockNumber, chainParam)
+		require.NoError(t, ob.LoadDB(t.TempDir(), chain))
+		ob.SetLastBlockHeightScanned(blockNumber - 1)
+		evmClient.WithReceipt(receipt)
+		evmJSONRPC.WithBlock(block).WithBlock(block)
+		wsClient := mocks.NewMockEvmSubscriptionClient()
+		ob.WithSubscriptionClient("ws://localhost:8546", dialMock(wsClient))
+
+		errCh := make(chan error)
+		go func() {
+			errCh <- ob.WatchInboundSubscription(zerolog.Nop())
+		}()
+
+		// the archived block is confirmed by the first head and scanned by polling
+		wsClient.PushHead(&ethtypes.Header{Number: new(big.Int).SetUint64(blockNumber + confirmation)})
+		require.Eventually(t, func() bool {
+			return ob.GetLastBlockHeightScanned() == blockNumber
+		}, 5*time.Second, 10*time.Millisecond)
+		require.EqualValues(t, blockNumber+confirmation, ob.GetLastBlockHeight())
+
+		wsClient.Interrupt(errors.New("websocket: close 1006"))
+		require.ErrorContains(t, <-errCh, "subscription interrupted")
+	})
+	t.Run("should not scan beyond the block failed to vote", func(t *testing.T) {
+		ob := MockEVMObserver(t, chain, evmClient, evmJSONRPC, zetacoreClient, tss, blockNumber, chainParam)
+		require.NoError(t, ob.LoadDB(t.TempDir(), chain))
+		ob.SetLastBlockHeightScanned(blockNumber - 1)
+
+		// the archived block is covered by the subscription but the vote fails
+		buffer := observer.NewInboundLogBuffer()
+		buffer.AddHead(blockNumber - 1)
+		buffer.AddLog(ethtypes.Log{Address: ethcommon.Address{}, BlockNumber: blockNumber})
+		evmClient.WithReceipts([]*ethtypes.Receipt{receipt, receipt})
+		zetacoreClient.Pause()
+		defer zetacoreClient.Unpause()
+
+		err := ob.ObserveInboundFromSubscription(buffer, blockNumber+confirmation+10, zerolog.Nop())
+		require.NoError(t, err)
+		require.EqualValues(t, blockNumber-1, ob.GetLastBlockHeightScanned())
+		require.Len(t, buffer.Logs(blockNumber, blockNumber), 1)
+	})
+	t.Run("should not scan beyond the block whose buffered logs fail to be confirmed", func(t *testing.T) {
+		// the archived block is followed by an empty block, both scanned in one go
+		evmJSONRPC := mocks.NewMockJSONRPCClient().
+			WithBlock(&ethrpc.Block{Number: int(blockNumber + 1)}).
+			WithBlock(block).
+			WithBlock(block)
+		ob := MockEVMObserver(t, chain, evmClient, evmJSONRPC, zetacoreClient, tss, blockNumber, chainParam)
+		require.NoError(t, ob.LoadDB(t.TempDir(), chain))
+		ob.SetLastBlockHeightScanned(blockNumber - 1)
+
+		// the receipt of the log in the empty block is not returned by the rpc endpoints
+		buffer := observer.NewInboundLogBuffer()
+		buffer.AddHead(blockNumber - 1)
+		buffer.AddLog(ethtypes.Log{TxHash: sample.Hash(), BlockNumber: blockNumber + 1})
+		evmClient.WithReceipts([]*ethtypes.Receipt{receipt, nil})
+
+		err := ob.ObserveInboundFromSubscription(buffer, blockNumber+1+confirmation+1, zerolog.Nop())
+		require.NoError(t, err)
+		require.EqualValues(t, blockNumber, ob.GetLastBlockHeightScanned())
+		require.Len(t, buffer.Logs(blockNumber+1, blockNumber+1), 1)
+	})
+}
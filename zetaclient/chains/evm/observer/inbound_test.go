@@ -2,17 +2,21 @@ package observer_test
 
 import (
 	"encoding/hex"
+	"errors"
 	"testing"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/onrik/ethrpc"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 
 	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/pkg/coin"
 	"github.com/zeta-chain/zetacore/pkg/constant"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/evm"
+	"github.com/zeta-chain/zetacore/zetaclient/compliance"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 	"github.com/zeta-chain/zetacore/zetaclient/keys"
 	"github.com/zeta-chain/zetacore/zetaclient/testutils"
@@ -239,6 +243,17 @@ func Test_CheckAndVoteInboundTokenGas(t *testing.T) {
 	})
 }
 
+// failingScreener fails to screen any address
+type failingScreener struct{}
+
+func (failingScreener) Name() string {
+	return "failing"
+}
+
+func (failingScreener) IsRestricted(string) (bool, error) {
+	return false, errors.New("screening service unavailable")
+}
+
 func Test_BuildInboundVoteMsgForZetaSentEvent(t *testing.T) {
 	// load archived ZetaSent receipt
 	// https://etherscan.io/tx/0xf3935200c80f98502d5edc7e871ffc40ca898e134525c42c2ae3cbc5725f9d76
@@ -259,7 +274,8 @@ func Test_BuildInboundVoteMsgForZetaSentEvent(t *testing.T) {
 	}
 
 	t.Run("should return vote msg for archived ZetaSent event", func(t *testing.T) {
-		msg := ob.BuildInboundVoteMsgForZetaSentEvent(event)
+		msg, err := ob.BuildInboundVoteMsgForZetaSentEvent(event)
+		require.NoError(t, err)
 		require.NotNil(t, msg)
 		require.Equal(t, cctx.InboundParams.BallotIndex, msg.Digest())
 	})
@@ -267,21 +283,31 @@ func Test_BuildInboundVoteMsgForZetaSentEvent(t *testing.T) {
 		sender := event.ZetaTxSenderAddress.Hex()
 		cfg.ComplianceConfig.RestrictedAddresses = []string{sender}
 		config.LoadComplianceConfig(cfg)
-		msg := ob.BuildInboundVoteMsgForZetaSentEvent(event)
+		msg, err := ob.BuildInboundVoteMsgForZetaSentEvent(event)
+		require.NoError(t, err)
 		require.Nil(t, msg)
 	})
 	t.Run("should return nil msg if receiver is restricted", func(t *testing.T) {
 		receiver := clienttypes.BytesToEthHex(event.DestinationAddress)
 		cfg.ComplianceConfig.RestrictedAddresses = []string{receiver}
 		config.LoadComplianceConfig(cfg)
-		msg := ob.BuildInboundVoteMsgForZetaSentEvent(event)
+		msg, err := ob.BuildInboundVoteMsgForZetaSentEvent(event)
+		require.NoError(t, err)
 		require.Nil(t, msg)
 	})
 	t.Run("should return nil msg if txOrigin is restricted", func(t *testing.T) {
 		txOrigin := event.SourceTxOriginAddress.Hex()
 		cfg.ComplianceConfig.RestrictedAddresses = []string{txOrigin}
 		config.LoadComplianceConfig(cfg)
-		msg := ob.BuildInboundVoteMsgForZetaSentEvent(event)
+		msg, err := ob.BuildInboundVoteMsgForZetaSentEvent(event)
+		require.NoError(t, err)
+		require.Nil(t, msg)
+	})
+	t.Run("should return error if the addresses fail to be screened", func(t *testing.T) {
+		defer compliance.SetScreener(compliance.StaticScreener{}, zerolog.Nop())
+		compliance.SetScreener(failingScreener{}, zerolog.Nop())
+		msg, err := ob.BuildInboundVoteMsgForZetaSentEvent(event)
+		require.ErrorContains(t, err, "screening service unavailable")
 		require.Nil(t, msg)
 	})
 }
@@ -307,26 +333,30 @@ func Test_BuildInboundVoteMsgForDepositedEvent(t *testing.T) {
 	}
 
 	t.Run("should return vote msg for archived Deposited event", func(t *testing.T) {
-		msg := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
+		msg, err := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
+		require.NoError(t, err)
 		require.NotNil(t, msg)
 		require.Equal(t, cctx.InboundParams.BallotIndex, msg.Digest())
 	})
 	t.Run("should return nil msg if sender is restricted", func(t *testing.T) {
 		cfg.ComplianceConfig.RestrictedAddresses = []string{sender.Hex()}
 		config.LoadComplianceConfig(cfg)
-		msg := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
+		msg, err := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
+		require.NoError(t, err)
 		require.Nil(t, msg)
 	})
 	t.Run("should return nil msg if receiver is restricted", func(t *testing.T) {
 		receiver := clienttypes.BytesToEthHex(event.Recipient)
 		cfg.ComplianceConfig.RestrictedAddresses = []string{receiver}
 		config.LoadComplianceConfig(cfg)
-		msg := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
+		msg, err := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
+		require.NoError(t, err)
 		require.Nil(t, msg)
 	})
 	t.Run("should return nil msg on donation transaction", func(t *testing.T) {
 		event.Message = []byte(constant.DonationMessage)
-		msg := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
+		msg, err := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
+		require.NoError(t, err)
 		require.Nil(t, msg)
 	})
 }
@@ -360,22 +390,24 @@ func Test_BuildInboundVoteMsgForTokenSentToTSS(t *testing.T) {
 	}
 
 	t.Run("should return vote msg for archived gas token transfer to TSS", func(t *testing.T) {
-		msg := ob.BuildInboundVoteMsgForTokenSentToTSS(
+		msg, err := ob.BuildInboundVoteMsgForTokenSentToTSS(
 			tx,
 			ethcommon.HexToAddress(tx.From),
 			receipt.BlockNumber.Uint64(),
 		)
+		require.NoError(t, err)
 		require.NotNil(t, msg)
 		require.Equal(t, cctx.InboundParams.BallotIndex, msg.Digest())
 	})
 	t.Run("should return nil msg if sender is restricted", func(t *testing.T) {
 		cfg.ComplianceConfig.RestrictedAddresses = []string{tx.From}
 		config.LoadComplianceConfig(cfg)
-		msg := ob.BuildInboundVoteMsgForTokenSentToTSS(
+		msg, err := ob.BuildInboundVoteMsgForTokenSentToTSS(
 			tx,
 			ethcommon.HexToAddress(tx.From),
 			receipt.BlockNumber.Uint64(),
 		)
+		require.NoError(t, err)
 		require.Nil(t, msg)
 	})
 	t.Run("should return nil msg if receiver is restricted", func(t *testing.T) {
@@ -385,16 +417,18 @@ func Test_BuildInboundVoteMsgForTokenSentToTSS(t *testing.T) {
 		txCopy.Input = message // use other address as receiver
 		cfg.ComplianceConfig.RestrictedAddresses = []string{testutils.OtherAddress1}
 		config.LoadComplianceConfig(cfg)
-		msg := ob.BuildInboundVoteMsgForTokenSentToTSS(
+		msg, err := ob.BuildInboundVoteMsgForTokenSentToTSS(
 			txCopy,
 			ethcommon.HexToAddress(txCopy.From),
 			receipt.BlockNumber.Uint64(),
 		)
+		require.NoError(t, err)
 		require.Nil(t, msg)
 	})
 	t.Run("should return nil msg on donation transaction", func(t *testing.T) {
-		msg := ob.BuildInboundVoteMsgForTokenSentToTSS(txDonation,
+		msg, err := ob.BuildInboundVoteMsgForTokenSentToTSS(txDonation,
 			ethcommon.HexToAddress(txDonation.From), receiptDonation.BlockNumber.Uint64())
+		require.NoError(t, err)
 		require.Nil(t, msg)
 	})
 }
@@ -426,8 +460,8 @@ func Test_ObserveTSSReceiveInBlock(t *testing.T) {
 	t.Run("should observe TSS receive in block", func(t *testing.T) {
 		ob := MockEVMObserver(t, chain, evmClient, evmJSONRPC, zetacoreClient, tss, lastBlock, chainParam)
 
-		// feed archived block and receipt
-		evmJSONRPC.WithBlock(block)
+		// feed archived block (scanned and checked canonical) and receipt
+		evmJSONRPC.WithBlock(block).WithBlock(block)
 		evmClient.WithReceipt(receipt)
 		err := ob.ObserveTSSReceiveInBlock(blockNumber)
 		require.NoError(t, err)
@@ -449,7 +483,7 @@ func Test_ObserveTSSReceiveInBlock(t *testing.T) {
 		ob := MockEVMObserver(t, chain, evmClient, evmJSONRPC, zetacoreClient, tss, lastBlock, chainParam)
 
 		// feed archived block and pause zetacore client
-		evmJSONRPC.WithBlock(block)
+		evmJSONRPC.WithBlock(block).WithBlock(block)
 		evmClient.WithReceipt(receipt)
 		zetacoreClient.Pause()
 		err := ob.ObserveTSSReceiveInBlock(blockNumber)
@@ -457,3 +491,88 @@ func Test_ObserveTSSReceiveInBlock(t *testing.T) {
 		require.ErrorContains(t, err, "error checking and voting")
 	})
 }
+
+func Test_RescanInbound(t *testing.T) {
+	// https://etherscan.io/tx/0xeaec67d5dd5d85f27b21bef83e01cbdf59154fd793ea7a22c297f7c3a722c532
+	chain := chains.Ethereum
+	chainID := chain.ChainId
+	confirmation := uint64(1)
+	chainParam := mocks.MockChainParams(chain.ChainId, confirmation)
+	inboundHash := "0xeaec67d5dd5d85f27b21bef83e01cbdf59154fd793ea7a22c297f7c3a722c532"
+
+	// load archived tx receipt and block
+	_, receipt := testutils.LoadEVMInboundNReceipt(t, TestDataDir, chainID, inboundHash, coin.CoinType_Gas)
+	blockNumber := receipt.BlockNumber.Uint64()
+	block := testutils.LoadEVMBlock(t, TestDataDir, chainID, blockNumber, true)
+
+	t.Run("should rescan confirmed block and vote on missing inbound", func(t *testing.T) {
+		evmClient := mocks.NewMockEvmClient().WithBlockNumber(blockNumber + confirmation)
+		evmJSONRPC := mocks.NewMockJSONRPCClient()
+		ob := MockEVMObserver(t, chain, evmClient, evmJSONRPC, nil, nil, blockNumber+confirmation, chainParam)
+		ob.SetLastBlockHeightScanned(blockNumber + 10)
+
+		// feed archived block (scanned and checked canonical) and receipt
+		evmJSONRPC.WithBlock(block).WithBlock(block)
+		evmClient.WithReceipt(receipt)
+		report, err := ob.RescanInbound(blockNumber, blockNumber, false)
+		require.NoError(t, err)
+		require.Len(t, report.Inbounds, 1)
+		require.Equal(t, inboundHash, report.Inbounds[0].InboundHash)
+		require.True(t, report.Inbounds[0].Voted)
+		require.Equal(t, 1, report.Missing)
+		require.Equal(t, 1, report.Voted)
+
+		// the last scanned block is left unchanged
+		require.Equal(t, blockNumber+10, ob.GetLastBlockHeightScanned())
+	})
+	t.Run("should only report missing inbound in dry-run", func(t *testing.T) {
+		evmClient := mocks.NewMockEvmClient().WithBlockNumber(blockNumber + confirmation)
+		evmJSONRPC := mocks.NewMockJSONRPCClient().WithBlock(block).WithBlock(block)
+		evmClient.WithReceipt(receipt)
+		ob := MockEVMObserver(t, chain, evmClient, evmJSONRPC, nil, nil, blockNumber+confirmation, chainParam)
+
+		report, err := ob.RescanInbound(blockNumber, blockNumber, true)
+		require.NoError(t, err)
+		require.True(t, report.DryRun)
+		require.Len(t, report.Inbounds, 1)
+		require.False(t, report.Inbounds[0].Voted)
+		require.Equal(t, 1, report.Missing)
+		require.Zero(t, report.Voted)
+	})
+	t.Run("should skip inbound having a cctx", func(t *testing.T) {
+		evmClient := mocks.NewMockEvmClient().WithBlockNumber(blockNumber + confirmation)
+		evmJSONRPC := mocks.NewMockJSONRPCClient().WithBlock(block).WithBlock(block)
+		evmClient.WithReceipt(receipt)
+		zetacoreClient := mocks.NewMockZetacoreClient().
+			WithKeys(&keys.Keys{}).
+			WithInboundCctx(inboundHash, crosschaintypes.CrossChainTx{Index: "0x01"})
+		ob := MockEVMObserver(t, chain, evmClient, evmJSONRPC, zetacoreClient, nil, blockNumber+confirmation, chainParam)
+
+		report, err := ob.RescanInbound(blockNumber, blockNumber, false)
+		require.NoError(t, err)
+		require.Len(t, report.Inbounds, 1)
+		require.Equal(t, []string{"0x01"}, report.Inbounds[0].CctxIndexes)
+		require.False(t, report.Inbounds[0].Voted)
+		require.Zero(t, report.Missing)
+	})
+	t.Run("should not rescan invalid block range", func(t *testing.T) {
+		ob := MockEVMObserver(t, chain, mocks.NewMockEvmClient(), nil, nil, nil, blockNumber, chainParam)
+		_, err := ob.RescanInbound(blockNumber+1, blockNumber, false)
+		require.ErrorContains(t, err, "invalid block range")
+		_, err = ob.RescanInbound(0, blockNumber, false)
+		require.ErrorContains(t, err, "invalid block range")
+	})
+	t.Run("should not rescan unconfirmed block", func(t *testing.T) {
+		evmClient := mocks.NewMockEvmClient().WithBlockNumber(blockNumber)
+		ob := MockEVMObserver(t, chain, evmClient, nil, nil, nil, blockNumber, chainParam)
+		_, err := ob.RescanInbound(blockNumber, blockNumber, false)
+		require.ErrorContains(t, err, "is not confirmed")
+	})
+	t.Run("should stop rescan on error", func(t *testing.T) {
+		evmClient := mocks.NewMockEvmClient().WithBlockNumber(blockNumber + confirmation)
+		ob := MockEVMObserver(t, chain, evmClient, mocks.NewMockJSONRPCClient(), nil, nil, blockNumber, chainParam)
+		// error getting block is expected because the mock JSONRPC contains no block
+		_, err := ob.RescanInbound(blockNumber, blockNumber, false)
+		require.ErrorContains(t, err, "error getting block")
+	})
+}
@@ -5,86 +5,59 @@ import (
 	"fmt"
 	"math"
 	"math/big"
-	"os"
-	"strconv"
 	"strings"
-	"sync"
-	"sync/atomic"
 	"time"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rlp"
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/onrik/ethrpc"
 	"github.com/pkg/errors"
-	"github.com/rs/zerolog"
 	"github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/erc20custody.sol"
 	"github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/zeta.non-eth.sol"
 	zetaconnectoreth "github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/zetaconnector.eth.sol"
 	"github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/zetaconnector.non-eth.sol"
-	"gorm.io/driver/sqlite"
-	"gorm.io/gorm"
 
 	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/pkg/proofs"
-	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/base"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/evm"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
 	clientcommon "github.com/zeta-chain/zetacore/zetaclient/common"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 	clientcontext "github.com/zeta-chain/zetacore/zetaclient/context"
 	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+	"github.com/zeta-chain/zetacore/zetaclient/reorg"
+	"github.com/zeta-chain/zetacore/zetaclient/rpcpool"
 	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
 )
 
-// Logger is the logger for evm chains
-// TODO: Merge this logger with the one in bitcoin
-// https://github.com/zeta-chain/node/issues/2022
-type Logger struct {
-	// Chain is the parent logger for the chain
-	Chain zerolog.Logger
-
-	// Inbound is the logger for incoming transactions
-	Inbound zerolog.Logger
-
-	// Outbound is the logger for outgoing transactions
-	Outbound zerolog.Logger
-
-	// GasPrice is the logger for gas prices
-	GasPrice zerolog.Logger
-
-	// Compliance is the logger for compliance checks
-	Compliance zerolog.Logger
-}
-
-var _ interfaces.ChainObserver = &Observer{}
+var (
+	_ interfaces.ChainObserver       = &Observer{}
+	_ interfaces.ChainObserverStatus = &Observer{}
+	_ interfaces.InboundRescanner    = &Observer{}
+	_ base.ChainPlugin               = &Observer{}
+)
 
 // Observer is the observer for evm chains
 type Observer struct {
-	Tss interfaces.TSSSigner
+	*base.Observer
 
-	Mu *sync.Mutex
-
-	chain                         chains.Chain
 	evmClient                     interfaces.EVMRPCClient
 	evmJSONRPC                    interfaces.EVMJSONRPCClient
-	zetacoreClient                interfaces.ZetacoreClient
-	lastBlockScanned              uint64
-	lastBlock                     uint64
-	db                            *gorm.DB
 	outboundPendingTransactions   map[string]*ethtypes.Transaction
 	outboundConfirmedReceipts     map[string]*ethtypes.Receipt
 	outboundConfirmedTransactions map[string]*ethtypes.Transaction
-	stop                          chan struct{}
-	logger                        Logger
-	coreContext                   *clientcontext.ZetacoreContext
-	chainParams                   observertypes.ChainParams
-	ts                            *metrics.TelemetryServer
 
-	blockCache  *lru.Cache
 	headerCache *lru.Cache
+
+	// reorgDetector tracks the hashes of the scanned blocks to detect chain reorgs
+	reorgDetector *reorg.Detector
+
+	// wsEndpoint is the optional websocket endpoint to subscribe to new heads and inbound logs
+	wsEndpoint string
+	wsDial     SubscriptionDialer
 }
 
 // NewObserver returns a new EVM chain observer
@@ -97,134 +70,90 @@ func NewObserver(
 	evmCfg config.EVMConfig,
 	ts *metrics.TelemetryServer,
 ) (*Observer, error) {
-	ob := Observer{
-		ts: ts,
-	}
-
-	chainLogger := loggers.Std.With().Str("chain", evmCfg.Chain.ChainName.String()).Logger()
-	ob.logger = Logger{
-		Chain:      chainLogger,
-		Inbound:    chainLogger.With().Str("module", "WatchInbound").Logger(),
-		Outbound:   chainLogger.With().Str("module", "WatchOutbound").Logger(),
-		GasPrice:   chainLogger.With().Str("module", "WatchGasPrice").Logger(),
-		Compliance: loggers.Compliance,
-	}
-
-	ob.coreContext = appContext.ZetacoreContext()
-	chainParams, found := ob.coreContext.GetEVMChainParams(evmCfg.Chain.ChainId)
+	coreContext := appContext.ZetacoreContext()
+	chainParams, found := coreContext.GetEVMChainParams(evmCfg.Chain.ChainId)
 	if !found {
 		return nil, fmt.Errorf("evm chains params not initialized for chain %d", evmCfg.Chain.ChainId)
 	}
 
-	ob.chainParams = *chainParams
-	ob.stop = make(chan struct{})
-	ob.chain = evmCfg.Chain
-	ob.Mu = &sync.Mutex{}
-	ob.zetacoreClient = zetacoreClient
-	ob.Tss = tss
-	ob.outboundPendingTransactions = make(map[string]*ethtypes.Transaction)
-	ob.outboundConfirmedReceipts = make(map[string]*ethtypes.Receipt)
-	ob.outboundConfirmedTransactions = make(map[string]*ethtypes.Transaction)
-
-	ob.logger.Chain.Info().Msgf("Chain %s endpoint %s", ob.chain.ChainName.String(), evmCfg.Endpoint)
-	client, err := ethclient.Dial(evmCfg.Endpoint)
+	baseObserver, err := base.NewObserver(
+		evmCfg.Chain,
+		*chainParams,
+		coreContext,
+		zetacoreClient,
+		tss,
+		base.DefaultBlockCacheSize,
+		ts,
+		loggers,
+	)
 	if err != nil {
-		ob.logger.Chain.Error().Err(err).Msg("eth Client Dial")
 		return nil, err
 	}
+	ob := NewObserverFromBase(baseObserver)
 
-	ob.evmClient = client
-	ob.evmJSONRPC = ethrpc.NewEthRPC(evmCfg.Endpoint)
-
-	// create block header and block caches
-	ob.blockCache, err = lru.New(1000)
+	ob.Logger().Chain.Info().Msgf("Chain %s endpoints %v", ob.Chain().ChainName.String(),
+		rpcpool.EndpointNames(evmCfg.GetEndpoints()))
+	client, err := evm.NewRPCClient(evmCfg, rpcpool.ModuleObserver, ob.Logger().Chain)
 	if err != nil {
-		ob.logger.Chain.Error().Err(err).Msg("failed to create block cache")
+		ob.Logger().Chain.Error().Err(err).Msg("eth Client Dial")
 		return nil, err
 	}
 
-	ob.headerCache, err = lru.New(1000)
+	ob.evmClient = client
+	ob.evmJSONRPC = client
+	ob.wsEndpoint = evmCfg.WSEndpoint
+	ob.wsDial = DialSubscriptionClient
+
+	// create block header cache
+	ob.headerCache, err = lru.New(base.DefaultBlockCacheSize)
 	if err != nil {
-		ob.logger.Chain.Error().Err(err).Msg("failed to create header cache")
+		ob.Logger().Chain.Error().Err(err).Msg("failed to create header cache")
 		return nil, err
 	}
 
-	err = ob.LoadDB(dbpath, ob.chain)
+	err = ob.LoadDB(dbpath, ob.Chain())
 	if err != nil {
 		return nil, err
 	}
 
-	ob.logger.Chain.Info().Msgf("%s: start scanning from block %d", ob.chain.String(), ob.GetLastBlockHeightScanned())
-
-	return &ob, nil
-}
+	ob.Logger().Chain.Info().Msgf("%s: start scanning from block %d", ob.Chain().ChainName.String(), ob.GetLastBlockHeightScanned())
 
-// WithChain attaches a new chain to the observer
-func (ob *Observer) WithChain(chain chains.Chain) {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
-	ob.chain = chain
+	return ob, nil
 }
 
-// WithLogger attaches a new logger to the observer
-func (ob *Observer) WithLogger(logger zerolog.Logger) {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
-	ob.logger = Logger{
-		Chain:    logger,
-		Inbound:  logger.With().Str("module", "WatchInbound").Logger(),
-		Outbound: logger.With().Str("module", "WatchOutbound").Logger(),
-		GasPrice: logger.With().Str("module", "WatchGasPrice").Logger(),
+// NewObserverFromBase returns an EVM chain observer built on the base observer, without RPC client
+func NewObserverFromBase(baseObserver *base.Observer) *Observer {
+	ob := &Observer{
+		Observer:                      baseObserver,
+		outboundPendingTransactions:   make(map[string]*ethtypes.Transaction),
+		outboundConfirmedReceipts:     make(map[string]*ethtypes.Receipt),
+		outboundConfirmedTransactions: make(map[string]*ethtypes.Transaction),
+		reorgDetector:                 reorg.NewDetector(baseObserver.Chain().ChainName.String(), reorg.DefaultWindow),
 	}
+	ob.WithPlugin(ob)
+	return ob
 }
 
 // WithEvmClient attaches a new evm client to the observer
 func (ob *Observer) WithEvmClient(client interfaces.EVMRPCClient) {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
 	ob.evmClient = client
 }
 
 // WithEvmJSONRPC attaches a new evm json rpc client to the observer
 func (ob *Observer) WithEvmJSONRPC(client interfaces.EVMJSONRPCClient) {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
 	ob.evmJSONRPC = client
 }
 
-// WithZetacoreClient attaches a new client to interact with zetacore to the observer
-func (ob *Observer) WithZetacoreClient(client interfaces.ZetacoreClient) {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
-	ob.zetacoreClient = client
-}
-
-// WithBlockCache attaches a new block cache to the observer
-func (ob *Observer) WithBlockCache(cache *lru.Cache) {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
-	ob.blockCache = cache
-}
-
-// Chain returns the chain for the observer
-func (ob *Observer) Chain() chains.Chain {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
-	return ob.chain
-}
-
-// SetChainParams sets the chain params for the observer
-func (ob *Observer) SetChainParams(params observertypes.ChainParams) {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
-	ob.chainParams = params
-}
-
-// GetChainParams returns the chain params for the observer
-func (ob *Observer) GetChainParams() observertypes.ChainParams {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
-	return ob.chainParams
+// WithSubscriptionClient attaches a websocket endpoint and its dialer to the observer
+func (ob *Observer) WithSubscriptionClient(endpoint string, dial SubscriptionDialer) {
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
+	ob.wsEndpoint = endpoint
+	ob.wsDial = dial
 }
 
 func (ob *Observer) GetConnectorContract() (ethcommon.Address, *zetaconnector.ZetaConnectorNonEth, error) {
@@ -282,97 +211,81 @@ func FetchERC20CustodyContract(
 // Start all observation routines for the evm chain
 func (ob *Observer) Start() {
 	// watch evm chain for incoming txs and post votes to zetacore
-	go ob.WatchInbound()
+	ob.Go(ob.WatchInbound)
 
 	// watch evm chain for outgoing txs status
-	go ob.WatchOutbound()
+	ob.Go(ob.WatchOutbound)
 
 	// watch evm chain for gas prices and post to zetacore
-	go ob.WatchGasPrice()
+	ob.Go(ob.WatchGasPrice)
 
 	// watch zetacore for inbound trackers
-	go ob.WatchInboundTracker()
+	ob.Go(ob.WatchInboundTracker)
 
 	// watch the RPC status of the evm chain
-	go ob.WatchRPCStatus()
-}
-
-// WatchRPCStatus watches the RPC status of the evm chain
-func (ob *Observer) WatchRPCStatus() {
-	ob.logger.Chain.Info().Msgf("Starting RPC status check for chain %s", ob.chain.String())
-	ticker := time.NewTicker(60 * time.Second)
-	for {
-		select {
-		case <-ticker.C:
-			if !ob.GetChainParams().IsSupported {
-				continue
-			}
-			bn, err := ob.evmClient.BlockNumber(context.Background())
-			if err != nil {
-				ob.logger.Chain.Error().Err(err).Msg("RPC Status Check error: RPC down?")
-				continue
-			}
-			gasPrice, err := ob.evmClient.SuggestGasPrice(context.Background())
-			if err != nil {
-				ob.logger.Chain.Error().Err(err).Msg("RPC Status Check error: RPC down?")
-				continue
-			}
-			header, err := ob.evmClient.HeaderByNumber(context.Background(), new(big.Int).SetUint64(bn))
-			if err != nil {
-				ob.logger.Chain.Error().Err(err).Msg("RPC Status Check error: RPC down?")
-				continue
-			}
-			// #nosec G701 always in range
-			blockTime := time.Unix(int64(header.Time), 0).UTC()
-			elapsedSeconds := time.Since(blockTime).Seconds()
-			if elapsedSeconds > 100 {
-				ob.logger.Chain.Warn().
-					Msgf("RPC Status Check warning: RPC stale or chain stuck (check explorer)? Latest block %d timestamp is %.0fs ago", bn, elapsedSeconds)
-				continue
-			}
-			ob.logger.Chain.Info().
-				Msgf("[OK] RPC status: latest block num %d, timestamp %s ( %.0fs ago), suggested gas price %d", header.Number, blockTime.String(), elapsedSeconds, gasPrice.Uint64())
-		case <-ob.stop:
-			return
-		}
-	}
+	ob.Go(ob.WatchRPCStatus)
 }
 
-func (ob *Observer) Stop() {
-	ob.logger.Chain.Info().Msgf("ob %s is stopping", ob.chain.String())
-	close(ob.stop) // this notifies all goroutines to stop
+// LatestBlockHeight returns the height of the latest block of the evm chain
+func (ob *Observer) LatestBlockHeight() (uint64, error) {
+	header, err := ob.evmClient.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return 0, err
+	}
+	return header.Number.Uint64(), nil
+}
 
-	ob.logger.Chain.Info().Msg("closing ob.db")
-	dbInst, err := ob.db.DB()
+// CheckRPCStatus returns an error if the RPC of the evm chain is down or stale
+func (ob *Observer) CheckRPCStatus() error {
+	ob.CheckRPCHealth(ob.evmClient)
+	bn, err := ob.evmClient.BlockNumber(context.Background())
 	if err != nil {
-		ob.logger.Chain.Info().Msg("error getting database instance")
+		return err
 	}
-	err = dbInst.Close()
+	gasPrice, err := ob.evmClient.SuggestGasPrice(context.Background())
 	if err != nil {
-		ob.logger.Chain.Error().Err(err).Msg("error closing database")
+		return err
 	}
+	header, err := ob.evmClient.HeaderByNumber(context.Background(), new(big.Int).SetUint64(bn))
+	if err != nil {
+		return err
+	}
+	// #nosec G701 always in range
+	blockTime := time.Unix(int64(header.Time), 0).UTC()
+	elapsedSeconds := time.Since(blockTime).Seconds()
+	if elapsedSeconds > 100 {
+		ob.Logger().Chain.Warn().
+			Msgf("RPC Status Check warning: RPC stale or chain stuck (check explorer)? Latest block %d timestamp is %.0fs ago", bn, elapsedSeconds)
+		return nil
+	}
+	ob.Logger().Chain.Info().
+		Msgf("[OK] RPC status: latest block num %d, timestamp %s ( %.0fs ago), suggested gas price %d", header.Number, blockTime.String(), elapsedSeconds, gasPrice.Uint64())
+	return nil
+}
 
-	ob.logger.Chain.Info().Msgf("%s observer stopped", ob.chain.String())
+// Status returns the status of the chain reported by the telemetry server
+func (ob *Observer) Status() metrics.ChainStatus {
+	return ob.ChainStatus(ob.evmClient)
 }
 
 // SetPendingTx sets the pending transaction in memory
 func (ob *Observer) SetPendingTx(nonce uint64, transaction *ethtypes.Transaction) {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
 	ob.outboundPendingTransactions[ob.GetTxID(nonce)] = transaction
 }
 
 // GetPendingTx gets the pending transaction from memory
 func (ob *Observer) GetPendingTx(nonce uint64) *ethtypes.Transaction {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
 	return ob.outboundPendingTransactions[ob.GetTxID(nonce)]
 }
 
 // SetTxNReceipt sets the receipt and transaction in memory
 func (ob *Observer) SetTxNReceipt(nonce uint64, receipt *ethtypes.Receipt, transaction *ethtypes.Transaction) {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
 	delete(ob.outboundPendingTransactions, ob.GetTxID(nonce)) // remove pending transaction, if any
 	ob.outboundConfirmedReceipts[ob.GetTxID(nonce)] = receipt
 	ob.outboundConfirmedTransactions[ob.GetTxID(nonce)] = transaction
@@ -380,8 +293,8 @@ func (ob *Observer) SetTxNReceipt(nonce uint64, receipt *ethtypes.Receipt, trans
 
 // GetTxNReceipt gets the receipt and transaction from memory
 func (ob *Observer) GetTxNReceipt(nonce uint64) (*ethtypes.Receipt, *ethtypes.Transaction) {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
 	receipt := ob.outboundConfirmedReceipts[ob.GetTxID(nonce)]
 	transaction := ob.outboundConfirmedTransactions[ob.GetTxID(nonce)]
 	return receipt, transaction
@@ -389,8 +302,8 @@ func (ob *Observer) GetTxNReceipt(nonce uint64) (*ethtypes.Receipt, *ethtypes.Tr
 
 // IsTxConfirmed returns true if there is a confirmed tx for 'nonce'
 func (ob *Observer) IsTxConfirmed(nonce uint64) bool {
-	ob.Mu.Lock()
-	defer ob.Mu.Unlock()
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
 	return ob.outboundConfirmedReceipts[ob.GetTxID(nonce)] != nil &&
 		ob.outboundConfirmedTransactions[ob.GetTxID(nonce)] != nil
 }
@@ -419,87 +332,43 @@ func (ob *Observer) CheckTxInclusion(tx *ethtypes.Transaction, receipt *ethtypes
 	return nil
 }
 
-// SetLastBlockHeightScanned set last block height scanned (not necessarily caught up with external block; could be slow/paused)
-func (ob *Observer) SetLastBlockHeightScanned(height uint64) {
-	atomic.StoreUint64(&ob.lastBlockScanned, height)
-	metrics.LastScannedBlockNumber.WithLabelValues(ob.chain.ChainName.String()).Set(float64(height))
-}
-
-// GetLastBlockHeightScanned get last block height scanned (not necessarily caught up with external block; could be slow/paused)
-func (ob *Observer) GetLastBlockHeightScanned() uint64 {
-	height := atomic.LoadUint64(&ob.lastBlockScanned)
-	return height
-}
-
-// SetLastBlockHeight set external last block height
-func (ob *Observer) SetLastBlockHeight(height uint64) {
-	atomic.StoreUint64(&ob.lastBlock, height)
-}
-
-// GetLastBlockHeight get external last block height
-func (ob *Observer) GetLastBlockHeight() uint64 {
-	return atomic.LoadUint64(&ob.lastBlock)
-}
-
-// WatchGasPrice watches evm chain for gas prices and post to zetacore
-func (ob *Observer) WatchGasPrice() {
-	// report gas price right away as the ticker takes time to kick in
-	err := ob.PostGasPrice()
-	if err != nil {
-		ob.logger.GasPrice.Error().Err(err).Msgf("PostGasPrice error for chain %d", ob.chain.ChainId)
+// EffectiveGasPrice returns the gas price paid by the transaction included at the block of the receipt
+// the gas price of a dynamic fee tx is the fee cap, the price paid is the base fee plus the effective tip
+func (ob *Observer) EffectiveGasPrice(receipt *ethtypes.Receipt, tx *ethtypes.Transaction) (*big.Int, error) {
+	if tx.Type() != ethtypes.DynamicFeeTxType {
+		return tx.GasPrice(), nil
 	}
-
-	// start gas price ticker
-	ticker, err := clienttypes.NewDynamicTicker(
-		fmt.Sprintf("EVM_WatchGasPrice_%d", ob.chain.ChainId),
-		ob.GetChainParams().GasPriceTicker,
-	)
+	header, err := ob.GetBlockHeaderCached(receipt.BlockNumber.Uint64())
 	if err != nil {
-		ob.logger.GasPrice.Error().Err(err).Msg("NewDynamicTicker error")
-		return
-	}
-	ob.logger.GasPrice.Info().Msgf("WatchGasPrice started for chain %d with interval %d",
-		ob.chain.ChainId, ob.GetChainParams().GasPriceTicker)
-
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C():
-			if !ob.GetChainParams().IsSupported {
-				continue
-			}
-			err = ob.PostGasPrice()
-			if err != nil {
-				ob.logger.GasPrice.Error().Err(err).Msgf("PostGasPrice error for chain %d", ob.chain.ChainId)
-			}
-			ticker.UpdateInterval(ob.GetChainParams().GasPriceTicker, ob.logger.GasPrice)
-		case <-ob.stop:
-			ob.logger.GasPrice.Info().Msg("WatchGasPrice stopped")
-			return
-		}
+		return nil, errors.Wrapf(err, "error getting block header %d", receipt.BlockNumber.Uint64())
+	}
+	if header.BaseFee == nil {
+		return tx.GasPrice(), nil
 	}
+	return new(big.Int).Add(header.BaseFee, tx.EffectiveGasTipValue(header.BaseFee)), nil
 }
 
+// PostGasPrice posts the gas price of the evm chain to zetacore
 func (ob *Observer) PostGasPrice() error {
 
 	// GAS PRICE
 	gasPrice, err := ob.evmClient.SuggestGasPrice(context.TODO())
 	if err != nil {
-		ob.logger.GasPrice.Err(err).Msg("Err SuggestGasPrice:")
+		ob.Logger().GasPrice.Err(err).Msg("Err SuggestGasPrice:")
 		return err
 	}
 	blockNum, err := ob.evmClient.BlockNumber(context.TODO())
 	if err != nil {
-		ob.logger.GasPrice.Err(err).Msg("Err Fetching Most recent Block : ")
+		ob.Logger().GasPrice.Err(err).Msg("Err Fetching Most recent Block : ")
 		return err
 	}
 
 	// SUPPLY
 	supply := "100" // lockedAmount on ETH, totalSupply on other chains
 
-	zetaHash, err := ob.zetacoreClient.PostGasPrice(ob.chain, gasPrice.Uint64(), supply, blockNum)
+	zetaHash, err := ob.ZetacoreClient().PostGasPrice(ob.Chain(), gasPrice.Uint64(), supply, blockNum)
 	if err != nil {
-		ob.logger.GasPrice.Err(err).Msg("PostGasPrice to zetacore failed")
+		ob.Logger().GasPrice.Err(err).Msg("PostGasPrice to zetacore failed")
 		return err
 	}
 	_ = zetaHash
@@ -535,7 +404,7 @@ func (ob *Observer) GetBlockHeaderCached(blockNumber uint64) (*ethtypes.Header,
 // GetBlockByNumberCached get block by number from cache
 // returns block, ethrpc.Block, isFallback, isSkip, error
 func (ob *Observer) GetBlockByNumberCached(blockNumber uint64) (*ethrpc.Block, error) {
-	if block, ok := ob.blockCache.Get(blockNumber); ok {
+	if block, ok := ob.BlockCache().Get(blockNumber); ok {
 		return block.(*ethrpc.Block), nil
 	}
 	if blockNumber > math.MaxInt32 {
@@ -546,13 +415,70 @@ func (ob *Observer) GetBlockByNumberCached(blockNumber uint64) (*ethrpc.Block, e
 	if err != nil {
 		return nil, err
 	}
-	ob.blockCache.Add(blockNumber, block)
+	ob.BlockCache().Add(blockNumber, block)
 	return block, nil
 }
 
 // RemoveCachedBlock remove block from cache
 func (ob *Observer) RemoveCachedBlock(blockNumber uint64) {
-	ob.blockCache.Remove(blockNumber)
+	ob.BlockCache().Remove(blockNumber)
+}
+
+// CheckReorg detects the scanned blocks replaced by a chain reorg and rewinds the inbound scanning to the fork height
+func (ob *Observer) CheckReorg() error {
+	forkHeight, found, err := ob.reorgDetector.Detect(func(height uint64) (string, error) {
+		if height > math.MaxInt32 {
+			return "", fmt.Errorf("block number %d is too large", height)
+		}
+		// #nosec G701 always in range, checked above
+		block, err := ob.evmJSONRPC.EthGetBlockByNumber(int(height), false)
+		if err != nil {
+			return "", err
+		}
+		if block == nil {
+			return "", fmt.Errorf("block %d not found", height)
+		}
+		return block.Hash, nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error checking reorg for chain %d", ob.Chain().ChainId)
+	}
+	if !found {
+		return nil
+	}
+
+	// drop the replaced blocks from the caches
+	for height := forkHeight; height <= ob.GetLastBlockHeight(); height++ {
+		ob.RemoveCachedBlock(height)
+		ob.headerCache.Remove(height)
+	}
+
+	// re-scan the replaced blocks
+	lastScanned := ob.GetLastBlockHeightScanned()
+	ob.Logger().Inbound.Error().
+		Msgf("CheckReorg: chain %d reorg detected from block %d, re-scanning from last scanned block %d",
+			ob.Chain().ChainId, forkHeight, lastScanned)
+	if lastScanned >= forkHeight {
+		ob.SaveLastBlockScanned(forkHeight - 1)
+	}
+	return nil
+}
+
+// CheckBlockCanonical returns an error if the block of an inbound is no longer in the canonical chain
+// the block is queried without the cache, which may hold the block replaced by the reorg
+func (ob *Observer) CheckBlockCanonical(blockNumber uint64, blockHash ethcommon.Hash) error {
+	if blockNumber > math.MaxInt32 {
+		return fmt.Errorf("block number %d is too large", blockNumber)
+	}
+	// #nosec G701 always in range, checked above
+	block, err := ob.evmJSONRPC.EthGetBlockByNumber(int(blockNumber), false)
+	if err != nil {
+		return errors.Wrapf(err, "error getting block %d for chain %d", blockNumber, ob.Chain().ChainId)
+	}
+	if block == nil {
+		return fmt.Errorf("block %d not found for chain %d", blockNumber, ob.Chain().ChainId)
+	}
+	return ob.reorgDetector.CheckCanonical(blockNumber, blockHash.Hex(), block.Hash)
 }
 
 // BlockByNumber query block by number via JSON-RPC
@@ -570,92 +496,30 @@ func (ob *Observer) BlockByNumber(blockNumber int) (*ethrpc.Block, error) {
 	return block, nil
 }
 
-// LoadLastScannedBlock loads last scanned block from specified height or from database
-// The last scanned block is the height from which the observer should continue scanning for inbound transactions
-func (ob *Observer) LoadLastScannedBlock() error {
-	// get environment variable
-	envvar := ob.chain.ChainName.String() + "_SCAN_FROM"
-	scanFromBlock := os.Getenv(envvar)
-
-	// load from environment variable if set
-	if scanFromBlock != "" {
-		ob.logger.Chain.Info().
-			Msgf("LoadLastScannedBlock: envvar %s is set; scan from  block %s", envvar, scanFromBlock)
-		if scanFromBlock == clienttypes.EnvVarLatest {
-			header, err := ob.evmClient.HeaderByNumber(context.Background(), nil)
-			if err != nil {
-				return err
-			}
-			ob.SetLastBlockHeightScanned(header.Number.Uint64())
-		} else {
-			scanFromBlockInt, err := strconv.ParseUint(scanFromBlock, 10, 64)
-			if err != nil {
-				return err
-			}
-			ob.SetLastBlockHeightScanned(scanFromBlockInt)
-		}
-	} else {
-		// load from DB otherwise
-		var lastBlock clienttypes.LastBlockSQLType
-		if err := ob.db.First(&lastBlock, clienttypes.LastBlockNumID).Error; err != nil {
-			ob.logger.Chain.Info().Msg("LoadLastScannedBlock: last scanned block not found in DB, scan from latest")
-			header, err := ob.evmClient.HeaderByNumber(context.Background(), nil)
-			if err != nil {
-				return err
-			}
-			ob.SetLastBlockHeightScanned(header.Number.Uint64())
-			if dbc := ob.db.Save(clienttypes.ToLastBlockSQLType(ob.GetLastBlockHeightScanned())); dbc.Error != nil {
-				ob.logger.Chain.Error().Err(dbc.Error).Msgf("LoadLastScannedBlock: error writing last scanned block %d to DB", ob.GetLastBlockHeightScanned())
-			}
-		} else {
-			ob.SetLastBlockHeightScanned(lastBlock.Num)
-		}
-	}
-	ob.logger.Chain.Info().
-		Msgf("LoadLastScannedBlock: chain %d starts scanning from block %d", ob.chain.ChainId, ob.GetLastBlockHeightScanned())
-
-	return nil
-}
-
 // LoadDB open sql database and load data into EVM observer
 func (ob *Observer) LoadDB(dbPath string, chain chains.Chain) error {
-	if dbPath != "" {
-		if _, err := os.Stat(dbPath); os.IsNotExist(err) {
-			err := os.MkdirAll(dbPath, os.ModePerm)
-			if err != nil {
-				return err
-			}
-		}
-		path := fmt.Sprintf("%s/%s", dbPath, chain.ChainName.String()) //Use "file::memory:?cache=shared" for temp db
-		db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
-		if err != nil {
-			ob.logger.Chain.Error().
-				Err(err).
-				Msgf("failed to open observer database for %s", ob.chain.ChainName.String())
-			return err
-		}
-
-		err = db.AutoMigrate(&clienttypes.ReceiptSQLType{},
-			&clienttypes.TransactionSQLType{},
-			&clienttypes.LastBlockSQLType{})
-		if err != nil {
-			ob.logger.Chain.Error().Err(err).Msg("error migrating db")
-			return err
-		}
+	if dbPath == "" {
+		return nil
+	}
+	err := ob.OpenDB(dbPath, chain.ChainName.String(),
+		&clienttypes.ReceiptSQLType{},
+		&clienttypes.TransactionSQLType{},
+		&clienttypes.SignedOutboundSQLType{})
+	if err != nil {
+		return err
+	}
 
-		ob.db = db
-		err = ob.LoadLastScannedBlock()
-		if err != nil {
-			return err
-		}
+	err = ob.LoadLastScannedBlock()
+	if err != nil {
+		return err
 	}
-	return nil
+	return ob.LoadSignedOutbounds()
 }
 
 func (ob *Observer) postBlockHeader(tip uint64) error {
 	bn := tip
 
-	res, err := ob.zetacoreClient.GetBlockHeaderChainState(ob.chain.ChainId)
+	res, err := ob.ZetacoreClient().GetBlockHeaderChainState(ob.Chain().ChainId)
 	if err == nil && res.ChainState != nil && res.ChainState.EarliestHeight > 0 {
 		// #nosec G701 always positive
 		bn = uint64(res.ChainState.LatestHeight) + 1 // the next header to post
@@ -667,23 +531,23 @@ func (ob *Observer) postBlockHeader(tip uint64) error {
 
 	header, err := ob.GetBlockHeaderCached(bn)
 	if err != nil {
-		ob.logger.Inbound.Error().Err(err).Msgf("postBlockHeader: error getting block: %d", bn)
+		ob.Logger().Inbound.Error().Err(err).Msgf("postBlockHeader: error getting block: %d", bn)
 		return err
 	}
 	headerRLP, err := rlp.EncodeToBytes(header)
 	if err != nil {
-		ob.logger.Inbound.Error().Err(err).Msgf("postBlockHeader: error encoding block header: %d", bn)
+		ob.Logger().Inbound.Error().Err(err).Msgf("postBlockHeader: error encoding block header: %d", bn)
 		return err
 	}
 
-	_, err = ob.zetacoreClient.PostVoteBlockHeader(
-		ob.chain.ChainId,
+	_, err = ob.ZetacoreClient().PostVoteBlockHeader(
+		ob.Chain().ChainId,
 		header.Hash().Bytes(),
 		header.Number.Int64(),
 		proofs.NewEthereumHeader(headerRLP),
 	)
 	if err != nil {
-		ob.logger.Inbound.Error().Err(err).Msgf("postBlockHeader: error posting block header: %d", bn)
+		ob.Logger().Inbound.Error().Err(err).Msgf("postBlockHeader: error posting block header: %d", bn)
 		return err
 	}
 	return nil
@@ -1,10 +1,10 @@
 package observer_test
 
 import (
-	"sync"
 	"testing"
 
 	"cosmossdk.io/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/onrik/ethrpc"
 	"github.com/rs/zerolog"
@@ -15,12 +15,14 @@ import (
 	"github.com/zeta-chain/zetacore/testutil/sample"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/base"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/evm/observer"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/zetacore/zetaclient/common"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 	"github.com/zeta-chain/zetacore/zetaclient/context"
 	"github.com/zeta-chain/zetacore/zetaclient/keys"
+	"github.com/zeta-chain/zetacore/zetaclient/reorg"
 	"github.com/zeta-chain/zetacore/zetaclient/testutils"
 	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
 )
@@ -48,8 +50,10 @@ func getAppContext(
 	coreCtx.Update(
 		&observertypes.Keygen{},
 		[]chains.Chain{evmChain},
+		[]chains.Chain{},
 		evmChainParamsMap,
 		nil,
+		nil,
 		"",
 		*sample.CrosschainFlags(),
 		sample.HeaderSupportedChains(),
@@ -92,11 +96,27 @@ func MockEVMObserver(
 	return client
 }
 
+// newObserverWithoutRPC creates an observer of Ethereum without RPC client
+func newObserverWithoutRPC(t *testing.T) *observer.Observer {
+	baseObserver, err := base.NewObserver(
+		chains.Ethereum,
+		observertypes.ChainParams{},
+		nil,
+		nil,
+		nil,
+		base.DefaultBlockCacheSize,
+		nil,
+		common.ClientLogger{},
+	)
+	require.NoError(t, err)
+	return observer.NewObserverFromBase(baseObserver)
+}
+
 func Test_BlockCache(t *testing.T) {
 	// create client
 	blockCache, err := lru.New(1000)
 	require.NoError(t, err)
-	ob := &observer.Observer{Mu: &sync.Mutex{}}
+	ob := newObserverWithoutRPC(t)
 	ob.WithBlockCache(blockCache)
 
 	// delete non-existing block should not panic
@@ -135,7 +155,7 @@ func Test_CheckTxInclusion(t *testing.T) {
 	// create client
 	blockCache, err := lru.New(1000)
 	require.NoError(t, err)
-	ob := &observer.Observer{Mu: &sync.Mutex{}}
+	ob := newObserverWithoutRPC(t)
 
 	// save block to cache
 	blockCache.Add(blockNumber, block)
@@ -199,3 +219,77 @@ func Test_VoteOutboundBallot(t *testing.T) {
 		require.Equal(t, ballotExpected, msg.Digest())
 	})
 }
+
+func Test_CheckReorg(t *testing.T) {
+	// https://etherscan.io/tx/0xeaec67d5dd5d85f27b21bef83e01cbdf59154fd793ea7a22c297f7c3a722c532
+	chain := chains.Ethereum
+	chainID := chain.ChainId
+	confirmation := uint64(1)
+	chainParam := mocks.MockChainParams(chain.ChainId, confirmation)
+	inboundHash := "0xeaec67d5dd5d85f27b21bef83e01cbdf59154fd793ea7a22c297f7c3a722c532"
+
+	// load archived tx, receipt and block
+	tx, receipt := testutils.LoadEVMInboundNReceipt(t, TestDataDir, chainID, inboundHash, coin.CoinType_Gas)
+	blockNumber := receipt.BlockNumber.Uint64()
+	block := testutils.LoadEVMBlock(t, TestDataDir, chainID, blockNumber, true)
+
+	// the block replacing the archived block after a reorg
+	forkedBlock := *block
+	forkedBlock.Hash = sample.Hash().Hex()
+
+	// newObserver creates an observer that has scanned the archived block
+	newObserver := func(t *testing.T) (*observer.Observer, *mocks.MockJSONRPCClient) {
+		evmClient := mocks.NewMockEvmClient().WithReceipt(receipt).WithHeader(&ethtypes.Header{Number: receipt.BlockNumber})
+		evmJSONRPC := mocks.NewMockJSONRPCClient().WithBlock(block).WithBlock(block)
+		ob := MockEVMObserver(t, chain, evmClient, evmJSONRPC, nil, nil, blockNumber+confirmation, chainParam)
+		require.NoError(t, ob.LoadDB(t.TempDir(), chain))
+		require.NoError(t, ob.ObserveTSSReceiveInBlock(blockNumber))
+		ob.SetLastBlockHeightScanned(blockNumber)
+		return ob, evmJSONRPC
+	}
+
+	t.Run("should not rewind if the scanned block is canonical", func(t *testing.T) {
+		ob, evmJSONRPC := newObserver(t)
+		evmJSONRPC.WithBlock(block)
+		require.NoError(t, ob.CheckReorg())
+		require.EqualValues(t, blockNumber, ob.GetLastBlockHeightScanned())
+	})
+	t.Run("should rewind to the block replaced by a reorg", func(t *testing.T) {
+		ob, evmJSONRPC := newObserver(t)
+		evmJSONRPC.WithBlock(&forkedBlock)
+		require.NoError(t, ob.CheckReorg())
+		require.EqualValues(t, blockNumber-1, ob.GetLastBlockHeightScanned())
+
+		// the replaced block is evicted from the cache
+		evmJSONRPC.WithBlock(&forkedBlock)
+		cached, err := ob.GetBlockByNumberCached(blockNumber)
+		require.NoError(t, err)
+		require.Equal(t, forkedBlock.Hash, cached.Hash)
+	})
+	t.Run("should refuse to vote on inbound whose block is not canonical", func(t *testing.T) {
+		ob, evmJSONRPC := newObserver(t)
+		evmJSONRPC.WithBlock(&forkedBlock)
+		require.NoError(t, ob.CheckReorg())
+
+		evmJSONRPC.WithBlock(&forkedBlock)
+		_, err := ob.CheckAndVoteInboundTokenGas(tx, receipt, true)
+		require.ErrorIs(t, err, reorg.ErrNotCanonical)
+	})
+	t.Run("should return error if the canonical block can't be queried", func(t *testing.T) {
+		ob, _ := newObserver(t)
+		require.ErrorContains(t, ob.CheckReorg(), "error checking reorg")
+	})
+	t.Run("should return error if the canonical block is not found", func(t *testing.T) {
+		ob, evmJSONRPC := newObserver(t)
+		evmJSONRPC.WithBlock(nil)
+		require.ErrorContains(t, ob.CheckReorg(), "not found")
+	})
+	t.Run("should not use the cached block to check the inbound block", func(t *testing.T) {
+		ob, evmJSONRPC := newObserver(t)
+
+		// the archived block is still cached while the canonical chain is reorganized
+		evmJSONRPC.WithBlock(&forkedBlock)
+		_, err := ob.CheckAndVoteInboundTokenGas(tx, receipt, true)
+		require.ErrorIs(t, err, reorg.ErrNotCanonical)
+	})
+}
@@ -0,0 +1,104 @@
+package observer
+
+import (
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
+)
+
+// SaveSignedOutbound journals the outbound signed by TSS so it survives a restart of zetaclient
+func (ob *Observer) SaveSignedOutbound(outboundID string, transaction *ethtypes.Transaction) error {
+	ob.SetPendingTx(transaction.Nonce(), transaction)
+	if ob.DB() == nil {
+		return nil
+	}
+
+	entry, err := clienttypes.ToSignedOutboundSQLType(outboundID, transaction)
+	if err != nil {
+		return errors.Wrapf(err, "error encoding signed outbound %s", outboundID)
+	}
+	err = ob.DB().Where(clienttypes.SignedOutboundSQLType{Identifier: outboundID}).
+		Assign(clienttypes.SignedOutboundSQLType{
+			Nonce:    entry.Nonce,
+			TxHash:   entry.TxHash,
+			RawTx:    entry.RawTx,
+			GasPrice: entry.GasPrice,
+		}).
+		FirstOrCreate(&clienttypes.SignedOutboundSQLType{}).Error
+	if err != nil {
+		return errors.Wrapf(err, "error journaling signed outbound %s", outboundID)
+	}
+	return nil
+}
+
+// GetSignedOutbound returns the journaled outbound signed by TSS and the number of times it was broadcast
+func (ob *Observer) GetSignedOutbound(outboundID string) (*ethtypes.Transaction, uint64, bool) {
+	if ob.DB() == nil {
+		return nil, 0, false
+	}
+
+	var entry clienttypes.SignedOutboundSQLType
+	if err := ob.DB().Where("identifier = ?", outboundID).First(&entry).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			ob.Logger().Outbound.Error().Err(err).Msgf("GetSignedOutbound: error reading signed outbound %s", outboundID)
+		}
+		return nil, 0, false
+	}
+	transaction, err := clienttypes.FromSignedOutboundSQLType(entry)
+	if err != nil {
+		ob.Logger().Outbound.Error().Err(err).Msgf("GetSignedOutbound: error decoding signed outbound %s", outboundID)
+		return nil, 0, false
+	}
+	return transaction, entry.BroadcastAttempts, true
+}
+
+// RecordBroadcastAttempt increments the number of times the journaled outbound was broadcast
+func (ob *Observer) RecordBroadcastAttempt(outboundID string) error {
+	if ob.DB() == nil {
+		return nil
+	}
+
+	err := ob.DB().Model(&clienttypes.SignedOutboundSQLType{}).
+		Where("identifier = ?", outboundID).
+		Update("broadcast_attempts", gorm.Expr("broadcast_attempts + ?", 1)).Error
+	if err != nil {
+		return errors.Wrapf(err, "error recording broadcast attempt for signed outbound %s", outboundID)
+	}
+	return nil
+}
+
+// RemoveSignedOutbounds drops the journaled outbounds of the confirmed nonce
+func (ob *Observer) RemoveSignedOutbounds(nonce uint64) error {
+	if ob.DB() == nil {
+		return nil
+	}
+
+	err := ob.DB().Unscoped().Where("nonce = ?", nonce).Delete(&clienttypes.SignedOutboundSQLType{}).Error
+	if err != nil {
+		return errors.Wrapf(err, "error removing signed outbounds for nonce %d", nonce)
+	}
+	return nil
+}
+
+// LoadSignedOutbounds restores the journaled outbounds as pending transactions
+func (ob *Observer) LoadSignedOutbounds() error {
+	var entries []clienttypes.SignedOutboundSQLType
+	if err := ob.DB().Order("nonce").Find(&entries).Error; err != nil {
+		return errors.Wrap(err, "error reading signed outbounds")
+	}
+	for _, entry := range entries {
+		transaction, err := clienttypes.FromSignedOutboundSQLType(entry)
+		if err != nil {
+			ob.Logger().Chain.Error().Err(err).Msgf("LoadSignedOutbounds: error decoding signed outbound %s", entry.Identifier)
+			continue
+		}
+		ob.SetPendingTx(transaction.Nonce(), transaction)
+	}
+	if len(entries) > 0 {
+		ob.Logger().Chain.Info().
+			Msgf("LoadSignedOutbounds: chain %d restored %d signed outbounds", ob.Chain().ChainId, len(entries))
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package observer_test
+
+import (
+	"math/big"
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
+)
+
+func Test_SignedOutboundJournal(t *testing.T) {
+	chain := chains.Ethereum
+	chainParam := mocks.MockChainParams(chain.ChainId, 1)
+	evmClient := mocks.NewMockEvmClient().WithHeader(&ethtypes.Header{Number: big.NewInt(100)})
+
+	newTx := func(nonce uint64, gasPrice int64) *ethtypes.Transaction {
+		to := sample.EthAddress()
+		return ethtypes.NewTx(&ethtypes.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: big.NewInt(gasPrice),
+			Gas:      21000,
+			To:       &to,
+			Value:    big.NewInt(1),
+		})
+	}
+
+	t.Run("should survive a restart and count the broadcast attempts", func(t *testing.T) {
+		dbPath := t.TempDir()
+		ob := MockEVMObserver(t, chain, evmClient, nil, nil, nil, 100, chainParam)
+		require.NoError(t, ob.LoadDB(dbPath, chain))
+
+		tx := newTx(7, 100)
+		require.NoError(t, ob.SaveSignedOutbound("cctx-1-7", tx))
+		require.NoError(t, ob.RecordBroadcastAttempt("cctx-1-7"))
+		require.NoError(t, ob.RecordBroadcastAttempt("cctx-1-7"))
+
+		// restart the observer on the same database
+		restarted := MockEVMObserver(t, chain, evmClient, nil, nil, nil, 100, chainParam)
+		require.NoError(t, restarted.LoadDB(dbPath, chain))
+
+		journaled, attempts, found := restarted.GetSignedOutbound("cctx-1-7")
+		require.True(t, found)
+		require.Equal(t, tx.Hash(), journaled.Hash())
+		require.EqualValues(t, 2, attempts)
+		require.Equal(t, tx.Hash(), restarted.GetPendingTx(7).Hash())
+	})
+	t.Run("should replace the journaled outbound re-signed for the same outbound", func(t *testing.T) {
+		ob := MockEVMObserver(t, chain, evmClient, nil, nil, nil, 100, chainParam)
+		require.NoError(t, ob.LoadDB(t.TempDir(), chain))
+
+		require.NoError(t, ob.SaveSignedOutbound("cctx-1-7", newTx(7, 100)))
+		require.NoError(t, ob.RecordBroadcastAttempt("cctx-1-7"))
+		resigned := newTx(7, 200)
+		require.NoError(t, ob.SaveSignedOutbound("cctx-1-7", resigned))
+
+		journaled, _, found := ob.GetSignedOutbound("cctx-1-7")
+		require.True(t, found)
+		require.Equal(t, resigned.Hash(), journaled.Hash())
+	})
+	t.Run("should remove the journaled outbounds of the confirmed nonce", func(t *testing.T) {
+		ob := MockEVMObserver(t, chain, evmClient, nil, nil, nil, 100, chainParam)
+		require.NoError(t, ob.LoadDB(t.TempDir(), chain))
+
+		require.NoError(t, ob.SaveSignedOutbound("cctx-1-7", newTx(7, 100)))
+		require.NoError(t, ob.SaveSignedOutbound("cctx-2-8", newTx(8, 100)))
+		require.NoError(t, ob.RemoveSignedOutbounds(7))
+
+		_, _, found := ob.GetSignedOutbound("cctx-1-7")
+		require.False(t, found)
+		_, _, found = ob.GetSignedOutbound("cctx-2-8")
+		require.True(t, found)
+	})
+	t.Run("should be a no-op without database", func(t *testing.T) {
+		ob := MockEVMObserver(t, chain, evmClient, nil, nil, nil, 100, chainParam)
+
+		require.NoError(t, ob.SaveSignedOutbound("cctx-1-7", newTx(7, 100)))
+		require.NoError(t, ob.RecordBroadcastAttempt("cctx-1-7"))
+		_, _, found := ob.GetSignedOutbound("cctx-1-7")
+		require.False(t, found)
+	})
+}
@@ -1,9 +1,11 @@
 package observer_test
 
 import (
+	"math/big"
 	"testing"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/erc20custody.sol"
@@ -218,6 +220,41 @@ func Test_PostVoteOutbound(t *testing.T) {
 			zerolog.Logger{},
 		)
 	})
+
+	t.Run("post vote outbound with the effective gas price of a dynamic fee tx", func(t *testing.T) {
+		// the fee cap of the dynamic fee tx differs from the effective gas price, 28 gwei base fee + 2 gwei tip
+		gasFeeCap := big.NewInt(100e9)
+		effectiveGasPrice := big.NewInt(30e9)
+		evmClient := mocks.NewMockEvmClient()
+		evmClient.Header = &ethtypes.Header{Number: receipt.BlockNumber, BaseFee: big.NewInt(28e9)}
+		dynamicFeeTx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+			ChainID:   big.NewInt(chain.ChainId),
+			Nonce:     nonce,
+			GasTipCap: big.NewInt(2e9),
+			GasFeeCap: gasFeeCap,
+			Gas:       outbound.Gas(),
+			To:        outbound.To(),
+			Value:     outbound.Value(),
+			Data:      outbound.Data(),
+		})
+		dynamicFeeReceipt := *receipt
+		dynamicFeeReceipt.Type = ethtypes.DynamicFeeTxType
+		require.Equal(t, gasFeeCap, dynamicFeeTx.GasPrice())
+
+		zetacoreClient := mocks.NewMockZetacoreClient()
+		client := MockEVMObserver(t, chain, evmClient, nil, zetacoreClient, nil, 1, observertypes.ChainParams{})
+		client.PostVoteOutbound(
+			cctx.Index,
+			&dynamicFeeReceipt,
+			dynamicFeeTx,
+			cctx.GetCurrentOutboundParam().Amount.BigInt(),
+			chains.ReceiveStatus_success,
+			nonce,
+			coinType,
+			zerolog.Logger{},
+		)
+		require.Equal(t, effectiveGasPrice, zetacoreClient.OutboundGasPrice())
+	})
 }
 
 func Test_ParseZetaReceived(t *testing.T) {
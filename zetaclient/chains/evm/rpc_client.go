@@ -0,0 +1,325 @@
+package evm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/onrik/ethrpc"
+	"github.com/rs/zerolog"
+
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/rpcpool"
+)
+
+// RPCMaxBlockLag is the number of blocks behind the highest endpoint above which an EVM endpoint is considered down
+const RPCMaxBlockLag = 20
+
+// RPCProvider is the RPC client of a single EVM endpoint
+type RPCProvider interface {
+	interfaces.EVMRPCClient
+	interfaces.EVMJSONRPCClient
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// provider is the RPC provider dialed to a single EVM endpoint
+type provider struct {
+	*ethclient.Client
+	*ethrpc.EthRPC
+}
+
+var (
+	_ interfaces.EVMRPCClient     = &RPCClient{}
+	_ interfaces.EVMJSONRPCClient = &RPCClient{}
+)
+
+// RPCClient is an EVM RPC client routing the requests to the healthiest of several endpoints
+// the receipts, headers, blocks and logs are cross-checked against the RPC quorum of endpoints
+type RPCClient struct {
+	pool *rpcpool.Pool[RPCProvider]
+}
+
+// NewRPCClient dials all the endpoints of the EVM chain config for the module (observer or signer)
+func NewRPCClient(evmCfg config.EVMConfig, module string, logger zerolog.Logger) (*RPCClient, error) {
+	urls := evmCfg.GetEndpoints()
+	names := rpcpool.EndpointNames(urls)
+	endpoints := make([]*rpcpool.Endpoint[RPCProvider], len(urls))
+	for i, url := range urls {
+		client, err := ethclient.Dial(url)
+		if err != nil {
+			return nil, fmt.Errorf("error dialing endpoint %s: %w", names[i], err)
+		}
+		endpoints[i] = rpcpool.NewEndpoint[RPCProvider](names[i], provider{client, ethrpc.NewEthRPC(url)})
+	}
+	return NewRPCClientFromEndpoints(evmCfg.Chain.ChainName.String(), module, endpoints, evmCfg.RPCQuorum, logger)
+}
+
+// NewRPCClientFromEndpoints creates an EVM RPC client from the given endpoints
+func NewRPCClientFromEndpoints(
+	chainName string,
+	module string,
+	endpoints []*rpcpool.Endpoint[RPCProvider],
+	quorum int,
+	logger zerolog.Logger,
+) (*RPCClient, error) {
+	probe := func(ctx context.Context, client RPCProvider) (uint64, error) {
+		return client.BlockNumber(ctx)
+	}
+	pool, err := rpcpool.New(chainName, module, endpoints, probe, IsEndpointFailure, quorum, RPCMaxBlockLag, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &RPCClient{pool: pool}, nil
+}
+
+// IsEndpointFailure returns true if the error is caused by the endpoint rather than the request
+// JSON-RPC error responses (e.g. execution reverted, nonce too low) and not found results are request errors
+func IsEndpointFailure(err error) bool {
+	if err == nil || errors.Is(err, ethereum.NotFound) {
+		return false
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return false
+	}
+	var ethErr ethrpc.EthError
+	return !errors.As(err, &ethErr)
+}
+
+// CheckHealth probes all the endpoints and returns the number of healthy endpoints
+func (c *RPCClient) CheckHealth(ctx context.Context) int {
+	return c.pool.CheckHealth(ctx)
+}
+
+// Stats returns the health stats of the endpoints, the healthiest first
+func (c *RPCClient) Stats() []rpcpool.Stats {
+	return c.pool.Stats()
+}
+
+// CodeAt returns the code of the contract
+func (c *RPCClient) CodeAt(ctx context.Context, contract ethcommon.Address, blockNumber *big.Int) ([]byte, error) {
+	return rpcpool.Call(c.pool, func(client RPCProvider) ([]byte, error) {
+		return client.CodeAt(ctx, contract, blockNumber)
+	})
+}
+
+// CallContract executes a contract call
+func (c *RPCClient) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return rpcpool.Call(c.pool, func(client RPCProvider) ([]byte, error) {
+		return client.CallContract(ctx, call, blockNumber)
+	})
+}
+
+// HeaderByNumber returns the block header, cross-checked by hash unless the latest header is requested
+func (c *RPCClient) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	fn := func(client RPCProvider) (*ethtypes.Header, error) {
+		return client.HeaderByNumber(ctx, number)
+	}
+	if number == nil {
+		return rpcpool.Call(c.pool, fn)
+	}
+	return rpcpool.CallQuorum(c.pool, fn, func(header *ethtypes.Header) string {
+		return header.Hash().Hex()
+	})
+}
+
+// PendingCodeAt returns the code of the contract in the pending state
+func (c *RPCClient) PendingCodeAt(ctx context.Context, account ethcommon.Address) ([]byte, error) {
+	return rpcpool.Call(c.pool, func(client RPCProvider) ([]byte, error) {
+		return client.PendingCodeAt(ctx, account)
+	})
+}
+
+// PendingNonceAt returns the account nonce in the pending state
+func (c *RPCClient) PendingNonceAt(ctx context.Context, account ethcommon.Address) (uint64, error) {
+	return rpcpool.Call(c.pool, func(client RPCProvider) (uint64, error) {
+		return client.PendingNonceAt(ctx, account)
+	})
+}
+
+// SuggestGasPrice returns the suggested gas price
+func (c *RPCClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return rpcpool.Call(c.pool, func(client RPCProvider) (*big.Int, error) {
+		return client.SuggestGasPrice(ctx)
+	})
+}
+
+// SuggestGasTipCap returns the suggested gas tip cap
+func (c *RPCClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return rpcpool.Call(c.pool, func(client RPCProvider) (*big.Int, error) {
+		return client.SuggestGasTipCap(ctx)
+	})
+}
+
+// EstimateGas estimates the gas of the call
+func (c *RPCClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return rpcpool.Call(c.pool, func(client RPCProvider) (uint64, error) {
+		return client.EstimateGas(ctx, call)
+	})
+}
+
+// SendTransaction broadcasts the transaction
+func (c *RPCClient) SendTransaction(ctx context.Context, tx *ethtypes.Transaction) error {
+	return c.pool.Do(func(client RPCProvider) error {
+		return client.SendTransaction(ctx, tx)
+	})
+}
+
+// FilterLogs returns the logs matching the query, cross-checked by their full content
+func (c *RPCClient) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]ethtypes.Log, error) {
+	fn := func(client RPCProvider) ([]ethtypes.Log, error) {
+		return client.FilterLogs(ctx, query)
+	}
+	return rpcpool.CallQuorum(c.pool, fn, func(logs []ethtypes.Log) string {
+		return contentKey(logs)
+	})
+}
+
+// SubscribeFilterLogs subscribes to the logs matching the query on the healthiest endpoint
+func (c *RPCClient) SubscribeFilterLogs(
+	ctx context.Context,
+	query ethereum.FilterQuery,
+	ch chan<- ethtypes.Log,
+) (ethereum.Subscription, error) {
+	return rpcpool.Call(c.pool, func(client RPCProvider) (ethereum.Subscription, error) {
+		return client.SubscribeFilterLogs(ctx, query, ch)
+	})
+}
+
+// BlockNumber returns the latest block number
+func (c *RPCClient) BlockNumber(ctx context.Context) (uint64, error) {
+	return rpcpool.Call(c.pool, func(client RPCProvider) (uint64, error) {
+		return client.BlockNumber(ctx)
+	})
+}
+
+// BlockByNumber returns the block, cross-checked by hash and transactions unless the latest block is requested
+func (c *RPCClient) BlockByNumber(ctx context.Context, number *big.Int) (*ethtypes.Block, error) {
+	fn := func(client RPCProvider) (*ethtypes.Block, error) {
+		return client.BlockByNumber(ctx, number)
+	}
+	if number == nil {
+		return rpcpool.Call(c.pool, fn)
+	}
+	return rpcpool.CallQuorum(c.pool, fn, func(block *ethtypes.Block) string {
+		// the hash of the block only commits to the header
+		keys := make([]string, 0, len(block.Transactions())+1)
+		keys = append(keys, block.Hash().Hex())
+		for _, tx := range block.Transactions() {
+			keys = append(keys, tx.Hash().Hex())
+		}
+		return strings.Join(keys, ",")
+	})
+}
+
+// TransactionByHash returns the transaction, an endpoint returning a transaction of another hash is failed over
+func (c *RPCClient) TransactionByHash(
+	ctx context.Context,
+	hash ethcommon.Hash,
+) (tx *ethtypes.Transaction, isPending bool, err error) {
+	err = c.pool.Do(func(client RPCProvider) error {
+		var err error
+		tx, isPending, err = client.TransactionByHash(ctx, hash)
+		if err == nil && tx.Hash() != hash {
+			return fmt.Errorf("transaction %s returned for hash %s", tx.Hash().Hex(), hash.Hex())
+		}
+		return err
+	})
+	return tx, isPending, err
+}
+
+// TransactionReceipt returns the receipt, cross-checked by its consensus encoding (status, gas, bloom and logs)
+// and its inclusion in the block
+func (c *RPCClient) TransactionReceipt(ctx context.Context, txHash ethcommon.Hash) (*ethtypes.Receipt, error) {
+	fn := func(client RPCProvider) (*ethtypes.Receipt, error) {
+		return client.TransactionReceipt(ctx, txHash)
+	}
+	return rpcpool.CallQuorum(c.pool, fn, func(receipt *ethtypes.Receipt) string {
+		encoded, err := receipt.MarshalBinary()
+		if err != nil {
+			return fmt.Sprintf("invalid receipt: %s", err)
+		}
+		return fmt.Sprintf("%s:%s:%s:%d:%d:%s",
+			crypto.Keccak256Hash(encoded).Hex(),
+			receipt.TxHash.Hex(),
+			receipt.BlockHash.Hex(),
+			receipt.TransactionIndex,
+			receipt.GasUsed,
+			receipt.ContractAddress.Hex(),
+		)
+	})
+}
+
+// TransactionSender returns the sender of the transaction
+func (c *RPCClient) TransactionSender(
+	ctx context.Context,
+	tx *ethtypes.Transaction,
+	block ethcommon.Hash,
+	index uint,
+) (ethcommon.Address, error) {
+	return rpcpool.Call(c.pool, func(client RPCProvider) (ethcommon.Address, error) {
+		return client.TransactionSender(ctx, tx, block, index)
+	})
+}
+
+// ChainID returns the chain id of the healthiest endpoint
+func (c *RPCClient) ChainID(ctx context.Context) (*big.Int, error) {
+	return rpcpool.Call(c.pool, func(client RPCProvider) (*big.Int, error) {
+		return client.ChainID(ctx)
+	})
+}
+
+// EthGetBlockByNumber returns the block with its transactions, cross-checked by the block fields and the full content
+// of the transactions: the hash reported by the endpoint is not computed from the block, it can't be trusted alone
+func (c *RPCClient) EthGetBlockByNumber(number int, withTransactions bool) (*ethrpc.Block, error) {
+	fn := func(client RPCProvider) (*ethrpc.Block, error) {
+		return client.EthGetBlockByNumber(number, withTransactions)
+	}
+	return rpcpool.CallQuorum(c.pool, fn, func(block *ethrpc.Block) string {
+		if block == nil {
+			return ""
+		}
+		return fmt.Sprintf("%d:%s:%s:%s:%s:%d:%s",
+			block.Number,
+			block.Hash,
+			block.ParentHash,
+			block.TransactionsRoot,
+			block.StateRoot,
+			block.Timestamp,
+			contentKey(block.Transactions),
+		)
+	})
+}
+
+// EthGetTransactionByHash returns the transaction, cross-checked by its full content (e.g. sender, receiver, value)
+func (c *RPCClient) EthGetTransactionByHash(hash string) (*ethrpc.Transaction, error) {
+	fn := func(client RPCProvider) (*ethrpc.Transaction, error) {
+		tx, err := client.EthGetTransactionByHash(hash)
+		if err == nil && tx != nil && !strings.EqualFold(tx.Hash, hash) {
+			return nil, fmt.Errorf("transaction %s returned for hash %s", tx.Hash, hash)
+		}
+		return tx, err
+	}
+	return rpcpool.CallQuorum(c.pool, fn, func(tx *ethrpc.Transaction) string {
+		return contentKey(tx)
+	})
+}
+
+// contentKey returns the key of the full content of a result cross-checked by the RPC quorum
+func contentKey(result any) string {
+	content, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Sprintf("invalid content: %s", err)
+	}
+	return crypto.Keccak256Hash(content).Hex()
+}
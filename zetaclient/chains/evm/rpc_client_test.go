@@ -0,0 +1,209 @@
+package evm_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/evm"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/rpcpool"
+)
+
+// testEndpoint is an EVM JSON-RPC endpoint serving a block number and a receipt
+type testEndpoint struct {
+	server      *httptest.Server
+	blockNumber uint64
+	receipt     *ethtypes.Receipt
+	tx          *ethtypes.Transaction
+	down        bool
+	rpcError    bool
+	calls       atomic.Int32
+}
+
+func newTestEndpoint(t *testing.T, blockNumber uint64, receipt *ethtypes.Receipt) *testEndpoint {
+	e := &testEndpoint{blockNumber: blockNumber, receipt: receipt}
+	e.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		e.calls.Add(1)
+		if e.down {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		res := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		switch {
+		case e.rpcError:
+			res["error"] = map[string]interface{}{"code": -32000, "message": "execution reverted"}
+		case req.Method == "eth_blockNumber":
+			res["result"] = hexutil.Uint64(e.blockNumber)
+		case req.Method == "eth_getTransactionReceipt":
+			res["result"] = e.receipt
+		case req.Method == "eth_getTransactionByHash":
+			res["result"] = e.tx
+		default:
+			res["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+	t.Cleanup(e.server.Close)
+	return e
+}
+
+func newTestReceipt(blockHash ethcommon.Hash) *ethtypes.Receipt {
+	return &ethtypes.Receipt{
+		Status:      ethtypes.ReceiptStatusSuccessful,
+		TxHash:      sample.Hash(),
+		BlockHash:   blockHash,
+		BlockNumber: ethcommon.Big1,
+		GasUsed:     21000,
+		Logs:        []*ethtypes.Log{},
+	}
+}
+
+func newTestRPCClient(t *testing.T, quorum int, endpoints ...*testEndpoint) *evm.RPCClient {
+	evmCfg := config.EVMConfig{Chain: chains.Ethereum, RPCQuorum: quorum}
+	for _, e := range endpoints {
+		evmCfg.Endpoints = append(evmCfg.Endpoints, e.server.URL)
+	}
+	client, err := evm.NewRPCClient(evmCfg, rpcpool.ModuleObserver, zerolog.Nop())
+	require.NoError(t, err)
+	return client
+}
+
+func TestRPCClient_Failover(t *testing.T) {
+	t.Run("should fail over to the next endpoint if the endpoint is down", func(t *testing.T) {
+		down := newTestEndpoint(t, 100, nil)
+		down.down = true
+		up := newTestEndpoint(t, 101, nil)
+		client := newTestRPCClient(t, 1, down, up)
+
+		bn, err := client.BlockNumber(context.Background())
+		require.NoError(t, err)
+		require.EqualValues(t, 101, bn)
+	})
+	t.Run("should not fail over on JSON-RPC error", func(t *testing.T) {
+		reverted := newTestEndpoint(t, 100, nil)
+		reverted.rpcError = true
+		other := newTestEndpoint(t, 100, nil)
+		client := newTestRPCClient(t, 1, reverted, other)
+
+		_, err := client.BlockNumber(context.Background())
+		require.ErrorContains(t, err, "execution reverted")
+		require.Zero(t, other.calls.Load())
+	})
+	t.Run("should route to the healthiest endpoint after health check", func(t *testing.T) {
+		lagging := newTestEndpoint(t, 50, nil)
+		tip := newTestEndpoint(t, 100, nil)
+		client := newTestRPCClient(t, 1, lagging, tip)
+
+		require.Equal(t, 1, client.CheckHealth(context.Background()))
+		bn, err := client.BlockNumber(context.Background())
+		require.NoError(t, err)
+		require.EqualValues(t, 100, bn)
+		require.EqualValues(t, 50, client.Stats()[1].BlockLag)
+	})
+}
+
+func TestRPCClient_TransactionReceipt(t *testing.T) {
+	blockHash := sample.Hash()
+	receipt := newTestReceipt(blockHash)
+	txHash := receipt.TxHash
+
+	t.Run("should return receipt agreed by the quorum", func(t *testing.T) {
+		forked := newTestReceipt(sample.Hash())
+		client := newTestRPCClient(t, 2,
+			newTestEndpoint(t, 100, forked),
+			newTestEndpoint(t, 100, receipt),
+			newTestEndpoint(t, 100, receipt),
+		)
+		result, err := client.TransactionReceipt(context.Background(), txHash)
+		require.NoError(t, err)
+		require.Equal(t, blockHash, result.BlockHash)
+	})
+	t.Run("should fail if the endpoints disagree on the receipt", func(t *testing.T) {
+		client := newTestRPCClient(t, 2,
+			newTestEndpoint(t, 100, newTestReceipt(sample.Hash())),
+			newTestEndpoint(t, 100, receipt),
+		)
+		_, err := client.TransactionReceipt(context.Background(), txHash)
+		require.ErrorIs(t, err, rpcpool.ErrNoQuorum)
+	})
+	t.Run("should fail if the endpoints disagree on the logs", func(t *testing.T) {
+		tampered := newTestReceipt(blockHash)
+		tampered.TxHash = txHash
+		emitter := sample.EthAddress()
+		tampered.Logs = []*ethtypes.Log{{Address: emitter, Topics: []ethcommon.Hash{}, Data: []byte("tampered")}}
+		original := newTestReceipt(blockHash)
+		original.TxHash = txHash
+		original.Logs = []*ethtypes.Log{{Address: emitter, Topics: []ethcommon.Hash{}, Data: []byte("original")}}
+
+		client := newTestRPCClient(t, 2,
+			newTestEndpoint(t, 100, tampered),
+			newTestEndpoint(t, 100, original),
+		)
+		_, err := client.TransactionReceipt(context.Background(), txHash)
+		require.ErrorIs(t, err, rpcpool.ErrNoQuorum)
+	})
+	t.Run("should return not found if no endpoint has the receipt", func(t *testing.T) {
+		client := newTestRPCClient(t, 2, newTestEndpoint(t, 100, nil), newTestEndpoint(t, 100, nil))
+		_, err := client.TransactionReceipt(context.Background(), txHash)
+		require.Equal(t, ethereum.NotFound, err)
+	})
+}
+
+func TestRPCClient_TransactionByHash(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := ethtypes.LatestSignerForChainID(big.NewInt(1))
+	newTx := func(value int64) *ethtypes.Transaction {
+		tx := ethtypes.NewTransaction(1, sample.EthAddress(), big.NewInt(value), 21000, big.NewInt(1), nil)
+		signed, err := ethtypes.SignTx(tx, signer, privateKey)
+		require.NoError(t, err)
+		return signed
+	}
+	tx := newTx(1000)
+
+	t.Run("should fail over if the endpoint returns another transaction", func(t *testing.T) {
+		forged := newTestEndpoint(t, 100, nil)
+		forged.tx = newTx(2000)
+		honest := newTestEndpoint(t, 100, nil)
+		honest.tx = tx
+		client := newTestRPCClient(t, 1, forged, honest)
+
+		result, _, err := client.TransactionByHash(context.Background(), tx.Hash())
+		require.NoError(t, err)
+		require.Equal(t, tx.Hash(), result.Hash())
+		require.EqualValues(t, 1000, result.Value().Int64())
+	})
+}
+
+func TestNewRPCClient(t *testing.T) {
+	t.Run("should fail without endpoint", func(t *testing.T) {
+		_, err := evm.NewRPCClient(config.EVMConfig{Chain: chains.Ethereum}, rpcpool.ModuleObserver, zerolog.Nop())
+		require.Error(t, err)
+	})
+	t.Run("should fail if quorum can't be reached", func(t *testing.T) {
+		evmCfg := config.EVMConfig{Chain: chains.Ethereum, Endpoint: "http://localhost:8545", RPCQuorum: 2}
+		_, err := evm.NewRPCClient(evmCfg, rpcpool.ModuleObserver, zerolog.Nop())
+		require.ErrorContains(t, err, "quorum")
+	})
+}
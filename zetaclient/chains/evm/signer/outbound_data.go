@@ -9,6 +9,7 @@ import (
 	"math/big"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/rs/zerolog"
 
 	"github.com/zeta-chain/zetacore/pkg/chains"
@@ -38,6 +39,10 @@ type OutboundData struct {
 	nonce      uint64
 	height     uint64
 
+	// gasTipCap is the priority fee of EIP-1559 transactions, gasPrice is then used as the fee cap
+	// a nil gasTipCap means a legacy transaction is built
+	gasTipCap *big.Int
+
 	// cctxIndex field is the inbound message digest that is sent to the destination contract
 	cctxIndex [32]byte
 
@@ -90,7 +95,7 @@ func (txData *OutboundData) SetupGas(
 	// we should possibly remove it completely and return an error if no GasPrice is provided because it means no fee is processed on ZetaChain
 	specified, ok := new(big.Int).SetString(cctx.GetCurrentOutboundParam().GasPrice, 10)
 	if !ok {
-		if chains.IsEthereumChain(chain.ChainId) {
+		if chain.Network == chains.Network_eth {
 			suggested, err := client.SuggestGasPrice(context.Background())
 			if err != nil {
 				return errors.Join(err, fmt.Errorf("cannot get gas price from chain %s ", chain))
@@ -105,6 +110,33 @@ func (txData *OutboundData) SetupGas(
 	return nil
 }
 
+// SetupDynamicFee sets the priority fee used to build EIP-1559 transactions, gasPrice is used as the fee cap.
+// The gas tip cap is left unset (legacy transaction) if the chain doesn't support EIP-1559
+func (txData *OutboundData) SetupDynamicFee(logger zerolog.Logger, client interfaces.EVMRPCClient) error {
+	// the block header has no base fee if London fork is not activated on the chain
+	header, err := client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return errors.Join(err, errors.New("cannot get latest block header"))
+	}
+	if header.BaseFee == nil {
+		logger.Warn().Msg("EIP-1559 is not supported by chain, fall back to legacy transaction")
+		return nil
+	}
+
+	gasTipCap, err := client.SuggestGasTipCap(context.Background())
+	if err != nil {
+		return errors.Join(err, errors.New("cannot get gas tip cap"))
+	}
+
+	// priority fee can't exceed the fee cap
+	if gasTipCap.Cmp(txData.gasPrice) > 0 {
+		gasTipCap = new(big.Int).Set(txData.gasPrice)
+	}
+	txData.gasTipCap = gasTipCap
+
+	return nil
+}
+
 // NewOutboundData populates transaction input fields parsed from the cctx and other parameters
 // returns
 //  1. New NewOutboundData Data struct or nil if an error occurred.
@@ -133,7 +165,7 @@ func NewOutboundData(
 		return nil, true, nil
 	}
 
-	toChain := chains.GetChainFromChainID(txData.toChainID.Int64())
+	toChain := chains.GetChainFromChainID(txData.toChainID.Int64(), evmObserver.CoreContext().GetAdditionalChains())
 	if toChain == nil {
 		return nil, true, fmt.Errorf("unknown chain: %d", txData.toChainID.Int64())
 	}
@@ -154,6 +186,12 @@ func NewOutboundData(
 	if err != nil {
 		return nil, true, err
 	}
+	if evmObserver.GetChainParams().IsEip1559Enabled {
+		err = txData.SetupDynamicFee(logger, evmRPC)
+		if err != nil {
+			return nil, true, err
+		}
+	}
 
 	// Get sendHash
 	logger.Info().
@@ -164,18 +202,6 @@ func NewOutboundData(
 	}
 	copy(txData.cctxIndex[:32], cctxIndex[:32])
 
-	// In case there is a pending transaction, make sure this keysign is a transaction replacement
-	pendingTx := evmObserver.GetPendingTx(nonce)
-	if pendingTx != nil {
-		if txData.gasPrice.Cmp(pendingTx.GasPrice()) > 0 {
-			logger.Info().
-				Msgf("replace pending outbound %s nonce %d using gas price %d", pendingTx.Hash().Hex(), nonce, txData.gasPrice)
-		} else {
-			logger.Info().Msgf("please wait for pending outbound %s nonce %d to be included", pendingTx.Hash().Hex(), nonce)
-			return nil, true, nil
-		}
-	}
-
 	// Base64 decode message
 	if cctx.InboundParams.CoinType != coin.CoinType_Cmd {
 		txData.message, err = base64.StdEncoding.DecodeString(cctx.RelayedMessage)
@@ -186,3 +212,44 @@ func NewOutboundData(
 
 	return &txData, false, nil
 }
+
+// waitForPendingTx returns true if the keysign has to wait for the pending transaction of the nonce to be included
+// a pending transaction is only replaced by a keysign using a higher gas price
+func (txData *OutboundData) waitForPendingTx(evmObserver *observer.Observer, logger zerolog.Logger) bool {
+	pendingTx := evmObserver.GetPendingTx(txData.nonce)
+	if pendingTx == nil {
+		return false
+	}
+	if txData.gasPrice.Cmp(pendingTx.GasPrice()) > 0 {
+		logger.Info().
+			Msgf("replace pending outbound %s nonce %d using gas price %d", pendingTx.Hash().Hex(), txData.nonce, txData.gasPrice)
+		txData.bumpGasTipCap(pendingTx.GasTipCap())
+		return false
+	}
+	logger.Info().Msgf("please wait for pending outbound %s nonce %d to be included", pendingTx.Hash().Hex(), txData.nonce)
+	return true
+}
+
+// bumpGasTipCap raises the priority fee of a dynamic fee transaction replacing a pending transaction
+// node requires the priority fee of a replacement to be bumped as well (10% by default)
+func (txData *OutboundData) bumpGasTipCap(pendingGasTipCap *big.Int) {
+	if txData.gasTipCap == nil {
+		return
+	}
+	minGasTipCap := new(big.Int).Div(new(big.Int).Mul(pendingGasTipCap, big.NewInt(110)), big.NewInt(100))
+	if txData.gasTipCap.Cmp(minGasTipCap) < 0 {
+		txData.gasTipCap = minGasTipCap
+	}
+	if txData.gasTipCap.Cmp(txData.gasPrice) > 0 {
+		txData.gasTipCap = new(big.Int).Set(txData.gasPrice)
+	}
+}
+
+// isFeeCoveredBy returns true if the fees of the signed tx are not lower than the fees of the outbound
+// both the fee cap and the priority fee are compared, so a bump of the priority fee alone requires a new keysign
+func (txData *OutboundData) isFeeCoveredBy(tx *ethtypes.Transaction) bool {
+	if tx.GasFeeCap().Cmp(txData.gasPrice) < 0 {
+		return false
+	}
+	return txData.gasTipCap == nil || tx.GasTipCap().Cmp(txData.gasTipCap) >= 0
+}
@@ -5,11 +5,13 @@ import (
 	"testing"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 
 	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
 )
 
 func TestSigner_SetChainAndSender(t *testing.T) {
@@ -65,6 +67,86 @@ func TestSigner_SetupGas(t *testing.T) {
 	})
 }
 
+func TestSigner_SetupDynamicFee(t *testing.T) {
+	logger := zerolog.Logger{}
+
+	t.Run("SetupDynamicFee should set gas tip cap", func(t *testing.T) {
+		client := mocks.NewMockEvmClient().
+			WithHeader(&ethtypes.Header{BaseFee: big.NewInt(10)}).
+			WithGasTipCap(big.NewInt(2))
+		txData := &OutboundData{gasPrice: big.NewInt(20)}
+
+		err := txData.SetupDynamicFee(logger, client)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(2), txData.gasTipCap)
+	})
+
+	t.Run("SetupDynamicFee should cap gas tip cap to gas price", func(t *testing.T) {
+		client := mocks.NewMockEvmClient().
+			WithHeader(&ethtypes.Header{BaseFee: big.NewInt(10)}).
+			WithGasTipCap(big.NewInt(30))
+		txData := &OutboundData{gasPrice: big.NewInt(20)}
+
+		err := txData.SetupDynamicFee(logger, client)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(20), txData.gasTipCap)
+	})
+
+	t.Run("SetupDynamicFee should fall back to legacy tx if base fee is missing", func(t *testing.T) {
+		client := mocks.NewMockEvmClient().WithGasTipCap(big.NewInt(2))
+		txData := &OutboundData{gasPrice: big.NewInt(20)}
+
+		err := txData.SetupDynamicFee(logger, client)
+		require.NoError(t, err)
+		require.Nil(t, txData.gasTipCap)
+	})
+}
+
+func TestSigner_BumpGasTipCap(t *testing.T) {
+	t.Run("should bump gas tip cap by 10% of pending tx", func(t *testing.T) {
+		txData := &OutboundData{gasPrice: big.NewInt(100), gasTipCap: big.NewInt(10)}
+		txData.bumpGasTipCap(big.NewInt(20))
+		require.Equal(t, big.NewInt(22), txData.gasTipCap)
+	})
+
+	t.Run("should not exceed gas price", func(t *testing.T) {
+		txData := &OutboundData{gasPrice: big.NewInt(100), gasTipCap: big.NewInt(10)}
+		txData.bumpGasTipCap(big.NewInt(95))
+		require.Equal(t, big.NewInt(100), txData.gasTipCap)
+	})
+
+	t.Run("should do nothing for legacy tx", func(t *testing.T) {
+		txData := &OutboundData{gasPrice: big.NewInt(100)}
+		txData.bumpGasTipCap(big.NewInt(20))
+		require.Nil(t, txData.gasTipCap)
+	})
+}
+
+func TestSigner_IsFeeCoveredBy(t *testing.T) {
+	dynamicTx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{GasFeeCap: big.NewInt(100), GasTipCap: big.NewInt(10)})
+	legacyTx := ethtypes.NewTx(&ethtypes.LegacyTx{GasPrice: big.NewInt(100)})
+
+	t.Run("should be covered by the tx signed with the same fees", func(t *testing.T) {
+		txData := &OutboundData{gasPrice: big.NewInt(100), gasTipCap: big.NewInt(10)}
+		require.True(t, txData.isFeeCoveredBy(dynamicTx))
+	})
+
+	t.Run("should not be covered if the gas price is increased", func(t *testing.T) {
+		txData := &OutboundData{gasPrice: big.NewInt(110), gasTipCap: big.NewInt(10)}
+		require.False(t, txData.isFeeCoveredBy(dynamicTx))
+	})
+
+	t.Run("should not be covered if only the priority fee is increased", func(t *testing.T) {
+		txData := &OutboundData{gasPrice: big.NewInt(100), gasTipCap: big.NewInt(11)}
+		require.False(t, txData.isFeeCoveredBy(dynamicTx))
+	})
+
+	t.Run("should compare the gas price of legacy tx", func(t *testing.T) {
+		require.True(t, (&OutboundData{gasPrice: big.NewInt(100)}).isFeeCoveredBy(legacyTx))
+		require.False(t, (&OutboundData{gasPrice: big.NewInt(101)}).isFeeCoveredBy(legacyTx))
+	})
+}
+
 func TestSigner_NewOutboundData(t *testing.T) {
 	// Setup evm signer
 	evmSigner, err := getNewEvmSigner()
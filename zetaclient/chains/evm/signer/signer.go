@@ -8,7 +8,6 @@ import (
 	"math/rand"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,7 +15,6 @@ import (
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"github.com/zeta-chain/protocol-contracts/pkg/contracts/evm/erc20custody.sol"
@@ -27,42 +25,44 @@ import (
 	crosschainkeeper "github.com/zeta-chain/zetacore/x/crosschain/keeper"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/base"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/evm"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/evm/observer"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
 	clientcommon "github.com/zeta-chain/zetacore/zetaclient/common"
 	"github.com/zeta-chain/zetacore/zetaclient/compliance"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
 	clientcontext "github.com/zeta-chain/zetacore/zetaclient/context"
 	"github.com/zeta-chain/zetacore/zetaclient/metrics"
 	"github.com/zeta-chain/zetacore/zetaclient/outboundprocessor"
+	"github.com/zeta-chain/zetacore/zetaclient/rpcpool"
 	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
 	"github.com/zeta-chain/zetacore/zetaclient/zetacore"
 )
 
-var _ interfaces.ChainSigner = &Signer{}
+var (
+	_ interfaces.ChainSigner           = &Signer{}
+	_ interfaces.OutboundRebroadcaster = &Signer{}
+)
 
 // Signer deals with the signing EVM transactions and implements the ChainSigner interface
 type Signer struct {
-	client      interfaces.EVMRPCClient
-	chain       *chains.Chain
-	tssSigner   interfaces.TSSSigner
-	ethSigner   ethtypes.Signer
-	logger      clientcommon.ClientLogger
-	ts          *metrics.TelemetryServer
-	coreContext *clientcontext.ZetacoreContext
-
-	// mu protects below fields from concurrent access
-	mu                        *sync.Mutex
-	zetaConnectorABI          abi.ABI
-	erc20CustodyABI           abi.ABI
-	zetaConnectorAddress      ethcommon.Address
-	er20CustodyAddress        ethcommon.Address
-	outboundHashBeingReported map[string]bool
+	// base.Signer implements the base chain signer
+	*base.Signer
+
+	client    interfaces.EVMRPCClient
+	ethSigner ethtypes.Signer
+
+	// the below fields are protected by the lock of the base signer
+	zetaConnectorABI     abi.ABI
+	erc20CustodyABI      abi.ABI
+	zetaConnectorAddress ethcommon.Address
+	er20CustodyAddress   ethcommon.Address
 }
 
 func NewSigner(
 	chain chains.Chain,
-	endpoint string,
+	endpoints []string,
 	tssSigner interfaces.TSSSigner,
 	zetaConnectorABI string,
 	erc20CustodyABI string,
@@ -72,7 +72,8 @@ func NewSigner(
 	loggers clientcommon.ClientLogger,
 	ts *metrics.TelemetryServer,
 ) (*Signer, error) {
-	client, ethSigner, err := getEVMRPC(endpoint)
+	baseSigner := base.NewSigner(chain, coreContext, tssSigner, ts, loggers, "EVMSigner")
+	client, ethSigner, err := getEVMRPC(chain, endpoints, baseSigner.Logger().Std)
 	if err != nil {
 		return nil, err
 	}
@@ -86,72 +87,65 @@ func NewSigner(
 	}
 
 	return &Signer{
+		Signer:               baseSigner,
 		client:               client,
-		chain:                &chain,
-		tssSigner:            tssSigner,
 		ethSigner:            ethSigner,
 		zetaConnectorABI:     connectorABI,
 		erc20CustodyABI:      custodyABI,
 		zetaConnectorAddress: zetaConnectorAddress,
 		er20CustodyAddress:   erc20CustodyAddress,
-		coreContext:          coreContext,
-		logger: clientcommon.ClientLogger{
-			Std:        loggers.Std.With().Str("chain", chain.ChainName.String()).Str("module", "EVMSigner").Logger(),
-			Compliance: loggers.Compliance,
-		},
-		ts:                        ts,
-		mu:                        &sync.Mutex{},
-		outboundHashBeingReported: make(map[string]bool),
 	}, nil
 }
 
 // SetZetaConnectorAddress sets the zeta connector address
 func (signer *Signer) SetZetaConnectorAddress(addr ethcommon.Address) {
-	signer.mu.Lock()
-	defer signer.mu.Unlock()
+	signer.Mu().Lock()
+	defer signer.Mu().Unlock()
 	signer.zetaConnectorAddress = addr
 }
 
 // SetERC20CustodyAddress sets the erc20 custody address
 func (signer *Signer) SetERC20CustodyAddress(addr ethcommon.Address) {
-	signer.mu.Lock()
-	defer signer.mu.Unlock()
+	signer.Mu().Lock()
+	defer signer.Mu().Unlock()
 	signer.er20CustodyAddress = addr
 }
 
 // GetZetaConnectorAddress returns the zeta connector address
 func (signer *Signer) GetZetaConnectorAddress() ethcommon.Address {
-	signer.mu.Lock()
-	defer signer.mu.Unlock()
+	signer.Mu().Lock()
+	defer signer.Mu().Unlock()
 	return signer.zetaConnectorAddress
 }
 
 // GetERC20CustodyAddress returns the erc20 custody address
 func (signer *Signer) GetERC20CustodyAddress() ethcommon.Address {
-	signer.mu.Lock()
-	defer signer.mu.Unlock()
+	signer.Mu().Lock()
+	defer signer.Mu().Unlock()
 	return signer.er20CustodyAddress
 }
 
 // Sign given data, and metadata (gas, nonce, etc)
 // returns a signed transaction, sig bytes, hash bytes, and error
+// Note: an EIP-1559 dynamic fee transaction is built if gasTipCap is not nil, gasPrice is then used as the fee cap
 func (signer *Signer) Sign(
 	data []byte,
 	to ethcommon.Address,
+	amount *big.Int,
 	gasLimit uint64,
 	gasPrice *big.Int,
+	gasTipCap *big.Int,
 	nonce uint64,
 	height uint64,
 ) (*ethtypes.Transaction, []byte, []byte, error) {
-	log.Debug().Msgf("TSS SIGNER: %s", signer.tssSigner.Pubkey())
+	log.Debug().Msgf("TSS SIGNER: %s", signer.TSS().Pubkey())
 
-	// TODO: use EIP-1559 transaction type
-	// https://github.com/zeta-chain/node/issues/1952
-	tx := ethtypes.NewTransaction(nonce, to, big.NewInt(0), gasLimit, gasPrice, data)
+	tx := newTx(signer.ethSigner.ChainID(), data, to, amount, gasLimit, gasPrice, gasTipCap, nonce)
 
 	hashBytes := signer.ethSigner.Hash(tx).Bytes()
 
-	sig, err := signer.tssSigner.Sign(hashBytes, height, nonce, signer.chain, "")
+	chain := signer.Chain()
+	sig, err := signer.TSS().Sign(hashBytes, height, nonce, &chain, "")
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -159,11 +153,11 @@ func (signer *Signer) Sign(
 	log.Debug().Msgf("Sign: Signature: %s", hex.EncodeToString(sig[:]))
 	pubk, err := crypto.SigToPub(hashBytes, sig[:])
 	if err != nil {
-		signer.logger.Std.Error().Err(err).Msgf("SigToPub error")
+		signer.Logger().Std.Error().Err(err).Msgf("SigToPub error")
 	}
 
 	addr := crypto.PubkeyToAddress(*pubk)
-	signer.logger.Std.Info().Msgf("Sign: Ecrecovery of signature: %s", addr.Hex())
+	signer.Logger().Std.Info().Msgf("Sign: Ecrecovery of signature: %s", addr.Hex())
 	signedTX, err := tx.WithSignature(signer.ethSigner, sig[:])
 	if err != nil {
 		return nil, nil, nil, err
@@ -179,6 +173,23 @@ func (signer *Signer) Broadcast(tx *ethtypes.Transaction) error {
 	return signer.client.SendTransaction(ctxt, tx)
 }
 
+// RebroadcastOutbound re-broadcasts the pending outbound of the nonce signed by TSS and returns its hash
+func (signer *Signer) RebroadcastOutbound(chainObserver interfaces.ChainObserver, nonce uint64) (string, error) {
+	evmObserver, ok := chainObserver.(*observer.Observer)
+	if !ok {
+		return "", fmt.Errorf("chain observer is not an EVM observer")
+	}
+	tx := evmObserver.GetPendingTx(nonce)
+	if tx == nil {
+		return "", fmt.Errorf("no pending signed outbound for nonce %d on chain %d", nonce, signer.Chain().ChainId)
+	}
+	if err := signer.Broadcast(tx); err != nil {
+		return "", fmt.Errorf("error re-broadcasting outbound %s: %w", tx.Hash().Hex(), err)
+	}
+	signer.Logger().Std.Info().Msgf("RebroadcastOutbound: re-broadcast outbound %s nonce %d", tx.Hash().Hex(), nonce)
+	return tx.Hash().Hex(), nil
+}
+
 // SignOutbound
 // function onReceive(
 //
@@ -207,8 +218,10 @@ func (signer *Signer) SignOutbound(txData *OutboundData) (*ethtypes.Transaction,
 
 	tx, _, _, err := signer.Sign(data,
 		signer.zetaConnectorAddress,
+		big.NewInt(0),
 		txData.gasLimit,
 		txData.gasPrice,
+		txData.gasTipCap,
 		txData.nonce,
 		txData.height)
 	if err != nil {
@@ -246,8 +259,10 @@ func (signer *Signer) SignRevertTx(txData *OutboundData) (*ethtypes.Transaction,
 
 	tx, _, _, err := signer.Sign(data,
 		signer.zetaConnectorAddress,
+		big.NewInt(0),
 		txData.gasLimit,
 		txData.gasPrice,
+		txData.gasTipCap,
 		txData.nonce,
 		txData.height)
 	if err != nil {
@@ -258,57 +273,41 @@ func (signer *Signer) SignRevertTx(txData *OutboundData) (*ethtypes.Transaction,
 }
 
 // SignCancelTx signs a transaction from TSS address to itself with a zero amount in order to increment the nonce
-func (signer *Signer) SignCancelTx(nonce uint64, gasPrice *big.Int, height uint64) (*ethtypes.Transaction, error) {
-	// TODO: use EIP-1559 transaction type
-	// https://github.com/zeta-chain/node/issues/1952
-	tx := ethtypes.NewTransaction(nonce, signer.tssSigner.EVMAddress(), big.NewInt(0), 21000, gasPrice, nil)
-
-	hashBytes := signer.ethSigner.Hash(tx).Bytes()
-	sig, err := signer.tssSigner.Sign(hashBytes, height, nonce, signer.chain, "")
-	if err != nil {
-		return nil, err
-	}
-
-	pubk, err := crypto.SigToPub(hashBytes, sig[:])
-	if err != nil {
-		signer.logger.Std.Error().Err(err).Msgf("SigToPub error")
-	}
-
-	addr := crypto.PubkeyToAddress(*pubk)
-	signer.logger.Std.Info().Msgf("Sign: Ecrecovery of signature: %s", addr.Hex())
-	signedTX, err := tx.WithSignature(signer.ethSigner, sig[:])
+func (signer *Signer) SignCancelTx(txData *OutboundData) (*ethtypes.Transaction, error) {
+	tx, _, _, err := signer.Sign(
+		nil,
+		signer.TSS().EVMAddress(),
+		big.NewInt(0),
+		21000,
+		txData.gasPrice,
+		txData.gasTipCap,
+		txData.nonce,
+		txData.height,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	return signedTX, nil
+	return tx, nil
 }
 
 // SignWithdrawTx signs a withdrawal transaction sent from the TSS address to the destination
 func (signer *Signer) SignWithdrawTx(txData *OutboundData) (*ethtypes.Transaction, error) {
-	// TODO: use EIP-1559 transaction type
-	// https://github.com/zeta-chain/node/issues/1952
-	tx := ethtypes.NewTransaction(txData.nonce, txData.to, txData.amount, 21000, txData.gasPrice, nil)
-
-	hashBytes := signer.ethSigner.Hash(tx).Bytes()
-	sig, err := signer.tssSigner.Sign(hashBytes, txData.height, txData.nonce, signer.chain, "")
-	if err != nil {
-		return nil, err
-	}
-
-	pubk, err := crypto.SigToPub(hashBytes, sig[:])
-	if err != nil {
-		signer.logger.Std.Error().Err(err).Msgf("SigToPub error")
-	}
-
-	addr := crypto.PubkeyToAddress(*pubk)
-	signer.logger.Std.Info().Msgf("Sign: Ecrecovery of signature: %s", addr.Hex())
-	signedTX, err := tx.WithSignature(signer.ethSigner, sig[:])
+	tx, _, _, err := signer.Sign(
+		nil,
+		txData.to,
+		txData.amount,
+		21000,
+		txData.gasPrice,
+		txData.gasTipCap,
+		txData.nonce,
+		txData.height,
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	return signedTX, nil
+	return tx, nil
 }
 
 // SignCommandTx signs a transaction based on the given command includes:
@@ -336,7 +335,7 @@ func (signer *Signer) TryProcessOutbound(
 	zetacoreClient interfaces.ZetacoreClient,
 	height uint64,
 ) {
-	logger := signer.logger.Std.With().
+	logger := signer.Logger().Std.With().
 		Str("outboundID", outboundID).
 		Str("SendHash", cctx.Index).
 		Logger()
@@ -369,27 +368,46 @@ func (signer *Signer) TryProcessOutbound(
 		return
 	}
 
+	// re-broadcast the outbound already signed (e.g. before a restart) instead of re-entering keysign,
+	// unless the gas price or the priority fee of the cctx has been increased since
+	if tx, attempts, found := evmObserver.GetSignedOutbound(outboundID); found && txData.isFeeCoveredBy(tx) {
+		logger.Info().Msgf(
+			"found signed outbound %s for nonce %d in journal, broadcast %d times, skipping keysign",
+			tx.Hash().Hex(),
+			tx.Nonce(),
+			attempts,
+		)
+		signer.broadcastJournaledOutbound(tx, cctx, logger, myID, zetacoreClient, txData, evmObserver, outboundID)
+		return
+	}
+
+	// in case there is a pending transaction, make sure this keysign is a transaction replacement
+	// checked after the journal as the journaled outbounds are restored as pending transactions
+	if txData.waitForPendingTx(evmObserver, logger) {
+		return
+	}
+
 	// Get destination chain for logging
-	toChain := chains.GetChainFromChainID(txData.toChainID.Int64())
+	toChain := chains.GetChainFromChainID(txData.toChainID.Int64(), signer.CoreContext().GetAdditionalChains())
 
 	// Get cross-chain flags
-	crossChainflags := signer.coreContext.GetCrossChainFlags()
+	crossChainflags := signer.CoreContext().GetCrossChainFlags()
 	// https://github.com/zeta-chain/node/issues/2050
 	var tx *ethtypes.Transaction
 	// compliance check goes first
 	if compliance.IsCctxRestricted(cctx) {
 		compliance.PrintComplianceLog(
 			logger,
-			signer.logger.Compliance,
+			signer.Logger().Compliance,
 			true,
-			signer.chain.ChainId,
+			signer.Chain().ChainId,
 			cctx.Index,
 			cctx.InboundParams.Sender,
 			txData.to.Hex(),
 			cctx.GetCurrentOutboundParam().CoinType.String(),
 		)
 
-		tx, err = signer.SignCancelTx(txData.nonce, txData.gasPrice, height) // cancel the tx
+		tx, err = signer.SignCancelTx(txData) // cancel the tx
 		if err != nil {
 			logger.Warn().Err(err).Msg(ErrorMsg(cctx))
 			return
@@ -522,7 +540,33 @@ func (signer *Signer) TryProcessOutbound(
 		cctx.GetCurrentOutboundParam().TssNonce,
 	)
 
+	// journal the signed tx before broadcasting it
+	if tx != nil {
+		if err := evmObserver.SaveSignedOutbound(outboundID, tx); err != nil {
+			logger.Error().Err(err).Msgf("error journaling signed outbound %s", tx.Hash().Hex())
+		}
+	}
+
 	// Broadcast Signed Tx
+	signer.broadcastJournaledOutbound(tx, cctx, logger, myID, zetacoreClient, txData, evmObserver, outboundID)
+}
+
+// broadcastJournaledOutbound broadcasts the signed tx and records the broadcast attempt in the journal
+func (signer *Signer) broadcastJournaledOutbound(
+	tx *ethtypes.Transaction,
+	cctx *types.CrossChainTx,
+	logger zerolog.Logger,
+	myID sdk.AccAddress,
+	zetacoreClient interfaces.ZetacoreClient,
+	txData *OutboundData,
+	evmObserver *observer.Observer,
+	outboundID string,
+) {
+	if tx != nil {
+		if err := evmObserver.RecordBroadcastAttempt(outboundID); err != nil {
+			logger.Error().Err(err).Msgf("error recording broadcast attempt of outbound %s", tx.Hash().Hex())
+		}
+	}
 	signer.BroadcastOutbound(tx, cctx, logger, myID, zetacoreClient, txData)
 }
 
@@ -535,7 +579,7 @@ func (signer *Signer) BroadcastOutbound(
 	zetacoreClient interfaces.ZetacoreClient,
 	txData *OutboundData) {
 	// Get destination chain for logging
-	toChain := chains.GetChainFromChainID(txData.toChainID.Int64())
+	toChain := chains.GetChainFromChainID(txData.toChainID.Int64(), signer.CoreContext().GetAdditionalChains())
 	if tx == nil {
 		logger.Warn().Msgf("BroadcastOutbound: no tx to broadcast %s", cctx.Index)
 	}
@@ -543,7 +587,7 @@ func (signer *Signer) BroadcastOutbound(
 	if tx != nil {
 		outboundHash := tx.Hash().Hex()
 		logger.Info().
-			Msgf("on chain %s nonce %d, outboundHash %s signer %s", signer.chain, cctx.GetCurrentOutboundParam().TssNonce, outboundHash, myID)
+			Msgf("on chain %s nonce %d, outboundHash %s signer %s", signer.Chain().ChainName, cctx.GetCurrentOutboundParam().TssNonce, outboundHash, myID)
 		//if len(signers) == 0 || myid == signers[send.OutboundParams.Broadcaster] || myid == signers[int(send.OutboundParams.Broadcaster+1)%len(signers)] {
 		backOff := 1000 * time.Millisecond
 		// retry loop: 1s, 2s, 4s, 8s, 16s in case of RPC error
@@ -595,8 +639,10 @@ func (signer *Signer) SignERC20WithdrawTx(txData *OutboundData) (*ethtypes.Trans
 	tx, _, _, err := signer.Sign(
 		data,
 		signer.er20CustodyAddress,
+		big.NewInt(0),
 		txData.gasLimit,
 		txData.gasPrice,
+		txData.gasTipCap,
 		txData.nonce,
 		txData.height,
 	)
@@ -621,6 +667,7 @@ func (signer *Signer) SignWhitelistTx(
 	gasLimit uint64,
 	nonce uint64,
 	gasPrice *big.Int,
+	gasTipCap *big.Int,
 	height uint64,
 ) (*ethtypes.Transaction, error) {
 	var data []byte
@@ -632,7 +679,16 @@ func (signer *Signer) SignWhitelistTx(
 		return nil, fmt.Errorf("pack error: %w", err)
 	}
 
-	tx, _, _, err := signer.Sign(data, signer.er20CustodyAddress, gasLimit, gasPrice, nonce, height)
+	tx, _, _, err := signer.Sign(
+		data,
+		signer.er20CustodyAddress,
+		big.NewInt(0),
+		gasLimit,
+		gasPrice,
+		gasTipCap,
+		nonce,
+		height,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("Sign error: %w", err)
 	}
@@ -642,13 +698,6 @@ func (signer *Signer) SignWhitelistTx(
 
 // Exported for unit tests
 
-// GetReportedTxList returns a list of outboundHash being reported
-// TODO: investigate pointer usage
-// https://github.com/zeta-chain/node/issues/2084
-func (signer *Signer) GetReportedTxList() *map[string]bool {
-	return &signer.outboundHashBeingReported
-}
-
 func (signer *Signer) EvmClient() interfaces.EVMRPCClient {
 	return signer.client
 }
@@ -691,8 +740,10 @@ func (signer *Signer) SignWhitelistERC20Cmd(txData *OutboundData, params string)
 	tx, _, _, err := signer.Sign(
 		data,
 		txData.to,
+		big.NewInt(0),
 		txData.gasLimit,
 		txData.gasPrice,
+		txData.gasTipCap,
 		outboundParams.TssNonce,
 		txData.height,
 	)
@@ -707,8 +758,10 @@ func (signer *Signer) SignMigrateTssFundsCmd(txData *OutboundData) (*ethtypes.Tr
 	tx, _, _, err := signer.Sign(
 		nil,
 		txData.to,
+		big.NewInt(0),
 		txData.gasLimit,
 		txData.gasPrice,
+		txData.gasTipCap,
 		outboundParams.TssNonce,
 		txData.height,
 	)
@@ -727,22 +780,15 @@ func (signer *Signer) reportToOutboundTracker(
 	logger zerolog.Logger,
 ) {
 	// skip if already being reported
-	signer.mu.Lock()
-	defer signer.mu.Unlock()
-	if _, found := signer.outboundHashBeingReported[outboundHash]; found {
+	if signer.SetBeingReportedFlag(outboundHash) {
 		logger.Info().
 			Msgf("reportToOutboundTracker: outboundHash %s for chain %d nonce %d is being reported", outboundHash, chainID, nonce)
 		return
 	}
-	signer.outboundHashBeingReported[outboundHash] = true // mark as being reported
 
 	// report to outbound tracker with goroutine
 	go func() {
-		defer func() {
-			signer.mu.Lock()
-			delete(signer.outboundHashBeingReported, outboundHash)
-			signer.mu.Unlock()
-		}()
+		defer signer.ClearBeingReportedFlag(outboundHash)
 
 		// try monitoring tx inclusion status for 10 minutes
 		var err error
@@ -830,15 +876,21 @@ func (signer *Signer) reportToOutboundTracker(
 }
 
 // getEVMRPC is a helper function to set up the client and signer, also initializes a mock client for unit tests
-func getEVMRPC(endpoint string) (interfaces.EVMRPCClient, ethtypes.Signer, error) {
-	if endpoint == mocks.EVMRPCEnabled {
+// the client fails over between the endpoints, the first endpoint being the primary one
+func getEVMRPC(
+	chain chains.Chain,
+	endpoints []string,
+	logger zerolog.Logger,
+) (interfaces.EVMRPCClient, ethtypes.Signer, error) {
+	if len(endpoints) == 1 && endpoints[0] == mocks.EVMRPCEnabled {
 		chainID := big.NewInt(chains.BscMainnet.ChainId)
 		ethSigner := ethtypes.NewLondonSigner(chainID)
 		client := &mocks.MockEvmClient{}
 		return client, ethSigner, nil
 	}
 
-	client, err := ethclient.Dial(endpoint)
+	evmCfg := config.EVMConfig{Chain: chain, Endpoints: endpoints}
+	client, err := evm.NewRPCClient(evmCfg, rpcpool.ModuleSigner, logger)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -851,6 +903,34 @@ func getEVMRPC(endpoint string) (interfaces.EVMRPCClient, ethtypes.Signer, error
 	return client, ethSigner, nil
 }
 
+// newTx builds an unsigned outbound transaction
+// an EIP-1559 dynamic fee transaction is built if gasTipCap is not nil, otherwise a legacy transaction is built
+func newTx(
+	chainID *big.Int,
+	data []byte,
+	to ethcommon.Address,
+	amount *big.Int,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	gasTipCap *big.Int,
+	nonce uint64,
+) *ethtypes.Transaction {
+	if gasTipCap == nil {
+		return ethtypes.NewTransaction(nonce, to, amount, gasLimit, gasPrice, data)
+	}
+
+	return ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasPrice,
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     amount,
+		Data:      data,
+	})
+}
+
 func roundUpToNearestGwei(gasPrice *big.Int) *big.Int {
 	oneGwei := big.NewInt(1_000_000_000) // 1 Gwei
 	mod := new(big.Int)
@@ -1,9 +1,11 @@
 package signer
 
 import (
+	"math/big"
 	"testing"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
@@ -12,6 +14,7 @@ import (
 	"github.com/zeta-chain/zetacore/pkg/constant"
 	"github.com/zeta-chain/zetacore/testutil/sample"
 	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/base"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/evm/observer"
 	"github.com/zeta-chain/zetacore/zetaclient/common"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
@@ -37,7 +40,7 @@ func getNewEvmSigner() (*Signer, error) {
 	cfg := config.NewConfig()
 	return NewSigner(
 		chains.BscMainnet,
-		mocks.EVMRPCEnabled,
+		[]string{mocks.EVMRPCEnabled},
 		mocks.NewTSSMainnet(),
 		config.GetConnectorABI(),
 		config.GetERC20CustodyABI(),
@@ -116,7 +119,44 @@ func TestSigner_TryProcessOutbound(t *testing.T) {
 
 	// Check if cctx was signed and broadcasted
 	list := evmSigner.GetReportedTxList()
-	require.Len(t, *list, 1)
+	require.Len(t, list, 1)
+}
+
+func TestSigner_TryProcessOutboundFromJournal(t *testing.T) {
+	evmSigner, err := getNewEvmSigner()
+	require.NoError(t, err)
+	cctx := getCCTX(t)
+	outboundID := "journaled"
+	dbPath := t.TempDir()
+	t.Setenv(chains.BscMainnet.ChainName.String()+base.EnvVarScanFromSuffix, "100")
+
+	// journal the outbound signed before a restart
+	ob, err := getNewEvmChainObserver()
+	require.NoError(t, err)
+	require.NoError(t, ob.LoadDB(dbPath, chains.BscMainnet))
+	txData, skip, err := NewOutboundData(cctx, ob, evmSigner.EvmClient(), zerolog.Logger{}, 123)
+	require.False(t, skip)
+	require.NoError(t, err)
+	tx, err := evmSigner.SignERC20WithdrawTx(txData)
+	require.NoError(t, err)
+	require.NoError(t, ob.SaveSignedOutbound(outboundID, tx))
+
+	// the restarted observer reloads the journaled outbound as pending tx
+	restarted, err := getNewEvmChainObserver()
+	require.NoError(t, err)
+	require.NoError(t, restarted.LoadDB(dbPath, chains.BscMainnet))
+	require.Equal(t, tx.Hash(), restarted.GetPendingTx(tx.Nonce()).Hash())
+
+	// the journaled outbound is re-broadcast instead of waiting for the pending tx
+	client := mocks.NewMockZetacoreClient().WithKeys(&keys.Keys{})
+	evmSigner.TryProcessOutbound(cctx, getNewOutboundProcessor(), outboundID, restarted, client, 123)
+
+	list := evmSigner.GetReportedTxList()
+	require.Len(t, list, 1)
+	require.True(t, list[tx.Hash().Hex()])
+	_, attempts, found := restarted.GetSignedOutbound(outboundID)
+	require.True(t, found)
+	require.EqualValues(t, 1, attempts)
 }
 
 func TestSigner_SignOutbound(t *testing.T) {
@@ -207,6 +247,52 @@ func TestSigner_SignWithdrawTx(t *testing.T) {
 	})
 }
 
+func TestSigner_SignDynamicFeeTx(t *testing.T) {
+	// Setup evm signer
+	evmSigner, err := getNewEvmSigner()
+	require.NoError(t, err)
+
+	// Setup txData struct with EIP-1559 enabled
+	cctx := getCCTX(t)
+	mockObserver, err := getNewEvmChainObserver()
+	require.NoError(t, err)
+	chainParams := mockObserver.GetChainParams()
+	chainParams.IsEip1559Enabled = true
+	mockObserver.SetChainParams(chainParams)
+
+	client := evmSigner.EvmClient().(*mocks.MockEvmClient)
+	client.WithHeader(&ethtypes.Header{BaseFee: big.NewInt(1)}).WithGasTipCap(big.NewInt(1))
+	defer client.Reset()
+
+	txData, skip, err := NewOutboundData(cctx, mockObserver, client, zerolog.Logger{}, 123)
+	require.False(t, skip)
+	require.NoError(t, err)
+
+	t.Run("SignWithdrawTx - should sign a dynamic fee tx", func(t *testing.T) {
+		tx, err := evmSigner.SignWithdrawTx(txData)
+		require.NoError(t, err)
+		require.EqualValues(t, ethtypes.DynamicFeeTxType, tx.Type())
+		require.Equal(t, txData.gasPrice, tx.GasFeeCap())
+		require.Equal(t, big.NewInt(1), tx.GasTipCap())
+
+		// Verify Signature
+		tss := mocks.NewTSSMainnet()
+		_, r, s := tx.RawSignatureValues()
+		signature := append(r.Bytes(), s.Bytes()...)
+		hash := evmSigner.EvmSigner().Hash(tx)
+
+		verified := crypto.VerifySignature(tss.Pubkey(), hash.Bytes(), signature)
+		require.True(t, verified)
+	})
+
+	t.Run("SignCancelTx - should sign a dynamic fee tx", func(t *testing.T) {
+		tx, err := evmSigner.SignCancelTx(txData)
+		require.NoError(t, err)
+		require.EqualValues(t, ethtypes.DynamicFeeTxType, tx.Type())
+		require.Equal(t, mocks.NewTSSMainnet().EVMAddress(), *tx.To())
+	})
+}
+
 func TestSigner_SignCommandTx(t *testing.T) {
 	// Setup evm signer
 	evmSigner, err := getNewEvmSigner()
@@ -312,13 +398,13 @@ func TestSigner_BroadcastOutbound(t *testing.T) {
 
 		//Check if cctx was signed and broadcasted
 		list := evmSigner.GetReportedTxList()
-		require.Len(t, *list, 1)
+		require.Len(t, list, 1)
 	})
 }
 
 func TestSigner_getEVMRPC(t *testing.T) {
 	t.Run("getEVMRPC error dialing", func(t *testing.T) {
-		client, signer, err := getEVMRPC("invalidEndpoint")
+		client, signer, err := getEVMRPC(chains.BscMainnet, []string{"invalidEndpoint"}, zerolog.Nop())
 		require.Nil(t, client)
 		require.Nil(t, signer)
 		require.Error(t, err)
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"crypto/ed25519"
 	"math/big"
 
 	sdkmath "cosmossdk.io/math"
@@ -10,6 +11,8 @@ import (
 	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -23,8 +26,11 @@ import (
 	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
 	lightclienttypes "github.com/zeta-chain/zetacore/x/lightclient/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/solana"
 	keyinterfaces "github.com/zeta-chain/zetacore/zetaclient/keys/interfaces"
+	"github.com/zeta-chain/zetacore/zetaclient/metrics"
 	"github.com/zeta-chain/zetacore/zetaclient/outboundprocessor"
+	"github.com/zeta-chain/zetacore/zetaclient/rescan"
 )
 
 type Order string
@@ -46,6 +52,25 @@ type ChainObserver interface {
 	WatchInboundTracker()
 }
 
+// ChainObserverStatus is implemented by the chain observers reporting their status to the telemetry server
+type ChainObserverStatus interface {
+	Status() metrics.ChainStatus
+}
+
+// InboundRescanner is implemented by the chain observers able to re-observe the inbounds of past blocks
+type InboundRescanner interface {
+	// RescanInbound re-observes the inbounds of the confirmed blocks [fromBlock, toBlock] and posts the votes
+	// of the inbounds without cctx, the votes are not posted in dry-run
+	// the last scanned block of the observer is left unchanged
+	RescanInbound(fromBlock uint64, toBlock uint64, dryRun bool) (*rescan.Report, error)
+}
+
+// OutboundRebroadcaster is implemented by the chain signers able to re-broadcast a signed outbound
+type OutboundRebroadcaster interface {
+	// RebroadcastOutbound re-broadcasts the outbound of the nonce signed by TSS and returns its hash
+	RebroadcastOutbound(observer ChainObserver, nonce uint64) (string, error)
+}
+
 // ChainSigner is the interface to sign transactions for a chain
 type ChainSigner interface {
 	TryProcessOutbound(
@@ -101,7 +126,9 @@ type ZetacoreClient interface {
 	ListPendingCctxWithinRatelimit() ([]*crosschaintypes.CrossChainTx, uint64, int64, string, bool, error)
 	GetRateLimiterInput(window int64) (crosschaintypes.QueryRateLimiterInputResponse, error)
 	GetPendingNoncesByChain(chainID int64) (observertypes.PendingNonces, error)
+	GetCctxByHash(sendHash string) (*crosschaintypes.CrossChainTx, error)
 	GetCctxByNonce(chainID int64, nonce uint64) (*crosschaintypes.CrossChainTx, error)
+	GetInboundHashToCctxData(inboundHash string) (*crosschaintypes.QueryInboundHashToCctxDataResponse, error)
 	GetOutboundTracker(chain chains.Chain, nonce uint64) (*crosschaintypes.OutboundTracker, error)
 	GetAllOutboundTrackerByChain(chainID int64, order Order) ([]crosschaintypes.OutboundTracker, error)
 	GetCrosschainFlags() (observertypes.CrosschainFlags, error)
@@ -133,6 +160,7 @@ type BTCRPCClient interface {
 	GetBlockVerbose(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseResult, error)
 	GetBlockVerboseTx(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseTxResult, error)
 	GetBlockHeader(blockHash *chainhash.Hash) (*wire.BlockHeader, error)
+	GetAddressInfo(address string) (*btcjson.GetAddressInfoResult, error)
 }
 
 // EVMRPCClient is the interface for EVM RPC client
@@ -159,6 +187,43 @@ type EVMJSONRPCClient interface {
 	EthGetTransactionByHash(hash string) (*ethrpc.Transaction, error)
 }
 
+// EVMSubscriptionClient is the interface for EVM websocket client subscribing to new heads and logs
+type EVMSubscriptionClient interface {
+	SubscribeNewHead(ctx context.Context, ch chan<- *ethtypes.Header) (ethereum.Subscription, error)
+	SubscribeFilterLogs(
+		ctx context.Context,
+		query ethereum.FilterQuery,
+		ch chan<- ethtypes.Log,
+	) (ethereum.Subscription, error)
+	Close()
+}
+
+// ZetacoreEventsClient is the interface for CometBFT websocket client subscribing to zetacore blocks and tx events
+type ZetacoreEventsClient interface {
+	Subscribe(
+		ctx context.Context,
+		subscriber string,
+		query string,
+		outCapacity ...int,
+	) (<-chan coretypes.ResultEvent, error)
+	Stop() error
+}
+
+// SolanaRPCClient is the interface for Solana RPC client
+type SolanaRPCClient interface {
+	GetHealth(ctx context.Context) error
+	GetSlot(ctx context.Context, commitment solana.Commitment) (uint64, error)
+	GetBalance(ctx context.Context, address string, commitment solana.Commitment) (uint64, error)
+	GetBlock(ctx context.Context, slot uint64, commitment solana.Commitment) (*solana.BlockResult, error)
+	GetSignaturesForAddress(
+		ctx context.Context,
+		address string,
+		opts solana.SignaturesForAddressOpts,
+	) ([]solana.SignatureInfo, error)
+	GetTransaction(ctx context.Context, signature string, commitment solana.Commitment) (*solana.TransactionResult, error)
+	SendTransaction(ctx context.Context, tx *solana.Transaction) (string, error)
+}
+
 // TSSSigner is the interface for TSS signer
 type TSSSigner interface {
 	Pubkey() []byte
@@ -170,7 +235,21 @@ type TSSSigner interface {
 	Sign(data []byte, height uint64, nonce uint64, chain *chains.Chain, optionalPubkey string) ([65]byte, error)
 
 	EVMAddress() ethcommon.Address
-	BTCAddress() string
-	BTCAddressWitnessPubkeyHash() *btcutil.AddressWitnessPubKeyHash
+
+	// BTCAddress returns the TSS address on the bitcoin chain
+	BTCAddress(chainID int64) string
+
+	// BTCAddressWitnessPubkeyHash returns the TSS p2wpkh address on the bitcoin chain
+	BTCAddressWitnessPubkeyHash(chainID int64) *btcutil.AddressWitnessPubKeyHash
+
 	PubKeyCompressedBytes() []byte
 }
+
+// TSSSignerEdDSA is the interface for TSS signer producing ed25519 signatures (e.g. for Solana)
+type TSSSignerEdDSA interface {
+	// PubKeyEdDSA returns the ed25519 public key of the TSS
+	PubKeyEdDSA() ed25519.PublicKey
+
+	// SignEdDSA signs the message with the ed25519 key of the TSS
+	SignEdDSA(msg []byte, height uint64, nonce uint64, chain *chains.Chain) ([64]byte, error)
+}
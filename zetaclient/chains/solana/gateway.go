@@ -0,0 +1,197 @@
+package solana
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"filippo.io/edwards25519"
+	"github.com/pkg/errors"
+)
+
+// The gateway program holds the SOL deposited to ZetaChain in a program derived account (PDA) and releases it
+// to withdrawal recipients on instructions signed by the TSS. Its instructions follow the Anchor encoding:
+// an 8-byte discriminator followed by the borsh encoded arguments.
+//
+//	deposit(amount: u64, memo: Vec<u8>)  accounts: [depositor (signer, writable), pda (writable), system program]
+//	withdraw(amount: u64, nonce: u64)    accounts: [tss (signer, writable), pda (writable), recipient (writable)]
+//
+// The deposit memo is the 20-byte receiver address on ZetaChain optionally followed by the message of the call.
+// The gateway stores the TSS address and the next withdrawal nonce in its PDA, a withdrawal is only accepted
+// if it is signed by the TSS and carries the next nonce.
+const (
+	// GatewayPDASeed is the seed of the gateway PDA
+	GatewayPDASeed = "meta"
+
+	// depositDataMinLen is the length of the deposit data up to the memo bytes
+	depositDataMinLen = 8 + 8 + 4
+
+	// withdrawDataLen is the length of the withdraw data
+	withdrawDataLen = 8 + 8 + 8
+)
+
+var (
+	// DiscriminatorDeposit is the discriminator of the gateway deposit instruction
+	DiscriminatorDeposit = anchorDiscriminator("deposit")
+
+	// DiscriminatorWithdraw is the discriminator of the gateway withdraw instruction
+	DiscriminatorWithdraw = anchorDiscriminator("withdraw")
+
+	// SystemProgramID is the address of the Solana system program
+	SystemProgramID = PublicKey{}
+)
+
+// DepositInstruction is the data of a gateway deposit instruction
+type DepositInstruction struct {
+	// Amount is the deposited lamports
+	Amount uint64
+
+	// Memo is the receiver address on ZetaChain optionally followed by the message
+	Memo []byte
+}
+
+// WithdrawInstruction is the data of a gateway withdraw instruction
+type WithdrawInstruction struct {
+	// Amount is the withdrawn lamports
+	Amount uint64
+
+	// Nonce is the TSS nonce of the outbound
+	Nonce uint64
+}
+
+// Data returns the instruction data of the deposit
+func (inst DepositInstruction) Data() []byte {
+	data := make([]byte, 0, depositDataMinLen+len(inst.Memo))
+	data = append(data, DiscriminatorDeposit[:]...)
+	data = binary.LittleEndian.AppendUint64(data, inst.Amount)
+	// #nosec G701 memo length is bounded by the transaction size
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(inst.Memo)))
+	return append(data, inst.Memo...)
+}
+
+// Data returns the instruction data of the withdrawal
+func (inst WithdrawInstruction) Data() []byte {
+	data := make([]byte, 0, withdrawDataLen)
+	data = append(data, DiscriminatorWithdraw[:]...)
+	data = binary.LittleEndian.AppendUint64(data, inst.Amount)
+	return binary.LittleEndian.AppendUint64(data, inst.Nonce)
+}
+
+// ParseDepositInstruction decodes the data of a gateway deposit instruction
+func ParseDepositInstruction(data []byte) (DepositInstruction, error) {
+	if len(data) < depositDataMinLen {
+		return DepositInstruction{}, fmt.Errorf("deposit data too short: %d", len(data))
+	}
+	if !bytes.Equal(data[:8], DiscriminatorDeposit[:]) {
+		return DepositInstruction{}, errors.New("not a deposit instruction")
+	}
+	memoLen := binary.LittleEndian.Uint32(data[16:20])
+	if uint64(len(data)) != depositDataMinLen+uint64(memoLen) {
+		return DepositInstruction{}, fmt.Errorf("invalid memo length %d for deposit data length %d", memoLen, len(data))
+	}
+	return DepositInstruction{
+		Amount: binary.LittleEndian.Uint64(data[8:16]),
+		Memo:   data[depositDataMinLen:],
+	}, nil
+}
+
+// ParseWithdrawInstruction decodes the data of a gateway withdraw instruction
+func ParseWithdrawInstruction(data []byte) (WithdrawInstruction, error) {
+	if len(data) != withdrawDataLen {
+		return WithdrawInstruction{}, fmt.Errorf("invalid withdraw data length: %d", len(data))
+	}
+	if !bytes.Equal(data[:8], DiscriminatorWithdraw[:]) {
+		return WithdrawInstruction{}, errors.New("not a withdraw instruction")
+	}
+	return WithdrawInstruction{
+		Amount: binary.LittleEndian.Uint64(data[8:16]),
+		Nonce:  binary.LittleEndian.Uint64(data[16:24]),
+	}, nil
+}
+
+// NewDepositInstruction returns a gateway instruction depositing lamports from the depositor
+func NewDepositInstruction(gatewayID, depositor PublicKey, amount uint64, memo []byte) (Instruction, error) {
+	pda, _, err := GatewayPDA(gatewayID)
+	if err != nil {
+		return Instruction{}, err
+	}
+	return Instruction{
+		ProgramID: gatewayID,
+		Accounts: []AccountMeta{
+			{PublicKey: depositor, IsSigner: true, IsWritable: true},
+			{PublicKey: pda, IsWritable: true},
+			{PublicKey: SystemProgramID},
+		},
+		Data: DepositInstruction{Amount: amount, Memo: memo}.Data(),
+	}, nil
+}
+
+// NewWithdrawInstruction returns a gateway instruction withdrawing lamports to the recipient, signed by the TSS
+func NewWithdrawInstruction(gatewayID, tss, recipient PublicKey, amount, nonce uint64) (Instruction, error) {
+	pda, _, err := GatewayPDA(gatewayID)
+	if err != nil {
+		return Instruction{}, err
+	}
+	return Instruction{
+		ProgramID: gatewayID,
+		Accounts: []AccountMeta{
+			{PublicKey: tss, IsSigner: true, IsWritable: true},
+			{PublicKey: pda, IsWritable: true},
+			{PublicKey: recipient, IsWritable: true},
+		},
+		Data: WithdrawInstruction{Amount: amount, Nonce: nonce}.Data(),
+	}, nil
+}
+
+// GatewayPDA returns the PDA of the gateway program and its bump seed
+func GatewayPDA(gatewayID PublicKey) (PublicKey, uint8, error) {
+	return FindProgramAddress([][]byte{[]byte(GatewayPDASeed)}, gatewayID)
+}
+
+// FindProgramAddress returns the first valid program derived address of the seeds, searching the bump seed from 255
+func FindProgramAddress(seeds [][]byte, programID PublicKey) (PublicKey, uint8, error) {
+	for bump := 255; bump >= 0; bump-- {
+		// #nosec G701 always in range
+		address, err := CreateProgramAddress(append(seeds, []byte{uint8(bump)}), programID)
+		if err == nil {
+			// #nosec G701 always in range
+			return address, uint8(bump), nil
+		}
+	}
+	return PublicKey{}, 0, errors.New("unable to find a valid program address")
+}
+
+// CreateProgramAddress derives a program address from the seeds, the address must not be on the ed25519 curve
+func CreateProgramAddress(seeds [][]byte, programID PublicKey) (PublicKey, error) {
+	h := sha256.New()
+	for _, seed := range seeds {
+		if len(seed) > 32 {
+			return PublicKey{}, fmt.Errorf("seed too long: %d", len(seed))
+		}
+		h.Write(seed)
+	}
+	h.Write(programID[:])
+	h.Write([]byte("ProgramDerivedAddress"))
+
+	var address PublicKey
+	copy(address[:], h.Sum(nil))
+	if IsOnCurve(address) {
+		return PublicKey{}, errors.New("program address is on the ed25519 curve")
+	}
+	return address, nil
+}
+
+// IsOnCurve returns true if the address is a point of the ed25519 curve (an address with a private key)
+func IsOnCurve(address PublicKey) bool {
+	_, err := new(edwards25519.Point).SetBytes(address[:])
+	return err == nil
+}
+
+// anchorDiscriminator returns the Anchor discriminator of a global instruction
+func anchorDiscriminator(name string) [8]byte {
+	var discriminator [8]byte
+	h := sha256.Sum256([]byte("global:" + name))
+	copy(discriminator[:], h[:8])
+	return discriminator
+}
@@ -0,0 +1,83 @@
+package solana
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatewayPDA(t *testing.T) {
+	gatewayID, err := PublicKeyFromBase58("94U5AHQMKkV5txNJ17QPXWoh474PheGou6cNP2FEuL1d")
+	require.NoError(t, err)
+
+	pda, bump, err := GatewayPDA(gatewayID)
+	require.NoError(t, err)
+	require.False(t, IsOnCurve(pda))
+
+	// the PDA is derived from the seed and the bump
+	derived, err := CreateProgramAddress([][]byte{[]byte(GatewayPDASeed), {bump}}, gatewayID)
+	require.NoError(t, err)
+	require.Equal(t, pda, derived)
+
+	// a key pair address is on the curve
+	address, _ := newTestKey(t)
+	require.True(t, IsOnCurve(address))
+}
+
+func TestDepositInstruction(t *testing.T) {
+	t.Run("should encode and parse deposit", func(t *testing.T) {
+		deposit := DepositInstruction{Amount: 1_000_000, Memo: []byte("receiver address and message")}
+		data := deposit.Data()
+		require.Equal(t, DiscriminatorDeposit[:], data[:8])
+
+		parsed, err := ParseDepositInstruction(data)
+		require.NoError(t, err)
+		require.Equal(t, deposit, parsed)
+	})
+	t.Run("should fail on invalid memo length", func(t *testing.T) {
+		data := DepositInstruction{Amount: 1, Memo: []byte{1, 2, 3}}.Data()
+		_, err := ParseDepositInstruction(data[:len(data)-1])
+		require.Error(t, err)
+	})
+	t.Run("should fail on withdraw data", func(t *testing.T) {
+		data := WithdrawInstruction{Amount: 1, Nonce: 2}.Data()
+		_, err := ParseDepositInstruction(data)
+		require.Error(t, err)
+	})
+}
+
+func TestWithdrawInstruction(t *testing.T) {
+	t.Run("should encode and parse withdrawal", func(t *testing.T) {
+		withdrawal := WithdrawInstruction{Amount: 1_000_000, Nonce: 42}
+		data := withdrawal.Data()
+		require.Len(t, data, withdrawDataLen)
+		require.Equal(t, DiscriminatorWithdraw[:], data[:8])
+
+		parsed, err := ParseWithdrawInstruction(data)
+		require.NoError(t, err)
+		require.Equal(t, withdrawal, parsed)
+	})
+	t.Run("should fail on deposit data", func(t *testing.T) {
+		data := DepositInstruction{Amount: 1, Memo: []byte{1, 2, 3, 4}}.Data()
+		_, err := ParseWithdrawInstruction(data[:withdrawDataLen])
+		require.Error(t, err)
+	})
+}
+
+func TestNewWithdrawInstruction(t *testing.T) {
+	gatewayID, _ := newTestKey(t)
+	tss, _ := newTestKey(t)
+	recipient, _ := newTestKey(t)
+	pda, _, err := GatewayPDA(gatewayID)
+	require.NoError(t, err)
+
+	inst, err := NewWithdrawInstruction(gatewayID, tss, recipient, 100, 1)
+	require.NoError(t, err)
+	require.Equal(t, gatewayID, inst.ProgramID)
+	require.Equal(t, []AccountMeta{
+		{PublicKey: tss, IsSigner: true, IsWritable: true},
+		{PublicKey: pda, IsWritable: true},
+		{PublicKey: recipient, IsWritable: true},
+	}, inst.Accounts)
+	require.Equal(t, WithdrawInstruction{Amount: 100, Nonce: 1}.Data(), inst.Data)
+}
@@ -0,0 +1,331 @@
+package observer
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	cosmosmath "cosmossdk.io/math"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/pkg/coin"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/solana"
+	"github.com/zeta-chain/zetacore/zetaclient/compliance"
+	clientcontext "github.com/zeta-chain/zetacore/zetaclient/context"
+	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
+	"github.com/zeta-chain/zetacore/zetaclient/zetacore"
+)
+
+// InboundEvent is a deposit to the gateway program
+type InboundEvent struct {
+	// Sender is the depositor address
+	Sender string
+
+	// Amount is the deposited lamports
+	Amount uint64
+
+	// Memo is the receiver address on ZetaChain optionally followed by the message
+	Memo []byte
+
+	// Slot is the slot of the deposit transaction
+	Slot uint64
+
+	// Signature is the signature of the deposit transaction
+	Signature string
+
+	// Index is the index of the deposit instruction in the transaction
+	Index uint
+}
+
+// WatchInbound watches the gateway program for deposits and post votes to zetacore
+func (ob *Observer) WatchInbound() {
+	ticker, err := clienttypes.NewDynamicTicker("Solana_WatchInbound", ob.GetChainParams().InboundTicker)
+	if err != nil {
+		ob.logger.Inbound.Error().Err(err).Msg("error creating ticker")
+		return
+	}
+	defer ticker.Stop()
+
+	ob.logger.Inbound.Info().Msgf("WatchInbound started for chain %d", ob.chain.ChainId)
+	sampledLogger := ob.logger.Inbound.Sample(&zerolog.BasicSampler{N: 10})
+
+	for {
+		select {
+		case <-ticker.C():
+			if !clientcontext.IsInboundObservationEnabled(ob.coreContext, ob.GetChainParams()) {
+				sampledLogger.Info().
+					Msgf("WatchInbound: inbound observation is disabled for chain %d", ob.chain.ChainId)
+				continue
+			}
+			err := ob.ObserveInbound()
+			if err != nil {
+				ob.logger.Inbound.Error().Err(err).Msg("WatchInbound error observing in tx")
+			}
+			ticker.UpdateInterval(ob.GetChainParams().InboundTicker, ob.logger.Inbound)
+		case <-ob.stop:
+			ob.logger.Inbound.Info().Msgf("WatchInbound stopped for chain %d", ob.chain.ChainId)
+			return
+		}
+	}
+}
+
+// ObserveInbound processes the finalized transactions of the gateway program since the last processed one
+func (ob *Observer) ObserveInbound() error {
+	gatewayID, err := ob.GatewayID()
+	if err != nil {
+		return err
+	}
+
+	// query the signatures after the last processed one, they are returned from newest to oldest
+	lastSignature := ob.GetLastSignature()
+	sigs := make([]solana.SignatureInfo, 0)
+	before := ""
+	for {
+		page, err := ob.rpcClient.GetSignaturesForAddress(
+			context.Background(),
+			gatewayID.String(),
+			solana.SignaturesForAddressOpts{
+				Limit:      solana.MaxSignaturesPerQuery,
+				Before:     before,
+				Until:      lastSignature,
+				Commitment: solana.CommitmentFinalized,
+			},
+		)
+		if err != nil {
+			return errors.Wrapf(err, "ObserveInbound: error getting signatures for gateway %s", gatewayID)
+		}
+		sigs = append(sigs, page...)
+		if len(page) < solana.MaxSignaturesPerQuery {
+			break
+		}
+		before = page[len(page)-1].Signature
+	}
+	if len(sigs) > 0 {
+		ob.logger.Inbound.Info().
+			Msgf("ObserveInbound: %d new transactions of gateway %s after %s", len(sigs), gatewayID, lastSignature)
+	}
+
+	// process the transactions from oldest to newest
+	for i := len(sigs) - 1; i >= 0; i-- {
+		sig := sigs[i]
+		if sig.Err == nil {
+			tx, err := ob.rpcClient.GetTransaction(context.Background(), sig.Signature, solana.CommitmentFinalized)
+			if err != nil {
+				return errors.Wrapf(err, "ObserveInbound: error getting transaction %s", sig.Signature)
+			}
+			if tx == nil {
+				return fmt.Errorf("ObserveInbound: transaction %s not found", sig.Signature)
+			}
+			events, err := ParseInboundEvents(tx, gatewayID, ob.logger.Inbound)
+			if err != nil {
+				// the transaction can't be parsed now or later, move on to the next one
+				ob.logger.Inbound.Error().Err(err).Msgf("ObserveInbound: error parsing transaction %s", sig.Signature)
+			}
+			for _, event := range events {
+				if err := ob.postInboundVote(event); err != nil {
+					return err // we have to re-process this transaction next time
+				}
+			}
+		}
+
+		ob.SaveLastSignature(sig.Signature)
+		ob.SetLastSlot(sig.Slot)
+	}
+
+	return nil
+}
+
+// WatchInboundTracker watches zetacore for solana inbound trackers
+func (ob *Observer) WatchInboundTracker() {
+	ticker, err := clienttypes.NewDynamicTicker("Solana_WatchInboundTracker", ob.GetChainParams().InboundTicker)
+	if err != nil {
+		ob.logger.Inbound.Err(err).Msg("error creating ticker")
+		return
+	}
+
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C():
+			if !clientcontext.IsInboundObservationEnabled(ob.coreContext, ob.GetChainParams()) {
+				continue
+			}
+			err := ob.ProcessInboundTrackers()
+			if err != nil {
+				ob.logger.Inbound.Error().
+					Err(err).
+					Msgf("error observing inbound tracker for chain %d", ob.chain.ChainId)
+			}
+			ticker.UpdateInterval(ob.GetChainParams().InboundTicker, ob.logger.Inbound)
+		case <-ob.stop:
+			ob.logger.Inbound.Info().Msgf("WatchInboundTracker stopped for chain %d", ob.chain.ChainId)
+			return
+		}
+	}
+}
+
+// ProcessInboundTrackers processes inbound trackers
+func (ob *Observer) ProcessInboundTrackers() error {
+	trackers, err := ob.zetacoreClient.GetInboundTrackersForChain(ob.chain.ChainId)
+	if err != nil {
+		return err
+	}
+	gatewayID, err := ob.GatewayID()
+	if err != nil {
+		return err
+	}
+
+	for _, tracker := range trackers {
+		ob.logger.Inbound.Info().
+			Msgf("checking tracker with hash :%s and coin-type :%s ", tracker.TxHash, tracker.CoinType)
+		tx, err := ob.rpcClient.GetTransaction(context.Background(), tracker.TxHash, solana.CommitmentFinalized)
+		if err != nil {
+			return err
+		}
+		if tx == nil {
+			return fmt.Errorf("inbound tracker transaction %s not finalized yet", tracker.TxHash)
+		}
+		events, err := ParseInboundEvents(tx, gatewayID, ob.logger.Inbound)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return fmt.Errorf("no solana deposit found in inbound tracker %s", tracker.TxHash)
+		}
+		for _, event := range events {
+			if err := ob.postInboundVote(event); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ParseInboundEvents returns the deposits to the gateway program in a successful transaction
+// Note: only deposits made by top-level instructions are observed, deposits made by other programs are not.
+func ParseInboundEvents(
+	tx *solana.TransactionResult,
+	gatewayID solana.PublicKey,
+	logger zerolog.Logger,
+) ([]*InboundEvent, error) {
+	if !tx.IsSuccessful() {
+		return nil, nil
+	}
+	instructions, err := tx.ProgramInstructions(gatewayID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*InboundEvent, 0)
+	for i, inst := range instructions {
+		if len(inst.Data) < len(solana.DiscriminatorDeposit) ||
+			!bytes.Equal(inst.Data[:len(solana.DiscriminatorDeposit)], solana.DiscriminatorDeposit[:]) {
+			continue
+		}
+		deposit, err := solana.ParseDepositInstruction(inst.Data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing deposit %d of transaction %s", i, tx.ID())
+		}
+		if len(inst.Accounts) == 0 {
+			return nil, fmt.Errorf("no depositor in deposit %d of transaction %s", i, tx.ID())
+		}
+		if len(deposit.Memo) < ethcommon.AddressLength {
+			logger.Warn().Msgf("ParseInboundEvents: memo too short in deposit %d of transaction %s", i, tx.ID())
+			continue
+		}
+		events = append(events, &InboundEvent{
+			Sender:    inst.Accounts[0],
+			Amount:    deposit.Amount,
+			Memo:      deposit.Memo,
+			Slot:      tx.Slot,
+			Signature: tx.ID(),
+			// #nosec G701 always positive
+			Index: uint(i),
+		})
+	}
+	return events, nil
+}
+
+// GetInboundVoteMessageFromEvent returns the inbound vote message of a deposit, nil if the deposit is restricted
+// an error is returned if the addresses of the deposit fail to be screened
+func (ob *Observer) GetInboundVoteMessageFromEvent(event *InboundEvent) (*crosschaintypes.MsgVoteInbound, error) {
+	ob.logger.Inbound.Debug().Msgf("Processing inbound: %s", event.Signature)
+
+	// compliance check
+	// if the inbound contains restricted addresses, return nil
+	restricted, err := ob.DoesInboundContainsRestrictedAddress(event)
+	if err != nil {
+		return nil, err
+	}
+	if restricted {
+		return nil, nil
+	}
+
+	return zetacore.GetInBoundVoteMessage(
+		event.Sender,
+		ob.chain.ChainId,
+		event.Sender,
+		event.Sender,
+		ob.zetacoreClient.Chain().ChainId,
+		cosmosmath.NewUint(event.Amount),
+		hex.EncodeToString(event.Memo),
+		event.Signature,
+		event.Slot,
+		0,
+		coin.CoinType_Gas,
+		"",
+		ob.zetacoreClient.GetKeys().GetOperatorAddress().String(),
+		event.Index,
+	), nil
+}
+
+// DoesInboundContainsRestrictedAddress returns true if the inbound contains restricted addresses
+// an error is returned if the addresses fail to be screened
+func (ob *Observer) DoesInboundContainsRestrictedAddress(event *InboundEvent) (bool, error) {
+	receiver := ""
+	parsedAddress, _, err := chains.ParseAddressAndData(hex.EncodeToString(event.Memo))
+	if err == nil && parsedAddress != (ethcommon.Address{}) {
+		receiver = parsedAddress.Hex()
+	}
+	restricted, err := compliance.ContainRestrictedAddress(event.Sender, receiver)
+	if err != nil {
+		return false, errors.Wrapf(err, "error screening inbound %s", event.Signature)
+	}
+	if restricted {
+		compliance.PrintComplianceLog(ob.logger.Inbound, ob.logger.Compliance,
+			false, ob.chain.ChainId, event.Signature, event.Sender, receiver, "SOL")
+	}
+	return restricted, nil
+}
+
+// postInboundVote posts the inbound vote of a deposit to zetacore
+func (ob *Observer) postInboundVote(event *InboundEvent) error {
+	msg, err := ob.GetInboundVoteMessageFromEvent(event)
+	if err != nil {
+		return err
+	}
+	if msg == nil {
+		return nil
+	}
+	zetaHash, ballot, err := ob.zetacoreClient.PostVoteInbound(
+		zetacore.PostVoteInboundGasLimit,
+		zetacore.PostVoteInboundExecutionGasLimit,
+		msg,
+	)
+	if err != nil {
+		ob.logger.Inbound.Error().
+			Err(err).
+			Msgf("postInboundVote: error posting to zetacore for inbound %s", event.Signature)
+		return err
+	} else if zetaHash != "" {
+		ob.logger.Inbound.Info().Msgf("postInboundVote: PostVoteInbound zeta tx hash: %s inbound %s ballot %s",
+			zetaHash, event.Signature, ballot)
+	}
+	return nil
+}
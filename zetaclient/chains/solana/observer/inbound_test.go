@@ -0,0 +1,146 @@
+package observer
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/pkg/coin"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/solana"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/keys"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
+)
+
+func TestParseInboundEvents(t *testing.T) {
+	validator, gatewayID := newTestValidator(t)
+	depositorAddress, depositor := newTestAccount(t, validator)
+	client := solana.NewClient(validator.Endpoint())
+	receiver := sample.EthAddress()
+
+	t.Run("should parse deposit with message", func(t *testing.T) {
+		memo := append(receiver.Bytes(), []byte("hello")...)
+		sig := validator.Deposit(t, depositor, 12345, memo)
+		tx, err := client.GetTransaction(context.Background(), sig, solana.CommitmentFinalized)
+		require.NoError(t, err)
+
+		events, err := ParseInboundEvents(tx, gatewayID, zerolog.Nop())
+		require.NoError(t, err)
+		require.Equal(t, []*InboundEvent{{
+			Sender:    depositorAddress.String(),
+			Amount:    12345,
+			Memo:      memo,
+			Slot:      tx.Slot,
+			Signature: sig,
+			Index:     0,
+		}}, events)
+	})
+	t.Run("should skip deposit with short memo", func(t *testing.T) {
+		sig := validator.Deposit(t, depositor, 12345, []byte("short"))
+		tx, err := client.GetTransaction(context.Background(), sig, solana.CommitmentFinalized)
+		require.NoError(t, err)
+
+		events, err := ParseInboundEvents(tx, gatewayID, zerolog.Nop())
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+	t.Run("should skip failed transaction", func(t *testing.T) {
+		sig := validator.Deposit(t, depositor, 12345, receiver.Bytes())
+		tx, err := client.GetTransaction(context.Background(), sig, solana.CommitmentFinalized)
+		require.NoError(t, err)
+		tx.Meta.Err = map[string]interface{}{"InstructionError": []interface{}{0, "Custom"}}
+
+		events, err := ParseInboundEvents(tx, gatewayID, zerolog.Nop())
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+	t.Run("should skip instructions of other programs", func(t *testing.T) {
+		sig := validator.Deposit(t, depositor, 12345, receiver.Bytes())
+		tx, err := client.GetTransaction(context.Background(), sig, solana.CommitmentFinalized)
+		require.NoError(t, err)
+
+		events, err := ParseInboundEvents(tx, solana.SystemProgramID, zerolog.Nop())
+		require.NoError(t, err)
+		require.Empty(t, events)
+	})
+}
+
+func TestGetInboundVoteMessageFromEvent(t *testing.T) {
+	validator, _ := newTestValidator(t)
+	ob := MockSolanaObserver(t, validator)
+	receiver := sample.EthAddress()
+	event := &InboundEvent{
+		Sender:    "5eDGZ1RvCDNmDG5MCgGRgDfsZrzwhqfGrw7VvbWY3Gaq",
+		Amount:    1000,
+		Memo:      append(receiver.Bytes(), 0x01, 0x02),
+		Slot:      100,
+		Signature: "5JrFrB4pZ4Xh9gFWr5wTNTtUPwwfFT3fcZBz9ty7ELCHXgfbwkmS6D7YbBkQcqbTXyHAh9ouMfLvHu4urw8jCZh",
+		Index:     1,
+	}
+
+	t.Run("should return vote message", func(t *testing.T) {
+		msg, err := ob.GetInboundVoteMessageFromEvent(event)
+		require.NoError(t, err)
+		require.NotNil(t, msg)
+		require.Equal(t, event.Sender, msg.Sender)
+		require.Equal(t, chains.SolanaLocalnet.ChainId, msg.SenderChainId)
+		require.Equal(t, event.Sender, msg.TxOrigin)
+		require.EqualValues(t, event.Amount, msg.Amount.Uint64())
+		require.Equal(t, hex.EncodeToString(event.Memo), msg.Message)
+		require.Equal(t, event.Signature, msg.InboundHash)
+		require.Equal(t, event.Slot, msg.InboundBlockHeight)
+		require.Equal(t, coin.CoinType_Gas, msg.CoinType)
+		require.EqualValues(t, event.Index, msg.EventIndex)
+	})
+	t.Run("should return nil if sender is restricted", func(t *testing.T) {
+		cfg := config.Config{}
+		cfg.ComplianceConfig.RestrictedAddresses = []string{event.Sender}
+		config.LoadComplianceConfig(cfg)
+		defer config.LoadComplianceConfig(config.Config{})
+
+		msg, err := ob.GetInboundVoteMessageFromEvent(event)
+		require.NoError(t, err)
+		require.Nil(t, msg)
+	})
+	t.Run("should return nil if receiver is restricted", func(t *testing.T) {
+		cfg := config.Config{}
+		cfg.ComplianceConfig.RestrictedAddresses = []string{receiver.Hex()}
+		config.LoadComplianceConfig(cfg)
+		defer config.LoadComplianceConfig(config.Config{})
+
+		msg, err := ob.GetInboundVoteMessageFromEvent(event)
+		require.NoError(t, err)
+		require.Nil(t, msg)
+	})
+}
+
+func TestObserveInbound(t *testing.T) {
+	validator, _ := newTestValidator(t)
+	_, depositor := newTestAccount(t, validator)
+	ob := MockSolanaObserver(t, validator)
+	require.Empty(t, ob.GetLastSignature())
+
+	t.Run("should process new deposits", func(t *testing.T) {
+		validator.Deposit(t, depositor, 1000, sample.EthAddress().Bytes())
+		sig := validator.Deposit(t, depositor, 2000, sample.EthAddress().Bytes())
+
+		require.NoError(t, ob.ObserveInbound())
+		require.Equal(t, sig, ob.GetLastSignature())
+		require.Equal(t, validator.Slot(), ob.GetLastSlot())
+	})
+	t.Run("should retry deposits if zetacore client fails", func(t *testing.T) {
+		lastSignature := ob.GetLastSignature()
+		validator.Deposit(t, depositor, 3000, sample.EthAddress().Bytes())
+
+		zetacoreClient := mocks.NewMockZetacoreClient().WithKeys(&keys.Keys{})
+		zetacoreClient.Pause()
+		ob.WithZetacoreClient(zetacoreClient)
+		require.Error(t, ob.ObserveInbound())
+		require.Equal(t, lastSignature, ob.GetLastSignature())
+	})
+}
@@ -0,0 +1,328 @@
+package observer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rs/zerolog"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/solana"
+	clientcommon "github.com/zeta-chain/zetacore/zetaclient/common"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	clientcontext "github.com/zeta-chain/zetacore/zetaclient/context"
+	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
+)
+
+var _ interfaces.ChainObserver = &Observer{}
+
+// Logger contains list of loggers used by Solana chain observer
+type Logger struct {
+	// Chain is the parent logger for the chain
+	Chain zerolog.Logger
+
+	// Inbound is the logger for incoming transactions
+	Inbound zerolog.Logger
+
+	// Outbound is the logger for outgoing transactions
+	Outbound zerolog.Logger
+
+	// GasPrice is the logger for gas price
+	GasPrice zerolog.Logger
+
+	// Compliance is the logger for compliance checks
+	Compliance zerolog.Logger
+}
+
+// Observer is the Solana chain observer
+type Observer struct {
+	// Mu is lock for the chain params, the last processed signature and the included outbounds
+	Mu *sync.Mutex
+
+	chain          chains.Chain
+	rpcClient      interfaces.SolanaRPCClient
+	zetacoreClient interfaces.ZetacoreClient
+	params         observertypes.ChainParams
+	coreContext    *clientcontext.ZetacoreContext
+
+	// lastSlot is the slot of the last processed inbound
+	lastSlot uint64
+
+	// lastSignature is the signature of the last processed transaction of the gateway program
+	lastSignature string
+
+	// includedTxResults indexes the finalized outbound with the outbound tx identifier
+	includedTxResults map[string]*solana.TransactionResult
+
+	db     *gorm.DB
+	stop   chan struct{}
+	logger Logger
+	ts     *metrics.TelemetryServer
+}
+
+// NewObserver returns a new Solana chain observer
+func NewObserver(
+	appContext *clientcontext.AppContext,
+	chain chains.Chain,
+	zetacoreClient interfaces.ZetacoreClient,
+	dbpath string,
+	loggers clientcommon.ClientLogger,
+	solCfg config.SolanaConfig,
+	ts *metrics.TelemetryServer,
+) (*Observer, error) {
+	ob := Observer{
+		ts: ts,
+	}
+	ob.stop = make(chan struct{})
+	ob.chain = chain
+	ob.Mu = &sync.Mutex{}
+
+	chainLogger := loggers.Std.With().Str("chain", chain.ChainName.String()).Logger()
+	ob.logger = Logger{
+		Chain:      chainLogger,
+		Inbound:    chainLogger.With().Str("module", "WatchInbound").Logger(),
+		Outbound:   chainLogger.With().Str("module", "WatchOutbound").Logger(),
+		GasPrice:   chainLogger.With().Str("module", "WatchGasPrice").Logger(),
+		Compliance: loggers.Compliance,
+	}
+
+	ob.zetacoreClient = zetacoreClient
+	ob.coreContext = appContext.ZetacoreContext()
+	ob.includedTxResults = make(map[string]*solana.TransactionResult)
+
+	// set the Solana chain params
+	_, chainParams, found := ob.coreContext.GetSolanaChainParams()
+	if !found {
+		return nil, fmt.Errorf("solana chain params not initialized")
+	}
+	ob.params = *chainParams
+
+	// create the RPC client
+	ob.logger.Chain.Info().Msgf("Chain %s endpoint %s", ob.chain.String(), solCfg.Endpoint)
+	ob.rpcClient = solana.NewClient(solCfg.Endpoint)
+
+	// load solana chain observer DB
+	err := ob.LoadDB(dbpath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ob, nil
+}
+
+// WithSolanaClient attaches a new Solana RPC client to the observer
+func (ob *Observer) WithSolanaClient(client interfaces.SolanaRPCClient) {
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	ob.rpcClient = client
+}
+
+// WithZetacoreClient attaches a new zetacore client to the observer
+func (ob *Observer) WithZetacoreClient(client interfaces.ZetacoreClient) {
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	ob.zetacoreClient = client
+}
+
+// Chain returns the chain of the observer
+func (ob *Observer) Chain() chains.Chain {
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	return ob.chain
+}
+
+// SetChainParams sets the chain params of the observer
+func (ob *Observer) SetChainParams(params observertypes.ChainParams) {
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	ob.params = params
+}
+
+// GetChainParams returns the chain params of the observer
+func (ob *Observer) GetChainParams() observertypes.ChainParams {
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	return ob.params
+}
+
+// GatewayID returns the address of the gateway program from the chain params
+func (ob *Observer) GatewayID() (solana.PublicKey, error) {
+	gatewayAddress := ob.GetChainParams().GatewayAddress
+	gatewayID, err := solana.PublicKeyFromBase58(gatewayAddress)
+	if err != nil {
+		return solana.PublicKey{}, fmt.Errorf("invalid gateway address %s: %s", gatewayAddress, err)
+	}
+	return gatewayID, nil
+}
+
+// Start starts the Go routines to observe the Solana chain
+func (ob *Observer) Start() {
+	ob.logger.Chain.Info().Msgf("Solana client is starting")
+	go ob.WatchInbound()        // watch the gateway program for deposits and post votes to zetacore
+	go ob.WatchOutbound()       // watch the gateway program for withdrawals status
+	go ob.WatchGasPrice()       // watch solana chain for gas price and post to zetacore
+	go ob.WatchInboundTracker() // watch zetacore for solana inbound trackers
+}
+
+// Stop stops the Go routines of the observer
+func (ob *Observer) Stop() {
+	ob.logger.Chain.Info().Msgf("ob %s is stopping", ob.chain.String())
+	close(ob.stop) // this notifies all goroutines to stop
+	ob.logger.Chain.Info().Msgf("%s observer stopped", ob.chain.String())
+}
+
+// SetLastSlot sets the slot of the last processed inbound
+func (ob *Observer) SetLastSlot(slot uint64) {
+	atomic.StoreUint64(&ob.lastSlot, slot)
+	metrics.LastScannedBlockNumber.WithLabelValues(ob.chain.ChainName.String()).Set(float64(slot))
+}
+
+// GetLastSlot returns the slot of the last processed inbound
+func (ob *Observer) GetLastSlot() uint64 {
+	return atomic.LoadUint64(&ob.lastSlot)
+}
+
+// SetLastSignature sets the signature of the last processed transaction
+func (ob *Observer) SetLastSignature(signature string) {
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	ob.lastSignature = signature
+}
+
+// GetLastSignature returns the signature of the last processed transaction
+func (ob *Observer) GetLastSignature() string {
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	return ob.lastSignature
+}
+
+// SaveLastSignature sets and persists the signature of the last processed transaction
+func (ob *Observer) SaveLastSignature(signature string) {
+	ob.SetLastSignature(signature)
+	if ob.db == nil {
+		return
+	}
+	if err := ob.db.Save(clienttypes.ToLastSignatureSQLType(signature)).Error; err != nil {
+		ob.logger.Inbound.Error().Err(err).Msgf("SaveLastSignature: error writing last signature %s to db", signature)
+	}
+}
+
+// WatchGasPrice watches Solana chain for gas price and post to zetacore
+func (ob *Observer) WatchGasPrice() {
+	// report gas price right away as the ticker takes time to kick in
+	err := ob.PostGasPrice()
+	if err != nil {
+		ob.logger.GasPrice.Error().Err(err).Msgf("PostGasPrice error for chain %d", ob.chain.ChainId)
+	}
+
+	// start gas price ticker
+	ticker, err := clienttypes.NewDynamicTicker("Solana_WatchGasPrice", ob.GetChainParams().GasPriceTicker)
+	if err != nil {
+		ob.logger.GasPrice.Error().Err(err).Msg("error creating ticker")
+		return
+	}
+	ob.logger.GasPrice.Info().Msgf("WatchGasPrice started for chain %d with interval %d",
+		ob.chain.ChainId, ob.GetChainParams().GasPriceTicker)
+
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C():
+			if !ob.GetChainParams().IsSupported {
+				continue
+			}
+			err := ob.PostGasPrice()
+			if err != nil {
+				ob.logger.GasPrice.Error().Err(err).Msgf("PostGasPrice error for chain %d", ob.chain.ChainId)
+			}
+			ticker.UpdateInterval(ob.GetChainParams().GasPriceTicker, ob.logger.GasPrice)
+		case <-ob.stop:
+			ob.logger.GasPrice.Info().Msgf("WatchGasPrice stopped for chain %d", ob.chain.ChainId)
+			return
+		}
+	}
+}
+
+// PostGasPrice posts the fee per signature (in lamports) to zetacore
+// Solana charges a fixed fee per signature, priority fees are not paid by the outbounds.
+func (ob *Observer) PostGasPrice() error {
+	slot, err := ob.rpcClient.GetSlot(context.Background(), solana.CommitmentFinalized)
+	if err != nil {
+		return err
+	}
+
+	_, err = ob.zetacoreClient.PostGasPrice(ob.chain, solana.LamportsPerSignature, "100", slot)
+	if err != nil {
+		ob.logger.GasPrice.Err(err).Msg("PostGasPrice:")
+		return err
+	}
+	return nil
+}
+
+// LoadLastSignature loads the last processed signature from database
+// If not found, the observer starts from the latest transaction of the gateway program.
+func (ob *Observer) LoadLastSignature() error {
+	var lastSignature clienttypes.LastSignatureSQLType
+	if err := ob.db.First(&lastSignature, clienttypes.LastSignatureID).Error; err == nil {
+		ob.SetLastSignature(lastSignature.Signature)
+		ob.logger.Chain.Info().
+			Msgf("LoadLastSignature: chain %d starts scanning after signature %s", ob.chain.ChainId, lastSignature.Signature)
+		return nil
+	}
+
+	ob.logger.Chain.Info().Msg("LoadLastSignature: last signature not found in DB, scan from latest")
+	gatewayID, err := ob.GatewayID()
+	if err != nil {
+		return err
+	}
+	sigs, err := ob.rpcClient.GetSignaturesForAddress(
+		context.Background(),
+		gatewayID.String(),
+		solana.SignaturesForAddressOpts{Limit: 1, Commitment: solana.CommitmentFinalized},
+	)
+	if err != nil {
+		return err
+	}
+	if len(sigs) > 0 {
+		ob.SaveLastSignature(sigs[0].Signature)
+		ob.SetLastSlot(sigs[0].Slot)
+	}
+	return nil
+}
+
+// LoadDB open sql database and load data into Solana observer
+func (ob *Observer) LoadDB(dbPath string) error {
+	if dbPath == "" {
+		return nil
+	}
+	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+		err := os.MkdirAll(dbPath, os.ModePerm)
+		if err != nil {
+			return err
+		}
+	}
+	path := fmt.Sprintf("%s/%s", dbPath, ob.chain.ChainName.String())
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		ob.logger.Chain.Error().Err(err).Msgf("failed to open observer database for %s", ob.chain.ChainName.String())
+		return err
+	}
+
+	err = db.AutoMigrate(&clienttypes.LastSignatureSQLType{})
+	if err != nil {
+		ob.logger.Chain.Error().Err(err).Msg("error migrating db")
+		return err
+	}
+	ob.db = db
+
+	return ob.LoadLastSignature()
+}
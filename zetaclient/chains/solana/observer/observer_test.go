@@ -0,0 +1,135 @@
+package observer
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/solana"
+	clientcommon "github.com/zeta-chain/zetacore/zetaclient/common"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	clientcontext "github.com/zeta-chain/zetacore/zetaclient/context"
+	"github.com/zeta-chain/zetacore/zetaclient/keys"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
+)
+
+// getAppContext creates an app context with the Solana chain params for unit tests
+func getAppContext(endpoint string, params *observertypes.ChainParams) (*clientcontext.AppContext, config.SolanaConfig) {
+	cfg := config.NewConfig()
+	cfg.SolanaConfig = config.SolanaConfig{Endpoint: endpoint}
+	coreCtx := clientcontext.NewZetacoreContext(cfg)
+	coreCtx.Update(
+		&observertypes.Keygen{},
+		[]chains.Chain{chains.SolanaLocalnet},
+		[]chains.Chain{},
+		nil,
+		nil,
+		params,
+		"",
+		*sample.CrosschainFlags(),
+		sample.HeaderSupportedChains(),
+		true,
+		zerolog.Logger{},
+	)
+	return clientcontext.NewAppContext(coreCtx, cfg), cfg.SolanaConfig
+}
+
+// MockSolanaObserver creates a Solana observer connected to the local validator stand-in
+func MockSolanaObserver(t *testing.T, validator *testutils.SolanaValidator) *Observer {
+	appCtx, solCfg := getAppContext(validator.Endpoint(), observertypes.GetDefaultSolanaLocalnetChainParams())
+	zetacoreClient := mocks.NewMockZetacoreClient().WithKeys(&keys.Keys{})
+	ob, err := NewObserver(appCtx, chains.SolanaLocalnet, zetacoreClient, "", clientcommon.ClientLogger{}, solCfg, nil)
+	require.NoError(t, err)
+	return ob
+}
+
+// newTestValidator starts a local validator stand-in with the localnet gateway and the mock TSS
+func newTestValidator(t *testing.T) (*testutils.SolanaValidator, solana.PublicKey) {
+	gatewayID, err := solana.PublicKeyFromBase58(testutils.SolanaGatewayAddressLocalnet)
+	require.NoError(t, err)
+	var tss solana.PublicKey
+	copy(tss[:], mocks.NewTSSMainnet().PubKeyEdDSA())
+	return testutils.NewSolanaValidator(t, gatewayID, tss), gatewayID
+}
+
+// newTestAccount returns a funded account of the validator
+func newTestAccount(t *testing.T, validator *testutils.SolanaValidator) (solana.PublicKey, ed25519.PrivateKey) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	var address solana.PublicKey
+	copy(address[:], pub)
+	validator.Fund(address, 1_000_000_000)
+	return address, priv
+}
+
+func TestNewObserver(t *testing.T) {
+	t.Run("should return error if solana chain params are not set", func(t *testing.T) {
+		cfg := config.NewConfig()
+		appCtx := clientcontext.NewAppContext(clientcontext.NewZetacoreContext(cfg), cfg)
+		ob, err := NewObserver(appCtx, chains.SolanaLocalnet, mocks.NewMockZetacoreClient(), "",
+			clientcommon.ClientLogger{}, config.SolanaConfig{}, nil)
+		require.ErrorContains(t, err, "solana chain params not initialized")
+		require.Nil(t, ob)
+	})
+	t.Run("should create observer", func(t *testing.T) {
+		validator, gatewayID := newTestValidator(t)
+		ob := MockSolanaObserver(t, validator)
+		require.Equal(t, chains.SolanaLocalnet, ob.Chain())
+
+		id, err := ob.GatewayID()
+		require.NoError(t, err)
+		require.Equal(t, gatewayID, id)
+	})
+	t.Run("should fail on invalid gateway address", func(t *testing.T) {
+		validator, _ := newTestValidator(t)
+		ob := MockSolanaObserver(t, validator)
+		params := ob.GetChainParams()
+		params.GatewayAddress = "invalid"
+		ob.SetChainParams(params)
+		_, err := ob.GatewayID()
+		require.Error(t, err)
+	})
+}
+
+func TestLoadLastSignature(t *testing.T) {
+	validator, _ := newTestValidator(t)
+	_, depositor := newTestAccount(t, validator)
+	dbPath := t.TempDir()
+
+	t.Run("should start from the latest gateway transaction", func(t *testing.T) {
+		sig := validator.Deposit(t, depositor, 1000, sample.EthAddress().Bytes())
+		ob := MockSolanaObserver(t, validator)
+		require.NoError(t, ob.LoadDB(dbPath))
+		require.Equal(t, sig, ob.GetLastSignature())
+	})
+	t.Run("should load the last signature from database", func(t *testing.T) {
+		ob := MockSolanaObserver(t, validator)
+		require.NoError(t, ob.LoadDB(dbPath))
+		lastSignature := ob.GetLastSignature()
+
+		// a new deposit is not skipped on restart
+		validator.Deposit(t, depositor, 1000, sample.EthAddress().Bytes())
+		ob = MockSolanaObserver(t, validator)
+		require.NoError(t, ob.LoadDB(dbPath))
+		require.Equal(t, lastSignature, ob.GetLastSignature())
+	})
+}
+
+func TestPostGasPrice(t *testing.T) {
+	validator, _ := newTestValidator(t)
+	ob := MockSolanaObserver(t, validator)
+	require.NoError(t, ob.PostGasPrice())
+
+	// the zetacore client fails to post
+	zetacoreClient := mocks.NewMockZetacoreClient()
+	zetacoreClient.Pause()
+	ob.WithZetacoreClient(zetacoreClient)
+	require.Error(t, ob.PostGasPrice())
+}
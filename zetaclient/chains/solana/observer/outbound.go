@@ -0,0 +1,226 @@
+package observer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/pkg/coin"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/solana"
+	"github.com/zeta-chain/zetacore/zetaclient/compliance"
+	clientcontext "github.com/zeta-chain/zetacore/zetaclient/context"
+	clienttypes "github.com/zeta-chain/zetacore/zetaclient/types"
+)
+
+// GetTxID returns a unique id for outbound tx
+func (ob *Observer) GetTxID(nonce uint64) string {
+	return fmt.Sprintf("%d-%s-%d", ob.chain.ChainId, ob.GetChainParams().GatewayAddress, nonce)
+}
+
+// WatchOutbound watches the outbound trackers of Solana chain for finalized withdrawals
+func (ob *Observer) WatchOutbound() {
+	ticker, err := clienttypes.NewDynamicTicker("Solana_WatchOutbound", ob.GetChainParams().OutboundTicker)
+	if err != nil {
+		ob.logger.Outbound.Error().Err(err).Msg("error creating ticker")
+		return
+	}
+	defer ticker.Stop()
+
+	ob.logger.Outbound.Info().Msgf("WatchOutbound started for chain %d", ob.chain.ChainId)
+	sampledLogger := ob.logger.Outbound.Sample(&zerolog.BasicSampler{N: 10})
+
+	for {
+		select {
+		case <-ticker.C():
+			if !clientcontext.IsOutboundObservationEnabled(ob.coreContext, ob.GetChainParams()) {
+				sampledLogger.Info().
+					Msgf("WatchOutbound: outbound observation is disabled for chain %d", ob.chain.ChainId)
+				continue
+			}
+			err := ob.ObserveOutbound()
+			if err != nil {
+				ob.logger.Outbound.Error().Err(err).Msgf("WatchOutbound: error observing outbound for chain %d", ob.chain.ChainId)
+			}
+			ticker.UpdateInterval(ob.GetChainParams().OutboundTicker, ob.logger.Outbound)
+		case <-ob.stop:
+			ob.logger.Outbound.Info().Msgf("WatchOutbound stopped for chain %d", ob.chain.ChainId)
+			return
+		}
+	}
+}
+
+// ObserveOutbound checks the signatures of the outbound trackers and records the finalized withdrawals
+func (ob *Observer) ObserveOutbound() error {
+	trackers, err := ob.zetacoreClient.GetAllOutboundTrackerByChain(ob.chain.ChainId, interfaces.Ascending)
+	if err != nil {
+		return errors.Wrap(err, "error GetAllOutboundTrackerByChain")
+	}
+	for _, tracker := range trackers {
+		outboundID := ob.GetTxID(tracker.Nonce)
+		if ob.getIncludedTx(tracker.Nonce) != nil {
+			continue
+		}
+		cctx, err := ob.zetacoreClient.GetCctxByNonce(ob.chain.ChainId, tracker.Nonce)
+		if err != nil {
+			return errors.Wrapf(err, "can't find cctx for chain %d nonce %d", ob.chain.ChainId, tracker.Nonce)
+		}
+
+		// the gateway only accepts one withdrawal per nonce, so at most one signature can be included
+		for _, txHash := range tracker.HashList {
+			if txResult := ob.checkIncludedTx(cctx, txHash.TxHash); txResult != nil {
+				ob.setIncludedTx(tracker.Nonce, txResult)
+				ob.logger.Outbound.Info().
+					Msgf("ObserveOutbound: included outbound %s outboundID %s", txHash.TxHash, outboundID)
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// IsOutboundProcessed returns isIncluded, isConfirmed, Error
+// An included outbound is already finalized on Solana chain, so it is confirmed as soon as it is included.
+func (ob *Observer) IsOutboundProcessed(cctx *crosschaintypes.CrossChainTx, logger zerolog.Logger) (bool, bool, error) {
+	params := cctx.GetCurrentOutboundParam()
+	sendHash := cctx.Index
+	nonce := params.TssNonce
+
+	res := ob.getIncludedTx(nonce)
+	if res == nil {
+		return false, false, nil
+	}
+
+	// It's safe to use cctx's amount to post confirmation because it has already been verified in checkIncludedTx()
+	amount := params.Amount.BigInt()
+	zetaHash, ballot, err := ob.zetacoreClient.PostVoteOutbound(
+		sendHash,
+		res.ID(),
+		res.Slot,
+		0,   // gas used not used with Solana
+		nil, // gas price not used with Solana
+		0,   // gas limit not used with Solana
+		amount,
+		chains.ReceiveStatus_success,
+		ob.chain,
+		nonce,
+		coin.CoinType_Gas,
+	)
+	if err != nil {
+		logger.Error().
+			Err(err).
+			Msgf("IsOutboundProcessed: error confirming solana outbound %s, nonce %d ballot %s", res.ID(), nonce, ballot)
+	} else if zetaHash != "" {
+		logger.Info().Msgf("IsOutboundProcessed: confirmed solana outbound %s, zeta tx hash %s nonce %d ballot %s",
+			res.ID(), zetaHash, nonce, ballot)
+	}
+
+	return true, true, nil
+}
+
+// checkIncludedTx returns the finalized transaction of the signature if it is the withdrawal of the cctx
+// Note: a failed withdrawal doesn't consume the gateway nonce, the signer keeps signing it until one succeeds.
+func (ob *Observer) checkIncludedTx(cctx *crosschaintypes.CrossChainTx, signature string) *solana.TransactionResult {
+	outboundID := ob.GetTxID(cctx.GetCurrentOutboundParam().TssNonce)
+	txResult, err := ob.rpcClient.GetTransaction(context.Background(), signature, solana.CommitmentFinalized)
+	if err != nil {
+		ob.logger.Outbound.Error().Err(err).Msgf("checkIncludedTx: error GetTransaction %s", signature)
+		return nil
+	}
+	if txResult == nil || !txResult.IsSuccessful() {
+		return nil
+	}
+
+	err = ob.checkWithdrawal(cctx, txResult)
+	if err != nil {
+		ob.logger.Outbound.Error().
+			Err(err).
+			Msgf("checkIncludedTx: error verifying solana outbound %s outboundID %s", signature, outboundID)
+		return nil
+	}
+	return txResult
+}
+
+// checkWithdrawal verifies the gateway withdrawal made by the transaction against the cctx
+//   - the transaction withdraws exactly once from the gateway PDA with the cctx nonce
+//   - the withdrawn amount and recipient are the cctx amount and receiver
+//   - a cancelled (compliance restricted) cctx withdraws zero lamports back to the TSS
+func (ob *Observer) checkWithdrawal(cctx *crosschaintypes.CrossChainTx, txResult *solana.TransactionResult) error {
+	params := cctx.GetCurrentOutboundParam()
+	gatewayID, err := ob.GatewayID()
+	if err != nil {
+		return err
+	}
+	pda, _, err := solana.GatewayPDA(gatewayID)
+	if err != nil {
+		return err
+	}
+	instructions, err := txResult.ProgramInstructions(gatewayID)
+	if err != nil {
+		return err
+	}
+
+	var withdrawal *solana.WithdrawInstruction
+	var accounts []string
+	for _, inst := range instructions {
+		if len(inst.Data) < len(solana.DiscriminatorWithdraw) ||
+			!bytes.Equal(inst.Data[:len(solana.DiscriminatorWithdraw)], solana.DiscriminatorWithdraw[:]) {
+			continue
+		}
+		if withdrawal != nil {
+			return errors.New("multiple withdrawals in transaction")
+		}
+		parsed, err := solana.ParseWithdrawInstruction(inst.Data)
+		if err != nil {
+			return err
+		}
+		withdrawal, accounts = &parsed, inst.Accounts
+	}
+	if withdrawal == nil {
+		return errors.New("no withdrawal in transaction")
+	}
+	if len(accounts) < 3 {
+		return fmt.Errorf("withdrawal has %d accounts", len(accounts))
+	}
+	if withdrawal.Nonce != params.TssNonce {
+		return fmt.Errorf("withdrawal nonce %d not match cctx nonce %d", withdrawal.Nonce, params.TssNonce)
+	}
+	if accounts[1] != pda.String() {
+		return fmt.Errorf("withdrawal from %s not match gateway PDA %s", accounts[1], pda)
+	}
+
+	if compliance.IsCctxRestricted(cctx) {
+		if withdrawal.Amount != 0 || accounts[2] != accounts[0] {
+			return fmt.Errorf("cancelled withdrawal sent %d lamports to %s", withdrawal.Amount, accounts[2])
+		}
+		return nil
+	}
+	if !params.Amount.BigInt().IsUint64() || withdrawal.Amount != params.Amount.Uint64() {
+		return fmt.Errorf("withdrawal amount %d not match cctx amount %s", withdrawal.Amount, params.Amount)
+	}
+	if accounts[2] != params.Receiver {
+		return fmt.Errorf("withdrawal recipient %s not match cctx receiver %s", accounts[2], params.Receiver)
+	}
+	return nil
+}
+
+// setIncludedTx saves included tx result in memory
+func (ob *Observer) setIncludedTx(nonce uint64, txResult *solana.TransactionResult) {
+	outboundID := ob.GetTxID(nonce)
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	ob.includedTxResults[outboundID] = txResult
+}
+
+// getIncludedTx gets the included tx result from memory
+func (ob *Observer) getIncludedTx(nonce uint64) *solana.TransactionResult {
+	outboundID := ob.GetTxID(nonce)
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	return ob.includedTxResults[outboundID]
+}
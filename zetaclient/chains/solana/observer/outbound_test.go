@@ -0,0 +1,136 @@
+package observer
+
+import (
+	"context"
+	"crypto/ed25519"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/pkg/coin"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/solana"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
+)
+
+// newTestCctx returns a cctx withdrawing SOL to the receiver
+func newTestCctx(receiver string, amount uint64, nonce uint64) *crosschaintypes.CrossChainTx {
+	return &crosschaintypes.CrossChainTx{
+		Index: sample.Hash().Hex(),
+		InboundParams: &crosschaintypes.InboundParams{
+			Sender:        sample.EthAddress().Hex(),
+			SenderChainId: chains.ZetaChainPrivnet.ChainId,
+		},
+		OutboundParams: []*crosschaintypes.OutboundParams{{
+			Receiver:        receiver,
+			ReceiverChainId: chains.SolanaLocalnet.ChainId,
+			Amount:          sdkmath.NewUint(amount),
+			TssNonce:        nonce,
+			CoinType:        coin.CoinType_Gas,
+		}},
+	}
+}
+
+// sendWithdrawal signs a gateway withdrawal with the mock TSS key and sends it to the validator
+func sendWithdrawal(
+	t *testing.T,
+	validator *testutils.SolanaValidator,
+	gatewayID, recipient solana.PublicKey,
+	amount, nonce uint64,
+) (string, error) {
+	tss := mocks.NewTSSMainnet()
+	var tssAddress solana.PublicKey
+	copy(tssAddress[:], tss.PubKeyEdDSA())
+
+	inst, err := solana.NewWithdrawInstruction(gatewayID, tssAddress, recipient, amount, nonce)
+	require.NoError(t, err)
+	msg, err := solana.NewMessage(tssAddress, []solana.Instruction{inst}, validator.LatestBlockhash())
+	require.NoError(t, err)
+	var sig solana.Signature
+	copy(sig[:], ed25519.Sign(tss.PrivKeyEdDSA, msg.Serialize()))
+
+	tx := &solana.Transaction{Signatures: []solana.Signature{sig}, Message: msg}
+	return solana.NewClient(validator.Endpoint()).SendTransaction(context.Background(), tx)
+}
+
+func TestCheckIncludedTx(t *testing.T) {
+	validator, gatewayID := newTestValidator(t)
+	_, depositor := newTestAccount(t, validator)
+	recipient, _ := newTestAccount(t, validator)
+	var tssAddress solana.PublicKey
+	copy(tssAddress[:], mocks.NewTSSMainnet().PubKeyEdDSA())
+	validator.Fund(tssAddress, 1_000_000)
+	validator.Deposit(t, depositor, 100_000, sample.EthAddress().Bytes())
+	ob := MockSolanaObserver(t, validator)
+
+	t.Run("should include withdrawal of the cctx", func(t *testing.T) {
+		sig, err := sendWithdrawal(t, validator, gatewayID, recipient, 1000, 0)
+		require.NoError(t, err)
+
+		cctx := newTestCctx(recipient.String(), 1000, 0)
+		require.NotNil(t, ob.checkIncludedTx(cctx, sig))
+
+		// the withdrawal doesn't match other cctxs
+		require.Nil(t, ob.checkIncludedTx(newTestCctx(recipient.String(), 1001, 0), sig))
+		require.Nil(t, ob.checkIncludedTx(newTestCctx(recipient.String(), 1000, 1), sig))
+		require.Nil(t, ob.checkIncludedTx(newTestCctx(tssAddress.String(), 1000, 0), sig))
+	})
+	t.Run("should include cancelled withdrawal of restricted cctx", func(t *testing.T) {
+		sig, err := sendWithdrawal(t, validator, gatewayID, tssAddress, 0, 1)
+		require.NoError(t, err)
+
+		cctx := newTestCctx(recipient.String(), 1000, 1)
+		require.Nil(t, ob.checkIncludedTx(cctx, sig))
+
+		cfg := config.Config{}
+		cfg.ComplianceConfig.RestrictedAddresses = []string{recipient.String()}
+		config.LoadComplianceConfig(cfg)
+		defer config.LoadComplianceConfig(config.Config{})
+		require.NotNil(t, ob.checkIncludedTx(cctx, sig))
+	})
+	t.Run("should not include unknown transaction", func(t *testing.T) {
+		cctx := newTestCctx(recipient.String(), 1000, 2)
+		require.Nil(t, ob.checkIncludedTx(cctx, solana.Signature{}.String()))
+	})
+	t.Run("should reject withdrawal with wrong nonce", func(t *testing.T) {
+		_, err := sendWithdrawal(t, validator, gatewayID, recipient, 1000, 5)
+		require.ErrorContains(t, err, "nonce mismatch")
+	})
+}
+
+func TestIsOutboundProcessed(t *testing.T) {
+	validator, gatewayID := newTestValidator(t)
+	_, depositor := newTestAccount(t, validator)
+	recipient, _ := newTestAccount(t, validator)
+	var tssAddress solana.PublicKey
+	copy(tssAddress[:], mocks.NewTSSMainnet().PubKeyEdDSA())
+	validator.Fund(tssAddress, 1_000_000)
+	validator.Deposit(t, depositor, 100_000, sample.EthAddress().Bytes())
+	ob := MockSolanaObserver(t, validator)
+	cctx := newTestCctx(recipient.String(), 1000, 0)
+
+	t.Run("should not be processed before inclusion", func(t *testing.T) {
+		included, confirmed, err := ob.IsOutboundProcessed(cctx, zerolog.Nop())
+		require.NoError(t, err)
+		require.False(t, included)
+		require.False(t, confirmed)
+	})
+	t.Run("should be confirmed once included", func(t *testing.T) {
+		sig, err := sendWithdrawal(t, validator, gatewayID, recipient, 1000, 0)
+		require.NoError(t, err)
+		txResult := ob.checkIncludedTx(cctx, sig)
+		require.NotNil(t, txResult)
+		ob.setIncludedTx(0, txResult)
+
+		included, confirmed, err := ob.IsOutboundProcessed(cctx, zerolog.Nop())
+		require.NoError(t, err)
+		require.True(t, included)
+		require.True(t, confirmed)
+	})
+}
@@ -0,0 +1,329 @@
+package solana
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/pkg/errors"
+)
+
+// Commitment is the commitment level of the bank state queried from a Solana node
+type Commitment string
+
+const (
+	// CommitmentProcessed queries the most recent block of the node, which may be skipped by the cluster
+	CommitmentProcessed Commitment = "processed"
+
+	// CommitmentConfirmed queries the most recent block voted by a supermajority of the cluster
+	CommitmentConfirmed Commitment = "confirmed"
+
+	// CommitmentFinalized queries the most recent block rooted by a supermajority of the cluster
+	CommitmentFinalized Commitment = "finalized"
+)
+
+const (
+	// LamportsPerSignature is the fee charged by the cluster for each signature of a transaction
+	LamportsPerSignature = 5000
+
+	// MaxSignaturesPerQuery is the maximum number of signatures returned by a getSignaturesForAddress query
+	MaxSignaturesPerQuery = 1000
+
+	// rpcTimeout is the timeout of a RPC request
+	rpcTimeout = 30 * time.Second
+
+	// errCodeSlotSkipped is the RPC error code returned when querying a skipped slot
+	errCodeSlotSkipped = -32007
+
+	// errCodeLongTermStorageSlotSkipped is the RPC error code returned when querying a skipped slot in long-term storage
+	errCodeLongTermStorageSlotSkipped = -32009
+)
+
+// SignaturesForAddressOpts are the options of a getSignaturesForAddress query
+type SignaturesForAddressOpts struct {
+	Limit      int        `json:"limit,omitempty"`
+	Before     string     `json:"before,omitempty"`
+	Until      string     `json:"until,omitempty"`
+	Commitment Commitment `json:"commitment,omitempty"`
+}
+
+// SignatureInfo is a transaction signature returned by getSignaturesForAddress, from newest to oldest
+type SignatureInfo struct {
+	Signature          string      `json:"signature"`
+	Slot               uint64      `json:"slot"`
+	Err                interface{} `json:"err"`
+	BlockTime          *int64      `json:"blockTime"`
+	ConfirmationStatus string      `json:"confirmationStatus"`
+}
+
+// TransactionResult is a transaction returned by getTransaction with the "json" encoding
+type TransactionResult struct {
+	Slot        uint64           `json:"slot"`
+	BlockTime   *int64           `json:"blockTime"`
+	Meta        *TransactionMeta `json:"meta"`
+	Transaction UITransaction    `json:"transaction"`
+}
+
+// TransactionMeta is the status of an executed transaction
+type TransactionMeta struct {
+	Err          interface{} `json:"err"`
+	Fee          uint64      `json:"fee"`
+	PreBalances  []uint64    `json:"preBalances"`
+	PostBalances []uint64    `json:"postBalances"`
+}
+
+// UITransaction is the json encoding of a transaction
+type UITransaction struct {
+	Signatures []string  `json:"signatures"`
+	Message    UIMessage `json:"message"`
+}
+
+// UIMessage is the json encoding of a transaction message
+type UIMessage struct {
+	Header          MessageHeader   `json:"header"`
+	AccountKeys     []string        `json:"accountKeys"`
+	RecentBlockhash string          `json:"recentBlockhash"`
+	Instructions    []UIInstruction `json:"instructions"`
+}
+
+// UIInstruction is the json encoding of a compiled instruction, the data is base58 encoded
+type UIInstruction struct {
+	ProgramIDIndex uint16   `json:"programIdIndex"`
+	Accounts       []uint16 `json:"accounts"`
+	Data           string   `json:"data"`
+}
+
+// IsSuccessful returns true if the transaction is executed without error
+func (res *TransactionResult) IsSuccessful() bool {
+	return res.Meta != nil && res.Meta.Err == nil
+}
+
+// ID returns the id of the transaction which is its first signature
+func (res *TransactionResult) ID() string {
+	if len(res.Transaction.Signatures) == 0 {
+		return ""
+	}
+	return res.Transaction.Signatures[0]
+}
+
+// ProgramInstructions returns the instructions of given program in the transaction
+// The accounts of the returned instructions are resolved to their addresses.
+func (res *TransactionResult) ProgramInstructions(programID PublicKey) ([]ParsedInstruction, error) {
+	keys := res.Transaction.Message.AccountKeys
+	program := programID.String()
+	instructions := make([]ParsedInstruction, 0)
+	for i, inst := range res.Transaction.Message.Instructions {
+		if int(inst.ProgramIDIndex) >= len(keys) {
+			return nil, fmt.Errorf("program index %d out of range in instruction %d", inst.ProgramIDIndex, i)
+		}
+		if keys[inst.ProgramIDIndex] != program {
+			continue
+		}
+		accounts := make([]string, len(inst.Accounts))
+		for j, idx := range inst.Accounts {
+			if int(idx) >= len(keys) {
+				return nil, fmt.Errorf("account index %d out of range in instruction %d", idx, i)
+			}
+			accounts[j] = keys[idx]
+		}
+		instructions = append(instructions, ParsedInstruction{
+			Accounts: accounts,
+			Data:     base58.Decode(inst.Data),
+		})
+	}
+	return instructions, nil
+}
+
+// ParsedInstruction is an instruction with resolved account addresses and decoded data
+type ParsedInstruction struct {
+	Accounts []string
+	Data     []byte
+}
+
+// BlockResult is a block returned by getBlock without transaction details
+type BlockResult struct {
+	Blockhash         string `json:"blockhash"`
+	PreviousBlockhash string `json:"previousBlockhash"`
+	ParentSlot        uint64 `json:"parentSlot"`
+	BlockHeight       uint64 `json:"blockHeight"`
+}
+
+// rpcRequest is a JSON-RPC 2.0 request
+type rpcRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params,omitempty"`
+}
+
+// rpcResponse is a JSON-RPC 2.0 response
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *RPCError       `json:"error"`
+}
+
+// RPCError is the error returned by a Solana node
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("solana rpc error %d: %s", e.Code, e.Message)
+}
+
+// Client is a JSON-RPC client of a Solana node
+type Client struct {
+	endpoint   string
+	httpClient *http.Client
+	requestID  uint64
+}
+
+// NewClient creates a new Solana JSON-RPC client
+func NewClient(endpoint string) *Client {
+	return &Client{
+		endpoint:   endpoint,
+		httpClient: &http.Client{Timeout: rpcTimeout},
+	}
+}
+
+// GetHealth returns an error if the node is not healthy
+func (c *Client) GetHealth(ctx context.Context) error {
+	var result string
+	if err := c.call(ctx, "getHealth", nil, &result); err != nil {
+		return err
+	}
+	if result != "ok" {
+		return fmt.Errorf("node is unhealthy: %s", result)
+	}
+	return nil
+}
+
+// GetSlot returns the slot that reached the given commitment level
+func (c *Client) GetSlot(ctx context.Context, commitment Commitment) (uint64, error) {
+	var slot uint64
+	err := c.call(ctx, "getSlot", []interface{}{commitmentConfig(commitment)}, &slot)
+	return slot, err
+}
+
+// GetBalance returns the lamports of the account
+func (c *Client) GetBalance(ctx context.Context, address string, commitment Commitment) (uint64, error) {
+	var result struct {
+		Value uint64 `json:"value"`
+	}
+	err := c.call(ctx, "getBalance", []interface{}{address, commitmentConfig(commitment)}, &result)
+	return result.Value, err
+}
+
+// GetBlock returns the block produced at the slot, nil if the slot was skipped
+func (c *Client) GetBlock(ctx context.Context, slot uint64, commitment Commitment) (*BlockResult, error) {
+	config := map[string]interface{}{
+		"commitment":                     commitment,
+		"transactionDetails":             "none",
+		"rewards":                        false,
+		"maxSupportedTransactionVersion": 0,
+	}
+	var result *BlockResult
+	err := c.call(ctx, "getBlock", []interface{}{slot, config}, &result)
+	var rpcErr *RPCError
+	if errors.As(err, &rpcErr) && (rpcErr.Code == errCodeSlotSkipped || rpcErr.Code == errCodeLongTermStorageSlotSkipped) {
+		return nil, nil
+	}
+	return result, err
+}
+
+// GetSignaturesForAddress returns the signatures of the transactions involving the address, from newest to oldest
+func (c *Client) GetSignaturesForAddress(
+	ctx context.Context,
+	address string,
+	opts SignaturesForAddressOpts,
+) ([]SignatureInfo, error) {
+	var result []SignatureInfo
+	err := c.call(ctx, "getSignaturesForAddress", []interface{}{address, opts}, &result)
+	return result, err
+}
+
+// GetTransaction returns the transaction of given signature, nil if the transaction is not found
+func (c *Client) GetTransaction(
+	ctx context.Context,
+	signature string,
+	commitment Commitment,
+) (*TransactionResult, error) {
+	config := map[string]interface{}{
+		"encoding":                       "json",
+		"commitment":                     commitment,
+		"maxSupportedTransactionVersion": 0,
+	}
+	var result *TransactionResult
+	err := c.call(ctx, "getTransaction", []interface{}{signature, config}, &result)
+	return result, err
+}
+
+// SendTransaction broadcasts the signed transaction and returns its signature
+func (c *Client) SendTransaction(ctx context.Context, tx *Transaction) (string, error) {
+	config := map[string]interface{}{
+		"encoding":            "base64",
+		"preflightCommitment": CommitmentConfirmed,
+	}
+	encoded := base64.StdEncoding.EncodeToString(tx.Serialize())
+	var signature string
+	err := c.call(ctx, "sendTransaction", []interface{}{encoded, config}, &signature)
+	return signature, err
+}
+
+// call sends a JSON-RPC request to the node and decodes the result
+func (c *Client) call(ctx context.Context, method string, params []interface{}, result interface{}) error {
+	body, err := json.Marshal(rpcRequest{
+		JSONRPC: "2.0",
+		ID:      atomic.AddUint64(&c.requestID, 1),
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error encoding %s request", method)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(err, "error creating %s request", method)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "error sending %s request", method)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "error reading %s response", method)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s request failed with status %d: %s", method, resp.StatusCode, string(data))
+	}
+
+	var rpcResp rpcResponse
+	if err := json.Unmarshal(data, &rpcResp); err != nil {
+		return errors.Wrapf(err, "error decoding %s response", method)
+	}
+	if rpcResp.Error != nil {
+		return errors.Wrapf(rpcResp.Error, "%s request failed", method)
+	}
+	if err := json.Unmarshal(rpcResp.Result, result); err != nil {
+		return errors.Wrapf(err, "error decoding %s result", method)
+	}
+	return nil
+}
+
+// commitmentConfig returns the config object of a query at given commitment level
+func commitmentConfig(commitment Commitment) map[string]interface{} {
+	return map[string]interface{}{"commitment": commitment}
+}
@@ -0,0 +1,88 @@
+package solana_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/zetaclient/chains/solana"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils"
+)
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	gatewayID, err := solana.PublicKeyFromBase58(testutils.SolanaGatewayAddressLocalnet)
+	require.NoError(t, err)
+	pda, _, err := solana.GatewayPDA(gatewayID)
+	require.NoError(t, err)
+
+	pub, depositor, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	var depositorAddress solana.PublicKey
+	copy(depositorAddress[:], pub)
+
+	validator := testutils.NewSolanaValidator(t, gatewayID, solana.PublicKey{1})
+	validator.Fund(depositorAddress, 1_000_000)
+	client := solana.NewClient(validator.Endpoint())
+
+	t.Run("should get health and slot", func(t *testing.T) {
+		require.NoError(t, client.GetHealth(ctx))
+		slot, err := client.GetSlot(ctx, solana.CommitmentFinalized)
+		require.NoError(t, err)
+		require.Equal(t, validator.Slot(), slot)
+	})
+
+	t.Run("should get deposit transaction", func(t *testing.T) {
+		sig := validator.Deposit(t, depositor, 1000, []byte("memo"))
+
+		balance, err := client.GetBalance(ctx, pda.String(), solana.CommitmentFinalized)
+		require.NoError(t, err)
+		require.EqualValues(t, 1000, balance)
+
+		tx, err := client.GetTransaction(ctx, sig, solana.CommitmentFinalized)
+		require.NoError(t, err)
+		require.NotNil(t, tx)
+		require.True(t, tx.IsSuccessful())
+		require.Equal(t, sig, tx.ID())
+
+		instructions, err := tx.ProgramInstructions(gatewayID)
+		require.NoError(t, err)
+		require.Len(t, instructions, 1)
+		require.Equal(t, []string{depositorAddress.String(), pda.String(), solana.SystemProgramID.String()},
+			instructions[0].Accounts)
+		deposit, err := solana.ParseDepositInstruction(instructions[0].Data)
+		require.NoError(t, err)
+		require.Equal(t, solana.DepositInstruction{Amount: 1000, Memo: []byte("memo")}, deposit)
+
+		sigs, err := client.GetSignaturesForAddress(ctx, gatewayID.String(), solana.SignaturesForAddressOpts{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, sigs, 1)
+		require.Equal(t, sig, sigs[0].Signature)
+	})
+
+	t.Run("should return nil for unknown transaction", func(t *testing.T) {
+		tx, err := client.GetTransaction(ctx, solana.Signature{}.String(), solana.CommitmentFinalized)
+		require.NoError(t, err)
+		require.Nil(t, tx)
+	})
+
+	t.Run("should return nil for skipped slot", func(t *testing.T) {
+		validator.SkipSlots(1)
+		block, err := client.GetBlock(ctx, validator.Slot(), solana.CommitmentFinalized)
+		require.NoError(t, err)
+		require.Nil(t, block)
+
+		block, err = client.GetBlock(ctx, 1, solana.CommitmentFinalized)
+		require.NoError(t, err)
+		require.NotNil(t, block)
+	})
+
+	t.Run("should fail to send invalid transaction", func(t *testing.T) {
+		tx := &solana.Transaction{Message: solana.Message{AccountKeys: []solana.PublicKey{depositorAddress}}}
+		_, err := client.SendTransaction(ctx, tx)
+		require.Error(t, err)
+	})
+}
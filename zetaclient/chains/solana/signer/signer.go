@@ -0,0 +1,279 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/pkg/coin"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/solana"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/solana/observer"
+	clientcommon "github.com/zeta-chain/zetacore/zetaclient/common"
+	"github.com/zeta-chain/zetacore/zetaclient/compliance"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	clientcontext "github.com/zeta-chain/zetacore/zetaclient/context"
+	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+	"github.com/zeta-chain/zetacore/zetaclient/outboundprocessor"
+)
+
+const (
+	// BlockhashSlotInterval is the interval of the slots whose blockhash is used by the withdrawals.
+	// Every TSS signer has to sign the same message, so the recent blockhash of a withdrawal must not depend on
+	// the moment each signer builds it. The signers pick the blockhash of the first block produced at or after
+	// the latest finalized slot rounded down to this interval, which is the same for all of them unless the
+	// finalized slot crosses a multiple of the interval while they build the message (the keysign then fails
+	// and is retried). A blockhash expires after 150 blocks, the interval leaves enough time to sign and broadcast.
+	BlockhashSlotInterval = 20
+
+	// broadcastRetries is the number of attempts to broadcast a signed withdrawal
+	broadcastRetries = 5
+)
+
+var _ interfaces.ChainSigner = &Signer{}
+
+// Signer deals with signing Solana transactions and implements the ChainSigner interface
+type Signer struct {
+	tssSigner        interfaces.TSSSignerEdDSA
+	rpcClient        interfaces.SolanaRPCClient
+	logger           zerolog.Logger
+	loggerCompliance zerolog.Logger
+	ts               *metrics.TelemetryServer
+	coreContext      *clientcontext.ZetacoreContext
+}
+
+// NewSigner creates a new Solana signer
+func NewSigner(
+	cfg config.SolanaConfig,
+	tssSigner interfaces.TSSSignerEdDSA,
+	loggers clientcommon.ClientLogger,
+	ts *metrics.TelemetryServer,
+	coreContext *clientcontext.ZetacoreContext,
+) *Signer {
+	return &Signer{
+		tssSigner:        tssSigner,
+		rpcClient:        solana.NewClient(cfg.Endpoint),
+		logger:           loggers.Std.With().Str("chain", "SOL").Str("module", "SOLSigner").Logger(),
+		loggerCompliance: loggers.Compliance,
+		ts:               ts,
+		coreContext:      coreContext,
+	}
+}
+
+// WithSolanaClient attaches a new Solana RPC client to the signer
+func (signer *Signer) WithSolanaClient(client interfaces.SolanaRPCClient) {
+	signer.rpcClient = client
+}
+
+// SetZetaConnectorAddress does nothing for Solana
+func (signer *Signer) SetZetaConnectorAddress(_ ethcommon.Address) {
+}
+
+// SetERC20CustodyAddress does nothing for Solana
+func (signer *Signer) SetERC20CustodyAddress(_ ethcommon.Address) {
+}
+
+// GetZetaConnectorAddress returns dummy address
+func (signer *Signer) GetZetaConnectorAddress() ethcommon.Address {
+	return ethcommon.Address{}
+}
+
+// GetERC20CustodyAddress returns dummy address
+func (signer *Signer) GetERC20CustodyAddress() ethcommon.Address {
+	return ethcommon.Address{}
+}
+
+// TSSAddress returns the Solana address of the TSS
+func (signer *Signer) TSSAddress() solana.PublicKey {
+	var address solana.PublicKey
+	copy(address[:], signer.tssSigner.PubKeyEdDSA())
+	return address
+}
+
+// GetRecentBlockhash returns the blockhash agreed by the TSS signers for the withdrawals
+// It is the hash of the first block produced at or after the finalized slot rounded down to BlockhashSlotInterval.
+func (signer *Signer) GetRecentBlockhash() (solana.Hash, error) {
+	ctx := context.Background()
+	finalized, err := signer.rpcClient.GetSlot(ctx, solana.CommitmentFinalized)
+	if err != nil {
+		return solana.Hash{}, errors.Wrap(err, "error getting finalized slot")
+	}
+	for slot := finalized - finalized%BlockhashSlotInterval; slot <= finalized; slot++ {
+		block, err := signer.rpcClient.GetBlock(ctx, slot, solana.CommitmentFinalized)
+		if err != nil {
+			return solana.Hash{}, errors.Wrapf(err, "error getting block at slot %d", slot)
+		}
+		if block == nil { // skipped slot
+			continue
+		}
+		return solana.HashFromBase58(block.Blockhash)
+	}
+	return solana.Hash{}, fmt.Errorf("no block produced between slot %d and %d",
+		finalized-finalized%BlockhashSlotInterval, finalized)
+}
+
+// SignWithdrawTx signs a gateway withdrawal paid by the TSS
+func (signer *Signer) SignWithdrawTx(
+	gatewayID solana.PublicKey,
+	recipient solana.PublicKey,
+	amount uint64,
+	height uint64,
+	nonce uint64,
+	chain *chains.Chain,
+) (*solana.Transaction, error) {
+	tssAddress := signer.TSSAddress()
+	inst, err := solana.NewWithdrawInstruction(gatewayID, tssAddress, recipient, amount, nonce)
+	if err != nil {
+		return nil, err
+	}
+	blockhash, err := signer.GetRecentBlockhash()
+	if err != nil {
+		return nil, err
+	}
+	msg, err := solana.NewMessage(tssAddress, []solana.Instruction{inst}, blockhash)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := signer.tssSigner.SignEdDSA(msg.Serialize(), height, nonce, chain)
+	if err != nil {
+		return nil, err
+	}
+	tx := &solana.Transaction{
+		Signatures: []solana.Signature{sig},
+		Message:    msg,
+	}
+	if err := tx.Verify(); err != nil {
+		return nil, errors.Wrap(err, "error verifying TSS signature")
+	}
+	if size := len(tx.Serialize()); size > solana.MaxTransactionSize {
+		return nil, fmt.Errorf("transaction size %d exceeds limit %d", size, solana.MaxTransactionSize)
+	}
+	return tx, nil
+}
+
+// Broadcast sends the signed transaction to the network
+func (signer *Signer) Broadcast(tx *solana.Transaction) error {
+	sig, err := signer.rpcClient.SendTransaction(context.Background(), tx)
+	if err != nil {
+		return err
+	}
+	if sig != tx.ID() {
+		return fmt.Errorf("broadcasted signature %s not match transaction id %s", sig, tx.ID())
+	}
+	return nil
+}
+
+// TryProcessOutbound signs and broadcasts the gateway withdrawal of the cctx
+func (signer *Signer) TryProcessOutbound(
+	cctx *types.CrossChainTx,
+	outboundProcessor *outboundprocessor.Processor,
+	outboundID string,
+	chainObserver interfaces.ChainObserver,
+	zetacoreClient interfaces.ZetacoreClient,
+	height uint64,
+) {
+	defer func() {
+		outboundProcessor.EndTryProcess(outboundID)
+		if err := recover(); err != nil {
+			signer.logger.Error().Msgf("SOL TryProcessOutbound: %s, caught panic error: %v", outboundID, err)
+		}
+	}()
+
+	logger := signer.logger.With().
+		Str("OutboundID", outboundID).
+		Str("SendHash", cctx.Index).
+		Logger()
+
+	params := cctx.GetCurrentOutboundParam()
+	logger.Info().
+		Msgf("SOL TryProcessOutbound: %s, value %d to %s", cctx.Index, params.Amount.BigInt(), params.Receiver)
+
+	solObserver, ok := chainObserver.(*observer.Observer)
+	if !ok {
+		logger.Error().Msgf("chain observer is not a solana observer")
+		return
+	}
+	flags := signer.coreContext.GetCrossChainFlags()
+	if !flags.IsOutboundEnabled {
+		logger.Info().Msgf("outbound is disabled")
+		return
+	}
+	// the EdDSA key of the TSS is generated apart from the ECDSA keygen
+	if len(signer.tssSigner.PubKeyEdDSA()) == 0 {
+		logger.Warn().Msgf("TSS has no EdDSA key yet")
+		return
+	}
+	chain := solObserver.Chain()
+	gatewayID, err := solObserver.GatewayID()
+	if err != nil {
+		logger.Error().Err(err).Msgf("cannot get gateway address")
+		return
+	}
+
+	// only SOL withdrawals are supported
+	if params.CoinType != coin.CoinType_Gas {
+		logger.Error().Msgf("SOL TryProcessOutbound: unsupported coin type %s", params.CoinType)
+		return
+	}
+	if !params.Amount.BigInt().IsUint64() {
+		logger.Error().Msgf("SOL TryProcessOutbound: invalid amount %s", params.Amount)
+		return
+	}
+	amount := params.Amount.Uint64()
+
+	// compliance check, a cancelled withdrawal pays zero lamports back to the TSS to consume the nonce
+	var recipient solana.PublicKey
+	if compliance.IsCctxRestricted(cctx) {
+		compliance.PrintComplianceLog(logger, signer.loggerCompliance,
+			true, chain.ChainId, cctx.Index, cctx.InboundParams.Sender, params.Receiver, "SOL")
+		recipient = signer.TSSAddress()
+		amount = 0
+	} else {
+		recipient, err = solana.PublicKeyFromBase58(params.Receiver)
+		if err != nil {
+			logger.Error().Err(err).Msgf("SOL TryProcessOutbound: invalid receiver %s", params.Receiver)
+			return
+		}
+	}
+
+	logger.Info().Msgf("SignWithdrawTx: to %s, value %d lamports, nonce %d", recipient, amount, params.TssNonce)
+	tx, err := signer.SignWithdrawTx(gatewayID, recipient, amount, height, params.TssNonce, &chain)
+	if err != nil {
+		logger.Warn().Err(err).Msgf("SignOutbound error: nonce %d chain %d", params.TssNonce, chain.ChainId)
+		return
+	}
+	outboundHash := tx.ID()
+	logger.Info().
+		Msgf("Key-sign success: %d => %s, nonce %d, outboundHash %s", cctx.InboundParams.SenderChainId, chain.ChainName, params.TssNonce, outboundHash)
+
+	// retry loop in case of RPC error
+	for i := 0; i < broadcastRetries; i++ {
+		// #nosec G404 randomness is not a security issue here
+		time.Sleep(time.Duration(rand.Intn(1500)) * time.Millisecond) //random delay to avoid sychronized broadcast
+		err := signer.Broadcast(tx)
+		if err != nil {
+			logger.Warn().
+				Err(err).
+				Msgf("broadcasting tx %s to chain %s: nonce %d, retry %d", outboundHash, chain.ChainName, params.TssNonce, i)
+			continue
+		}
+		logger.Info().
+			Msgf("Broadcast success: nonce %d to chain %s outboundHash %s", params.TssNonce, chain.String(), outboundHash)
+
+		zetaHash, err := zetacoreClient.AddOutboundTracker(chain.ChainId, params.TssNonce, outboundHash, nil, "", -1)
+		if err != nil {
+			logger.Err(err).
+				Msgf("Unable to add to tracker on zetacore: nonce %d chain %s outboundHash %s", params.TssNonce, chain.ChainName, outboundHash)
+		}
+		logger.Info().Msgf("Broadcast to core successful %s", zetaHash)
+		break // successful broadcast; no need to retry
+	}
+}
@@ -0,0 +1,201 @@
+package signer
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/pkg/coin"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/solana"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/solana/observer"
+	clientcommon "github.com/zeta-chain/zetacore/zetaclient/common"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	clientcontext "github.com/zeta-chain/zetacore/zetaclient/context"
+	"github.com/zeta-chain/zetacore/zetaclient/keys"
+	"github.com/zeta-chain/zetacore/zetaclient/outboundprocessor"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
+)
+
+// testSuite is a Solana signer and observer connected to a local validator stand-in
+type testSuite struct {
+	validator *testutils.SolanaValidator
+	signer    *Signer
+	observer  *observer.Observer
+	tss       solana.PublicKey
+}
+
+func newTestSuite(t *testing.T) *testSuite {
+	gatewayID, err := solana.PublicKeyFromBase58(testutils.SolanaGatewayAddressLocalnet)
+	require.NoError(t, err)
+	tss := mocks.NewTSSMainnet()
+	var tssAddress solana.PublicKey
+	copy(tssAddress[:], tss.PubKeyEdDSA())
+
+	validator := testutils.NewSolanaValidator(t, gatewayID, tssAddress)
+	validator.Fund(tssAddress, 1_000_000)
+
+	// create app context with the Solana chain params
+	cfg := config.NewConfig()
+	cfg.SolanaConfig = config.SolanaConfig{Endpoint: validator.Endpoint()}
+	coreCtx := clientcontext.NewZetacoreContext(cfg)
+	coreCtx.Update(
+		&observertypes.Keygen{},
+		[]chains.Chain{chains.SolanaLocalnet},
+		[]chains.Chain{},
+		nil,
+		nil,
+		observertypes.GetDefaultSolanaLocalnetChainParams(),
+		"",
+		*sample.CrosschainFlags(),
+		sample.HeaderSupportedChains(),
+		true,
+		zerolog.Logger{},
+	)
+	appCtx := clientcontext.NewAppContext(coreCtx, cfg)
+
+	ob, err := observer.NewObserver(
+		appCtx,
+		chains.SolanaLocalnet,
+		mocks.NewMockZetacoreClient().WithKeys(&keys.Keys{}),
+		"",
+		clientcommon.ClientLogger{},
+		cfg.SolanaConfig,
+		nil,
+	)
+	require.NoError(t, err)
+	signer := NewSigner(cfg.SolanaConfig, tss, clientcommon.ClientLogger{}, nil, coreCtx)
+
+	return &testSuite{validator: validator, signer: signer, observer: ob, tss: tssAddress}
+}
+
+// deposit funds the gateway with a deposit
+func (s *testSuite) deposit(t *testing.T, amount uint64) {
+	pub, depositor, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	var address solana.PublicKey
+	copy(address[:], pub)
+	s.validator.Fund(address, amount+solana.LamportsPerSignature)
+	s.validator.Deposit(t, depositor, amount, sample.EthAddress().Bytes())
+}
+
+// tryProcessOutbound runs the signer on the cctx
+func (s *testSuite) tryProcessOutbound(cctx *crosschaintypes.CrossChainTx) {
+	params := cctx.GetCurrentOutboundParam()
+	outboundProc := outboundprocessor.NewProcessor(zerolog.Nop())
+	outboundID := outboundprocessor.ToOutboundID(cctx.Index, params.ReceiverChainId, params.TssNonce)
+	outboundProc.StartTryProcess(outboundID)
+	s.signer.TryProcessOutbound(cctx, outboundProc, outboundID, s.observer,
+		mocks.NewMockZetacoreClient().WithKeys(&keys.Keys{}), 1)
+}
+
+// newTestCctx returns a cctx withdrawing SOL to the receiver
+func newTestCctx(receiver string, amount uint64, nonce uint64) *crosschaintypes.CrossChainTx {
+	return &crosschaintypes.CrossChainTx{
+		Index: sample.Hash().Hex(),
+		InboundParams: &crosschaintypes.InboundParams{
+			Sender:        sample.EthAddress().Hex(),
+			SenderChainId: chains.ZetaChainPrivnet.ChainId,
+		},
+		OutboundParams: []*crosschaintypes.OutboundParams{{
+			Receiver:        receiver,
+			ReceiverChainId: chains.SolanaLocalnet.ChainId,
+			Amount:          sdkmath.NewUint(amount),
+			TssNonce:        nonce,
+			CoinType:        coin.CoinType_Gas,
+		}},
+	}
+}
+
+func TestGetRecentBlockhash(t *testing.T) {
+	s := newTestSuite(t)
+
+	// produce blocks until a multiple of the interval is reached
+	for s.validator.Slot()%BlockhashSlotInterval != 0 {
+		s.deposit(t, 1)
+	}
+	expected := s.validator.LatestBlockhash()
+
+	t.Run("should pick the block of the rounded down slot", func(t *testing.T) {
+		s.deposit(t, 1)
+		s.deposit(t, 1)
+		blockhash, err := s.signer.GetRecentBlockhash()
+		require.NoError(t, err)
+		require.Equal(t, expected, blockhash)
+	})
+	t.Run("should pick the first block after skipped slots", func(t *testing.T) {
+		s.validator.SkipSlots(BlockhashSlotInterval)
+		s.deposit(t, 1)
+		expected := s.validator.LatestBlockhash()
+		s.deposit(t, 1)
+
+		blockhash, err := s.signer.GetRecentBlockhash()
+		require.NoError(t, err)
+		require.Equal(t, expected, blockhash)
+	})
+	t.Run("should fail if no block is produced since the rounded down slot", func(t *testing.T) {
+		s.validator.SkipSlots(2 * BlockhashSlotInterval)
+		_, err := s.signer.GetRecentBlockhash()
+		require.ErrorContains(t, err, "no block produced")
+	})
+}
+
+func TestSignWithdrawTx(t *testing.T) {
+	s := newTestSuite(t)
+	gatewayID, err := s.observer.GatewayID()
+	require.NoError(t, err)
+	recipient := solana.PublicKey{1, 2, 3}
+
+	tx, err := s.signer.SignWithdrawTx(gatewayID, recipient, 1000, 10, 0, &chains.SolanaLocalnet)
+	require.NoError(t, err)
+	require.NoError(t, tx.Verify())
+	require.Equal(t, []solana.PublicKey{s.tss}, tx.Message.Signers())
+
+	// the signed message is the same for all the TSS signers
+	other, err := s.signer.SignWithdrawTx(gatewayID, recipient, 1000, 10, 0, &chains.SolanaLocalnet)
+	require.NoError(t, err)
+	require.Equal(t, tx.Message.Serialize(), other.Message.Serialize())
+}
+
+func TestTryProcessOutbound(t *testing.T) {
+	s := newTestSuite(t)
+	s.deposit(t, 100_000)
+	recipient := solana.PublicKey{1, 2, 3}
+
+	t.Run("should withdraw to the receiver", func(t *testing.T) {
+		cctx := newTestCctx(recipient.String(), 1000, 0)
+		s.tryProcessOutbound(cctx)
+		require.EqualValues(t, 1, s.validator.Nonce())
+		require.EqualValues(t, 1000, s.validator.Balance(recipient))
+	})
+	t.Run("should cancel withdrawal of restricted cctx", func(t *testing.T) {
+		cfg := config.Config{}
+		cfg.ComplianceConfig.RestrictedAddresses = []string{recipient.String()}
+		config.LoadComplianceConfig(cfg)
+		defer config.LoadComplianceConfig(config.Config{})
+
+		cctx := newTestCctx(recipient.String(), 1000, 1)
+		s.tryProcessOutbound(cctx)
+		require.EqualValues(t, 2, s.validator.Nonce())
+		require.EqualValues(t, 1000, s.validator.Balance(recipient))
+	})
+	t.Run("should not withdraw with used nonce", func(t *testing.T) {
+		cctx := newTestCctx(recipient.String(), 1000, 0)
+		s.tryProcessOutbound(cctx)
+		require.EqualValues(t, 2, s.validator.Nonce())
+		require.EqualValues(t, 1000, s.validator.Balance(recipient))
+	})
+	t.Run("should not withdraw to invalid receiver", func(t *testing.T) {
+		cctx := newTestCctx(sample.EthAddress().Hex(), 1000, 2)
+		s.tryProcessOutbound(cctx)
+		require.EqualValues(t, 2, s.validator.Nonce())
+	})
+}
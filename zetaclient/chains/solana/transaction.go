@@ -0,0 +1,379 @@
+package solana
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"fmt"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/pkg/errors"
+)
+
+const (
+	// PublicKeyLength is the length in bytes of a Solana public key (an account address)
+	PublicKeyLength = 32
+
+	// SignatureLength is the length in bytes of a Solana transaction signature
+	SignatureLength = 64
+
+	// MaxTransactionSize is the maximum size in bytes of a serialized Solana transaction
+	MaxTransactionSize = 1232
+)
+
+// PublicKey is a Solana account address
+type PublicKey [PublicKeyLength]byte
+
+// PublicKeyFromBase58 decodes a base58 encoded Solana account address
+func PublicKeyFromBase58(address string) (PublicKey, error) {
+	var pk PublicKey
+	decoded := base58.Decode(address)
+	if len(decoded) != PublicKeyLength {
+		return pk, fmt.Errorf("invalid solana address %s", address)
+	}
+	copy(pk[:], decoded)
+	return pk, nil
+}
+
+// String returns the base58 encoded address
+func (pk PublicKey) String() string {
+	return base58.Encode(pk[:])
+}
+
+// Hash is a Solana block hash
+type Hash [32]byte
+
+// HashFromBase58 decodes a base58 encoded Solana block hash
+func HashFromBase58(hash string) (Hash, error) {
+	var h Hash
+	decoded := base58.Decode(hash)
+	if len(decoded) != len(h) {
+		return h, fmt.Errorf("invalid solana hash %s", hash)
+	}
+	copy(h[:], decoded)
+	return h, nil
+}
+
+// String returns the base58 encoded hash
+func (h Hash) String() string {
+	return base58.Encode(h[:])
+}
+
+// Signature is an ed25519 signature of a Solana transaction
+type Signature [SignatureLength]byte
+
+// String returns the base58 encoded signature, the first signature of a transaction is its id
+func (sig Signature) String() string {
+	return base58.Encode(sig[:])
+}
+
+// AccountMeta is an account referenced by an instruction
+type AccountMeta struct {
+	PublicKey  PublicKey
+	IsSigner   bool
+	IsWritable bool
+}
+
+// Instruction is an instruction to be compiled into a transaction message
+type Instruction struct {
+	ProgramID PublicKey
+	Accounts  []AccountMeta
+	Data      []byte
+}
+
+// MessageHeader describes the signers and read-only accounts of a message
+type MessageHeader struct {
+	NumRequiredSignatures       uint8 `json:"numRequiredSignatures"`
+	NumReadonlySignedAccounts   uint8 `json:"numReadonlySignedAccounts"`
+	NumReadonlyUnsignedAccounts uint8 `json:"numReadonlyUnsignedAccounts"`
+}
+
+// CompiledInstruction is an instruction referencing accounts by their index in the message
+type CompiledInstruction struct {
+	ProgramIDIndex uint8
+	Accounts       []uint8
+	Data           []byte
+}
+
+// Message is a legacy Solana transaction message
+type Message struct {
+	Header          MessageHeader
+	AccountKeys     []PublicKey
+	RecentBlockhash Hash
+	Instructions    []CompiledInstruction
+}
+
+// Transaction is a Solana transaction with the signatures of its message
+type Transaction struct {
+	Signatures []Signature
+	Message    Message
+}
+
+// NewMessage compiles the instructions into a message paid by the fee payer.
+// Accounts are ordered as: writable signers (fee payer first), read-only signers, writable and read-only non-signers.
+func NewMessage(feePayer PublicKey, instructions []Instruction, recentBlockhash Hash) (Message, error) {
+	// collect the accounts and merge their permissions
+	metas := []AccountMeta{{PublicKey: feePayer, IsSigner: true, IsWritable: true}}
+	indexOf := map[PublicKey]int{feePayer: 0}
+	addAccount := func(meta AccountMeta) {
+		if idx, found := indexOf[meta.PublicKey]; found {
+			metas[idx].IsSigner = metas[idx].IsSigner || meta.IsSigner
+			metas[idx].IsWritable = metas[idx].IsWritable || meta.IsWritable
+			return
+		}
+		indexOf[meta.PublicKey] = len(metas)
+		metas = append(metas, meta)
+	}
+	for _, inst := range instructions {
+		for _, account := range inst.Accounts {
+			addAccount(account)
+		}
+		addAccount(AccountMeta{PublicKey: inst.ProgramID})
+	}
+
+	// sort accounts into the four permission groups, keeping the order within each group
+	ordered := make([]AccountMeta, 0, len(metas))
+	for _, group := range []struct{ signer, writable bool }{{true, true}, {true, false}, {false, true}, {false, false}} {
+		for _, meta := range metas {
+			if meta.IsSigner == group.signer && meta.IsWritable == group.writable {
+				ordered = append(ordered, meta)
+			}
+		}
+	}
+	if len(ordered) > 256 {
+		return Message{}, fmt.Errorf("too many accounts: %d", len(ordered))
+	}
+
+	msg := Message{
+		AccountKeys:     make([]PublicKey, len(ordered)),
+		RecentBlockhash: recentBlockhash,
+		Instructions:    make([]CompiledInstruction, len(instructions)),
+	}
+	for i, meta := range ordered {
+		msg.AccountKeys[i] = meta.PublicKey
+		indexOf[meta.PublicKey] = i
+		if meta.IsSigner {
+			msg.Header.NumRequiredSignatures++
+			if !meta.IsWritable {
+				msg.Header.NumReadonlySignedAccounts++
+			}
+		} else if !meta.IsWritable {
+			msg.Header.NumReadonlyUnsignedAccounts++
+		}
+	}
+
+	// compile the instructions
+	for i, inst := range instructions {
+		accounts := make([]uint8, len(inst.Accounts))
+		for j, account := range inst.Accounts {
+			// #nosec G701 always in range, checked above
+			accounts[j] = uint8(indexOf[account.PublicKey])
+		}
+		msg.Instructions[i] = CompiledInstruction{
+			// #nosec G701 always in range, checked above
+			ProgramIDIndex: uint8(indexOf[inst.ProgramID]),
+			Accounts:       accounts,
+			Data:           inst.Data,
+		}
+	}
+	return msg, nil
+}
+
+// Serialize returns the wire format of the message, which is the payload signed by the signers
+func (m Message) Serialize() []byte {
+	var buf bytes.Buffer
+	buf.WriteByte(m.Header.NumRequiredSignatures)
+	buf.WriteByte(m.Header.NumReadonlySignedAccounts)
+	buf.WriteByte(m.Header.NumReadonlyUnsignedAccounts)
+
+	buf.Write(EncodeCompactU16(len(m.AccountKeys)))
+	for _, key := range m.AccountKeys {
+		buf.Write(key[:])
+	}
+	buf.Write(m.RecentBlockhash[:])
+
+	buf.Write(EncodeCompactU16(len(m.Instructions)))
+	for _, inst := range m.Instructions {
+		buf.WriteByte(inst.ProgramIDIndex)
+		buf.Write(EncodeCompactU16(len(inst.Accounts)))
+		buf.Write(inst.Accounts)
+		buf.Write(EncodeCompactU16(len(inst.Data)))
+		buf.Write(inst.Data)
+	}
+	return buf.Bytes()
+}
+
+// Signers returns the accounts required to sign the message
+func (m Message) Signers() []PublicKey {
+	n := int(m.Header.NumRequiredSignatures)
+	if n > len(m.AccountKeys) {
+		n = len(m.AccountKeys)
+	}
+	return m.AccountKeys[:n]
+}
+
+// IsWritable returns true if the account at given index is writable
+func (m Message) IsWritable(index int) bool {
+	numSigners := int(m.Header.NumRequiredSignatures)
+	if index < numSigners {
+		return index < numSigners-int(m.Header.NumReadonlySignedAccounts)
+	}
+	return index < len(m.AccountKeys)-int(m.Header.NumReadonlyUnsignedAccounts)
+}
+
+// ID returns the id of the transaction which is its first signature
+func (tx *Transaction) ID() string {
+	if len(tx.Signatures) == 0 {
+		return ""
+	}
+	return tx.Signatures[0].String()
+}
+
+// Serialize returns the wire format of the transaction
+func (tx *Transaction) Serialize() []byte {
+	var buf bytes.Buffer
+	buf.Write(EncodeCompactU16(len(tx.Signatures)))
+	for _, sig := range tx.Signatures {
+		buf.Write(sig[:])
+	}
+	buf.Write(tx.Message.Serialize())
+	return buf.Bytes()
+}
+
+// Verify verifies the signatures of the transaction against its signers
+func (tx *Transaction) Verify() error {
+	signers := tx.Message.Signers()
+	if len(tx.Signatures) != int(tx.Message.Header.NumRequiredSignatures) || len(signers) != len(tx.Signatures) {
+		return fmt.Errorf("expected %d signatures, got %d", tx.Message.Header.NumRequiredSignatures, len(tx.Signatures))
+	}
+	payload := tx.Message.Serialize()
+	for i, signer := range signers {
+		if !ed25519.Verify(signer[:], payload, tx.Signatures[i][:]) {
+			return fmt.Errorf("invalid signature of signer %s", signer)
+		}
+	}
+	return nil
+}
+
+// DeserializeTransaction decodes a transaction from its wire format
+func DeserializeTransaction(data []byte) (*Transaction, error) {
+	r := &reader{data: data}
+	tx := &Transaction{}
+
+	numSigs, err := r.compactU16()
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading signature count")
+	}
+	tx.Signatures = make([]Signature, numSigs)
+	for i := range tx.Signatures {
+		if err := r.read(tx.Signatures[i][:]); err != nil {
+			return nil, errors.Wrapf(err, "error reading signature %d", i)
+		}
+	}
+
+	header := make([]byte, 3)
+	if err := r.read(header); err != nil {
+		return nil, errors.Wrap(err, "error reading message header")
+	}
+	tx.Message.Header = MessageHeader{
+		NumRequiredSignatures:       header[0],
+		NumReadonlySignedAccounts:   header[1],
+		NumReadonlyUnsignedAccounts: header[2],
+	}
+	if header[0]&0x80 != 0 {
+		return nil, errors.New("versioned transactions are not supported")
+	}
+
+	numKeys, err := r.compactU16()
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading account count")
+	}
+	tx.Message.AccountKeys = make([]PublicKey, numKeys)
+	for i := range tx.Message.AccountKeys {
+		if err := r.read(tx.Message.AccountKeys[i][:]); err != nil {
+			return nil, errors.Wrapf(err, "error reading account %d", i)
+		}
+	}
+	if err := r.read(tx.Message.RecentBlockhash[:]); err != nil {
+		return nil, errors.Wrap(err, "error reading recent blockhash")
+	}
+
+	numInsts, err := r.compactU16()
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading instruction count")
+	}
+	tx.Message.Instructions = make([]CompiledInstruction, numInsts)
+	for i := range tx.Message.Instructions {
+		inst := &tx.Message.Instructions[i]
+		programIndex := make([]byte, 1)
+		if err := r.read(programIndex); err != nil {
+			return nil, errors.Wrapf(err, "error reading program of instruction %d", i)
+		}
+		inst.ProgramIDIndex = programIndex[0]
+
+		numAccounts, err := r.compactU16()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading account count of instruction %d", i)
+		}
+		inst.Accounts = make([]uint8, numAccounts)
+		if err := r.read(inst.Accounts); err != nil {
+			return nil, errors.Wrapf(err, "error reading accounts of instruction %d", i)
+		}
+
+		dataLen, err := r.compactU16()
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading data length of instruction %d", i)
+		}
+		inst.Data = make([]byte, dataLen)
+		if err := r.read(inst.Data); err != nil {
+			return nil, errors.Wrapf(err, "error reading data of instruction %d", i)
+		}
+	}
+	if r.pos != len(data) {
+		return nil, fmt.Errorf("%d trailing bytes after transaction", len(data)-r.pos)
+	}
+	return tx, nil
+}
+
+// EncodeCompactU16 encodes a length in Solana's compact-u16 format
+func EncodeCompactU16(n int) []byte {
+	out := make([]byte, 0, 3)
+	for {
+		b := byte(n & 0x7f)
+		n >>= 7
+		if n == 0 {
+			return append(out, b)
+		}
+		out = append(out, b|0x80)
+	}
+}
+
+// reader reads the wire format of a transaction
+type reader struct {
+	data []byte
+	pos  int
+}
+
+func (r *reader) read(out []byte) error {
+	if r.pos+len(out) > len(r.data) {
+		return fmt.Errorf("unexpected end of data at %d", r.pos)
+	}
+	copy(out, r.data[r.pos:])
+	r.pos += len(out)
+	return nil
+}
+
+func (r *reader) compactU16() (int, error) {
+	n := 0
+	for i := 0; i < 3; i++ {
+		if r.pos >= len(r.data) {
+			return 0, fmt.Errorf("unexpected end of data at %d", r.pos)
+		}
+		b := r.data[r.pos]
+		r.pos++
+		n |= int(b&0x7f) << (7 * i)
+		if b&0x80 == 0 {
+			return n, nil
+		}
+	}
+	return 0, errors.New("compact-u16 overflow")
+}
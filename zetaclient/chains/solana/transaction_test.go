@@ -0,0 +1,133 @@
+package solana
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKey(t *testing.T) (PublicKey, ed25519.PrivateKey) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	var pk PublicKey
+	copy(pk[:], pub)
+	return pk, priv
+}
+
+func TestPublicKeyFromBase58(t *testing.T) {
+	t.Run("should decode valid address", func(t *testing.T) {
+		pk, err := PublicKeyFromBase58("94U5AHQMKkV5txNJ17QPXWoh474PheGou6cNP2FEuL1d")
+		require.NoError(t, err)
+		require.Equal(t, "94U5AHQMKkV5txNJ17QPXWoh474PheGou6cNP2FEuL1d", pk.String())
+	})
+	t.Run("should decode system program", func(t *testing.T) {
+		pk, err := PublicKeyFromBase58("11111111111111111111111111111111")
+		require.NoError(t, err)
+		require.Equal(t, SystemProgramID, pk)
+	})
+	t.Run("should fail on invalid address", func(t *testing.T) {
+		_, err := PublicKeyFromBase58("0x70e967acFcC17c3941E87562161406d41676FD83")
+		require.Error(t, err)
+		_, err = PublicKeyFromBase58("")
+		require.Error(t, err)
+	})
+}
+
+func TestEncodeCompactU16(t *testing.T) {
+	tests := []struct {
+		n    int
+		want []byte
+	}{
+		{0, []byte{0x00}},
+		{0x7f, []byte{0x7f}},
+		{0x80, []byte{0x80, 0x01}},
+		{0x3fff, []byte{0xff, 0x7f}},
+		{0x4000, []byte{0x80, 0x80, 0x01}},
+		{0xffff, []byte{0xff, 0xff, 0x03}},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, EncodeCompactU16(tt.n))
+		r := &reader{data: tt.want}
+		n, err := r.compactU16()
+		require.NoError(t, err)
+		require.Equal(t, tt.n, n)
+	}
+}
+
+func TestNewMessage(t *testing.T) {
+	payer, _ := newTestKey(t)
+	other, _ := newTestKey(t)
+	readonly, _ := newTestKey(t)
+	programID, _ := newTestKey(t)
+
+	inst := Instruction{
+		ProgramID: programID,
+		Accounts: []AccountMeta{
+			{PublicKey: readonly},
+			{PublicKey: other, IsWritable: true},
+			{PublicKey: payer, IsSigner: true},
+		},
+		Data: []byte{1, 2, 3},
+	}
+	msg, err := NewMessage(payer, []Instruction{inst}, Hash{1})
+	require.NoError(t, err)
+
+	// fee payer first, then writable non-signers, then read-only non-signers
+	require.Equal(t, []PublicKey{payer, other, readonly, programID}, msg.AccountKeys)
+	require.Equal(t, MessageHeader{
+		NumRequiredSignatures:       1,
+		NumReadonlySignedAccounts:   0,
+		NumReadonlyUnsignedAccounts: 2,
+	}, msg.Header)
+	require.Equal(t, []CompiledInstruction{{ProgramIDIndex: 3, Accounts: []uint8{2, 1, 0}, Data: []byte{1, 2, 3}}},
+		msg.Instructions)
+	require.Equal(t, []PublicKey{payer}, msg.Signers())
+	require.True(t, msg.IsWritable(0))
+	require.True(t, msg.IsWritable(1))
+	require.False(t, msg.IsWritable(2))
+	require.False(t, msg.IsWritable(3))
+}
+
+func TestTransactionSerialization(t *testing.T) {
+	payer, privKey := newTestKey(t)
+	recipient, _ := newTestKey(t)
+	gatewayID, _ := newTestKey(t)
+
+	inst, err := NewWithdrawInstruction(gatewayID, payer, recipient, 1000, 7)
+	require.NoError(t, err)
+	msg, err := NewMessage(payer, []Instruction{inst}, Hash{9})
+	require.NoError(t, err)
+
+	var sig Signature
+	copy(sig[:], ed25519.Sign(privKey, msg.Serialize()))
+	tx := &Transaction{Signatures: []Signature{sig}, Message: msg}
+	require.NoError(t, tx.Verify())
+	require.Equal(t, sig.String(), tx.ID())
+
+	t.Run("should deserialize serialized transaction", func(t *testing.T) {
+		decoded, err := DeserializeTransaction(tx.Serialize())
+		require.NoError(t, err)
+		require.Equal(t, tx, decoded)
+		require.NoError(t, decoded.Verify())
+	})
+	t.Run("should fail on trailing bytes", func(t *testing.T) {
+		_, err := DeserializeTransaction(append(tx.Serialize(), 0))
+		require.Error(t, err)
+	})
+	t.Run("should fail on truncated transaction", func(t *testing.T) {
+		data := tx.Serialize()
+		_, err := DeserializeTransaction(data[:len(data)-1])
+		require.Error(t, err)
+	})
+	t.Run("should fail to verify tampered transaction", func(t *testing.T) {
+		tampered := *tx
+		tampered.Message.RecentBlockhash = Hash{10}
+		require.Error(t, tampered.Verify())
+	})
+	t.Run("should fail to verify missing signature", func(t *testing.T) {
+		unsigned := &Transaction{Message: msg}
+		require.Error(t, unsigned.Verify())
+	})
+}
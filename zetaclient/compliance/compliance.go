@@ -8,6 +8,8 @@ import (
 )
 
 // IsCctxRestricted returns true if the cctx involves restricted addresses
+// Note: the outbound is signed by all the TSS signers that must reach the same decision, it is only checked against
+// the restricted addresses of the config, the screener is not consulted since its decisions can differ between nodes
 func IsCctxRestricted(cctx *crosschaintypes.CrossChainTx) bool {
 	sender := cctx.InboundParams.Sender
 	receiver := cctx.GetCurrentOutboundParam().Receiver
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 
 	"github.com/zeta-chain/zetacore/pkg/chains"
@@ -43,6 +44,13 @@ func TestCctxRestricted(t *testing.T) {
 		cctx.InboundParams.Sender = ethcommon.Address{}.String()
 		require.True(t, IsCctxRestricted(cctx))
 	})
+	t.Run("should not consult the screener", func(t *testing.T) {
+		defer SetScreener(StaticScreener{}, zerolog.Nop())
+		SetScreener(mockScreener{restricted: map[string]bool{cctx.InboundParams.Sender: true}}, zerolog.Nop())
+		cfg.ComplianceConfig.RestrictedAddresses = []string{}
+		config.LoadComplianceConfig(cfg)
+		require.False(t, IsCctxRestricted(cctx))
+	})
 	t.Run("should ignore empty address", func(t *testing.T) {
 		cfg.ComplianceConfig.RestrictedAddresses = []string{""}
 		config.LoadComplianceConfig(cfg)
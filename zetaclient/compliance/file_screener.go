@@ -0,0 +1,113 @@
+package compliance
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fileCheckInterval is the minimum interval between two checks of the restricted addresses file for changes
+const fileCheckInterval = 10 * time.Second
+
+// FileScreener screens the addresses against a file of restricted addresses reloaded when it changes
+//
+// The file is either a JSON array of addresses or a CSV file with the address in the first column
+type FileScreener struct {
+	mu            sync.Mutex
+	path          string
+	checkInterval time.Duration
+	lastCheck     time.Time
+	modTime       time.Time
+	addresses     map[string]bool
+}
+
+// NewFileScreener creates a screener loading the restricted addresses from the file
+func NewFileScreener(path string) (*FileScreener, error) {
+	s := &FileScreener{
+		path:          filepath.Clean(path),
+		checkInterval: fileCheckInterval,
+	}
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Name returns the name of the file screener
+func (s *FileScreener) Name() string {
+	return "file"
+}
+
+// IsRestricted returns true if the address is in the restricted addresses file
+// Note: if the changed file can't be loaded, the previously loaded addresses are used and the error is returned
+func (s *FileScreener) IsRestricted(address string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var err error
+	if time.Since(s.lastCheck) >= s.checkInterval {
+		err = s.reload()
+	}
+	return s.addresses[strings.ToLower(address)], err
+}
+
+// reload reloads the restricted addresses if the file has been modified since the last load
+func (s *FileScreener) reload() error {
+	s.lastCheck = time.Now()
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return fmt.Errorf("error reading restricted addresses file %s: %w", s.path, err)
+	}
+	if s.addresses != nil && info.ModTime().Equal(s.modTime) {
+		return nil
+	}
+
+	// the file is loaded again at next check until it is loaded successfully
+	addresses, err := loadRestrictedAddresses(s.path)
+	if err != nil {
+		return err
+	}
+	s.addresses = addresses
+	s.modTime = info.ModTime()
+	return nil
+}
+
+// loadRestrictedAddresses loads the restricted addresses from a JSON or CSV file
+func loadRestrictedAddresses(path string) (map[string]bool, error) {
+	input, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading restricted addresses file %s: %w", path, err)
+	}
+
+	var list []string
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		if err := json.Unmarshal(input, &list); err != nil {
+			return nil, fmt.Errorf("error decoding restricted addresses file %s: %w", path, err)
+		}
+	} else {
+		reader := csv.NewReader(strings.NewReader(string(input)))
+		reader.Comment = '#'
+		reader.FieldsPerRecord = -1
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("error decoding restricted addresses file %s: %w", path, err)
+		}
+		for _, record := range records {
+			list = append(list, record[0])
+		}
+	}
+
+	addresses := make(map[string]bool, len(list))
+	for _, address := range list {
+		address = strings.TrimSpace(address)
+		if address != "" {
+			addresses[strings.ToLower(address)] = true
+		}
+	}
+	return addresses, nil
+}
@@ -0,0 +1,98 @@
+package compliance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// screeningResponse is the response of the screening service
+type screeningResponse struct {
+	Restricted bool `json:"restricted"`
+}
+
+// screeningDecision is a cached decision of the screening service
+type screeningDecision struct {
+	restricted bool
+	expiresAt  time.Time
+}
+
+// HTTPScreener screens the addresses with an HTTP screening service
+//
+// The service is queried with 'GET <url>?address=<address>' and responds with '{"restricted": <bool>}'
+// The decisions are cached by lowercased address, the least recently used ones are evicted once the cache is full
+type HTTPScreener struct {
+	url      string
+	client   *http.Client
+	cacheTTL time.Duration
+	cache    *lru.Cache
+}
+
+// NewHTTPScreener creates a screener querying the screening service with the timeout and caching its decisions
+func NewHTTPScreener(serviceURL string, timeout time.Duration, cacheTTL time.Duration) (*HTTPScreener, error) {
+	cache, err := lru.New(ScreeningCacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("error creating screening cache: %w", err)
+	}
+	return &HTTPScreener{
+		url:      serviceURL,
+		client:   &http.Client{Timeout: timeout},
+		cacheTTL: cacheTTL,
+		cache:    cache,
+	}, nil
+}
+
+// Name returns the name of the HTTP screener
+func (s *HTTPScreener) Name() string {
+	return "http"
+}
+
+// IsRestricted returns the cached decision or queries the screening service
+func (s *HTTPScreener) IsRestricted(address string) (bool, error) {
+	key := strings.ToLower(address)
+	if cached, found := s.cache.Get(key); found {
+		decision := cached.(screeningDecision)
+		if time.Now().Before(decision.expiresAt) {
+			return decision.restricted, nil
+		}
+		s.cache.Remove(key)
+	}
+
+	restricted, err := s.query(address)
+	if err != nil {
+		return false, err
+	}
+	s.cache.Add(key, screeningDecision{restricted: restricted, expiresAt: time.Now().Add(s.cacheTTL)})
+	return restricted, nil
+}
+
+// query queries the screening service for the address
+func (s *HTTPScreener) query(address string) (bool, error) {
+	reqURL, err := url.Parse(s.url)
+	if err != nil {
+		return false, fmt.Errorf("invalid screening url %s: %w", s.url, err)
+	}
+	query := reqURL.Query()
+	query.Set("address", address)
+	reqURL.RawQuery = query.Encode()
+
+	res, err := s.client.Get(reqURL.String())
+	if err != nil {
+		return false, fmt.Errorf("error querying screening service for address %s: %w", address, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("screening service returned status %d for address %s", res.StatusCode, address)
+	}
+
+	var response screeningResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return false, fmt.Errorf("error decoding screening response for address %s: %w", address, err)
+	}
+	return response.Restricted, nil
+}
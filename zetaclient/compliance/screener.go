@@ -0,0 +1,153 @@
+package compliance
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+)
+
+const (
+	// DefaultScreeningTimeout is the default timeout of a request to the screening service
+	DefaultScreeningTimeout = 5 * time.Second
+
+	// DefaultScreeningCacheTTL is the default duration a decision of the screening service is cached
+	DefaultScreeningCacheTTL = 10 * time.Minute
+
+	// ScreeningCacheSize is the maximum number of decisions of the screening service cached
+	ScreeningCacheSize = 10000
+)
+
+// Screener decides whether an address is restricted
+type Screener interface {
+	// Name returns the name of the screener written to the compliance log
+	Name() string
+
+	// IsRestricted returns true if the address is restricted
+	IsRestricted(address string) (bool, error)
+}
+
+var (
+	// screenerLock protects the screener and the compliance logger
+	screenerLock sync.RWMutex
+
+	// screener is consulted for all inbounds, the static list of the config by default
+	screener Screener = StaticScreener{}
+
+	// complianceLogger records every decision of the screener
+	complianceLogger = zerolog.Nop()
+)
+
+// SetScreener sets the screener consulted for all inbounds and the logger recording its decisions
+func SetScreener(s Screener, logger zerolog.Logger) {
+	screenerLock.Lock()
+	defer screenerLock.Unlock()
+	screener = s
+	complianceLogger = logger
+}
+
+// NewScreener creates the screener combining the static list, the restricted addresses file and
+// the screening service of the compliance config
+func NewScreener(cfg config.ComplianceConfig) (Screener, error) {
+	screeners := []Screener{StaticScreener{}}
+	if cfg.RestrictedAddressesFile != "" {
+		fileScreener, err := NewFileScreener(cfg.RestrictedAddressesFile)
+		if err != nil {
+			return nil, err
+		}
+		screeners = append(screeners, fileScreener)
+	}
+	if cfg.ScreeningURL != "" {
+		timeout, cacheTTL := DefaultScreeningTimeout, DefaultScreeningCacheTTL
+		if cfg.ScreeningTimeout > 0 {
+			// #nosec G701 always in range
+			timeout = time.Duration(cfg.ScreeningTimeout) * time.Second
+		}
+		if cfg.ScreeningCacheTTL > 0 {
+			// #nosec G701 always in range
+			cacheTTL = time.Duration(cfg.ScreeningCacheTTL) * time.Second
+		}
+		httpScreener, err := NewHTTPScreener(cfg.ScreeningURL, timeout, cacheTTL)
+		if err != nil {
+			return nil, err
+		}
+		screeners = append(screeners, httpScreener)
+	}
+	if len(screeners) == 1 {
+		return screeners[0], nil
+	}
+	return MultiScreener(screeners), nil
+}
+
+// ContainRestrictedAddress returns true if any one of the addresses is restricted by the screener
+// an error is returned if an address fails to be screened, the caller should retry the inbound later
+// Note: the addrs can contains ETH, BTC and Solana addresses
+func ContainRestrictedAddress(addrs ...string) (bool, error) {
+	screenerLock.RLock()
+	s, logger := screener, complianceLogger
+	screenerLock.RUnlock()
+
+	for _, addr := range addrs {
+		if addr == "" {
+			continue
+		}
+		restricted, err := s.IsRestricted(addr)
+		if err != nil {
+			logger.Error().Err(err).Str("screener", s.Name()).Str("address", addr).Msg("error screening address")
+			return false, errors.Wrapf(err, "error screening address %s with %s screener", addr, s.Name())
+		}
+		logger.Debug().Str("screener", s.Name()).Str("address", addr).Bool("restricted", restricted).Msg("address screened")
+		if restricted {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// StaticScreener screens the addresses against the restricted addresses of the config
+type StaticScreener struct{}
+
+// Name returns the name of the static screener
+func (StaticScreener) Name() string {
+	return "static"
+}
+
+// IsRestricted returns true if the address is in the restricted addresses of the config
+func (StaticScreener) IsRestricted(address string) (bool, error) {
+	return config.ContainRestrictedAddress(address), nil
+}
+
+// MultiScreener restricts an address restricted by any one of the screeners
+// Note: if no screener restricts the address, the error of a failing screener is returned
+type MultiScreener []Screener
+
+// Name returns the names of the screeners
+func (m MultiScreener) Name() string {
+	names := make([]string, 0, len(m))
+	for _, s := range m {
+		names = append(names, s.Name())
+	}
+	return strings.Join(names, "+")
+}
+
+// IsRestricted returns true if any one of the screeners restricts the address
+func (m MultiScreener) IsRestricted(address string) (bool, error) {
+	var firstErr error
+	for _, s := range m {
+		restricted, err := s.IsRestricted(address)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if restricted {
+			return true, nil
+		}
+	}
+	return false, firstErr
+}
@@ -0,0 +1,272 @@
+package compliance
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+)
+
+const (
+	restrictedAddress = "0x27104b8dB4aEdDb054fCed87c346C0758Ff5dFB1"
+	otherAddress      = "bc1qm24wp577nk8aacckv8np465z3dvmu7ry45el6y"
+)
+
+// mockScreener is a screener restricting the addresses of the list or failing with the error
+type mockScreener struct {
+	restricted map[string]bool
+	err        error
+}
+
+func (m mockScreener) Name() string {
+	return "mock"
+}
+
+func (m mockScreener) IsRestricted(address string) (bool, error) {
+	return m.restricted[address], m.err
+}
+
+func TestFileScreener(t *testing.T) {
+	t.Run("should load restricted addresses from JSON file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "restricted.json")
+		require.NoError(t, os.WriteFile(path, []byte(`["`+restrictedAddress+`"]`), 0600))
+
+		s, err := NewFileScreener(path)
+		require.NoError(t, err)
+		restricted, err := s.IsRestricted(restrictedAddress)
+		require.NoError(t, err)
+		require.True(t, restricted)
+		restricted, err = s.IsRestricted(otherAddress)
+		require.NoError(t, err)
+		require.False(t, restricted)
+	})
+	t.Run("should load restricted addresses from CSV file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "restricted.csv")
+		content := "# sanctioned addresses\n" + otherAddress + ",ofac\n" + restrictedAddress + "\n"
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+		s, err := NewFileScreener(path)
+		require.NoError(t, err)
+		restricted, err := s.IsRestricted(otherAddress)
+		require.NoError(t, err)
+		require.True(t, restricted)
+		restricted, err = s.IsRestricted(restrictedAddress)
+		require.NoError(t, err)
+		require.True(t, restricted)
+	})
+	t.Run("should return error if file can't be loaded", func(t *testing.T) {
+		_, err := NewFileScreener(filepath.Join(t.TempDir(), "missing.json"))
+		require.ErrorContains(t, err, "error reading restricted addresses file")
+	})
+	t.Run("should reload the file when it changes", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "restricted.json")
+		require.NoError(t, os.WriteFile(path, []byte(`[]`), 0600))
+		s, err := NewFileScreener(path)
+		require.NoError(t, err)
+		s.checkInterval = 0
+
+		restricted, err := s.IsRestricted(restrictedAddress)
+		require.NoError(t, err)
+		require.False(t, restricted)
+
+		// restrict the address
+		require.NoError(t, os.WriteFile(path, []byte(`["`+restrictedAddress+`"]`), 0600))
+		require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+		restricted, err = s.IsRestricted(restrictedAddress)
+		require.NoError(t, err)
+		require.True(t, restricted)
+
+		// keep the loaded addresses if the changed file is malformed
+		modTime := time.Now().Add(2 * time.Minute)
+		require.NoError(t, os.WriteFile(path, []byte(`[`), 0600))
+		require.NoError(t, os.Chtimes(path, time.Now(), modTime))
+		restricted, err = s.IsRestricted(restrictedAddress)
+		require.ErrorContains(t, err, "error decoding restricted addresses file")
+		require.True(t, restricted)
+
+		// load the fixed file even if its modification time is unchanged
+		require.NoError(t, os.WriteFile(path, []byte(`[]`), 0600))
+		require.NoError(t, os.Chtimes(path, time.Now(), modTime))
+		restricted, err = s.IsRestricted(restrictedAddress)
+		require.NoError(t, err)
+		require.False(t, restricted)
+	})
+}
+
+func TestHTTPScreener(t *testing.T) {
+	// newService creates a screening service restricting the address and counting the requests
+	newService := func(delay time.Duration, requests *int32) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(requests, 1)
+			time.Sleep(delay)
+			address := r.URL.Query().Get("address")
+			if address == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(screeningResponse{Restricted: address == restrictedAddress})
+		}))
+	}
+
+	t.Run("should query the service and cache the decision", func(t *testing.T) {
+		var requests int32
+		service := newService(0, &requests)
+		defer service.Close()
+
+		s, err := NewHTTPScreener(service.URL, time.Second, time.Minute)
+		require.NoError(t, err)
+		for i := 0; i < 3; i++ {
+			restricted, err := s.IsRestricted(restrictedAddress)
+			require.NoError(t, err)
+			require.True(t, restricted)
+		}
+		restricted, err := s.IsRestricted(otherAddress)
+		require.NoError(t, err)
+		require.False(t, restricted)
+		require.EqualValues(t, 2, atomic.LoadInt32(&requests))
+	})
+	t.Run("should query the service again once the decision expires", func(t *testing.T) {
+		var requests int32
+		service := newService(0, &requests)
+		defer service.Close()
+
+		s, err := NewHTTPScreener(service.URL, time.Second, 0)
+		require.NoError(t, err)
+		_, err = s.IsRestricted(restrictedAddress)
+		require.NoError(t, err)
+		_, err = s.IsRestricted(restrictedAddress)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, atomic.LoadInt32(&requests))
+	})
+	t.Run("should return error if the service times out", func(t *testing.T) {
+		var requests int32
+		service := newService(200*time.Millisecond, &requests)
+		defer service.Close()
+
+		s, err := NewHTTPScreener(service.URL, 50*time.Millisecond, time.Minute)
+		require.NoError(t, err)
+		restricted, err := s.IsRestricted(restrictedAddress)
+		require.ErrorContains(t, err, "error querying screening service")
+		require.False(t, restricted)
+	})
+	t.Run("should return error if the service fails", func(t *testing.T) {
+		service := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer service.Close()
+
+		s, err := NewHTTPScreener(service.URL, time.Second, time.Minute)
+		require.NoError(t, err)
+		_, err = s.IsRestricted(restrictedAddress)
+		require.ErrorContains(t, err, "screening service returned status 500")
+	})
+	t.Run("should cache the decision by lowercased address", func(t *testing.T) {
+		var requests int32
+		service := newService(0, &requests)
+		defer service.Close()
+
+		s, err := NewHTTPScreener(service.URL, time.Second, time.Minute)
+		require.NoError(t, err)
+		restricted, err := s.IsRestricted(restrictedAddress)
+		require.NoError(t, err)
+		require.True(t, restricted)
+		restricted, err = s.IsRestricted(strings.ToUpper(restrictedAddress))
+		require.NoError(t, err)
+		require.True(t, restricted)
+		require.EqualValues(t, 1, atomic.LoadInt32(&requests))
+	})
+	t.Run("should bound the number of cached decisions", func(t *testing.T) {
+		var requests int32
+		service := newService(0, &requests)
+		defer service.Close()
+
+		s, err := NewHTTPScreener(service.URL, time.Second, time.Minute)
+		require.NoError(t, err)
+		for i := 0; i < ScreeningCacheSize+10; i++ {
+			s.cache.Add(sample.EthAddress().Hex(), screeningDecision{expiresAt: time.Now().Add(time.Minute)})
+		}
+		require.Equal(t, ScreeningCacheSize, s.cache.Len())
+	})
+}
+
+func TestMultiScreener(t *testing.T) {
+	failing := mockScreener{err: errors.New("service unavailable")}
+	restricting := mockScreener{restricted: map[string]bool{restrictedAddress: true}}
+
+	t.Run("should restrict address restricted by any screener", func(t *testing.T) {
+		restricted, err := MultiScreener{failing, restricting}.IsRestricted(restrictedAddress)
+		require.NoError(t, err)
+		require.True(t, restricted)
+	})
+	t.Run("should not restrict address if a screener fails", func(t *testing.T) {
+		restricted, err := MultiScreener{failing, restricting}.IsRestricted(otherAddress)
+		require.ErrorContains(t, err, "service unavailable")
+		require.False(t, restricted)
+	})
+	t.Run("should join the names of the screeners", func(t *testing.T) {
+		require.Equal(t, "mock+mock", MultiScreener{failing, restricting}.Name())
+	})
+}
+
+func TestNewScreener(t *testing.T) {
+	t.Run("should use the static list by default", func(t *testing.T) {
+		s, err := NewScreener(config.ComplianceConfig{})
+		require.NoError(t, err)
+		require.Equal(t, "static", s.Name())
+	})
+	t.Run("should combine the configured screeners", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "restricted.json")
+		require.NoError(t, os.WriteFile(path, []byte(`[]`), 0600))
+
+		s, err := NewScreener(config.ComplianceConfig{
+			RestrictedAddressesFile: path,
+			ScreeningURL:            "http://localhost:8080/screen",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "static+file+http", s.Name())
+	})
+	t.Run("should return error if the restricted addresses file can't be loaded", func(t *testing.T) {
+		_, err := NewScreener(config.ComplianceConfig{RestrictedAddressesFile: filepath.Join(t.TempDir(), "missing.csv")})
+		require.Error(t, err)
+	})
+}
+
+func TestContainRestrictedAddress(t *testing.T) {
+	defer SetScreener(StaticScreener{}, zerolog.Nop())
+	sender := sample.EthAddress().Hex()
+
+	t.Run("should restrict the addresses restricted by the screener", func(t *testing.T) {
+		SetScreener(mockScreener{restricted: map[string]bool{restrictedAddress: true}}, zerolog.Nop())
+
+		restricted, err := ContainRestrictedAddress(sender, restrictedAddress)
+		require.NoError(t, err)
+		require.True(t, restricted)
+
+		restricted, err = ContainRestrictedAddress(sender, otherAddress)
+		require.NoError(t, err)
+		require.False(t, restricted)
+
+		restricted, err = ContainRestrictedAddress("", "")
+		require.NoError(t, err)
+		require.False(t, restricted)
+	})
+	t.Run("should return error if the addresses fail to be screened", func(t *testing.T) {
+		SetScreener(mockScreener{err: errors.New("service unavailable")}, zerolog.Nop())
+
+		restricted, err := ContainRestrictedAddress(sender)
+		require.ErrorContains(t, err, "service unavailable")
+		require.False(t, restricted)
+	})
+}
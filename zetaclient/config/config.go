@@ -6,6 +6,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
 )
 
 // restrictedAddressBook is a map of restricted addresses
@@ -67,6 +69,11 @@ func Load(path string) (Config, error) {
 		return Config{}, fmt.Errorf("invalid keyring backend %s", cfg.KeyringBackend)
 	}
 
+	// fold the legacy bitcoin config into the bitcoin chain configs
+	if err := foldLegacyBTCConfig(&cfg); err != nil {
+		return Config{}, err
+	}
+
 	// fields sanitization
 	cfg.TssPath = GetPath(cfg.TssPath)
 	cfg.PreParamsPath = GetPath(cfg.PreParamsPath)
@@ -78,6 +85,30 @@ func Load(path string) (Config, error) {
 	return cfg, nil
 }
 
+// foldLegacyBTCConfig moves the legacy single bitcoin config into the bitcoin chain configs
+// the chain is derived from the rpc params, an empty value meaning mainnet as in rpcclient
+func foldLegacyBTCConfig(cfg *Config) error {
+	if cfg.BitcoinConfig.isEmpty() {
+		return nil
+	}
+	chainID := chains.BitcoinMainnet.ChainId
+	if cfg.BitcoinConfig.RPCParams != "" {
+		var err error
+		chainID, err = chains.BitcoinChainIDFromNetworkName(cfg.BitcoinConfig.RPCParams)
+		if err != nil {
+			return fmt.Errorf("invalid bitcoin rpc params %s: %w", cfg.BitcoinConfig.RPCParams, err)
+		}
+	}
+	if cfg.BTCChainConfigs == nil {
+		cfg.BTCChainConfigs = make(map[int64]BTCConfig)
+	}
+	if _, found := cfg.BTCChainConfigs[chainID]; !found {
+		cfg.BTCChainConfigs[chainID] = cfg.BitcoinConfig
+	}
+	cfg.BitcoinConfig = BTCConfig{}
+	return nil
+}
+
 func LoadComplianceConfig(cfg Config) {
 	restrictedAddressBook = cfg.GetRestrictedAddressBook()
 }
@@ -24,7 +24,9 @@ func GetERC20CustodyABI() string {
 func New() Config {
 	return Config{
 		EVMChainConfigs: evmChainsConfigs,
-		BitcoinConfig:   bitcoinConfigRegnet,
+		BTCChainConfigs: map[int64]BTCConfig{
+			chains.BitcoinRegtest.ChainId: bitcoinConfigRegnet,
+		},
 	}
 }
 
@@ -0,0 +1,54 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("should fold legacy bitcoin config into the bitcoin chain configs", func(t *testing.T) {
+		path := t.TempDir()
+		cfg := config.NewConfig()
+		cfg.BitcoinConfig = config.BTCConfig{RPCHost: "bitcoin:18443", RPCParams: "regtest"}
+		cfg.BTCChainConfigs[chains.BitcoinSignetTestnet.ChainId] = config.BTCConfig{
+			RPCHost:   "signet:38332",
+			RPCParams: "signet",
+		}
+		require.NoError(t, config.Save(&cfg, path))
+
+		loaded, err := config.Load(path)
+		require.NoError(t, err)
+		require.Equal(t, config.BTCConfig{}, loaded.BitcoinConfig)
+		require.Len(t, loaded.GetAllBTCConfigs(), 2)
+
+		btcCfg, enabled := loaded.GetBTCConfig(chains.BitcoinRegtest.ChainId)
+		require.True(t, enabled)
+		require.Equal(t, "bitcoin:18443", btcCfg.RPCHost)
+	})
+	t.Run("should not override the chain config with the legacy bitcoin config", func(t *testing.T) {
+		path := t.TempDir()
+		cfg := config.NewConfig()
+		cfg.BitcoinConfig = config.BTCConfig{RPCHost: "legacy:8332"}
+		cfg.BTCChainConfigs[chains.BitcoinMainnet.ChainId] = config.BTCConfig{RPCHost: "bitcoin:8332"}
+		require.NoError(t, config.Save(&cfg, path))
+
+		loaded, err := config.Load(path)
+		require.NoError(t, err)
+		btcCfg, enabled := loaded.GetBTCConfig(chains.BitcoinMainnet.ChainId)
+		require.True(t, enabled)
+		require.Equal(t, "bitcoin:8332", btcCfg.RPCHost)
+	})
+	t.Run("should fail on unknown legacy bitcoin rpc params", func(t *testing.T) {
+		path := t.TempDir()
+		cfg := config.NewConfig()
+		cfg.BitcoinConfig = config.BTCConfig{RPCHost: "bitcoin:8332", RPCParams: "unknown"}
+		require.NoError(t, config.Save(&cfg, path))
+
+		_, err := config.Load(path)
+		require.ErrorContains(t, err, "invalid bitcoin rpc params")
+	})
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"strings"
 	"sync"
@@ -17,6 +19,9 @@ const (
 	KeyringBackendFile      KeyringBackend = "file"
 )
 
+// configVersionLength is the number of hex characters of the config version
+const configVersionLength = 12
+
 // ClientConfiguration is a subset of zetaclient config that is used by zetacore client
 type ClientConfiguration struct {
 	ChainHost       string `json:"chain_host"        mapstructure:"chain_host"`
@@ -30,6 +35,17 @@ type ClientConfiguration struct {
 type EVMConfig struct {
 	Chain    chains.Chain
 	Endpoint string
+
+	// Endpoints are the fallback endpoints used when the primary endpoint degrades
+	Endpoints []string `json:",omitempty"`
+
+	// RPCQuorum is the number of endpoints that must agree on critical reads (receipts, block hashes)
+	// the reads are not cross-checked if the quorum is 0 or 1
+	RPCQuorum int `json:",omitempty"`
+
+	// WSEndpoint is the optional websocket endpoint used to subscribe to new heads and inbound logs
+	// the inbound is polled from the endpoints if it is empty or the subscription is interrupted
+	WSEndpoint string `json:",omitempty"`
 }
 
 type BTCConfig struct {
@@ -37,12 +53,83 @@ type BTCConfig struct {
 	RPCUsername string
 	RPCPassword string
 	RPCHost     string
-	RPCParams   string // "regtest", "mainnet", "testnet3"
+	RPCParams   string // "regtest", "mainnet", "testnet3", "signet", "testnet4"
+
+	// RPCHosts are the fallback hosts used when the primary host degrades
+	// a host can override the credentials in the form "user:password@host:port"
+	RPCHosts []string `json:",omitempty"`
+
+	// RPCQuorum is the number of hosts that must agree on critical reads (transactions, block hashes)
+	// the reads are not cross-checked if the quorum is 0 or 1
+	RPCQuorum int `json:",omitempty"`
+}
+
+// GetEndpoints returns the primary endpoint followed by the fallback endpoints, without duplicates
+func (c EVMConfig) GetEndpoints() []string {
+	return uniqueNonEmpty(append([]string{c.Endpoint}, c.Endpoints...))
+}
+
+// GetRPCHosts returns the primary host followed by the fallback hosts, without duplicates
+func (c BTCConfig) GetRPCHosts() []string {
+	return uniqueNonEmpty(append([]string{c.RPCHost}, c.RPCHosts...))
+}
+
+// isEmpty returns true if none of the bitcoin config fields is set
+func (c BTCConfig) isEmpty() bool {
+	return c.RPCUsername == "" && c.RPCPassword == "" && c.RPCHost == "" && c.RPCParams == "" &&
+		len(c.RPCHosts) == 0 && c.RPCQuorum == 0
+}
+
+// uniqueNonEmpty returns the non-empty values in order, without duplicates
+func uniqueNonEmpty(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := make([]string, 0, len(values))
+	for _, value := range values {
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		unique = append(unique, value)
+	}
+	return unique
+}
+
+// SolanaConfig is the config for Solana chain
+type SolanaConfig struct {
+	Endpoint string
+}
+
+// RemoteSignerConfig is the config of the remote signer holding the hotkey that signs the zetaclient transactions
+type RemoteSignerConfig struct {
+	// Endpoint is the gRPC endpoint (host:port) of the remote signer, the remote signer is disabled if empty
+	Endpoint string `json:"Endpoint"`
+
+	// CertFile and KeyFile are the client certificate and key presented to the remote signer
+	CertFile string `json:"CertFile"`
+	KeyFile  string `json:"KeyFile"`
+
+	// CAFile is the certificate authority verifying the certificate of the remote signer
+	CAFile string `json:"CAFile"`
+
+	// Timeout is the timeout in seconds of a request to the remote signer
+	Timeout uint64 `json:"Timeout,omitempty"`
 }
 
 type ComplianceConfig struct {
 	LogPath             string   `json:"LogPath"`
 	RestrictedAddresses []string `json:"RestrictedAddresses"`
+
+	// RestrictedAddressesFile is a CSV or JSON file of restricted addresses reloaded when it changes
+	RestrictedAddressesFile string `json:"RestrictedAddressesFile,omitempty"`
+
+	// ScreeningURL is the endpoint of an HTTP address screening service
+	ScreeningURL string `json:"ScreeningURL,omitempty"`
+
+	// ScreeningTimeout is the timeout in seconds of a request to the screening service
+	ScreeningTimeout uint64 `json:"ScreeningTimeout,omitempty"`
+
+	// ScreeningCacheTTL is the number of seconds a decision of the screening service is cached
+	ScreeningCacheTTL uint64 `json:"ScreeningCacheTTL,omitempty"`
 }
 
 // Config is the config for ZetaClient
@@ -71,8 +158,31 @@ type Config struct {
 	HsmMode             bool           `json:"HsmMode"`
 	HsmHotKey           string         `json:"HsmHotKey"`
 
+	// EdDSAPort is the port of the p2p communication of the EdDSA ceremonies of the TSS, 6669 if 0
+	EdDSAPort int `json:"EdDSAPort,omitempty"`
+
+	// RemoteSigner signs the zetaclient transactions with the hotkey held by a remote signer
+	RemoteSigner RemoteSignerConfig `json:"RemoteSigner"`
+
+	// TelemetryListenAddress is the listen address of the telemetry server, loopback only if empty
+	TelemetryListenAddress string `json:"TelemetryListenAddress,omitempty"`
+
+	// AdminAPIToken is the bearer token of the admin endpoints of the telemetry server, they are disabled if empty
+	AdminAPIToken string `json:"AdminAPIToken,omitempty"`
+
+	// MaxConcurrentKeysigns is the maximum number of outbound keysigns in progress across all chains, unlimited if 0
+	MaxConcurrentKeysigns int `json:"MaxConcurrentKeysigns,omitempty"`
+
+	// MaxConcurrentKeysignsPerChain is the maximum number of outbound keysigns in progress per chain, unlimited if 0
+	MaxConcurrentKeysignsPerChain int `json:"MaxConcurrentKeysignsPerChain,omitempty"`
+
 	EVMChainConfigs map[int64]EVMConfig `json:"EVMChainConfigs"`
-	BitcoinConfig   BTCConfig           `json:"BitcoinConfig"`
+	BTCChainConfigs map[int64]BTCConfig `json:"BTCChainConfigs"`
+	SolanaConfig    SolanaConfig        `json:"SolanaConfig"`
+
+	// BitcoinConfig is the legacy config of the single bitcoin chain observed
+	// Deprecated: it is folded into BTCChainConfigs when the config is loaded, use BTCChainConfigs instead
+	BitcoinConfig BTCConfig `json:"BitcoinConfig"`
 
 	// compliance config
 	ComplianceConfig ComplianceConfig `json:"ComplianceConfig"`
@@ -82,6 +192,7 @@ func NewConfig() Config {
 	return Config{
 		cfgLock:         &sync.RWMutex{},
 		EVMChainConfigs: make(map[int64]EVMConfig),
+		BTCChainConfigs: make(map[int64]BTCConfig),
 	}
 }
 
@@ -104,11 +215,36 @@ func (c Config) GetAllEVMConfigs() map[int64]EVMConfig {
 	return copied
 }
 
-func (c Config) GetBTCConfig() (BTCConfig, bool) {
+// GetBTCConfig returns the config of the bitcoin chain and whether it is enabled
+func (c Config) GetBTCConfig(chainID int64) (BTCConfig, bool) {
 	c.cfgLock.RLock()
 	defer c.cfgLock.RUnlock()
 
-	return c.BitcoinConfig, c.BitcoinConfig != (BTCConfig{})
+	btcCfg, found := c.BTCChainConfigs[chainID]
+	return btcCfg, found && !btcCfg.isEmpty()
+}
+
+// GetAllBTCConfigs returns the configs of all enabled bitcoin chains
+func (c Config) GetAllBTCConfigs() map[int64]BTCConfig {
+	c.cfgLock.RLock()
+	defer c.cfgLock.RUnlock()
+
+	// deep copy btc configs
+	copied := make(map[int64]BTCConfig, len(c.BTCChainConfigs))
+	for chainID, btcConfig := range c.BTCChainConfigs {
+		if !btcConfig.isEmpty() {
+			copied[chainID] = btcConfig
+		}
+	}
+	return copied
+}
+
+// GetSolanaConfig returns the solana config and whether it is enabled
+func (c Config) GetSolanaConfig() (SolanaConfig, bool) {
+	c.cfgLock.RLock()
+	defer c.cfgLock.RUnlock()
+
+	return c.SolanaConfig, c.SolanaConfig != (SolanaConfig{})
 }
 
 func (c Config) String() string {
@@ -119,8 +255,14 @@ func (c Config) String() string {
 	return string(s)
 }
 
+// Version returns a short hash identifying the content of the config
+func (c Config) Version() string {
+	hash := sha256.Sum256([]byte(c.String()))
+	return hex.EncodeToString(hash[:])[:configVersionLength]
+}
+
 // GetRestrictedAddressBook returns a map of restricted addresses
-// Note: the restricted address book contains both ETH and BTC addresses
+// Note: the restricted address book contains ETH, BTC and Solana addresses
 func (c Config) GetRestrictedAddressBook() map[string]bool {
 	restrictedAddresses := make(map[string]bool)
 	for _, address := range c.ComplianceConfig.RestrictedAddresses {
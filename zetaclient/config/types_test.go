@@ -1 +1,78 @@
 package config_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+)
+
+func TestEVMConfig_GetEndpoints(t *testing.T) {
+	t.Run("should return primary endpoint first without duplicates", func(t *testing.T) {
+		evmCfg := config.EVMConfig{
+			Endpoint:  "http://eth:8545",
+			Endpoints: []string{"http://eth-backup:8545", "", "http://eth:8545"},
+		}
+		require.Equal(t, []string{"http://eth:8545", "http://eth-backup:8545"}, evmCfg.GetEndpoints())
+	})
+	t.Run("should return fallback endpoints without primary endpoint", func(t *testing.T) {
+		evmCfg := config.EVMConfig{Endpoints: []string{"http://eth-backup:8545"}}
+		require.Equal(t, []string{"http://eth-backup:8545"}, evmCfg.GetEndpoints())
+	})
+	t.Run("should return no endpoint if none is set", func(t *testing.T) {
+		require.Empty(t, config.EVMConfig{}.GetEndpoints())
+	})
+}
+
+func TestConfig_GetBTCConfig(t *testing.T) {
+	t.Run("should return primary host first without duplicates", func(t *testing.T) {
+		cfg := config.NewConfig()
+		cfg.BTCChainConfigs[chains.BitcoinRegtest.ChainId] = config.BTCConfig{
+			RPCHost:  "bitcoin:18443",
+			RPCHosts: []string{"bitcoin:18443", "user:pass@bitcoin-backup:18443"},
+		}
+		btcCfg, enabled := cfg.GetBTCConfig(chains.BitcoinRegtest.ChainId)
+		require.True(t, enabled)
+		require.Equal(t, []string{"bitcoin:18443", "user:pass@bitcoin-backup:18443"}, btcCfg.GetRPCHosts())
+	})
+	t.Run("should be enabled with fallback hosts only", func(t *testing.T) {
+		cfg := config.NewConfig()
+		cfg.BTCChainConfigs[chains.BitcoinRegtest.ChainId] = config.BTCConfig{RPCHosts: []string{"bitcoin:18443"}}
+		_, enabled := cfg.GetBTCConfig(chains.BitcoinRegtest.ChainId)
+		require.True(t, enabled)
+	})
+	t.Run("should be disabled if empty", func(t *testing.T) {
+		cfg := config.NewConfig()
+		cfg.BTCChainConfigs[chains.BitcoinRegtest.ChainId] = config.BTCConfig{}
+		_, enabled := cfg.GetBTCConfig(chains.BitcoinRegtest.ChainId)
+		require.False(t, enabled)
+		require.Empty(t, cfg.GetAllBTCConfigs())
+	})
+	t.Run("should be disabled for another chain", func(t *testing.T) {
+		cfg := config.NewConfig()
+		cfg.BTCChainConfigs[chains.BitcoinRegtest.ChainId] = config.BTCConfig{RPCHost: "bitcoin:18443"}
+		_, enabled := cfg.GetBTCConfig(chains.BitcoinSignetTestnet.ChainId)
+		require.False(t, enabled)
+	})
+	t.Run("should return all enabled btc configs", func(t *testing.T) {
+		cfg := config.NewConfig()
+		cfg.BTCChainConfigs[chains.BitcoinMainnet.ChainId] = config.BTCConfig{RPCHost: "bitcoin:8332"}
+		cfg.BTCChainConfigs[chains.BitcoinSignetTestnet.ChainId] = config.BTCConfig{RPCHost: "signet:38332"}
+		all := cfg.GetAllBTCConfigs()
+		require.Len(t, all, 2)
+		require.Equal(t, "signet:38332", all[chains.BitcoinSignetTestnet.ChainId].RPCHost)
+	})
+}
+
+func TestConfig_Version(t *testing.T) {
+	cfg := config.NewConfig()
+	cfg.BTCChainConfigs[chains.BitcoinRegtest.ChainId] = config.BTCConfig{RPCHost: "bitcoin:18443"}
+	version := cfg.Version()
+	require.Len(t, version, 12)
+	require.Equal(t, version, cfg.Version())
+
+	cfg.BTCChainConfigs[chains.BitcoinRegtest.ChainId] = config.BTCConfig{RPCHost: "bitcoin:18444"}
+	require.NotEqual(t, version, cfg.Version())
+}
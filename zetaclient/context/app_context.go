@@ -1,6 +1,9 @@
 package context
 
 import (
+	"sort"
+	"sync"
+
 	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 )
@@ -9,6 +12,7 @@ import (
 type AppContext struct {
 	coreContext *ZetacoreContext
 	config      config.Config
+	configLock  *sync.RWMutex
 }
 
 // NewAppContext creates and returns new AppContext
@@ -19,21 +23,37 @@ func NewAppContext(
 	return &AppContext{
 		coreContext: coreContext,
 		config:      config,
+		configLock:  new(sync.RWMutex),
 	}
 }
 
 func (a AppContext) Config() config.Config {
+	a.configLock.RLock()
+	defer a.configLock.RUnlock()
 	return a.config
 }
 
+// UpdateChainConfigs replaces the external chain configs with the ones of the reloaded config
+// the zetacore context then tracks the chain params of the chains configured in the reloaded config
+func (a *AppContext) UpdateChainConfigs(reloaded config.Config) {
+	a.configLock.Lock()
+	a.config.EVMChainConfigs = reloaded.GetAllEVMConfigs()
+	a.config.BTCChainConfigs = reloaded.GetAllBTCConfigs()
+	a.config.SolanaConfig, _ = reloaded.GetSolanaConfig()
+	cfg := a.config
+	a.configLock.Unlock()
+
+	a.coreContext.UpdateChainConfigs(cfg)
+}
+
 func (a AppContext) ZetacoreContext() *ZetacoreContext {
 	return a.coreContext
 }
 
-// GetBTCChainAndConfig returns btc chain and config if enabled
-func (a AppContext) GetBTCChainAndConfig() (chains.Chain, config.BTCConfig, bool) {
-	btcConfig, configEnabled := a.Config().GetBTCConfig()
-	btcChain, _, paramsEnabled := a.coreContext.GetBTCChainParams()
+// GetBTCChainAndConfig returns btc chain and config of the chain id if enabled
+func (a AppContext) GetBTCChainAndConfig(chainID int64) (chains.Chain, config.BTCConfig, bool) {
+	btcConfig, configEnabled := a.Config().GetBTCConfig(chainID)
+	btcChain, _, paramsEnabled := a.coreContext.GetBTCChainParams(chainID)
 
 	if !configEnabled || !paramsEnabled {
 		return chains.Chain{}, config.BTCConfig{}, false
@@ -41,3 +61,29 @@ func (a AppContext) GetBTCChainAndConfig() (chains.Chain, config.BTCConfig, bool
 
 	return btcChain, btcConfig, true
 }
+
+// GetEnabledBTCChains returns the btc chains both configured and having chain params, sorted by chain id
+func (a AppContext) GetEnabledBTCChains() []chains.Chain {
+	btcChains := make([]chains.Chain, 0)
+	for chainID := range a.Config().GetAllBTCConfigs() {
+		if btcChain, _, enabled := a.coreContext.GetBTCChainParams(chainID); enabled {
+			btcChains = append(btcChains, btcChain)
+		}
+	}
+	sort.Slice(btcChains, func(i, j int) bool {
+		return btcChains[i].ChainId < btcChains[j].ChainId
+	})
+	return btcChains
+}
+
+// GetSolanaChainAndConfig returns solana chain and config if enabled
+func (a AppContext) GetSolanaChainAndConfig() (chains.Chain, config.SolanaConfig, bool) {
+	solConfig, configEnabled := a.Config().GetSolanaConfig()
+	solChain, _, paramsEnabled := a.coreContext.GetSolanaChainParams()
+
+	if !configEnabled || !paramsEnabled {
+		return chains.Chain{}, config.SolanaConfig{}, false
+	}
+
+	return solChain, solConfig, true
+}
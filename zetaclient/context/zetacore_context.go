@@ -15,17 +15,22 @@ import (
 // ZetacoreContext contains zetacore context params
 // these are initialized and updated at runtime at every height
 type ZetacoreContext struct {
-	coreContextLock    *sync.RWMutex
-	keygen             observertypes.Keygen
-	chainsEnabled      []chains.Chain
-	evmChainParams     map[int64]*observertypes.ChainParams
-	bitcoinChainParams *observertypes.ChainParams
-	currentTssPubkey   string
-	crosschainFlags    observertypes.CrosschainFlags
+	coreContextLock   *sync.RWMutex
+	keygen            observertypes.Keygen
+	chainsEnabled     []chains.Chain
+	additionalChains  []chains.Chain
+	evmChainParams    map[int64]*observertypes.ChainParams
+	btcChainParams    map[int64]*observertypes.ChainParams
+	solanaChainParams *observertypes.ChainParams
+	currentTssPubkey  string
+	crosschainFlags   observertypes.CrosschainFlags
 
 	// blockHeaderEnabledChains is used to store the list of chains that have block header verification enabled
 	// All chains in this list will have Enabled flag set to true
 	blockHeaderEnabledChains []lightclienttypes.HeaderSupportedChain
+
+	// updated receives a value after each update, an update not consumed yet absorbs the next ones
+	updated chan struct{}
 }
 
 // NewZetacoreContext creates and returns new ZetacoreContext
@@ -36,22 +41,35 @@ func NewZetacoreContext(cfg config.Config) *ZetacoreContext {
 		evmChainParams[e.Chain.ChainId] = &observertypes.ChainParams{}
 	}
 
-	var bitcoinChainParams *observertypes.ChainParams
-	_, found := cfg.GetBTCConfig()
+	btcChainParams := make(map[int64]*observertypes.ChainParams)
+	for chainID := range cfg.GetAllBTCConfigs() {
+		btcChainParams[chainID] = &observertypes.ChainParams{}
+	}
+
+	var solanaChainParams *observertypes.ChainParams
+	_, found := cfg.GetSolanaConfig()
 	if found {
-		bitcoinChainParams = &observertypes.ChainParams{}
+		solanaChainParams = &observertypes.ChainParams{}
 	}
 
 	return &ZetacoreContext{
 		coreContextLock:          new(sync.RWMutex),
 		chainsEnabled:            []chains.Chain{},
+		additionalChains:         []chains.Chain{},
 		evmChainParams:           evmChainParams,
-		bitcoinChainParams:       bitcoinChainParams,
+		btcChainParams:           btcChainParams,
+		solanaChainParams:        solanaChainParams,
 		crosschainFlags:          observertypes.CrosschainFlags{},
 		blockHeaderEnabledChains: []lightclienttypes.HeaderSupportedChain{},
+		updated:                  make(chan struct{}, 1),
 	}
 }
 
+// Updated returns a channel receiving a value after the zetacore context is updated
+func (c *ZetacoreContext) Updated() <-chan struct{} {
+	return c.updated
+}
+
 func (c *ZetacoreContext) GetKeygen() observertypes.Keygen {
 	c.coreContextLock.RLock()
 	defer c.coreContextLock.RUnlock()
@@ -99,6 +117,17 @@ func (c *ZetacoreContext) GetEnabledExternalChains() []chains.Chain {
 	return externalChains
 }
 
+// GetAdditionalChains returns the chains supported in addition to the default chains
+// they are the chains added in the chain info of the authority module
+func (c *ZetacoreContext) GetAdditionalChains() []chains.Chain {
+	c.coreContextLock.RLock()
+	defer c.coreContextLock.RUnlock()
+
+	copiedChains := make([]chains.Chain, len(c.additionalChains))
+	copy(copiedChains, c.additionalChains)
+	return copiedChains
+}
+
 func (c *ZetacoreContext) GetEVMChainParams(chainID int64) (*observertypes.ChainParams, bool) {
 	c.coreContextLock.RLock()
 	defer c.coreContextLock.RUnlock()
@@ -120,21 +149,54 @@ func (c *ZetacoreContext) GetAllEVMChainParams() map[int64]*observertypes.ChainP
 	return copied
 }
 
-// GetBTCChainParams returns (chain, chain params, found) for bitcoin chain
-func (c *ZetacoreContext) GetBTCChainParams() (chains.Chain, *observertypes.ChainParams, bool) {
+// GetBTCChainParams returns (chain, chain params, found) for the bitcoin chain
+// the chain is not found if it is not configured or its chain params are not fetched yet
+func (c *ZetacoreContext) GetBTCChainParams(chainID int64) (chains.Chain, *observertypes.ChainParams, bool) {
+	c.coreContextLock.RLock()
+	defer c.coreContextLock.RUnlock()
+
+	btcChainParams, found := c.btcChainParams[chainID]
+	if !found || btcChainParams.ChainId != chainID { // bitcoin chain is not enabled
+		return chains.Chain{}, nil, false
+	}
+
+	chain := chains.GetChainFromChainID(chainID, c.additionalChains)
+	if chain == nil {
+		return chains.Chain{}, nil, false
+	}
+
+	return *chain, btcChainParams, true
+}
+
+// GetAllBTCChainParams returns the chain params of all configured bitcoin chains
+func (c *ZetacoreContext) GetAllBTCChainParams() map[int64]*observertypes.ChainParams {
+	c.coreContextLock.RLock()
+	defer c.coreContextLock.RUnlock()
+
+	// deep copy btc chain params
+	copied := make(map[int64]*observertypes.ChainParams, len(c.btcChainParams))
+	for chainID, btcParams := range c.btcChainParams {
+		copied[chainID] = &observertypes.ChainParams{}
+		*copied[chainID] = *btcParams
+	}
+	return copied
+}
+
+// GetSolanaChainParams returns (chain, chain params, found) for solana chain
+func (c *ZetacoreContext) GetSolanaChainParams() (chains.Chain, *observertypes.ChainParams, bool) {
 	c.coreContextLock.RLock()
 	defer c.coreContextLock.RUnlock()
 
-	if c.bitcoinChainParams == nil { // bitcoin is not enabled
+	if c.solanaChainParams == nil { // solana is not enabled
 		return chains.Chain{}, nil, false
 	}
 
-	chain := chains.GetChainFromChainID(c.bitcoinChainParams.ChainId)
+	chain := chains.GetChainFromChainID(c.solanaChainParams.ChainId, c.additionalChains)
 	if chain == nil {
 		return chains.Chain{}, nil, false
 	}
 
-	return *chain, c.bitcoinChainParams, true
+	return *chain, c.solanaChainParams, true
 }
 
 func (c *ZetacoreContext) GetCrossChainFlags() observertypes.CrosschainFlags {
@@ -167,8 +229,10 @@ func (c *ZetacoreContext) GetBlockHeaderEnabledChains(chainID int64) (lightclien
 func (c *ZetacoreContext) Update(
 	keygen *observertypes.Keygen,
 	newChains []chains.Chain,
+	additionalChains []chains.Chain,
 	evmChainParams map[int64]*observertypes.ChainParams,
-	btcChainParams *observertypes.ChainParams,
+	btcChainParams map[int64]*observertypes.ChainParams,
+	solChainParams *observertypes.ChainParams,
 	tssPubKey string,
 	crosschainFlags observertypes.CrosschainFlags,
 	blockHeaderEnabledChains []lightclienttypes.HeaderSupportedChain,
@@ -213,12 +277,22 @@ func (c *ZetacoreContext) Update(
 	}
 
 	c.chainsEnabled = newChains
+	c.additionalChains = additionalChains
 	c.crosschainFlags = crosschainFlags
 	c.blockHeaderEnabledChains = blockHeaderEnabledChains
 
-	// update chain params for bitcoin if it has config in file
-	if c.bitcoinChainParams != nil && btcChainParams != nil {
-		c.bitcoinChainParams = btcChainParams
+	// update chain params for bitcoin chains we have configs in file
+	for _, params := range btcChainParams {
+		_, found := c.btcChainParams[params.ChainId]
+		if !found {
+			continue
+		}
+		c.btcChainParams[params.ChainId] = params
+	}
+
+	// update chain params for solana if it has config in file
+	if c.solanaChainParams != nil && solChainParams != nil {
+		c.solanaChainParams = solChainParams
 	}
 
 	// update core params for evm chains we have configs in file
@@ -233,6 +307,49 @@ func (c *ZetacoreContext) Update(
 	if tssPubKey != "" {
 		c.currentTssPubkey = tssPubKey
 	}
+
+	select {
+	case c.updated <- struct{}{}:
+	default:
+	}
+}
+
+// UpdateChainConfigs tracks the chain params of the chains configured in the config
+// the params of a newly configured chain are set on the next update, the params of an unconfigured chain are dropped
+func (c *ZetacoreContext) UpdateChainConfigs(cfg config.Config) {
+	c.coreContextLock.Lock()
+	defer c.coreContextLock.Unlock()
+
+	evmConfigs := cfg.GetAllEVMConfigs()
+	for chainID := range c.evmChainParams {
+		if _, found := evmConfigs[chainID]; !found {
+			delete(c.evmChainParams, chainID)
+		}
+	}
+	for chainID := range evmConfigs {
+		if _, found := c.evmChainParams[chainID]; !found {
+			c.evmChainParams[chainID] = &observertypes.ChainParams{}
+		}
+	}
+
+	btcConfigs := cfg.GetAllBTCConfigs()
+	for chainID := range c.btcChainParams {
+		if _, found := btcConfigs[chainID]; !found {
+			delete(c.btcChainParams, chainID)
+		}
+	}
+	for chainID := range btcConfigs {
+		if _, found := c.btcChainParams[chainID]; !found {
+			c.btcChainParams[chainID] = &observertypes.ChainParams{}
+		}
+	}
+
+	_, found := cfg.GetSolanaConfig()
+	if !found {
+		c.solanaChainParams = nil
+	} else if c.solanaChainParams == nil {
+		c.solanaChainParams = &observertypes.ChainParams{}
+	}
 }
 
 // IsOutboundObservationEnabled returns true if the chain is supported and outbound flag is enabled
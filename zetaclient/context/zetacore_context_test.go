@@ -35,8 +35,10 @@ func getTestCoreContext(
 	coreContext.Update(
 		&observertypes.Keygen{},
 		[]chains.Chain{evmChain},
+		[]chains.Chain{},
 		evmChainParamsMap,
 		nil,
+		nil,
 		"",
 		ccFlags,
 		headerSupportedChains,
@@ -67,10 +69,11 @@ func TestNewZetaCoreContext(t *testing.T) {
 		require.Equal(t, "", zetaContext.GetCurrentTssPubkey())
 
 		// assert btc chain params
-		chain, btcChainParams, btcChainParamsFound := zetaContext.GetBTCChainParams()
+		chain, btcChainParams, btcChainParamsFound := zetaContext.GetBTCChainParams(chains.BitcoinMainnet.ChainId)
 		require.Equal(t, chains.Chain{}, chain)
 		require.False(t, btcChainParamsFound)
 		require.Nil(t, btcChainParams)
+		require.Empty(t, zetaContext.GetAllBTCChainParams())
 
 		// assert evm chain params
 		allEVMChainParams := zetaContext.GetAllEVMChainParams()
@@ -80,7 +83,7 @@ func TestNewZetaCoreContext(t *testing.T) {
 	t.Run("should return nil chain params if chain id is not found", func(t *testing.T) {
 		// create config with btc config
 		testCfg := config.NewConfig()
-		testCfg.BitcoinConfig = config.BTCConfig{
+		testCfg.BTCChainConfigs[chains.BitcoinMainnet.ChainId] = config.BTCConfig{
 			RPCUsername: "test_user",
 			RPCPassword: "test_password",
 		}
@@ -90,7 +93,7 @@ func TestNewZetaCoreContext(t *testing.T) {
 		require.NotNil(t, zetaContext)
 
 		// assert btc chain params
-		chain, btcChainParams, btcChainParamsFound := zetaContext.GetBTCChainParams()
+		chain, btcChainParams, btcChainParamsFound := zetaContext.GetBTCChainParams(chains.BitcoinMainnet.ChainId)
 		require.Equal(t, chains.Chain{}, chain)
 		require.False(t, btcChainParamsFound)
 		require.Nil(t, btcChainParams)
@@ -132,7 +135,7 @@ func TestNewZetaCoreContext(t *testing.T) {
 
 	t.Run("should create new zetacore context with config containing btc config", func(t *testing.T) {
 		testCfg := config.NewConfig()
-		testCfg.BitcoinConfig = config.BTCConfig{
+		testCfg.BTCChainConfigs[chains.BitcoinTestnet.ChainId] = config.BTCConfig{
 			RPCUsername: "test username",
 			RPCPassword: "test password",
 			RPCHost:     "test host",
@@ -140,6 +143,11 @@ func TestNewZetaCoreContext(t *testing.T) {
 		}
 		zetaContext := context.NewZetacoreContext(testCfg)
 		require.NotNil(t, zetaContext)
+
+		// assert btc chain params are tracked for the configured chain
+		allBTCChainParams := zetaContext.GetAllBTCChainParams()
+		require.Len(t, allBTCChainParams, 1)
+		require.Equal(t, &observertypes.ChainParams{}, allBTCChainParams[chains.BitcoinTestnet.ChainId])
 	})
 }
 
@@ -175,8 +183,10 @@ func TestUpdateZetacoreContext(t *testing.T) {
 				ChainId: 2,
 			},
 		}
-		btcChainParamsToUpdate := &observertypes.ChainParams{
-			ChainId: 3,
+		btcChainParamsToUpdate := map[int64]*observertypes.ChainParams{
+			3: {
+				ChainId: 3,
+			},
 		}
 		tssPubKeyToUpdate := "tsspubkeytest"
 		loggers := clientcommon.DefaultLoggers()
@@ -187,8 +197,10 @@ func TestUpdateZetacoreContext(t *testing.T) {
 		zetaContext.Update(
 			&keyGenToUpdate,
 			enabledChainsToUpdate,
+			[]chains.Chain{},
 			evmChainParamsToUpdate,
 			btcChainParamsToUpdate,
+			nil,
 			tssPubKeyToUpdate,
 			*crosschainFlags,
 			verificationFlags,
@@ -210,7 +222,7 @@ func TestUpdateZetacoreContext(t *testing.T) {
 		require.Equal(t, tssPubKeyToUpdate, zetaContext.GetCurrentTssPubkey())
 
 		// assert btc chain params still empty because they were not specified in config
-		chain, btcChainParams, btcChainParamsFound := zetaContext.GetBTCChainParams()
+		chain, btcChainParams, btcChainParamsFound := zetaContext.GetBTCChainParams(3)
 		require.Equal(t, chains.Chain{}, chain)
 		require.False(t, btcChainParamsFound)
 		require.Nil(t, btcChainParams)
@@ -244,7 +256,7 @@ func TestUpdateZetacoreContext(t *testing.T) {
 					},
 				},
 			}
-			testCfg.BitcoinConfig = config.BTCConfig{
+			testCfg.BTCChainConfigs[chains.BitcoinTestnet.ChainId] = config.BTCConfig{
 				RPCUsername: "test username",
 				RPCPassword: "test password",
 				RPCHost:     "test host",
@@ -278,8 +290,10 @@ func TestUpdateZetacoreContext(t *testing.T) {
 			}
 
 			testBtcChain := chains.BitcoinTestnet
-			btcChainParamsToUpdate := &observertypes.ChainParams{
-				ChainId: testBtcChain.ChainId,
+			btcChainParamsToUpdate := map[int64]*observertypes.ChainParams{
+				testBtcChain.ChainId: {
+					ChainId: testBtcChain.ChainId,
+				},
 			}
 			tssPubKeyToUpdate := "tsspubkeytest"
 			crosschainFlags := sample.CrosschainFlags()
@@ -289,8 +303,10 @@ func TestUpdateZetacoreContext(t *testing.T) {
 			zetaContext.Update(
 				&keyGenToUpdate,
 				enabledChainsToUpdate,
+				[]chains.Chain{},
 				evmChainParamsToUpdate,
 				btcChainParamsToUpdate,
+				nil,
 				tssPubKeyToUpdate,
 				*crosschainFlags,
 				verificationFlags,
@@ -309,10 +325,10 @@ func TestUpdateZetacoreContext(t *testing.T) {
 			require.Equal(t, tssPubKeyToUpdate, zetaContext.GetCurrentTssPubkey())
 
 			// assert btc chain params
-			chain, btcChainParams, btcChainParamsFound := zetaContext.GetBTCChainParams()
+			chain, btcChainParams, btcChainParamsFound := zetaContext.GetBTCChainParams(testBtcChain.ChainId)
 			require.Equal(t, testBtcChain, chain)
 			require.True(t, btcChainParamsFound)
-			require.Equal(t, btcChainParamsToUpdate, btcChainParams)
+			require.Equal(t, btcChainParamsToUpdate[testBtcChain.ChainId], btcChainParams)
 
 			// assert evm chain params
 			allEVMChainParams := zetaContext.GetAllEVMChainParams()
@@ -333,6 +349,230 @@ func TestUpdateZetacoreContext(t *testing.T) {
 			require.Equal(t, verFlags, verificationFlags)
 		},
 	)
+
+	t.Run("should update the chain params of all configured bitcoin chains", func(t *testing.T) {
+		testCfg := config.NewConfig()
+		testCfg.BTCChainConfigs[chains.BitcoinMainnet.ChainId] = config.BTCConfig{RPCHost: "bitcoin:8332"}
+		testCfg.BTCChainConfigs[chains.BitcoinSignetTestnet.ChainId] = config.BTCConfig{RPCHost: "signet:38332"}
+
+		zetaContext := context.NewZetacoreContext(testCfg)
+		require.NotNil(t, zetaContext)
+
+		// testnet chain params are ignored as it is not configured
+		btcChainParamsToUpdate := map[int64]*observertypes.ChainParams{
+			chains.BitcoinMainnet.ChainId:       {ChainId: chains.BitcoinMainnet.ChainId},
+			chains.BitcoinSignetTestnet.ChainId: {ChainId: chains.BitcoinSignetTestnet.ChainId},
+			chains.BitcoinTestnet.ChainId:       {ChainId: chains.BitcoinTestnet.ChainId},
+		}
+		zetaContext.Update(
+			&observertypes.Keygen{},
+			[]chains.Chain{chains.BitcoinMainnet, chains.BitcoinSignetTestnet, chains.BitcoinTestnet},
+			[]chains.Chain{},
+			nil,
+			btcChainParamsToUpdate,
+			nil,
+			"",
+			*sample.CrosschainFlags(),
+			sample.HeaderSupportedChains(),
+			false,
+			zerolog.Logger{},
+		)
+
+		chain, params, found := zetaContext.GetBTCChainParams(chains.BitcoinMainnet.ChainId)
+		require.True(t, found)
+		require.Equal(t, chains.BitcoinMainnet, chain)
+		require.Equal(t, btcChainParamsToUpdate[chains.BitcoinMainnet.ChainId], params)
+
+		chain, params, found = zetaContext.GetBTCChainParams(chains.BitcoinSignetTestnet.ChainId)
+		require.True(t, found)
+		require.Equal(t, chains.BitcoinSignetTestnet, chain)
+		require.Equal(t, btcChainParamsToUpdate[chains.BitcoinSignetTestnet.ChainId], params)
+
+		_, _, found = zetaContext.GetBTCChainParams(chains.BitcoinTestnet.ChainId)
+		require.False(t, found)
+		require.Len(t, zetaContext.GetAllBTCChainParams(), 2)
+	})
+
+	t.Run("should update solana chain params if solana config is set", func(t *testing.T) {
+		testCfg := config.NewConfig()
+		testCfg.SolanaConfig = config.SolanaConfig{
+			Endpoint: "http://solana:8899",
+		}
+
+		zetaContext := context.NewZetacoreContext(testCfg)
+		require.NotNil(t, zetaContext)
+
+		// solana chain params are not found before update
+		_, _, found := zetaContext.GetSolanaChainParams()
+		require.False(t, found)
+
+		solChainParamsToUpdate := observertypes.GetDefaultSolanaLocalnetChainParams()
+		zetaContext.Update(
+			&observertypes.Keygen{},
+			[]chains.Chain{chains.SolanaLocalnet},
+			[]chains.Chain{},
+			nil,
+			nil,
+			solChainParamsToUpdate,
+			"",
+			*sample.CrosschainFlags(),
+			sample.HeaderSupportedChains(),
+			false,
+			zerolog.Logger{},
+		)
+
+		// assert solana chain params
+		chain, solChainParams, found := zetaContext.GetSolanaChainParams()
+		require.True(t, found)
+		require.Equal(t, chains.SolanaLocalnet, chain)
+		require.Equal(t, solChainParamsToUpdate, solChainParams)
+	})
+
+	t.Run("should resolve the bitcoin chains added in the chain info", func(t *testing.T) {
+		newChain := chains.BitcoinRegtest
+		newChain.ChainId = 42
+
+		testCfg := config.NewConfig()
+		testCfg.BTCChainConfigs[newChain.ChainId] = config.BTCConfig{RPCHost: "bitcoin:18443"}
+
+		zetaContext := context.NewZetacoreContext(testCfg)
+		require.NotNil(t, zetaContext)
+		require.Empty(t, zetaContext.GetAdditionalChains())
+
+		btcChainParamsToUpdate := map[int64]*observertypes.ChainParams{
+			newChain.ChainId: {ChainId: newChain.ChainId},
+		}
+		zetaContext.Update(
+			&observertypes.Keygen{},
+			[]chains.Chain{newChain},
+			[]chains.Chain{newChain},
+			nil,
+			btcChainParamsToUpdate,
+			nil,
+			"",
+			*sample.CrosschainFlags(),
+			sample.HeaderSupportedChains(),
+			false,
+			zerolog.Logger{},
+		)
+		require.Equal(t, []chains.Chain{newChain}, zetaContext.GetAdditionalChains())
+
+		chain, params, found := zetaContext.GetBTCChainParams(newChain.ChainId)
+		require.True(t, found)
+		require.Equal(t, newChain, chain)
+		require.Equal(t, btcChainParamsToUpdate[newChain.ChainId], params)
+	})
+
+	t.Run("should notify the updates", func(t *testing.T) {
+		zetaContext := context.NewZetacoreContext(config.NewConfig())
+		require.Empty(t, zetaContext.Updated())
+
+		update := func() {
+			zetaContext.Update(
+				&observertypes.Keygen{},
+				[]chains.Chain{},
+				[]chains.Chain{},
+				nil,
+				nil,
+				nil,
+				"",
+				*sample.CrosschainFlags(),
+				sample.HeaderSupportedChains(),
+				false,
+				zerolog.Nop(),
+			)
+		}
+
+		// the updates not consumed yet are notified once
+		update()
+		update()
+		require.Len(t, zetaContext.Updated(), 1)
+		<-zetaContext.Updated()
+		require.Empty(t, zetaContext.Updated())
+
+		update()
+		require.Len(t, zetaContext.Updated(), 1)
+	})
+}
+
+func TestUpdateChainConfigs(t *testing.T) {
+	ethChain := chains.Ethereum
+	bscChain := chains.BscMainnet
+	ethChainParams := &observertypes.ChainParams{ChainId: ethChain.ChainId}
+	bscChainParams := &observertypes.ChainParams{ChainId: bscChain.ChainId}
+
+	// update feeds the chain params of ethereum, bsc and bitcoin
+	update := func(zetaContext *context.ZetacoreContext) {
+		zetaContext.Update(
+			&observertypes.Keygen{},
+			[]chains.Chain{ethChain, bscChain, chains.BitcoinMainnet},
+			[]chains.Chain{},
+			map[int64]*observertypes.ChainParams{
+				ethChain.ChainId: ethChainParams,
+				bscChain.ChainId: bscChainParams,
+			},
+			map[int64]*observertypes.ChainParams{
+				chains.BitcoinMainnet.ChainId: {ChainId: chains.BitcoinMainnet.ChainId},
+			},
+			nil,
+			"",
+			*sample.CrosschainFlags(),
+			sample.HeaderSupportedChains(),
+			false,
+			zerolog.Logger{},
+		)
+	}
+
+	t.Run("should track the chain params of the chains added to the config", func(t *testing.T) {
+		cfg := config.NewConfig()
+		cfg.EVMChainConfigs[ethChain.ChainId] = config.EVMConfig{Chain: ethChain}
+		zetaContext := context.NewZetacoreContext(cfg)
+
+		// bsc and bitcoin chain params are ignored as they are not configured
+		update(zetaContext)
+		_, found := zetaContext.GetEVMChainParams(bscChain.ChainId)
+		require.False(t, found)
+		_, _, found = zetaContext.GetBTCChainParams(chains.BitcoinMainnet.ChainId)
+		require.False(t, found)
+
+		// bsc and bitcoin chain params are set on the next update after they are configured
+		cfg = config.NewConfig()
+		cfg.EVMChainConfigs[ethChain.ChainId] = config.EVMConfig{Chain: ethChain}
+		cfg.EVMChainConfigs[bscChain.ChainId] = config.EVMConfig{Chain: bscChain}
+		cfg.BTCChainConfigs[chains.BitcoinMainnet.ChainId] = config.BTCConfig{RPCHost: "localhost"}
+		zetaContext.UpdateChainConfigs(cfg)
+		update(zetaContext)
+
+		params, found := zetaContext.GetEVMChainParams(bscChain.ChainId)
+		require.True(t, found)
+		require.Equal(t, bscChainParams, params)
+		params, found = zetaContext.GetEVMChainParams(ethChain.ChainId)
+		require.True(t, found)
+		require.Equal(t, ethChainParams, params)
+		chain, _, found := zetaContext.GetBTCChainParams(chains.BitcoinMainnet.ChainId)
+		require.True(t, found)
+		require.Equal(t, chains.BitcoinMainnet, chain)
+	})
+	t.Run("should drop the chain params of the chains removed from the config", func(t *testing.T) {
+		cfg := config.NewConfig()
+		cfg.EVMChainConfigs[ethChain.ChainId] = config.EVMConfig{Chain: ethChain}
+		cfg.EVMChainConfigs[bscChain.ChainId] = config.EVMConfig{Chain: bscChain}
+		cfg.BTCChainConfigs[chains.BitcoinMainnet.ChainId] = config.BTCConfig{RPCHost: "localhost"}
+		zetaContext := context.NewZetacoreContext(cfg)
+		update(zetaContext)
+
+		cfg = config.NewConfig()
+		cfg.EVMChainConfigs[ethChain.ChainId] = config.EVMConfig{Chain: ethChain}
+		zetaContext.UpdateChainConfigs(cfg)
+
+		_, found := zetaContext.GetEVMChainParams(bscChain.ChainId)
+		require.False(t, found)
+		_, _, found = zetaContext.GetBTCChainParams(chains.BitcoinMainnet.ChainId)
+		require.False(t, found)
+		params, found := zetaContext.GetEVMChainParams(ethChain.ChainId)
+		require.True(t, found)
+		require.Equal(t, ethChainParams, params)
+	})
 }
 
 func TestIsOutboundObservationEnabled(t *testing.T) {
@@ -14,4 +14,14 @@ type ObserverKeys interface {
 	GetPrivateKey(password string) (cryptotypes.PrivKey, error)
 	GetKeybase() ckeys.Keyring
 	GetHotkeyPassword() string
+	GetRemoteSigner() RemoteSigner
+}
+
+// RemoteSigner signs the zetaclient transactions with the hotkey held by a remote signer
+type RemoteSigner interface {
+	// PubKey returns the public key of the remote hotkey
+	PubKey() cryptotypes.PubKey
+
+	// Sign returns the signature of the sign bytes by the remote hotkey
+	Sign(signBytes []byte) ([]byte, error)
 }
@@ -35,6 +35,9 @@ type Keys struct {
 	kb              ckeys.Keyring
 	OperatorAddress sdk.AccAddress
 	hotkeyPassword  string
+
+	// remoteSigner signs the transactions in place of the keyring hotkey if set
+	remoteSigner interfaces.RemoteSigner
 }
 
 // NewKeysWithKeybase create a new instance of Keys
@@ -52,6 +55,38 @@ func NewKeysWithKeybase(
 	}
 }
 
+// NewKeysWithRemoteSigner creates a new instance of Keys signing the transactions with the remote signer
+// the transactions are signed without keyring, but the hotkey is also the p2p identity of the TSS:
+// the TSS loads its own copy of the hotkey, checked against the remote signer with ValidateTSSKey
+func NewKeysWithRemoteSigner(
+	signer interfaces.RemoteSigner,
+	granterAddress sdk.AccAddress,
+	granteeName string,
+) *Keys {
+	return &Keys{
+		signerName:      granteeName,
+		OperatorAddress: granterAddress,
+		remoteSigner:    signer,
+	}
+}
+
+// ValidateTSSKey checks the key of the p2p identity of the TSS is the hotkey held by the remote signer, if any
+// the TSS parties are the grantee pubkeys of the node accounts and the grantee addresses signing the transactions
+// are derived from them, so a TSS key different from the remote hotkey could not be a party of the TSS
+func ValidateTSSKey(tssKey cryptotypes.PrivKey, signer interfaces.RemoteSigner) error {
+	if signer == nil {
+		return nil
+	}
+	if !tssKey.PubKey().Equals(signer.PubKey()) {
+		return fmt.Errorf(
+			"TSS key %s is not the hotkey %s of the remote signer",
+			sdk.AccAddress(tssKey.PubKey().Address()),
+			sdk.AccAddress(signer.PubKey().Address()),
+		)
+	}
+	return nil
+}
+
 func GetGranteeKeyName(signerName string) string {
 	return signerName
 }
@@ -100,9 +135,27 @@ func GetKeyringKeybase(cfg config.Config, hotkeyPassword string) (ckeys.Keyring,
 	return kb, pubkeyBech32, nil
 }
 
+// WithRemoteSigner signs the transactions with the remote signer in place of the keyring hotkey
+func (k *Keys) WithRemoteSigner(signer interfaces.RemoteSigner) *Keys {
+	k.remoteSigner = signer
+	return k
+}
+
+// GetRemoteSigner returns the remote signer, nil if the transactions are signed by the keyring hotkey
+func (k *Keys) GetRemoteSigner() interfaces.RemoteSigner {
+	return k.remoteSigner
+}
+
 // GetSignerInfo return signer info
 func (k *Keys) GetSignerInfo() *ckeys.Record {
 	signer := GetGranteeKeyName(k.signerName)
+	if k.remoteSigner != nil {
+		info, err := ckeys.NewOfflineRecord(signer, k.remoteSigner.PubKey())
+		if err != nil {
+			return nil
+		}
+		return info
+	}
 	info, err := k.kb.Key(signer)
 	if err != nil {
 		return nil
@@ -116,6 +169,9 @@ func (k *Keys) GetOperatorAddress() sdk.AccAddress {
 
 // GetAddress return the signer address
 func (k *Keys) GetAddress() (sdk.AccAddress, error) {
+	if k.remoteSigner != nil {
+		return sdk.AccAddress(k.remoteSigner.PubKey().Address()), nil
+	}
 	signer := GetGranteeKeyName(k.signerName)
 	info, err := k.kb.Key(signer)
 	if err != nil {
@@ -130,6 +186,9 @@ func (k *Keys) GetAddress() (sdk.AccAddress, error) {
 
 // GetPrivateKey return the private key
 func (k *Keys) GetPrivateKey(password string) (cryptotypes.PrivKey, error) {
+	if k.kb == nil {
+		return nil, errors.New("no keyring, the hotkey is held by the remote signer")
+	}
 	signer := GetGranteeKeyName(k.signerName)
 	privKeyArmor, err := k.kb.ExportPrivKeyArmor(signer, password)
 	if err != nil {
@@ -173,7 +232,7 @@ func (k *Keys) GetPubKeySet(password string) (zetacrypto.PubKeySet, error) {
 // GetHotkeyPassword returns the password to be used
 // returns empty if no password is needed
 func (k *Keys) GetHotkeyPassword() string {
-	if k.GetKeybase().Backend() == ckeys.BackendFile {
+	if k.kb != nil && k.kb.Backend() == ckeys.BackendFile {
 		return k.hotkeyPassword
 	}
 	return ""
@@ -15,6 +15,8 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	hd "github.com/cosmos/cosmos-sdk/crypto/hd"
 	cKeys "github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	. "gopkg.in/check.v1"
@@ -140,3 +142,66 @@ func TestGetSignerInfo(t *testing.T) {
 	info := keys.GetSignerInfo()
 	require.Nil(t, info)
 }
+
+// remoteSigner signs with a local key as a remote signer would
+type remoteSigner struct {
+	privKey *secp256k1.PrivKey
+}
+
+func (s remoteSigner) PubKey() cryptotypes.PubKey {
+	return s.privKey.PubKey()
+}
+
+func (s remoteSigner) Sign(signBytes []byte) ([]byte, error) {
+	return s.privKey.Sign(signBytes)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	granterAddress := sdk.AccAddress(crypto.AddressHash([]byte("granter")))
+	keys := NewKeysWithKeybase(mocks.NewKeyring(), granterAddress, "hotkey", "")
+	require.Nil(t, keys.GetRemoteSigner())
+
+	// the signer of the remote hotkey replaces the keyring hotkey
+	signer := remoteSigner{privKey: secp256k1.GenPrivKey()}
+	keys.WithRemoteSigner(signer)
+	require.Equal(t, signer, keys.GetRemoteSigner())
+
+	address, err := keys.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(signer.PubKey().Address()), address)
+
+	info := keys.GetSignerInfo()
+	require.NotNil(t, info)
+	pubKey, err := info.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, signer.PubKey().Equals(pubKey))
+}
+
+func TestNewKeysWithRemoteSigner(t *testing.T) {
+	granterAddress := sdk.AccAddress(crypto.AddressHash([]byte("granter")))
+	signer := remoteSigner{privKey: secp256k1.GenPrivKey()}
+	keys := NewKeysWithRemoteSigner(signer, granterAddress, "hotkey")
+
+	// the transactions are signed by the remote signer without keyring
+	require.Nil(t, keys.GetKeybase())
+	require.Equal(t, signer, keys.GetRemoteSigner())
+	require.Empty(t, keys.GetHotkeyPassword())
+	address, err := keys.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(signer.PubKey().Address()), address)
+
+	_, err = keys.GetPrivateKey("")
+	require.ErrorContains(t, err, "held by the remote signer")
+}
+
+func TestValidateTSSKey(t *testing.T) {
+	tssKey := secp256k1.GenPrivKey()
+
+	// the TSS key is not checked without remote signer
+	require.NoError(t, ValidateTSSKey(tssKey, nil))
+
+	require.NoError(t, ValidateTSSKey(tssKey, remoteSigner{privKey: tssKey}))
+
+	err := ValidateTSSKey(tssKey, remoteSigner{privKey: secp256k1.GenPrivKey()})
+	require.ErrorContains(t, err, "is not the hotkey")
+}
@@ -89,6 +89,91 @@ var (
 		Name:      "percentage_of_rate_reached",
 		Help:      "Percentage of the rate limiter rate reached",
 	})
+
+	PercentageOfRateReachedPerBucket = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "percentage_of_rate_reached_per_bucket",
+		Help:      "Percentage of the rate limiter rate reached per chain and per asset rate limit bucket",
+	}, []string{"bucket"})
+
+	RPCEndpointHealthScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "rpc_endpoint_health_score",
+		Help:      "Health score of the RPC endpoint per chain and module, from 0 (down) to 1 (best)",
+	}, []string{"chain", "module", "endpoint"})
+
+	RPCEndpointLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "rpc_endpoint_latency_seconds",
+		Help:      "Latency of the last health check of the RPC endpoint per chain and module",
+	}, []string{"chain", "module", "endpoint"})
+
+	RPCEndpointBlockLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "rpc_endpoint_block_lag",
+		Help:      "Number of blocks the RPC endpoint is behind the highest endpoint per chain and module",
+	}, []string{"chain", "module", "endpoint"})
+
+	RPCEndpointErrorRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "rpc_endpoint_error_rate",
+		Help:      "Moving average of the error rate of the RPC endpoint per chain and module",
+	}, []string{"chain", "module", "endpoint"})
+
+	RPCEndpointRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "rpc_endpoint_requests_count",
+		Help:      "Count of requests per chain, module, RPC endpoint and status",
+	}, []string{"chain", "module", "endpoint", "status"})
+
+	RPCQuorumFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "rpc_quorum_failures_count",
+		Help:      "Count of cross-checked reads that did not reach the RPC quorum per chain and module",
+	}, []string{"chain", "module"})
+
+	ChainReorgs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "chain_reorgs_count",
+		Help:      "Count of chain reorgs replacing scanned blocks per chain",
+	}, []string{"chain"})
+
+	ChainReorgDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "chain_reorg_depth",
+		Help:      "Number of scanned blocks replaced by the last chain reorg per chain",
+	}, []string{"chain"})
+
+	NonCanonicalInbounds = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "non_canonical_inbounds_count",
+		Help:      "Count of inbound votes refused because the inbound block is no longer canonical per chain",
+	}, []string{"chain"})
+
+	KeysignQueueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "keysign_queue_depth",
+		Help:      "Number of outbound keysigns waiting for the keysign budget per chain",
+	}, []string{"chain"})
+
+	KeysignQueueWaitTime = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "keysign_queue_wait_seconds",
+		Help:      "Time an outbound keysign waited for the keysign budget per chain",
+		Buckets:   prometheus.ExponentialBuckets(0.1, 2, 12),
+	}, []string{"chain"})
+
+	KeysignsInProgress = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "keysigns_in_progress",
+		Help:      "Number of outbound keysigns in progress per chain",
+	}, []string{"chain"})
+
+	ZetacoreEventsDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "zetacore_events_dropped_count",
+		Help:      "Count of zetacore tx events dropped by the websocket subscription",
+	})
 )
 
 func NewMetrics() (*Metrics, error) {
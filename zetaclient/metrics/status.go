@@ -0,0 +1,130 @@
+package metrics
+
+import "github.com/zeta-chain/zetacore/zetaclient/rescan"
+
+// Status is the status of zetaclient reported by the /status endpoint of the telemetry server
+type Status struct {
+	// Version is the version of the zetaclient binary
+	Version string `json:"version"`
+
+	// ConfigVersion is the short hash of the config loaded by zetaclient
+	ConfigVersion string `json:"config_version"`
+
+	// TSSPubkey is the current TSS public key
+	TSSPubkey string `json:"tss_pubkey"`
+
+	// ActiveKeysigns is the number of keysigns in progress
+	ActiveKeysigns int64 `json:"active_keysigns"`
+
+	// Chains is the status of the observed chains, sorted by chain id
+	Chains []ChainStatus `json:"chains"`
+}
+
+// ChainStatus is the status of an observed chain
+type ChainStatus struct {
+	ChainID   int64  `json:"chain_id"`
+	ChainName string `json:"chain_name"`
+
+	// LastBlock is the latest block height of the chain
+	LastBlock uint64 `json:"last_block"`
+
+	// LastScannedBlock is the last block scanned for inbounds
+	LastScannedBlock uint64 `json:"last_scanned_block"`
+
+	// LastConfirmedBlock is the last block having enough confirmations to be scanned
+	LastConfirmedBlock uint64 `json:"last_confirmed_block"`
+
+	// PendingOutbounds are the outbounds being signed and broadcast, sorted by nonce
+	PendingOutbounds []OutboundStatus `json:"pending_outbounds"`
+
+	// HealthyRPCEndpoints is the number of RPC endpoints up
+	HealthyRPCEndpoints int `json:"healthy_rpc_endpoints"`
+
+	// RPCEndpoints is the health of the RPC endpoints, the healthiest first
+	RPCEndpoints []RPCEndpointStatus `json:"rpc_endpoints,omitempty"`
+
+	// UTXOs is the summary of the TSS UTXOs good to spend, for bitcoin chains only
+	UTXOs *UTXOSummary `json:"utxos,omitempty"`
+}
+
+// OutboundStatus is the status of an outbound being processed
+type OutboundStatus struct {
+	OutboundID        string  `json:"outbound_id"`
+	Nonce             uint64  `json:"nonce"`
+	ProcessingSeconds float64 `json:"processing_seconds"`
+}
+
+// RPCEndpointStatus is the health of an RPC endpoint
+type RPCEndpointStatus struct {
+	Name      string  `json:"name"`
+	Down      bool    `json:"down"`
+	Height    uint64  `json:"height"`
+	BlockLag  uint64  `json:"block_lag"`
+	LatencyMs int64   `json:"latency_ms"`
+	ErrorRate float64 `json:"error_rate"`
+}
+
+// UTXOSummary is the summary of a set of bitcoin UTXOs, the amounts are in BTC
+type UTXOSummary struct {
+	Count int     `json:"count"`
+	Total float64 `json:"total"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+}
+
+// StatusProvider reports the status of zetaclient
+type StatusProvider interface {
+	Status() Status
+}
+
+// AdminProvider executes the operator commands of the admin endpoints
+type AdminProvider interface {
+	// RescanInbound re-observes the inbounds of the block range [fromBlock, toBlock] and posts the votes
+	// of the inbounds without cctx, the votes are not posted in dry-run
+	RescanInbound(chainID int64, fromBlock uint64, toBlock uint64, dryRun bool) (*rescan.Report, error)
+
+	// RebroadcastOutbound re-broadcasts the signed outbound of the nonce and returns its hash
+	RebroadcastOutbound(chainID int64, nonce uint64) (string, error)
+}
+
+// RescanRequest is the body of the /admin/rescan request
+type RescanRequest struct {
+	ChainID   int64  `json:"chain_id"`
+	FromBlock uint64 `json:"from_block"`
+	ToBlock   uint64 `json:"to_block"`
+	DryRun    bool   `json:"dry_run"`
+}
+
+// RescanJobStatus is the status of a rescan job
+type RescanJobStatus string
+
+const (
+	RescanStatusRunning RescanJobStatus = "running"
+	RescanStatusDone    RescanJobStatus = "done"
+	RescanStatusFailed  RescanJobStatus = "failed"
+)
+
+// RescanJob is a rescan running in the background, it is the body of the /admin/rescan response
+// and of the /admin/rescan/{id} status response
+type RescanJob struct {
+	ID      uint64          `json:"id"`
+	Request RescanRequest   `json:"request"`
+	Status  RescanJobStatus `json:"status"`
+
+	// Error is the error of the failed rescan
+	Error string `json:"error,omitempty"`
+
+	// Report is the report of the finished rescan
+	Report *rescan.Report `json:"report,omitempty"`
+}
+
+// RebroadcastRequest is the body of the /admin/rebroadcast request
+type RebroadcastRequest struct {
+	ChainID int64  `json:"chain_id"`
+	Nonce   uint64 `json:"nonce"`
+}
+
+// RebroadcastResponse is the body of the /admin/rebroadcast response
+type RebroadcastResponse struct {
+	TxHash string `json:"tx_hash"`
+}
@@ -2,9 +2,14 @@ package metrics
 
 import (
 	"context"
+	"crypto/subtle"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -13,6 +18,17 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+const (
+	// DefaultTelemetryListenAddress is the listen address of the telemetry server, it only listens to the loopback
+	DefaultTelemetryListenAddress = "127.0.0.1:8123"
+
+	// MaxRescanRange is the maximum number of blocks of a rescan request
+	MaxRescanRange = 10000
+
+	// maxRescanJobs is the number of rescan jobs kept for the status endpoint, the oldest finished are dropped
+	maxRescanJobs = 100
+)
+
 // TelemetryServer provide http endpoint for Tss server
 type TelemetryServer struct {
 	logger         zerolog.Logger
@@ -21,19 +37,34 @@ type TelemetryServer struct {
 	mu             sync.Mutex
 	ipAddress      string
 	HotKeyBurnRate *BurnRate
+
+	// status and admin providers of the /status and /admin endpoints
+	status     StatusProvider
+	admin      AdminProvider
+	adminToken string
+
+	// rescanJobs are the rescan jobs by id, lastRescanJobID the id of the last job started
+	rescanJobs      map[uint64]*RescanJob
+	lastRescanJobID uint64
 }
 
-// NewTelemetryServer should only listen to the loopback
-func NewTelemetryServer() *TelemetryServer {
+// NewTelemetryServer creates the telemetry server listening to the address, DefaultTelemetryListenAddress if empty
+func NewTelemetryServer(listenAddress string) *TelemetryServer {
+	if listenAddress == "" {
+		listenAddress = DefaultTelemetryListenAddress
+	}
 	hs := &TelemetryServer{
 		logger:         log.With().Str("module", "http").Logger(),
 		HotKeyBurnRate: NewBurnRate(100),
+		rescanJobs:     make(map[uint64]*RescanJob),
 	}
 	s := &http.Server{
-		Addr:              ":8123",
+		Addr:              listenAddress,
 		Handler:           hs.Handlers(),
 		ReadTimeout:       5 * time.Second,
 		ReadHeaderTimeout: 5 * time.Second,
+		WriteTimeout:      10 * time.Second,
+		IdleTimeout:       60 * time.Second,
 	}
 	hs.s = s
 	return hs
@@ -67,6 +98,22 @@ func (t *TelemetryServer) GetIPAddress() string {
 	return t.ipAddress
 }
 
+// SetStatusProvider sets the provider of the /status endpoint
+func (t *TelemetryServer) SetStatusProvider(status StatusProvider) {
+	t.mu.Lock()
+	t.status = status
+	t.mu.Unlock()
+}
+
+// SetAdminProvider sets the provider of the /admin endpoints and the bearer token authenticating the requests
+// the admin endpoints are disabled if the token is empty
+func (t *TelemetryServer) SetAdminProvider(admin AdminProvider, token string) {
+	t.mu.Lock()
+	t.admin = admin
+	t.adminToken = token
+	t.mu.Unlock()
+}
+
 // AddFeeEntry adds fee entry
 func (t *TelemetryServer) AddFeeEntry(block int64, amount int64) {
 	t.mu.Lock()
@@ -84,6 +131,13 @@ func (t *TelemetryServer) Handlers() http.Handler {
 	router.Handle("/p2p", http.HandlerFunc(t.p2pHandler)).Methods(http.MethodGet)
 	router.Handle("/ip", http.HandlerFunc(t.ipHandler)).Methods(http.MethodGet)
 	router.Handle("/hotkeyburnrate", http.HandlerFunc(t.hotKeyFeeBurnRate)).Methods(http.MethodGet)
+	router.Handle("/status", http.HandlerFunc(t.statusHandler)).Methods(http.MethodGet)
+
+	admin := router.PathPrefix("/admin").Subrouter()
+	admin.Handle("/rescan", http.HandlerFunc(t.rescanHandler)).Methods(http.MethodPost)
+	admin.Handle("/rescan/{id:[0-9]+}", http.HandlerFunc(t.rescanStatusHandler)).Methods(http.MethodGet)
+	admin.Handle("/rebroadcast", http.HandlerFunc(t.rebroadcastHandler)).Methods(http.MethodPost)
+	admin.Use(t.adminMiddleware())
 
 	router.Use(logMiddleware())
 
@@ -138,6 +192,170 @@ func (t *TelemetryServer) hotKeyFeeBurnRate(w http.ResponseWriter, _ *http.Reque
 	fmt.Fprintf(w, "%v", t.HotKeyBurnRate.GetBurnRate())
 }
 
+func (t *TelemetryServer) statusHandler(w http.ResponseWriter, _ *http.Request) {
+	t.mu.Lock()
+	status := t.status
+	t.mu.Unlock()
+	if status == nil {
+		http.Error(w, "status is not available yet", http.StatusServiceUnavailable)
+		return
+	}
+	writeJSON(w, http.StatusOK, status.Status())
+}
+
+func (t *TelemetryServer) rescanHandler(w http.ResponseWriter, r *http.Request) {
+	var req RescanRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid rescan request: %s", err), http.StatusBadRequest)
+		return
+	}
+	if req.FromBlock > req.ToBlock {
+		http.Error(w, fmt.Sprintf("invalid block range [%d, %d]", req.FromBlock, req.ToBlock), http.StatusBadRequest)
+		return
+	}
+	if req.ToBlock-req.FromBlock >= MaxRescanRange {
+		http.Error(w, fmt.Sprintf("block range [%d, %d] exceeds %d blocks", req.FromBlock, req.ToBlock, MaxRescanRange),
+			http.StatusBadRequest)
+		return
+	}
+
+	job, err := t.startRescanJob(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	writeJSON(w, http.StatusAccepted, job)
+}
+
+func (t *TelemetryServer) rescanStatusHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseUint(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid rescan job id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	t.mu.Lock()
+	job, found := t.rescanJobs[id]
+	var status RescanJob
+	if found {
+		status = *job
+	}
+	t.mu.Unlock()
+	if !found {
+		http.Error(w, fmt.Sprintf("rescan job %d not found", id), http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+// startRescanJob starts the rescan in the background and returns the job, whose status is then
+// reported by the rescan status endpoint, a single rescan runs at a time per chain
+func (t *TelemetryServer) startRescanJob(req RescanRequest) (RescanJob, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, job := range t.rescanJobs {
+		if job.Status == RescanStatusRunning && job.Request.ChainID == req.ChainID {
+			return RescanJob{}, fmt.Errorf("rescan job %d of chain %d is running", job.ID, req.ChainID)
+		}
+	}
+	t.pruneRescanJobs()
+
+	t.lastRescanJobID++
+	job := &RescanJob{ID: t.lastRescanJobID, Request: req, Status: RescanStatusRunning}
+	t.rescanJobs[job.ID] = job
+	admin := t.admin
+
+	go func() {
+		t.logger.Info().
+			Msgf("admin: rescan job %d rescanning inbounds of chain %d from block %d to %d dry-run %t",
+				job.ID, req.ChainID, req.FromBlock, req.ToBlock, req.DryRun)
+		report, err := admin.RescanInbound(req.ChainID, req.FromBlock, req.ToBlock, req.DryRun)
+
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if err != nil {
+			t.logger.Error().Err(err).Msgf("admin: rescan job %d failed", job.ID)
+			job.Status = RescanStatusFailed
+			job.Error = err.Error()
+			return
+		}
+		job.Status = RescanStatusDone
+		job.Report = report
+	}()
+	return *job, nil
+}
+
+// pruneRescanJobs drops the oldest finished rescan jobs beyond maxRescanJobs, the lock must be held
+func (t *TelemetryServer) pruneRescanJobs() {
+	finished := make([]uint64, 0, len(t.rescanJobs))
+	for id, job := range t.rescanJobs {
+		if job.Status != RescanStatusRunning {
+			finished = append(finished, id)
+		}
+	}
+	sort.Slice(finished, func(i, j int) bool { return finished[i] < finished[j] })
+	for _, id := range finished {
+		if len(t.rescanJobs) < maxRescanJobs {
+			return
+		}
+		delete(t.rescanJobs, id)
+	}
+}
+
+func (t *TelemetryServer) rebroadcastHandler(w http.ResponseWriter, r *http.Request) {
+	var req RebroadcastRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid rebroadcast request: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	t.logger.Info().Msgf("admin: re-broadcasting outbound of chain %d nonce %d", req.ChainID, req.Nonce)
+	txHash, err := t.getAdmin().RebroadcastOutbound(req.ChainID, req.Nonce)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, http.StatusOK, RebroadcastResponse{TxHash: txHash})
+}
+
+func (t *TelemetryServer) getAdmin() AdminProvider {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.admin
+}
+
+// adminMiddleware authenticates the admin requests with the bearer token of the admin provider
+func (t *TelemetryServer) adminMiddleware() mux.MiddlewareFunc {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.mu.Lock()
+			admin, token := t.admin, t.adminToken
+			t.mu.Unlock()
+			if admin == nil || token == "" {
+				http.Error(w, "admin endpoints are disabled", http.StatusForbidden)
+				return
+			}
+
+			bearer, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !found || subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+				t.logger.Warn().Str("route", r.URL.Path).Msg("unauthorized admin request")
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+			handler.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Error().Err(err).Msg("failed to write json response")
+	}
+}
+
 func logMiddleware() mux.MiddlewareFunc {
 	return func(handler http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,234 @@
+package metrics
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/zetaclient/rescan"
+)
+
+// fakeProvider is a status and admin provider recording the admin requests
+type fakeProvider struct {
+	mu           sync.Mutex
+	rescans      []RescanRequest
+	rebroadcasts []RebroadcastRequest
+	err          error
+
+	// release blocks the rescans until closed if set
+	release chan struct{}
+}
+
+func (p *fakeProvider) Status() Status {
+	return Status{
+		Version:   "v1.0.0",
+		TSSPubkey: "zetapub1",
+		Chains:    []ChainStatus{{ChainID: 1, LastBlock: 100, UTXOs: &UTXOSummary{Count: 2, Total: 0.3}}},
+	}
+}
+
+func (p *fakeProvider) RescanInbound(
+	chainID int64,
+	fromBlock uint64,
+	toBlock uint64,
+	dryRun bool,
+) (*rescan.Report, error) {
+	if p.release != nil {
+		<-p.release
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.rescans = append(p.rescans, RescanRequest{ChainID: chainID, FromBlock: fromBlock, ToBlock: toBlock, DryRun: dryRun})
+	if p.err != nil {
+		return nil, p.err
+	}
+	report := rescan.NewSession(nil, chainID, fromBlock, toBlock, dryRun).Report()
+	report.Inbounds = []rescan.Inbound{{InboundHash: "0x01", BlockHeight: fromBlock}}
+	report.Missing = 1
+	return report, nil
+}
+
+func (p *fakeProvider) RebroadcastOutbound(chainID int64, nonce uint64) (string, error) {
+	p.rebroadcasts = append(p.rebroadcasts, RebroadcastRequest{ChainID: chainID, Nonce: nonce})
+	return "0xabc", p.err
+}
+
+// getRescans returns the rescan requests received by the provider
+func (p *fakeProvider) getRescans() []RescanRequest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.rescans
+}
+
+// waitRescanJob polls the status of the rescan job until it is finished
+func waitRescanJob(t *testing.T, ts *TelemetryServer, id string) RescanJob {
+	var job RescanJob
+	require.Eventually(t, func() bool {
+		rec := serve(ts, http.MethodGet, "/admin/rescan/"+id, "secret", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &job))
+		return job.Status != RescanStatusRunning
+	}, time.Second, 10*time.Millisecond)
+	return job
+}
+
+func serve(t *TelemetryServer, method, path, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	t.Handlers().ServeHTTP(rec, req)
+	return rec
+}
+
+func TestTelemetryServer_Status(t *testing.T) {
+	t.Run("should be unavailable without status provider", func(t *testing.T) {
+		ts := NewTelemetryServer("")
+		rec := serve(ts, http.MethodGet, "/status", "", "")
+		require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	})
+	t.Run("should report status", func(t *testing.T) {
+		ts := NewTelemetryServer("")
+		ts.SetStatusProvider(&fakeProvider{})
+		rec := serve(ts, http.MethodGet, "/status", "", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var status Status
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+		require.Equal(t, "zetapub1", status.TSSPubkey)
+		require.Len(t, status.Chains, 1)
+		require.EqualValues(t, 100, status.Chains[0].LastBlock)
+		require.Equal(t, 2, status.Chains[0].UTXOs.Count)
+	})
+}
+
+func TestTelemetryServer_Admin(t *testing.T) {
+	t.Run("should disable admin endpoints without token", func(t *testing.T) {
+		ts := NewTelemetryServer("")
+		provider := &fakeProvider{}
+		ts.SetAdminProvider(provider, "")
+		rec := serve(ts, http.MethodPost, "/admin/rescan", "", `{"chain_id":1,"from_block":1,"to_block":2}`)
+		require.Equal(t, http.StatusForbidden, rec.Code)
+		require.Empty(t, provider.getRescans())
+	})
+	t.Run("should reject invalid token", func(t *testing.T) {
+		ts := NewTelemetryServer("")
+		provider := &fakeProvider{}
+		ts.SetAdminProvider(provider, "secret")
+		for _, token := range []string{"", "wrong", "secret2"} {
+			rec := serve(ts, http.MethodPost, "/admin/rescan", token, `{"chain_id":1,"from_block":1,"to_block":2}`)
+			require.Equal(t, http.StatusUnauthorized, rec.Code)
+		}
+		require.Empty(t, provider.getRescans())
+	})
+	t.Run("should rescan inbounds in the background", func(t *testing.T) {
+		ts := NewTelemetryServer("")
+		provider := &fakeProvider{}
+		ts.SetAdminProvider(provider, "secret")
+		rec := serve(ts, http.MethodPost, "/admin/rescan", "secret", `{"chain_id":1,"from_block":10,"to_block":20,"dry_run":true}`)
+		require.Equal(t, http.StatusAccepted, rec.Code)
+
+		var job RescanJob
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &job))
+		require.EqualValues(t, 1, job.ID)
+		require.Equal(t, RescanStatusRunning, job.Status)
+
+		job = waitRescanJob(t, ts, "1")
+		require.Equal(t, RescanStatusDone, job.Status)
+		require.Equal(t, []RescanRequest{{ChainID: 1, FromBlock: 10, ToBlock: 20, DryRun: true}}, provider.getRescans())
+		require.True(t, job.Report.DryRun)
+		require.Equal(t, 1, job.Report.Missing)
+		require.Equal(t, "0x01", job.Report.Inbounds[0].InboundHash)
+	})
+	t.Run("should run a single rescan at a time per chain", func(t *testing.T) {
+		ts := NewTelemetryServer("")
+		provider := &fakeProvider{release: make(chan struct{})}
+		ts.SetAdminProvider(provider, "secret")
+		rec := serve(ts, http.MethodPost, "/admin/rescan", "secret", `{"chain_id":1,"from_block":10,"to_block":20}`)
+		require.Equal(t, http.StatusAccepted, rec.Code)
+		rec = serve(ts, http.MethodPost, "/admin/rescan", "secret", `{"chain_id":1,"from_block":30,"to_block":40}`)
+		require.Equal(t, http.StatusConflict, rec.Code)
+		rec = serve(ts, http.MethodPost, "/admin/rescan", "secret", `{"chain_id":2,"from_block":30,"to_block":40}`)
+		require.Equal(t, http.StatusAccepted, rec.Code)
+
+		close(provider.release)
+		require.Equal(t, RescanStatusDone, waitRescanJob(t, ts, "1").Status)
+		require.Equal(t, RescanStatusDone, waitRescanJob(t, ts, "2").Status)
+		rec = serve(ts, http.MethodPost, "/admin/rescan", "secret", `{"chain_id":1,"from_block":30,"to_block":40}`)
+		require.Equal(t, http.StatusAccepted, rec.Code)
+	})
+	t.Run("should report unknown rescan job", func(t *testing.T) {
+		ts := NewTelemetryServer("")
+		ts.SetAdminProvider(&fakeProvider{}, "secret")
+		rec := serve(ts, http.MethodGet, "/admin/rescan/7", "secret", "")
+		require.Equal(t, http.StatusNotFound, rec.Code)
+	})
+	t.Run("should reject invalid rescan requests", func(t *testing.T) {
+		ts := NewTelemetryServer("")
+		provider := &fakeProvider{}
+		ts.SetAdminProvider(provider, "secret")
+		rec := serve(ts, http.MethodPost, "/admin/rescan", "secret", `{"chain_id":1,"from_block":20,"to_block":10}`)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		rec = serve(ts, http.MethodPost, "/admin/rescan", "secret", `not json`)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		rec = serve(ts, http.MethodPost, "/admin/rescan", "secret", `{"chain_id":1,"from_block":1,"to_block":10001}`)
+		require.Equal(t, http.StatusBadRequest, rec.Code)
+		require.Contains(t, rec.Body.String(), "exceeds")
+		require.Empty(t, provider.getRescans())
+	})
+	t.Run("should report rescan error", func(t *testing.T) {
+		ts := NewTelemetryServer("")
+		ts.SetAdminProvider(&fakeProvider{err: errors.New("block not confirmed")}, "secret")
+		rec := serve(ts, http.MethodPost, "/admin/rescan", "secret", `{"chain_id":1,"from_block":10,"to_block":20}`)
+		require.Equal(t, http.StatusAccepted, rec.Code)
+
+		job := waitRescanJob(t, ts, "1")
+		require.Equal(t, RescanStatusFailed, job.Status)
+		require.Equal(t, "block not confirmed", job.Error)
+		require.Nil(t, job.Report)
+	})
+	t.Run("should rebroadcast outbound", func(t *testing.T) {
+		ts := NewTelemetryServer("")
+		provider := &fakeProvider{}
+		ts.SetAdminProvider(provider, "secret")
+		rec := serve(ts, http.MethodPost, "/admin/rebroadcast", "secret", `{"chain_id":1,"nonce":7}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, []RebroadcastRequest{{ChainID: 1, Nonce: 7}}, provider.rebroadcasts)
+
+		var res RebroadcastResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, "0xabc", res.TxHash)
+	})
+	t.Run("should only accept POST", func(t *testing.T) {
+		ts := NewTelemetryServer("")
+		ts.SetAdminProvider(&fakeProvider{}, "secret")
+		rec := serve(ts, http.MethodGet, "/admin/rebroadcast", "secret", "")
+		require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
+
+func TestTelemetryServer_PruneRescanJobs(t *testing.T) {
+	ts := NewTelemetryServer("")
+	for id := uint64(1); id <= maxRescanJobs; id++ {
+		status := RescanStatusDone
+		if id == 1 {
+			status = RescanStatusRunning
+		}
+		ts.rescanJobs[id] = &RescanJob{ID: id, Status: status}
+		ts.lastRescanJobID = id
+	}
+
+	// the oldest finished job is dropped, the running jobs are kept
+	ts.pruneRescanJobs()
+	require.Len(t, ts.rescanJobs, maxRescanJobs-1)
+	require.Contains(t, ts.rescanJobs, uint64(1))
+	require.NotContains(t, ts.rescanJobs, uint64(2))
+}
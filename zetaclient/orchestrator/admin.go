@@ -0,0 +1,130 @@
+package orchestrator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/pkg/constant"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+	"github.com/zeta-chain/zetacore/zetaclient/outboundprocessor"
+	"github.com/zeta-chain/zetacore/zetaclient/rescan"
+)
+
+var (
+	_ metrics.StatusProvider = &Orchestrator{}
+	_ metrics.AdminProvider  = &Orchestrator{}
+)
+
+// KeysignsTracker counts the keysigns in progress
+type KeysignsTracker interface {
+	GetNumActiveMessageSigns() int64
+}
+
+// WithKeysignsTracker sets the tracker of the keysigns in progress reported in the status
+func (oc *Orchestrator) WithKeysignsTracker(tracker KeysignsTracker) *Orchestrator {
+	oc.keysigns = tracker
+	return oc
+}
+
+// Status returns the status of zetaclient and of the observed chains, see the /status endpoint
+func (oc *Orchestrator) Status() metrics.Status {
+	status := metrics.Status{
+		Version: constant.Version,
+		Chains:  make([]metrics.ChainStatus, 0),
+	}
+	if oc.keysigns != nil {
+		status.ActiveKeysigns = oc.keysigns.GetNumActiveMessageSigns()
+	}
+
+	oc.mu.RLock()
+	appContext := oc.appContext
+	var additionalChains []chains.Chain
+	if appContext != nil {
+		additionalChains = appContext.ZetacoreContext().GetAdditionalChains()
+	}
+	chainStatuses := make(map[int64]*metrics.ChainStatus, len(oc.observerMap))
+	for chainID, observer := range oc.observerMap {
+		chainStatus := metrics.ChainStatus{ChainID: chainID}
+		if reporter, ok := observer.(interfaces.ChainObserverStatus); ok {
+			chainStatus = reporter.Status()
+		} else if chain := chains.GetChainFromChainID(chainID, additionalChains); chain != nil {
+			chainStatus.ChainName = chain.ChainName.String()
+		}
+		chainStatus.PendingOutbounds = make([]metrics.OutboundStatus, 0)
+		chainStatuses[chainID] = &chainStatus
+	}
+	oc.mu.RUnlock()
+
+	if appContext != nil {
+		status.ConfigVersion = appContext.Config().Version()
+		status.TSSPubkey = appContext.ZetacoreContext().GetCurrentTssPubkey()
+	}
+
+	// attach the outbounds being processed to their chain
+	for outboundID, elapsed := range oc.outboundProc.ActiveOutbounds() {
+		_, chainID, nonce, err := outboundprocessor.ParseOutboundID(outboundID)
+		if err != nil {
+			oc.logger.Std.Error().Err(err).Msg("Status: error parsing outbound id")
+			continue
+		}
+		chainStatus, found := chainStatuses[chainID]
+		if !found {
+			continue
+		}
+		chainStatus.PendingOutbounds = append(chainStatus.PendingOutbounds, metrics.OutboundStatus{
+			OutboundID:        outboundID,
+			Nonce:             nonce,
+			ProcessingSeconds: elapsed.Seconds(),
+		})
+	}
+
+	for _, chainStatus := range chainStatuses {
+		sort.Slice(chainStatus.PendingOutbounds, func(i, j int) bool {
+			return chainStatus.PendingOutbounds[i].Nonce < chainStatus.PendingOutbounds[j].Nonce
+		})
+		status.Chains = append(status.Chains, *chainStatus)
+	}
+	sort.Slice(status.Chains, func(i, j int) bool {
+		return status.Chains[i].ChainID < status.Chains[j].ChainID
+	})
+	return status
+}
+
+// RescanInbound re-observes the inbounds of the block range [fromBlock, toBlock] of the chain
+// and posts the votes of the inbounds without cctx, the votes are not posted in dry-run
+func (oc *Orchestrator) RescanInbound(
+	chainID int64,
+	fromBlock uint64,
+	toBlock uint64,
+	dryRun bool,
+) (*rescan.Report, error) {
+	oc.mu.RLock()
+	observer, found := oc.observerMap[chainID]
+	oc.mu.RUnlock()
+	if !found {
+		return nil, fmt.Errorf("chain observer not found for chainID %d", chainID)
+	}
+	rescanner, ok := observer.(interfaces.InboundRescanner)
+	if !ok {
+		return nil, fmt.Errorf("inbound rescan is not supported for chainID %d", chainID)
+	}
+	return rescanner.RescanInbound(fromBlock, toBlock, dryRun)
+}
+
+// RebroadcastOutbound re-broadcasts the signed outbound of the nonce on the chain and returns its hash
+func (oc *Orchestrator) RebroadcastOutbound(chainID int64, nonce uint64) (string, error) {
+	oc.mu.RLock()
+	observer, foundObserver := oc.observerMap[chainID]
+	signer, foundSigner := oc.signerMap[chainID]
+	oc.mu.RUnlock()
+	if !foundObserver || !foundSigner {
+		return "", fmt.Errorf("chain observer or signer not found for chainID %d", chainID)
+	}
+	rebroadcaster, ok := signer.(interfaces.OutboundRebroadcaster)
+	if !ok {
+		return "", fmt.Errorf("outbound re-broadcast is not supported for chainID %d", chainID)
+	}
+	return rebroadcaster.RebroadcastOutbound(observer, nonce)
+}
@@ -0,0 +1,129 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/context"
+	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+	"github.com/zeta-chain/zetacore/zetaclient/outboundprocessor"
+	"github.com/zeta-chain/zetacore/zetaclient/rescan"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
+)
+
+// statusObserver is a mock evm observer reporting its status and recording the rescans
+type statusObserver struct {
+	*mocks.EVMObserver
+	status  metrics.ChainStatus
+	rescans [][2]uint64
+}
+
+func (ob *statusObserver) Status() metrics.ChainStatus {
+	return ob.status
+}
+
+func (ob *statusObserver) RescanInbound(fromBlock, toBlock uint64, dryRun bool) (*rescan.Report, error) {
+	ob.rescans = append(ob.rescans, [2]uint64{fromBlock, toBlock})
+	return &rescan.Report{ChainID: ob.status.ChainID, FromBlock: fromBlock, ToBlock: toBlock, DryRun: dryRun}, nil
+}
+
+// fakeKeysignsTracker is a keysigns tracker with a fixed number of keysigns
+type fakeKeysignsTracker int64
+
+func (k fakeKeysignsTracker) GetNumActiveMessageSigns() int64 {
+	return int64(k)
+}
+
+func newAdminOrchestrator(t *testing.T) (*Orchestrator, *statusObserver) {
+	evmChain := chains.Ethereum
+	btcChain := chains.BitcoinMainnet
+	evmChainParams := &observertypes.ChainParams{ChainId: evmChain.ChainId}
+	btcChainParams := &observertypes.ChainParams{ChainId: btcChain.ChainId}
+
+	oc := MockOrchestrator(t, nil, evmChain, btcChain, evmChainParams, btcChainParams)
+	oc.outboundProc = outboundprocessor.NewProcessor(zerolog.Nop())
+	observer := &statusObserver{
+		EVMObserver: mocks.NewEVMObserver(evmChainParams),
+		status:      metrics.ChainStatus{ChainID: evmChain.ChainId, ChainName: "eth_mainnet", LastBlock: 100},
+	}
+	oc.observerMap[evmChain.ChainId] = observer
+	return oc, observer
+}
+
+func Test_Status(t *testing.T) {
+	t.Run("should report chain status and pending outbounds", func(t *testing.T) {
+		oc, _ := newAdminOrchestrator(t)
+		oc.WithKeysignsTracker(fakeKeysignsTracker(2))
+
+		cfg := config.NewConfig()
+		oc.appContext = context.NewAppContext(context.NewZetacoreContext(cfg), cfg)
+		oc.outboundProc.StartTryProcess(outboundprocessor.ToOutboundID("0x02", chains.Ethereum.ChainId, 11))
+		oc.outboundProc.StartTryProcess(outboundprocessor.ToOutboundID("0x01", chains.Ethereum.ChainId, 10))
+		oc.outboundProc.StartTryProcess(outboundprocessor.ToOutboundID("0x03", chains.BscMainnet.ChainId, 1))
+
+		status := oc.Status()
+		require.EqualValues(t, 2, status.ActiveKeysigns)
+		require.Equal(t, cfg.Version(), status.ConfigVersion)
+
+		// chains are sorted by chain id, the observers without status are reported by name
+		require.Len(t, status.Chains, 2)
+		require.Equal(t, chains.Ethereum.ChainId, status.Chains[0].ChainID)
+		require.EqualValues(t, 100, status.Chains[0].LastBlock)
+		require.Equal(t, chains.BitcoinMainnet.ChainId, status.Chains[1].ChainID)
+		require.Equal(t, chains.BitcoinMainnet.ChainName.String(), status.Chains[1].ChainName)
+		require.Empty(t, status.Chains[1].PendingOutbounds)
+
+		// pending outbounds are sorted by nonce, the outbounds of unknown chains are skipped
+		pending := status.Chains[0].PendingOutbounds
+		require.Len(t, pending, 2)
+		require.EqualValues(t, 10, pending[0].Nonce)
+		require.EqualValues(t, 11, pending[1].Nonce)
+	})
+	t.Run("should report status before the orchestrator starts", func(t *testing.T) {
+		oc, _ := newAdminOrchestrator(t)
+		status := oc.Status()
+		require.Empty(t, status.ConfigVersion)
+		require.Len(t, status.Chains, 2)
+	})
+}
+
+func Test_RescanInbound(t *testing.T) {
+	t.Run("should rescan inbounds of the chain", func(t *testing.T) {
+		oc, observer := newAdminOrchestrator(t)
+		report, err := oc.RescanInbound(chains.Ethereum.ChainId, 10, 20, true)
+		require.NoError(t, err)
+		require.True(t, report.DryRun)
+		require.Equal(t, [][2]uint64{{10, 20}}, observer.rescans)
+	})
+	t.Run("should fail for unknown chain", func(t *testing.T) {
+		oc, _ := newAdminOrchestrator(t)
+		_, err := oc.RescanInbound(chains.BscMainnet.ChainId, 10, 20, false)
+		require.ErrorContains(t, err, "chain observer not found")
+	})
+	t.Run("should fail if observer can't rescan", func(t *testing.T) {
+		oc, _ := newAdminOrchestrator(t)
+		_, err := oc.RescanInbound(chains.BitcoinMainnet.ChainId, 10, 20, false)
+		require.ErrorContains(t, err, "not supported")
+	})
+}
+
+func Test_RebroadcastOutbound(t *testing.T) {
+	t.Run("should fail for unknown chain", func(t *testing.T) {
+		oc, _ := newAdminOrchestrator(t)
+		_, err := oc.RebroadcastOutbound(chains.BscMainnet.ChainId, 1)
+		require.ErrorContains(t, err, "not found")
+	})
+	t.Run("should fail if signer can't rebroadcast", func(t *testing.T) {
+		oc, _ := newAdminOrchestrator(t)
+		_, ok := oc.signerMap[chains.Ethereum.ChainId].(interfaces.OutboundRebroadcaster)
+		require.False(t, ok)
+		_, err := oc.RebroadcastOutbound(chains.Ethereum.ChainId, 1)
+		require.ErrorContains(t, err, "not supported")
+	})
+}
@@ -0,0 +1,221 @@
+package orchestrator
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// PendingCctxIndex is the local index of the pending cctxs of the external chains
+// it is updated incrementally from the cctxs changed by the zetacore events, and fully resynced
+// from zetacore when the subscription starts, has a gap or the resync interval elapses
+type PendingCctxIndex struct {
+	mu sync.Mutex
+
+	// cctxs are the pending cctxs by index
+	cctxs map[string]*types.CrossChainTx
+
+	// updated are the indexes of the cctxs changed by the events since their last fetch
+	updated map[string]bool
+
+	// finalized are the indexes of the cctxs fetched no longer pending since the last resync
+	finalized map[string]bool
+
+	// subscribed is true while the events are received without gap
+	subscribed bool
+
+	// synced is true once resynced after the subscription started
+	synced bool
+
+	// generation is incremented at every subscription gap, a resync started before the gap is discarded
+	generation uint64
+
+	// lastHeight is the height of the last block received, resyncHeight the height of the last resync
+	lastHeight   int64
+	resyncHeight int64
+
+	// dropped is the number of events dropped by the subscription
+	dropped uint64
+}
+
+// NewPendingCctxIndex creates a new empty pending cctx index
+func NewPendingCctxIndex() *PendingCctxIndex {
+	return &PendingCctxIndex{
+		cctxs:     make(map[string]*types.CrossChainTx),
+		updated:   make(map[string]bool),
+		finalized: make(map[string]bool),
+	}
+}
+
+// AddBlock records a new block received from the subscription
+// it returns false and invalidates the index if the block is not the successor of the last block,
+// the subscription then restarts from the block
+func (idx *PendingCctxIndex) AddBlock(height int64) bool {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	noGap := !idx.subscribed || height == idx.lastHeight+1
+	if !noGap {
+		idx.invalidate()
+	}
+	idx.subscribed = true
+	idx.lastHeight = height
+	return noGap
+}
+
+// MarkUpdated records the cctxs created or updated by the events, they are fetched on the next refresh
+func (idx *PendingCctxIndex) MarkUpdated(cctxIndexes ...string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for _, cctxIndex := range cctxIndexes {
+		idx.updated[cctxIndex] = true
+	}
+}
+
+// IsSubscribed returns true while the events are received without gap
+func (idx *PendingCctxIndex) IsSubscribed() bool {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	return idx.subscribed
+}
+
+// Invalidate records a subscription gap, the index is resynced once the subscription restarts
+func (idx *PendingCctxIndex) Invalidate() {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.invalidate()
+}
+
+// MarkDropped records events dropped by the subscription, the index is resynced on the next listing
+func (idx *PendingCctxIndex) MarkDropped(count uint64) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.synced = false
+	idx.dropped += count
+}
+
+// Dropped returns the number of events dropped by the subscription
+func (idx *PendingCctxIndex) Dropped() uint64 {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	return idx.dropped
+}
+
+// invalidate invalidates the index, the lock must be held
+func (idx *PendingCctxIndex) invalidate() {
+	idx.subscribed = false
+	idx.synced = false
+	idx.lastHeight = 0
+	idx.generation++
+}
+
+// NeedsResync returns true if the index must be resynced, and the generation and height to resync at
+// the index is not usable without subscription, and is resynced every 'interval' blocks
+func (idx *PendingCctxIndex) NeedsResync(interval int64) (bool, uint64, int64) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	needsResync := !idx.subscribed || !idx.synced || idx.lastHeight-idx.resyncHeight >= interval
+	return needsResync, idx.generation, idx.lastHeight
+}
+
+// Resync replaces the pending cctxs with the ones listed by zetacore at the height
+// the index is not synced if a gap happened since the resync started at 'generation'
+// the cctxs updated by the events during the resync are fetched on the next refresh
+func (idx *PendingCctxIndex) Resync(generation uint64, height int64, cctxMap map[int64][]*types.CrossChainTx) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.cctxs = make(map[string]*types.CrossChainTx)
+	idx.finalized = make(map[string]bool)
+	for _, cctxs := range cctxMap {
+		for _, cctx := range cctxs {
+			idx.cctxs[cctx.Index] = cctx
+		}
+	}
+	idx.synced = idx.subscribed && generation == idx.generation
+	idx.resyncHeight = height
+}
+
+// Refresh fetches the cctxs updated by the events, keeps the pending ones and drops the others
+// the cctxs failing to be fetched, or updated again during the fetch, are fetched on the next refresh
+func (idx *PendingCctxIndex) Refresh(fetch func(cctxIndex string) (*types.CrossChainTx, error)) error {
+	idx.mu.Lock()
+	updated := make([]string, 0, len(idx.updated))
+	for cctxIndex := range idx.updated {
+		updated = append(updated, cctxIndex)
+	}
+	idx.updated = make(map[string]bool)
+	idx.mu.Unlock()
+
+	var lastErr error
+	for _, cctxIndex := range updated {
+		cctx, err := fetch(cctxIndex)
+		if err != nil {
+			idx.MarkUpdated(cctxIndex)
+			lastErr = err
+			continue
+		}
+
+		idx.mu.Lock()
+		if IsCctxPending(cctx) {
+			idx.cctxs[cctxIndex] = cctx
+			delete(idx.finalized, cctxIndex)
+		} else {
+			delete(idx.cctxs, cctxIndex)
+			idx.finalized[cctxIndex] = true
+		}
+		idx.mu.Unlock()
+	}
+	return lastErr
+}
+
+// PendingCctxs returns the pending cctxs of the chain sorted by nonce
+func (idx *PendingCctxIndex) PendingCctxs(chainID int64) []*types.CrossChainTx {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	cctxs := make([]*types.CrossChainTx, 0)
+	for _, cctx := range idx.cctxs {
+		if cctx.GetCurrentOutboundParam().ReceiverChainId == chainID {
+			cctxs = append(cctxs, cctx)
+		}
+	}
+	sort.Slice(cctxs, func(i, j int) bool {
+		return cctxs[i].GetCurrentOutboundParam().TssNonce < cctxs[j].GetCurrentOutboundParam().TssNonce
+	})
+	return cctxs
+}
+
+// FilterPending drops the cctxs fetched no longer pending since the last resync from the cctxs listed by
+// zetacore, and replaces the others with the copies fetched last
+func (idx *PendingCctxIndex) FilterPending(cctxMap map[int64][]*types.CrossChainTx) map[int64][]*types.CrossChainTx {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	filtered := make(map[int64][]*types.CrossChainTx, len(cctxMap))
+	for chainID, cctxs := range cctxMap {
+		pending := make([]*types.CrossChainTx, 0, len(cctxs))
+		for _, cctx := range cctxs {
+			if idx.finalized[cctx.Index] {
+				continue
+			}
+			if fetched, found := idx.cctxs[cctx.Index]; found &&
+				fetched.GetCurrentOutboundParam().ReceiverChainId == chainID {
+				cctx = fetched
+			}
+			pending = append(pending, cctx)
+		}
+		filtered[chainID] = pending
+	}
+	return filtered
+}
+
+// IsCctxPending returns true if the cctx waits for an outbound or revert to be signed
+func IsCctxPending(cctx *types.CrossChainTx) bool {
+	if cctx == nil || cctx.CctxStatus == nil {
+		return false
+	}
+	status := cctx.CctxStatus.Status
+	return status == types.CctxStatus_PendingOutbound || status == types.CctxStatus_PendingRevert
+}
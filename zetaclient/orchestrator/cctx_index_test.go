@@ -0,0 +1,210 @@
+package orchestrator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// createCctx creates a cctx with the given index, receiver chain, nonce and status
+func createCctx(index string, chainID int64, nonce uint64, status crosschaintypes.CctxStatus) *crosschaintypes.CrossChainTx {
+	return &crosschaintypes.CrossChainTx{
+		Index:      index,
+		CctxStatus: &crosschaintypes.Status{Status: status},
+		OutboundParams: []*crosschaintypes.OutboundParams{
+			{ReceiverChainId: chainID, TssNonce: nonce},
+		},
+	}
+}
+
+func Test_PendingCctxIndex_AddBlock(t *testing.T) {
+	t.Run("should subscribe on first block and accept consecutive blocks", func(t *testing.T) {
+		idx := NewPendingCctxIndex()
+		require.False(t, idx.IsSubscribed())
+		require.True(t, idx.AddBlock(100))
+		require.True(t, idx.AddBlock(101))
+		require.True(t, idx.IsSubscribed())
+	})
+	t.Run("should invalidate index on block gap", func(t *testing.T) {
+		idx := NewPendingCctxIndex()
+		require.True(t, idx.AddBlock(100))
+		idx.Resync(0, 100, nil)
+
+		// synced at generation 0
+		needsResync, _, _ := idx.NeedsResync(pendingCctxResyncInterval)
+		require.False(t, needsResync)
+
+		// gap invalidates the index and restarts the subscription from the block
+		require.False(t, idx.AddBlock(102))
+		require.True(t, idx.IsSubscribed())
+		needsResync, generation, height := idx.NeedsResync(pendingCctxResyncInterval)
+		require.True(t, needsResync)
+		require.EqualValues(t, 1, generation)
+		require.EqualValues(t, 102, height)
+	})
+	t.Run("should not be subscribed after invalidation", func(t *testing.T) {
+		idx := NewPendingCctxIndex()
+		require.True(t, idx.AddBlock(100))
+		idx.Invalidate()
+		require.False(t, idx.IsSubscribed())
+	})
+}
+
+func Test_PendingCctxIndex_Resync(t *testing.T) {
+	chainID := chains.Ethereum.ChainId
+	cctxMap := map[int64][]*crosschaintypes.CrossChainTx{
+		chainID: {createCctx("0x1", chainID, 1, crosschaintypes.CctxStatus_PendingOutbound)},
+	}
+
+	t.Run("should need resync without subscription", func(t *testing.T) {
+		idx := NewPendingCctxIndex()
+		idx.Resync(0, 0, cctxMap)
+		needsResync, _, _ := idx.NeedsResync(pendingCctxResyncInterval)
+		require.True(t, needsResync)
+	})
+	t.Run("should be synced until resync interval elapses", func(t *testing.T) {
+		idx := NewPendingCctxIndex()
+		idx.AddBlock(100)
+		needsResync, generation, height := idx.NeedsResync(pendingCctxResyncInterval)
+		require.True(t, needsResync)
+		idx.Resync(generation, height, cctxMap)
+		require.Len(t, idx.PendingCctxs(chainID), 1)
+
+		for bn := int64(101); bn < 100+pendingCctxResyncInterval; bn++ {
+			require.True(t, idx.AddBlock(bn))
+			needsResync, _, _ = idx.NeedsResync(pendingCctxResyncInterval)
+			require.False(t, needsResync)
+		}
+		require.True(t, idx.AddBlock(100+pendingCctxResyncInterval))
+		needsResync, _, _ = idx.NeedsResync(pendingCctxResyncInterval)
+		require.True(t, needsResync)
+	})
+	t.Run("should discard resync started before a gap", func(t *testing.T) {
+		idx := NewPendingCctxIndex()
+		idx.AddBlock(100)
+		_, generation, height := idx.NeedsResync(pendingCctxResyncInterval)
+
+		// gap while listing the pending cctxs
+		idx.AddBlock(105)
+		idx.Resync(generation, height, cctxMap)
+		needsResync, _, _ := idx.NeedsResync(pendingCctxResyncInterval)
+		require.True(t, needsResync)
+	})
+}
+
+func Test_PendingCctxIndex_Refresh(t *testing.T) {
+	chainID := chains.Ethereum.ChainId
+	cctx1 := createCctx("0x1", chainID, 1, crosschaintypes.CctxStatus_PendingOutbound)
+	cctx2 := createCctx("0x2", chainID, 2, crosschaintypes.CctxStatus_PendingOutbound)
+
+	t.Run("should add pending cctxs and drop finalized ones", func(t *testing.T) {
+		idx := NewPendingCctxIndex()
+		idx.Resync(0, 0, map[int64][]*crosschaintypes.CrossChainTx{chainID: {cctx1}})
+
+		fetched := map[string]*crosschaintypes.CrossChainTx{
+			"0x1": createCctx("0x1", chainID, 1, crosschaintypes.CctxStatus_OutboundMined),
+			"0x2": cctx2,
+			"0x3": createCctx("0x3", chainID, 3, crosschaintypes.CctxStatus_PendingRevert),
+			"0x4": createCctx("0x4", chainID, 4, crosschaintypes.CctxStatus_Aborted),
+		}
+		idx.MarkUpdated("0x1", "0x2", "0x3", "0x4")
+		err := idx.Refresh(func(cctxIndex string) (*crosschaintypes.CrossChainTx, error) {
+			return fetched[cctxIndex], nil
+		})
+		require.NoError(t, err)
+
+		cctxs := idx.PendingCctxs(chainID)
+		require.Len(t, cctxs, 2)
+		require.Equal(t, "0x2", cctxs[0].Index)
+		require.Equal(t, "0x3", cctxs[1].Index)
+	})
+	t.Run("should fetch again the cctxs failing to be fetched", func(t *testing.T) {
+		idx := NewPendingCctxIndex()
+		idx.MarkUpdated("0x2")
+		err := idx.Refresh(func(string) (*crosschaintypes.CrossChainTx, error) {
+			return nil, errors.New("rpc failed")
+		})
+		require.Error(t, err)
+		require.Empty(t, idx.PendingCctxs(chainID))
+
+		err = idx.Refresh(func(string) (*crosschaintypes.CrossChainTx, error) {
+			return cctx2, nil
+		})
+		require.NoError(t, err)
+		require.Len(t, idx.PendingCctxs(chainID), 1)
+	})
+}
+
+func Test_PendingCctxIndex_PendingCctxs(t *testing.T) {
+	ethChainID := chains.Ethereum.ChainId
+	btcChainID := chains.BitcoinMainnet.ChainId
+
+	idx := NewPendingCctxIndex()
+	idx.Resync(0, 0, map[int64][]*crosschaintypes.CrossChainTx{
+		ethChainID: {
+			createCctx("0x3", ethChainID, 3, crosschaintypes.CctxStatus_PendingOutbound),
+			createCctx("0x1", ethChainID, 1, crosschaintypes.CctxStatus_PendingOutbound),
+			createCctx("0x2", ethChainID, 2, crosschaintypes.CctxStatus_PendingRevert),
+		},
+		btcChainID: {
+			createCctx("0x4", btcChainID, 7, crosschaintypes.CctxStatus_PendingOutbound),
+		},
+	})
+
+	cctxs := idx.PendingCctxs(ethChainID)
+	require.Len(t, cctxs, 3)
+	for i, cctx := range cctxs {
+		require.EqualValues(t, i+1, cctx.GetCurrentOutboundParam().TssNonce)
+	}
+	require.Len(t, idx.PendingCctxs(btcChainID), 1)
+	require.Empty(t, idx.PendingCctxs(chains.Polygon.ChainId))
+}
+
+func Test_PendingCctxIndex_MarkDropped(t *testing.T) {
+	idx := NewPendingCctxIndex()
+	idx.AddBlock(100)
+	_, generation, height := idx.NeedsResync(pendingCctxResyncInterval)
+	idx.Resync(generation, height, nil)
+
+	// dropped events trigger a resync without interrupting the subscription
+	idx.MarkDropped(2)
+	needsResync, _, _ := idx.NeedsResync(pendingCctxResyncInterval)
+	require.True(t, needsResync)
+	require.True(t, idx.IsSubscribed())
+	require.EqualValues(t, 2, idx.Dropped())
+}
+
+func Test_PendingCctxIndex_FilterPending(t *testing.T) {
+	chainID := chains.Ethereum.ChainId
+	cctx1 := createCctx("0x1", chainID, 1, crosschaintypes.CctxStatus_PendingOutbound)
+	cctx2 := createCctx("0x2", chainID, 2, crosschaintypes.CctxStatus_PendingOutbound)
+	cctx3 := createCctx("0x3", chainID, 3, crosschaintypes.CctxStatus_PendingOutbound)
+	listed := map[int64][]*crosschaintypes.CrossChainTx{chainID: {cctx1, cctx2, cctx3}}
+
+	idx := NewPendingCctxIndex()
+	idx.Resync(0, 0, listed)
+	require.Equal(t, listed, idx.FilterPending(listed))
+
+	// cctx1 is mined and cctx2 gas price increased since the listing
+	cctx2Bumped := createCctx("0x2", chainID, 2, crosschaintypes.CctxStatus_PendingOutbound)
+	cctx2Bumped.GetCurrentOutboundParam().GasPrice = "2"
+	fetched := map[string]*crosschaintypes.CrossChainTx{
+		"0x1": createCctx("0x1", chainID, 1, crosschaintypes.CctxStatus_OutboundMined),
+		"0x2": cctx2Bumped,
+	}
+	idx.MarkUpdated("0x1", "0x2")
+	require.NoError(t, idx.Refresh(func(cctxIndex string) (*crosschaintypes.CrossChainTx, error) {
+		return fetched[cctxIndex], nil
+	}))
+
+	filtered := idx.FilterPending(listed)
+	require.Equal(t, []*crosschaintypes.CrossChainTx{cctx2Bumped, cctx3}, filtered[chainID])
+
+	// the finalized cctxs are forgotten on resync
+	idx.Resync(0, 0, nil)
+	require.Equal(t, listed, idx.FilterPending(listed))
+}
@@ -3,6 +3,7 @@ package orchestrator
 import (
 	"fmt"
 	"math"
+	"sync"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -14,7 +15,9 @@ import (
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 	btcobserver "github.com/zeta-chain/zetacore/zetaclient/chains/bitcoin/observer"
+	btcsigner "github.com/zeta-chain/zetacore/zetaclient/chains/bitcoin/signer"
 	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/zetacore/zetaclient/compliance"
 	"github.com/zeta-chain/zetacore/zetaclient/context"
 	"github.com/zeta-chain/zetacore/zetaclient/metrics"
 	"github.com/zeta-chain/zetacore/zetaclient/outboundprocessor"
@@ -43,15 +46,30 @@ type Orchestrator struct {
 	zetacoreClient interfaces.ZetacoreClient
 
 	// chain signers and observers
+	mu          sync.RWMutex
 	signerMap   map[int64]interfaces.ChainSigner
 	observerMap map[int64]interfaces.ChainObserver
 
-	// outbound processor
+	// chain builder and the configs the observers and signers were built with, see ReconcileChains
+	builder        ChainBuilder
+	startObservers bool
+	chainConfigs   map[int64]interface{}
+
+	// outbound processor and the scheduler starting the outbound keysigns within the keysign budget
 	outboundProc *outboundprocessor.Processor
+	scheduler    *outboundprocessor.Scheduler
+
+	// zetacore websocket dialer and the pending cctxs tracked from the zetacore events
+	eventsDial ZetacoreEventsDialer
+	cctxIndex  *PendingCctxIndex
 
 	// last operator balance
 	lastOperatorBalance sdkmath.Int
 
+	// app context and keysigns tracker reported in the status, see Status
+	appContext *context.AppContext
+	keysigns   KeysignsTracker
+
 	// misc
 	logger Log
 	stop   chan struct{}
@@ -67,8 +85,9 @@ func NewOrchestrator(
 	ts *metrics.TelemetryServer,
 ) *Orchestrator {
 	oc := Orchestrator{
-		ts:   ts,
-		stop: make(chan struct{}),
+		ts:        ts,
+		stop:      make(chan struct{}),
+		cctxIndex: NewPendingCctxIndex(),
 	}
 
 	// create loggers
@@ -82,8 +101,9 @@ func NewOrchestrator(
 	oc.signerMap = signerMap
 	oc.observerMap = observerMap
 
-	// create outbound processor manager
+	// create outbound processor manager and keysign scheduler, the keysigns are not limited by default
 	oc.outboundProc = outboundprocessor.NewProcessor(logger)
+	oc.scheduler = outboundprocessor.NewScheduler(oc.outboundProc, 0, 0, logger)
 
 	balance, err := zetacoreClient.GetZetaHotKeyBalance()
 	if err != nil {
@@ -94,6 +114,13 @@ func NewOrchestrator(
 	return &oc
 }
 
+// WithKeysignBudget limits the number of outbound keysigns in progress across all chains and per chain
+// the keysigns are not limited if the budget is 0
+func (oc *Orchestrator) WithKeysignBudget(maxKeysigns, maxKeysignsPerChain int) *Orchestrator {
+	oc.scheduler = outboundprocessor.NewScheduler(oc.outboundProc, maxKeysigns, maxKeysignsPerChain, oc.logger.Std)
+	return oc
+}
+
 func (oc *Orchestrator) MonitorCore(appContext *context.AppContext) error {
 	signerAddress, err := oc.zetacoreClient.GetKeys().GetAddress()
 	if err != nil {
@@ -101,9 +128,19 @@ func (oc *Orchestrator) MonitorCore(appContext *context.AppContext) error {
 	}
 	oc.logger.Std.Info().Msgf("Starting orchestrator for signer: %s", signerAddress)
 
+	oc.mu.Lock()
+	oc.appContext = appContext
+	oc.mu.Unlock()
+
 	// start cctx scheduler
 	go oc.StartCctxScheduler(appContext)
 
+	// start and stop chain observers and signers following the enabled chains
+	if oc.builder != nil {
+		oc.recordChainConfigs(appContext)
+		go oc.StartChainReconciler(appContext)
+	}
+
 	// watch for upgrade plan from zetacore
 	go func() {
 		// wait for upgrade plan signal to arrive
@@ -111,6 +148,8 @@ func (oc *Orchestrator) MonitorCore(appContext *context.AppContext) error {
 
 		// now stop orchestrator and all observers
 		close(oc.stop)
+		oc.mu.RLock()
+		defer oc.mu.RUnlock()
 		for _, c := range oc.observerMap {
 			c.Stop()
 		}
@@ -124,12 +163,14 @@ func (oc *Orchestrator) GetUpdatedSigner(
 	coreContext *context.ZetacoreContext,
 	chainID int64,
 ) (interfaces.ChainSigner, error) {
+	oc.mu.RLock()
 	signer, found := oc.signerMap[chainID]
+	oc.mu.RUnlock()
 	if !found {
 		return nil, fmt.Errorf("signer not found for chainID %d", chainID)
 	}
 	// update EVM signer parameters only. BTC signer doesn't use chain parameters for now.
-	if chains.IsEVMChain(chainID) {
+	if chains.IsEVMChain(chainID, coreContext.GetAdditionalChains()) {
 		evmParams, found := coreContext.GetEVMChainParams(chainID)
 		if found {
 			// update zeta connector and ERC20 custody addresses
@@ -155,26 +196,37 @@ func (oc *Orchestrator) GetUpdatedChainObserver(
 	coreContext *context.ZetacoreContext,
 	chainID int64,
 ) (interfaces.ChainObserver, error) {
+	oc.mu.RLock()
 	observer, found := oc.observerMap[chainID]
+	oc.mu.RUnlock()
 	if !found {
 		return nil, fmt.Errorf("chain observer not found for chainID %d", chainID)
 	}
 	// update chain observer chain parameters
 	curParams := observer.GetChainParams()
-	if chains.IsEVMChain(chainID) {
+	additionalChains := coreContext.GetAdditionalChains()
+	if chains.IsEVMChain(chainID, additionalChains) {
 		evmParams, found := coreContext.GetEVMChainParams(chainID)
 		if found && !observertypes.ChainParamsEqual(curParams, *evmParams) {
 			observer.SetChainParams(*evmParams)
 			oc.logger.Std.Info().Msgf(
 				"updated chain params for chainID %d, new params: %v", chainID, *evmParams)
 		}
-	} else if chains.IsBitcoinChain(chainID) {
-		_, btcParams, found := coreContext.GetBTCChainParams()
+	} else if chains.IsBitcoinChain(chainID, additionalChains) {
+		_, btcParams, found := coreContext.GetBTCChainParams(chainID)
 
 		if found && !observertypes.ChainParamsEqual(curParams, *btcParams) {
 			observer.SetChainParams(*btcParams)
 			oc.logger.Std.Info().Msgf(
-				"updated chain params for Bitcoin, new params: %v", *btcParams)
+				"updated chain params for Bitcoin chainID %d, new params: %v", chainID, *btcParams)
+		}
+	} else if chains.IsSolanaChain(chainID, additionalChains) {
+		_, solParams, found := coreContext.GetSolanaChainParams()
+
+		if found && !observertypes.ChainParamsEqual(curParams, *solParams) {
+			observer.SetChainParams(*solParams)
+			oc.logger.Std.Info().Msgf(
+				"updated chain params for Solana, new params: %v", *solParams)
 		}
 	}
 	return observer, nil
@@ -193,20 +245,13 @@ func (oc *Orchestrator) GetPendingCctxsWithinRatelimit(
 	// apply rate limiter or not according to the flags
 	rateLimiterUsable := ratelimiter.IsRateLimiterUsable(rateLimitFlags)
 
-	// fallback to non-rate-limited query if rate limiter is not usable
-	cctxsMap := make(map[int64][]*types.CrossChainTx)
+	// fallback to non-rate-limited pending cctxs if rate limiter is not usable
 	if !rateLimiterUsable {
-		for _, chain := range foreignChains {
-			resp, _, err := oc.zetacoreClient.ListPendingCctx(chain.ChainId)
-			if err == nil && resp != nil {
-				cctxsMap[chain.ChainId] = resp
-			}
-		}
-		return cctxsMap, nil
+		return oc.listPendingCctxs(foreignChains), nil
 	}
 
 	// query rate limiter input
-	resp, err := oc.zetacoreClient.GetRateLimiterInput(rateLimitFlags.Window)
+	resp, err := oc.zetacoreClient.GetRateLimiterInput(rateLimitFlags.InputWindow())
 	if err != nil {
 		return nil, err
 	}
@@ -219,28 +264,143 @@ func (oc *Orchestrator) GetPendingCctxsWithinRatelimit(
 	output := ratelimiter.ApplyRateLimiter(input, rateLimitFlags.Window, rateLimitFlags.Rate)
 
 	// set metrics
-	percentage := zetamath.Percentage(output.CurrentWithdrawRate.BigInt(), rateLimitFlags.Rate.BigInt())
-	if percentage != nil {
+	if rateLimitFlags.HasGlobalRateLimit() {
+		percentage := zetamath.Percentage(output.CurrentWithdrawRate.BigInt(), rateLimitFlags.Rate.BigInt())
+		if percentage != nil {
+			percentageFloat, _ := percentage.Float64()
+			metrics.PercentageOfRateReached.Set(percentageFloat)
+			oc.logger.Sampled.Info().Msgf("current rate limiter window: %d rate: %s, percentage: %f",
+				output.CurrentWithdrawWindow, output.CurrentWithdrawRate.String(), percentageFloat)
+		}
+	}
+	for _, utilization := range output.BucketUtilizations {
+		withdrawRate, ok := sdkmath.NewIntFromString(utilization.CurrentWithdrawRate)
+		if !ok || !utilization.Bucket.IsUsable() {
+			continue
+		}
+		percentage := zetamath.Percentage(withdrawRate.BigInt(), utilization.Bucket.Rate.BigInt())
+		if percentage == nil {
+			continue
+		}
 		percentageFloat, _ := percentage.Float64()
-		metrics.PercentageOfRateReached.Set(percentageFloat)
-		oc.logger.Sampled.Info().Msgf("current rate limiter window: %d rate: %s, percentage: %f",
-			output.CurrentWithdrawWindow, output.CurrentWithdrawRate.String(), percentageFloat)
+		metrics.PercentageOfRateReachedPerBucket.WithLabelValues(utilization.Bucket.Name()).Set(percentageFloat)
+		if utilization.RateLimitExceeded {
+			oc.logger.Sampled.Warn().Msgf("rate limit exceeded for bucket %s window: %d rate: %s, percentage: %f",
+				utilization.Bucket.Name(),
+				utilization.CurrentWithdrawWindow,
+				utilization.CurrentWithdrawRate,
+				percentageFloat,
+			)
+		}
+	}
+
+	return oc.syncPendingCctxIndex(&resp, output.CctxsMap), nil
+}
+
+// syncPendingCctxIndex feeds the pending cctxs listed by the rate limiter input into the pending cctx index
+// the index is resynced if the input lists all the pending cctxs, and refreshed otherwise, the cctxs
+// within rate limit are then filtered by the index to drop the ones finalized since the input query
+func (oc *Orchestrator) syncPendingCctxIndex(
+	resp *types.QueryRateLimiterInputResponse,
+	cctxsMap map[int64][]*types.CrossChainTx,
+) map[int64][]*types.CrossChainTx {
+	if oc.cctxIndex == nil {
+		return cctxsMap
 	}
 
-	return output.CctxsMap, nil
+	needsResync, generation, height := oc.cctxIndex.NeedsResync(pendingCctxResyncInterval)
+	// #nosec G701 always in range
+	if uint64(len(resp.CctxsMissed)+len(resp.CctxsPending)) == resp.TotalPending {
+		listed := make(map[int64][]*types.CrossChainTx)
+		for _, cctx := range append(append([]*types.CrossChainTx{}, resp.CctxsMissed...), resp.CctxsPending...) {
+			if !IsCctxPending(cctx) {
+				continue
+			}
+			chainID := cctx.GetCurrentOutboundParam().ReceiverChainId
+			listed[chainID] = append(listed[chainID], cctx)
+		}
+		oc.cctxIndex.Resync(generation, height, listed)
+	} else if !needsResync {
+		if err := oc.cctxIndex.Refresh(oc.zetacoreClient.GetCctxByHash); err != nil {
+			oc.logger.Std.Error().Err(err).Msg("syncPendingCctxIndex: error refreshing pending cctx index")
+		}
+	}
+	return oc.cctxIndex.FilterPending(cctxsMap)
 }
 
-// StartCctxScheduler schedules keysigns for cctxs on each ZetaChain block (the ticker)
+// listPendingCctxs lists the pending cctxs across foreign chains from the pending cctx index while
+// the zetacore events are subscribed, and from zetacore otherwise or when the index must be resynced
+func (oc *Orchestrator) listPendingCctxs(foreignChains []chains.Chain) map[int64][]*types.CrossChainTx {
+	var (
+		needsResync bool
+		generation  uint64
+		height      int64
+	)
+	if oc.cctxIndex != nil {
+		needsResync, generation, height = oc.cctxIndex.NeedsResync(pendingCctxResyncInterval)
+		if !needsResync {
+			err := oc.cctxIndex.Refresh(oc.zetacoreClient.GetCctxByHash)
+			if err == nil {
+				cctxsMap := make(map[int64][]*types.CrossChainTx)
+				for _, chain := range foreignChains {
+					cctxsMap[chain.ChainId] = oc.cctxIndex.PendingCctxs(chain.ChainId)
+				}
+				return cctxsMap
+			}
+			oc.logger.Std.Error().Err(err).Msg("listPendingCctxs: error refreshing pending cctx index, resyncing")
+		}
+	}
+
+	listed := true
+	cctxsMap := make(map[int64][]*types.CrossChainTx)
+	for _, chain := range foreignChains {
+		resp, _, err := oc.zetacoreClient.ListPendingCctx(chain.ChainId)
+		if err != nil {
+			listed = false
+			continue
+		}
+		if resp != nil {
+			cctxsMap[chain.ChainId] = resp
+		}
+	}
+
+	// the index is only resynced from a complete listing
+	if oc.cctxIndex != nil && listed {
+		oc.cctxIndex.Resync(generation, height, cctxsMap)
+	}
+	return cctxsMap
+}
+
+// StartCctxScheduler schedules keysigns for cctxs on each ZetaChain block
+// the blocks are received from the zetacore events if subscribed, or polled with the ticker otherwise
 func (oc *Orchestrator) StartCctxScheduler(appContext *context.AppContext) {
 	observeTicker := time.NewTicker(3 * time.Second)
+
+	// blocks stays nil, i.e. never ready, without zetacore events
+	var blocks chan int64
+	if oc.eventsDial != nil {
+		blocks = make(chan int64)
+		go oc.StartZetacoreEventsWatcher(blocks)
+	}
+
 	var lastBlockNum int64
 	for {
 		select {
 		case <-oc.stop:
 			oc.logger.Std.Warn().Msg("StartCctxScheduler: stopped")
 			return
+		case bn := <-blocks:
+			if bn > lastBlockNum {
+				oc.scheduleCctxs(appContext, bn)
+				lastBlockNum = bn
+			}
 		case <-observeTicker.C:
 			{
+				// the blocks are received from the subscription
+				if oc.cctxIndex != nil && oc.cctxIndex.IsSubscribed() {
+					continue
+				}
+
 				bn, err := oc.zetacoreClient.GetBlockHeight()
 				if err != nil {
 					oc.logger.Std.Error().Err(err).Msg("StartCctxScheduler: GetBlockHeight fail")
@@ -255,83 +415,102 @@ func (oc *Orchestrator) StartCctxScheduler(appContext *context.AppContext) {
 				}
 				if bn > lastBlockNum { // we have a new block
 					bn = lastBlockNum + 1
-					if bn%10 == 0 {
-						oc.logger.Std.Debug().Msgf("StartCctxScheduler: zetacore heart beat: %d", bn)
-					}
-
-					balance, err := oc.zetacoreClient.GetZetaHotKeyBalance()
-					if err != nil {
-						oc.logger.Std.Error().Err(err).Msgf("couldn't get operator balance")
-					} else {
-						diff := oc.lastOperatorBalance.Sub(balance)
-						if diff.GT(sdkmath.NewInt(0)) && diff.LT(sdkmath.NewInt(math.MaxInt64)) {
-							oc.ts.AddFeeEntry(bn, diff.Int64())
-							oc.lastOperatorBalance = balance
-						}
-					}
-
-					// set current hot key burn rate
-					metrics.HotKeyBurnRate.Set(float64(oc.ts.HotKeyBurnRate.GetBurnRate().Int64()))
-
-					// get supported external chains
-					coreContext := appContext.ZetacoreContext()
-					externalChains := coreContext.GetEnabledExternalChains()
-
-					// query pending cctxs across all external chains within rate limit
-					cctxMap, err := oc.GetPendingCctxsWithinRatelimit(externalChains)
-					if err != nil {
-						oc.logger.Std.Error().Err(err).Msgf("StartCctxScheduler: GetPendingCctxsWithinRatelimit failed")
-					}
-
-					// schedule keysign for pending cctxs on each chain
-					for _, c := range externalChains {
-						// get cctxs from map and set pending transactions prometheus gauge
-						cctxList := cctxMap[c.ChainId]
-						metrics.PendingTxsPerChain.WithLabelValues(c.ChainName.String()).Set(float64(len(cctxList)))
-						if len(cctxList) == 0 {
-							continue
-						}
-
-						// update chain parameters for signer and chain observer
-						signer, err := oc.GetUpdatedSigner(coreContext, c.ChainId)
-						if err != nil {
-							oc.logger.Std.Error().
-								Err(err).
-								Msgf("StartCctxScheduler: GetUpdatedSigner failed for chain %d", c.ChainId)
-							continue
-						}
-						ob, err := oc.GetUpdatedChainObserver(coreContext, c.ChainId)
-						if err != nil {
-							oc.logger.Std.Error().
-								Err(err).
-								Msgf("StartCctxScheduler: GetUpdatedChainObserver failed for chain %d", c.ChainId)
-							continue
-						}
-						if !context.IsOutboundObservationEnabled(coreContext, ob.GetChainParams()) {
-							continue
-						}
-
-						// #nosec G701 range is verified
-						zetaHeight := uint64(bn)
-						if chains.IsEVMChain(c.ChainId) {
-							oc.ScheduleCctxEVM(zetaHeight, c.ChainId, cctxList, ob, signer)
-						} else if chains.IsBitcoinChain(c.ChainId) {
-							oc.ScheduleCctxBTC(zetaHeight, c.ChainId, cctxList, ob, signer)
-						} else {
-							oc.logger.Std.Error().Msgf("StartCctxScheduler: unsupported chain %d", c.ChainId)
-							continue
-						}
-					}
-
-					// update last processed block number
+					oc.scheduleCctxs(appContext, bn)
 					lastBlockNum = bn
-					metrics.LastCoreBlockNumber.Set(float64(lastBlockNum))
 				}
 			}
 		}
 	}
 }
 
+// scheduleCctxs schedules keysigns for the pending cctxs of the external chains at ZetaChain block 'bn'
+func (oc *Orchestrator) scheduleCctxs(appContext *context.AppContext, bn int64) {
+	if bn%10 == 0 {
+		oc.logger.Std.Debug().Msgf("StartCctxScheduler: zetacore heart beat: %d", bn)
+	}
+
+	balance, err := oc.zetacoreClient.GetZetaHotKeyBalance()
+	if err != nil {
+		oc.logger.Std.Error().Err(err).Msgf("couldn't get operator balance")
+	} else {
+		diff := oc.lastOperatorBalance.Sub(balance)
+		if diff.GT(sdkmath.NewInt(0)) && diff.LT(sdkmath.NewInt(math.MaxInt64)) {
+			oc.ts.AddFeeEntry(bn, diff.Int64())
+			oc.lastOperatorBalance = balance
+		}
+	}
+
+	// set current hot key burn rate
+	metrics.HotKeyBurnRate.Set(float64(oc.ts.HotKeyBurnRate.GetBurnRate().Int64()))
+
+	// get supported external chains
+	coreContext := appContext.ZetacoreContext()
+	externalChains := coreContext.GetEnabledExternalChains()
+
+	// the queued keysigns scheduled at a past zeta height and the ones of the chains no longer observed are dropped
+	// #nosec G701 always positive
+	oc.scheduler.SetZetaHeight(uint64(bn))
+	externalChainIDs := make(map[int64]bool, len(externalChains))
+	for _, c := range externalChains {
+		externalChainIDs[c.ChainId] = true
+	}
+	oc.scheduler.PruneChains(externalChainIDs)
+
+	// query pending cctxs across all external chains within rate limit
+	cctxMap, err := oc.GetPendingCctxsWithinRatelimit(externalChains)
+	if err != nil {
+		oc.logger.Std.Error().Err(err).Msgf("StartCctxScheduler: GetPendingCctxsWithinRatelimit failed")
+	}
+
+	// schedule keysign for pending cctxs on each chain
+	for _, c := range externalChains {
+		// get cctxs from map and set pending transactions prometheus gauge
+		cctxList := cctxMap[c.ChainId]
+		metrics.PendingTxsPerChain.WithLabelValues(c.ChainName.String()).Set(float64(len(cctxList)))
+
+		// drop the queued keysigns of the outbounds no longer pending
+		oc.scheduler.Prune(c.ChainId, pendingOutboundIDs(cctxList))
+		if len(cctxList) == 0 {
+			continue
+		}
+
+		// update chain parameters for signer and chain observer
+		signer, err := oc.GetUpdatedSigner(coreContext, c.ChainId)
+		if err != nil {
+			oc.logger.Std.Error().
+				Err(err).
+				Msgf("StartCctxScheduler: GetUpdatedSigner failed for chain %d", c.ChainId)
+			continue
+		}
+		ob, err := oc.GetUpdatedChainObserver(coreContext, c.ChainId)
+		if err != nil {
+			oc.logger.Std.Error().
+				Err(err).
+				Msgf("StartCctxScheduler: GetUpdatedChainObserver failed for chain %d", c.ChainId)
+			continue
+		}
+		if !context.IsOutboundObservationEnabled(coreContext, ob.GetChainParams()) {
+			continue
+		}
+
+		// #nosec G701 range is verified
+		zetaHeight := uint64(bn)
+		if c.IsEVMChain() {
+			oc.ScheduleCctxEVM(zetaHeight, c.ChainId, cctxList, ob, signer)
+		} else if c.IsBitcoinChain() {
+			oc.ScheduleCctxBTC(zetaHeight, c.ChainId, cctxList, ob, signer)
+		} else if c.IsSolanaChain() {
+			oc.ScheduleCctxSolana(zetaHeight, c.ChainId, cctxList, ob, signer)
+		} else {
+			oc.logger.Std.Error().Msgf("StartCctxScheduler: unsupported chain %d", c.ChainId)
+			continue
+		}
+	}
+
+	// update last processed block number
+	metrics.LastCoreBlockNumber.Set(float64(bn))
+}
+
 // ScheduleCctxEVM schedules evm outbound keysign on each ZetaChain block (the ticker)
 func (oc *Orchestrator) ScheduleCctxEVM(
 	zetaHeight uint64,
@@ -409,10 +588,9 @@ func (oc *Orchestrator) ScheduleCctxEVM(
 		// otherwise, the normal interval is used
 		if nonce%outboundScheduleInterval == zetaHeight%outboundScheduleInterval &&
 			!oc.outboundProc.IsOutboundActive(outboundID) {
-			oc.outboundProc.StartTryProcess(outboundID)
 			oc.logger.Std.Debug().
 				Msgf("ScheduleCctxEVM: sign outbound %s with value %d\n", outboundID, cctx.GetCurrentOutboundParam().Amount)
-			go signer.TryProcessOutbound(cctx, oc.outboundProc, outboundID, observer, oc.zetacoreClient, zetaHeight)
+			oc.scheduleOutbound(cctx, outboundID, cctxList, zetaHeight, observer, signer)
 		}
 
 		// #nosec G701 always in range
@@ -441,6 +619,7 @@ func (oc *Orchestrator) ScheduleCctxBTC(
 	// #nosec G701 positive
 	interval := uint64(observer.GetChainParams().OutboundScheduleInterval)
 	lookahead := observer.GetChainParams().OutboundScheduleLookahead
+	batchSize := observer.GetChainParams().OutboundBatchSize
 
 	// schedule at most one keysign per ticker
 	for idx, cctx := range cctxList {
@@ -461,7 +640,20 @@ func (oc *Orchestrator) ScheduleCctxBTC(
 				Msgf("ScheduleCctxBTC: IsOutboundProcessed faild for chain %d nonce %d", chainID, nonce)
 			continue
 		}
-		if included || confirmed {
+		if confirmed {
+			oc.logger.Std.Info().
+				Msgf("ScheduleCctxBTC: outbound %s already confirmed; do not schedule keysign", outboundID)
+			continue
+		}
+		if included {
+			// the pending outbound in mempool may be replaced by fee (RBF) if its gas price is increased by zetacore
+			if btcObserver.IsOutboundReplaceable(nonce) {
+				if nonce%interval == zetaHeight%interval && !oc.outboundProc.IsOutboundActive(outboundID) {
+					oc.logger.Std.Debug().Msgf("ScheduleCctxBTC: try replacing outbound %s by fee", outboundID)
+					oc.scheduleOutbound(cctx, outboundID, cctxList, zetaHeight, observer, signer)
+				}
+				continue
+			}
 			oc.logger.Std.Info().
 				Msgf("ScheduleCctxBTC: outbound %s already included; do not schedule keysign", outboundID)
 			continue
@@ -481,9 +673,165 @@ func (oc *Orchestrator) ScheduleCctxBTC(
 		}
 		// try confirming the outbound or scheduling a keysign
 		if nonce%interval == zetaHeight%interval && !oc.outboundProc.IsOutboundActive(outboundID) {
-			oc.outboundProc.StartTryProcess(outboundID)
-			oc.logger.Std.Debug().Msgf("ScheduleCctxBTC: sign outbound %s with value %d\n", outboundID, params.Amount)
-			go signer.TryProcessOutbound(cctx, oc.outboundProc, outboundID, observer, oc.zetacoreClient, zetaHeight)
+			// pay the pending cctxs that follow in the same outbound if batching is enabled
+			batch := []*types.CrossChainTx{cctx}
+			btcSigner, isBTCSigner := signer.(*btcsigner.Signer)
+			if batchSize > 1 && isBTCSigner {
+				// all TSS signers must sign the same batch, so it's built from the pending nonces in zetacore, not the local state
+				pendingNonces, err := oc.zetacoreClient.GetPendingNoncesByChain(chainID)
+				if err != nil {
+					oc.logger.Std.Error().
+						Err(err).
+						Msgf("ScheduleCctxBTC: GetPendingNoncesByChain failed for chain %d, sign outbound %s alone", chainID, outboundID)
+				} else {
+					batch = getBTCOutboundBatch(cctxList, idx, pendingNonces, batchSize, lookahead)
+				}
+			}
+			if len(batch) > 1 {
+				oc.logger.Std.Debug().
+					Msgf("ScheduleCctxBTC: sign batched outbound %s paying %d cctxs\n", outboundID, len(batch))
+				batchOutboundIDs := make([]string, 0, len(batch)-1)
+				for _, next := range batch[1:] {
+					nextParams := next.GetCurrentOutboundParam()
+					batchOutboundIDs = append(
+						batchOutboundIDs,
+						outboundprocessor.ToOutboundID(next.Index, nextParams.ReceiverChainId, nextParams.TssNonce),
+					)
+				}
+				oc.scheduler.Submit(outboundprocessor.Keysign{
+					OutboundID:       outboundID,
+					ChainID:          chainID,
+					Nonce:            nonce,
+					Priority:         outboundPriority(cctx, cctxList),
+					BatchOutboundIDs: batchOutboundIDs,
+					ZetaHeight:       zetaHeight,
+					Run: func() {
+						btcSigner.TryProcessOutbounds(batch, oc.outboundProc, outboundID, observer, oc.zetacoreClient, zetaHeight)
+					},
+				})
+			} else {
+				oc.logger.Std.Debug().Msgf("ScheduleCctxBTC: sign outbound %s with value %d\n", outboundID, params.Amount)
+				oc.scheduleOutbound(cctx, outboundID, cctxList, zetaHeight, observer, signer)
+			}
+		}
+	}
+}
+
+// ScheduleCctxSolana schedules solana outbound keysign on each ZetaChain block (the ticker)
+// The gateway program only accepts the withdrawal of its next nonce, so only the earliest pending cctx
+// that is not included yet is scheduled.
+func (oc *Orchestrator) ScheduleCctxSolana(
+	zetaHeight uint64,
+	chainID int64,
+	cctxList []*types.CrossChainTx,
+	observer interfaces.ChainObserver,
+	signer interfaces.ChainSigner,
+) {
+	// #nosec G701 positive
+	interval := uint64(observer.GetChainParams().OutboundScheduleInterval)
+
+	for _, cctx := range cctxList {
+		params := cctx.GetCurrentOutboundParam()
+		nonce := params.TssNonce
+		outboundID := outboundprocessor.ToOutboundID(cctx.Index, params.ReceiverChainId, nonce)
+
+		if params.ReceiverChainId != chainID {
+			oc.logger.Std.Error().
+				Msgf("ScheduleCctxSolana: outbound %s chainid mismatch: want %d, got %d", outboundID, chainID, params.ReceiverChainId)
+			continue
+		}
+		// try confirming the outbound
+		included, _, err := observer.IsOutboundProcessed(cctx, oc.logger.Std)
+		if err != nil {
+			oc.logger.Std.Error().
+				Err(err).
+				Msgf("ScheduleCctxSolana: IsOutboundProcessed faild for chain %d nonce %d", chainID, nonce)
+			return
+		}
+		if included {
+			oc.logger.Std.Info().
+				Msgf("ScheduleCctxSolana: outbound %s already included; do not schedule keysign", outboundID)
+			continue
+		}
+
+		// the later nonces can't be withdrawn before this one
+		if nonce%interval == zetaHeight%interval && !oc.outboundProc.IsOutboundActive(outboundID) {
+			oc.logger.Std.Debug().Msgf("ScheduleCctxSolana: sign outbound %s with value %d\n", outboundID, params.Amount)
+			oc.scheduleOutbound(cctx, outboundID, cctxList, zetaHeight, observer, signer)
+		}
+		return
+	}
+}
+
+// scheduleOutbound queues the keysign of the outbound of the cctx scheduled at the zeta height in the keysign scheduler
+func (oc *Orchestrator) scheduleOutbound(
+	cctx *types.CrossChainTx,
+	outboundID string,
+	cctxList []*types.CrossChainTx,
+	zetaHeight uint64,
+	observer interfaces.ChainObserver,
+	signer interfaces.ChainSigner,
+) {
+	params := cctx.GetCurrentOutboundParam()
+	oc.scheduler.Submit(outboundprocessor.Keysign{
+		OutboundID: outboundID,
+		ChainID:    params.ReceiverChainId,
+		Nonce:      params.TssNonce,
+		Priority:   outboundPriority(cctx, cctxList),
+		ZetaHeight: zetaHeight,
+		Run: func() {
+			signer.TryProcessOutbound(cctx, oc.outboundProc, outboundID, observer, oc.zetacoreClient, zetaHeight)
+		},
+	})
+}
+
+// outboundPriority returns the keysign priority of the outbound of the cctx among the pending cctxs of its chain
+// the lowest pending nonce blocks the following nonces and goes first, then the reverts and refunds
+func outboundPriority(cctx *types.CrossChainTx, cctxList []*types.CrossChainTx) outboundprocessor.Priority {
+	if len(cctxList) > 0 && cctx.GetCurrentOutboundParam().TssNonce == cctxList[0].GetCurrentOutboundParam().TssNonce {
+		return outboundprocessor.PriorityLowestNonce
+	}
+	if cctx.CctxStatus != nil && cctx.CctxStatus.Status == types.CctxStatus_PendingRevert {
+		return outboundprocessor.PriorityRevert
+	}
+	return outboundprocessor.PriorityNormal
+}
+
+// pendingOutboundIDs returns the outbound ids of the pending cctxs
+func pendingOutboundIDs(cctxList []*types.CrossChainTx) map[string]bool {
+	outboundIDs := make(map[string]bool, len(cctxList))
+	for _, cctx := range cctxList {
+		params := cctx.GetCurrentOutboundParam()
+		outboundIDs[outboundprocessor.ToOutboundID(cctx.Index, params.ReceiverChainId, params.TssNonce)] = true
+	}
+	return outboundIDs
+}
+
+// getBTCOutboundBatch returns the cctxs of consecutive nonces to be paid by the outbound of cctxList[idx].
+// Only the cctx of the lowest pending nonce in zetacore starts a batch, the batch is limited to the pending
+// nonces in zetacore. Restricted cctxs are always paid alone.
+func getBTCOutboundBatch(
+	cctxList []*types.CrossChainTx,
+	idx int,
+	pendingNonces observertypes.PendingNonces,
+	batchSize uint64,
+	lookahead int64,
+) []*types.CrossChainTx {
+	batch := []*types.CrossChainTx{cctxList[idx]}
+	nonce := cctxList[idx].GetCurrentOutboundParam().TssNonce
+	// #nosec G701 always positive
+	if nonce != uint64(pendingNonces.NonceLow) || batchSize <= 1 || compliance.IsCctxRestricted(cctxList[idx]) {
+		return batch
+	}
+	for i := idx + 1; i < len(cctxList) && int64(i) < lookahead && uint64(len(batch)) < batchSize; i++ {
+		next := cctxList[i]
+		nextNonce := next.GetCurrentOutboundParam().TssNonce
+		// #nosec G701 always in range
+		if nextNonce != nonce+uint64(len(batch)) || nextNonce >= uint64(pendingNonces.NonceHigh) ||
+			compliance.IsCctxRestricted(next) {
+			break
 		}
+		batch = append(batch, next)
 	}
+	return batch
 }
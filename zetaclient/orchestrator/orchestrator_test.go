@@ -1,7 +1,9 @@
 package orchestrator
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -17,6 +19,7 @@ import (
 	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/zetacore/zetaclient/config"
 	"github.com/zeta-chain/zetacore/zetaclient/context"
+	"github.com/zeta-chain/zetacore/zetaclient/outboundprocessor"
 	"github.com/zeta-chain/zetacore/zetaclient/testutils"
 	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
 )
@@ -62,7 +65,7 @@ func CreateCoreContext(
 	cfg.EVMChainConfigs[evmChain.ChainId] = config.EVMConfig{
 		Chain: evmChain,
 	}
-	cfg.BitcoinConfig = config.BTCConfig{
+	cfg.BTCChainConfigs[btcChain.ChainId] = config.BTCConfig{
 		RPCHost: "localhost",
 	}
 	// new zetacore context
@@ -76,8 +79,10 @@ func CreateCoreContext(
 	coreContext.Update(
 		&observertypes.Keygen{},
 		[]chains.Chain{evmChain, btcChain},
+		[]chains.Chain{},
 		evmChainParamsMap,
-		btcChainParams,
+		map[int64]*observertypes.ChainParams{btcChain.ChainId: btcChainParams},
+		nil,
 		"",
 		*ccFlags,
 		verificationFlags,
@@ -202,6 +207,36 @@ func Test_GetUpdatedChainObserver(t *testing.T) {
 		require.NotNil(t, chainOb)
 		require.True(t, observertypes.ChainParamsEqual(*btcChainParamsNew, chainOb.GetChainParams()))
 	})
+	t.Run("chain params in solana chain observer should be updated successfully", func(t *testing.T) {
+		solChain := chains.SolanaLocalnet
+		solChainParamsNew := observertypes.GetDefaultSolanaLocalnetChainParams()
+		orchestrator := &Orchestrator{
+			observerMap: map[int64]interfaces.ChainObserver{
+				solChain.ChainId: mocks.NewEVMObserver(&observertypes.ChainParams{ChainId: solChain.ChainId}),
+			},
+		}
+		cfg := config.NewConfig()
+		cfg.SolanaConfig = config.SolanaConfig{Endpoint: "http://localhost:8899"}
+		coreContext := context.NewZetacoreContext(cfg)
+		coreContext.Update(
+			&observertypes.Keygen{},
+			[]chains.Chain{solChain},
+			[]chains.Chain{},
+			nil,
+			nil,
+			solChainParamsNew,
+			"",
+			*sample.CrosschainFlags(),
+			sample.HeaderSupportedChains(),
+			true,
+			zerolog.Logger{},
+		)
+		// update solana chain observer with new chain params
+		chainOb, err := orchestrator.GetUpdatedChainObserver(coreContext, solChain.ChainId)
+		require.NoError(t, err)
+		require.NotNil(t, chainOb)
+		require.True(t, observertypes.ChainParamsEqual(*solChainParamsNew, chainOb.GetChainParams()))
+	})
 }
 
 func Test_GetPendingCctxsWithinRatelimit(t *testing.T) {
@@ -376,3 +411,340 @@ func Test_GetPendingCctxsWithinRatelimit(t *testing.T) {
 		})
 	}
 }
+
+func Test_getBTCOutboundBatch(t *testing.T) {
+	// create 10 pending cctxs of nonce 0 to 9
+	btcChain := chains.BitcoinMainnet
+	cctxs := sample.CustomCctxsInBlockRange(
+		t,
+		1,
+		10,
+		chains.ZetaChainMainnet.ChainId,
+		btcChain.ChainId,
+		coin.CoinType_Gas,
+		"",
+		2000,
+		crosschaintypes.CctxStatus_PendingOutbound,
+	)
+	config.LoadComplianceConfig(config.Config{})
+
+	// the pending nonces [0, 10) in zetacore
+	pendingNonces := observertypes.PendingNonces{NonceLow: 0, NonceHigh: 10, ChainId: btcChain.ChainId}
+
+	t.Run("should return single cctx if batching is disabled", func(t *testing.T) {
+		batch := getBTCOutboundBatch(cctxs, 0, pendingNonces, 0, 100)
+		require.Equal(t, cctxs[:1], batch)
+	})
+	t.Run("should return single cctx if nonce is not the lowest pending nonce in zetacore", func(t *testing.T) {
+		batch := getBTCOutboundBatch(cctxs, 1, pendingNonces, 5, 100)
+		require.Equal(t, cctxs[1:2], batch)
+	})
+	t.Run("should return batch of consecutive nonces up to batch size", func(t *testing.T) {
+		pendingNonces := observertypes.PendingNonces{NonceLow: 2, NonceHigh: 10, ChainId: btcChain.ChainId}
+		batch := getBTCOutboundBatch(cctxs, 2, pendingNonces, 5, 100)
+		require.Equal(t, cctxs[2:7], batch)
+	})
+	t.Run("should stop batching at the highest pending nonce in zetacore", func(t *testing.T) {
+		pendingNonces := observertypes.PendingNonces{NonceLow: 2, NonceHigh: 4, ChainId: btcChain.ChainId}
+		batch := getBTCOutboundBatch(cctxs, 2, pendingNonces, 5, 100)
+		require.Equal(t, cctxs[2:4], batch)
+	})
+	t.Run("should stop batching at lookahead", func(t *testing.T) {
+		pendingNonces := observertypes.PendingNonces{NonceLow: 2, NonceHigh: 10, ChainId: btcChain.ChainId}
+		batch := getBTCOutboundBatch(cctxs, 2, pendingNonces, 5, 4)
+		require.Equal(t, cctxs[2:4], batch)
+	})
+	t.Run("should stop batching at nonce gap", func(t *testing.T) {
+		cctxsGap := append(append([]*crosschaintypes.CrossChainTx{}, cctxs[:3]...), cctxs[4:]...)
+		batch := getBTCOutboundBatch(cctxsGap, 0, pendingNonces, 5, 100)
+		require.Equal(t, cctxs[:3], batch)
+	})
+	t.Run("should stop batching at restricted cctx", func(t *testing.T) {
+		cfg := config.Config{}
+		cfg.ComplianceConfig.RestrictedAddresses = []string{cctxs[3].GetCurrentOutboundParam().Receiver}
+		config.LoadComplianceConfig(cfg)
+		defer config.LoadComplianceConfig(config.Config{})
+
+		batch := getBTCOutboundBatch(cctxs, 0, pendingNonces, 5, 100)
+		require.Equal(t, cctxs[:3], batch)
+
+		pendingNonces := observertypes.PendingNonces{NonceLow: 3, NonceHigh: 10, ChainId: btcChain.ChainId}
+		batch = getBTCOutboundBatch(cctxs, 3, pendingNonces, 5, 100)
+		require.Equal(t, cctxs[3:4], batch)
+	})
+}
+
+func Test_outboundPriority(t *testing.T) {
+	// create 3 pending cctxs of nonce 0 to 2
+	evmChain := chains.Ethereum
+	cctxs := sample.CustomCctxsInBlockRange(
+		t,
+		1,
+		3,
+		chains.ZetaChainMainnet.ChainId,
+		evmChain.ChainId,
+		coin.CoinType_Gas,
+		"",
+		2000,
+		crosschaintypes.CctxStatus_PendingOutbound,
+	)
+	revert := *cctxs[2]
+	revert.CctxStatus = &crosschaintypes.Status{Status: crosschaintypes.CctxStatus_PendingRevert}
+
+	require.Equal(t, outboundprocessor.PriorityLowestNonce, outboundPriority(cctxs[0], cctxs))
+	require.Equal(t, outboundprocessor.PriorityNormal, outboundPriority(cctxs[1], cctxs))
+	require.Equal(t, outboundprocessor.PriorityRevert, outboundPriority(&revert, cctxs))
+
+	outboundIDs := pendingOutboundIDs(cctxs)
+	require.Len(t, outboundIDs, 3)
+	require.True(t, outboundIDs[outboundprocessor.ToOutboundID(cctxs[1].Index, evmChain.ChainId, 1)])
+}
+
+// mockChainBuilder builds mock observers and signers and records the chains built
+type mockChainBuilder struct {
+	observers map[int64]*mocks.EVMObserver
+	signers   map[int64]interfaces.ChainSigner
+}
+
+func newMockChainBuilder() *mockChainBuilder {
+	return &mockChainBuilder{
+		observers: make(map[int64]*mocks.EVMObserver),
+		signers:   make(map[int64]interfaces.ChainSigner),
+	}
+}
+
+func (b *mockChainBuilder) NewObserver(
+	appContext *context.AppContext,
+	chain chains.Chain,
+) (interfaces.ChainObserver, error) {
+	params, found := appContext.ZetacoreContext().GetEVMChainParams(chain.ChainId)
+	if chain.IsBitcoinChain() {
+		_, params, found = appContext.ZetacoreContext().GetBTCChainParams(chain.ChainId)
+	}
+	if !found {
+		return nil, fmt.Errorf("chain params not found for chain %d", chain.ChainId)
+	}
+	observer := mocks.NewEVMObserver(params)
+	b.observers[chain.ChainId] = observer
+	return observer, nil
+}
+
+func (b *mockChainBuilder) NewSigner(
+	_ *context.AppContext,
+	chain chains.Chain,
+) (interfaces.ChainSigner, error) {
+	signer := mocks.NewEVMSigner(chain, ethcommon.Address{}, ethcommon.Address{})
+	b.signers[chain.ChainId] = signer
+	return signer, nil
+}
+
+func Test_ReconcileChains(t *testing.T) {
+	ethChain := chains.Ethereum
+	bscChain := chains.BscMainnet
+	ethChainParams := &observertypes.ChainParams{ChainId: ethChain.ChainId, IsSupported: true}
+	bscChainParams := &observertypes.ChainParams{ChainId: bscChain.ChainId, IsSupported: true}
+
+	// newAppContext creates an app context with ethereum and bsc configured
+	newAppContext := func() *context.AppContext {
+		cfg := config.NewConfig()
+		cfg.EVMChainConfigs[ethChain.ChainId] = config.EVMConfig{Chain: ethChain, Endpoint: "http://eth"}
+		cfg.EVMChainConfigs[bscChain.ChainId] = config.EVMConfig{Chain: bscChain, Endpoint: "http://bsc"}
+		return context.NewAppContext(context.NewZetacoreContext(cfg), cfg)
+	}
+
+	// enableChains updates the zetacore context with the enabled chains
+	enableChains := func(appContext *context.AppContext, enabled ...chains.Chain) {
+		appContext.ZetacoreContext().Update(
+			&observertypes.Keygen{},
+			enabled,
+			[]chains.Chain{},
+			map[int64]*observertypes.ChainParams{
+				ethChain.ChainId: ethChainParams,
+				bscChain.ChainId: bscChainParams,
+			},
+			nil,
+			nil,
+			"",
+			*sample.CrosschainFlags(),
+			sample.HeaderSupportedChains(),
+			false,
+			zerolog.Nop(),
+		)
+	}
+
+	// newOrchestrator creates an orchestrator running ethereum
+	newOrchestrator := func(
+		appContext *context.AppContext,
+		startObservers bool,
+	) (*Orchestrator, *mocks.EVMObserver, *mockChainBuilder) {
+		ethObserver := mocks.NewEVMObserver(ethChainParams)
+		builder := newMockChainBuilder()
+		oc := &Orchestrator{
+			signerMap: map[int64]interfaces.ChainSigner{
+				ethChain.ChainId: mocks.NewEVMSigner(ethChain, ethcommon.Address{}, ethcommon.Address{}),
+			},
+			observerMap: map[int64]interfaces.ChainObserver{
+				ethChain.ChainId: ethObserver,
+			},
+			logger: Log{Std: zerolog.Nop(), Sampled: zerolog.Nop()},
+			stop:   make(chan struct{}),
+		}
+		oc.WithChainBuilder(builder, startObservers)
+		oc.recordChainConfigs(appContext)
+		return oc, ethObserver, builder
+	}
+
+	t.Run("should keep the running chains unchanged", func(t *testing.T) {
+		appContext := newAppContext()
+		enableChains(appContext, ethChain)
+		oc, ethObserver, builder := newOrchestrator(appContext, true)
+
+		oc.ReconcileChains(appContext)
+		require.Len(t, oc.observerMap, 1)
+		require.Equal(t, ethObserver, oc.observerMap[ethChain.ChainId])
+		require.False(t, ethObserver.Stopped)
+		require.Empty(t, builder.observers)
+		require.Empty(t, builder.signers)
+	})
+	t.Run("should start the observer and signer of a newly enabled chain", func(t *testing.T) {
+		appContext := newAppContext()
+		enableChains(appContext, ethChain, bscChain)
+		oc, _, builder := newOrchestrator(appContext, true)
+
+		oc.ReconcileChains(appContext)
+		require.Len(t, oc.observerMap, 2)
+		require.Len(t, oc.signerMap, 2)
+		require.Equal(t, builder.observers[bscChain.ChainId], oc.observerMap[bscChain.ChainId])
+		require.Equal(t, builder.signers[bscChain.ChainId], oc.signerMap[bscChain.ChainId])
+		require.True(t, builder.observers[bscChain.ChainId].Started)
+	})
+	t.Run("should not start the observer of a newly enabled chain if the node is not active", func(t *testing.T) {
+		appContext := newAppContext()
+		enableChains(appContext, ethChain, bscChain)
+		oc, _, builder := newOrchestrator(appContext, false)
+
+		oc.ReconcileChains(appContext)
+		require.Len(t, oc.observerMap, 2)
+		require.False(t, builder.observers[bscChain.ChainId].Started)
+	})
+	t.Run("should not start a chain whose chain params are not fetched yet", func(t *testing.T) {
+		appContext := newAppContext()
+		enableChains(appContext, ethChain, bscChain)
+		oc, _, builder := newOrchestrator(appContext, true)
+
+		// polygon is configured after the last zetacore context update
+		cfg := appContext.Config()
+		cfg.EVMChainConfigs = cfg.GetAllEVMConfigs()
+		cfg.EVMChainConfigs[chains.Polygon.ChainId] = config.EVMConfig{Chain: chains.Polygon}
+		appContext.UpdateChainConfigs(cfg)
+		enableChains(appContext, ethChain, chains.Polygon)
+
+		oc.ReconcileChains(appContext)
+		require.Len(t, oc.observerMap, 1)
+		require.NotContains(t, builder.observers, chains.Polygon.ChainId)
+	})
+	t.Run("should stop the observer and signer of a chain no longer enabled", func(t *testing.T) {
+		appContext := newAppContext()
+		enableChains(appContext, bscChain)
+		oc, ethObserver, _ := newOrchestrator(appContext, true)
+
+		oc.ReconcileChains(appContext)
+		require.True(t, ethObserver.Stopped)
+		require.NotContains(t, oc.observerMap, ethChain.ChainId)
+		require.NotContains(t, oc.signerMap, ethChain.ChainId)
+		require.Contains(t, oc.observerMap, bscChain.ChainId)
+	})
+	t.Run("should stop the observer and signer of a chain removed from the config", func(t *testing.T) {
+		appContext := newAppContext()
+		enableChains(appContext, ethChain)
+		oc, ethObserver, _ := newOrchestrator(appContext, true)
+
+		cfg := appContext.Config()
+		cfg.EVMChainConfigs = map[int64]config.EVMConfig{}
+		appContext.UpdateChainConfigs(cfg)
+
+		oc.ReconcileChains(appContext)
+		require.True(t, ethObserver.Stopped)
+		require.Empty(t, oc.observerMap)
+		require.Empty(t, oc.signerMap)
+	})
+	t.Run("should start the observers and signers of all configured bitcoin chains", func(t *testing.T) {
+		cfg := config.NewConfig()
+		cfg.BTCChainConfigs[chains.BitcoinMainnet.ChainId] = config.BTCConfig{RPCHost: "bitcoin:8332"}
+		cfg.BTCChainConfigs[chains.BitcoinSignetTestnet.ChainId] = config.BTCConfig{RPCHost: "signet:38332"}
+		appContext := context.NewAppContext(context.NewZetacoreContext(cfg), cfg)
+		appContext.ZetacoreContext().Update(
+			&observertypes.Keygen{},
+			[]chains.Chain{chains.BitcoinMainnet, chains.BitcoinSignetTestnet, chains.BitcoinTestnet},
+			[]chains.Chain{},
+			nil,
+			map[int64]*observertypes.ChainParams{
+				chains.BitcoinMainnet.ChainId:       {ChainId: chains.BitcoinMainnet.ChainId},
+				chains.BitcoinSignetTestnet.ChainId: {ChainId: chains.BitcoinSignetTestnet.ChainId},
+				chains.BitcoinTestnet.ChainId:       {ChainId: chains.BitcoinTestnet.ChainId},
+			},
+			nil,
+			"",
+			*sample.CrosschainFlags(),
+			sample.HeaderSupportedChains(),
+			false,
+			zerolog.Nop(),
+		)
+		require.Equal(
+			t,
+			[]chains.Chain{chains.BitcoinMainnet, chains.BitcoinSignetTestnet},
+			appContext.GetEnabledBTCChains(),
+		)
+
+		// bitcoin testnet is enabled on zetacore but not configured
+		oc, ethObserver, builder := newOrchestrator(appContext, true)
+		oc.ReconcileChains(appContext)
+		require.True(t, ethObserver.Stopped)
+		require.Len(t, oc.observerMap, 2)
+		require.Len(t, oc.signerMap, 2)
+		require.Equal(t, builder.observers[chains.BitcoinMainnet.ChainId], oc.observerMap[chains.BitcoinMainnet.ChainId])
+		require.Equal(
+			t,
+			builder.observers[chains.BitcoinSignetTestnet.ChainId],
+			oc.observerMap[chains.BitcoinSignetTestnet.ChainId],
+		)
+		require.NotContains(t, oc.observerMap, chains.BitcoinTestnet.ChainId)
+	})
+	t.Run("should restart a chain whose endpoint changed", func(t *testing.T) {
+		appContext := newAppContext()
+		enableChains(appContext, ethChain)
+		oc, ethObserver, builder := newOrchestrator(appContext, true)
+
+		cfg := appContext.Config()
+		cfg.EVMChainConfigs = cfg.GetAllEVMConfigs()
+		cfg.EVMChainConfigs[ethChain.ChainId] = config.EVMConfig{Chain: ethChain, Endpoint: "http://eth-new"}
+		appContext.UpdateChainConfigs(cfg)
+
+		oc.ReconcileChains(appContext)
+		require.True(t, ethObserver.Stopped)
+		require.Equal(t, builder.observers[ethChain.ChainId], oc.observerMap[ethChain.ChainId])
+		require.Equal(t, builder.signers[ethChain.ChainId], oc.signerMap[ethChain.ChainId])
+		require.True(t, builder.observers[ethChain.ChainId].Started)
+
+		// the rebuilt chain is not restarted again
+		oc.ReconcileChains(appContext)
+		require.False(t, builder.observers[ethChain.ChainId].Stopped)
+	})
+	t.Run("should reconcile the chains at every zetacore context update", func(t *testing.T) {
+		appContext := newAppContext()
+		enableChains(appContext, ethChain)
+		oc, _, _ := newOrchestrator(appContext, true)
+		<-appContext.ZetacoreContext().Updated()
+
+		go oc.StartChainReconciler(appContext)
+		defer close(oc.stop)
+
+		enableChains(appContext, ethChain, bscChain)
+		require.Eventually(t, func() bool {
+			oc.mu.RLock()
+			defer oc.mu.RUnlock()
+			_, found := oc.observerMap[bscChain.ChainId]
+			return found
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
@@ -0,0 +1,233 @@
+package orchestrator
+
+import (
+	"reflect"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/context"
+)
+
+// ChainBuilder builds the observer and signer of an external chain from the app context
+type ChainBuilder interface {
+	// NewObserver creates the observer of the chain, with its database initialized and last scanned block loaded
+	NewObserver(appContext *context.AppContext, chain chains.Chain) (interfaces.ChainObserver, error)
+
+	// NewSigner creates the signer of the chain
+	NewSigner(appContext *context.AppContext, chain chains.Chain) (interfaces.ChainSigner, error)
+}
+
+// WithChainBuilder sets the builder used to start the observers and signers of newly enabled chains
+// the new observers are only started if 'startObservers' is true, i.e. the node is an active observer
+func (oc *Orchestrator) WithChainBuilder(builder ChainBuilder, startObservers bool) *Orchestrator {
+	oc.builder = builder
+	oc.startObservers = startObservers
+	return oc
+}
+
+// StartChainReconciler reloads the chain configs from disk and reconciles the observers and signers
+// against the enabled chains at every zetacore context update
+func (oc *Orchestrator) StartChainReconciler(appContext *context.AppContext) {
+	for {
+		select {
+		case <-oc.stop:
+			oc.logger.Std.Warn().Msg("StartChainReconciler: stopped")
+			return
+		case <-appContext.ZetacoreContext().Updated():
+			reloaded, err := config.Load(appContext.Config().ZetaCoreHome)
+			if err != nil {
+				oc.logger.Std.Error().Err(err).Msg("StartChainReconciler: failed to reload config")
+			} else {
+				appContext.UpdateChainConfigs(reloaded)
+			}
+			oc.ReconcileChains(appContext)
+		}
+	}
+}
+
+// ReconcileChains starts the observers and signers of the enabled external chains that are not running yet,
+// stops the ones of the chains no longer enabled or configured, and rebuilds the ones whose config changed
+// the observers and signers are built, started and stopped outside the lock, the maps are only swapped under it
+func (oc *Orchestrator) ReconcileChains(appContext *context.AppContext) {
+	cfg := appContext.Config()
+	coreContext := appContext.ZetacoreContext()
+
+	// the chains enabled on zetacore, configured in the config file and whose chain params are known
+	enabled := make(map[int64]chains.Chain)
+	for _, chain := range coreContext.GetEnabledExternalChains() {
+		if _, found := chainConfig(cfg, chain); !found {
+			continue
+		}
+		if !hasChainParams(coreContext, chain) {
+			continue
+		}
+		enabled[chain.ChainId] = chain
+	}
+
+	// remove the chains no longer enabled or whose config changed, e.g. the endpoints
+	// and list the observers and signers missing
+	oc.mu.Lock()
+	if oc.chainConfigs == nil {
+		oc.chainConfigs = make(map[int64]interface{})
+	}
+	removed := make(map[int64]interfaces.ChainObserver)
+	for _, chainID := range oc.runningChainIDs() {
+		if _, found := enabled[chainID]; !found {
+			oc.logger.Std.Info().Msgf("ReconcileChains: chain %d is no longer enabled, stopping it", chainID)
+			removed[chainID] = oc.removeChain(chainID)
+		}
+	}
+	missingSigners := make(map[int64]chains.Chain)
+	missingObservers := make(map[int64]chains.Chain)
+	for chainID, chain := range enabled {
+		chainCfg, _ := chainConfig(cfg, chain)
+		if prevCfg, found := oc.chainConfigs[chainID]; found && !reflect.DeepEqual(prevCfg, chainCfg) {
+			oc.logger.Std.Info().Msgf("ReconcileChains: config of chain %d changed, restarting it", chainID)
+			removed[chainID] = oc.removeChain(chainID)
+		}
+		oc.chainConfigs[chainID] = chainCfg
+
+		if _, found := oc.signerMap[chainID]; !found {
+			missingSigners[chainID] = chain
+		}
+		if _, found := oc.observerMap[chainID]; !found {
+			missingObservers[chainID] = chain
+		}
+	}
+	oc.mu.Unlock()
+
+	for _, observer := range removed {
+		if observer != nil {
+			observer.Stop()
+		}
+	}
+
+	// build the observers and signers missing
+	signers := make(map[int64]interfaces.ChainSigner)
+	for chainID, chain := range missingSigners {
+		signer, err := oc.builder.NewSigner(appContext, chain)
+		if err != nil {
+			oc.logger.Std.Error().Err(err).Msgf("ReconcileChains: NewSigner failed for chain %d", chainID)
+			continue
+		}
+		signers[chainID] = signer
+	}
+	observers := make(map[int64]interfaces.ChainObserver)
+	for chainID, chain := range missingObservers {
+		observer, err := oc.builder.NewObserver(appContext, chain)
+		if err != nil {
+			oc.logger.Std.Error().Err(err).Msgf("ReconcileChains: NewObserver failed for chain %d", chainID)
+			continue
+		}
+		observers[chainID] = observer
+	}
+
+	// add them unless they were added in the meantime
+	var added []interfaces.ChainObserver
+	oc.mu.Lock()
+	for chainID, signer := range signers {
+		if _, found := oc.signerMap[chainID]; !found {
+			oc.signerMap[chainID] = signer
+			oc.logger.Std.Info().Msgf("ReconcileChains: added signer for chain %d", chainID)
+		}
+	}
+	for chainID, observer := range observers {
+		if _, found := oc.observerMap[chainID]; !found {
+			oc.observerMap[chainID] = observer
+			added = append(added, observer)
+			oc.logger.Std.Info().Msgf("ReconcileChains: added observer for chain %d", chainID)
+		}
+	}
+	oc.mu.Unlock()
+
+	if oc.startObservers {
+		for _, observer := range added {
+			observer.Start()
+		}
+	}
+}
+
+// StopChainObservers stops the observers of all chains
+func (oc *Orchestrator) StopChainObservers() {
+	oc.mu.RLock()
+	defer oc.mu.RUnlock()
+	for _, observer := range oc.observerMap {
+		observer.Stop()
+	}
+}
+
+// recordChainConfigs records the configs the observers and signers created at startup were built with
+func (oc *Orchestrator) recordChainConfigs(appContext *context.AppContext) {
+	cfg := appContext.Config()
+	additionalChains := appContext.ZetacoreContext().GetAdditionalChains()
+
+	oc.mu.Lock()
+	defer oc.mu.Unlock()
+	oc.chainConfigs = make(map[int64]interface{})
+	for _, chainID := range oc.runningChainIDs() {
+		chain := chains.GetChainFromChainID(chainID, additionalChains)
+		if chain == nil {
+			continue
+		}
+		if chainCfg, found := chainConfig(cfg, *chain); found {
+			oc.chainConfigs[chainID] = chainCfg
+		}
+	}
+}
+
+// runningChainIDs returns the ids of the chains having an observer or a signer, the lock must be held
+func (oc *Orchestrator) runningChainIDs() []int64 {
+	chainIDs := make([]int64, 0, len(oc.observerMap))
+	for chainID := range oc.observerMap {
+		chainIDs = append(chainIDs, chainID)
+	}
+	for chainID := range oc.signerMap {
+		if _, found := oc.observerMap[chainID]; !found {
+			chainIDs = append(chainIDs, chainID)
+		}
+	}
+	return chainIDs
+}
+
+// removeChain drops the observer and signer of the chain and returns the observer to stop if any,
+// the lock must be held
+func (oc *Orchestrator) removeChain(chainID int64) interfaces.ChainObserver {
+	observer := oc.observerMap[chainID]
+	delete(oc.observerMap, chainID)
+	delete(oc.signerMap, chainID)
+	delete(oc.chainConfigs, chainID)
+	return observer
+}
+
+// chainConfig returns the config of the external chain in the config file
+func chainConfig(cfg config.Config, chain chains.Chain) (interface{}, bool) {
+	switch {
+	case chain.IsEVMChain():
+		return cfg.GetEVMConfig(chain.ChainId)
+	case chain.IsBitcoinChain():
+		return cfg.GetBTCConfig(chain.ChainId)
+	case chain.IsSolanaChain():
+		return cfg.GetSolanaConfig()
+	default:
+		return nil, false
+	}
+}
+
+// hasChainParams returns true if the chain params of the chain have been fetched from zetacore
+func hasChainParams(coreContext *context.ZetacoreContext, chain chains.Chain) bool {
+	chainID := chain.ChainId
+	switch {
+	case chain.IsEVMChain():
+		params, found := coreContext.GetEVMChainParams(chainID)
+		return found && params.ChainId == chainID
+	case chain.IsBitcoinChain():
+		_, _, found := coreContext.GetBTCChainParams(chainID)
+		return found
+	case chain.IsSolanaChain():
+		_, params, found := coreContext.GetSolanaChainParams()
+		return found && params.ChainId == chainID
+	default:
+		return false
+	}
+}
@@ -0,0 +1,217 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+)
+
+const (
+	// zetacoreEventsSubscriber is the subscriber name of the orchestrator on the zetacore websocket
+	zetacoreEventsSubscriber = "zetaclient-orchestrator"
+
+	// zetacoreEventsCapacity is the capacity of the subscription channels, the events are dropped when full
+	zetacoreEventsCapacity = 1000
+
+	// zetacoreEventsRetryInterval is the interval to wait before resubscribing after an interruption
+	zetacoreEventsRetryInterval = 10 * time.Second
+
+	// pendingCctxResyncInterval is the number of blocks after which the pending cctx index is fully resynced
+	// it recovers the events dropped by the websocket client and the cctx updates without typed event
+	pendingCctxResyncInterval = 100
+
+	// txEventsDelay is the number of blocks to wait for the tx events of a block before counting them as dropped
+	txEventsDelay = 2
+)
+
+var (
+	// queryNewBlock and queryTx are the queries of the new block and tx events subscriptions
+	queryNewBlock = tmtypes.QueryForEvent(tmtypes.EventNewBlock).String()
+	queryTx       = tmtypes.QueryForEvent(tmtypes.EventTx).String()
+
+	// cctxEventAttributes are the attributes of the crosschain typed events creating or updating a cctx
+	cctxEventAttributes = []string{
+		eventAttribute(&types.EventInboundFinalized{}, "cctx_index"),
+		eventAttribute(&types.EventZrcWithdrawCreated{}, "cctx_index"),
+		eventAttribute(&types.EventZetaWithdrawCreated{}, "cctx_index"),
+		eventAttribute(&types.EventOutboundSuccess{}, "cctx_index"),
+		eventAttribute(&types.EventOutboundFailure{}, "cctx_index"),
+		eventAttribute(&types.EventCCTXGasPriceIncreased{}, "cctx_index"),
+		eventAttribute(&types.EventERC20Whitelist{}, "whitelist_cctx_index"),
+	}
+)
+
+// ZetacoreEventsDialer connects to the zetacore websocket
+type ZetacoreEventsDialer func() (interfaces.ZetacoreEventsClient, error)
+
+// WithZetacoreEvents sets the dialer of the zetacore websocket, the cctx scheduler is then driven by
+// the new block events and the pending cctxs are tracked from the crosschain typed events
+func (oc *Orchestrator) WithZetacoreEvents(dial ZetacoreEventsDialer) *Orchestrator {
+	oc.eventsDial = dial
+	return oc
+}
+
+// StartZetacoreEventsWatcher watches the zetacore events and forwards the new block heights to 'blocks'
+// the subscription is restarted after every interruption until the orchestrator stops
+func (oc *Orchestrator) StartZetacoreEventsWatcher(blocks chan<- int64) {
+	for {
+		err := oc.WatchZetacoreEvents(blocks)
+		if err == nil {
+			oc.logger.Std.Info().Msg("StartZetacoreEventsWatcher: stopped")
+			return
+		}
+		oc.logger.Std.Error().Err(err).Msg("StartZetacoreEventsWatcher: subscription interrupted, falling back to polling")
+
+		select {
+		case <-oc.stop:
+			oc.logger.Std.Info().Msg("StartZetacoreEventsWatcher: stopped")
+			return
+		case <-time.After(zetacoreEventsRetryInterval):
+		}
+	}
+}
+
+// WatchZetacoreEvents subscribes to the zetacore new block and tx events, records the cctxs updated by
+// the events in the pending cctx index and forwards the new block heights to 'blocks'
+// it returns an error when the subscription is interrupted, and nil when the orchestrator stops
+func (oc *Orchestrator) WatchZetacoreEvents(blocks chan<- int64) error {
+	client, err := oc.eventsDial()
+	if err != nil {
+		return fmt.Errorf("failed to dial zetacore websocket: %w", err)
+	}
+	defer func() {
+		if err := client.Stop(); err != nil {
+			oc.logger.Std.Error().Err(err).Msg("WatchZetacoreEvents: error stopping websocket client")
+		}
+	}()
+
+	// the index is resynced once the subscription restarts
+	defer oc.cctxIndex.Invalidate()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// subscribe to the txs first, the txs of the first block received are then all observed
+	txEvents, err := client.Subscribe(ctx, zetacoreEventsSubscriber, queryTx, zetacoreEventsCapacity)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to tx events: %w", err)
+	}
+	blockEvents, err := client.Subscribe(ctx, zetacoreEventsSubscriber, queryNewBlock, zetacoreEventsCapacity)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to new block events: %w", err)
+	}
+	oc.logger.Std.Info().Msg("WatchZetacoreEvents: subscribed to zetacore events")
+
+	// txCounts are the numbers of tx events received per height, blockTxs the numbers of txs per block
+	txCounts := make(map[int64]int)
+	blockTxs := make(map[int64]int)
+
+	for {
+		select {
+		case <-oc.stop:
+			return nil
+		case event, ok := <-txEvents:
+			if !ok {
+				return fmt.Errorf("tx events subscription closed")
+			}
+			oc.cctxIndex.MarkUpdated(CctxIndexesFromEvents(event.Events)...)
+			if height, found := txHeightFromEvents(event.Events); found {
+				txCounts[height]++
+			}
+		case event, ok := <-blockEvents:
+			if !ok {
+				return fmt.Errorf("new block events subscription closed")
+			}
+			data, ok := event.Data.(tmtypes.EventDataNewBlock)
+			if !ok || data.Block == nil {
+				return fmt.Errorf("invalid new block event data %T", event.Data)
+			}
+			height := data.Block.Height
+
+			// begin and end block events
+			oc.cctxIndex.MarkUpdated(CctxIndexesFromEvents(event.Events)...)
+			if !oc.cctxIndex.AddBlock(height) {
+				oc.logger.Std.Warn().Msgf("WatchZetacoreEvents: gap before block %d, resyncing pending cctxs", height)
+			}
+
+			// the tx events of a block are published after the block, they are counted a few blocks later
+			blockTxs[height] = len(data.Block.Txs)
+			oc.checkDroppedTxEvents(height-txEventsDelay, txCounts, blockTxs)
+
+			select {
+			case <-oc.stop:
+				return nil
+			case blocks <- height:
+			}
+		}
+	}
+}
+
+// checkDroppedTxEvents compares the tx events received up to the height with the txs of the blocks
+// the dropped tx events are recorded in the pending cctx index, which is then resynced on the next listing
+func (oc *Orchestrator) checkDroppedTxEvents(height int64, txCounts, blockTxs map[int64]int) {
+	for h, expected := range blockTxs {
+		if h > height {
+			continue
+		}
+		if received := txCounts[h]; received < expected {
+			// #nosec G701 always positive
+			dropped := uint64(expected - received)
+			oc.cctxIndex.MarkDropped(dropped)
+			metrics.ZetacoreEventsDropped.Add(float64(dropped))
+			oc.logger.Std.Warn().
+				Msgf("WatchZetacoreEvents: %d tx events dropped at block %d, resyncing pending cctxs", dropped, h)
+		}
+		delete(blockTxs, h)
+	}
+	for h := range txCounts {
+		if h <= height {
+			delete(txCounts, h)
+		}
+	}
+}
+
+// txHeightFromEvents returns the height of the tx of the tx events
+func txHeightFromEvents(events map[string][]string) (int64, bool) {
+	values := events[tmtypes.TxHeightKey]
+	if len(values) == 0 {
+		return 0, false
+	}
+	height, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return height, true
+}
+
+// CctxIndexesFromEvents returns the indexes of the cctxs created or updated by the events of a block or tx
+func CctxIndexesFromEvents(events map[string][]string) []string {
+	cctxIndexes := make([]string, 0)
+	for _, attribute := range cctxEventAttributes {
+		for _, value := range events[attribute] {
+			// typed event attributes are JSON encoded
+			var cctxIndex string
+			if err := json.Unmarshal([]byte(value), &cctxIndex); err != nil {
+				cctxIndex = value
+			}
+			if cctxIndex != "" {
+				cctxIndexes = append(cctxIndexes, cctxIndex)
+			}
+		}
+	}
+	return cctxIndexes
+}
+
+// eventAttribute returns the composite key of the attribute of a typed event
+func eventAttribute(event proto.Message, attribute string) string {
+	return proto.MessageName(event) + "." + attribute
+}
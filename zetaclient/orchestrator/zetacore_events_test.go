@@ -0,0 +1,231 @@
+package orchestrator
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils/mocks"
+)
+
+// newEventsOrchestrator creates an orchestrator subscribing to the zetacore events of the mock client
+func newEventsOrchestrator(
+	zetacoreClient interfaces.ZetacoreClient,
+	eventsClient *mocks.MockZetacoreEventsClient,
+) *Orchestrator {
+	oc := &Orchestrator{
+		zetacoreClient: zetacoreClient,
+		logger:         Log{Std: zerolog.Nop(), Sampled: zerolog.Nop()},
+		stop:           make(chan struct{}),
+		cctxIndex:      NewPendingCctxIndex(),
+	}
+	return oc.WithZetacoreEvents(func() (interfaces.ZetacoreEventsClient, error) {
+		return eventsClient, nil
+	})
+}
+
+// newBlockEvent creates a new block event at the height with the begin and end block events
+func newBlockEvent(height int64, events map[string][]string) coretypes.ResultEvent {
+	return coretypes.ResultEvent{
+		Query:  queryNewBlock,
+		Data:   tmtypes.EventDataNewBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: height}}},
+		Events: events,
+	}
+}
+
+// isMarkedUpdated returns true if the cctx is marked as updated in the index
+func isMarkedUpdated(idx *PendingCctxIndex, cctxIndex string) bool {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	return idx.updated[cctxIndex]
+}
+
+func Test_CctxIndexesFromEvents(t *testing.T) {
+	events := map[string][]string{
+		"zetachain.zetacore.crosschain.EventOutboundSuccess.cctx_index":          {`"0x1"`},
+		"zetachain.zetacore.crosschain.EventInboundFinalized.cctx_index":         {`"0x2"`, `"0x3"`},
+		"zetachain.zetacore.crosschain.EventCCTXGasPriceIncreased.cctx_index":    {"0x4"},
+		"zetachain.zetacore.crosschain.EventERC20Whitelist.whitelist_cctx_index": {`"0x5"`},
+		"zetachain.zetacore.crosschain.EventOutboundSuccess.new_status":          {`"OutboundMined"`},
+		"zetachain.zetacore.crosschain.EventOutboundFailure.cctx_index":          {`""`},
+		"tx.height": {"10"},
+	}
+	require.ElementsMatch(t, []string{"0x1", "0x2", "0x3", "0x4", "0x5"}, CctxIndexesFromEvents(events))
+	require.Empty(t, CctxIndexesFromEvents(nil))
+}
+
+func Test_WatchZetacoreEvents(t *testing.T) {
+	cctxEvent := func(cctxIndex string) map[string][]string {
+		return map[string][]string{
+			"zetachain.zetacore.crosschain.EventOutboundSuccess.cctx_index": {`"` + cctxIndex + `"`},
+		}
+	}
+
+	t.Run("should forward blocks and mark updated cctxs until interrupted", func(t *testing.T) {
+		eventsClient := mocks.NewMockZetacoreEventsClient()
+		oc := newEventsOrchestrator(mocks.NewMockZetacoreClient(), eventsClient)
+
+		blocks := make(chan int64)
+		errCh := make(chan error)
+		go func() { errCh <- oc.WatchZetacoreEvents(blocks) }()
+
+		eventsClient.Push(newBlockEvent(10, cctxEvent("0x1")))
+		require.EqualValues(t, 10, <-blocks)
+		require.True(t, oc.cctxIndex.IsSubscribed())
+		require.True(t, isMarkedUpdated(oc.cctxIndex, "0x1"))
+
+		eventsClient.Push(coretypes.ResultEvent{Query: queryTx, Events: cctxEvent("0x2")})
+		require.Eventually(t, func() bool {
+			return isMarkedUpdated(oc.cctxIndex, "0x2")
+		}, time.Second, 10*time.Millisecond)
+
+		eventsClient.Push(newBlockEvent(11, nil))
+		require.EqualValues(t, 11, <-blocks)
+
+		// the interruption invalidates the index
+		eventsClient.Interrupt(queryNewBlock)
+		require.Error(t, <-errCh)
+		require.False(t, oc.cctxIndex.IsSubscribed())
+		require.True(t, eventsClient.IsStopped())
+	})
+	t.Run("should resync the index when tx events are dropped", func(t *testing.T) {
+		eventsClient := mocks.NewMockZetacoreEventsClient()
+		oc := newEventsOrchestrator(mocks.NewMockZetacoreClient(), eventsClient)
+
+		blocks := make(chan int64)
+		go func() { _ = oc.WatchZetacoreEvents(blocks) }()
+		defer close(oc.stop)
+
+		// block 10 has 2 txs but a single tx event is received
+		block := newBlockEvent(10, nil)
+		block.Data.(tmtypes.EventDataNewBlock).Block.Txs = tmtypes.Txs{tmtypes.Tx("tx1"), tmtypes.Tx("tx2")}
+		eventsClient.Push(block)
+		require.EqualValues(t, 10, <-blocks)
+		oc.cctxIndex.Resync(0, 10, nil)
+		txEvent := cctxEvent("0x1")
+		txEvent["tx.height"] = []string{"10"}
+		eventsClient.Push(coretypes.ResultEvent{Query: queryTx, Events: txEvent})
+		require.Eventually(t, func() bool {
+			return isMarkedUpdated(oc.cctxIndex, "0x1")
+		}, time.Second, 10*time.Millisecond)
+
+		eventsClient.Push(newBlockEvent(11, nil))
+		require.EqualValues(t, 11, <-blocks)
+		require.Zero(t, oc.cctxIndex.Dropped())
+
+		eventsClient.Push(newBlockEvent(12, nil))
+		require.EqualValues(t, 12, <-blocks)
+		require.EqualValues(t, 1, oc.cctxIndex.Dropped())
+		needsResync, _, _ := oc.cctxIndex.NeedsResync(pendingCctxResyncInterval)
+		require.True(t, needsResync)
+		require.True(t, oc.cctxIndex.IsSubscribed())
+	})
+	t.Run("should return nil when orchestrator stops", func(t *testing.T) {
+		eventsClient := mocks.NewMockZetacoreEventsClient()
+		oc := newEventsOrchestrator(mocks.NewMockZetacoreClient(), eventsClient)
+
+		errCh := make(chan error)
+		go func() { errCh <- oc.WatchZetacoreEvents(make(chan int64)) }()
+
+		close(oc.stop)
+		require.NoError(t, <-errCh)
+		require.True(t, eventsClient.IsStopped())
+	})
+	t.Run("should fail if cannot subscribe", func(t *testing.T) {
+		eventsClient := mocks.NewMockZetacoreEventsClient().WithError(errors.New("connection refused"))
+		oc := newEventsOrchestrator(mocks.NewMockZetacoreClient(), eventsClient)
+
+		require.ErrorContains(t, oc.WatchZetacoreEvents(make(chan int64)), "connection refused")
+		require.True(t, eventsClient.IsStopped())
+	})
+}
+
+func Test_listPendingCctxs(t *testing.T) {
+	ethChain := chains.Ethereum
+	btcChain := chains.BitcoinMainnet
+	foreignChains := []chains.Chain{ethChain, btcChain}
+
+	cctx1 := createCctx("0x1", ethChain.ChainId, 1, crosschaintypes.CctxStatus_PendingOutbound)
+	cctx2 := createCctx("0x2", ethChain.ChainId, 2, crosschaintypes.CctxStatus_PendingOutbound)
+	cctx3 := createCctx("0x3", btcChain.ChainId, 3, crosschaintypes.CctxStatus_PendingOutbound)
+
+	client := mocks.NewMockZetacoreClient().
+		WithPendingCctx(ethChain.ChainId, []*crosschaintypes.CrossChainTx{cctx1}).
+		WithPendingCctx(btcChain.ChainId, []*crosschaintypes.CrossChainTx{cctx3})
+	oc := newEventsOrchestrator(client, mocks.NewMockZetacoreEventsClient())
+
+	t.Run("should list pending cctxs from zetacore without subscription", func(t *testing.T) {
+		cctxsMap := oc.listPendingCctxs(foreignChains)
+		require.Equal(t, []*crosschaintypes.CrossChainTx{cctx1}, cctxsMap[ethChain.ChainId])
+		require.Equal(t, []*crosschaintypes.CrossChainTx{cctx3}, cctxsMap[btcChain.ChainId])
+	})
+	t.Run("should resync index on first block and then refresh updated cctxs", func(t *testing.T) {
+		oc.cctxIndex.AddBlock(100)
+		oc.listPendingCctxs(foreignChains)
+		needsResync, _, _ := oc.cctxIndex.NeedsResync(pendingCctxResyncInterval)
+		require.False(t, needsResync)
+
+		// cctx1 is mined and cctx2 is created, the pending cctxs listed by zetacore are not queried again
+		client.WithPendingCctx(ethChain.ChainId, nil)
+		client.WithCctx(createCctx("0x1", ethChain.ChainId, 1, crosschaintypes.CctxStatus_OutboundMined))
+		client.WithCctx(cctx2)
+		oc.cctxIndex.MarkUpdated("0x1", "0x2")
+		oc.cctxIndex.AddBlock(101)
+
+		cctxsMap := oc.listPendingCctxs(foreignChains)
+		require.Equal(t, []*crosschaintypes.CrossChainTx{cctx2}, cctxsMap[ethChain.ChainId])
+		require.Equal(t, []*crosschaintypes.CrossChainTx{cctx3}, cctxsMap[btcChain.ChainId])
+	})
+	t.Run("should resync index if a cctx cannot be fetched", func(t *testing.T) {
+		oc.cctxIndex.MarkUpdated("0x9")
+		oc.cctxIndex.AddBlock(102)
+
+		cctxsMap := oc.listPendingCctxs(foreignChains)
+		require.Empty(t, cctxsMap[ethChain.ChainId])
+		require.Equal(t, []*crosschaintypes.CrossChainTx{cctx3}, cctxsMap[btcChain.ChainId])
+	})
+}
+
+func Test_syncPendingCctxIndex(t *testing.T) {
+	ethChain := chains.Ethereum
+	cctx1 := createCctx("0x1", ethChain.ChainId, 1, crosschaintypes.CctxStatus_PendingOutbound)
+	cctx2 := createCctx("0x2", ethChain.ChainId, 2, crosschaintypes.CctxStatus_PendingOutbound)
+	withinRatelimit := map[int64][]*crosschaintypes.CrossChainTx{ethChain.ChainId: {cctx1, cctx2}}
+
+	client := mocks.NewMockZetacoreClient()
+	oc := newEventsOrchestrator(client, mocks.NewMockZetacoreEventsClient())
+	oc.cctxIndex.AddBlock(100)
+
+	t.Run("should resync the index from a complete rate limiter input", func(t *testing.T) {
+		resp := &crosschaintypes.QueryRateLimiterInputResponse{
+			CctxsMissed:  []*crosschaintypes.CrossChainTx{cctx1},
+			CctxsPending: []*crosschaintypes.CrossChainTx{cctx2},
+			TotalPending: 2,
+		}
+		require.Equal(t, withinRatelimit, oc.syncPendingCctxIndex(resp, withinRatelimit))
+		needsResync, _, _ := oc.cctxIndex.NeedsResync(pendingCctxResyncInterval)
+		require.False(t, needsResync)
+		require.Len(t, oc.cctxIndex.PendingCctxs(ethChain.ChainId), 2)
+	})
+	t.Run("should drop the cctxs finalized since the rate limiter input", func(t *testing.T) {
+		// the input lists only part of the pending cctxs, the index is refreshed instead
+		resp := &crosschaintypes.QueryRateLimiterInputResponse{
+			CctxsPending: []*crosschaintypes.CrossChainTx{cctx1, cctx2},
+			TotalPending: 3,
+		}
+		client.WithCctx(createCctx("0x1", ethChain.ChainId, 1, crosschaintypes.CctxStatus_OutboundMined))
+		oc.cctxIndex.MarkUpdated("0x1")
+		oc.cctxIndex.AddBlock(101)
+
+		cctxsMap := oc.syncPendingCctxIndex(resp, withinRatelimit)
+		require.Equal(t, []*crosschaintypes.CrossChainTx{cctx2}, cctxsMap[ethChain.ChainId])
+	})
+}
@@ -2,6 +2,8 @@ package outboundprocessor
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -63,7 +65,35 @@ func (p *Processor) TimeInTryProcess(outboundID string) time.Duration {
 	return 0
 }
 
+// ActiveOutbounds returns the outbounds being processed and the time elapsed since they started
+func (p *Processor) ActiveOutbounds() map[string]time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	active := make(map[string]time.Duration, len(p.outboundActive))
+	for outboundID := range p.outboundActive {
+		active[outboundID] = time.Since(p.outboundStartTime[outboundID])
+	}
+	return active
+}
+
 // ToOutboundID returns the outbound ID for OutboundProcessorManager to track
 func ToOutboundID(index string, receiverChainID int64, nonce uint64) string {
 	return fmt.Sprintf("%s-%d-%d", index, receiverChainID, nonce)
 }
+
+// ParseOutboundID parses the cctx index, receiver chain id and nonce of the outbound ID, see ToOutboundID
+func ParseOutboundID(outboundID string) (string, int64, uint64, error) {
+	parts := strings.Split(outboundID, "-")
+	if len(parts) < 3 {
+		return "", 0, 0, fmt.Errorf("invalid outbound id %s", outboundID)
+	}
+	chainID, err := strconv.ParseInt(parts[len(parts)-2], 10, 64)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("invalid chain id in outbound id %s: %w", outboundID, err)
+	}
+	nonce, err := strconv.ParseUint(parts[len(parts)-1], 10, 64)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("invalid nonce in outbound id %s: %w", outboundID, err)
+	}
+	return strings.Join(parts[:len(parts)-2], "-"), chainID, nonce, nil
+}
@@ -1 +1,38 @@
 package outboundprocessor_test
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/zetaclient/outboundprocessor"
+)
+
+func TestProcessor_ActiveOutbounds(t *testing.T) {
+	p := outboundprocessor.NewProcessor(zerolog.Nop())
+	p.StartTryProcess("0x01-1-10")
+	p.StartTryProcess("0x02-1-11")
+	p.EndTryProcess("0x01-1-10")
+
+	active := p.ActiveOutbounds()
+	require.Len(t, active, 1)
+	require.Contains(t, active, "0x02-1-11")
+}
+
+func TestParseOutboundID(t *testing.T) {
+	t.Run("should parse outbound id", func(t *testing.T) {
+		outboundID := outboundprocessor.ToOutboundID("0xabc", 8332, 42)
+		index, chainID, nonce, err := outboundprocessor.ParseOutboundID(outboundID)
+		require.NoError(t, err)
+		require.Equal(t, "0xabc", index)
+		require.Equal(t, int64(8332), chainID)
+		require.Equal(t, uint64(42), nonce)
+	})
+	t.Run("should fail on invalid outbound id", func(t *testing.T) {
+		for _, outboundID := range []string{"0xabc", "0xabc-1", "0xabc-x-1", "0xabc-1-x"} {
+			_, _, _, err := outboundprocessor.ParseOutboundID(outboundID)
+			require.Error(t, err, outboundID)
+		}
+	})
+}
@@ -0,0 +1,294 @@
+package outboundprocessor
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+)
+
+// Priority is the priority of an outbound keysign, the keysigns of higher priority are started first
+type Priority int
+
+const (
+	// PriorityNormal is the priority of the outbounds of the following nonces
+	PriorityNormal Priority = iota
+
+	// PriorityRevert is the priority of the reverts and refunds
+	PriorityRevert
+
+	// PriorityLowestNonce is the priority of the outbound of the lowest pending nonce, blocking the following nonces
+	PriorityLowestNonce
+)
+
+// Keysign is an outbound keysign queued in the scheduler
+type Keysign struct {
+	OutboundID string
+	ChainID    int64
+	Nonce      uint64
+	Priority   Priority
+
+	// BatchOutboundIDs are the outbounds of the following nonces paid by the same (batched) keysign,
+	// they are marked active along with the outbound and ended when the keysign is done
+	BatchOutboundIDs []string
+
+	// ZetaHeight is the zeta height the keysign is scheduled at, all the TSS signers join the keysign at this height
+	// the keysign is dropped if the zeta height moves past it before the keysign is started
+	ZetaHeight uint64
+
+	// Run signs and broadcasts the outbound at the scheduled zeta height,
+	// it must end the outbound processing with EndTryProcess when done
+	Run func()
+
+	queuedAt time.Time
+}
+
+// Scheduler starts the outbound keysigns within a global and a per-chain concurrency budget
+// The queued keysigns of a chain are started by priority then nonce,
+// the chains are served in round-robin among the keysigns of the same priority
+type Scheduler struct {
+	processor *Processor
+
+	// maxKeysigns and maxKeysignsPerChain are the concurrency budgets, unlimited if 0
+	maxKeysigns         int
+	maxKeysignsPerChain int
+
+	mu           sync.Mutex
+	queues       map[int64][]*Keysign
+	queued       map[string]*Keysign
+	running      int
+	chainRunning map[int64]int
+	lastChainID  int64
+	zetaHeight   uint64
+	logger       zerolog.Logger
+}
+
+// NewScheduler creates a keysign scheduler marking the started outbounds as active in the processor
+func NewScheduler(processor *Processor, maxKeysigns, maxKeysignsPerChain int, logger zerolog.Logger) *Scheduler {
+	return &Scheduler{
+		processor:           processor,
+		maxKeysigns:         maxKeysigns,
+		maxKeysignsPerChain: maxKeysignsPerChain,
+		queues:              make(map[int64][]*Keysign),
+		queued:              make(map[string]*Keysign),
+		chainRunning:        make(map[int64]int),
+		logger:              logger.With().Str("module", "KeysignScheduler").Logger(),
+	}
+}
+
+// Submit queues the keysign of the outbound and starts the keysigns within the budget
+// the keysign of an outbound already queued replaces the queued one, it is ignored if the outbound is active
+// or if its scheduled zeta height has passed
+// returns false if the keysign is ignored
+func (s *Scheduler) Submit(keysign Keysign) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.processor.IsOutboundActive(keysign.OutboundID) || keysign.ZetaHeight < s.zetaHeight {
+		return false
+	}
+	keysign.queuedAt = time.Now()
+	if queued, found := s.queued[keysign.OutboundID]; found {
+		// keep the time the outbound was first queued
+		keysign.queuedAt = queued.queuedAt
+		s.remove(queued)
+	}
+	s.queued[keysign.OutboundID] = &keysign
+	s.queues[keysign.ChainID] = append(s.queues[keysign.ChainID], &keysign)
+	sortKeysigns(s.queues[keysign.ChainID])
+	s.updateQueueDepth(keysign.ChainID)
+
+	s.dispatch()
+	return true
+}
+
+// SetZetaHeight sets the current zeta height and drops the queued keysigns scheduled at a past zeta height
+// the other TSS signers join a keysign at its scheduled height only, a late keysign would time out
+func (s *Scheduler) SetZetaHeight(zetaHeight uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if zetaHeight <= s.zetaHeight {
+		return
+	}
+	s.zetaHeight = zetaHeight
+
+	for _, keysign := range s.queued {
+		if keysign.ZetaHeight < zetaHeight {
+			s.logger.Info().Msgf(
+				"SetZetaHeight: dropping keysign of outbound %s scheduled at zeta height %d",
+				keysign.OutboundID,
+				keysign.ZetaHeight,
+			)
+			s.remove(keysign)
+			s.updateQueueDepth(keysign.ChainID)
+		}
+	}
+}
+
+// PruneChains removes the queued keysigns of the chains that are not in 'chainIDs'
+func (s *Scheduler) PruneChains(chainIDs map[int64]bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for chainID, queue := range s.queues {
+		if chainIDs[chainID] {
+			continue
+		}
+		s.logger.Info().Msgf("PruneChains: chain %d is no longer observed, dropping %d keysigns", chainID, len(queue))
+		for _, keysign := range append([]*Keysign{}, queue...) {
+			s.remove(keysign)
+		}
+		s.updateQueueDepth(chainID)
+	}
+}
+
+// Prune removes the queued keysigns of the chain whose outbound is no longer pending
+func (s *Scheduler) Prune(chainID int64, pending map[string]bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, keysign := range append([]*Keysign{}, s.queues[chainID]...) {
+		if !pending[keysign.OutboundID] {
+			s.logger.Info().Msgf("Prune: outbound %s is no longer pending", keysign.OutboundID)
+			s.remove(keysign)
+		}
+	}
+	s.updateQueueDepth(chainID)
+}
+
+// QueueDepth returns the number of keysigns of the chain waiting for the budget
+func (s *Scheduler) QueueDepth(chainID int64) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.queues[chainID])
+}
+
+// IsQueued returns true if the keysign of the outbound is waiting for the budget
+func (s *Scheduler) IsQueued(outboundID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, found := s.queued[outboundID]
+	return found
+}
+
+// dispatch starts the queued keysigns until the budget is exhausted
+func (s *Scheduler) dispatch() {
+	for s.maxKeysigns <= 0 || s.running < s.maxKeysigns {
+		keysign := s.next()
+		if keysign == nil {
+			return
+		}
+		s.remove(keysign)
+		s.updateQueueDepth(keysign.ChainID)
+		s.lastChainID = keysign.ChainID
+		s.running++
+		s.chainRunning[keysign.ChainID]++
+
+		chainLabel := chainLabel(keysign.ChainID)
+		metrics.KeysignQueueWaitTime.WithLabelValues(chainLabel).Observe(time.Since(keysign.queuedAt).Seconds())
+		metrics.KeysignsInProgress.WithLabelValues(chainLabel).Set(float64(s.chainRunning[keysign.ChainID]))
+
+		// the outbound is marked active before releasing the lock so it can't be submitted again while starting
+		s.processor.StartTryProcess(keysign.OutboundID)
+		for _, outboundID := range keysign.BatchOutboundIDs {
+			if queued, found := s.queued[outboundID]; found {
+				s.remove(queued)
+				s.updateQueueDepth(queued.ChainID)
+			}
+			s.processor.StartTryProcess(outboundID)
+		}
+		go func(keysign *Keysign) {
+			defer s.done(keysign)
+			keysign.Run()
+		}(keysign)
+	}
+}
+
+// next returns the next keysign to start, nil if none is within the budget
+// the keysign of highest priority is selected, the chains are served in round-robin among the same priority
+func (s *Scheduler) next() *Keysign {
+	chainIDs := make([]int64, 0, len(s.queues))
+	for chainID := range s.queues {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Slice(chainIDs, func(i, j int) bool { return chainIDs[i] < chainIDs[j] })
+
+	// start from the chain following the last served chain
+	start := sort.Search(len(chainIDs), func(i int) bool { return chainIDs[i] > s.lastChainID })
+
+	var best *Keysign
+	for i := range chainIDs {
+		chainID := chainIDs[(start+i)%len(chainIDs)]
+		if s.maxKeysignsPerChain > 0 && s.chainRunning[chainID] >= s.maxKeysignsPerChain {
+			continue
+		}
+		head := s.queues[chainID][0]
+		if best == nil || head.Priority > best.Priority {
+			best = head
+		}
+	}
+	return best
+}
+
+// done releases the budget of the keysign and starts the queued keysigns
+func (s *Scheduler) done(keysign *Keysign) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.running--
+	s.chainRunning[keysign.ChainID]--
+	for _, outboundID := range keysign.BatchOutboundIDs {
+		s.processor.EndTryProcess(outboundID)
+	}
+	metrics.KeysignsInProgress.WithLabelValues(chainLabel(keysign.ChainID)).Set(float64(s.chainRunning[keysign.ChainID]))
+	s.logger.Debug().
+		Msgf("done: keysign of outbound %s done, %d keysigns in progress", keysign.OutboundID, s.running)
+
+	s.dispatch()
+}
+
+// remove removes the keysign from the queue of its chain
+func (s *Scheduler) remove(keysign *Keysign) {
+	delete(s.queued, keysign.OutboundID)
+	queue := s.queues[keysign.ChainID]
+	for i, queued := range queue {
+		if queued == keysign {
+			queue = append(queue[:i], queue[i+1:]...)
+			break
+		}
+	}
+	if len(queue) == 0 {
+		delete(s.queues, keysign.ChainID)
+		return
+	}
+	s.queues[keysign.ChainID] = queue
+}
+
+// updateQueueDepth sets the queue depth metric of the chain
+func (s *Scheduler) updateQueueDepth(chainID int64) {
+	metrics.KeysignQueueDepth.WithLabelValues(chainLabel(chainID)).Set(float64(len(s.queues[chainID])))
+}
+
+// sortKeysigns sorts the keysigns by priority (descending), then nonce (ascending)
+func sortKeysigns(keysigns []*Keysign) {
+	sort.SliceStable(keysigns, func(i, j int) bool {
+		if keysigns[i].Priority != keysigns[j].Priority {
+			return keysigns[i].Priority > keysigns[j].Priority
+		}
+		return keysigns[i].Nonce < keysigns[j].Nonce
+	})
+}
+
+// chainLabel returns the metrics label of the chain
+// chains outside the default chains are labeled by their chain id
+func chainLabel(chainID int64) string {
+	if chain := chains.GetChainFromChainID(chainID, []chains.Chain{}); chain != nil {
+		return chain.ChainName.String()
+	}
+	return strconv.FormatInt(chainID, 10)
+}
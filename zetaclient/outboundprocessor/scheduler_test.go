@@ -0,0 +1,254 @@
+package outboundprocessor_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/zetaclient/outboundprocessor"
+)
+
+// keysignRecorder records the order the keysigns are started and holds them until released
+type keysignRecorder struct {
+	processor *outboundprocessor.Processor
+	mu        sync.Mutex
+	started   []string
+	heights   []uint64
+	release   chan struct{}
+}
+
+func newKeysignRecorder() *keysignRecorder {
+	return &keysignRecorder{
+		processor: outboundprocessor.NewProcessor(zerolog.Nop()),
+		release:   make(chan struct{}),
+	}
+}
+
+func (r *keysignRecorder) keysign(chainID int64, nonce uint64, priority outboundprocessor.Priority) outboundprocessor.Keysign {
+	return r.keysignAt(chainID, nonce, priority, 0)
+}
+
+func (r *keysignRecorder) keysignAt(
+	chainID int64,
+	nonce uint64,
+	priority outboundprocessor.Priority,
+	zetaHeight uint64,
+) outboundprocessor.Keysign {
+	outboundID := outboundprocessor.ToOutboundID("0x", chainID, nonce)
+	return outboundprocessor.Keysign{
+		OutboundID: outboundID,
+		ChainID:    chainID,
+		Nonce:      nonce,
+		Priority:   priority,
+		ZetaHeight: zetaHeight,
+		Run: func() {
+			r.mu.Lock()
+			r.started = append(r.started, outboundID)
+			r.heights = append(r.heights, zetaHeight)
+			r.mu.Unlock()
+			<-r.release
+			r.processor.EndTryProcess(outboundID)
+		},
+	}
+}
+
+// waitStarted waits until n keysigns are started and returns them
+func (r *keysignRecorder) waitStarted(t *testing.T, n int) []string {
+	require.Eventually(t, func() bool {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return len(r.started) >= n
+	}, time.Second, time.Millisecond)
+
+	// the keysigns beyond n must not start
+	time.Sleep(10 * time.Millisecond)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	require.Len(t, r.started, n)
+	return append([]string{}, r.started...)
+}
+
+// releaseOne lets one keysign in progress finish
+func (r *keysignRecorder) releaseOne() {
+	r.release <- struct{}{}
+}
+
+func id(chainID int64, nonce uint64) string {
+	return outboundprocessor.ToOutboundID("0x", chainID, nonce)
+}
+
+func TestScheduler(t *testing.T) {
+	t.Run("should start keysigns without budget", func(t *testing.T) {
+		r := newKeysignRecorder()
+		defer close(r.release)
+		s := outboundprocessor.NewScheduler(r.processor, 0, 0, zerolog.Nop())
+		for nonce := uint64(0); nonce < 5; nonce++ {
+			require.True(t, s.Submit(r.keysign(1, nonce, outboundprocessor.PriorityNormal)))
+		}
+		r.waitStarted(t, 5)
+		require.Zero(t, s.QueueDepth(1))
+	})
+	t.Run("should respect the global and per-chain budgets", func(t *testing.T) {
+		r := newKeysignRecorder()
+		defer close(r.release)
+		s := outboundprocessor.NewScheduler(r.processor, 3, 2, zerolog.Nop())
+		for nonce := uint64(0); nonce < 4; nonce++ {
+			s.Submit(r.keysign(1, nonce, outboundprocessor.PriorityNormal))
+		}
+		r.waitStarted(t, 2)
+		require.Equal(t, 2, s.QueueDepth(1))
+
+		// another chain can use the remaining global budget
+		s.Submit(r.keysign(2, 0, outboundprocessor.PriorityNormal))
+		s.Submit(r.keysign(2, 1, outboundprocessor.PriorityNormal))
+		started := r.waitStarted(t, 3)
+		require.Equal(t, id(2, 0), started[2])
+
+		// a finished keysign releases its budget
+		r.releaseOne()
+		r.waitStarted(t, 4)
+	})
+	t.Run("should start the lowest nonce and the reverts first", func(t *testing.T) {
+		r := newKeysignRecorder()
+		defer close(r.release)
+		s := outboundprocessor.NewScheduler(r.processor, 1, 0, zerolog.Nop())
+
+		// the first keysign takes the budget, the others are queued
+		s.Submit(r.keysign(1, 100, outboundprocessor.PriorityNormal))
+		r.waitStarted(t, 1)
+		s.Submit(r.keysign(1, 12, outboundprocessor.PriorityNormal))
+		s.Submit(r.keysign(1, 11, outboundprocessor.PriorityNormal))
+		s.Submit(r.keysign(1, 13, outboundprocessor.PriorityRevert))
+		s.Submit(r.keysign(1, 10, outboundprocessor.PriorityLowestNonce))
+
+		for i := 2; i <= 5; i++ {
+			r.releaseOne()
+			r.waitStarted(t, i)
+		}
+		started := r.waitStarted(t, 5)
+		require.Equal(t, []string{id(1, 100), id(1, 10), id(1, 13), id(1, 11), id(1, 12)}, started)
+	})
+	t.Run("should serve the chains in round-robin", func(t *testing.T) {
+		r := newKeysignRecorder()
+		defer close(r.release)
+		s := outboundprocessor.NewScheduler(r.processor, 1, 0, zerolog.Nop())
+
+		s.Submit(r.keysign(1, 0, outboundprocessor.PriorityNormal))
+		r.waitStarted(t, 1)
+		// a burst on chain 1 doesn't starve chain 2
+		for nonce := uint64(1); nonce < 4; nonce++ {
+			s.Submit(r.keysign(1, nonce, outboundprocessor.PriorityNormal))
+		}
+		s.Submit(r.keysign(2, 0, outboundprocessor.PriorityNormal))
+		s.Submit(r.keysign(2, 1, outboundprocessor.PriorityNormal))
+
+		for i := 2; i <= 5; i++ {
+			r.releaseOne()
+			r.waitStarted(t, i)
+		}
+		started := r.waitStarted(t, 5)
+		require.Equal(t, []string{id(1, 0), id(2, 0), id(1, 1), id(2, 1), id(1, 2)}, started)
+	})
+	t.Run("should not queue an outbound twice", func(t *testing.T) {
+		r := newKeysignRecorder()
+		defer close(r.release)
+		s := outboundprocessor.NewScheduler(r.processor, 1, 0, zerolog.Nop())
+
+		require.True(t, s.Submit(r.keysign(1, 0, outboundprocessor.PriorityNormal)))
+		r.waitStarted(t, 1)
+
+		// the active outbound is ignored, the queued outbound is replaced
+		require.False(t, s.Submit(r.keysign(1, 0, outboundprocessor.PriorityNormal)))
+		require.True(t, s.Submit(r.keysign(1, 1, outboundprocessor.PriorityNormal)))
+		require.True(t, s.Submit(r.keysign(1, 1, outboundprocessor.PriorityNormal)))
+		require.Equal(t, 1, s.QueueDepth(1))
+		require.True(t, s.IsQueued(id(1, 1)))
+	})
+	t.Run("should prune the outbounds no longer pending", func(t *testing.T) {
+		r := newKeysignRecorder()
+		defer close(r.release)
+		s := outboundprocessor.NewScheduler(r.processor, 1, 0, zerolog.Nop())
+
+		s.Submit(r.keysign(1, 0, outboundprocessor.PriorityNormal))
+		s.Submit(r.keysign(1, 1, outboundprocessor.PriorityNormal))
+		s.Submit(r.keysign(1, 2, outboundprocessor.PriorityNormal))
+		r.waitStarted(t, 1)
+
+		s.Prune(1, map[string]bool{id(1, 2): true})
+		require.Equal(t, 1, s.QueueDepth(1))
+		require.False(t, s.IsQueued(id(1, 1)))
+		require.True(t, s.IsQueued(id(1, 2)))
+	})
+	t.Run("should prune the outbounds of the chains no longer observed", func(t *testing.T) {
+		r := newKeysignRecorder()
+		defer close(r.release)
+		s := outboundprocessor.NewScheduler(r.processor, 1, 0, zerolog.Nop())
+
+		s.Submit(r.keysign(1, 0, outboundprocessor.PriorityNormal))
+		s.Submit(r.keysign(1, 1, outboundprocessor.PriorityNormal))
+		s.Submit(r.keysign(2, 0, outboundprocessor.PriorityNormal))
+		s.Submit(r.keysign(3, 0, outboundprocessor.PriorityNormal))
+		r.waitStarted(t, 1)
+
+		s.PruneChains(map[int64]bool{1: true})
+		require.Equal(t, 1, s.QueueDepth(1))
+		require.Zero(t, s.QueueDepth(2))
+		require.Zero(t, s.QueueDepth(3))
+		require.False(t, s.IsQueued(id(2, 0)))
+	})
+	t.Run("should run the keysigns at their scheduled zeta height", func(t *testing.T) {
+		r := newKeysignRecorder()
+		defer close(r.release)
+		s := outboundprocessor.NewScheduler(r.processor, 1, 0, zerolog.Nop())
+
+		s.SetZetaHeight(100)
+		s.Submit(r.keysignAt(1, 0, outboundprocessor.PriorityNormal, 100))
+		s.Submit(r.keysignAt(1, 1, outboundprocessor.PriorityNormal, 100))
+		s.Submit(r.keysignAt(2, 0, outboundprocessor.PriorityNormal, 101))
+		r.waitStarted(t, 1)
+
+		// the keysign whose slot has passed is dropped instead of running late, the height never decreases
+		s.SetZetaHeight(101)
+		s.SetZetaHeight(99)
+		require.False(t, s.IsQueued(id(1, 1)))
+		require.True(t, s.IsQueued(id(2, 0)))
+		require.False(t, s.Submit(r.keysignAt(1, 2, outboundprocessor.PriorityNormal, 100)))
+
+		r.releaseOne()
+		require.Equal(t, []string{id(1, 0), id(2, 0)}, r.waitStarted(t, 2))
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		require.Equal(t, []uint64{100, 101}, r.heights)
+	})
+	t.Run("should mark all outbounds of a batched keysign active", func(t *testing.T) {
+		r := newKeysignRecorder()
+		s := outboundprocessor.NewScheduler(r.processor, 1, 0, zerolog.Nop())
+
+		// the outbound of nonce 1 queued alone is dropped when the batch starts
+		s.Submit(r.keysign(2, 0, outboundprocessor.PriorityNormal))
+		s.Submit(r.keysign(1, 1, outboundprocessor.PriorityNormal))
+		batch := r.keysign(1, 0, outboundprocessor.PriorityLowestNonce)
+		batch.BatchOutboundIDs = []string{id(1, 1), id(1, 2)}
+		s.Submit(batch)
+		r.waitStarted(t, 1)
+
+		r.releaseOne()
+		require.Equal(t, []string{id(2, 0), id(1, 0)}, r.waitStarted(t, 2))
+		require.False(t, s.IsQueued(id(1, 1)))
+		require.True(t, r.processor.IsOutboundActive(id(1, 1)))
+		require.True(t, r.processor.IsOutboundActive(id(1, 2)))
+
+		// all outbounds of the batch are ended when the keysign is done
+		r.releaseOne()
+		require.Eventually(t, func() bool {
+			return !r.processor.IsOutboundActive(id(1, 0)) &&
+				!r.processor.IsOutboundActive(id(1, 1)) &&
+				!r.processor.IsOutboundActive(id(1, 2))
+		}, time.Second, time.Millisecond)
+		close(r.release)
+	})
+}
@@ -26,6 +26,9 @@ type Input struct {
 
 	// the lowest height of the pending (not missed) cctxs across all chains
 	LowestPendingCctxHeight int64
+
+	// the usages of the rate limit buckets per chain and per asset
+	BucketUsages []crosschaintypes.RateLimitBucketUsage
 }
 
 // Output is the output data for the rate limiter
@@ -41,6 +44,9 @@ type Output struct {
 
 	// wehther the current withdraw rate exceeds the given rate limit or not
 	RateLimitExceeded bool
+
+	// the current utilization of the rate limit buckets per chain and per asset
+	BucketUtilizations []crosschaintypes.RateLimitBucketUtilization
 }
 
 // NewInput creates a rate limiter input from gRPC response
@@ -64,6 +70,7 @@ func NewInput(resp crosschaintypes.QueryRateLimiterInputResponse) (*Input, bool)
 		PastCctxsValue:          pastCctxsValue,
 		PendingCctxsValue:       pendingCctxsValue,
 		LowestPendingCctxHeight: resp.LowestPendingCctxHeight,
+		BucketUsages:            resp.BucketUsages,
 	}, true
 }
 
@@ -72,20 +79,18 @@ func IsRateLimiterUsable(rateLimiterFlags crosschaintypes.RateLimiterFlags) bool
 	if !rateLimiterFlags.Enabled {
 		return false
 	}
-	if rateLimiterFlags.Window <= 0 {
-		return false
-	}
-	if rateLimiterFlags.Rate.IsNil() {
-		return false
-	}
-	if rateLimiterFlags.Rate.IsZero() {
-		return false
-	}
-	return true
+	return rateLimiterFlags.HasGlobalRateLimit() || rateLimiterFlags.HasBucketRateLimits()
 }
 
 // ApplyRateLimiter applies the rate limiter to the input and produces output
+// the global rate limit is applied if the given window and rate are set, along with the rate limit buckets of the input
 func ApplyRateLimiter(input *Input, window int64, rate sdkmath.Uint) *Output {
+	// the global rate limit is not set if any of the window or rate is empty
+	applyGlobalLimit := window > 0 && !rate.IsNil() && !rate.IsZero()
+	if !applyGlobalLimit {
+		rate = sdkmath.ZeroUint()
+	}
+
 	// block limit and the window limit in azeta
 	blockLimitInAzeta := sdkmath.NewIntFromBigInt(rate.BigInt())
 	windowLimitInAzeta := blockLimitInAzeta.Mul(sdkmath.NewInt(window))
@@ -107,7 +112,7 @@ func ApplyRateLimiter(input *Input, window int64, rate sdkmath.Uint) *Output {
 
 	// limit exceeded or not
 	totalWithdrawInAzeta := input.PastCctxsValue.Add(input.PendingCctxsValue)
-	limitExceeded := totalWithdrawInAzeta.GT(withdrawLimitInAzeta)
+	limitExceeded := applyGlobalLimit && totalWithdrawInAzeta.GT(withdrawLimitInAzeta)
 
 	// define the result cctx map to be scheduled
 	cctxMap := make(map[int64][]*crosschaintypes.CrossChainTx)
@@ -126,15 +131,36 @@ func ApplyRateLimiter(input *Input, window int64, rate sdkmath.Uint) *Output {
 	// schedule missed cctxs regardless of the `limitExceeded` flag
 	addCctxsToMap(input.CctxsMissed)
 
+	// utilization of the rate limit buckets per chain and per asset
+	bucketUtilizations := make([]crosschaintypes.RateLimitBucketUtilization, 0, len(input.BucketUsages))
+	for _, usage := range input.BucketUsages {
+		bucketUtilizations = append(bucketUtilizations, usage.Utilization(input.Height))
+	}
+
 	// schedule pending cctxs only if `limitExceeded == false`
+	// the pending cctxs of a chain are held from the first cctx matching an exceeded bucket
 	if !limitExceeded {
-		addCctxsToMap(input.CctxsPending)
+		heldNonces := crosschaintypes.RateLimitHeldNonces(input.CctxsPending, input.BucketUsages, input.Height)
+		pendingCctxs := make([]*crosschaintypes.CrossChainTx, 0, len(input.CctxsPending))
+		for _, cctx := range input.CctxsPending {
+			if !crosschaintypes.IsRateLimitHeld(cctx, heldNonces) {
+				pendingCctxs = append(pendingCctxs, cctx)
+			}
+		}
+		addCctxsToMap(pendingCctxs)
+		limitExceeded = len(heldNonces) > 0
+	}
+
+	currentWithdrawRate := sdkmath.ZeroInt()
+	if withdrawWindow > 0 {
+		currentWithdrawRate = totalWithdrawInAzeta.Quo(sdk.NewInt(withdrawWindow))
 	}
 
 	return &Output{
 		CctxsMap:              cctxMap,
 		CurrentWithdrawWindow: withdrawWindow,
-		CurrentWithdrawRate:   totalWithdrawInAzeta.Quo(sdk.NewInt(withdrawWindow)),
+		CurrentWithdrawRate:   currentWithdrawRate,
 		RateLimitExceeded:     limitExceeded,
+		BucketUtilizations:    bucketUtilizations,
 	}
 }
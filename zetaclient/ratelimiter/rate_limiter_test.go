@@ -25,6 +25,18 @@ func Test_NewInput(t *testing.T) {
 		PastCctxsValue:          sdk.NewInt(12345678).Mul(sdk.NewInt(1e18)).String(),
 		PendingCctxsValue:       sdk.NewInt(4321).Mul(sdk.NewInt(1e18)).String(),
 		LowestPendingCctxHeight: 2,
+		BucketUsages: []crosschaintypes.RateLimitBucketUsage{
+			{
+				Bucket: crosschaintypes.RateLimitBucket{
+					Type:    crosschaintypes.RateLimitBucketType_Chain,
+					ChainId: chains.Ethereum.ChainId,
+					Window:  100,
+					Rate:    sdkmath.NewUint(1e18),
+				},
+				PastCctxsValue:    sdk.NewInt(1e18),
+				PendingCctxsValue: sdk.NewInt(2e18),
+			},
+		},
 	}
 
 	t.Run("should create a input from gRPC response", func(t *testing.T) {
@@ -36,6 +48,7 @@ func Test_NewInput(t *testing.T) {
 		require.Equal(t, response.PastCctxsValue, filterInput.PastCctxsValue.String())
 		require.Equal(t, response.PendingCctxsValue, filterInput.PendingCctxsValue.String())
 		require.Equal(t, response.LowestPendingCctxHeight, filterInput.LowestPendingCctxHeight)
+		require.Equal(t, response.BucketUsages, filterInput.BucketUsages)
 	})
 	t.Run("should return false if past cctxs value is invalid", func(t *testing.T) {
 		invalidResp := response
@@ -101,6 +114,26 @@ func Test_IsRateLimiterUsable(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name: "rate limiter is enabled with chain rate limits only",
+			flags: crosschaintypes.RateLimiterFlags{
+				Enabled: true,
+				ChainRateLimits: []crosschaintypes.ChainRateLimit{
+					{ChainId: chains.Ethereum.ChainId, Window: 100, Rate: sdkmath.NewUint(1e18)},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "rate limiter is disabled with asset rate limits",
+			flags: crosschaintypes.RateLimiterFlags{
+				Enabled: false,
+				AssetRateLimits: []crosschaintypes.AssetRateLimit{
+					{Zrc20: sample.EthAddress().Hex(), Window: 100, Rate: sdkmath.NewUint(1e18)},
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -313,3 +346,133 @@ func Test_ApplyRateLimiter(t *testing.T) {
 		})
 	}
 }
+
+func Test_ApplyRateLimiterWithBuckets(t *testing.T) {
+	// define test chain ids
+	ethChainID := chains.Ethereum.ChainId
+	btcChainID := chains.BitcoinMainnet.ChainId
+	zetaChainID := chains.ZetaChainMainnet.ChainId
+
+	// create 10 missed and 90 pending cctxs for eth and btc chains
+	ethCctxsMissed := sample.CustomCctxsInBlockRange(
+		t,
+		1,
+		10,
+		zetaChainID,
+		ethChainID,
+		coin.CoinType_Gas,
+		"",
+		uint64(2e14),
+		crosschaintypes.CctxStatus_PendingOutbound,
+	)
+	ethCctxsPending := sample.CustomCctxsInBlockRange(
+		t,
+		11,
+		100,
+		zetaChainID,
+		ethChainID,
+		coin.CoinType_Gas,
+		"",
+		uint64(2e14),
+		crosschaintypes.CctxStatus_PendingOutbound,
+	)
+	ethCctxsAll := append(append([]*crosschaintypes.CrossChainTx{}, ethCctxsMissed...), ethCctxsPending...)
+	btcCctxsMissed := sample.CustomCctxsInBlockRange(
+		t,
+		1,
+		10,
+		zetaChainID,
+		btcChainID,
+		coin.CoinType_Gas,
+		"",
+		2000,
+		crosschaintypes.CctxStatus_PendingOutbound,
+	)
+	btcCctxsPending := sample.CustomCctxsInBlockRange(
+		t,
+		11,
+		100,
+		zetaChainID,
+		btcChainID,
+		coin.CoinType_Gas,
+		"",
+		2000,
+		crosschaintypes.CctxStatus_PendingOutbound,
+	)
+	btcCctxsAll := append(append([]*crosschaintypes.CrossChainTx{}, btcCctxsMissed...), btcCctxsPending...)
+
+	// all missed cctxs and all pending cctxs across all chains
+	allCctxsMissed := crosschainkeeper.SortCctxsByHeightAndChainID(
+		append(append([]*crosschaintypes.CrossChainTx{}, ethCctxsMissed...), btcCctxsMissed...))
+	allCctxsPending := crosschainkeeper.SortCctxsByHeightAndChainID(
+		append(append([]*crosschaintypes.CrossChainTx{}, ethCctxsPending...), btcCctxsPending...))
+
+	// the btc chain bucket with a rate of 1 ZETA/block
+	btcBucket := crosschaintypes.RateLimitBucket{
+		Type:    crosschaintypes.RateLimitBucketType_Chain,
+		ChainId: btcChainID,
+		Window:  100,
+		Rate:    sdkmath.NewUint(1e18),
+	}
+
+	// newInput creates the input with the given value of the pending btc cctxs
+	newInput := func(btcPendingValue sdkmath.Int) ratelimiter.Input {
+		return ratelimiter.Input{
+			Height:                  100,
+			CctxsMissed:             allCctxsMissed,
+			CctxsPending:            allCctxsPending,
+			PastCctxsValue:          sdk.NewInt(10).Mul(sdk.NewInt(1e18)),
+			PendingCctxsValue:       sdk.NewInt(90).Mul(sdk.NewInt(1e18)),
+			LowestPendingCctxHeight: 11,
+			BucketUsages: []crosschaintypes.RateLimitBucketUsage{
+				{
+					Bucket:                  btcBucket,
+					PastCctxsValue:          sdk.NewInt(10).Mul(sdk.NewInt(1e18)),
+					PendingCctxsValue:       btcPendingValue,
+					LowestPendingCctxHeight: 11,
+				},
+			},
+		}
+	}
+
+	t.Run("should return all missed and pending cctxs within the bucket limit", func(t *testing.T) {
+		input := newInput(sdk.NewInt(90).Mul(sdk.NewInt(1e18)))
+		output := ratelimiter.ApplyRateLimiter(&input, 100, sdkmath.NewUint(10*1e18))
+		require.Equal(t, map[int64][]*crosschaintypes.CrossChainTx{
+			ethChainID: ethCctxsAll,
+			btcChainID: btcCctxsAll,
+		}, output.CctxsMap)
+		require.False(t, output.RateLimitExceeded)
+		require.Len(t, output.BucketUtilizations, 1)
+		require.Equal(t, sdk.NewInt(1e18).String(), output.BucketUtilizations[0].CurrentWithdrawRate)
+		require.False(t, output.BucketUtilizations[0].RateLimitExceeded)
+	})
+
+	t.Run("should hold the pending cctxs of the chain exceeding the bucket limit", func(t *testing.T) {
+		input := newInput(sdk.NewInt(91).Mul(sdk.NewInt(1e18)))
+		output := ratelimiter.ApplyRateLimiter(&input, 100, sdkmath.NewUint(10*1e18))
+		require.Equal(t, map[int64][]*crosschaintypes.CrossChainTx{
+			ethChainID: ethCctxsAll,
+			btcChainID: btcCctxsMissed,
+		}, output.CctxsMap)
+		require.True(t, output.RateLimitExceeded)
+		require.Len(t, output.BucketUtilizations, 1)
+		require.Equal(
+			t,
+			sdk.NewInt(101).Mul(sdk.NewInt(1e18)).String(),
+			output.BucketUtilizations[0].CurrentWithdrawValue,
+		)
+		require.True(t, output.BucketUtilizations[0].RateLimitExceeded)
+	})
+
+	t.Run("should apply the bucket limit without global rate limit", func(t *testing.T) {
+		input := newInput(sdk.NewInt(91).Mul(sdk.NewInt(1e18)))
+		output := ratelimiter.ApplyRateLimiter(&input, 0, sdkmath.NewUint(0))
+		require.Equal(t, map[int64][]*crosschaintypes.CrossChainTx{
+			ethChainID: ethCctxsAll,
+			btcChainID: btcCctxsMissed,
+		}, output.CctxsMap)
+		require.True(t, output.RateLimitExceeded)
+		require.Equal(t, int64(90), output.CurrentWithdrawWindow)
+	})
+}
@@ -0,0 +1,192 @@
+package remotesigner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/keys/interfaces"
+)
+
+// DefaultTimeout is the default timeout of a request to the remote signer
+const DefaultTimeout = 10 * time.Second
+
+var _ interfaces.RemoteSigner = &Client{}
+
+// Client signs the zetaclient transactions with the hotkey held by the remote signer
+type Client struct {
+	conn    *grpc.ClientConn
+	client  RemoteSignerClient
+	pubKey  cryptotypes.PubKey
+	timeout time.Duration
+}
+
+// Dial connects to the remote signer with mutual TLS and fetches the public key of the hotkey
+func Dial(cfg config.RemoteSignerConfig) (*Client, error) {
+	tlsConfig, err := NewClientTLSConfig(cfg.CertFile, cfg.KeyFile, cfg.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(cfg.Endpoint, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error dialing remote signer %s", cfg.Endpoint)
+	}
+
+	timeout := DefaultTimeout
+	if cfg.Timeout > 0 {
+		// #nosec G701 always in range
+		timeout = time.Duration(cfg.Timeout) * time.Second
+	}
+	c, err := NewClient(conn, timeout)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewClient creates a client of the remote signer on the connection and fetches the public key of the hotkey
+func NewClient(conn *grpc.ClientConn, timeout time.Duration) (*Client, error) {
+	c := &Client{
+		conn:    conn,
+		client:  NewRemoteSignerClient(conn),
+		timeout: timeout,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	res, err := c.client.GetPubKey(ctx, &GetPubKeyRequest{})
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting public key from remote signer")
+	}
+	if len(res.PubKey) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("invalid public key length %d from remote signer", len(res.PubKey))
+	}
+	c.pubKey = &secp256k1.PubKey{Key: res.PubKey}
+	return c, nil
+}
+
+// PubKey returns the public key of the hotkey
+func (c *Client) PubKey() cryptotypes.PubKey {
+	return c.pubKey
+}
+
+// Sign returns the signature of the sign bytes by the hotkey, verified against the public key
+func (c *Client) Sign(signBytes []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	res, err := c.client.Sign(ctx, &SignRequest{SignDoc: signBytes})
+	if err != nil {
+		return nil, errors.Wrap(err, "error signing with remote signer")
+	}
+	if !c.pubKey.VerifySignature(signBytes, res.Signature) {
+		return nil, errors.New("invalid signature from remote signer")
+	}
+	return res.Signature, nil
+}
+
+// Close closes the connection to the remote signer
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// SignTx signs a given tx with the remote signer.
+// This is adapted from github.com/cosmos/cosmos-sdk/client/tx Sign() function; Modified to use a remote signer.
+// The resulting signature will be added to the transaction builder overwriting the previous
+// ones if overwrite=true (otherwise, the signature will be appended).
+// Only SIGN_MODE_DIRECT is supported as the remote signer checks the messages of the sign doc.
+func SignTx(
+	signer interfaces.RemoteSigner,
+	txf clienttx.Factory,
+	txBuilder client.TxBuilder,
+	overwriteSig bool,
+	txConfig client.TxConfig,
+) error {
+	signMode := txf.SignMode()
+	if signMode != signing.SignMode_SIGN_MODE_DIRECT {
+		return fmt.Errorf("sign mode %s not supported by remote signer", signMode)
+	}
+
+	pubKey := signer.PubKey()
+	signerData := authsigning.SignerData{
+		ChainID:       txf.ChainID(),
+		AccountNumber: txf.AccountNumber(),
+		Sequence:      txf.Sequence(),
+		PubKey:        pubKey,
+		Address:       sdk.AccAddress(pubKey.Address()).String(),
+	}
+
+	// For SIGN_MODE_DIRECT, calling SetSignatures calls setSignerInfos on
+	// TxBuilder under the hood, and SignerInfos is needed to generate the
+	// sign bytes. This is the reason for setting SetSignatures here, with a
+	// nil signature.
+	sigData := signing.SingleSignatureData{
+		SignMode:  signMode,
+		Signature: nil,
+	}
+	sig := signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &sigData,
+		Sequence: txf.Sequence(),
+	}
+
+	var prevSignatures []signing.SignatureV2
+	var err error
+	if !overwriteSig {
+		prevSignatures, err = txBuilder.GetTx().GetSignaturesV2()
+		if err != nil {
+			return err
+		}
+	}
+	// Overwrite or append signer infos.
+	var sigs []signing.SignatureV2
+	if overwriteSig {
+		sigs = []signing.SignatureV2{sig}
+	} else {
+		sigs = append(prevSignatures, sig) //nolint:gocritic
+	}
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
+		return err
+	}
+
+	// Generate the bytes to be signed.
+	bytesToSign, err := txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	// Sign those bytes
+	sigBytes, err := signer.Sign(bytesToSign)
+	if err != nil {
+		return err
+	}
+
+	// Construct the SignatureV2 struct
+	sigData = signing.SingleSignatureData{
+		SignMode:  signMode,
+		Signature: sigBytes,
+	}
+	sig = signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &sigData,
+		Sequence: txf.Sequence(),
+	}
+
+	if overwriteSig {
+		return txBuilder.SetSignatures(sig)
+	}
+	prevSignatures = append(prevSignatures, sig)
+	return txBuilder.SetSignatures(prevSignatures...)
+}
@@ -0,0 +1,261 @@
+package remotesigner
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/zetacore/app"
+	zetacoredconfig "github.com/zeta-chain/zetacore/cmd/zetacored/config"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+)
+
+const testChainID = "athens_7001-1"
+
+// testMaxFee is the maximum fee paid by the txs signed by the remote signer
+var testMaxFee = sdk.NewCoins(sdk.NewInt64Coin(zetacoredconfig.BaseDenom, 1_000_000))
+
+// certFiles are the files of a certificate and its key
+type certFiles struct {
+	cert string
+	key  string
+}
+
+// newCA creates a certificate authority in the directory
+func newCA(t *testing.T, dir, name string) (*x509.Certificate, *ecdsa.PrivateKey, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, name+".pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	return cert, key, path
+}
+
+// newCert creates a certificate signed by the certificate authority in the directory
+func newCert(
+	t *testing.T,
+	dir, name string,
+	ca *x509.Certificate,
+	caKey *ecdsa.PrivateKey,
+	usage x509.ExtKeyUsage,
+) certFiles {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	files := certFiles{cert: filepath.Join(dir, name+".pem"), key: filepath.Join(dir, name+"-key.pem")}
+	require.NoError(t, os.WriteFile(files.cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(files.key, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	return files
+}
+
+// newSignDoc creates the sign doc of a tx with the messages
+func newSignDoc(t *testing.T, chainID string, msgs ...sdk.Msg) []byte {
+	body := txtypes.TxBody{}
+	for _, msg := range msgs {
+		msgAny, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		body.Messages = append(body.Messages, msgAny)
+	}
+	bodyBytes, err := body.Marshal()
+	require.NoError(t, err)
+	signDoc := txtypes.SignDoc{BodyBytes: bodyBytes, ChainId: chainID, AccountNumber: 1}
+	signDocBytes, err := signDoc.Marshal()
+	require.NoError(t, err)
+	return signDocBytes
+}
+
+// withFee sets the fee paid by the tx of the sign doc
+func withFee(t *testing.T, signDocBytes []byte, fee sdk.Coins) []byte {
+	var signDoc txtypes.SignDoc
+	require.NoError(t, signDoc.Unmarshal(signDocBytes))
+	authInfo := txtypes.AuthInfo{Fee: &txtypes.Fee{Amount: fee, GasLimit: 200_000}}
+	authInfoBytes, err := authInfo.Marshal()
+	require.NoError(t, err)
+	signDoc.AuthInfoBytes = authInfoBytes
+	signDocBytes, err = signDoc.Marshal()
+	require.NoError(t, err)
+	return signDocBytes
+}
+
+// newExec wraps the messages in authz MsgExec of the grantee
+func newExec(grantee sdk.AccAddress, msgs ...sdk.Msg) sdk.Msg {
+	exec := authz.NewMsgExec(grantee, msgs)
+	return &exec
+}
+
+func TestServer_CheckSignDoc(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	hotkey := sdk.AccAddress(privKey.PubKey().Address())
+	server := NewServer(privKey, testChainID, testMaxFee, zerolog.Nop())
+	vote := &crosschaintypes.MsgVoteGasPrice{Creator: sample.AccAddress()}
+
+	t.Run("should allow the zetaclient messages executed by the hotkey", func(t *testing.T) {
+		msgTypes, err := server.CheckSignDoc(newSignDoc(t, testChainID, newExec(hotkey, vote)))
+		require.NoError(t, err)
+		require.Equal(t, []string{sdk.MsgTypeURL(vote)}, msgTypes)
+	})
+	t.Run("should refuse the messages zetaclient is not granted", func(t *testing.T) {
+		send := &banktypes.MsgSend{FromAddress: sample.AccAddress(), ToAddress: sample.AccAddress()}
+		_, err := server.CheckSignDoc(newSignDoc(t, testChainID, newExec(hotkey, vote, send)))
+		require.ErrorContains(t, err, "message type /cosmos.bank.v1beta1.MsgSend not allowed")
+	})
+	t.Run("should refuse the messages not executed through authz", func(t *testing.T) {
+		_, err := server.CheckSignDoc(newSignDoc(t, testChainID, vote))
+		require.ErrorContains(t, err, "not allowed")
+	})
+	t.Run("should refuse the messages executed by another grantee", func(t *testing.T) {
+		_, err := server.CheckSignDoc(newSignDoc(t, testChainID, newExec(sample.Bech32AccAddress(), vote)))
+		require.ErrorContains(t, err, "is not the hotkey")
+	})
+	t.Run("should refuse the sign doc of another chain", func(t *testing.T) {
+		_, err := server.CheckSignDoc(newSignDoc(t, "zetachain_7000-1", newExec(hotkey, vote)))
+		require.ErrorContains(t, err, "chain id zetachain_7000-1 not allowed")
+	})
+	t.Run("should refuse the sign doc without message", func(t *testing.T) {
+		_, err := server.CheckSignDoc(newSignDoc(t, testChainID))
+		require.ErrorContains(t, err, "no message to sign")
+	})
+	t.Run("should allow the fee up to the maximum fee", func(t *testing.T) {
+		_, err := server.CheckSignDoc(withFee(t, newSignDoc(t, testChainID, newExec(hotkey, vote)), testMaxFee))
+		require.NoError(t, err)
+	})
+	t.Run("should refuse the fee exceeding the maximum fee", func(t *testing.T) {
+		fee := sdk.NewCoins(sdk.NewInt64Coin(zetacoredconfig.BaseDenom, 1_000_001))
+		_, err := server.CheckSignDoc(withFee(t, newSignDoc(t, testChainID, newExec(hotkey, vote)), fee))
+		require.ErrorContains(t, err, "exceeds the maximum fee")
+	})
+	t.Run("should refuse the fee of another denom", func(t *testing.T) {
+		fee := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))
+		_, err := server.CheckSignDoc(withFee(t, newSignDoc(t, testChainID, newExec(hotkey, vote)), fee))
+		require.ErrorContains(t, err, "exceeds the maximum fee")
+	})
+}
+
+func TestRemoteSigner(t *testing.T) {
+	// create the certificates of the remote signer and zetaclient
+	dir := t.TempDir()
+	ca, caKey, caFile := newCA(t, dir, "ca")
+	serverCert := newCert(t, dir, "server", ca, caKey, x509.ExtKeyUsageServerAuth)
+	clientCert := newCert(t, dir, "client", ca, caKey, x509.ExtKeyUsageClientAuth)
+
+	// start the remote signer
+	privKey := secp256k1.GenPrivKey()
+	hotkey := sdk.AccAddress(privKey.PubKey().Address())
+	tlsConfig, err := NewServerTLSConfig(serverCert.cert, serverCert.key, caFile)
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)), ServerCodec())
+	RegisterRemoteSignerServer(server, NewServer(privKey, testChainID, testMaxFee, zerolog.Nop()))
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+
+	cfg := config.RemoteSignerConfig{
+		Endpoint: listener.Addr().String(),
+		CertFile: clientCert.cert,
+		KeyFile:  clientCert.key,
+		CAFile:   caFile,
+		Timeout:  5,
+	}
+	client, err := Dial(cfg)
+	require.NoError(t, err)
+	defer client.Close()
+	vote := &crosschaintypes.MsgVoteGasPrice{Creator: sample.AccAddress()}
+
+	t.Run("should get the public key of the hotkey", func(t *testing.T) {
+		require.True(t, privKey.PubKey().Equals(client.PubKey()))
+	})
+	t.Run("should sign the allowed messages", func(t *testing.T) {
+		signDoc := newSignDoc(t, testChainID, newExec(hotkey, vote))
+		signature, err := client.Sign(signDoc)
+		require.NoError(t, err)
+		require.True(t, privKey.PubKey().VerifySignature(signDoc, signature))
+	})
+	t.Run("should refuse to sign the messages not allowed", func(t *testing.T) {
+		send := &banktypes.MsgSend{FromAddress: sample.AccAddress(), ToAddress: sample.AccAddress()}
+		_, err := client.Sign(newSignDoc(t, testChainID, newExec(hotkey, send)))
+		require.Equal(t, codes.PermissionDenied, status.Code(errors.Cause(err)))
+	})
+	t.Run("should sign the tx built by zetaclient", func(t *testing.T) {
+		encodingCfg := app.MakeEncodingConfig()
+		txf := clienttx.Factory{}.
+			WithChainID(testChainID).
+			WithAccountNumber(1).
+			WithSequence(2).
+			WithSignMode(signing.SignMode_SIGN_MODE_DIRECT).
+			WithTxConfig(encodingCfg.TxConfig)
+		builder, err := txf.BuildUnsignedTx(newExec(hotkey, vote))
+		require.NoError(t, err)
+
+		require.NoError(t, SignTx(client, txf, builder, true, encodingCfg.TxConfig))
+		sigs, err := builder.GetTx().GetSignaturesV2()
+		require.NoError(t, err)
+		require.Len(t, sigs, 1)
+		require.True(t, privKey.PubKey().Equals(sigs[0].PubKey))
+		require.EqualValues(t, 2, sigs[0].Sequence)
+	})
+	t.Run("should refuse the client with a certificate of another authority", func(t *testing.T) {
+		otherCA, otherKey, _ := newCA(t, dir, "other-ca")
+		otherCert := newCert(t, dir, "other-client", otherCA, otherKey, x509.ExtKeyUsageClientAuth)
+		cfg := cfg
+		cfg.CertFile, cfg.KeyFile = otherCert.cert, otherCert.key
+
+		_, err := Dial(cfg)
+		require.ErrorContains(t, err, "error getting public key from remote signer")
+	})
+}
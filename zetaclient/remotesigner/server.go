@@ -0,0 +1,122 @@
+package remotesigner
+
+import (
+	"context"
+	"fmt"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+var _ RemoteSignerServer = &Server{}
+
+// Server signs with the hotkey the zetaclient transactions wrapping allowed messages in authz MsgExec
+type Server struct {
+	privKey cryptotypes.PrivKey
+	address sdk.AccAddress
+	chainID string
+	maxFee  sdk.Coins
+	allowed map[string]bool
+	logger  zerolog.Logger
+}
+
+// NewServer creates a remote signer signing with the hotkey the messages zetaclient is granted to execute
+// The sign docs of other chains than 'chainID' are refused, unless 'chainID' is empty
+// The sign docs paying more fees than 'maxFee' are refused, an empty 'maxFee' refuses any fee
+func NewServer(privKey cryptotypes.PrivKey, chainID string, maxFee sdk.Coins, logger zerolog.Logger) *Server {
+	allowed := make(map[string]bool)
+	for _, msgType := range crosschaintypes.GetAllAuthzZetaclientTxTypes() {
+		allowed[msgType] = true
+	}
+	return &Server{
+		privKey: privKey,
+		address: sdk.AccAddress(privKey.PubKey().Address()),
+		chainID: chainID,
+		maxFee:  maxFee,
+		allowed: allowed,
+		logger:  logger.With().Str("module", "RemoteSigner").Logger(),
+	}
+}
+
+// GetPubKey returns the public key of the hotkey
+func (s *Server) GetPubKey(_ context.Context, _ *GetPubKeyRequest) (*GetPubKeyResponse, error) {
+	return &GetPubKeyResponse{PubKey: s.privKey.PubKey().Bytes()}, nil
+}
+
+// Sign signs the sign doc with the hotkey if it only contains allowed messages
+func (s *Server) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	msgTypes, err := s.CheckSignDoc(req.SignDoc)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("refused to sign")
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	signature, err := s.privKey.Sign(req.SignDoc)
+	if err != nil {
+		s.logger.Error().Err(err).Msg("error signing")
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.logger.Info().Strs("msgs", msgTypes).Msg("signed")
+	return &SignResponse{Signature: signature}, nil
+}
+
+// CheckSignDoc returns the types of the messages in the sign doc, or an error if the sign doc contains
+// any message other than the allowed messages executed by the hotkey through authz MsgExec
+// or pays more fees than the maximum fee
+func (s *Server) CheckSignDoc(signDocBytes []byte) ([]string, error) {
+	var signDoc txtypes.SignDoc
+	if err := signDoc.Unmarshal(signDocBytes); err != nil {
+		return nil, fmt.Errorf("error decoding sign doc: %w", err)
+	}
+	if s.chainID != "" && signDoc.ChainId != s.chainID {
+		return nil, fmt.Errorf("chain id %s not allowed", signDoc.ChainId)
+	}
+
+	var authInfo txtypes.AuthInfo
+	if err := authInfo.Unmarshal(signDoc.AuthInfoBytes); err != nil {
+		return nil, fmt.Errorf("error decoding auth info: %w", err)
+	}
+	if authInfo.Fee != nil && !authInfo.Fee.Amount.IsAllLTE(s.maxFee) {
+		return nil, fmt.Errorf("fee %s exceeds the maximum fee %s", authInfo.Fee.Amount, s.maxFee)
+	}
+
+	var body txtypes.TxBody
+	if err := body.Unmarshal(signDoc.BodyBytes); err != nil {
+		return nil, fmt.Errorf("error decoding tx body: %w", err)
+	}
+	if len(body.Messages) == 0 {
+		return nil, fmt.Errorf("no message to sign")
+	}
+
+	execType := sdk.MsgTypeURL(&authz.MsgExec{})
+	var msgTypes []string
+	for _, msg := range body.Messages {
+		if msg.TypeUrl != execType {
+			return nil, fmt.Errorf("message type %s not allowed", msg.TypeUrl)
+		}
+		var exec authz.MsgExec
+		if err := exec.Unmarshal(msg.Value); err != nil {
+			return nil, fmt.Errorf("error decoding %s: %w", execType, err)
+		}
+		if exec.Grantee != s.address.String() {
+			return nil, fmt.Errorf("grantee %s is not the hotkey %s", exec.Grantee, s.address)
+		}
+		if len(exec.Msgs) == 0 {
+			return nil, fmt.Errorf("no message to execute")
+		}
+		for _, inner := range exec.Msgs {
+			if !s.allowed[inner.TypeUrl] {
+				return nil, fmt.Errorf("message type %s not allowed", inner.TypeUrl)
+			}
+			msgTypes = append(msgTypes, inner.TypeUrl)
+		}
+	}
+	return msgTypes, nil
+}
@@ -0,0 +1,158 @@
+// Package remotesigner implements the gRPC remote signer holding the zetaclient hotkey
+package remotesigner
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	// codecName is the content subtype of the remote signer requests
+	codecName = "json"
+
+	// serviceName is the full name of the remote signer gRPC service
+	serviceName = "zetachain.zetacore.zetaclient.RemoteSigner"
+)
+
+// jsonCodec encodes the remote signer requests and responses in JSON
+// it is set on the calls of the client and on the server of the remote signer only, not registered globally
+// so the codecs of the other gRPC clients and servers of the process are left unchanged
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return codecName
+}
+
+// GetPubKeyRequest is the request for the public key of the hotkey
+type GetPubKeyRequest struct{}
+
+// GetPubKeyResponse contains the compressed secp256k1 public key of the hotkey
+type GetPubKeyResponse struct {
+	PubKey []byte `json:"pub_key"`
+}
+
+// SignRequest is the request to sign the SIGN_MODE_DIRECT sign doc of a transaction
+type SignRequest struct {
+	SignDoc []byte `json:"sign_doc"`
+}
+
+// SignResponse contains the signature of the sign doc by the hotkey
+type SignResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// ServerCodec returns the server option decoding the requests of the remote signer server
+// Note: the codec applies to all the services of the server, the remote signer is served alone
+func ServerCodec() grpc.ServerOption {
+	return grpc.ForceServerCodec(jsonCodec{})
+}
+
+// RemoteSignerServer is the server API of the remote signer
+type RemoteSignerServer interface {
+	GetPubKey(context.Context, *GetPubKeyRequest) (*GetPubKeyResponse, error)
+	Sign(context.Context, *SignRequest) (*SignResponse, error)
+}
+
+// RemoteSignerClient is the client API of the remote signer
+type RemoteSignerClient interface {
+	GetPubKey(ctx context.Context, in *GetPubKeyRequest, opts ...grpc.CallOption) (*GetPubKeyResponse, error)
+	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
+}
+
+type remoteSignerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewRemoteSignerClient creates a client of the remote signer on the connection
+func NewRemoteSignerClient(cc grpc.ClientConnInterface) RemoteSignerClient {
+	return &remoteSignerClient{cc}
+}
+
+func (c *remoteSignerClient) GetPubKey(
+	ctx context.Context,
+	in *GetPubKeyRequest,
+	opts ...grpc.CallOption,
+) (*GetPubKeyResponse, error) {
+	out := new(GetPubKeyResponse)
+	opts = append([]grpc.CallOption{grpc.ForceCodec(jsonCodec{})}, opts...)
+	if err := c.cc.Invoke(ctx, "/"+serviceName+"/GetPubKey", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	opts = append([]grpc.CallOption{grpc.ForceCodec(jsonCodec{})}, opts...)
+	if err := c.cc.Invoke(ctx, "/"+serviceName+"/Sign", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RegisterRemoteSignerServer registers the remote signer service on the gRPC server
+func RegisterRemoteSignerServer(s grpc.ServiceRegistrar, srv RemoteSignerServer) {
+	s.RegisterService(&serviceDesc, srv)
+}
+
+func getPubKeyHandler(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(GetPubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).GetPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + serviceName + "/GetPubKey"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).GetPubKey(ctx, req.(*GetPubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func signHandler(
+	srv interface{},
+	ctx context.Context,
+	dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(SignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/" + serviceName + "/Sign"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).Sign(ctx, req.(*SignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// serviceDesc is the gRPC service descriptor of the remote signer
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*RemoteSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "GetPubKey", Handler: getPubKeyHandler},
+		{MethodName: "Sign", Handler: signHandler},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "zetaclient/remotesigner/service.go",
+}
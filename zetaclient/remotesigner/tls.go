@@ -0,0 +1,53 @@
+package remotesigner
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// NewClientTLSConfig creates the mutual TLS config of zetaclient presenting its certificate to the remote signer
+func NewClientTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	cert, pool, err := loadCertificates(certFile, keyFile, caFile)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// NewServerTLSConfig creates the mutual TLS config of the remote signer requiring a client certificate
+func NewServerTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	cert, pool, err := loadCertificates(certFile, keyFile, caFile)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// loadCertificates loads the key pair and the certificate authority
+func loadCertificates(certFile, keyFile, caFile string) (tls.Certificate, *x509.CertPool, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("error loading key pair %s %s: %w", certFile, keyFile, err)
+	}
+	caPEM, err := os.ReadFile(filepath.Clean(caFile))
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("error reading certificate authority %s: %w", caFile, err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return tls.Certificate{}, nil, fmt.Errorf("no certificate found in certificate authority %s", caFile)
+	}
+	return cert, pool, nil
+}
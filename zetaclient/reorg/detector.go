@@ -0,0 +1,120 @@
+// Package reorg detects the scanned blocks replaced by chain reorgs
+package reorg
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+)
+
+// DefaultWindow is the number of most recent scanned heights whose block hashes are tracked
+const DefaultWindow = 1000
+
+// ErrNotCanonical is returned for an inbound whose block is no longer in the canonical chain
+var ErrNotCanonical = errors.New("block is not canonical")
+
+// HashFunc returns the hash of the canonical block at the height
+type HashFunc func(height uint64) (string, error)
+
+// Detector tracks the hashes of the scanned blocks and detects the blocks replaced by a chain reorg
+type Detector struct {
+	mu      sync.Mutex
+	chain   string
+	window  uint64
+	hashes  map[uint64]string
+	highest uint64
+}
+
+// NewDetector creates a detector tracking the hashes of the last 'window' scanned heights of the chain
+func NewDetector(chainName string, window uint64) *Detector {
+	return &Detector{
+		chain:  chainName,
+		window: window,
+		hashes: make(map[uint64]string),
+	}
+}
+
+// Record tracks the hash of the scanned block at the height
+func (d *Detector) Record(height uint64, hash string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.hashes[height] = hash
+	if height <= d.highest {
+		return
+	}
+	d.highest = height
+
+	// forget the heights out of the window
+	if d.highest >= d.window {
+		for h := range d.hashes {
+			if h <= d.highest-d.window {
+				delete(d.hashes, h)
+			}
+		}
+	}
+}
+
+// Hash returns the tracked hash of the scanned block at the height
+func (d *Detector) Hash(height uint64) (string, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	hash, found := d.hashes[height]
+	return hash, found
+}
+
+// Detect checks the tracked hashes against the canonical chain from the highest height down
+// and returns the lowest height whose block has been replaced, whose hashes are forgotten
+//
+// Note: a block replaced by a reorg also replaces all the blocks above, so the check stops at the first canonical block
+func (d *Detector) Detect(canonicalHash HashFunc) (forkHeight uint64, found bool, err error) {
+	d.mu.Lock()
+	heights := make([]uint64, 0, len(d.hashes))
+	for h := range d.hashes {
+		heights = append(heights, h)
+	}
+	d.mu.Unlock()
+	sort.Slice(heights, func(i, j int) bool { return heights[i] > heights[j] })
+
+	for _, height := range heights {
+		hash, err := canonicalHash(height)
+		if err != nil {
+			return 0, false, fmt.Errorf("error getting canonical hash at height %d: %w", height, err)
+		}
+		tracked, _ := d.Hash(height)
+		if strings.EqualFold(hash, tracked) {
+			break
+		}
+		forkHeight, found = height, true
+	}
+	if !found {
+		return 0, false, nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	metrics.ChainReorgs.WithLabelValues(d.chain).Inc()
+	metrics.ChainReorgDepth.WithLabelValues(d.chain).Set(float64(d.highest - forkHeight + 1))
+	for h := range d.hashes {
+		if h >= forkHeight {
+			delete(d.hashes, h)
+		}
+	}
+	if forkHeight > 0 {
+		d.highest = forkHeight - 1
+	}
+	return forkHeight, true, nil
+}
+
+// CheckCanonical returns ErrNotCanonical if the block hash of an inbound is not the canonical block hash at the height
+func (d *Detector) CheckCanonical(height uint64, hash string, canonicalHash string) error {
+	if strings.EqualFold(hash, canonicalHash) {
+		return nil
+	}
+	metrics.NonCanonicalInbounds.WithLabelValues(d.chain).Inc()
+	return fmt.Errorf("%w: block %s at height %d replaced by %s", ErrNotCanonical, hash, height, canonicalHash)
+}
@@ -0,0 +1,85 @@
+package reorg
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// canonicalChain returns the hashes of a chain forked at height 'forkHeight'
+func canonicalChain(forkHeight uint64, calls *int) HashFunc {
+	return func(height uint64) (string, error) {
+		*calls++
+		if height >= forkHeight {
+			return fmt.Sprintf("0xfork%d", height), nil
+		}
+		return fmt.Sprintf("0x%d", height), nil
+	}
+}
+
+func newTestDetector(from, to uint64) *Detector {
+	d := NewDetector("test_chain", 10)
+	for h := from; h <= to; h++ {
+		d.Record(h, fmt.Sprintf("0x%d", h))
+	}
+	return d
+}
+
+func TestDetector_Record(t *testing.T) {
+	d := newTestDetector(1, 25)
+
+	_, found := d.Hash(15)
+	require.False(t, found)
+	hash, found := d.Hash(16)
+	require.True(t, found)
+	require.Equal(t, "0x16", hash)
+}
+
+func TestDetector_Detect(t *testing.T) {
+	t.Run("should not detect reorg if the highest block is canonical", func(t *testing.T) {
+		d := newTestDetector(100, 105)
+		calls := 0
+		_, found, err := d.Detect(canonicalChain(200, &calls))
+		require.NoError(t, err)
+		require.False(t, found)
+		require.Equal(t, 1, calls)
+	})
+	t.Run("should detect the lowest replaced block", func(t *testing.T) {
+		d := newTestDetector(100, 105)
+		calls := 0
+		forkHeight, found, err := d.Detect(canonicalChain(103, &calls))
+		require.NoError(t, err)
+		require.True(t, found)
+		require.EqualValues(t, 103, forkHeight)
+		require.Equal(t, 4, calls)
+
+		// the replaced blocks are forgotten
+		_, found = d.Hash(103)
+		require.False(t, found)
+		_, found = d.Hash(102)
+		require.True(t, found)
+	})
+	t.Run("should detect reorg deeper than the window at the lowest tracked block", func(t *testing.T) {
+		d := newTestDetector(100, 120)
+		calls := 0
+		forkHeight, found, err := d.Detect(canonicalChain(50, &calls))
+		require.NoError(t, err)
+		require.True(t, found)
+		require.EqualValues(t, 111, forkHeight)
+	})
+	t.Run("should return error if the canonical hash can't be queried", func(t *testing.T) {
+		d := newTestDetector(100, 105)
+		_, _, err := d.Detect(func(_ uint64) (string, error) {
+			return "", errors.New("connection refused")
+		})
+		require.ErrorContains(t, err, "connection refused")
+	})
+}
+
+func TestDetector_CheckCanonical(t *testing.T) {
+	d := NewDetector("test_chain", DefaultWindow)
+	require.NoError(t, d.CheckCanonical(100, "0xABC", "0xabc"))
+	require.ErrorIs(t, d.CheckCanonical(100, "0xabc", "0xdef"), ErrNotCanonical)
+}
@@ -0,0 +1,164 @@
+// Package rescan re-observes the inbounds of past blocks missed by the chain observers
+package rescan
+
+import (
+	"fmt"
+	"sync"
+
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// CctxQuerier queries the cctxs created by an inbound
+type CctxQuerier interface {
+	// GetInboundHashToCctxData returns the cctxs created by the inbound and the indexes of the pruned ones
+	// none if the inbound has no cctx
+	GetInboundHashToCctxData(inboundHash string) (*crosschaintypes.QueryInboundHashToCctxDataResponse, error)
+}
+
+// PostFunc posts the inbound vote to zetacore and returns the ballot
+type PostFunc func() (string, error)
+
+// Inbound is an inbound found by a rescan
+type Inbound struct {
+	InboundHash string `json:"inbound_hash"`
+	BlockHeight uint64 `json:"block_height"`
+	CoinType    string `json:"coin_type"`
+	Sender      string `json:"sender"`
+	Receiver    string `json:"receiver"`
+	Amount      string `json:"amount"`
+
+	// Ballot is the ballot of the inbound vote
+	Ballot string `json:"ballot"`
+
+	// CctxIndexes are the cctxs already created by the inbound, the inbound is skipped if any
+	CctxIndexes []string `json:"cctx_indexes,omitempty"`
+
+	// Voted is true if the vote was posted to zetacore
+	Voted bool `json:"voted"`
+}
+
+// Report is the report of a rescan of the block range [FromBlock, ToBlock]
+type Report struct {
+	ChainID   int64  `json:"chain_id"`
+	FromBlock uint64 `json:"from_block"`
+	ToBlock   uint64 `json:"to_block"`
+
+	// DryRun is true if the votes of the missing inbounds were not posted
+	DryRun bool `json:"dry_run"`
+
+	// Inbounds are the inbounds found in the block range, in the order they were observed
+	Inbounds []Inbound `json:"inbounds"`
+
+	// Missing is the number of inbounds without cctx
+	Missing int `json:"missing"`
+
+	// Voted is the number of votes posted to zetacore
+	Voted int `json:"voted"`
+}
+
+// Session tracks the inbounds found by a rescan
+// the inbounds already having a cctx are skipped, the votes of the others are posted unless in dry-run
+type Session struct {
+	querier CctxQuerier
+
+	mu     sync.Mutex
+	report Report
+
+	// seen are the inbound events voted or being voted, by inbound hash and event index
+	seen map[inboundEvent]bool
+}
+
+// inboundEvent identifies an inbound event, a tx can hold several inbound events
+type inboundEvent struct {
+	hash       string
+	eventIndex uint64
+}
+
+// NewSession creates a session for the rescan of the block range [fromBlock, toBlock] of the chain
+func NewSession(querier CctxQuerier, chainID int64, fromBlock, toBlock uint64, dryRun bool) *Session {
+	return &Session{
+		querier: querier,
+		report: Report{
+			ChainID:   chainID,
+			FromBlock: fromBlock,
+			ToBlock:   toBlock,
+			DryRun:    dryRun,
+			Inbounds:  make([]Inbound, 0),
+		},
+		seen: make(map[inboundEvent]bool),
+	}
+}
+
+// Vote records the inbound of the vote message and posts the vote if the inbound has no cctx yet
+// returns the ballot of the vote message, the ballot is not posted if skipped
+func (s *Session) Vote(msg *crosschaintypes.MsgVoteInbound, post PostFunc) (string, error) {
+	inbound := Inbound{
+		InboundHash: msg.InboundHash,
+		BlockHeight: msg.InboundBlockHeight,
+		CoinType:    msg.CoinType.String(),
+		Sender:      msg.Sender,
+		Receiver:    msg.Receiver,
+		Amount:      msg.Amount.String(),
+		Ballot:      msg.Digest(),
+	}
+
+	// an inbound event is only voted once per rescan, it is held as in flight while being voted
+	// and released if the vote fails so that it can be voted again
+	event := inboundEvent{hash: msg.InboundHash, eventIndex: msg.EventIndex}
+	s.mu.Lock()
+	if s.seen[event] {
+		s.mu.Unlock()
+		return inbound.Ballot, nil
+	}
+	s.seen[event] = true
+	s.mu.Unlock()
+
+	res, err := s.querier.GetInboundHashToCctxData(msg.InboundHash)
+	if err != nil {
+		s.release(event)
+		return "", fmt.Errorf("error querying cctxs of inbound %s: %w", msg.InboundHash, err)
+	}
+	for _, cctx := range res.CrossChainTxs {
+		inbound.CctxIndexes = append(inbound.CctxIndexes, cctx.Index)
+	}
+	// the cctxs pruned by the retention policy were created by the inbound, it must not be voted again
+	inbound.CctxIndexes = append(inbound.CctxIndexes, res.PrunedCctxIndexes...)
+	missing := len(inbound.CctxIndexes) == 0
+
+	if missing && !s.report.DryRun {
+		ballot, err := post()
+		if err != nil {
+			s.release(event)
+			return "", err
+		}
+		inbound.Ballot = ballot
+		inbound.Voted = true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.report.Inbounds = append(s.report.Inbounds, inbound)
+	if missing {
+		s.report.Missing++
+	}
+	if inbound.Voted {
+		s.report.Voted++
+	}
+	return inbound.Ballot, nil
+}
+
+// release releases the inbound event whose vote failed
+func (s *Session) release(event inboundEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.seen, event)
+}
+
+// Report returns the report of the inbounds found so far
+func (s *Session) Report() *Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	report := s.report
+	report.Inbounds = append([]Inbound{}, s.report.Inbounds...)
+	return &report
+}
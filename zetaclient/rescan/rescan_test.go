@@ -0,0 +1,208 @@
+package rescan_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	"github.com/zeta-chain/zetacore/zetaclient/rescan"
+)
+
+// fakeQuerier returns the cctxs and the pruned cctx indexes of the inbounds
+type fakeQuerier struct {
+	cctxs  map[string][]crosschaintypes.CrossChainTx
+	pruned map[string][]string
+	err    error
+}
+
+func (q *fakeQuerier) GetInboundHashToCctxData(
+	inboundHash string,
+) (*crosschaintypes.QueryInboundHashToCctxDataResponse, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	return &crosschaintypes.QueryInboundHashToCctxDataResponse{
+		CrossChainTxs:     q.cctxs[inboundHash],
+		PrunedCctxIndexes: q.pruned[inboundHash],
+	}, nil
+}
+
+func newVoteMsg(inboundHash string) *crosschaintypes.MsgVoteInbound {
+	msg := sample.InboundVote(0, 1, 7000)
+	msg.InboundHash = inboundHash
+	return &msg
+}
+
+func TestSession_Vote(t *testing.T) {
+	querier := &fakeQuerier{
+		cctxs: map[string][]crosschaintypes.CrossChainTx{
+			"0x01": {{Index: "0xcctx"}},
+		},
+		pruned: map[string][]string{
+			"0x03": {"0xpruned"},
+		},
+	}
+
+	t.Run("should vote on inbound without cctx", func(t *testing.T) {
+		session := rescan.NewSession(querier, 1, 10, 20, false)
+		posted := 0
+		ballot, err := session.Vote(newVoteMsg("0x02"), func() (string, error) {
+			posted++
+			return "ballot", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "ballot", ballot)
+		require.Equal(t, 1, posted)
+
+		report := session.Report()
+		require.Len(t, report.Inbounds, 1)
+		require.True(t, report.Inbounds[0].Voted)
+		require.Equal(t, 1, report.Missing)
+		require.Equal(t, 1, report.Voted)
+	})
+	t.Run("should skip inbound having a cctx", func(t *testing.T) {
+		session := rescan.NewSession(querier, 1, 10, 20, false)
+		msg := newVoteMsg("0x01")
+		ballot, err := session.Vote(msg, func() (string, error) {
+			require.FailNow(t, "vote should not be posted")
+			return "", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, msg.Digest(), ballot)
+
+		report := session.Report()
+		require.Equal(t, []string{"0xcctx"}, report.Inbounds[0].CctxIndexes)
+		require.Zero(t, report.Missing)
+		require.Zero(t, report.Voted)
+	})
+	t.Run("should skip inbound having a pruned cctx", func(t *testing.T) {
+		session := rescan.NewSession(querier, 1, 10, 20, false)
+		_, err := session.Vote(newVoteMsg("0x03"), func() (string, error) {
+			require.FailNow(t, "vote should not be posted")
+			return "", nil
+		})
+		require.NoError(t, err)
+
+		report := session.Report()
+		require.Equal(t, []string{"0xpruned"}, report.Inbounds[0].CctxIndexes)
+		require.Zero(t, report.Missing)
+		require.Zero(t, report.Voted)
+	})
+	t.Run("should only report inbound without cctx in dry-run", func(t *testing.T) {
+		session := rescan.NewSession(querier, 1, 10, 20, true)
+		msg := newVoteMsg("0x02")
+		ballot, err := session.Vote(msg, func() (string, error) {
+			require.FailNow(t, "vote should not be posted")
+			return "", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, msg.Digest(), ballot)
+
+		report := session.Report()
+		require.True(t, report.DryRun)
+		require.False(t, report.Inbounds[0].Voted)
+		require.Equal(t, 1, report.Missing)
+		require.Zero(t, report.Voted)
+	})
+	t.Run("should report a ballot once", func(t *testing.T) {
+		session := rescan.NewSession(querier, 1, 10, 20, false)
+		posted := 0
+		post := func() (string, error) {
+			posted++
+			return "ballot", nil
+		}
+		msg := newVoteMsg("0x02")
+		_, err := session.Vote(msg, post)
+		require.NoError(t, err)
+		_, err = session.Vote(msg, post)
+		require.NoError(t, err)
+		require.Equal(t, 1, posted)
+		require.Len(t, session.Report().Inbounds, 1)
+	})
+	t.Run("should vote once on concurrent votes of an inbound event", func(t *testing.T) {
+		session := rescan.NewSession(querier, 1, 10, 20, false)
+		posting := make(chan struct{})
+		release := make(chan struct{})
+		posted := 0
+		msg := newVoteMsg("0x02")
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := session.Vote(msg, func() (string, error) {
+				posted++
+				close(posting)
+				<-release
+				return "ballot", nil
+			})
+			require.NoError(t, err)
+		}()
+
+		// the inbound event is in flight
+		<-posting
+		ballot, err := session.Vote(msg, func() (string, error) {
+			require.FailNow(t, "vote should not be posted twice")
+			return "", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, msg.Digest(), ballot)
+
+		close(release)
+		wg.Wait()
+		require.Equal(t, 1, posted)
+		require.Len(t, session.Report().Inbounds, 1)
+	})
+	t.Run("should vote on every inbound event of a tx", func(t *testing.T) {
+		session := rescan.NewSession(querier, 1, 10, 20, false)
+		posted := 0
+		post := func() (string, error) {
+			posted++
+			return "ballot", nil
+		}
+		msg0 := newVoteMsg("0x02")
+		msg1 := newVoteMsg("0x02")
+		msg1.EventIndex = msg0.EventIndex + 1
+		_, err := session.Vote(msg0, post)
+		require.NoError(t, err)
+		_, err = session.Vote(msg1, post)
+		require.NoError(t, err)
+		require.Equal(t, 2, posted)
+		require.Len(t, session.Report().Inbounds, 2)
+	})
+	t.Run("should vote again on an inbound event whose vote failed", func(t *testing.T) {
+		session := rescan.NewSession(querier, 1, 10, 20, false)
+		msg := newVoteMsg("0x02")
+		_, err := session.Vote(msg, func() (string, error) {
+			return "", errors.New("paused")
+		})
+		require.Error(t, err)
+
+		ballot, err := session.Vote(msg, func() (string, error) {
+			return "ballot", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "ballot", ballot)
+		require.Equal(t, 1, session.Report().Voted)
+	})
+	t.Run("should fail if cctxs can't be queried", func(t *testing.T) {
+		session := rescan.NewSession(&fakeQuerier{err: errors.New("rpc failed")}, 1, 10, 20, false)
+		_, err := session.Vote(newVoteMsg("0x02"), func() (string, error) {
+			return "ballot", nil
+		})
+		require.ErrorContains(t, err, "rpc failed")
+		require.Empty(t, session.Report().Inbounds)
+	})
+	t.Run("should fail if vote can't be posted", func(t *testing.T) {
+		session := rescan.NewSession(querier, 1, 10, 20, false)
+		_, err := session.Vote(newVoteMsg("0x02"), func() (string, error) {
+			return "", errors.New("paused")
+		})
+		require.ErrorContains(t, err, "paused")
+		require.Empty(t, session.Report().Inbounds)
+	})
+}
@@ -0,0 +1,444 @@
+// Package rpcpool routes the RPC reads of an external chain to the healthiest of several endpoints
+package rpcpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/zeta-chain/zetacore/zetaclient/metrics"
+)
+
+const (
+	// ModuleObserver is the module name of the pools used by the chain observers
+	ModuleObserver = "observer"
+
+	// ModuleSigner is the module name of the pools used by the chain signers
+	ModuleSigner = "signer"
+
+	// HealthCheckInterval is the interval between two health checks of the endpoints
+	HealthCheckInterval = 30 * time.Second
+
+	// MaxLatency is the probe latency above which an endpoint gets the full latency penalty
+	MaxLatency = 5 * time.Second
+
+	// errorRateAlpha is the weight of the last request in the moving average of the error rate
+	errorRateAlpha = 0.1
+
+	// weights of the penalties in the health score
+	errorRateWeight = 0.5
+	blockLagWeight  = 0.3
+	latencyWeight   = 0.2
+)
+
+// ErrNoQuorum is returned when not enough endpoints agree on the result of a cross-checked read
+var ErrNoQuorum = errors.New("rpc quorum not reached")
+
+// ProbeFunc returns the latest block height of the endpoint
+type ProbeFunc[C any] func(ctx context.Context, client C) (uint64, error)
+
+// FailureFunc tells whether the error is caused by the endpoint (network, http, rate limit, ...)
+// application errors (e.g. not found, invalid params) don't degrade the endpoint and are not retried
+type FailureFunc func(err error) bool
+
+// Endpoint is an RPC endpoint of the pool with its health stats
+type Endpoint[C any] struct {
+	// Name is the endpoint name used in logs and metrics, without credentials or api keys
+	Name string
+
+	// Client is the RPC client connected to the endpoint
+	Client C
+
+	mu        sync.Mutex
+	down      bool
+	latency   time.Duration
+	height    uint64
+	blockLag  uint64
+	errorRate float64
+}
+
+// NewEndpoint creates a new endpoint
+func NewEndpoint[C any](name string, client C) *Endpoint[C] {
+	return &Endpoint[C]{Name: name, Client: client}
+}
+
+// Stats is a snapshot of the health stats of an endpoint
+type Stats struct {
+	Name      string
+	Down      bool
+	Latency   time.Duration
+	Height    uint64
+	BlockLag  uint64
+	ErrorRate float64
+	Score     float64
+}
+
+// Pool routes the requests to the endpoints in the order of their health score
+type Pool[C any] struct {
+	chain       string
+	module      string
+	endpoints   []*Endpoint[C]
+	probe       ProbeFunc[C]
+	isFailure   FailureFunc
+	quorum      int
+	maxBlockLag uint64
+	logger      zerolog.Logger
+}
+
+// New creates a new pool of endpoints for the chain, the module distinguishes the pools of a chain in metrics
+//   - the endpoints are ranked in the given order until the first health check
+//   - quorum is the number of endpoints that must agree on a cross-checked read
+//   - maxBlockLag is the number of blocks behind the highest endpoint above which an endpoint is considered down
+func New[C any](
+	chain string,
+	module string,
+	endpoints []*Endpoint[C],
+	probe ProbeFunc[C],
+	isFailure FailureFunc,
+	quorum int,
+	maxBlockLag uint64,
+	logger zerolog.Logger,
+) (*Pool[C], error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no rpc endpoint for chain %s", chain)
+	}
+	if quorum < 1 {
+		quorum = 1
+	}
+	if quorum > len(endpoints) {
+		return nil, fmt.Errorf("rpc quorum %d is greater than the number of endpoints %d", quorum, len(endpoints))
+	}
+
+	return &Pool[C]{
+		chain:       chain,
+		module:      module,
+		endpoints:   endpoints,
+		probe:       probe,
+		isFailure:   isFailure,
+		quorum:      quorum,
+		maxBlockLag: maxBlockLag,
+		logger:      logger,
+	}, nil
+}
+
+// Endpoints returns the endpoints of the pool in the configured order
+func (p *Pool[C]) Endpoints() []*Endpoint[C] {
+	return p.endpoints
+}
+
+// Quorum returns the number of endpoints that must agree on a cross-checked read
+func (p *Pool[C]) Quorum() int {
+	return p.quorum
+}
+
+// CheckHealth probes all the endpoints, updates their stats and returns the number of healthy endpoints
+func (p *Pool[C]) CheckHealth(ctx context.Context) int {
+	type result struct {
+		height  uint64
+		latency time.Duration
+		err     error
+	}
+
+	// probe the endpoints concurrently
+	results := make([]result, len(p.endpoints))
+	var wg sync.WaitGroup
+	for i, endpoint := range p.endpoints {
+		wg.Add(1)
+		go func(i int, endpoint *Endpoint[C]) {
+			defer wg.Done()
+			start := time.Now()
+			height, err := p.probe(ctx, endpoint.Client)
+			results[i] = result{height: height, latency: time.Since(start), err: err}
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	// the block lag is relative to the highest endpoint
+	var maxHeight uint64
+	for _, res := range results {
+		if res.err == nil && res.height > maxHeight {
+			maxHeight = res.height
+		}
+	}
+
+	healthy := 0
+	for i, endpoint := range p.endpoints {
+		res := results[i]
+		endpoint.mu.Lock()
+		endpoint.latency = res.latency
+		endpoint.recordRequest(res.err != nil)
+		if res.err != nil {
+			endpoint.down = true
+			p.logger.Warn().Err(res.err).Msgf("rpc endpoint %s of chain %s is down", endpoint.Name, p.chain)
+		} else {
+			endpoint.height = res.height
+			endpoint.blockLag = maxHeight - res.height
+			endpoint.down = p.maxBlockLag > 0 && endpoint.blockLag > p.maxBlockLag
+			if endpoint.down {
+				p.logger.Warn().
+					Msgf("rpc endpoint %s of chain %s is %d blocks behind", endpoint.Name, p.chain, endpoint.blockLag)
+			}
+		}
+		if !endpoint.down {
+			healthy++
+		}
+		endpoint.mu.Unlock()
+		p.exportStats(endpoint.stats(p.maxBlockLag))
+	}
+
+	return healthy
+}
+
+// Stats returns the stats of the endpoints in the order of their health score
+func (p *Pool[C]) Stats() []Stats {
+	ranked := p.ranked()
+	stats := make([]Stats, len(ranked))
+	for i, endpoint := range ranked {
+		endpoint.mu.Lock()
+		stats[i] = endpoint.stats(p.maxBlockLag)
+		endpoint.mu.Unlock()
+	}
+	return stats
+}
+
+// EndpointStatuses converts the stats of the endpoints to the status reported by the telemetry server
+// returns the number of endpoints up and the status of each endpoint
+func EndpointStatuses(stats []Stats) (int, []metrics.RPCEndpointStatus) {
+	healthy := 0
+	statuses := make([]metrics.RPCEndpointStatus, len(stats))
+	for i, s := range stats {
+		if !s.Down {
+			healthy++
+		}
+		statuses[i] = metrics.RPCEndpointStatus{
+			Name:      s.Name,
+			Down:      s.Down,
+			Height:    s.Height,
+			BlockLag:  s.BlockLag,
+			LatencyMs: s.Latency.Milliseconds(),
+			ErrorRate: s.ErrorRate,
+		}
+	}
+	return healthy, statuses
+}
+
+// Do calls fn on the endpoints in the order of their health score until one of them doesn't fail
+// the error of an endpoint is returned as is if it is not a failure of the endpoint
+func (p *Pool[C]) Do(fn func(client C) error) error {
+	return p.DoWhere(nil, fn)
+}
+
+// DoWhere is like Do but only calls fn on the eligible endpoints (e.g. the endpoints serving a wallet)
+// all the endpoints are eligible if eligible is nil
+func (p *Pool[C]) DoWhere(eligible func(endpoint *Endpoint[C]) bool, fn func(client C) error) error {
+	var errs []error
+	for _, endpoint := range p.ranked() {
+		if eligible != nil && !eligible(endpoint) {
+			continue
+		}
+		err := fn(endpoint.Client)
+		failed := p.isFailure(err)
+		p.record(endpoint, failed)
+		if !failed {
+			return err
+		}
+		p.logger.Debug().Err(err).Msgf("rpc endpoint %s of chain %s failed, trying next one", endpoint.Name, p.chain)
+		errs = append(errs, fmt.Errorf("%s: %w", endpoint.Name, err))
+	}
+	if len(errs) == 0 {
+		return fmt.Errorf("no eligible rpc endpoint of chain %s", p.chain)
+	}
+	return fmt.Errorf("all rpc endpoints of chain %s failed: %w", p.chain, errors.Join(errs...))
+}
+
+// Call calls fn on the healthiest endpoint and fails over to the next endpoints
+func Call[C any, T any](p *Pool[C], fn func(client C) (T, error)) (T, error) {
+	return CallWhere(p, nil, fn)
+}
+
+// CallWhere calls fn on the healthiest eligible endpoint and fails over to the next eligible endpoints
+func CallWhere[C any, T any](
+	p *Pool[C],
+	eligible func(endpoint *Endpoint[C]) bool,
+	fn func(client C) (T, error),
+) (T, error) {
+	var result T
+	err := p.DoWhere(eligible, func(client C) error {
+		var err error
+		result, err = fn(client)
+		return err
+	})
+	return result, err
+}
+
+// CallQuorum calls fn on the endpoints in the order of their health score until the results of quorum endpoints
+// have the same key, so that a single faulty or malicious endpoint can't make the observer vote on a wrong result
+//   - the call is not cross-checked if the quorum is 1
+//   - if all the endpoints return the same error (e.g. not found), the error is returned as is
+func CallQuorum[C any, T any](p *Pool[C], fn func(client C) (T, error), key func(result T) string) (T, error) {
+	if p.quorum <= 1 {
+		return Call(p, fn)
+	}
+
+	var (
+		firstErr error
+		errs     []error
+		votes    = make(map[string]int)
+	)
+	for _, endpoint := range p.ranked() {
+		result, err := fn(endpoint.Client)
+		p.record(endpoint, p.isFailure(err))
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			errs = append(errs, fmt.Errorf("%s: %w", endpoint.Name, err))
+			continue
+		}
+
+		k := key(result)
+		votes[k]++
+		if votes[k] >= p.quorum {
+			return result, nil
+		}
+	}
+
+	var zero T
+	metrics.RPCQuorumFailures.WithLabelValues(p.chain, p.module).Inc()
+	if len(votes) == 0 && firstErr != nil && allEqual(errs, firstErr) {
+		return zero, firstErr
+	}
+	return zero, fmt.Errorf(
+		"%w for chain %s: %d distinct results from %d endpoints, quorum %d: %v",
+		ErrNoQuorum, p.chain, len(votes), len(p.endpoints), p.quorum, errors.Join(errs...),
+	)
+}
+
+// EndpointName returns the scheme-less host of the endpoint, hiding the credentials and api keys of the URL
+func EndpointName(endpoint string) string {
+	raw := endpoint
+	if !strings.Contains(raw, "://") {
+		raw = "http://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return "invalid"
+	}
+	return u.Host
+}
+
+// EndpointNames returns the names of the endpoints, with an index suffix on duplicated names
+func EndpointNames(endpoints []string) []string {
+	names := make([]string, len(endpoints))
+	count := make(map[string]int, len(endpoints))
+	for i, endpoint := range endpoints {
+		name := EndpointName(endpoint)
+		if count[name] > 0 {
+			names[i] = fmt.Sprintf("%s#%d", name, count[name])
+		} else {
+			names[i] = name
+		}
+		count[name]++
+	}
+	return names
+}
+
+// ranked returns the endpoints sorted by decreasing health score, keeping the configured order on ties
+func (p *Pool[C]) ranked() []*Endpoint[C] {
+	scores := make(map[*Endpoint[C]]float64, len(p.endpoints))
+	for _, endpoint := range p.endpoints {
+		endpoint.mu.Lock()
+		scores[endpoint] = endpoint.score(p.maxBlockLag)
+		endpoint.mu.Unlock()
+	}
+
+	ranked := make([]*Endpoint[C], len(p.endpoints))
+	copy(ranked, p.endpoints)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[ranked[i]] > scores[ranked[j]]
+	})
+	return ranked
+}
+
+// record records the outcome of a request to the endpoint
+func (p *Pool[C]) record(endpoint *Endpoint[C], failed bool) {
+	endpoint.mu.Lock()
+	endpoint.recordRequest(failed)
+	stats := endpoint.stats(p.maxBlockLag)
+	endpoint.mu.Unlock()
+
+	status := "ok"
+	if failed {
+		status = "failed"
+	}
+	metrics.RPCEndpointRequests.WithLabelValues(p.chain, p.module, endpoint.Name, status).Inc()
+	p.exportStats(stats)
+}
+
+// exportStats exports the stats of the endpoint to prometheus
+func (p *Pool[C]) exportStats(stats Stats) {
+	metrics.RPCEndpointHealthScore.WithLabelValues(p.chain, p.module, stats.Name).Set(stats.Score)
+	metrics.RPCEndpointLatency.WithLabelValues(p.chain, p.module, stats.Name).Set(stats.Latency.Seconds())
+	metrics.RPCEndpointBlockLag.WithLabelValues(p.chain, p.module, stats.Name).Set(float64(stats.BlockLag))
+	metrics.RPCEndpointErrorRate.WithLabelValues(p.chain, p.module, stats.Name).Set(stats.ErrorRate)
+}
+
+// recordRequest updates the moving average of the error rate, the caller must hold the lock
+func (e *Endpoint[C]) recordRequest(failed bool) {
+	outcome := 0.0
+	if failed {
+		outcome = 1.0
+	}
+	e.errorRate = e.errorRate*(1-errorRateAlpha) + outcome*errorRateAlpha
+}
+
+// score returns the health score of the endpoint in [0, 1], the caller must hold the lock
+// a down endpoint scores 0, a healthy endpoint is penalized by its error rate, block lag and latency
+func (e *Endpoint[C]) score(maxBlockLag uint64) float64 {
+	if e.down {
+		return 0
+	}
+	score := 1 - errorRateWeight*e.errorRate
+	if maxBlockLag > 0 {
+		score -= blockLagWeight * minFloat(float64(e.blockLag)/float64(maxBlockLag), 1)
+	}
+	score -= latencyWeight * minFloat(float64(e.latency)/float64(MaxLatency), 1)
+	return score
+}
+
+// stats returns a snapshot of the endpoint stats, the caller must hold the lock
+func (e *Endpoint[C]) stats(maxBlockLag uint64) Stats {
+	return Stats{
+		Name:      e.Name,
+		Down:      e.down,
+		Latency:   e.latency,
+		Height:    e.height,
+		BlockLag:  e.blockLag,
+		ErrorRate: e.errorRate,
+		Score:     e.score(maxBlockLag),
+	}
+}
+
+// allEqual returns true if all the wrapped errors have the same message as err
+func allEqual(errs []error, err error) bool {
+	for _, e := range errs {
+		if errors.Unwrap(e).Error() != err.Error() {
+			return false
+		}
+	}
+	return true
+}
+
+func minFloat(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
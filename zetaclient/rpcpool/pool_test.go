@@ -0,0 +1,224 @@
+package rpcpool_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/zetaclient/rpcpool"
+)
+
+var (
+	errNetwork  = errors.New("connection refused")
+	errNotFound = errors.New("not found")
+)
+
+// fakeClient is an RPC client with a settable block height and result
+type fakeClient struct {
+	height uint64
+	result string
+	err    error
+	calls  int
+}
+
+func (c *fakeClient) get() (string, error) {
+	c.calls++
+	return c.result, c.err
+}
+
+func newTestPool(t *testing.T, quorum int, clients ...*fakeClient) *rpcpool.Pool[*fakeClient] {
+	endpoints := make([]*rpcpool.Endpoint[*fakeClient], len(clients))
+	for i, client := range clients {
+		endpoints[i] = rpcpool.NewEndpoint(string(rune('a'+i)), client)
+	}
+	probe := func(_ context.Context, c *fakeClient) (uint64, error) {
+		if errors.Is(c.err, errNetwork) {
+			return 0, c.err
+		}
+		return c.height, nil
+	}
+	isFailure := func(err error) bool {
+		return err != nil && !errors.Is(err, errNotFound)
+	}
+	pool, err := rpcpool.New("test", rpcpool.ModuleObserver, endpoints, probe, isFailure, quorum, 10, zerolog.Nop())
+	require.NoError(t, err)
+	return pool
+}
+
+func get(c *fakeClient) (string, error) {
+	return c.get()
+}
+
+func identity(s string) string {
+	return s
+}
+
+func TestNew(t *testing.T) {
+	t.Run("should fail without endpoints", func(t *testing.T) {
+		_, err := rpcpool.New[*fakeClient]("test", rpcpool.ModuleObserver, nil, nil, nil, 1, 0, zerolog.Nop())
+		require.ErrorContains(t, err, "no rpc endpoint")
+	})
+	t.Run("should fail if quorum is greater than the number of endpoints", func(t *testing.T) {
+		endpoints := []*rpcpool.Endpoint[*fakeClient]{rpcpool.NewEndpoint("a", &fakeClient{})}
+		_, err := rpcpool.New("test", rpcpool.ModuleObserver, endpoints, nil, nil, 2, 0, zerolog.Nop())
+		require.ErrorContains(t, err, "quorum")
+	})
+}
+
+func TestCall(t *testing.T) {
+	t.Run("should use the endpoints in the configured order before the first health check", func(t *testing.T) {
+		a, b := &fakeClient{result: "a"}, &fakeClient{result: "b"}
+		pool := newTestPool(t, 1, a, b)
+
+		result, err := rpcpool.Call(pool, get)
+		require.NoError(t, err)
+		require.Equal(t, "a", result)
+		require.Zero(t, b.calls)
+	})
+	t.Run("should fail over to the next endpoint on endpoint failure", func(t *testing.T) {
+		a, b := &fakeClient{err: errNetwork}, &fakeClient{result: "b"}
+		pool := newTestPool(t, 1, a, b)
+
+		result, err := rpcpool.Call(pool, get)
+		require.NoError(t, err)
+		require.Equal(t, "b", result)
+
+		// the failed endpoint is ranked last
+		require.Equal(t, "b", pool.Stats()[0].Name)
+		require.Greater(t, pool.Stats()[1].ErrorRate, 0.0)
+	})
+	t.Run("should not fail over on application error", func(t *testing.T) {
+		a, b := &fakeClient{err: errNotFound}, &fakeClient{result: "b"}
+		pool := newTestPool(t, 1, a, b)
+
+		_, err := rpcpool.Call(pool, get)
+		require.ErrorIs(t, err, errNotFound)
+		require.Zero(t, b.calls)
+	})
+	t.Run("should fail if all endpoints fail", func(t *testing.T) {
+		pool := newTestPool(t, 1, &fakeClient{err: errNetwork}, &fakeClient{err: errNetwork})
+		_, err := rpcpool.Call(pool, get)
+		require.ErrorIs(t, err, errNetwork)
+	})
+}
+
+func TestCallWhere(t *testing.T) {
+	onlyB := func(endpoint *rpcpool.Endpoint[*fakeClient]) bool {
+		return endpoint.Name == "b"
+	}
+
+	t.Run("should only call the eligible endpoints", func(t *testing.T) {
+		a, b := &fakeClient{result: "a"}, &fakeClient{result: "b"}
+		result, err := rpcpool.CallWhere(newTestPool(t, 1, a, b), onlyB, get)
+		require.NoError(t, err)
+		require.Equal(t, "b", result)
+		require.Equal(t, 0, a.calls)
+	})
+
+	t.Run("should fail if no endpoint is eligible", func(t *testing.T) {
+		a := &fakeClient{result: "a"}
+		_, err := rpcpool.CallWhere(newTestPool(t, 1, a), onlyB, get)
+		require.ErrorContains(t, err, "no eligible rpc endpoint")
+		require.Equal(t, 0, a.calls)
+	})
+}
+
+func TestCheckHealth(t *testing.T) {
+	t.Run("should rank down and lagging endpoints last", func(t *testing.T) {
+		down := &fakeClient{err: errNetwork, result: "down"}
+		lagging := &fakeClient{height: 80, result: "lagging"}
+		best := &fakeClient{height: 100, result: "best"}
+		pool := newTestPool(t, 1, down, lagging, best)
+
+		require.Equal(t, 1, pool.CheckHealth(context.Background()))
+		stats := pool.Stats()
+		require.Equal(t, "c", stats[0].Name)
+		require.False(t, stats[0].Down)
+		require.EqualValues(t, 100, stats[0].Height)
+
+		// the down endpoints keep the configured order
+		require.Equal(t, "a", stats[1].Name)
+		require.True(t, stats[1].Down)
+		require.Equal(t, "b", stats[2].Name)
+		require.True(t, stats[2].Down)
+		require.EqualValues(t, 20, stats[2].BlockLag)
+		require.Zero(t, stats[2].Score)
+
+		result, err := rpcpool.Call(pool, get)
+		require.NoError(t, err)
+		require.Equal(t, "best", result)
+	})
+	t.Run("should penalize the block lag within the tolerance", func(t *testing.T) {
+		behind := &fakeClient{height: 95}
+		tip := &fakeClient{height: 100}
+		pool := newTestPool(t, 1, behind, tip)
+
+		require.Equal(t, 2, pool.CheckHealth(context.Background()))
+		stats := pool.Stats()
+		require.Equal(t, "b", stats[0].Name)
+		require.Greater(t, stats[0].Score, stats[1].Score)
+	})
+	t.Run("should report the status of the endpoints", func(t *testing.T) {
+		pool := newTestPool(t, 1, &fakeClient{err: errNetwork}, &fakeClient{height: 100})
+		pool.CheckHealth(context.Background())
+
+		healthy, statuses := rpcpool.EndpointStatuses(pool.Stats())
+		require.Equal(t, 1, healthy)
+		require.Len(t, statuses, 2)
+		require.Equal(t, "b", statuses[0].Name)
+		require.EqualValues(t, 100, statuses[0].Height)
+		require.True(t, statuses[1].Down)
+	})
+}
+
+func TestCallQuorum(t *testing.T) {
+	t.Run("should return the result agreed by the quorum", func(t *testing.T) {
+		pool := newTestPool(t, 2, &fakeClient{result: "x"}, &fakeClient{result: "y"}, &fakeClient{result: "y"})
+		result, err := rpcpool.CallQuorum(pool, get, identity)
+		require.NoError(t, err)
+		require.Equal(t, "y", result)
+	})
+	t.Run("should stop querying once the quorum is reached", func(t *testing.T) {
+		c := &fakeClient{result: "x"}
+		pool := newTestPool(t, 2, &fakeClient{result: "x"}, &fakeClient{result: "x"}, c)
+		_, err := rpcpool.CallQuorum(pool, get, identity)
+		require.NoError(t, err)
+		require.Zero(t, c.calls)
+	})
+	t.Run("should fail if the endpoints disagree", func(t *testing.T) {
+		pool := newTestPool(t, 2, &fakeClient{result: "x"}, &fakeClient{result: "y"}, &fakeClient{err: errNetwork})
+		_, err := rpcpool.CallQuorum(pool, get, identity)
+		require.ErrorIs(t, err, rpcpool.ErrNoQuorum)
+	})
+	t.Run("should return the common error of all endpoints", func(t *testing.T) {
+		pool := newTestPool(t, 2, &fakeClient{err: errNotFound}, &fakeClient{err: errNotFound})
+		_, err := rpcpool.CallQuorum(pool, get, identity)
+		require.Equal(t, errNotFound, err)
+	})
+	t.Run("should not cross-check with quorum 1", func(t *testing.T) {
+		b := &fakeClient{result: "y"}
+		pool := newTestPool(t, 1, &fakeClient{result: "x"}, b)
+		result, err := rpcpool.CallQuorum(pool, get, identity)
+		require.NoError(t, err)
+		require.Equal(t, "x", result)
+		require.Zero(t, b.calls)
+	})
+}
+
+func TestEndpointNames(t *testing.T) {
+	names := rpcpool.EndpointNames([]string{
+		"https://eth-mainnet.g.alchemy.com/v2/secret-key",
+		"https://eth-mainnet.g.alchemy.com/v2/other-key",
+		"user:password@bitcoin:18443",
+		"http://localhost:8545",
+	})
+	require.Equal(t, []string{
+		"eth-mainnet.g.alchemy.com",
+		"eth-mainnet.g.alchemy.com#1",
+		"bitcoin:18443",
+		"localhost:8545",
+	}, names)
+}
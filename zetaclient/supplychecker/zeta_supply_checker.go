@@ -65,12 +65,13 @@ func NewZetaSupplyChecker(
 		zetaSupplyChecker.evmClient[evmConfig.Chain.ChainId] = client
 	}
 
+	additionalChains := appContext.ZetacoreContext().GetAdditionalChains()
 	for chainID := range zetaSupplyChecker.evmClient {
-		chain := chains.GetChainFromChainID(chainID)
-		if chain.IsExternalChain() && chains.IsEVMChain(chain.ChainId) && !chains.IsEthereumChain(chain.ChainId) {
+		chain := chains.GetChainFromChainID(chainID, additionalChains)
+		if chain.IsExternalChain() && chain.IsEVMChain() && !chains.IsEthereumChain(chain.ChainId, additionalChains) {
 			zetaSupplyChecker.externalEvmChain = append(zetaSupplyChecker.externalEvmChain, *chain)
 		}
-		if chains.IsEthereumChain(chain.ChainId) {
+		if chains.IsEthereumChain(chain.ChainId, additionalChains) {
 			zetaSupplyChecker.ethereumChain = *chain
 		}
 	}
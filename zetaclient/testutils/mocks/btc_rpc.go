@@ -127,3 +127,7 @@ func (c *MockBTCRPCClient) WithRawTransactions(txs []*btcutil.Tx) *MockBTCRPCCli
 	c.Txs = append(c.Txs, txs...)
 	return c
 }
+
+func (c *MockBTCRPCClient) GetAddressInfo(_ string) (*btcjson.GetAddressInfoResult, error) {
+	return nil, errors.New("not implemented")
+}
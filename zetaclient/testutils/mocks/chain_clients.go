@@ -16,6 +16,8 @@ var _ interfaces.ChainObserver = (*EVMObserver)(nil)
 // EVMObserver is a mock of evm chain observer for testing
 type EVMObserver struct {
 	ChainParams observertypes.ChainParams
+	Started     bool
+	Stopped     bool
 }
 
 func NewEVMObserver(chainParams *observertypes.ChainParams) *EVMObserver {
@@ -25,9 +27,11 @@ func NewEVMObserver(chainParams *observertypes.ChainParams) *EVMObserver {
 }
 
 func (ob *EVMObserver) Start() {
+	ob.Started = true
 }
 
 func (ob *EVMObserver) Stop() {
+	ob.Stopped = true
 }
 
 func (ob *EVMObserver) IsOutboundProcessed(_ *crosschaintypes.CrossChainTx, _ zerolog.Logger) (bool, bool, error) {
@@ -57,6 +61,8 @@ var _ interfaces.ChainObserver = (*BTCObserver)(nil)
 // BTCObserver is a mock of btc chain observer for testing
 type BTCObserver struct {
 	ChainParams observertypes.ChainParams
+	Started     bool
+	Stopped     bool
 }
 
 func NewBTCObserver(chainParams *observertypes.ChainParams) *BTCObserver {
@@ -66,9 +72,11 @@ func NewBTCObserver(chainParams *observertypes.ChainParams) *BTCObserver {
 }
 
 func (ob *BTCObserver) Start() {
+	ob.Started = true
 }
 
 func (ob *BTCObserver) Stop() {
+	ob.Stopped = true
 }
 
 func (ob *BTCObserver) IsOutboundProcessed(_ *crosschaintypes.CrossChainTx, _ zerolog.Logger) (bool, bool, error) {
@@ -31,7 +31,10 @@ func (s subscription) Err() <-chan error {
 var _ interfaces.EVMRPCClient = &MockEvmClient{}
 
 type MockEvmClient struct {
-	Receipts []*ethtypes.Receipt
+	Receipts    []*ethtypes.Receipt
+	Header      *ethtypes.Header
+	GasTipCap   *big.Int
+	LatestBlock uint64
 }
 
 func NewMockEvmClient() *MockEvmClient {
@@ -56,6 +59,9 @@ func (e *MockEvmClient) CallContract(_ context.Context, _ ethereum.CallMsg, _ *b
 }
 
 func (e *MockEvmClient) HeaderByNumber(_ context.Context, _ *big.Int) (*ethtypes.Header, error) {
+	if e.Header != nil {
+		return e.Header, nil
+	}
 	return &ethtypes.Header{}, nil
 }
 
@@ -72,6 +78,9 @@ func (e *MockEvmClient) SuggestGasPrice(_ context.Context) (*big.Int, error) {
 }
 
 func (e *MockEvmClient) SuggestGasTipCap(_ context.Context) (*big.Int, error) {
+	if e.GasTipCap != nil {
+		return e.GasTipCap, nil
+	}
 	return big.NewInt(0), nil
 }
 
@@ -90,7 +99,7 @@ func (e *MockEvmClient) FilterLogs(_ context.Context, _ ethereum.FilterQuery) ([
 }
 
 func (e *MockEvmClient) BlockNumber(_ context.Context) (uint64, error) {
-	return 0, nil
+	return e.LatestBlock, nil
 }
 
 func (e *MockEvmClient) BlockByNumber(_ context.Context, _ *big.Int) (*ethtypes.Block, error) {
@@ -125,6 +134,9 @@ func (e *MockEvmClient) TransactionSender(
 
 func (e *MockEvmClient) Reset() *MockEvmClient {
 	e.Receipts = []*ethtypes.Receipt{}
+	e.Header = nil
+	e.GasTipCap = nil
+	e.LatestBlock = 0
 	return e
 }
 
@@ -140,3 +152,18 @@ func (e *MockEvmClient) WithReceipts(receipts []*ethtypes.Receipt) *MockEvmClien
 	e.Receipts = append(e.Receipts, receipts...)
 	return e
 }
+
+func (e *MockEvmClient) WithHeader(header *ethtypes.Header) *MockEvmClient {
+	e.Header = header
+	return e
+}
+
+func (e *MockEvmClient) WithBlockNumber(blockNumber uint64) *MockEvmClient {
+	e.LatestBlock = blockNumber
+	return e
+}
+
+func (e *MockEvmClient) WithGasTipCap(gasTipCap *big.Int) *MockEvmClient {
+	e.GasTipCap = gasTipCap
+	return e
+}
@@ -0,0 +1,93 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+)
+
+// EvmSubscriptionClient interface
+var _ interfaces.EVMSubscriptionClient = &MockEvmSubscriptionClient{}
+
+// errSubscription is a subscription interrupted by the mock client
+type errSubscription struct {
+	errCh chan error
+}
+
+func (s errSubscription) Unsubscribe() {
+}
+
+func (s errSubscription) Err() <-chan error {
+	return s.errCh
+}
+
+// MockEvmSubscriptionClient is a websocket client delivering the heads and logs pushed by the test
+type MockEvmSubscriptionClient struct {
+	heads      chan<- *ethtypes.Header
+	logs       chan<- ethtypes.Log
+	subscribed chan struct{}
+	errCh      chan error
+	err        error
+}
+
+func NewMockEvmSubscriptionClient() *MockEvmSubscriptionClient {
+	return &MockEvmSubscriptionClient{
+		subscribed: make(chan struct{}),
+		errCh:      make(chan error, 1),
+	}
+}
+
+func (c *MockEvmSubscriptionClient) SubscribeNewHead(
+	_ context.Context,
+	ch chan<- *ethtypes.Header,
+) (ethereum.Subscription, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.heads = ch
+	close(c.subscribed)
+	return errSubscription{errCh: c.errCh}, nil
+}
+
+func (c *MockEvmSubscriptionClient) SubscribeFilterLogs(
+	_ context.Context,
+	_ ethereum.FilterQuery,
+	ch chan<- ethtypes.Log,
+) (ethereum.Subscription, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.logs = ch
+	return errSubscription{errCh: c.errCh}, nil
+}
+
+func (c *MockEvmSubscriptionClient) Close() {
+}
+
+// ----------------------------------------------------------------------------
+// Feed data to the mock websocket client for testing
+// ----------------------------------------------------------------------------
+func (c *MockEvmSubscriptionClient) WithError(err error) *MockEvmSubscriptionClient {
+	c.err = err
+	return c
+}
+
+// PushHead delivers a new head once the client is subscribed
+func (c *MockEvmSubscriptionClient) PushHead(header *ethtypes.Header) {
+	<-c.subscribed
+	c.heads <- header
+}
+
+// PushLog delivers a log once the client is subscribed
+func (c *MockEvmSubscriptionClient) PushLog(log ethtypes.Log) {
+	<-c.subscribed
+	c.logs <- log
+}
+
+// Interrupt interrupts the subscriptions with the error
+func (c *MockEvmSubscriptionClient) Interrupt(err error) {
+	c.errCh <- err
+}
@@ -2,6 +2,8 @@ package mocks
 
 import (
 	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -18,16 +20,24 @@ import (
 // TestPrivateKey is a random private key for testing
 var TestPrivateKey *ecdsa.PrivateKey
 
-// init generates a random private key for testing
+// TestPrivateKeyEdDSA is a random ed25519 private key for testing
+var TestPrivateKeyEdDSA ed25519.PrivateKey
+
+// init generates random private keys for testing
 func init() {
 	var err error
 	TestPrivateKey, err = crypto.GenerateKey()
 	if err != nil {
 		fmt.Println(err.Error())
 	}
+	_, TestPrivateKeyEdDSA, err = ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
 }
 
 var _ interfaces.TSSSigner = (*TSS)(nil)
+var _ interfaces.TSSSignerEdDSA = (*TSS)(nil)
 
 // TSS is a mock of TSS signer for testing
 type TSS struct {
@@ -38,14 +48,18 @@ type TSS struct {
 
 	// set PrivKey if you want to use a specific private key
 	PrivKey *ecdsa.PrivateKey
+
+	// set PrivKeyEdDSA if you want to use a specific ed25519 private key
+	PrivKeyEdDSA ed25519.PrivateKey
 }
 
 func NewMockTSS(chain chains.Chain, evmAddress string, btcAddress string) *TSS {
 	return &TSS{
-		chain:      chain,
-		evmAddress: evmAddress,
-		btcAddress: btcAddress,
-		PrivKey:    TestPrivateKey,
+		chain:        chain,
+		evmAddress:   evmAddress,
+		btcAddress:   btcAddress,
+		PrivKey:      TestPrivateKey,
+		PrivKeyEdDSA: TestPrivateKeyEdDSA,
 	}
 }
 
@@ -75,6 +89,18 @@ func (s *TSS) Sign(data []byte, _ uint64, _ uint64, _ *chains.Chain, _ string) (
 	return sigbyte, nil
 }
 
+// SignEdDSA uses test ed25519 key unrelated to any tss key in production
+func (s *TSS) SignEdDSA(msg []byte, _ uint64, _ uint64, _ *chains.Chain) ([64]byte, error) {
+	var sigbyte [64]byte
+	_ = copy(sigbyte[:], ed25519.Sign(s.PrivKeyEdDSA, msg))
+	return sigbyte, nil
+}
+
+// PubKeyEdDSA returns the test ed25519 public key
+func (s *TSS) PubKeyEdDSA() ed25519.PublicKey {
+	return s.PrivKeyEdDSA.Public().(ed25519.PublicKey)
+}
+
 func (s *TSS) Pubkey() []byte {
 	publicKeyBytes := crypto.FromECDSAPub(&s.PrivKey.PublicKey)
 	return publicKeyBytes
@@ -88,7 +114,7 @@ func (s *TSS) EVMAddress() ethcommon.Address {
 	return crypto.PubkeyToAddress(s.PrivKey.PublicKey)
 }
 
-func (s *TSS) BTCAddress() string {
+func (s *TSS) BTCAddress(_ int64) string {
 	// force use btcAddress if set
 	if s.btcAddress != "" {
 		return s.btcAddress
@@ -101,7 +127,7 @@ func (s *TSS) BTCAddress() string {
 	return testnet3Addr.EncodeAddress()
 }
 
-func (s *TSS) BTCAddressWitnessPubkeyHash() *btcutil.AddressWitnessPubKeyHash {
+func (s *TSS) BTCAddressWitnessPubkeyHash(_ int64) *btcutil.AddressWitnessPubKeyHash {
 	// if privkey is set, use it to generate a segwit address
 	if s.PrivKey != nil {
 		pkBytes := crypto.FromECDSAPub(&s.PrivKey.PublicKey)
@@ -130,7 +156,7 @@ func (s *TSS) BTCAddressWitnessPubkeyHash() *btcutil.AddressWitnessPubKeyHash {
 		fmt.Printf("error getting btc chain params: %v", err)
 		return nil
 	}
-	tssAddress := s.BTCAddress()
+	tssAddress := s.BTCAddress(s.chain.ChainId)
 	addr, err := btcutil.DecodeAddress(tssAddress, net)
 	if err != nil {
 		return nil
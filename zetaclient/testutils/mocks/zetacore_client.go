@@ -36,18 +36,33 @@ type MockZetacoreClient struct {
 	// pending cctxs
 	pendingCctxs map[int64][]*crosschaintypes.CrossChainTx
 
+	// cctxs by index
+	cctxs map[string]*crosschaintypes.CrossChainTx
+
+	// cctxs by inbound hash
+	inboundCctxs map[string][]crosschaintypes.CrossChainTx
+
+	// inbound trackers by chain id
+	inboundTrackers map[int64][]crosschaintypes.InboundTracker
+
 	// rate limiter flags
 	rateLimiterFlags *crosschaintypes.RateLimiterFlags
 
 	// rate limiter input
 	input *crosschaintypes.QueryRateLimiterInputResponse
+
+	// the gas price of the last posted outbound vote
+	outboundGasPrice *big.Int
 }
 
 func NewMockZetacoreClient() *MockZetacoreClient {
 	return &MockZetacoreClient{
-		paused:       false,
-		zetaChain:    chains.ZetaChainMainnet,
-		pendingCctxs: map[int64][]*crosschaintypes.CrossChainTx{},
+		paused:          false,
+		zetaChain:       chains.ZetaChainMainnet,
+		pendingCctxs:    map[int64][]*crosschaintypes.CrossChainTx{},
+		cctxs:           map[string]*crosschaintypes.CrossChainTx{},
+		inboundCctxs:    map[string][]crosschaintypes.CrossChainTx{},
+		inboundTrackers: map[int64][]crosschaintypes.InboundTracker{},
 	}
 }
 
@@ -63,7 +78,7 @@ func (m *MockZetacoreClient) PostVoteOutbound(
 	_ string,
 	_ uint64,
 	_ uint64,
-	_ *big.Int,
+	outboundGasPrice *big.Int,
 	_ uint64,
 	_ *big.Int,
 	_ chains.ReceiveStatus,
@@ -74,6 +89,7 @@ func (m *MockZetacoreClient) PostVoteOutbound(
 	if m.paused {
 		return "", "", errors.New(ErrMsgPaused)
 	}
+	m.outboundGasPrice = outboundGasPrice
 	return sample.Hash().Hex(), "", nil
 }
 
@@ -183,6 +199,17 @@ func (m *MockZetacoreClient) GetPendingNoncesByChain(_ int64) (observerTypes.Pen
 	return observerTypes.PendingNonces{}, nil
 }
 
+func (m *MockZetacoreClient) GetCctxByHash(sendHash string) (*crosschaintypes.CrossChainTx, error) {
+	if m.paused {
+		return nil, errors.New(ErrMsgPaused)
+	}
+	cctx, found := m.cctxs[sendHash]
+	if !found {
+		return nil, errors.New(ErrMsgRPCFailed)
+	}
+	return cctx, nil
+}
+
 func (m *MockZetacoreClient) GetCctxByNonce(_ int64, _ uint64) (*crosschaintypes.CrossChainTx, error) {
 	if m.paused {
 		return nil, errors.New(ErrMsgPaused)
@@ -190,6 +217,15 @@ func (m *MockZetacoreClient) GetCctxByNonce(_ int64, _ uint64) (*crosschaintypes
 	return &crosschaintypes.CrossChainTx{}, nil
 }
 
+func (m *MockZetacoreClient) GetInboundHashToCctxData(
+	inboundHash string,
+) (*crosschaintypes.QueryInboundHashToCctxDataResponse, error) {
+	if m.paused {
+		return nil, errors.New(ErrMsgPaused)
+	}
+	return &crosschaintypes.QueryInboundHashToCctxDataResponse{CrossChainTxs: m.inboundCctxs[inboundHash]}, nil
+}
+
 func (m *MockZetacoreClient) GetOutboundTracker(_ chains.Chain, _ uint64) (*crosschaintypes.OutboundTracker, error) {
 	if m.paused {
 		return nil, errors.New(ErrMsgPaused)
@@ -238,11 +274,16 @@ func (m *MockZetacoreClient) GetBtcTssAddress(_ int64) (string, error) {
 	return testutils.TSSAddressBTCMainnet, nil
 }
 
-func (m *MockZetacoreClient) GetInboundTrackersForChain(_ int64) ([]crosschaintypes.InboundTracker, error) {
+func (m *MockZetacoreClient) GetInboundTrackersForChain(chainID int64) ([]crosschaintypes.InboundTracker, error) {
 	if m.paused {
 		return nil, errors.New(ErrMsgPaused)
 	}
-	return []crosschaintypes.InboundTracker{}, nil
+	return append([]crosschaintypes.InboundTracker{}, m.inboundTrackers[chainID]...), nil
+}
+
+// OutboundGasPrice returns the gas price of the last posted outbound vote
+func (m *MockZetacoreClient) OutboundGasPrice() *big.Int {
+	return m.outboundGasPrice
 }
 
 func (m *MockZetacoreClient) Pause() {
@@ -274,6 +315,21 @@ func (m *MockZetacoreClient) WithPendingCctx(chainID int64, cctxs []*crosschaint
 	return m
 }
 
+func (m *MockZetacoreClient) WithCctx(cctx *crosschaintypes.CrossChainTx) *MockZetacoreClient {
+	m.cctxs[cctx.Index] = cctx
+	return m
+}
+
+func (m *MockZetacoreClient) WithInboundCctx(inboundHash string, cctx crosschaintypes.CrossChainTx) *MockZetacoreClient {
+	m.inboundCctxs[inboundHash] = append(m.inboundCctxs[inboundHash], cctx)
+	return m
+}
+
+func (m *MockZetacoreClient) WithInboundTracker(tracker crosschaintypes.InboundTracker) *MockZetacoreClient {
+	m.inboundTrackers[tracker.ChainId] = append(m.inboundTrackers[tracker.ChainId], tracker)
+	return m
+}
+
 func (m *MockZetacoreClient) WithRateLimiterFlags(flags *crosschaintypes.RateLimiterFlags) *MockZetacoreClient {
 	m.rateLimiterFlags = flags
 	return m
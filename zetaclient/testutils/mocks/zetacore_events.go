@@ -0,0 +1,83 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+)
+
+// ZetacoreEventsClient interface
+var _ interfaces.ZetacoreEventsClient = &MockZetacoreEventsClient{}
+
+// MockZetacoreEventsClient is a CometBFT websocket client delivering the events pushed by the test
+type MockZetacoreEventsClient struct {
+	mu       sync.Mutex
+	channels map[string]chan coretypes.ResultEvent
+	err      error
+	Stopped  bool
+}
+
+func NewMockZetacoreEventsClient() *MockZetacoreEventsClient {
+	return &MockZetacoreEventsClient{
+		channels: make(map[string]chan coretypes.ResultEvent),
+	}
+}
+
+func (c *MockZetacoreEventsClient) Subscribe(
+	_ context.Context,
+	_ string,
+	query string,
+	_ ...int,
+) (<-chan coretypes.ResultEvent, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.channel(query), nil
+}
+
+func (c *MockZetacoreEventsClient) Stop() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Stopped = true
+	return nil
+}
+
+// channel returns the channel delivering the events of the query
+func (c *MockZetacoreEventsClient) channel(query string) chan coretypes.ResultEvent {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch, found := c.channels[query]
+	if !found {
+		ch = make(chan coretypes.ResultEvent, 16)
+		c.channels[query] = ch
+	}
+	return ch
+}
+
+// ----------------------------------------------------------------------------
+// Feed data to the mock websocket client for testing
+// ----------------------------------------------------------------------------
+func (c *MockZetacoreEventsClient) WithError(err error) *MockZetacoreEventsClient {
+	c.err = err
+	return c
+}
+
+// Push delivers the event to the subscription of its query
+func (c *MockZetacoreEventsClient) Push(event coretypes.ResultEvent) {
+	c.channel(event.Query) <- event
+}
+
+// Interrupt closes the subscription of the query
+func (c *MockZetacoreEventsClient) Interrupt(query string) {
+	close(c.channel(query))
+}
+
+// IsStopped returns true if the client is stopped
+func (c *MockZetacoreEventsClient) IsStopped() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.Stopped
+}
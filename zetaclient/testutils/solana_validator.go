@@ -0,0 +1,423 @@
+package testutils
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/zetaclient/chains/solana"
+)
+
+const (
+	// SolanaGatewayAddressLocalnet is the address of the gateway program on the local validator stand-in
+	SolanaGatewayAddressLocalnet = "94U5AHQMKkV5txNJ17QPXWoh474PheGou6cNP2FEuL1d"
+
+	// solanaBlockhashValidity is the number of slots a blockhash can be used by a transaction
+	solanaBlockhashValidity = 150
+)
+
+// SolanaValidator is a local stand-in of a Solana validator serving the JSON-RPC methods used by zetaclient.
+// Every accepted transaction is executed right away in its own finalized block, only the gateway program
+// deposit and withdraw instructions can be executed.
+type SolanaValidator struct {
+	mu        sync.Mutex
+	server    *httptest.Server
+	gatewayID solana.PublicKey
+	pda       solana.PublicKey
+	tss       solana.PublicKey
+	nonce     uint64
+	slot      uint64
+
+	// lastBlockSlot is the slot of the latest produced block
+	lastBlockSlot uint64
+
+	balances map[solana.PublicKey]uint64
+	blocks   map[uint64]solana.Hash
+	txs      map[string]*solana.TransactionResult
+
+	// gatewaySigs are the signatures of the gateway transactions from oldest to newest
+	gatewaySigs []solana.SignatureInfo
+}
+
+// NewSolanaValidator starts a local validator stand-in with a gateway program withdrawn by the TSS
+func NewSolanaValidator(t *testing.T, gatewayID solana.PublicKey, tss solana.PublicKey) *SolanaValidator {
+	pda, _, err := solana.GatewayPDA(gatewayID)
+	require.NoError(t, err)
+
+	v := &SolanaValidator{
+		gatewayID: gatewayID,
+		pda:       pda,
+		tss:       tss,
+		balances:  make(map[solana.PublicKey]uint64),
+		blocks:    make(map[uint64]solana.Hash),
+		txs:       make(map[string]*solana.TransactionResult),
+	}
+	v.produceBlock()
+	v.server = httptest.NewServer(http.HandlerFunc(v.serveHTTP))
+	t.Cleanup(v.server.Close)
+	return v
+}
+
+// Endpoint returns the JSON-RPC endpoint of the validator
+func (v *SolanaValidator) Endpoint() string {
+	return v.server.URL
+}
+
+// Fund credits lamports to the account
+func (v *SolanaValidator) Fund(account solana.PublicKey, lamports uint64) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.balances[account] += lamports
+}
+
+// Balance returns the lamports of the account
+func (v *SolanaValidator) Balance(account solana.PublicKey) uint64 {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.balances[account]
+}
+
+// Nonce returns the next withdrawal nonce of the gateway
+func (v *SolanaValidator) Nonce() uint64 {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.nonce
+}
+
+// Slot returns the current slot
+func (v *SolanaValidator) Slot() uint64 {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.slot
+}
+
+// LatestBlockhash returns the hash of the latest produced block
+func (v *SolanaValidator) LatestBlockhash() solana.Hash {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.blocks[v.lastBlockSlot]
+}
+
+// SkipSlots advances the validator by n slots without producing blocks
+func (v *SolanaValidator) SkipSlots(n uint64) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.slot += n
+}
+
+// Deposit signs and executes a gateway deposit of the depositor, it returns the transaction signature
+func (v *SolanaValidator) Deposit(t *testing.T, depositor ed25519.PrivateKey, amount uint64, memo []byte) string {
+	var depositorAddress solana.PublicKey
+	copy(depositorAddress[:], depositor.Public().(ed25519.PublicKey))
+
+	inst, err := solana.NewDepositInstruction(v.gatewayID, depositorAddress, amount, memo)
+	require.NoError(t, err)
+
+	msg, err := solana.NewMessage(depositorAddress, []solana.Instruction{inst}, v.LatestBlockhash())
+	require.NoError(t, err)
+
+	var sig solana.Signature
+	copy(sig[:], ed25519.Sign(depositor, msg.Serialize()))
+	tx := &solana.Transaction{Signatures: []solana.Signature{sig}, Message: msg}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	require.NoError(t, v.execute(tx))
+	return tx.ID()
+}
+
+// produceBlock produces a block at the next slot
+func (v *SolanaValidator) produceBlock() {
+	v.slot++
+	var slot [8]byte
+	binary.LittleEndian.PutUint64(slot[:], v.slot)
+	v.blocks[v.slot] = sha256.Sum256(slot[:])
+	v.lastBlockSlot = v.slot
+}
+
+// execute checks and executes the transaction in a new block
+func (v *SolanaValidator) execute(tx *solana.Transaction) error {
+	if err := tx.Verify(); err != nil {
+		return err
+	}
+	if _, found := v.txs[tx.ID()]; found {
+		return fmt.Errorf("transaction %s already processed", tx.ID())
+	}
+	if !v.isValidBlockhash(tx.Message.RecentBlockhash) {
+		return fmt.Errorf("blockhash %s not found", tx.Message.RecentBlockhash)
+	}
+
+	// execute the instructions on a copy of the balances so that a failed transaction has no effect
+	msg := tx.Message
+	balances := make(map[solana.PublicKey]uint64, len(v.balances))
+	for account, lamports := range v.balances {
+		balances[account] = lamports
+	}
+	nonce := v.nonce
+	feePayer := msg.AccountKeys[0]
+	fee := uint64(solana.LamportsPerSignature * len(tx.Signatures))
+	if balances[feePayer] < fee {
+		return fmt.Errorf("insufficient funds for fee of %s", feePayer)
+	}
+	balances[feePayer] -= fee
+
+	touchesGateway := false
+	for i, inst := range msg.Instructions {
+		if int(inst.ProgramIDIndex) >= len(msg.AccountKeys) || msg.AccountKeys[inst.ProgramIDIndex] != v.gatewayID {
+			return fmt.Errorf("instruction %d: unsupported program", i)
+		}
+		touchesGateway = true
+		accounts := make([]solana.PublicKey, len(inst.Accounts))
+		for j, idx := range inst.Accounts {
+			if int(idx) >= len(msg.AccountKeys) {
+				return fmt.Errorf("instruction %d: account index %d out of range", i, idx)
+			}
+			if j < 2 && !msg.IsWritable(int(idx)) {
+				return fmt.Errorf("instruction %d: account %d is not writable", i, j)
+			}
+			accounts[j] = msg.AccountKeys[idx]
+		}
+		if len(accounts) != 3 || accounts[1] != v.pda {
+			return fmt.Errorf("instruction %d: invalid accounts", i)
+		}
+		signedBy := func(account solana.PublicKey) bool {
+			for _, signer := range msg.Signers() {
+				if signer == account {
+					return true
+				}
+			}
+			return false
+		}
+
+		var from, to solana.PublicKey
+		var amount uint64
+		switch {
+		case bytes.HasPrefix(inst.Data, solana.DiscriminatorDeposit[:]):
+			deposit, err := solana.ParseDepositInstruction(inst.Data)
+			if err != nil {
+				return fmt.Errorf("instruction %d: %s", i, err)
+			}
+			if !signedBy(accounts[0]) || accounts[2] != solana.SystemProgramID {
+				return fmt.Errorf("instruction %d: invalid deposit accounts", i)
+			}
+			from, to, amount = accounts[0], v.pda, deposit.Amount
+		case bytes.HasPrefix(inst.Data, solana.DiscriminatorWithdraw[:]):
+			withdrawal, err := solana.ParseWithdrawInstruction(inst.Data)
+			if err != nil {
+				return fmt.Errorf("instruction %d: %s", i, err)
+			}
+			if accounts[0] != v.tss || !signedBy(v.tss) {
+				return fmt.Errorf("instruction %d: withdrawal not signed by TSS", i)
+			}
+			if withdrawal.Nonce != nonce {
+				return fmt.Errorf("instruction %d: nonce mismatch, expected %d got %d", i, nonce, withdrawal.Nonce)
+			}
+			nonce++
+			from, to, amount = v.pda, accounts[2], withdrawal.Amount
+		default:
+			return fmt.Errorf("instruction %d: unknown gateway instruction", i)
+		}
+		if balances[from] < amount {
+			return fmt.Errorf("instruction %d: insufficient funds of %s", i, from)
+		}
+		balances[from] -= amount
+		balances[to] += amount
+	}
+
+	// commit the transaction in a new block
+	v.balances = balances
+	v.nonce = nonce
+	v.produceBlock()
+	v.txs[tx.ID()] = v.transactionResult(tx, fee)
+	if touchesGateway {
+		v.gatewaySigs = append(v.gatewaySigs, solana.SignatureInfo{
+			Signature:          tx.ID(),
+			Slot:               v.slot,
+			ConfirmationStatus: string(solana.CommitmentFinalized),
+		})
+	}
+	return nil
+}
+
+// isValidBlockhash returns true if the blockhash was produced within the validity window
+func (v *SolanaValidator) isValidBlockhash(blockhash solana.Hash) bool {
+	for slot, hash := range v.blocks {
+		if hash == blockhash && slot+solanaBlockhashValidity >= v.slot {
+			return true
+		}
+	}
+	return false
+}
+
+// transactionResult returns the "json" encoding of an executed transaction
+func (v *SolanaValidator) transactionResult(tx *solana.Transaction, fee uint64) *solana.TransactionResult {
+	msg := tx.Message
+	res := &solana.TransactionResult{
+		Slot: v.slot,
+		Meta: &solana.TransactionMeta{Fee: fee},
+		Transaction: solana.UITransaction{
+			Signatures: make([]string, len(tx.Signatures)),
+			Message: solana.UIMessage{
+				Header:          msg.Header,
+				AccountKeys:     make([]string, len(msg.AccountKeys)),
+				RecentBlockhash: msg.RecentBlockhash.String(),
+				Instructions:    make([]solana.UIInstruction, len(msg.Instructions)),
+			},
+		},
+	}
+	for i, sig := range tx.Signatures {
+		res.Transaction.Signatures[i] = sig.String()
+	}
+	for i, key := range msg.AccountKeys {
+		res.Transaction.Message.AccountKeys[i] = key.String()
+	}
+	for i, inst := range msg.Instructions {
+		accounts := make([]uint16, len(inst.Accounts))
+		for j, idx := range inst.Accounts {
+			accounts[j] = uint16(idx)
+		}
+		res.Transaction.Message.Instructions[i] = solana.UIInstruction{
+			ProgramIDIndex: uint16(inst.ProgramIDIndex),
+			Accounts:       accounts,
+			Data:           base58.Encode(inst.Data),
+		}
+	}
+	return res
+}
+
+// serveHTTP serves the JSON-RPC requests
+func (v *SolanaValidator) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     uint64            `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	v.mu.Lock()
+	result, rpcErr := v.handle(req.Method, req.Params)
+	v.mu.Unlock()
+
+	resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+	if rpcErr != nil {
+		resp["error"] = rpcErr
+	} else {
+		resp["result"] = result
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// handle executes a JSON-RPC method
+func (v *SolanaValidator) handle(method string, params []json.RawMessage) (interface{}, *solana.RPCError) {
+	invalidParams := &solana.RPCError{Code: -32602, Message: "invalid params"}
+	switch method {
+	case "getHealth":
+		return "ok", nil
+	case "getSlot":
+		return v.slot, nil
+	case "getBalance":
+		var address string
+		if len(params) < 1 || json.Unmarshal(params[0], &address) != nil {
+			return nil, invalidParams
+		}
+		account, err := solana.PublicKeyFromBase58(address)
+		if err != nil {
+			return nil, invalidParams
+		}
+		return map[string]interface{}{"context": map[string]uint64{"slot": v.slot}, "value": v.balances[account]}, nil
+	case "getBlock":
+		var slot uint64
+		if len(params) < 1 || json.Unmarshal(params[0], &slot) != nil {
+			return nil, invalidParams
+		}
+		blockhash, found := v.blocks[slot]
+		if !found {
+			return nil, &solana.RPCError{Code: -32007, Message: fmt.Sprintf("Slot %d was skipped", slot)}
+		}
+		var parentSlot uint64
+		var previousBlockhash solana.Hash
+		for s := slot - 1; s > 0; s-- {
+			if hash, found := v.blocks[s]; found {
+				parentSlot, previousBlockhash = s, hash
+				break
+			}
+		}
+		return solana.BlockResult{
+			Blockhash:         blockhash.String(),
+			PreviousBlockhash: previousBlockhash.String(),
+			ParentSlot:        parentSlot,
+			BlockHeight:       slot,
+		}, nil
+	case "getSignaturesForAddress":
+		var address string
+		var opts solana.SignaturesForAddressOpts
+		if len(params) < 1 || json.Unmarshal(params[0], &address) != nil {
+			return nil, invalidParams
+		}
+		if len(params) > 1 && json.Unmarshal(params[1], &opts) != nil {
+			return nil, invalidParams
+		}
+		return v.signaturesForAddress(address, opts), nil
+	case "getTransaction":
+		var signature string
+		if len(params) < 1 || json.Unmarshal(params[0], &signature) != nil {
+			return nil, invalidParams
+		}
+		return v.txs[signature], nil
+	case "sendTransaction":
+		var encoded string
+		if len(params) < 1 || json.Unmarshal(params[0], &encoded) != nil {
+			return nil, invalidParams
+		}
+		data, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, invalidParams
+		}
+		tx, err := solana.DeserializeTransaction(data)
+		if err != nil {
+			return nil, &solana.RPCError{Code: -32602, Message: err.Error()}
+		}
+		if err := v.execute(tx); err != nil {
+			return nil, &solana.RPCError{Code: -32002, Message: "Transaction simulation failed: " + err.Error()}
+		}
+		return tx.ID(), nil
+	default:
+		return nil, &solana.RPCError{Code: -32601, Message: "Method not found"}
+	}
+}
+
+// signaturesForAddress returns the gateway signatures from newest to oldest, between 'before' and 'until' (exclusive)
+func (v *SolanaValidator) signaturesForAddress(address string, opts solana.SignaturesForAddressOpts) []solana.SignatureInfo {
+	sigs := make([]solana.SignatureInfo, 0)
+	if address != v.gatewayID.String() {
+		return sigs
+	}
+	started := opts.Before == ""
+	for i := len(v.gatewaySigs) - 1; i >= 0; i-- {
+		sig := v.gatewaySigs[i]
+		if !started {
+			started = sig.Signature == opts.Before
+			continue
+		}
+		if sig.Signature == opts.Until || (opts.Limit > 0 && len(sigs) == opts.Limit) {
+			break
+		}
+		sigs = append(sigs, sig)
+	}
+	return sigs
+}
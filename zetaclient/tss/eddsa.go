@@ -0,0 +1,354 @@
+package tss
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	btss "github.com/binance-chain/tss-lib/tss"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	"github.com/zeta-chain/go-tss/conversion"
+
+	eddsakeygen "github.com/zeta-chain/zetacore/zetaclient/tss/eddsa/keygen"
+	eddsasigning "github.com/zeta-chain/zetacore/zetaclient/tss/eddsa/signing"
+)
+
+const (
+	// eddsaKeyFilePrefix is the prefix of the files of the EdDSA key shares in the TSS path
+	eddsaKeyFilePrefix = "eddsa-localstate-"
+
+	// EdDSAKeygenTimeout is the timeout of the EdDSA keygen ceremony
+	EdDSAKeygenTimeout = 120 * time.Second
+
+	// EdDSAKeysignTimeout is the timeout of the EdDSA keysign ceremony
+	EdDSAKeysignTimeout = 30 * time.Second
+)
+
+// ErrNotInSigningCommittee is returned by the parties left out of the committee of an EdDSA keysign
+var ErrNotInSigningCommittee = errors.New("local party is not in the signing committee")
+
+// EdDSAKey is the ed25519 key share of the local party saved after the keygen
+type EdDSAKey struct {
+	PubKey        []byte   `json:"pub_key"`
+	Participants  []string `json:"participants"`
+	LocalPartyKey string   `json:"local_party_key"`
+	KeygenHeight  int64    `json:"keygen_height"`
+
+	// LocalData is the key share of the local party saved by the tss-lib keygen
+	LocalData eddsakeygen.LocalPartySaveData `json:"local_data"`
+}
+
+// EdDSA runs the keygen and keysign ceremonies of the ed25519 key of the TSS (e.g. for Solana) with tss-lib
+//   - all the TSS signers take part in the keygen, run on its own once the TSS signers are known
+//   - threshold+1 of them take part in each keysign, the committee is selected among the parties online
+//     by the leader of the ceremony
+type EdDSA struct {
+	localPartyKey string
+	transport     EdDSATransport
+	keyDir        string
+	password      string
+	logger        zerolog.Logger
+
+	keygenTimeout  time.Duration
+	keysignTimeout time.Duration
+
+	mu  sync.RWMutex
+	key *EdDSAKey
+}
+
+// NewEdDSA creates the EdDSA ceremonies runner of the local party (grantee pubkey)
+// the key shares are saved in keyDir, encrypted with the password if not empty
+func NewEdDSA(
+	localPartyKey string,
+	transport EdDSATransport,
+	keyDir string,
+	password string,
+	logger zerolog.Logger,
+) *EdDSA {
+	return &EdDSA{
+		localPartyKey:  localPartyKey,
+		transport:      transport,
+		keyDir:         keyDir,
+		password:       password,
+		logger:         logger.With().Str("module", "tss_eddsa").Logger(),
+		keygenTimeout:  EdDSAKeygenTimeout,
+		keysignTimeout: EdDSAKeysignTimeout,
+	}
+}
+
+// PubKey returns the ed25519 public key of the TSS, nil if no key is generated yet
+func (e *EdDSA) PubKey() ed25519.PublicKey {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	if e.key == nil {
+		return nil
+	}
+	return e.key.PubKey
+}
+
+// HasKeyOf returns true if the EdDSA key is shared by the given participants
+func (e *EdDSA) HasKeyOf(participants []string) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.key != nil && strings.Join(e.key.Participants, ",") == strings.Join(sortedParties(participants), ",")
+}
+
+// AllowParties sets the parties whose messages are received: the given ones and the participants of the key
+// the parties of a keygen have to be allowed before any of them starts the keygen
+func (e *EdDSA) AllowParties(parties []string) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	allowed := append([]string{}, parties...)
+	if e.key != nil {
+		allowed = append(allowed, e.key.Participants...)
+	}
+	e.transport.AllowParties(allowed)
+}
+
+// Keygen generates a new ed25519 key shared by the participants (grantee pubkeys) at the keygen height
+func (e *EdDSA) Keygen(participants []string, keygenHeight int64) (ed25519.PublicKey, error) {
+	participants = sortedParties(participants)
+	if !containsParty(participants, e.localPartyKey) {
+		return nil, fmt.Errorf("local party is not a keygen participant")
+	}
+	threshold, err := conversion.GetThreshold(len(participants))
+	if err != nil {
+		return nil, err
+	}
+	partyIDs, localPartyID, err := eddsaPartyIDs(participants, e.localPartyKey)
+	if err != nil {
+		return nil, err
+	}
+	msgID := ceremonyID("keygen", fmt.Sprintf("%d", keygenHeight), strings.Join(participants, ","))
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.keygenTimeout)
+	defer cancel()
+	c := newCeremony(msgID, e.localPartyKey, participants, e.transport)
+	defer c.close()
+
+	params := btss.NewParameters(btss.NewPeerContext(partyIDs), localPartyID, len(partyIDs), threshold)
+	outCh := make(chan btss.Message, 2*len(partyIDs)+2)
+	endCh := make(chan eddsakeygen.LocalPartySaveData, 1)
+	data, err := runParty(ctx, c, eddsakeygen.NewLocalParty(params, outCh, endCh), partyIDs, outCh, endCh)
+	if err != nil {
+		return nil, fmt.Errorf("eddsa keygen failed: %w", err)
+	}
+	if data.EDDSAPub == nil {
+		return nil, fmt.Errorf("eddsa keygen failed: no key generated")
+	}
+
+	key := &EdDSAKey{
+		PubKey:        edwardsPubKey(data.EDDSAPub),
+		Participants:  participants,
+		LocalPartyKey: e.localPartyKey,
+		KeygenHeight:  keygenHeight,
+		LocalData:     data,
+	}
+	if err := e.saveKey(key); err != nil {
+		return nil, err
+	}
+	e.mu.Lock()
+	e.key = key
+	e.mu.Unlock()
+
+	e.logger.Info().Msgf("eddsa keygen succeeded at height %d, pubkey %s", keygenHeight, hex.EncodeToString(key.PubKey))
+	return key.PubKey, nil
+}
+
+// Sign signs the message with the ed25519 key of the TSS
+// the ceremony is identified by the message, height, nonce and chain so all the signers join the same one
+func (e *EdDSA) Sign(msg []byte, height uint64, nonce uint64, chainID int64) ([64]byte, error) {
+	e.mu.RLock()
+	key := e.key
+	e.mu.RUnlock()
+	if key == nil {
+		return [64]byte{}, fmt.Errorf("no eddsa key")
+	}
+	// tss-lib signs the message as a big integer, so its leading zero bytes would be dropped
+	if len(msg) == 0 || msg[0] == 0 {
+		return [64]byte{}, fmt.Errorf("message with a leading zero byte can't be signed")
+	}
+
+	ids := make([]byte, 24)
+	binary.BigEndian.PutUint64(ids[0:8], height)
+	binary.BigEndian.PutUint64(ids[8:16], nonce)
+	// #nosec G701 always in range
+	binary.BigEndian.PutUint64(ids[16:24], uint64(chainID))
+	msgID := ceremonyID("keysign", hex.EncodeToString(key.PubKey), hex.EncodeToString(ids), hex.EncodeToString(msg))
+
+	threshold, err := conversion.GetThreshold(len(key.Participants))
+	if err != nil {
+		return [64]byte{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.keysignTimeout)
+	defer cancel()
+	c := newCeremony(msgID, e.localPartyKey, key.Participants, e.transport)
+	defer c.close()
+
+	committee, err := c.selectCommittee(ctx, threshold+1)
+	if err != nil {
+		return [64]byte{}, fmt.Errorf("eddsa keysign failed: %w", err)
+	}
+	if !containsParty(committee, e.localPartyKey) {
+		return [64]byte{}, errors.Wrapf(ErrNotInSigningCommittee, "ceremony %s", msgID)
+	}
+	c.parties = committee
+	partyIDs, localPartyID, err := eddsaPartyIDs(committee, e.localPartyKey)
+	if err != nil {
+		return [64]byte{}, err
+	}
+
+	params := btss.NewParameters(btss.NewPeerContext(partyIDs), localPartyID, len(partyIDs), threshold)
+	outCh := make(chan btss.Message, 2*len(partyIDs)+2)
+	endCh := make(chan *eddsasigning.SignatureData, 1)
+	party := eddsasigning.NewLocalParty(new(big.Int).SetBytes(msg), params, key.LocalData, outCh, endCh)
+	data, err := runParty(ctx, c, party, partyIDs, outCh, endCh)
+	if err != nil {
+		return [64]byte{}, fmt.Errorf("eddsa keysign failed: %w", err)
+	}
+
+	var sig [64]byte
+	if data.Signature == nil || len(data.Signature.Signature) != len(sig) {
+		return [64]byte{}, fmt.Errorf("eddsa keysign failed: invalid signature")
+	}
+	copy(sig[:], data.Signature.Signature)
+	if !ed25519.Verify(key.PubKey, msg, sig[:]) {
+		return [64]byte{}, fmt.Errorf("signature verification failed")
+	}
+	return sig, nil
+}
+
+// LoadKey loads the latest EdDSA key share of the local party saved in the key directory
+func (e *EdDSA) LoadKey() error {
+	files, err := os.ReadDir(e.keyDir)
+	if err != nil {
+		return err
+	}
+
+	var latest *EdDSAKey
+	for _, file := range files {
+		if file.IsDir() || !strings.HasPrefix(file.Name(), eddsaKeyFilePrefix) {
+			continue
+		}
+		key, err := e.readKey(filepath.Join(e.keyDir, file.Name()))
+		if err != nil {
+			e.logger.Error().Err(err).Msgf("error reading eddsa key file %s", file.Name())
+			continue
+		}
+		if key.LocalPartyKey == e.localPartyKey && (latest == nil || key.KeygenHeight > latest.KeygenHeight) {
+			latest = key
+		}
+	}
+	if latest == nil {
+		e.logger.Info().Msg("eddsa key share file not found")
+		return nil
+	}
+
+	e.mu.Lock()
+	e.key = latest
+	e.mu.Unlock()
+	e.logger.Info().Msgf("loaded eddsa key %s generated at height %d", hex.EncodeToString(latest.PubKey), latest.KeygenHeight)
+	return nil
+}
+
+// saveKey saves the EdDSA key share in the key directory
+func (e *EdDSA) saveKey(key *EdDSAKey) error {
+	data, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+	if e.password != "" {
+		if data, err = e.cipher(data, true); err != nil {
+			return err
+		}
+	}
+	fileName := filepath.Join(e.keyDir, eddsaKeyFilePrefix+hex.EncodeToString(key.PubKey)+".json")
+	return os.WriteFile(fileName, data, 0o600)
+}
+
+// readKey reads an EdDSA key share file
+func (e *EdDSA) readKey(fileName string) (*EdDSAKey, error) {
+	data, err := os.ReadFile(filepath.Clean(fileName))
+	if err != nil {
+		return nil, err
+	}
+	if e.password != "" {
+		if data, err = e.cipher(data, false); err != nil {
+			return nil, err
+		}
+	}
+	// the points of the key share are decoded on the curve set in tss-lib
+	key := &EdDSAKey{}
+	err = withEdwardsCurve(context.Background(), func() error {
+		return json.Unmarshal(data, key)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if key.LocalData.EDDSAPub == nil || key.LocalData.Xi == nil {
+		return nil, fmt.Errorf("invalid key share")
+	}
+	return key, nil
+}
+
+// cipher encrypts or decrypts the key share with AES-GCM keyed by the hash of the password, as go-tss does
+func (e *EdDSA) cipher(data []byte, encrypt bool) ([]byte, error) {
+	passkey := sha256.Sum256([]byte(e.password))
+	block, err := aes.NewCipher(passkey[:])
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if encrypt {
+		nonce := make([]byte, gcm.NonceSize())
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return nil, err
+		}
+		return gcm.Seal(nonce, nonce, data, nil), nil
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("invalid encrypted key share")
+	}
+	return gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+}
+
+// ceremonyID returns the id of a ceremony identified by the given fields
+func ceremonyID(fields ...string) string {
+	hash := sha256.Sum256([]byte("eddsa:" + strings.Join(fields, ":")))
+	return hex.EncodeToString(hash[:])
+}
+
+func sortedParties(parties []string) []string {
+	sorted := append([]string{}, parties...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func containsParty(parties []string, party string) bool {
+	for _, p := range parties {
+		if p == party {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,6 @@
+// Package keygen is the EdDSA keygen of tss-lib v0.1.5 (github.com/binance-chain/tss-lib, MIT license, see LICENSE)
+// with its messages in their own protobuf package: the messages of the EdDSA and ECDSA packages of tss-lib
+// have the same names, they can't be registered in the same binary as the TSS server.
+//
+// eddsa-keygen.pb.go is generated by protoc-gen-go from eddsa-keygen.proto, it imports protob/shared.proto of tss-lib.
+package keygen
@@ -0,0 +1,166 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	cmt "github.com/binance-chain/tss-lib/crypto/commitments"
+	"github.com/binance-chain/tss-lib/tss"
+	"github.com/zeta-chain/zetacore/zetaclient/tss/eddsa/keygen"
+)
+
+// Implements Party
+// Implements Stringer
+var _ tss.Party = (*LocalParty)(nil)
+var _ fmt.Stringer = (*LocalParty)(nil)
+
+type (
+	LocalParty struct {
+		*tss.BaseParty
+		params *tss.Parameters
+
+		keys keygen.LocalPartySaveData
+		temp localTempData
+		data SignatureData
+
+		// outbound messaging
+		out chan<- tss.Message
+		end chan<- *SignatureData
+	}
+
+	localMessageStore struct {
+		signRound1Messages,
+		signRound2Messages,
+		signRound3Messages []tss.ParsedMessage
+	}
+
+	localTempData struct {
+		localMessageStore
+
+		// temp data (thrown away after sign) / round 1
+		wi,
+		m,
+		ri *big.Int
+		pointRi  *crypto.ECPoint
+		deCommit cmt.HashDeCommitment
+
+		// round 2
+		cjs []*big.Int
+		si  *[32]byte
+
+		// round 3
+		r *big.Int
+	}
+)
+
+func NewLocalParty(
+	msg *big.Int,
+	params *tss.Parameters,
+	key keygen.LocalPartySaveData,
+	out chan<- tss.Message,
+	end chan<- *SignatureData,
+) tss.Party {
+	partyCount := len(params.Parties().IDs())
+	p := &LocalParty{
+		BaseParty: new(tss.BaseParty),
+		params:    params,
+		keys:      keygen.BuildLocalSaveDataSubset(key, params.Parties().IDs()),
+		temp:      localTempData{},
+		data:      SignatureData{},
+		out:       out,
+		end:       end,
+	}
+	// msgs init
+	p.temp.signRound1Messages = make([]tss.ParsedMessage, partyCount)
+	p.temp.signRound2Messages = make([]tss.ParsedMessage, partyCount)
+	p.temp.signRound3Messages = make([]tss.ParsedMessage, partyCount)
+
+	// temp data init
+	p.temp.m = msg
+	p.temp.cjs = make([]*big.Int, partyCount)
+	return p
+}
+
+func (p *LocalParty) FirstRound() tss.Round {
+	return newRound1(p.params, &p.keys, &p.data, &p.temp, p.out, p.end)
+}
+
+func (p *LocalParty) Start() *tss.Error {
+	return tss.BaseStart(p, TaskName, func(round tss.Round) *tss.Error {
+		round1, ok := round.(*round1)
+		if !ok {
+			return round.WrapError(errors.New("unable to Start(). party is in an unexpected round"))
+		}
+		if err := round1.prepare(); err != nil {
+			return round.WrapError(err)
+		}
+		return nil
+	})
+}
+
+func (p *LocalParty) Update(msg tss.ParsedMessage) (ok bool, err *tss.Error) {
+	return tss.BaseUpdate(p, msg, TaskName)
+}
+
+func (p *LocalParty) UpdateFromBytes(wireBytes []byte, from *tss.PartyID, isBroadcast bool) (bool, *tss.Error) {
+	msg, err := tss.ParseWireMessage(wireBytes, from, isBroadcast)
+	if err != nil {
+		return false, p.WrapError(err)
+	}
+	return p.Update(msg)
+}
+
+func (p *LocalParty) ValidateMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	if msg.GetFrom() == nil || !msg.GetFrom().ValidateBasic() {
+		return false, p.WrapError(fmt.Errorf("received msg with an invalid sender: %s", msg))
+	}
+	// check that the message's "from index" will fit into the array
+	if maxFromIdx := len(p.params.Parties().IDs()) - 1; maxFromIdx < msg.GetFrom().Index {
+		return false, p.WrapError(fmt.Errorf("received msg with a sender index too great (%d <= %d)",
+			maxFromIdx, msg.GetFrom().Index), msg.GetFrom())
+	}
+	return p.BaseParty.ValidateMessage(msg)
+}
+
+func (p *LocalParty) StoreMessage(msg tss.ParsedMessage) (bool, *tss.Error) {
+	// ValidateBasic is cheap; double-check the message here in case the public StoreMessage was called externally
+	if ok, err := p.ValidateMessage(msg); !ok || err != nil {
+		return ok, err
+	}
+	fromPIdx := msg.GetFrom().Index
+
+	// switch/case is necessary to store any messages beyond current round
+	// this does not handle message replays. we expect the caller to apply replay and spoofing protection.
+	switch msg.Content().(type) {
+	case *SignRound1Message:
+		p.temp.signRound1Messages[fromPIdx] = msg
+
+	case *SignRound2Message:
+		p.temp.signRound2Messages[fromPIdx] = msg
+
+	case *SignRound3Message:
+		p.temp.signRound3Messages[fromPIdx] = msg
+
+	default: // unrecognised message, just ignore!
+		common.Logger.Warnf("unrecognised message ignored: %v", msg)
+		return false, nil
+	}
+	return true, nil
+}
+
+func (p *LocalParty) PartyID() *tss.PartyID {
+	return p.params.PartyID()
+}
+
+func (p *LocalParty) String() string {
+	return fmt.Sprintf("id: %s, %s", p.PartyID(), p.BaseParty.String())
+}
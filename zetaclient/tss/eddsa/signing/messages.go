@@ -0,0 +1,125 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto"
+	cmt "github.com/binance-chain/tss-lib/crypto/commitments"
+	"github.com/binance-chain/tss-lib/crypto/zkp"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// These messages were generated from Protocol Buffers definitions into eddsa-signing.pb.go
+
+var (
+	// Ensure that signing messages implement ValidateBasic
+	_ = []tss.MessageContent{
+		(*SignRound1Message)(nil),
+		(*SignRound2Message)(nil),
+		(*SignRound3Message)(nil),
+	}
+)
+
+// ----- //
+
+func NewSignRound1Message(
+	from *tss.PartyID,
+	commitment cmt.HashCommitment,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &SignRound1Message{
+		Commitment: commitment.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *SignRound1Message) ValidateBasic() bool {
+	return m.Commitment != nil &&
+		common.NonEmptyBytes(m.GetCommitment())
+}
+
+func (m *SignRound1Message) UnmarshalCommitment() *big.Int {
+	return new(big.Int).SetBytes(m.GetCommitment())
+}
+
+// ----- //
+
+func NewSignRound2Message(
+	from *tss.PartyID,
+	deCommitment cmt.HashDeCommitment,
+	proof *zkp.DLogProof,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	dcBzs := common.BigIntsToBytes(deCommitment)
+	content := &SignRound2Message{
+		DeCommitment: dcBzs,
+		ProofAlpha:   proof.Alpha.ToProtobufPoint(),
+		ProofT:       proof.T.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *SignRound2Message) ValidateBasic() bool {
+	return m != nil &&
+		m.ProofAlpha != nil &&
+		common.NonEmptyMultiBytes(m.DeCommitment, 3) &&
+		m.ProofAlpha.ValidateBasic() &&
+		common.NonEmptyBytes(m.ProofT)
+}
+
+func (m *SignRound2Message) UnmarshalDeCommitment() []*big.Int {
+	deComBzs := m.GetDeCommitment()
+	return cmt.NewHashDeCommitmentFromBytes(deComBzs)
+}
+
+func (m *SignRound2Message) UnmarshalZKProof() (*zkp.DLogProof, error) {
+	point, err := crypto.NewECPointFromProtobuf(m.GetProofAlpha())
+	if err != nil {
+		return nil, err
+	}
+	return &zkp.DLogProof{
+		Alpha: point,
+		T:     new(big.Int).SetBytes(m.GetProofT()),
+	}, nil
+}
+
+// ----- //
+
+func NewSignRound3Message(
+	from *tss.PartyID,
+	si *big.Int,
+) tss.ParsedMessage {
+	meta := tss.MessageRouting{
+		From:        from,
+		IsBroadcast: true,
+	}
+	content := &SignRound3Message{
+		S: si.Bytes(),
+	}
+	msg := tss.NewMessageWrapper(meta, content)
+	return tss.NewMessage(meta, content, msg)
+}
+
+func (m *SignRound3Message) ValidateBasic() bool {
+	return m != nil &&
+		common.NonEmptyBytes(m.S)
+}
+
+func (m *SignRound3Message) UnmarshalS() *big.Int {
+	return new(big.Int).SetBytes(m.S)
+}
@@ -0,0 +1,44 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+// PrepareForSigning(), Fig. 7
+func PrepareForSigning(i, pax int, xi *big.Int, ks []*big.Int) (wi *big.Int) {
+	modQ := common.ModInt(tss.EC().Params().N)
+	if len(ks) != pax {
+		panic(fmt.Errorf("PrepareForSigning: len(ks) != pax (%d != %d)", len(ks), pax))
+	}
+	if len(ks) <= i {
+		panic(fmt.Errorf("PrepareForSigning: len(ks) <= i (%d <= %d)", len(ks), i))
+	}
+
+	// 1-4.
+	wi = xi
+	for j := 0; j < pax; j++ {
+		if j == i {
+			continue
+		}
+		ksj := ks[j]
+		ksi := ks[i]
+		if ksj.Cmp(ksi) == 0 {
+			panic(fmt.Errorf("index of two parties are equal"))
+		}
+		// big.Int Div is calculated as: a/b = a * modInv(b,q)
+		coef := modQ.Mul(ks[j], modQ.Inverse(new(big.Int).Sub(ksj, ksi)))
+		wi = modQ.Mul(wi, coef)
+	}
+
+	return
+}
@@ -0,0 +1,128 @@
+// Copyright © 2019 Binance
+//
+// This file is part of Binance. The full Binance copyright notice, including
+// terms governing use, modification, and redistribution, is contained in the
+// file LICENSE at the root of the source code distribution tree.
+
+package signing
+
+import (
+	"math/big"
+
+	"github.com/agl/ed25519/edwards25519"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/tss"
+)
+
+func encodedBytesToBigInt(s *[32]byte) *big.Int {
+	// Use a copy so we don't screw up our original
+	// memory.
+	sCopy := new([32]byte)
+	for i := 0; i < 32; i++ {
+		sCopy[i] = s[i]
+	}
+	reverse(sCopy)
+
+	bi := new(big.Int).SetBytes(sCopy[:])
+
+	return bi
+}
+
+func bigIntToEncodedBytes(a *big.Int) *[32]byte {
+	s := new([32]byte)
+	if a == nil {
+		return s
+	}
+
+	// Caveat: a can be longer than 32 bytes.
+	s = copyBytes(a.Bytes())
+
+	// Reverse the byte string --> little endian after
+	// encoding.
+	reverse(s)
+
+	return s
+}
+
+func copyBytes(aB []byte) *[32]byte {
+	if aB == nil {
+		return nil
+	}
+	s := new([32]byte)
+
+	// If we have a short byte string, expand
+	// it so that it's long enough.
+	aBLen := len(aB)
+	if aBLen < 32 {
+		diff := 32 - aBLen
+		for i := 0; i < diff; i++ {
+			aB = append([]byte{0x00}, aB...)
+		}
+	}
+
+	for i := 0; i < 32; i++ {
+		s[i] = aB[i]
+	}
+
+	return s
+}
+
+func ecPointToEncodedBytes(x *big.Int, y *big.Int) *[32]byte {
+	s := bigIntToEncodedBytes(y)
+	xB := bigIntToEncodedBytes(x)
+	xFE := new(edwards25519.FieldElement)
+	edwards25519.FeFromBytes(xFE, xB)
+	isNegative := edwards25519.FeIsNegative(xFE) == 1
+
+	if isNegative {
+		s[31] |= (1 << 7)
+	} else {
+		s[31] &^= (1 << 7)
+	}
+
+	return s
+}
+
+func reverse(s *[32]byte) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+func addExtendedElements(p, q edwards25519.ExtendedGroupElement) edwards25519.ExtendedGroupElement {
+	var r edwards25519.CompletedGroupElement
+	var qCached edwards25519.CachedGroupElement
+	q.ToCached(&qCached)
+	edwards25519.GeAdd(&r, &p, &qCached)
+	var result edwards25519.ExtendedGroupElement
+	r.ToExtended(&result)
+	return result
+}
+
+func ecPointToExtendedElement(x *big.Int, y *big.Int) edwards25519.ExtendedGroupElement {
+	encodedXBytes := bigIntToEncodedBytes(x)
+	encodedYBytes := bigIntToEncodedBytes(y)
+
+	z := common.GetRandomPositiveInt(tss.EC().Params().N)
+	encodedZBytes := bigIntToEncodedBytes(z)
+
+	var fx, fy, fxy edwards25519.FieldElement
+	edwards25519.FeFromBytes(&fx, encodedXBytes)
+	edwards25519.FeFromBytes(&fy, encodedYBytes)
+
+	var X, Y, Z, T edwards25519.FieldElement
+	edwards25519.FeFromBytes(&Z, encodedZBytes)
+
+	edwards25519.FeMul(&X, &fx, &Z)
+	edwards25519.FeMul(&Y, &fy, &Z)
+	edwards25519.FeMul(&fxy, &fx, &fy)
+	edwards25519.FeMul(&T, &fxy, &Z)
+
+	return edwards25519.ExtendedGroupElement{
+		X: X,
+		Y: Y,
+		Z: Z,
+		T: T,
+	}
+}
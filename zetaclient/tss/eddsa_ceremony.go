@@ -0,0 +1,320 @@
+package tss
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	tsscrypto "github.com/binance-chain/tss-lib/crypto"
+	btss "github.com/binance-chain/tss-lib/tss"
+	"github.com/decred/dcrd/dcrec/edwards/v2"
+
+	"github.com/zeta-chain/zetacore/pkg/cosmos"
+)
+
+// rounds of the messages of the EdDSA ceremonies
+const (
+	// roundJoin is the message of a party ready to join the signing committee, sent to the leader
+	roundJoin = iota + 1
+
+	// roundCommittee is the signing committee selected by the leader among the parties joined
+	roundCommittee
+
+	// roundParty is a message of the tss-lib party
+	roundParty
+)
+
+// curvePollInterval is the interval at which an EdDSA ceremony retries to set the curve of tss-lib
+const curvePollInterval = 10 * time.Millisecond
+
+// curveLock serializes the tss-lib computations using different curves
+// tss-lib holds the curve in a global variable: the ECDSA ceremonies (secp256k1) of the TSS server share the lock
+// for their whole duration while each step of an EdDSA ceremony (edwards25519) holds it exclusively
+var curveLock sync.RWMutex
+
+// withEdwardsCurve runs fn with the edwards25519 curve set in tss-lib
+// it waits for a moment no ECDSA ceremony is running without blocking the ones starting meanwhile,
+// so the EdDSA ceremonies never delay the ECDSA ones; fn must not wait for the other parties
+func withEdwardsCurve(ctx context.Context, fn func() error) error {
+	for !curveLock.TryLock() {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for the ECDSA ceremonies to end")
+		case <-time.After(curvePollInterval):
+		}
+	}
+	defer curveLock.Unlock()
+
+	previous := btss.EC()
+	btss.SetCurve(edwards.Edwards())
+	defer btss.SetCurve(previous)
+	return fn()
+}
+
+// withECDSACurve runs the ECDSA ceremony fn of the TSS server, no EdDSA ceremony step runs meanwhile
+func withECDSACurve[T any](fn func() (T, error)) (T, error) {
+	curveLock.RLock()
+	defer curveLock.RUnlock()
+	return fn()
+}
+
+// ceremony delivers the messages of a ceremony among its parties
+type ceremony struct {
+	id        string
+	self      string
+	parties   []string
+	transport EdDSATransport
+	inCh      <-chan EdDSAMessage
+
+	// stash are the messages of later rounds received while waiting for a round
+	stash []EdDSAMessage
+}
+
+// newCeremony subscribes to the messages of the ceremony id among the parties
+// the caller must close the ceremony when done
+func newCeremony(id string, self string, parties []string, transport EdDSATransport) *ceremony {
+	return &ceremony{
+		id:        id,
+		self:      self,
+		parties:   parties,
+		transport: transport,
+		inCh:      transport.Subscribe(id),
+	}
+}
+
+func (c *ceremony) close() {
+	c.transport.Unsubscribe(c.id)
+}
+
+// others returns the parties of the ceremony except the local party
+func (c *ceremony) others() []string {
+	others := make([]string, 0, len(c.parties)-1)
+	for _, p := range c.parties {
+		if p != c.self {
+			others = append(others, p)
+		}
+	}
+	return others
+}
+
+// send sends the message of the round to the parties
+func (c *ceremony) send(to []string, round int, broadcast bool, payload []byte) error {
+	return c.transport.Send(c.id, to, EdDSAMessage{From: c.self, Round: round, Broadcast: broadcast, Payload: payload})
+}
+
+// receive waits for the next message of the round sent by one of the parties
+// the messages of the later rounds received meanwhile are stashed for their round
+func (c *ceremony) receive(ctx context.Context, round int) (EdDSAMessage, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return EdDSAMessage{}, fmt.Errorf("ceremony %s timed out in round %d", c.id, round)
+		case msg := <-c.inCh:
+			if !containsParty(c.parties, msg.From) {
+				continue
+			}
+			if msg.Round == round {
+				return msg, nil
+			}
+			if msg.Round > round {
+				c.stash = append(c.stash, msg)
+			}
+		}
+	}
+}
+
+// selectCommittee returns the size parties signing the ceremony, the local party has to be one of the parties
+//   - the leader of the ceremony, picked among the parties with the ceremony id, waits for the parties joining
+//     until the committee is complete and sends it to all the parties
+//   - the other parties join the ceremony and wait for the committee sent by the leader
+//
+// so an offline party is left out of the committee as long as size parties (including the leader) are online
+func (c *ceremony) selectCommittee(ctx context.Context, size int) ([]string, error) {
+	leader := ceremonyLeader(c.parties, c.id)
+	if leader != c.self {
+		if err := c.send([]string{leader}, roundJoin, false, nil); err != nil {
+			return nil, fmt.Errorf("error joining leader %s: %w", leader, err)
+		}
+		msg, err := c.receive(ctx, roundCommittee)
+		for err == nil && msg.From != leader {
+			msg, err = c.receive(ctx, roundCommittee)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("committee not received from leader %s: %w", leader, err)
+		}
+		var committee []string
+		if err := json.Unmarshal(msg.Payload, &committee); err != nil {
+			return nil, fmt.Errorf("invalid committee from leader %s: %w", leader, err)
+		}
+		if len(committee) != size || !containsParty(committee, leader) || !containsParties(c.parties, committee) {
+			return nil, fmt.Errorf("invalid committee %v from leader %s", committee, leader)
+		}
+		return sortedParties(committee), nil
+	}
+
+	committee := []string{c.self}
+	for len(committee) < size {
+		msg, err := c.receive(ctx, roundJoin)
+		if err != nil {
+			return nil, fmt.Errorf("only %d parties joined: %w", len(committee), err)
+		}
+		if !containsParty(committee, msg.From) {
+			committee = append(committee, msg.From)
+		}
+	}
+	committee = sortedParties(committee)
+	payload, err := json.Marshal(committee)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.send(c.others(), roundCommittee, false, payload); err != nil {
+		return nil, fmt.Errorf("error sending committee: %w", err)
+	}
+	return committee, nil
+}
+
+// runParty runs the tss-lib party of the local party among the parties of the ceremony until it ends
+// the messages of the party are sent through the out channel and the result through the end channel
+func runParty[T any](
+	ctx context.Context,
+	c *ceremony,
+	party btss.Party,
+	partyIDs btss.SortedPartyIDs,
+	outCh <-chan btss.Message,
+	endCh <-chan T,
+) (result T, err error) {
+	// tss-lib panics on some invalid inputs
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("ceremony %s panicked: %v", c.id, r)
+		}
+	}()
+
+	byParty := make(map[string]*btss.PartyID, len(partyIDs))
+	for _, id := range partyIDs {
+		byParty[id.Id] = id
+	}
+	update := func(msg EdDSAMessage) error {
+		from, found := byParty[msg.From]
+		if msg.Round != roundParty || !found || msg.From == c.self {
+			return nil
+		}
+		return withEdwardsCurve(ctx, func() error {
+			if _, tssErr := party.UpdateFromBytes(msg.Payload, from, msg.Broadcast); tssErr != nil {
+				return fmt.Errorf("ceremony %s: invalid message of party %s: %w", c.id, msg.From, tssErr.Cause())
+			}
+			return nil
+		})
+	}
+
+	send := func(out btss.Message) error {
+		wire, routing, err := out.WireBytes()
+		if err != nil {
+			return fmt.Errorf("ceremony %s: error encoding message: %w", c.id, err)
+		}
+		to := c.others()
+		if routing.To != nil {
+			to = make([]string, 0, len(routing.To))
+			for _, id := range routing.To {
+				to = append(to, id.Id)
+			}
+		}
+		return c.send(to, roundParty, routing.IsBroadcast, wire)
+	}
+
+	err = withEdwardsCurve(ctx, func() error {
+		if tssErr := party.Start(); tssErr != nil {
+			return fmt.Errorf("ceremony %s failed to start: %w", c.id, tssErr.Cause())
+		}
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+	for _, msg := range c.stash {
+		if err := update(msg); err != nil {
+			return result, err
+		}
+	}
+	c.stash = nil
+	for {
+		select {
+		case <-ctx.Done():
+			return result, fmt.Errorf("ceremony %s timed out, waiting for %v", c.id, party.WaitingFor())
+		case out := <-outCh:
+			if err := send(out); err != nil {
+				return result, err
+			}
+		case msg := <-c.inCh:
+			if err := update(msg); err != nil {
+				return result, err
+			}
+		case result = <-endCh:
+			// the last messages of the party may be sent along with the end of the party
+			for {
+				select {
+				case out := <-outCh:
+					if err := send(out); err != nil {
+						return result, err
+					}
+				default:
+					return result, nil
+				}
+			}
+		}
+	}
+}
+
+// eddsaPartyIDs returns the tss-lib ids of the parties (grantee pubkeys) and the id of the local party
+// the id of a party is its grantee pubkey and its key is the bytes of the pubkey, as the TSS server does
+func eddsaPartyIDs(parties []string, localParty string) (btss.SortedPartyIDs, *btss.PartyID, error) {
+	var local *btss.PartyID
+	ids := make(btss.UnSortedPartyIDs, 0, len(parties))
+	for _, party := range parties {
+		pubKey, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeAccPub, party)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid party %s: %w", party, err)
+		}
+		id := btss.NewPartyID(party, "", new(big.Int).SetBytes(pubKey.Bytes()))
+		if party == localParty {
+			local = id
+		}
+		ids = append(ids, id)
+	}
+	if local == nil {
+		return nil, nil, fmt.Errorf("local party is not in the parties")
+	}
+	return btss.SortPartyIDs(ids), local, nil
+}
+
+// edwardsPubKey returns the ed25519 encoding of the public key of a tss-lib key share
+func edwardsPubKey(point *tsscrypto.ECPoint) []byte {
+	pubKey := edwards.PublicKey{Curve: edwards.Edwards(), X: point.X(), Y: point.Y()}
+	return pubKey.Serialize()
+}
+
+// ceremonyLeader returns the party leading the ceremony msgID
+// the leader rotates over the sorted parties with the ceremony id
+func ceremonyLeader(parties []string, msgID string) string {
+	parties = sortedParties(parties)
+	seed := sha256.Sum256([]byte(msgID))
+	// #nosec G701 always in range
+	return parties[int(binary.BigEndian.Uint64(seed[:8])%uint64(len(parties)))]
+}
+
+// containsParties returns true if all the subset parties are in the parties, without duplicates
+func containsParties(parties []string, subset []string) bool {
+	seen := make(map[string]bool, len(subset))
+	for _, p := range subset {
+		if seen[p] || !containsParty(parties, p) {
+			return false
+		}
+		seen[p] = true
+	}
+	return true
+}
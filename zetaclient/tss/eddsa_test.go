@@ -0,0 +1,280 @@
+package tss
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/zetacore/pkg/chains"
+	"github.com/zeta-chain/zetacore/pkg/cosmos"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/zetaclient/chains/solana"
+	solsigner "github.com/zeta-chain/zetacore/zetaclient/chains/solana/signer"
+	clientcommon "github.com/zeta-chain/zetacore/zetaclient/common"
+	"github.com/zeta-chain/zetacore/zetaclient/config"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils"
+)
+
+// memoryNetwork delivers the messages of the EdDSA ceremonies among parties in memory
+type memoryNetwork struct {
+	routers map[string]*messageRouter
+}
+
+// memoryTransport is the EdDSATransport of a party of the memory network
+type memoryTransport struct {
+	*messageRouter
+	network *memoryNetwork
+}
+
+func (t *memoryTransport) Send(msgID string, to []string, msg EdDSAMessage) error {
+	for _, party := range to {
+		router, found := t.network.routers[party]
+		if !found {
+			return fmt.Errorf("unknown party %s", party)
+		}
+		if err := router.route(msgID, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newTestTSSs creates n production TSS signers sharing an ed25519 key generated at height 100
+func newTestTSSs(t *testing.T, n int) ([]*TSS, []string) {
+	setupConfig()
+	network := &memoryNetwork{routers: make(map[string]*messageRouter)}
+	parties := make([]string, n)
+	tssList := make([]*TSS, n)
+	for i := 0; i < n; i++ {
+		_, pubKey, _ := testdata.KeyTestPubAddr()
+		party, err := cosmos.Bech32ifyPubKey(cosmos.Bech32PubKeyTypeAccPub, pubKey)
+		require.NoError(t, err)
+		parties[i] = party
+
+		router := newMessageRouter()
+		network.routers[party] = router
+		transport := &memoryTransport{messageRouter: router, network: network}
+		tssList[i] = &TSS{
+			EdDSA:           NewEdDSA(party, transport, t.TempDir(), "password", zerolog.Nop()),
+			KeysignsTracker: NewKeysignsTracker(zerolog.Nop()),
+		}
+	}
+
+	for _, tss := range tssList {
+		tss.EdDSA.AllowParties(parties)
+	}
+
+	pubKeys := make([]ed25519.PublicKey, n)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := range tssList {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			pubKeys[i], errs[i] = tssList[i].EdDSA.Keygen(parties, 100)
+		}(i)
+	}
+	wg.Wait()
+	for i := range tssList {
+		require.NoError(t, errs[i])
+		require.Equal(t, pubKeys[0], pubKeys[i])
+		require.Equal(t, pubKeys[0], tssList[i].PubKeyEdDSA())
+	}
+	return tssList, parties
+}
+
+func TestEdDSA(t *testing.T) {
+	tssList, _ := newTestTSSs(t, 3)
+	pubKey := tssList[0].PubKeyEdDSA()
+
+	t.Run("should sign by the signing committee", func(t *testing.T) {
+		msg := []byte("hello solana")
+		sigs := make([][64]byte, len(tssList))
+		errs := make([]error, len(tssList))
+		var wg sync.WaitGroup
+		for i := range tssList {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				sigs[i], errs[i] = tssList[i].SignEdDSA(msg, 10, 1, &chains.SolanaLocalnet)
+			}(i)
+		}
+		wg.Wait()
+
+		// threshold+1 of the 3 parties are in the committee
+		signed := 0
+		for i := range tssList {
+			if errs[i] != nil {
+				require.ErrorContains(t, errs[i], "not in the signing committee")
+				continue
+			}
+			signed++
+			require.True(t, ed25519.Verify(pubKey, msg, sigs[i][:]))
+		}
+		require.Equal(t, 2, signed)
+	})
+
+	t.Run("should sign without an offline signer", func(t *testing.T) {
+		online := tssList[:2]
+		for _, tss := range online {
+			tss.EdDSA.keysignTimeout = 3 * time.Second
+		}
+		// the ceremony fails if the offline signer is the leader, the leader changes with the message
+		for attempt := 0; attempt < 10; attempt++ {
+			msg := []byte(fmt.Sprintf("offline signer %d", attempt))
+			sigs := make([][64]byte, len(online))
+			errs := make([]error, len(online))
+			var wg sync.WaitGroup
+			for i := range online {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					sigs[i], errs[i] = online[i].SignEdDSA(msg, 10, 3, &chains.SolanaLocalnet)
+				}(i)
+			}
+			wg.Wait()
+			if errs[0] != nil {
+				require.ErrorContains(t, errs[0], "timed out")
+				require.ErrorContains(t, errs[1], "timed out")
+				continue
+			}
+			require.NoError(t, errs[1])
+			require.True(t, ed25519.Verify(pubKey, msg, sigs[0][:]))
+			require.Equal(t, sigs[0], sigs[1])
+			return
+		}
+		t.Fatal("no ceremony led by an online signer")
+	})
+
+	t.Run("should not sign a message with a leading zero byte", func(t *testing.T) {
+		_, err := tssList[0].SignEdDSA(append([]byte{0}, "hello solana"...), 10, 4, &chains.SolanaLocalnet)
+		require.ErrorContains(t, err, "leading zero")
+	})
+
+	t.Run("should fail if the other signers don't join the ceremony", func(t *testing.T) {
+		for _, tss := range tssList {
+			tss.EdDSA.keysignTimeout = time.Second
+			_, err := tss.SignEdDSA([]byte("alone"), 10, 2, &chains.SolanaLocalnet)
+			if err != nil && strings.Contains(err.Error(), "not in the signing committee") {
+				continue
+			}
+			require.ErrorContains(t, err, "timed out")
+			return
+		}
+	})
+
+	t.Run("should load the saved key share", func(t *testing.T) {
+		saved := tssList[0].EdDSA
+		loaded := NewEdDSA(saved.localPartyKey, saved.transport, saved.keyDir, saved.password, zerolog.Nop())
+		require.NoError(t, loaded.LoadKey())
+		require.Equal(t, pubKey, loaded.PubKey())
+
+		wrongPassword := NewEdDSA(saved.localPartyKey, saved.transport, saved.keyDir, "wrong", zerolog.Nop())
+		require.NoError(t, wrongPassword.LoadKey())
+		require.Nil(t, wrongPassword.PubKey())
+	})
+}
+
+func TestMessageRouter(t *testing.T) {
+	t.Run("should drop the messages of unknown parties", func(t *testing.T) {
+		router := newMessageRouter()
+		router.AllowParties([]string{"alice"})
+
+		require.ErrorContains(t, router.route("id", EdDSAMessage{From: "mallory"}), "unknown party")
+		require.NoError(t, router.route("id", EdDSAMessage{From: "alice", Round: roundJoin}))
+
+		msg := <-router.Subscribe("id")
+		require.Equal(t, "alice", msg.From)
+	})
+
+	t.Run("should limit the messages of a party", func(t *testing.T) {
+		router := newMessageRouter()
+		router.AllowParties([]string{"alice", "bob"})
+
+		for i := 0; i < eddsaMaxMessagesPerParty; i++ {
+			require.NoError(t, router.route("id", EdDSAMessage{From: "alice"}))
+		}
+		require.ErrorContains(t, router.route("id", EdDSAMessage{From: "alice"}), "too many messages")
+		require.NoError(t, router.route("id", EdDSAMessage{From: "bob"}))
+	})
+
+	t.Run("should limit the pending ceremonies opened by a party", func(t *testing.T) {
+		router := newMessageRouter()
+		router.AllowParties([]string{"alice", "bob"})
+
+		for i := 0; i < eddsaMaxPendingCeremoniesPerParty; i++ {
+			require.NoError(t, router.route(fmt.Sprintf("id%d", i), EdDSAMessage{From: "alice"}))
+		}
+		require.Error(t, router.route("other", EdDSAMessage{From: "alice"}))
+
+		// the ceremonies of the other parties are kept
+		require.NoError(t, router.route("other", EdDSAMessage{From: "bob"}))
+		msg := <-router.Subscribe("id0")
+		require.Equal(t, "alice", msg.From)
+	})
+}
+
+func TestEdDSA_SolanaSigner(t *testing.T) {
+	tssList, _ := newTestTSSs(t, 3)
+	var tssAddress solana.PublicKey
+	copy(tssAddress[:], tssList[0].PubKeyEdDSA())
+
+	gatewayID, err := solana.PublicKeyFromBase58(testutils.SolanaGatewayAddressLocalnet)
+	require.NoError(t, err)
+	validator := testutils.NewSolanaValidator(t, gatewayID, tssAddress)
+	validator.Fund(tssAddress, 1_000_000)
+
+	// fund the gateway with a deposit
+	depositorPub, depositor, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	var depositorAddress solana.PublicKey
+	copy(depositorAddress[:], depositorPub)
+	validator.Fund(depositorAddress, 100_000+solana.LamportsPerSignature)
+	validator.Deposit(t, depositor, 100_000, sample.EthAddress().Bytes())
+	cfg := config.SolanaConfig{Endpoint: validator.Endpoint()}
+
+	// every TSS signer builds the withdrawal with its own Solana signer
+	signers := make([]*solsigner.Signer, len(tssList))
+	for i, tss := range tssList {
+		signers[i] = solsigner.NewSigner(cfg, tss, clientcommon.ClientLogger{}, nil, nil)
+		require.Equal(t, tssAddress, signers[i].TSSAddress())
+	}
+
+	recipient := solana.PublicKey{1, 2, 3}
+	txs := make([]*solana.Transaction, len(signers))
+	errs := make([]error, len(signers))
+	var wg sync.WaitGroup
+	for i := range signers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			txs[i], errs[i] = signers[i].SignWithdrawTx(gatewayID, recipient, 1000, 10, 0, &chains.SolanaLocalnet)
+		}(i)
+	}
+	wg.Wait()
+
+	// the committee members sign the same transaction
+	var signed []int
+	for i := range signers {
+		if errs[i] != nil {
+			require.ErrorContains(t, errs[i], "not in the signing committee")
+			continue
+		}
+		signed = append(signed, i)
+		require.NoError(t, txs[i].Verify())
+		require.Equal(t, []solana.PublicKey{tssAddress}, txs[i].Message.Signers())
+	}
+	require.Len(t, signed, 2)
+	require.Equal(t, txs[signed[0]].Serialize(), txs[signed[1]].Serialize())
+
+	require.NoError(t, signers[signed[0]].Broadcast(txs[signed[0]]))
+	require.EqualValues(t, 1000, validator.Balance(recipient))
+}
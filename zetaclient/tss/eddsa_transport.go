@@ -0,0 +1,294 @@
+package tss
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	maddr "github.com/multiformats/go-multiaddr"
+	"github.com/rs/zerolog"
+	"github.com/zeta-chain/go-tss/conversion"
+	"github.com/zeta-chain/go-tss/p2p"
+)
+
+const (
+	// EdDSAProtocolID is the libp2p protocol of the messages of the EdDSA ceremonies
+	EdDSAProtocolID protocol.ID = "/p2p/tss-eddsa"
+
+	// DefaultEdDSAPort is the default port of the p2p communication of the EdDSA ceremonies
+	DefaultEdDSAPort = 6669
+
+	// eddsaSendTimeout is the timeout to deliver a message to a party
+	eddsaSendTimeout = 10 * time.Second
+
+	// eddsaPendingTTL is how long the messages of a ceremony not started locally yet are kept
+	eddsaPendingTTL = 2 * time.Minute
+
+	// eddsaMaxPendingCeremoniesPerParty is the max number of ceremonies not started locally yet a party can open
+	eddsaMaxPendingCeremoniesPerParty = 20
+
+	// eddsaMaxMessagesPerParty is the max number of messages of a party in a ceremony
+	// a party sends at most 4 messages to another party in a keygen and 3 in a keysign
+	eddsaMaxMessagesPerParty = 16
+)
+
+// EdDSAMessage is a message of an EdDSA ceremony sent by a party
+// the parties are identified by their grantee pubkeys (bech32)
+type EdDSAMessage struct {
+	From  string `json:"from"`
+	Round int    `json:"round"`
+
+	// Broadcast is set if the tss-lib message is sent to all the parties of the ceremony
+	Broadcast bool   `json:"broadcast,omitempty"`
+	Payload   []byte `json:"payload"`
+}
+
+// EdDSATransport delivers the messages of the EdDSA ceremonies among the TSS parties
+type EdDSATransport interface {
+	// Send sends the message of the ceremony msgID to the given parties
+	Send(msgID string, to []string, msg EdDSAMessage) error
+
+	// Subscribe returns the channel receiving the messages of the ceremony msgID sent by other parties,
+	// including the messages received before the subscription
+	Subscribe(msgID string) <-chan EdDSAMessage
+
+	// Unsubscribe stops receiving the messages of the ceremony msgID
+	Unsubscribe(msgID string)
+
+	// AllowParties sets the parties whose messages are received, the messages of the other parties are dropped
+	AllowParties(parties []string)
+}
+
+// p2pEnvelope is the message sent over the wire
+type p2pEnvelope struct {
+	MsgID   string       `json:"msg_id"`
+	Message EdDSAMessage `json:"message"`
+}
+
+// pendingMessages are the messages of a ceremony received before the subscription
+type pendingMessages struct {
+	receivedAt time.Time
+	openedBy   string
+	messages   []EdDSAMessage
+}
+
+// messageRouter routes the received messages to the subscribed ceremonies
+//   - only the messages of the allowed parties (the TSS signers) are received
+//   - the number of messages of a party in a ceremony and the number of ceremonies not started locally yet
+//     a party can open are bounded, so a party can't evict the messages of the other parties
+type messageRouter struct {
+	mu          sync.Mutex
+	allowed     map[string]bool
+	subscribers map[string]chan EdDSAMessage
+	pending     map[string]*pendingMessages
+
+	// counts are the number of messages received per ceremony and per party
+	counts map[string]map[string]int
+}
+
+func newMessageRouter() *messageRouter {
+	return &messageRouter{
+		allowed:     make(map[string]bool),
+		subscribers: make(map[string]chan EdDSAMessage),
+		pending:     make(map[string]*pendingMessages),
+		counts:      make(map[string]map[string]int),
+	}
+}
+
+// AllowParties sets the parties whose messages are received
+func (r *messageRouter) AllowParties(parties []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.allowed = make(map[string]bool, len(parties))
+	for _, party := range parties {
+		r.allowed[party] = true
+	}
+}
+
+// Subscribe subscribes to the messages of the ceremony msgID
+func (r *messageRouter) Subscribe(msgID string) <-chan EdDSAMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ch := make(chan EdDSAMessage, eddsaMaxMessagesPerParty*(len(r.allowed)+1))
+	if pending, found := r.pending[msgID]; found {
+		for _, msg := range pending.messages {
+			select {
+			case ch <- msg:
+			default:
+			}
+		}
+		delete(r.pending, msgID)
+	}
+	r.subscribers[msgID] = ch
+	return ch
+}
+
+// Unsubscribe unsubscribes from the messages of the ceremony msgID
+func (r *messageRouter) Unsubscribe(msgID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.subscribers, msgID)
+	delete(r.counts, msgID)
+}
+
+// route delivers the message to the subscribed ceremony or keeps it until the ceremony is started
+func (r *messageRouter) route(msgID string, msg EdDSAMessage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.allowed[msg.From] {
+		return fmt.Errorf("message of ceremony %s from unknown party %s", msgID, msg.From)
+	}
+	if r.counts[msgID][msg.From] >= eddsaMaxMessagesPerParty {
+		return fmt.Errorf("too many messages of ceremony %s from party %s", msgID, msg.From)
+	}
+
+	if ch, found := r.subscribers[msgID]; found {
+		select {
+		case ch <- msg:
+			r.count(msgID, msg.From)
+			return nil
+		default:
+			return fmt.Errorf("subscriber of ceremony %s is full", msgID)
+		}
+	}
+
+	// drop the expired ceremonies
+	now := time.Now()
+	opened := 0
+	for id, pending := range r.pending {
+		if now.Sub(pending.receivedAt) > eddsaPendingTTL {
+			delete(r.pending, id)
+			delete(r.counts, id)
+			continue
+		}
+		if pending.openedBy == msg.From {
+			opened++
+		}
+	}
+	pending, found := r.pending[msgID]
+	if !found {
+		if opened >= eddsaMaxPendingCeremoniesPerParty {
+			return fmt.Errorf("too many pending ceremonies opened by party %s", msg.From)
+		}
+		pending = &pendingMessages{receivedAt: now, openedBy: msg.From}
+		r.pending[msgID] = pending
+	}
+	pending.messages = append(pending.messages, msg)
+	r.count(msgID, msg.From)
+	return nil
+}
+
+// count counts the message of the party in the ceremony msgID
+func (r *messageRouter) count(msgID string, party string) {
+	if r.counts[msgID] == nil {
+		r.counts[msgID] = make(map[string]int)
+	}
+	r.counts[msgID][party]++
+}
+
+var _ EdDSATransport = (*P2PTransport)(nil)
+
+// P2PTransport is the EdDSATransport over a libp2p host
+type P2PTransport struct {
+	*messageRouter
+	host   host.Host
+	logger zerolog.Logger
+}
+
+// NewP2PTransport creates the EdDSA transport handling the EdDSA protocol on the libp2p host
+func NewP2PTransport(h host.Host, logger zerolog.Logger) *P2PTransport {
+	t := &P2PTransport{
+		messageRouter: newMessageRouter(),
+		host:          h,
+		logger:        logger.With().Str("module", "eddsa_transport").Logger(),
+	}
+	h.SetStreamHandler(EdDSAProtocolID, t.handleStream)
+	return t
+}
+
+// Send sends the message of the ceremony msgID to the given parties
+func (t *P2PTransport) Send(msgID string, to []string, msg EdDSAMessage) error {
+	payload, err := json.Marshal(p2pEnvelope{MsgID: msgID, Message: msg})
+	if err != nil {
+		return err
+	}
+	for _, party := range to {
+		peerID, err := conversion.GetPeerIDFromPubKey(party)
+		if err != nil {
+			return fmt.Errorf("error getting peer id of party %s: %w", party, err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), eddsaSendTimeout)
+		stream, err := t.host.NewStream(ctx, peerID, EdDSAProtocolID)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("error opening stream to peer %s: %w", peerID, err)
+		}
+		err = p2p.WriteStreamWithBuffer(payload, stream)
+		_ = stream.Close()
+		if err != nil {
+			return fmt.Errorf("error writing to peer %s: %w", peerID, err)
+		}
+	}
+	return nil
+}
+
+// handleStream reads the message sent by a peer, the sender has to be the party of the peer
+func (t *P2PTransport) handleStream(stream network.Stream) {
+	defer stream.Close()
+	remotePeer := stream.Conn().RemotePeer()
+
+	payload, err := p2p.ReadStreamWithBuffer(stream)
+	if err != nil {
+		t.logger.Error().Err(err).Msgf("error reading from peer %s", remotePeer)
+		return
+	}
+	var envelope p2pEnvelope
+	if err := json.Unmarshal(payload, &envelope); err != nil {
+		t.logger.Error().Err(err).Msgf("error unmarshalling message from peer %s", remotePeer)
+		return
+	}
+	peerID, err := conversion.GetPeerIDFromPubKey(envelope.Message.From)
+	if err != nil || peerID != remotePeer {
+		t.logger.Error().Msgf("message from party %s sent by peer %s", envelope.Message.From, remotePeer)
+		return
+	}
+	if err := t.route(envelope.MsgID, envelope.Message); err != nil {
+		t.logger.Error().Err(err).Msgf("error routing message from peer %s", remotePeer)
+	}
+}
+
+// eddsaBootstrapPeers returns the bootstrap peers of the TSS server on the port of the EdDSA communication
+// the bootstrap peers are expected to use the same port, the other peers are discovered through them
+func eddsaBootstrapPeers(peers p2p.AddrList, port int) ([]maddr.Multiaddr, error) {
+	eddsaPeers := make([]maddr.Multiaddr, 0, len(peers))
+	for _, peer := range peers {
+		components := make([]maddr.Multiaddr, 0)
+		var err error
+		maddr.ForEach(peer, func(c maddr.Component) bool {
+			if c.Protocol().Code != maddr.P_TCP {
+				components = append(components, &c)
+				return true
+			}
+			var tcp *maddr.Component
+			tcp, err = maddr.NewComponent(c.Protocol().Name, fmt.Sprintf("%d", port))
+			if err != nil {
+				return false
+			}
+			components = append(components, tcp)
+			return true
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error rewriting bootstrap peer %s: %w", peer, err)
+		}
+		eddsaPeers = append(eddsaPeers, maddr.Join(components...))
+	}
+	return eddsaPeers, nil
+}
@@ -2,6 +2,7 @@ package tss
 
 import (
 	"bytes"
+	"crypto/ed25519"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
@@ -22,6 +23,8 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	thorcommon "github.com/zeta-chain/go-tss/common"
+	"github.com/zeta-chain/go-tss/conversion"
+	tsskeygen "github.com/zeta-chain/go-tss/keygen"
 	"github.com/zeta-chain/go-tss/keysign"
 	"github.com/zeta-chain/go-tss/p2p"
 	"github.com/zeta-chain/go-tss/tss"
@@ -67,7 +70,10 @@ func NewTSSKey(pk string) (*Key, error) {
 	return TSSKey, nil
 }
 
-var _ interfaces.TSSSigner = (*TSS)(nil)
+var (
+	_ interfaces.TSSSigner      = (*TSS)(nil)
+	_ interfaces.TSSSignerEdDSA = (*TSS)(nil)
+)
 
 // TSS is a struct that holds the server and the keys for TSS
 type TSS struct {
@@ -79,8 +85,11 @@ type TSS struct {
 	ZetacoreClient  interfaces.ZetacoreClient
 	KeysignsTracker *ConcurrentKeysignsTracker
 
-	// TODO: support multiple Bitcoin network, not just one network
-	// https://github.com/zeta-chain/node/issues/1397
+	// EdDSA runs the ceremonies of the ed25519 key of the TSS, nil if not set up
+	EdDSA *EdDSA
+
+	// BitcoinChainID is the bitcoin chain used to validate the TSS and log its address
+	// the bitcoin observers and signers derive the TSS address of their own chain
 	BitcoinChainID int64
 }
 
@@ -127,6 +136,11 @@ func NewTSS(
 		client.GetLogger().Error().Err(err).Msg("VerifyKeysharesForPubkeys fail")
 	}
 
+	newTss.EdDSA, err = SetupEdDSA(peer, privkey, appContext.Config(), pubkeyInBech32, tssPassword)
+	if err != nil {
+		return nil, fmt.Errorf("SetupEdDSA error: %w", err)
+	}
+
 	keygenRes, err := newTss.ZetacoreClient.GetKeyGen()
 	if err != nil {
 		return nil, err
@@ -207,6 +221,48 @@ func SetupTSSServer(
 	return tssServer, nil
 }
 
+// SetupEdDSA starts the p2p communication of the EdDSA ceremonies and loads the EdDSA key share of the party
+// the EdDSA ceremonies run on their own port as the TSS server doesn't support them
+func SetupEdDSA(
+	peer p2p.AddrList,
+	privkey tmcrypto.PrivKey,
+	cfg config.Config,
+	granteePubkey string,
+	tssPassword string,
+) (*EdDSA, error) {
+	port := cfg.EdDSAPort
+	if port == 0 {
+		port = DefaultEdDSAPort
+	}
+	bootstrapPeers, err := eddsaBootstrapPeers(peer, port)
+	if err != nil {
+		return nil, err
+	}
+	comm, err := p2p.NewCommunication("MetaMetaOpenTheDoorEdDSA", bootstrapPeers, port, cfg.PublicIP)
+	if err != nil {
+		return nil, fmt.Errorf("NewCommunication error: %w", err)
+	}
+	privkeyBytes, err := conversion.GetPriKeyRawBytes(privkey)
+	if err != nil {
+		return nil, err
+	}
+	if err := comm.Start(privkeyBytes); err != nil {
+		return nil, fmt.Errorf("eddsa communication start error: %w", err)
+	}
+
+	transport := NewP2PTransport(comm.GetHost(), log.Logger)
+	eddsa := NewEdDSA(granteePubkey, transport, cfg.TssPath, tssPassword, log.Logger)
+	if err := eddsa.LoadKey(); err != nil {
+		return nil, err
+	}
+	return eddsa, nil
+}
+
+// Keygen runs the ECDSA keygen of the TSS server
+func (tss *TSS) Keygen(req tsskeygen.Request) (tsskeygen.Response, error) {
+	return withECDSACurve(func() (tsskeygen.Response, error) { return tss.Server.Keygen(req) })
+}
+
 func (tss *TSS) Pubkey() []byte {
 	return tss.Keys[tss.CurrentPubkey].PubkeyInBytes
 }
@@ -238,7 +294,7 @@ func (tss *TSS) Sign(
 		"0.14.0",
 	)
 	tss.KeysignsTracker.StartMsgSign()
-	ksRes, err := tss.Server.KeySign(keysignReq)
+	ksRes, err := withECDSACurve(func() (keysign.Response, error) { return tss.Server.KeySign(keysignReq) })
 	tss.KeysignsTracker.EndMsgSign()
 	if err != nil {
 		log.Warn().Msg("keysign fail")
@@ -302,6 +358,29 @@ func (tss *TSS) Sign(
 	return sigbyte, nil
 }
 
+// PubKeyEdDSA returns the ed25519 public key of the TSS, nil if no EdDSA key is generated
+func (tss *TSS) PubKeyEdDSA() ed25519.PublicKey {
+	if tss.EdDSA == nil {
+		return nil
+	}
+	return tss.EdDSA.PubKey()
+}
+
+// SignEdDSA signs the message with the ed25519 key of the TSS
+func (tss *TSS) SignEdDSA(msg []byte, height uint64, nonce uint64, chain *chains.Chain) ([64]byte, error) {
+	if tss.EdDSA == nil {
+		return [64]byte{}, fmt.Errorf("eddsa is not set up")
+	}
+	tss.KeysignsTracker.StartMsgSign()
+	sig, err := tss.EdDSA.Sign(msg, height, nonce, chain.ChainId)
+	tss.KeysignsTracker.EndMsgSign()
+	if err != nil {
+		tss.logger.Error().Err(err).Msgf("eddsa keysign failed for chain %d nonce %d", chain.ChainId, nonce)
+		return [64]byte{}, err
+	}
+	return sig, nil
+}
+
 // SignBatch is hash of some data
 // digest should be batch of hashes of some data
 func (tss *TSS) SignBatch(digests [][]byte, height uint64, nonce uint64, chain *chains.Chain) ([][65]byte, error) {
@@ -314,7 +393,7 @@ func (tss *TSS) SignBatch(digests [][]byte, height uint64, nonce uint64, chain *
 	keysignReq := keysign.NewRequest(tssPubkey, digestBase64, int64(height), nil, "0.14.0")
 
 	tss.KeysignsTracker.StartMsgSign()
-	ksRes, err := tss.Server.KeySign(keysignReq)
+	ksRes, err := withECDSACurve(func() (keysign.Response, error) { return tss.Server.KeySign(keysignReq) })
 	tss.KeysignsTracker.EndMsgSign()
 	if err != nil {
 		log.Warn().Err(err).Msg("keysign fail")
@@ -412,8 +491,8 @@ func (tss *TSS) Validate() error {
 		return fmt.Errorf("invalid evm address : %s", evmAddress.String())
 	}
 
-	if tss.BTCAddressWitnessPubkeyHash() == nil {
-		return fmt.Errorf("invalid btc pub key hash : %s", tss.BTCAddress())
+	if tss.BTCAddressWitnessPubkeyHash(tss.BitcoinChainID) == nil {
+		return fmt.Errorf("invalid btc pub key hash : %s", tss.BTCAddress(tss.BitcoinChainID))
 	}
 
 	return nil
@@ -428,9 +507,9 @@ func (tss *TSS) EVMAddress() ethcommon.Address {
 	return addr
 }
 
-// BTCAddress generates a bech32 p2wpkh address from pubkey
-func (tss *TSS) BTCAddress() string {
-	addr, err := GetTssAddrBTC(tss.CurrentPubkey, tss.BitcoinChainID)
+// BTCAddress generates a bech32 p2wpkh address from pubkey for the bitcoin chain
+func (tss *TSS) BTCAddress(chainID int64) string {
+	addr, err := GetTssAddrBTC(tss.CurrentPubkey, chainID)
 	if err != nil {
 		log.Error().Err(err).Msg("getKeyAddr error")
 		return ""
@@ -438,8 +517,8 @@ func (tss *TSS) BTCAddress() string {
 	return addr
 }
 
-func (tss *TSS) BTCAddressWitnessPubkeyHash() *btcutil.AddressWitnessPubKeyHash {
-	addrWPKH, err := getKeyAddrBTCWitnessPubkeyHash(tss.CurrentPubkey, tss.BitcoinChainID)
+func (tss *TSS) BTCAddressWitnessPubkeyHash(chainID int64) *btcutil.AddressWitnessPubKeyHash {
+	addrWPKH, err := getKeyAddrBTCWitnessPubkeyHash(tss.CurrentPubkey, chainID)
 	if err != nil {
 		log.Error().Err(err).Msg("BTCAddressPubkeyHash error")
 		return nil
@@ -571,7 +650,7 @@ func TestKeysign(tssPubkey string, tssServer *tss.TssServer) error {
 		nil,
 		"0.14.0",
 	)
-	ksRes, err := tssServer.KeySign(keysignReq)
+	ksRes, err := withECDSACurve(func() (keysign.Response, error) { return tssServer.KeySign(keysignReq) })
 	if err != nil {
 		log.Warn().Msg("keysign fail")
 	}
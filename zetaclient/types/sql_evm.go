@@ -69,6 +69,17 @@ type LastBlockSQLType struct {
 	Num uint64
 }
 
+// SignedOutboundSQLType is a journal entry of an outbound signed by TSS, kept until the outbound is confirmed
+type SignedOutboundSQLType struct {
+	gorm.Model
+	Identifier        string `gorm:"uniqueIndex"`
+	Nonce             uint64 `gorm:"index"`
+	TxHash            string
+	RawTx             []byte
+	GasPrice          string
+	BroadcastAttempts uint64
+}
+
 // Type translation functions:
 
 func ToReceiptDBType(receipt *ethtypes.Receipt) (ReceiptDB, error) {
@@ -160,3 +171,23 @@ func ToLastBlockSQLType(lastBlock uint64) *LastBlockSQLType {
 		Num:   lastBlock,
 	}
 }
+
+func ToSignedOutboundSQLType(outboundID string, transaction *ethtypes.Transaction) (*SignedOutboundSQLType, error) {
+	rawTx, err := transaction.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &SignedOutboundSQLType{
+		Identifier: outboundID,
+		Nonce:      transaction.Nonce(),
+		TxHash:     transaction.Hash().Hex(),
+		RawTx:      rawTx,
+		GasPrice:   transaction.GasPrice().String(),
+	}, nil
+}
+
+func FromSignedOutboundSQLType(signedOutbound SignedOutboundSQLType) (*ethtypes.Transaction, error) {
+	res := &ethtypes.Transaction{}
+	err := res.UnmarshalBinary(signedOutbound.RawTx)
+	return res, err
+}
@@ -0,0 +1,22 @@
+package types
+
+import "gorm.io/gorm"
+
+// Solana chain observer types ----------------------------------->
+
+// LastSignatureID is the identifier of the last processed signature entry
+const LastSignatureID = 0xBEEF
+
+// LastSignatureSQLType is the signature of the last transaction processed by the Solana chain observer
+type LastSignatureSQLType struct {
+	gorm.Model
+	Signature string
+}
+
+// ToLastSignatureSQLType converts the last processed signature to its relational mapping
+func ToLastSignatureSQLType(signature string) *LastSignatureSQLType {
+	return &LastSignatureSQLType{
+		Model:     gorm.Model{ID: LastSignatureID},
+		Signature: signature,
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/zeta-chain/zetacore/cmd/zetacored/config"
 	"github.com/zeta-chain/zetacore/zetaclient/authz"
 	"github.com/zeta-chain/zetacore/zetaclient/hsm"
+	"github.com/zeta-chain/zetacore/zetaclient/remotesigner"
 )
 
 // BroadcastInterface defines the signature of the broadcast function used by zetacore transactions
@@ -211,6 +212,9 @@ func (c *Client) SignTx(
 	overwriteSig bool,
 	txConfig client.TxConfig,
 ) error {
+	if signer := c.keys.GetRemoteSigner(); signer != nil {
+		return remotesigner.SignTx(signer, txf, txBuilder, overwriteSig, txConfig)
+	}
 	if c.cfg.HsmMode {
 		return hsm.SignWithHSM(txf, name, txBuilder, overwriteSig, txConfig)
 	}
@@ -214,24 +214,32 @@ func (c *Client) UpdateZetacoreContext(
 		c.pause <- struct{}{} // notify Orchestrator to stop Observers, Signers, and Orchestrator itself
 	}
 
+	additionalChains, err := c.GetAdditionalChains()
+	if err != nil {
+		return fmt.Errorf("failed to get additional chains: %w", err)
+	}
+
 	chainParams, err := c.GetChainParams()
 	if err != nil {
 		return fmt.Errorf("failed to get chain params: %w", err)
 	}
 
 	newEVMParams := make(map[int64]*observertypes.ChainParams)
-	var newBTCParams *observertypes.ChainParams
+	newBTCParams := make(map[int64]*observertypes.ChainParams)
+	var newSolanaParams *observertypes.ChainParams
 
 	// check and update chain params for each chain
 	for _, chainParam := range chainParams {
-		err := observertypes.ValidateChainParams(chainParam)
+		err := observertypes.ValidateChainParams(chainParam, additionalChains)
 		if err != nil {
 			sampledLogger.Warn().Err(err).Msgf("Invalid chain params for chain %d", chainParam.ChainId)
 			continue
 		}
-		if chains.IsBitcoinChain(chainParam.ChainId) {
-			newBTCParams = chainParam
-		} else if chains.IsEVMChain(chainParam.ChainId) {
+		if chains.IsBitcoinChain(chainParam.ChainId, additionalChains) {
+			newBTCParams[chainParam.ChainId] = chainParam
+		} else if chains.IsSolanaChain(chainParam.ChainId, additionalChains) {
+			newSolanaParams = chainParam
+		} else if chains.IsEVMChain(chainParam.ChainId, additionalChains) {
 			newEVMParams[chainParam.ChainId] = chainParam
 		}
 	}
@@ -272,8 +280,10 @@ func (c *Client) UpdateZetacoreContext(
 	coreContext.Update(
 		keyGen,
 		newChains,
+		additionalChains,
 		newEVMParams,
 		newBTCParams,
+		newSolanaParams,
 		tssPubKey,
 		crosschainFlags,
 		blockHeaderEnabledChains,
@@ -0,0 +1,21 @@
+package zetacore
+
+import (
+	"fmt"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+
+	"github.com/zeta-chain/zetacore/zetaclient/chains/interfaces"
+)
+
+// DialEvents creates and starts the CometBFT websocket client subscribing to the zetacore blocks and tx events
+func (c *Client) DialEvents() (interfaces.ZetacoreEventsClient, error) {
+	client, err := rpchttp.New(fmt.Sprintf("tcp://%s", c.cfg.ChainRPC), "/websocket")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cometbft client: %w", err)
+	}
+	if err := client.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start cometbft websocket client: %w", err)
+	}
+	return client, nil
+}
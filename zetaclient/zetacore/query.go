@@ -15,10 +15,13 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/zeta-chain/zetacore/cmd/zetacored/config"
 	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/pkg/proofs"
+	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
 	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
 	lightclienttypes "github.com/zeta-chain/zetacore/x/lightclient/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
@@ -34,6 +37,19 @@ func (c *Client) GetCrosschainFlags() (observertypes.CrosschainFlags, error) {
 	return resp.CrosschainFlags, nil
 }
 
+// GetAdditionalChains returns the chains added in the chain info of the authority module
+// the list is empty if the chain info is not set
+func (c *Client) GetAdditionalChains() ([]chains.Chain, error) {
+	client := authoritytypes.NewQueryClient(c.grpcConn)
+	resp, err := client.ChainInfo(context.Background(), &authoritytypes.QueryGetChainInfoRequest{})
+	if status.Code(err) == codes.NotFound {
+		return []chains.Chain{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return resp.ChainInfo.Chains, nil
+}
+
 func (c *Client) GetBlockHeaderEnabledChains() ([]lightclienttypes.HeaderSupportedChain, error) {
 	client := lightclienttypes.NewQueryClient(c.grpcConn)
 	resp, err := client.HeaderEnabledChains(context.Background(), &lightclienttypes.QueryHeaderEnabledChainsRequest{})
@@ -107,6 +123,25 @@ func (c *Client) GetCctxByHash(sendHash string) (*crosschaintypes.CrossChainTx,
 	return resp.CrossChainTx, nil
 }
 
+// GetInboundHashToCctxData returns the cctxs created by the inbound and the indexes of the pruned ones
+// none if the inbound has no cctx
+func (c *Client) GetInboundHashToCctxData(
+	inboundHash string,
+) (*crosschaintypes.QueryInboundHashToCctxDataResponse, error) {
+	client := crosschaintypes.NewQueryClient(c.grpcConn)
+	resp, err := client.InboundHashToCctxData(
+		context.Background(),
+		&crosschaintypes.QueryInboundHashToCctxDataRequest{InboundHash: inboundHash},
+	)
+	if status.Code(err) == codes.NotFound {
+		return &crosschaintypes.QueryInboundHashToCctxDataResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *Client) GetCctxByNonce(chainID int64, nonce uint64) (*crosschaintypes.CrossChainTx, error) {
 	client := crosschaintypes.NewQueryClient(c.grpcConn)
 	resp, err := client.CctxByNonce(context.Background(), &crosschaintypes.QueryGetCctxByNonceRequest{
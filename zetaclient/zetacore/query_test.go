@@ -19,6 +19,7 @@ import (
 	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/pkg/coin"
 	"github.com/zeta-chain/zetacore/testutil/sample"
+	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
 	crosschainTypes "github.com/zeta-chain/zetacore/x/crosschain/types"
 	lightclienttypes "github.com/zeta-chain/zetacore/x/lightclient/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
@@ -153,6 +154,24 @@ func TestZetacore_HeaderEnabledChains(t *testing.T) {
 	require.Equal(t, expectedOutput.HeaderEnabledChains, resp)
 }
 
+func TestZetacore_GetAdditionalChains(t *testing.T) {
+	expectedOutput := authoritytypes.QueryGetChainInfoResponse{
+		ChainInfo: sample.ChainInfo(42),
+	}
+	input := authoritytypes.QueryGetChainInfoRequest{}
+	method := "/zetachain.zetacore.authority.Query/ChainInfo"
+	server := setupMockServer(t, authoritytypes.RegisterQueryServer, method, input, expectedOutput)
+	server.Serve()
+	defer closeMockServer(t, server)
+
+	client, err := setupZetacoreClient()
+	require.NoError(t, err)
+
+	resp, err := client.GetAdditionalChains()
+	require.NoError(t, err)
+	require.Equal(t, expectedOutput.ChainInfo.Chains, resp)
+}
+
 func TestZetacore_GetChainParamsForChainID(t *testing.T) {
 	expectedOutput := observertypes.QueryGetChainParamsForChainResponse{ChainParams: &observertypes.ChainParams{
 		ChainId:               123,
@@ -282,6 +301,30 @@ func TestZetacore_GetCctxByNonce(t *testing.T) {
 	require.Equal(t, expectedOutput.CrossChainTx, resp)
 }
 
+func TestZetacore_GetInboundHashToCctxData(t *testing.T) {
+	expectedOutput := crosschainTypes.QueryInboundHashToCctxDataResponse{
+		CrossChainTxs: []crosschainTypes.CrossChainTx{
+			{Index: "9c8d02b6956b9c78ecb6090a8160faaa48e7aecfd0026fcdf533721d861436a3"},
+		},
+		PrunedCctxIndexes: []string{"0x01"},
+	}
+	input := crosschainTypes.QueryInboundHashToCctxDataRequest{
+		InboundHash: "0xeaec67d5dd5d85f27b21bef83e01cbdf59154fd793ea7a22c297f7c3a722c532",
+	}
+	method := "/zetachain.zetacore.crosschain.Query/InboundHashToCctxData"
+	server := setupMockServer(t, crosschainTypes.RegisterQueryServer, method, input, expectedOutput)
+	server.Serve()
+	defer closeMockServer(t, server)
+
+	client, err := setupZetacoreClient()
+	require.NoError(t, err)
+
+	resp, err := client.GetInboundHashToCctxData(input.InboundHash)
+	require.NoError(t, err)
+	require.Equal(t, expectedOutput.CrossChainTxs, resp.CrossChainTxs)
+	require.Equal(t, expectedOutput.PrunedCctxIndexes, resp.PrunedCctxIndexes)
+}
+
 func TestZetacore_GetObserverList(t *testing.T) {
 	expectedOutput := observertypes.QueryObserverSetResponse{
 		Observers: []string{
@@ -22,6 +22,8 @@ import (
 	"github.com/zeta-chain/zetacore/pkg/chains"
 	"github.com/zeta-chain/zetacore/pkg/coin"
 	"github.com/zeta-chain/zetacore/pkg/proofs"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
 	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
 	lightclienttypes "github.com/zeta-chain/zetacore/x/lightclient/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
@@ -41,68 +43,68 @@ const (
 func Test_GasPriceMultiplier(t *testing.T) {
 	tt := []struct {
 		name       string
-		chainID    int64
+		chain      chains.Chain
 		multiplier float64
 		fail       bool
 	}{
 		{
 			name:       "get Ethereum multiplier",
-			chainID:    1,
+			chain:      chains.Ethereum,
 			multiplier: 1.2,
 			fail:       false,
 		},
 		{
 			name:       "get Goerli multiplier",
-			chainID:    5,
+			chain:      chains.Goerli,
 			multiplier: 1.2,
 			fail:       false,
 		},
 		{
 			name:       "get BSC multiplier",
-			chainID:    56,
+			chain:      chains.BscMainnet,
 			multiplier: 1.2,
 			fail:       false,
 		},
 		{
 			name:       "get BSC Testnet multiplier",
-			chainID:    97,
+			chain:      chains.BscTestnet,
 			multiplier: 1.2,
 			fail:       false,
 		},
 		{
 			name:       "get Polygon multiplier",
-			chainID:    137,
+			chain:      chains.Polygon,
 			multiplier: 1.2,
 			fail:       false,
 		},
 		{
 			name:       "get Mumbai Testnet multiplier",
-			chainID:    80001,
+			chain:      chains.Mumbai,
 			multiplier: 1.2,
 			fail:       false,
 		},
 		{
 			name:       "get Bitcoin multiplier",
-			chainID:    8332,
+			chain:      chains.BitcoinMainnet,
 			multiplier: 2.0,
 			fail:       false,
 		},
 		{
 			name:       "get Bitcoin Testnet multiplier",
-			chainID:    18332,
+			chain:      chains.BitcoinTestnet,
 			multiplier: 2.0,
 			fail:       false,
 		},
 		{
 			name:       "get unknown chain gas price multiplier",
-			chainID:    1234,
+			chain:      chains.Chain{ChainId: 1234, Consensus: chains.Consensus_tendermint},
 			multiplier: 1.0,
 			fail:       true,
 		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
-			multiplier, err := GasPriceMultiplier(tc.chainID)
+			multiplier, err := GasPriceMultiplier(tc.chain)
 			if tc.fail {
 				require.Error(t, err)
 				return
@@ -203,12 +205,14 @@ func TestZetacore_UpdateZetacoreContext(t *testing.T) {
 	//Setup server for multiple grpc calls
 	listener, err := net.Listen("tcp", "127.0.0.1:9090")
 	require.NoError(t, err)
+	chainInfo := sample.ChainInfo(42)
 
 	server := grpcmock.MockUnstartedServer(
 		grpcmock.RegisterService(crosschaintypes.RegisterQueryServer),
 		grpcmock.RegisterService(upgradetypes.RegisterQueryServer),
 		grpcmock.RegisterService(observertypes.RegisterQueryServer),
 		grpcmock.RegisterService(lightclienttypes.RegisterQueryServer),
+		grpcmock.RegisterService(authoritytypes.RegisterQueryServer),
 		grpcmock.WithPlanner(planner.FirstMatch()),
 		grpcmock.WithListener(listener),
 		func(s *grpcmock.Server) {
@@ -229,6 +233,12 @@ func TestZetacore_UpdateZetacoreContext(t *testing.T) {
 					},
 				})
 
+			method = "/zetachain.zetacore.authority.Query/ChainInfo"
+			s.ExpectUnary(method).
+				UnlimitedTimes().
+				WithPayload(authoritytypes.QueryGetChainInfoRequest{}).
+				Return(authoritytypes.QueryGetChainInfoResponse{ChainInfo: chainInfo})
+
 			method = "/zetachain.zetacore.observer.Query/GetChainParams"
 			s.ExpectUnary(method).
 				UnlimitedTimes().
@@ -337,6 +347,7 @@ func TestZetacore_UpdateZetacoreContext(t *testing.T) {
 		zetacoreBroadcast = MockBroadcast
 		err := client.UpdateZetacoreContext(coreCtx, false, zerolog.Logger{})
 		require.NoError(t, err)
+		require.Equal(t, chainInfo.Chains, coreCtx.GetAdditionalChains())
 	})
 }
 